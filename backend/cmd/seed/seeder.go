@@ -11,10 +11,11 @@ import (
 
 	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	data_process_service "github.com/qsoulior/tech-generator/backend/internal/service/data_process"
+	data_process_domain "github.com/qsoulior/tech-generator/backend/internal/service/data_process/domain"
+	variable_process_service "github.com/qsoulior/tech-generator/backend/internal/service/variable_process"
+	variable_process_domain "github.com/qsoulior/tech-generator/backend/internal/service/variable_process/domain"
 	version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
-	task_process_domain "github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/domain"
-	data_process_service "github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/service/data_process"
-	variable_process_service "github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/service/variable_process"
 )
 
 type templateInput struct {
@@ -50,7 +51,7 @@ type constraintInput struct {
 
 type taskInput struct {
 	Title     string // только для логов
-	Payload   map[string]string
+	Payload   map[string]any
 	CreatedAt time.Time
 	UpdatedAt time.Time // также используется как момент завершения обработки
 }
@@ -233,8 +234,8 @@ func (s *seeder) createAndProcessTask(ctx context.Context, creatorID, versionID
 	return taskID, nil
 }
 
-func (s *seeder) processTask(ctx context.Context, version version_get_domain.Version, payload map[string]string) ([]byte, *task_domain.ProcessError) {
-	values, err := variable_process_service.New().Handle(ctx, task_process_domain.VariableProcessIn{
+func (s *seeder) processTask(ctx context.Context, version version_get_domain.Version, payload map[string]any) ([]byte, *task_domain.ProcessError) {
+	values, err := variable_process_service.New().Handle(ctx, variable_process_domain.VariableProcessIn{
 		Variables: version.Variables,
 		Payload:   payload,
 	})
@@ -246,7 +247,7 @@ func (s *seeder) processTask(ctx context.Context, version version_get_domain.Ver
 		return nil, &task_domain.ProcessError{Message: err.Error()}
 	}
 
-	data, err := data_process_service.New().Handle(ctx, task_process_domain.DataProcessIn{
+	data, err := data_process_service.New().Handle(ctx, data_process_domain.DataProcessIn{
 		Values: values,
 		Data:   version.Data,
 	})
@@ -8,7 +8,7 @@ func passportTasks() []taskInput {
 		Title:     "Паспорт NotifyHub 1.4.2 (успех)",
 		CreatedAt: dateTaskSuccessCreated,
 		UpdatedAt: dateTaskSuccessUpdated,
-		Payload: map[string]string{
+		Payload: map[string]any{
 			"service_name":           "NotifyHub",
 			"service_owner":          "Команда платформы коммуникаций",
 			"service_version":        "1.4.2",
@@ -32,7 +32,7 @@ func passportTasks() []taskInput {
 		Title:     "Паспорт NotifyHub black-friday (ошибка)",
 		CreatedAt: dateTaskFailCreated,
 		UpdatedAt: dateTaskFailUpdated,
-		Payload: map[string]string{
+		Payload: map[string]any{
 			"service_name":           "NotifyHub",
 			"service_owner":          "Команда платформы коммуникаций",
 			"service_version":        "1.5.0-rc1",
@@ -61,7 +61,7 @@ func approbationTZTask() taskInput {
 		Title:     "ТЗ NotifyHub — апробация",
 		CreatedAt: dateApprobationTZTask,
 		UpdatedAt: dateApprobationTZTaskDone,
-		Payload: map[string]string{
+		Payload: map[string]any{
 			"system_name":      "Сервис уведомлений NotifyHub",
 			"system_code":      "ИС-УВЕД-2026",
 			"document_version": "1.0",
@@ -105,7 +105,7 @@ func approbationPMITask() taskInput {
 		Title:     "ПМИ NotifyHub — апробация",
 		CreatedAt: dateApprobationPMITask,
 		UpdatedAt: dateApprobationPMITaskDone,
-		Payload: map[string]string{
+		Payload: map[string]any{
 			"program_name":           "NotifyHub",
 			"program_version":        "1.4.2",
 			"document_code":          "ПМИ-NOTIFY-2026-04",
@@ -134,7 +134,7 @@ func approbationOPTask() taskInput {
 		Title:     "Описание программы NotifyHub — апробация",
 		CreatedAt: dateApprobationOPTask,
 		UpdatedAt: dateApprobationOPTaskDone,
-		Payload: map[string]string{
+		Payload: map[string]any{
 			"program_name":           "NotifyHub",
 			"program_designation":    "ПО-NOTIFY-2026",
 			"program_version":        "1.4.2",
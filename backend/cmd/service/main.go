@@ -17,12 +17,24 @@ import (
 	"github.com/qsoulior/tech-generator/backend/internal/pkg/httpserver"
 	"github.com/qsoulior/tech-generator/backend/internal/pkg/postgres"
 	"github.com/qsoulior/tech-generator/backend/internal/pkg/rabbitmq"
+	version_render_service "github.com/qsoulior/tech-generator/backend/internal/service/version_render"
 	"github.com/qsoulior/tech-generator/backend/internal/transport/http"
 	error_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/error"
+	project_constant_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_constant_list"
+	project_constant_save_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_constant_save"
 	project_create_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_create"
 	project_delete_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_delete"
+	project_dictionary_delete_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_dictionary_delete"
+	project_dictionary_get_by_id_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_dictionary_get_by_id"
+	project_dictionary_import_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_dictionary_import"
+	project_dictionary_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_dictionary_list"
+	project_dictionary_save_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_dictionary_save"
+	project_function_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_function_list"
+	project_function_save_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_function_save"
 	project_get_by_id_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_get_by_id"
 	project_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_list"
+	project_partial_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_partial_list"
+	project_partial_save_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_partial_save"
 	project_update_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_update"
 	project_update_users_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_update_users"
 	project_users_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_users"
@@ -48,11 +60,24 @@ import (
 	version_create_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/version_create"
 	version_create_from_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/version_create_from"
 	version_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/version_list"
+	version_preview_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/version_preview"
+	version_preview_draft_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/version_preview_draft"
 	auth_middleware "github.com/qsoulior/tech-generator/backend/internal/transport/http/middleware/auth"
+	project_constant_list_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_list"
+	project_constant_save_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save"
 	project_create_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_create"
 	project_delete_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_delete"
+	project_dictionary_delete_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete"
+	project_dictionary_get_by_id_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_get_by_id"
+	project_dictionary_import_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_import"
+	project_dictionary_list_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_list"
+	project_dictionary_save_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_save"
+	project_function_list_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_list"
+	project_function_save_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save"
 	project_get_by_id_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_get_by_id"
 	project_list_by_user_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_list_by_user"
+	project_partial_list_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_list"
+	project_partial_save_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save"
 	project_update_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_update"
 	project_user_list_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_user_list"
 	project_user_update_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/project_user_update"
@@ -78,6 +103,8 @@ import (
 	version_create_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/version_create"
 	version_create_from_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/version_create_from"
 	version_list_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/version_list"
+	version_preview_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview"
+	version_preview_draft_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview_draft"
 )
 
 func main() {
@@ -161,10 +188,24 @@ func run() (code int) {
 		return 1
 	}
 
+	// previews share one render service to keep their concurrency within the limit
+	versionRenderService := version_render_service.New(cfg.VersionPreviewTimeout, cfg.VersionPreviewMaxOutputBytes, cfg.VersionPreviewBudget, cfg.VersionPreviewConcurrency)
+
+	projectConstantListUsecase := project_constant_list_usecase.New(db)
+	projectConstantSaveUsecase := project_constant_save_usecase.New(db)
 	projectCreateUsecase := project_create_usecase.New(db)
 	projectDeleteUsecase := project_delete_usecase.New(db)
+	projectDictionaryDeleteUsecase := project_dictionary_delete_usecase.New(db)
+	projectDictionaryGetByIDUsecase := project_dictionary_get_by_id_usecase.New(db)
+	projectDictionaryImportUsecase := project_dictionary_import_usecase.New(db)
+	projectDictionaryListUsecase := project_dictionary_list_usecase.New(db)
+	projectDictionarySaveUsecase := project_dictionary_save_usecase.New(db)
+	projectFunctionListUsecase := project_function_list_usecase.New(db)
+	projectFunctionSaveUsecase := project_function_save_usecase.New(db)
 	projectGetByIDUsecase := project_get_by_id_usecase.New(db)
 	projectListUsecase := project_list_by_user_usecase.New(db)
+	projectPartialListUsecase := project_partial_list_usecase.New(db)
+	projectPartialSaveUsecase := project_partial_save_usecase.New(db)
 	projectUpdateUsecase := project_update_usecase.New(db)
 	projectUserListUsecase := project_user_list_usecase.New(db)
 	projectUserUpdateUsecase := project_user_update_usecase.New(db)
@@ -190,12 +231,25 @@ func run() (code int) {
 	versionCreateUsecase := version_create_usecase.New(db)
 	versionCreateFromUsecase := version_create_from_usecase.New(db)
 	versionListUsecase := version_list_usecase.New(db)
+	versionPreviewUsecase := version_preview_usecase.New(db, versionRenderService)
+	versionPreviewDraftUsecase := version_preview_draft_usecase.New(db, versionRenderService)
 
 	apiHandler := &http.Handler{
+		ProjectConstantListHandler:       project_constant_list_handler.New(projectConstantListUsecase),
+		ProjectConstantSaveHandler:       project_constant_save_handler.New(projectConstantSaveUsecase),
 		ProjectCreateHandler:             project_create_handler.New(projectCreateUsecase),
 		ProjectDeleteHandler:             project_delete_handler.New(projectDeleteUsecase),
+		ProjectDictionaryDeleteHandler:   project_dictionary_delete_handler.New(projectDictionaryDeleteUsecase),
+		ProjectDictionaryGetByIDHandler:  project_dictionary_get_by_id_handler.New(projectDictionaryGetByIDUsecase),
+		ProjectDictionaryImportHandler:   project_dictionary_import_handler.New(projectDictionaryImportUsecase),
+		ProjectDictionaryListHandler:     project_dictionary_list_handler.New(projectDictionaryListUsecase),
+		ProjectDictionarySaveHandler:     project_dictionary_save_handler.New(projectDictionarySaveUsecase),
+		ProjectFunctionListHandler:       project_function_list_handler.New(projectFunctionListUsecase),
+		ProjectFunctionSaveHandler:       project_function_save_handler.New(projectFunctionSaveUsecase),
 		ProjectGetByIDHandler:            project_get_by_id_handler.New(projectGetByIDUsecase),
 		ProjectListHandler:               project_list_handler.New(projectListUsecase),
+		ProjectPartialListHandler:        project_partial_list_handler.New(projectPartialListUsecase),
+		ProjectPartialSaveHandler:        project_partial_save_handler.New(projectPartialSaveUsecase),
 		ProjectUpdateHandler:             project_update_handler.New(projectUpdateUsecase),
 		ProjectUpdateUsersHandler:        project_update_users_handler.New(projectUserUpdateUsecase),
 		ProjectUsersHandler:              project_users_handler.New(projectUserListUsecase),
@@ -221,6 +275,8 @@ func run() (code int) {
 		VersionCreateHandler:             version_create_handler.New(versionCreateUsecase),
 		VersionCreateFromHandler:         version_create_from_handler.New(versionCreateFromUsecase),
 		VersionListHandler:               version_list_handler.New(versionListUsecase),
+		VersionPreviewHandler:            version_preview_handler.New(versionPreviewUsecase),
+		VersionPreviewDraftHandler:       version_preview_draft_handler.New(versionPreviewDraftUsecase),
 	}
 
 	apiServer, err := api.NewServer(apiHandler,
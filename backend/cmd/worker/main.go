@@ -7,11 +7,16 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 
+	"github.com/qsoulior/tech-generator/backend/internal/config"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/lru"
 	"github.com/qsoulior/tech-generator/backend/internal/pkg/postgres"
 	"github.com/qsoulior/tech-generator/backend/internal/pkg/rabbitmq"
+	data_process_service "github.com/qsoulior/tech-generator/backend/internal/service/data_process"
+	variable_process_service "github.com/qsoulior/tech-generator/backend/internal/service/variable_process"
 	task_process_handler "github.com/qsoulior/tech-generator/backend/internal/transport/amqp/handler/task_process"
 	task_process_usecase "github.com/qsoulior/tech-generator/backend/internal/usecase/task_process"
 )
@@ -34,6 +39,12 @@ func run() (code int) {
 		return 1
 	}
 
+	cfg, err := config.NewWorker()
+	if err != nil {
+		logger.Error("init config", slog.String("err", err.Error()))
+		return 1
+	}
+
 	db, err := postgres.Connect(ctx)
 	if err != nil {
 		logger.Error("connect postgres", slog.String("err", err.Error()))
@@ -79,7 +90,14 @@ func run() (code int) {
 		return 1
 	}
 
-	taskProcessUsecase := task_process_usecase.New(db)
+	variableCache := variable_process_service.NewCache(cfg.WorkerVersionCacheSize)
+	templateCache := data_process_service.NewCache(cfg.WorkerVersionCacheSize)
+	// a non-positive interval disables the cache stats
+	if cfg.WorkerCacheStatsInterval > 0 {
+		go logCacheStats(ctx, logger, cfg.WorkerCacheStatsInterval, variableCache.Stats, templateCache.Stats)
+	}
+
+	taskProcessUsecase := task_process_usecase.New(db, cfg, variableCache, templateCache, logger)
 	taskProcessHandler := task_process_handler.New(taskProcessUsecase)
 
 	msgs, err := ch.ConsumeWithContext(ctx, "task_created", "", false, false, false, false, nil)
@@ -98,3 +116,30 @@ func run() (code int) {
 	logger.Info("stop consumer")
 	return 0
 }
+
+// logCacheStats periodically logs the counters of the version caches.
+func logCacheStats(ctx context.Context, logger *slog.Logger, interval time.Duration, variableStats, templateStats func() lru.Stats) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			logger.Info("version cache stats",
+				slog.Any("variables", cacheStatsAttrs(variableStats())),
+				slog.Any("templates", cacheStatsAttrs(templateStats())),
+			)
+		}
+	}
+}
+
+func cacheStatsAttrs(stats lru.Stats) slog.Value {
+	return slog.GroupValue(
+		slog.Uint64("hits", stats.Hits),
+		slog.Uint64("misses", stats.Misses),
+		slog.Uint64("evictions", stats.Evictions),
+		slog.Int("len", stats.Len),
+	)
+}
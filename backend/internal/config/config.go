@@ -11,6 +11,11 @@ type Config struct {
 	ServiceAllowedOrigins []string      `envconfig:"SERVICE_ALLOWED_ORIGINS" required:"true"`
 	Ed25519PrivateKeyPath string        `envconfig:"ED25519_PRIVATE_KEY_PATH" required:"true"`
 	Ed25519PublicKeyPath  string        `envconfig:"ED25519_PUBLIC_KEY_PATH" required:"true"`
+
+	VersionPreviewTimeout        time.Duration `envconfig:"VERSION_PREVIEW_TIMEOUT" default:"5s"`
+	VersionPreviewMaxOutputBytes int           `envconfig:"VERSION_PREVIEW_MAX_OUTPUT_BYTES" default:"1048576"`
+	VersionPreviewBudget         uint          `envconfig:"VERSION_PREVIEW_BUDGET" default:"1000000"`
+	VersionPreviewConcurrency    int           `envconfig:"VERSION_PREVIEW_CONCURRENCY" default:"4"`
 }
 
 func New() (*Config, error) {
@@ -23,3 +28,23 @@ func New() (*Config, error) {
 
 	return &cfg, nil
 }
+
+type WorkerConfig struct {
+	WorkerVersionCacheSize   int           `envconfig:"WORKER_VERSION_CACHE_SIZE" default:"256"`
+	WorkerCacheStatsInterval time.Duration `envconfig:"WORKER_CACHE_STATS_INTERVAL" default:"1m"`
+
+	WorkerTaskTimeout    time.Duration `envconfig:"WORKER_TASK_TIMEOUT" default:"30s"`
+	WorkerMaxOutputBytes int           `envconfig:"WORKER_MAX_OUTPUT_BYTES" default:"10485760"`
+	WorkerBudget         uint          `envconfig:"WORKER_BUDGET" default:"1000000"`
+}
+
+func NewWorker() (*WorkerConfig, error) {
+	var cfg WorkerConfig
+
+	err := envconfig.Process("", &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
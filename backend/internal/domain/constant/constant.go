@@ -0,0 +1,9 @@
+package constant_domain
+
+// Constant is a project constant: a named string that the expressions and the
+// templates of every template in the project read under the project
+// namespace, e.g. .project.org_name.
+type Constant struct {
+	Name  string
+	Value string
+}
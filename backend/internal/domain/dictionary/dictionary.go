@@ -0,0 +1,89 @@
+package dictionary_domain
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+)
+
+// KeyColumn names the key of the rows, so that lookups and enum options can
+// refer to it like to any other column.
+const KeyColumn = "key"
+
+// ColumnTypes are the types a dictionary column may have.
+var ColumnTypes = []variable_domain.Type{
+	variable_domain.TypeString,
+	variable_domain.TypeInteger,
+	variable_domain.TypeFloat,
+	variable_domain.TypeDecimal,
+	variable_domain.TypeBoolean,
+	variable_domain.TypeDate,
+}
+
+// Dictionary is a project dictionary: a table of rows with unique keys and
+// typed columns that expressions read with lookup and enum variables take
+// their options from.
+type Dictionary struct {
+	// VersionID identifies the saved version of the dictionary. Versions are
+	// immutable, so it identifies the contents as well.
+	VersionID int64
+	Name      string
+	Columns   []Column
+	Rows      []Row
+}
+
+type Column struct {
+	Name string
+	Type variable_domain.Type
+}
+
+// Row holds the textual form of the values of a row by column name, see
+// variable_domain.Type.Parse.
+type Row struct {
+	Key    string
+	Values map[string]string
+}
+
+// Column returns the named column. The key column is a string column.
+func (d Dictionary) Column(name string) (Column, bool) {
+	if name == KeyColumn {
+		return Column{Name: KeyColumn, Type: variable_domain.TypeString}, true
+	}
+	return lo.Find(d.Columns, func(c Column) bool { return c.Name == name })
+}
+
+// Row returns the row with the key.
+func (d Dictionary) Row(key string) (Row, bool) {
+	return lo.Find(d.Rows, func(r Row) bool { return r.Key == key })
+}
+
+// Values returns the values of the named column in row order without
+// duplicates.
+func (d Dictionary) Values(column string) []string {
+	values := make([]string, 0, len(d.Rows))
+	for _, r := range d.Rows {
+		value := r.Values[column]
+		if column == KeyColumn {
+			value = r.Key
+		}
+		if !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// Find returns the named dictionary.
+func Find(dictionaries []Dictionary, name string) (Dictionary, bool) {
+	return lo.Find(dictionaries, func(d Dictionary) bool { return d.Name == name })
+}
+
+// SplitReference splits a reference to a dictionary column, such as
+// "materials.density", into the dictionary and column names.
+func SplitReference(ref string) (dictionary, column string, ok bool) {
+	dictionary, column, ok = strings.Cut(ref, ".")
+	return dictionary, column, ok && dictionary != "" && column != ""
+}
@@ -1,6 +1,9 @@
 package error_domain
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type ValidationError struct {
 	Field  string
@@ -16,3 +19,25 @@ func (e *ValidationError) Error() string {
 }
 
 func (e *ValidationError) Unwrap() error { return e.Reason }
+
+// ValidationErrors collects several validation errors so that all of them are
+// reported at once.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
@@ -0,0 +1,12 @@
+package function_domain
+
+// Function is a project function: a named expression with params that the
+// variable expressions of every template in the project may call.
+type Function struct {
+	// VersionID identifies the saved version of the function. Versions are
+	// immutable, so it identifies the definition as well.
+	VersionID int64
+	Name      string
+	Params    []string
+	Body      string
+}
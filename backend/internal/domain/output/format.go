@@ -0,0 +1,27 @@
+package output_domain
+
+type Format string
+
+const (
+	FormatMarkdown Format = "md"
+	FormatDOCX     Format = "docx"
+	FormatPDF      Format = "pdf"
+	FormatXLSX     Format = "xlsx"
+)
+
+var formatContentTypes = map[Format]string{
+	FormatMarkdown: "text/markdown; charset=utf-8",
+	FormatDOCX:     "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	FormatPDF:      "application/pdf",
+	FormatXLSX:     "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+func (f Format) Valid() bool {
+	_, found := formatContentTypes[f]
+	return found
+}
+
+// ContentType is the MIME type of the results in the format.
+func (f Format) ContentType() string {
+	return formatContentTypes[f]
+}
@@ -0,0 +1,72 @@
+package output_domain
+
+// Profile is the page layout and text style of a rendered document.
+type Profile struct {
+	Font string
+	// FontSize is in points.
+	FontSize    float64
+	LineSpacing float64
+	// FirstLineIndent and the margins are in millimetres.
+	FirstLineIndent float64
+	MarginTop       float64
+	MarginBottom    float64
+	MarginLeft      float64
+	MarginRight     float64
+	// NumberHeadings numbers the headings from the second level on as 1, 1.1,
+	// 1.1.1 in place of the numbers written in the template.
+	NumberHeadings bool
+	// TitlePage puts everything above the first heading of the second level on
+	// a page of its own without a page number.
+	TitlePage   bool
+	PageNumbers bool
+}
+
+const DefaultProfile = "gost_34"
+
+// CodeConstant is the project constant with the document code, which is put
+// in the page header of the formats laid out by pages.
+const CodeConstant = "document_code"
+
+// Profiles are the style profiles a template may choose from by name.
+var Profiles = map[string]Profile{
+	// ГОСТ 34 documents for automated systems, laid out by ГОСТ 2.105
+	"gost_34": {
+		Font:            "Times New Roman",
+		FontSize:        14,
+		LineSpacing:     1.5,
+		FirstLineIndent: 12.5,
+		MarginTop:       20,
+		MarginBottom:    20,
+		MarginLeft:      30,
+		MarginRight:     15,
+		NumberHeadings:  true,
+		TitlePage:       true,
+		PageNumbers:     true,
+	},
+	// ЕСПД program documents by ГОСТ 19.106
+	"gost_19": {
+		Font:            "Times New Roman",
+		FontSize:        12,
+		LineSpacing:     1.5,
+		FirstLineIndent: 12.5,
+		MarginTop:       25,
+		MarginBottom:    25,
+		MarginLeft:      25,
+		MarginRight:     10,
+		NumberHeadings:  true,
+		TitlePage:       true,
+		PageNumbers:     true,
+	},
+	// plain keeps the numbering of the template and has no title page
+	"plain": {
+		Font:            "Arial",
+		FontSize:        11,
+		LineSpacing:     1.15,
+		FirstLineIndent: 0,
+		MarginTop:       20,
+		MarginBottom:    20,
+		MarginLeft:      20,
+		MarginRight:     20,
+		PageNumbers:     true,
+	},
+}
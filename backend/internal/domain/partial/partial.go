@@ -0,0 +1,12 @@
+package partial_domain
+
+// Partial is a project partial: a named piece of template text, such as a
+// title page or an approval sheet, that the templates of the project call with
+// the template and block actions.
+type Partial struct {
+	// VersionID identifies the saved version of the partial. Versions are
+	// immutable, so it identifies the text as well.
+	VersionID int64
+	Name      string
+	Data      string
+}
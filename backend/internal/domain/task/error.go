@@ -12,17 +12,32 @@ var (
 	MessageVariableNotFound    = "Переменная не найдена"
 	MessageVariableTypeUnknown = "Неизвестный тип переменной"
 	MessageVariableParse       = "Ошибка парсинга переменной"
+	MessageVariableMissing     = "Не заполнена обязательная переменная"
+	MessageVariableOption      = "Значение не входит в список допустимых"
+	MessageVariableStructure   = "Неверная структура значения"
+	MessageColumnNotFound      = "Колонка не найдена"
+	MessageColumnMissing       = "Не заполнена колонка"
 	MessageVariableCompile     = "Ошибка компиляции переменной"
 	MessageVariableExec        = "Ошибка выполнения переменной"
 	MessageConstraintCheck     = "Нарушение ограничения"
 	MessageConstraintCompile   = "Ошибка компиляции ограничения"
 	MessageConstraintExec      = "Ошибка выполнения ограничения"
+	MessageConditionCompile    = "Ошибка компиляции условия переменной"
+	MessageConditionExec       = "Ошибка выполнения условия переменной"
+	MessageFunctionCompile     = "Ошибка компиляции функции проекта"
+	MessageDictionaryNotFound  = "Справочник или его столбец не найден"
 	MessageTemplateParse       = "Ошибка парсинга шаблона"
 	MessageTemplateExec        = "Ошибка выполнения шаблона"
+	MessageOutputLimit         = "Превышен допустимый размер результата"
+	MessageTimeout             = "Превышено время генерации"
+	MessageBudget              = "Превышен лимит вычислений"
+	MessageOutputRender        = "Ошибка формирования документа"
+	MessageBusy                = "Слишком много одновременных генераций, повторите позже"
 )
 
 type ProcessError struct {
 	Message        string          `json:"message,omitempty"`
+	Cycle          []string        `json:"cycle,omitempty"`
 	VariableErrors []VariableError `json:"variable_errors,omitempty"`
 	Template       *TemplateError  `json:"template,omitempty"`
 }
@@ -35,12 +50,14 @@ func (e *ProcessError) Error() string {
 // TemplateError describes a specific location in the template source where
 // parsing or execution failed. Line is 1-based; Column is 1-based when known
 // and 0 otherwise. Snippet is the offending source line (without trailing
-// newline). Detail is the raw Go text/template diagnostic message.
+// newline). Detail is the raw Go text/template diagnostic message. Partial
+// names the project partial the location is in, empty for the template itself.
 type TemplateError struct {
 	Line    int    `json:"line"`
 	Column  int    `json:"column,omitempty"`
 	Snippet string `json:"snippet,omitempty"`
 	Detail  string `json:"detail,omitempty"`
+	Partial string `json:"partial,omitempty"`
 }
 
 type VariableError struct {
@@ -48,6 +65,7 @@ type VariableError struct {
 	Name             string            `json:"name"`
 	Title            string            `json:"title"`
 	Value            string            `json:"value,omitempty"`
+	Path             string            `json:"path,omitempty"`
 	Message          string            `json:"message,omitempty"`
 	ConstraintErrors []ConstraintError `json:"constraint_errors,omitempty"`
 }
@@ -58,12 +76,20 @@ func (e *VariableError) Error() string {
 }
 
 type ConstraintError struct {
-	ID         int64  `json:"id"`
-	Name       string `json:"name"`
-	Expression string `json:"expression"`
-	Message    string `json:"message,omitempty"`
+	ID         int64                `json:"id"`
+	Name       string               `json:"name"`
+	Expression string               `json:"expression"`
+	Message    string               `json:"message,omitempty"`
+	Variables  []ConstraintVariable `json:"variables,omitempty"`
 }
 
 func (e *ConstraintError) Error() string {
 	return fmt.Sprintf("[%d] %s: %s", e.ID, e.Name, e.Message)
 }
+
+// ConstraintVariable is a variable the failed constraint refers to, with the
+// value it had when the constraint was checked.
+type ConstraintVariable struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
@@ -0,0 +1,42 @@
+package task_domain
+
+type TraceSource string
+
+const (
+	TraceSourcePayload    TraceSource = "payload"
+	TraceSourceDefault    TraceSource = "default"
+	TraceSourceExpression TraceSource = "expression"
+	TraceSourceDisabled   TraceSource = "disabled"
+)
+
+// VariableTrace explains how the value of a variable was reached. Variables
+// are traced in evaluation order. A variable that could not be evaluated has
+// Message set and no value; failed constraint checks are in Constraints.
+type VariableTrace struct {
+	Order        int               `json:"order"`
+	Name         string            `json:"name"`
+	Source       TraceSource       `json:"source,omitempty"`
+	Condition    string            `json:"condition,omitempty"`
+	Expression   string            `json:"expression,omitempty"`
+	Dependencies []TraceDependency `json:"dependencies,omitempty"`
+	Value        string            `json:"value,omitempty"`
+	Type         string            `json:"type,omitempty"`
+	Message      string            `json:"message,omitempty"`
+	Constraints  []ConstraintTrace `json:"constraints,omitempty"`
+}
+
+// TraceDependency is a variable the traced one depends on, with the value it
+// had when the traced variable was evaluated.
+type TraceDependency struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+// ConstraintTrace is the outcome of an active constraint check.
+type ConstraintTrace struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+	Passed     bool   `json:"passed"`
+	Message    string `json:"message,omitempty"`
+}
@@ -0,0 +1,40 @@
+package template_domain
+
+import (
+	"archive/zip"
+	"bytes"
+)
+
+// Kind is the kind of the template stored in a version.
+type Kind string
+
+const (
+	// KindMarkdown is a Markdown document with text/template actions.
+	KindMarkdown Kind = "md"
+	// KindDOCX is a Word document with the actions in its text.
+	KindDOCX Kind = "docx"
+)
+
+// documentPart is the main part of a Word document.
+const documentPart = "word/document.xml"
+
+// DetectKind tells a Word document, which is a ZIP package with the document
+// part, from a Markdown template.
+func DetectKind(data []byte) Kind {
+	if !bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return KindMarkdown
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return KindMarkdown
+	}
+
+	for _, f := range zr.File {
+		if f.Name == documentPart {
+			return KindDOCX
+		}
+	}
+
+	return KindMarkdown
+}
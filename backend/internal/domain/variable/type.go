@@ -1,20 +1,72 @@
 package variable_domain
 
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
 type Type string
 
 const (
 	TypeString  Type = "string"
 	TypeInteger Type = "integer"
 	TypeFloat   Type = "float"
+	TypeBoolean Type = "boolean"
+	TypeDate    Type = "date"
+	TypeEnum    Type = "enum"
+	TypeList    Type = "list"
+	TypeTable   Type = "table"
+	TypeDecimal Type = "decimal"
 )
 
 var typeSet = map[Type]struct{}{
 	TypeString:  {},
 	TypeInteger: {},
 	TypeFloat:   {},
+	TypeBoolean: {},
+	TypeDate:    {},
+	TypeEnum:    {},
+	TypeList:    {},
+	TypeTable:   {},
+	TypeDecimal: {},
 }
 
 func (r Type) Valid() bool {
 	_, found := typeSet[r]
 	return found
 }
+
+// Scalar reports whether values of the type are single values rather than
+// lists or tables.
+func (r Type) Scalar() bool {
+	return r.Valid() && r != TypeList && r != TypeTable
+}
+
+// Numeric reports whether values of the type are numbers, which may declare a
+// unit.
+func (r Type) Numeric() bool {
+	return r == TypeInteger || r == TypeFloat || r == TypeDecimal
+}
+
+var parsers = map[Type]func(s string) (any, error){
+	TypeInteger: func(s string) (any, error) { return strconv.ParseInt(s, 10, 64) },
+	TypeFloat:   func(s string) (any, error) { return strconv.ParseFloat(s, 64) },
+	TypeString:  func(s string) (any, error) { return s, nil },
+	TypeBoolean: func(s string) (any, error) { return strconv.ParseBool(s) },
+	TypeDate:    func(s string) (any, error) { return time.Parse(time.DateOnly, s) },
+	TypeEnum:    func(s string) (any, error) { return s, nil },
+	TypeDecimal: func(s string) (any, error) { return decimal.NewFromString(s) },
+}
+
+// Parse parses the textual form of a scalar value of the type. Enum values are
+// returned as is, without checking the options.
+func (r Type) Parse(s string) (any, error) {
+	parser, ok := parsers[r]
+	if !ok {
+		return nil, fmt.Errorf("type %q is not scalar", r)
+	}
+	return parser(s)
+}
@@ -28,6 +28,199 @@ func (c *codeRecorder) Unwrap() http.ResponseWriter {
 
 func recordError(string, error) {}
 
+// handleProjectConstantListRequest handles projectConstantList operation.
+//
+// Получить список констант проекта.
+//
+// GET /project/constant/list/{projectID}
+func (s *Server) handleProjectConstantListRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ProjectConstantListOperation,
+			ID:   "projectConstantList",
+		}
+	)
+	params, err := decodeProjectConstantListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ProjectConstantListRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ProjectConstantListOperation,
+			OperationSummary: "Получить список констант проекта",
+			OperationID:      "projectConstantList",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "projectID",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ProjectConstantListParams
+			Response = ProjectConstantListRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackProjectConstantListParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProjectConstantList(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProjectConstantList(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProjectConstantListResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleProjectConstantSaveRequest handles projectConstantSave operation.
+//
+// Новые значения используются в задачах, созданных
+// после сохранения. Обработанные задачи сохраняют
+// значения, с которыми они были сформированы.
+//
+// POST /project/constant/save/{projectID}
+func (s *Server) handleProjectConstantSaveRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ProjectConstantSaveOperation,
+			ID:   "projectConstantSave",
+		}
+	)
+	params, err := decodeProjectConstantSaveParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeProjectConstantSaveRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ProjectConstantSaveRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ProjectConstantSaveOperation,
+			OperationSummary: "Заменить список констант проекта",
+			OperationID:      "projectConstantSave",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "projectID",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *ProjectConstantSaveRequest
+			Params   = ProjectConstantSaveParams
+			Response = ProjectConstantSaveRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackProjectConstantSaveParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProjectConstantSave(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProjectConstantSave(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProjectConstantSaveResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
 // handleProjectCreateRequest handles projectCreate operation.
 //
 // Создать проект.
@@ -168,21 +361,888 @@ func (s *Server) handleProjectDeleteByIDRequest(args [1]string, argsEscaped bool
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "X-User-Id",
-					In:   "header",
-				}: params.XUserID,
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "projectID",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ProjectDeleteByIDParams
+			Response = ProjectDeleteByIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackProjectDeleteByIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProjectDeleteByID(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProjectDeleteByID(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProjectDeleteByIDResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleProjectDictionaryDeleteByIDRequest handles projectDictionaryDeleteByID operation.
+//
+// Справочник перестает быть доступен шаблонам, но его
+// версии сохраняются,
+// чтобы ранее созданные задачи генерировались так же.
+//
+// DELETE /project/dictionary/delete/{dictionaryID}
+func (s *Server) handleProjectDictionaryDeleteByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ProjectDictionaryDeleteByIDOperation,
+			ID:   "projectDictionaryDeleteByID",
+		}
+	)
+	params, err := decodeProjectDictionaryDeleteByIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ProjectDictionaryDeleteByIDRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ProjectDictionaryDeleteByIDOperation,
+			OperationSummary: "Удалить справочник проекта",
+			OperationID:      "projectDictionaryDeleteByID",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "dictionaryID",
+					In:   "path",
+				}: params.DictionaryID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ProjectDictionaryDeleteByIDParams
+			Response = ProjectDictionaryDeleteByIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackProjectDictionaryDeleteByIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProjectDictionaryDeleteByID(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProjectDictionaryDeleteByID(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProjectDictionaryDeleteByIDResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleProjectDictionaryGetByIDRequest handles projectDictionaryGetByID operation.
+//
+// Получить справочник проекта по ID.
+//
+// GET /project/dictionary/get/{dictionaryID}
+func (s *Server) handleProjectDictionaryGetByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ProjectDictionaryGetByIDOperation,
+			ID:   "projectDictionaryGetByID",
+		}
+	)
+	params, err := decodeProjectDictionaryGetByIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ProjectDictionaryGetByIDRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ProjectDictionaryGetByIDOperation,
+			OperationSummary: "Получить справочник проекта по ID",
+			OperationID:      "projectDictionaryGetByID",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "dictionaryID",
+					In:   "path",
+				}: params.DictionaryID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ProjectDictionaryGetByIDParams
+			Response = ProjectDictionaryGetByIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackProjectDictionaryGetByIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProjectDictionaryGetByID(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProjectDictionaryGetByID(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProjectDictionaryGetByIDResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleProjectDictionaryImportRequest handles projectDictionaryImport operation.
+//
+// Создает справочник проекта или новую версию
+// справочника с тем же названием из CSV.
+// Первая строка — заголовок: первый столбец содержит
+// ключи, остальные задаются как
+// name или name:type. Столбец без типа сохраняет тип из
+// текущей версии справочника
+// или становится строковым. Разделитель — запятая или
+// точка с запятой; в числах
+// допускается десятичная запятая.
+//
+// POST /project/dictionary/import/{projectID}
+func (s *Server) handleProjectDictionaryImportRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ProjectDictionaryImportOperation,
+			ID:   "projectDictionaryImport",
+		}
+	)
+	params, err := decodeProjectDictionaryImportParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeProjectDictionaryImportRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ProjectDictionaryImportRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ProjectDictionaryImportOperation,
+			OperationSummary: "Импортировать справочник проекта из CSV",
+			OperationID:      "projectDictionaryImport",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "projectID",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *ProjectDictionaryImportRequest
+			Params   = ProjectDictionaryImportParams
+			Response = ProjectDictionaryImportRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackProjectDictionaryImportParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProjectDictionaryImport(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProjectDictionaryImport(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProjectDictionaryImportResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleProjectDictionaryListRequest handles projectDictionaryList operation.
+//
+// Получить список справочников проекта.
+//
+// GET /project/dictionary/list/{projectID}
+func (s *Server) handleProjectDictionaryListRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ProjectDictionaryListOperation,
+			ID:   "projectDictionaryList",
+		}
+	)
+	params, err := decodeProjectDictionaryListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ProjectDictionaryListRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ProjectDictionaryListOperation,
+			OperationSummary: "Получить список справочников проекта",
+			OperationID:      "projectDictionaryList",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "projectID",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ProjectDictionaryListParams
+			Response = ProjectDictionaryListRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackProjectDictionaryListParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProjectDictionaryList(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProjectDictionaryList(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProjectDictionaryListResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleProjectDictionarySaveRequest handles projectDictionarySave operation.
+//
+// Создает справочник проекта или новую версию
+// справочника с тем же названием.
+// Выражения переменных читают справочник функцией
+// lookup(dict, key, column),
+// а перечисления могут брать из его столбца допустимые
+// значения.
+//
+// POST /project/dictionary/save/{projectID}
+func (s *Server) handleProjectDictionarySaveRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ProjectDictionarySaveOperation,
+			ID:   "projectDictionarySave",
+		}
+	)
+	params, err := decodeProjectDictionarySaveParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeProjectDictionarySaveRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ProjectDictionarySaveRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ProjectDictionarySaveOperation,
+			OperationSummary: "Сохранить справочник проекта",
+			OperationID:      "projectDictionarySave",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "projectID",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *ProjectDictionarySaveRequest
+			Params   = ProjectDictionarySaveParams
+			Response = ProjectDictionarySaveRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackProjectDictionarySaveParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProjectDictionarySave(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProjectDictionarySave(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProjectDictionarySaveResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleProjectFunctionListRequest handles projectFunctionList operation.
+//
+// Получить список функций проекта.
+//
+// GET /project/function/list/{projectID}
+func (s *Server) handleProjectFunctionListRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ProjectFunctionListOperation,
+			ID:   "projectFunctionList",
+		}
+	)
+	params, err := decodeProjectFunctionListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ProjectFunctionListRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ProjectFunctionListOperation,
+			OperationSummary: "Получить список функций проекта",
+			OperationID:      "projectFunctionList",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "projectID",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ProjectFunctionListParams
+			Response = ProjectFunctionListRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackProjectFunctionListParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProjectFunctionList(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProjectFunctionList(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProjectFunctionListResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleProjectFunctionSaveRequest handles projectFunctionSave operation.
+//
+// Создает функцию проекта или новую версию функции с
+// тем же названием.
+// Функцию могут вызывать выражения переменных всех
+// шаблонов проекта.
+//
+// POST /project/function/save/{projectID}
+func (s *Server) handleProjectFunctionSaveRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ProjectFunctionSaveOperation,
+			ID:   "projectFunctionSave",
+		}
+	)
+	params, err := decodeProjectFunctionSaveParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeProjectFunctionSaveRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ProjectFunctionSaveRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ProjectFunctionSaveOperation,
+			OperationSummary: "Сохранить функцию проекта",
+			OperationID:      "projectFunctionSave",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "projectID",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *ProjectFunctionSaveRequest
+			Params   = ProjectFunctionSaveParams
+			Response = ProjectFunctionSaveRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackProjectFunctionSaveParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProjectFunctionSave(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProjectFunctionSave(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProjectFunctionSaveResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleProjectGetByIDRequest handles projectGetByID operation.
+//
+// Получить проект по ID.
+//
+// GET /project/get/{projectID}
+func (s *Server) handleProjectGetByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ProjectGetByIDOperation,
+			ID:   "projectGetByID",
+		}
+	)
+	params, err := decodeProjectGetByIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ProjectGetByIDRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ProjectGetByIDOperation,
+			OperationSummary: "Получить проект по ID",
+			OperationID:      "projectGetByID",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "projectID",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ProjectGetByIDParams
+			Response = ProjectGetByIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackProjectGetByIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProjectGetByID(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProjectGetByID(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProjectGetByIDResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleProjectListRequest handles projectList operation.
+//
+// Получить список проектов.
+//
+// GET /project/list
+func (s *Server) handleProjectListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ProjectListOperation,
+			ID:   "projectList",
+		}
+	)
+	params, err := decodeProjectListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ProjectListRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ProjectListOperation,
+			OperationSummary: "Получить список проектов",
+			OperationID:      "projectList",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "size",
+					In:   "query",
+				}: params.Size,
+				{
+					Name: "sorting",
+					In:   "query",
+				}: params.Sorting,
 				{
-					Name: "projectID",
-					In:   "path",
-				}: params.ProjectID,
+					Name: "projectName",
+					In:   "query",
+				}: params.ProjectName,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ProjectDeleteByIDParams
-			Response = ProjectDeleteByIDRes
+			Params   = ProjectListParams
+			Response = ProjectListRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -191,14 +1251,14 @@ func (s *Server) handleProjectDeleteByIDRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackProjectDeleteByIDParams,
+			unpackProjectListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ProjectDeleteByID(ctx, params)
+				response, err = s.h.ProjectList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ProjectDeleteByID(ctx, params)
+		response, err = s.h.ProjectList(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -206,7 +1266,7 @@ func (s *Server) handleProjectDeleteByIDRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeProjectDeleteByIDResponse(response, w); err != nil {
+	if err := encodeProjectListResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -215,12 +1275,12 @@ func (s *Server) handleProjectDeleteByIDRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleProjectGetByIDRequest handles projectGetByID operation.
+// handleProjectPartialListRequest handles projectPartialList operation.
 //
-// Получить проект по ID.
+// Получить список фрагментов проекта.
 //
-// GET /project/get/{projectID}
-func (s *Server) handleProjectGetByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /project/partial/list/{projectID}
+func (s *Server) handleProjectPartialListRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -228,11 +1288,11 @@ func (s *Server) handleProjectGetByIDRequest(args [1]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ProjectGetByIDOperation,
-			ID:   "projectGetByID",
+			Name: ProjectPartialListOperation,
+			ID:   "projectPartialList",
 		}
 	)
-	params, err := decodeProjectGetByIDParams(args, argsEscaped, r)
+	params, err := decodeProjectPartialListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -245,13 +1305,13 @@ func (s *Server) handleProjectGetByIDRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response ProjectGetByIDRes
+	var response ProjectPartialListRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ProjectGetByIDOperation,
-			OperationSummary: "Получить проект по ID",
-			OperationID:      "projectGetByID",
+			OperationName:    ProjectPartialListOperation,
+			OperationSummary: "Получить список фрагментов проекта",
+			OperationID:      "projectPartialList",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -269,8 +1329,8 @@ func (s *Server) handleProjectGetByIDRequest(args [1]string, argsEscaped bool, w
 
 		type (
 			Request  = struct{}
-			Params   = ProjectGetByIDParams
-			Response = ProjectGetByIDRes
+			Params   = ProjectPartialListParams
+			Response = ProjectPartialListRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -279,14 +1339,14 @@ func (s *Server) handleProjectGetByIDRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackProjectGetByIDParams,
+			unpackProjectPartialListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ProjectGetByID(ctx, params)
+				response, err = s.h.ProjectPartialList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ProjectGetByID(ctx, params)
+		response, err = s.h.ProjectPartialList(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -294,7 +1354,7 @@ func (s *Server) handleProjectGetByIDRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeProjectGetByIDResponse(response, w); err != nil {
+	if err := encodeProjectPartialListResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -303,12 +1363,19 @@ func (s *Server) handleProjectGetByIDRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleProjectListRequest handles projectList operation.
+// handleProjectPartialSaveRequest handles projectPartialSave operation.
 //
-// Получить список проектов.
+// Создает фрагмент проекта или новую версию фрагмента
+// с тем же названием.
+// Шаблоны проекта вызывают фрагмент как {{ template
+// "название" . }} и могут
+// переопределять объявленные в нем блоки ({{ block }}), что
+// делает фрагмент макетом.
+// Версия шаблона сохраняется с версиями фрагментов,
+// которые она вызывает.
 //
-// GET /project/list
-func (s *Server) handleProjectListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /project/partial/save/{projectID}
+func (s *Server) handleProjectPartialSaveRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -316,11 +1383,11 @@ func (s *Server) handleProjectListRequest(args [0]string, argsEscaped bool, w ht
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ProjectListOperation,
-			ID:   "projectList",
+			Name: ProjectPartialSaveOperation,
+			ID:   "projectPartialSave",
 		}
 	)
-	params, err := decodeProjectListParams(args, argsEscaped, r)
+	params, err := decodeProjectPartialSaveParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -332,15 +1399,30 @@ func (s *Server) handleProjectListRequest(args [0]string, argsEscaped bool, w ht
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeProjectPartialSaveRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ProjectListRes
+	var response ProjectPartialSaveRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ProjectListOperation,
-			OperationSummary: "Получить список проектов",
-			OperationID:      "projectList",
-			Body:             nil,
+			OperationName:    ProjectPartialSaveOperation,
+			OperationSummary: "Сохранить фрагмент проекта",
+			OperationID:      "projectPartialSave",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
@@ -348,29 +1430,17 @@ func (s *Server) handleProjectListRequest(args [0]string, argsEscaped bool, w ht
 					In:   "header",
 				}: params.XUserID,
 				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
-				{
-					Name: "size",
-					In:   "query",
-				}: params.Size,
-				{
-					Name: "sorting",
-					In:   "query",
-				}: params.Sorting,
-				{
-					Name: "projectName",
-					In:   "query",
-				}: params.ProjectName,
+					Name: "projectID",
+					In:   "path",
+				}: params.ProjectID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ProjectListParams
-			Response = ProjectListRes
+			Request  = *ProjectPartialSaveRequest
+			Params   = ProjectPartialSaveParams
+			Response = ProjectPartialSaveRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -379,14 +1449,14 @@ func (s *Server) handleProjectListRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackProjectListParams,
+			unpackProjectPartialSaveParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ProjectList(ctx, params)
+				response, err = s.h.ProjectPartialSave(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ProjectList(ctx, params)
+		response, err = s.h.ProjectPartialSave(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -394,7 +1464,7 @@ func (s *Server) handleProjectListRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeProjectListResponse(response, w); err != nil {
+	if err := encodeProjectPartialSaveResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2745,3 +3815,203 @@ func (s *Server) handleVersionListRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 }
+
+// handleVersionPreviewRequest handles versionPreview operation.
+//
+// Сгенерировать документ по версии шаблона без
+// создания задачи.
+//
+// POST /version/preview
+func (s *Server) handleVersionPreviewRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: VersionPreviewOperation,
+			ID:   "versionPreview",
+		}
+	)
+	params, err := decodeVersionPreviewParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeVersionPreviewRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response VersionPreviewRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    VersionPreviewOperation,
+			OperationSummary: "Сгенерировать документ по версии шаблона без создания задачи",
+			OperationID:      "versionPreview",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *VersionPreviewRequest
+			Params   = VersionPreviewParams
+			Response = VersionPreviewRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackVersionPreviewParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.VersionPreview(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.VersionPreview(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeVersionPreviewResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleVersionPreviewDraftRequest handles versionPreviewDraft operation.
+//
+// Сгенерировать документ по несохранённому черновику
+// версии шаблона.
+//
+// POST /version/preview_draft
+func (s *Server) handleVersionPreviewDraftRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: VersionPreviewDraftOperation,
+			ID:   "versionPreviewDraft",
+		}
+	)
+	params, err := decodeVersionPreviewDraftParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeVersionPreviewDraftRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response VersionPreviewDraftRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    VersionPreviewDraftOperation,
+			OperationSummary: "Сгенерировать документ по несохранённому черновику версии шаблона",
+			OperationID:      "versionPreviewDraft",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "X-User-Id",
+					In:   "header",
+				}: params.XUserID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *VersionPreviewDraftRequest
+			Params   = VersionPreviewDraftParams
+			Response = VersionPreviewDraftRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackVersionPreviewDraftParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.VersionPreviewDraft(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.VersionPreviewDraft(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeVersionPreviewDraftResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
@@ -1,6 +1,14 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type ProjectConstantListRes interface {
+	projectConstantListRes()
+}
+
+type ProjectConstantSaveRes interface {
+	projectConstantSaveRes()
+}
+
 type ProjectCreateRes interface {
 	projectCreateRes()
 }
@@ -9,6 +17,34 @@ type ProjectDeleteByIDRes interface {
 	projectDeleteByIDRes()
 }
 
+type ProjectDictionaryDeleteByIDRes interface {
+	projectDictionaryDeleteByIDRes()
+}
+
+type ProjectDictionaryGetByIDRes interface {
+	projectDictionaryGetByIDRes()
+}
+
+type ProjectDictionaryImportRes interface {
+	projectDictionaryImportRes()
+}
+
+type ProjectDictionaryListRes interface {
+	projectDictionaryListRes()
+}
+
+type ProjectDictionarySaveRes interface {
+	projectDictionarySaveRes()
+}
+
+type ProjectFunctionListRes interface {
+	projectFunctionListRes()
+}
+
+type ProjectFunctionSaveRes interface {
+	projectFunctionSaveRes()
+}
+
 type ProjectGetByIDRes interface {
 	projectGetByIDRes()
 }
@@ -17,6 +53,14 @@ type ProjectListRes interface {
 	projectListRes()
 }
 
+type ProjectPartialListRes interface {
+	projectPartialListRes()
+}
+
+type ProjectPartialSaveRes interface {
+	projectPartialSaveRes()
+}
+
 type ProjectUpdateByIDRes interface {
 	projectUpdateByIDRes()
 }
@@ -112,3 +156,11 @@ type VersionCreateRes interface {
 type VersionListRes interface {
 	versionListRes()
 }
+
+type VersionPreviewDraftRes interface {
+	versionPreviewDraftRes()
+}
+
+type VersionPreviewRes interface {
+	versionPreviewRes()
+}
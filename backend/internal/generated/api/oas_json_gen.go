@@ -26,10 +26,21 @@ func (s *Error) encodeFields(e *jx.Encoder) {
 		e.FieldStart("message")
 		e.Str(s.Message)
 	}
+	{
+		if s.Details != nil {
+			e.FieldStart("details")
+			e.ArrStart()
+			for _, elem := range s.Details {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
 }
 
-var jsonFieldsNameOfError = [1]string{
+var jsonFieldsNameOfError = [2]string{
 	0: "message",
+	1: "details",
 }
 
 // Decode decodes Error from json.
@@ -53,6 +64,23 @@ func (s *Error) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"message\"")
 			}
+		case "details":
+			if err := func() error {
+				s.Details = make([]ErrorDetailsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ErrorDetailsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Details = append(s.Details, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"details\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -109,6 +137,154 @@ func (s *Error) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *ErrorDetailsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ErrorDetailsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("field")
+		e.Str(s.Field)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+}
+
+var jsonFieldsNameOfErrorDetailsItem = [2]string{
+	0: "field",
+	1: "message",
+}
+
+// Decode decodes ErrorDetailsItem from json.
+func (s *ErrorDetailsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ErrorDetailsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "field":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Field = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"field\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ErrorDetailsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfErrorDetailsItem) {
+					name = jsonFieldsNameOfErrorDetailsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ErrorDetailsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ErrorDetailsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes time.Time as json.
 func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
 	if !o.Set {
@@ -179,6 +355,105 @@ func (s *OptInt) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes OutputFormat as json.
+func (o OptOutputFormat) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes OutputFormat from json.
+func (o *OptOutputFormat) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptOutputFormat to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptOutputFormat) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptOutputFormat) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProcessError as json.
+func (o OptProcessError) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ProcessError from json.
+func (o *OptProcessError) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptProcessError to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptProcessError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptProcessError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProcessErrorTemplate as json.
+func (o OptProcessErrorTemplate) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ProcessErrorTemplate from json.
+func (o *OptProcessErrorTemplate) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptProcessErrorTemplate to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptProcessErrorTemplate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptProcessErrorTemplate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes string as json.
 func (o OptString) Encode(e *jx.Encoder) {
 	if !o.Set {
@@ -214,20 +489,21 @@ func (s *OptString) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
-// Encode encodes TaskGetByIDResponseTaskError as json.
-func (o OptTaskGetByIDResponseTaskError) Encode(e *jx.Encoder) {
+// Encode encodes TaskGetByIDResponseTaskConstants as json.
+func (o OptTaskGetByIDResponseTaskConstants) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes TaskGetByIDResponseTaskError from json.
-func (o *OptTaskGetByIDResponseTaskError) Decode(d *jx.Decoder) error {
+// Decode decodes TaskGetByIDResponseTaskConstants from json.
+func (o *OptTaskGetByIDResponseTaskConstants) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptTaskGetByIDResponseTaskError to nil")
+		return errors.New("invalid: unable to decode OptTaskGetByIDResponseTaskConstants to nil")
 	}
 	o.Set = true
+	o.Value = make(TaskGetByIDResponseTaskConstants)
 	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
@@ -235,30 +511,30 @@ func (o *OptTaskGetByIDResponseTaskError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptTaskGetByIDResponseTaskError) MarshalJSON() ([]byte, error) {
+func (s OptTaskGetByIDResponseTaskConstants) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptTaskGetByIDResponseTaskError) UnmarshalJSON(data []byte) error {
+func (s *OptTaskGetByIDResponseTaskConstants) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TaskGetByIDResponseTaskErrorTemplate as json.
-func (o OptTaskGetByIDResponseTaskErrorTemplate) Encode(e *jx.Encoder) {
+// Encode encodes TemplateGetByIDVersion as json.
+func (o OptTemplateGetByIDVersion) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes TaskGetByIDResponseTaskErrorTemplate from json.
-func (o *OptTaskGetByIDResponseTaskErrorTemplate) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateGetByIDVersion from json.
+func (o *OptTemplateGetByIDVersion) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptTaskGetByIDResponseTaskErrorTemplate to nil")
+		return errors.New("invalid: unable to decode OptTemplateGetByIDVersion to nil")
 	}
 	o.Set = true
 	if err := o.Value.Decode(d); err != nil {
@@ -268,30 +544,30 @@ func (o *OptTaskGetByIDResponseTaskErrorTemplate) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptTaskGetByIDResponseTaskErrorTemplate) MarshalJSON() ([]byte, error) {
+func (s OptTemplateGetByIDVersion) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptTaskGetByIDResponseTaskErrorTemplate) UnmarshalJSON(data []byte) error {
+func (s *OptTemplateGetByIDVersion) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TemplateGetByIDVersion as json.
-func (o OptTemplateGetByIDVersion) Encode(e *jx.Encoder) {
+// Encode encodes TemplateGetByIDVersionVariablesItemItemType as json.
+func (o OptTemplateGetByIDVersionVariablesItemItemType) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
-	o.Value.Encode(e)
+	e.Str(string(o.Value))
 }
 
-// Decode decodes TemplateGetByIDVersion from json.
-func (o *OptTemplateGetByIDVersion) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateGetByIDVersionVariablesItemItemType from json.
+func (o *OptTemplateGetByIDVersionVariablesItemItemType) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptTemplateGetByIDVersion to nil")
+		return errors.New("invalid: unable to decode OptTemplateGetByIDVersionVariablesItemItemType to nil")
 	}
 	o.Set = true
 	if err := o.Value.Decode(d); err != nil {
@@ -301,14 +577,14 @@ func (o *OptTemplateGetByIDVersion) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptTemplateGetByIDVersion) MarshalJSON() ([]byte, error) {
+func (s OptTemplateGetByIDVersionVariablesItemItemType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptTemplateGetByIDVersion) UnmarshalJSON(data []byte) error {
+func (s *OptTemplateGetByIDVersionVariablesItemItemType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -346,57 +622,6120 @@ func (s *OptTemplateImportVersion) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *ProjectCreateRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes TemplateImportVersionVariablesItemItemType as json.
+func (o OptTemplateImportVersionVariablesItemItemType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
 }
 
-// encodeFields encodes fields.
-func (s *ProjectCreateRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+// Decode decodes TemplateImportVersionVariablesItemItemType from json.
+func (o *OptTemplateImportVersionVariablesItemItemType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptTemplateImportVersionVariablesItemItemType to nil")
 	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
 }
 
-var jsonFieldsNameOfProjectCreateRequest = [1]string{
-	0: "name",
-}
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptTemplateImportVersionVariablesItemItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptTemplateImportVersionVariablesItemItemType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TraceItemSource as json.
+func (o OptTraceItemSource) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes TraceItemSource from json.
+func (o *OptTraceItemSource) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptTraceItemSource to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptTraceItemSource) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptTraceItemSource) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VersionCreateRequestVariablesItemItemType as json.
+func (o OptVersionCreateRequestVariablesItemItemType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VersionCreateRequestVariablesItemItemType from json.
+func (o *OptVersionCreateRequestVariablesItemItemType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVersionCreateRequestVariablesItemItemType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVersionCreateRequestVariablesItemItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVersionCreateRequestVariablesItemItemType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VersionPreviewDraftRequestVariablesItemItemType as json.
+func (o OptVersionPreviewDraftRequestVariablesItemItemType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VersionPreviewDraftRequestVariablesItemItemType from json.
+func (o *OptVersionPreviewDraftRequestVariablesItemItemType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVersionPreviewDraftRequestVariablesItemItemType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVersionPreviewDraftRequestVariablesItemItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVersionPreviewDraftRequestVariablesItemItemType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes OutputFormat as json.
+func (s OutputFormat) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes OutputFormat from json.
+func (s *OutputFormat) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OutputFormat to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch OutputFormat(v) {
+	case OutputFormatMd:
+		*s = OutputFormatMd
+	case OutputFormatDocx:
+		*s = OutputFormatDocx
+	case OutputFormatPdf:
+		*s = OutputFormatPdf
+	case OutputFormatXlsx:
+		*s = OutputFormatXlsx
+	default:
+		*s = OutputFormat(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OutputFormat) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OutputFormat) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProcessError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProcessError) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.Cycle != nil {
+			e.FieldStart("cycle")
+			e.ArrStart()
+			for _, elem := range s.Cycle {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Template.Set {
+			e.FieldStart("template")
+			s.Template.Encode(e)
+		}
+	}
+	{
+		if s.VariableErrors != nil {
+			e.FieldStart("variableErrors")
+			e.ArrStart()
+			for _, elem := range s.VariableErrors {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfProcessError = [4]string{
+	0: "message",
+	1: "cycle",
+	2: "template",
+	3: "variableErrors",
+}
+
+// Decode decodes ProcessError from json.
+func (s *ProcessError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProcessError to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "cycle":
+			if err := func() error {
+				s.Cycle = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Cycle = append(s.Cycle, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cycle\"")
+			}
+		case "template":
+			if err := func() error {
+				s.Template.Reset()
+				if err := s.Template.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"template\"")
+			}
+		case "variableErrors":
+			if err := func() error {
+				s.VariableErrors = make([]ProcessErrorVariableErrorsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProcessErrorVariableErrorsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.VariableErrors = append(s.VariableErrors, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"variableErrors\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProcessError")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProcessError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProcessError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProcessErrorTemplate) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProcessErrorTemplate) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("line")
+		e.Int(s.Line)
+	}
+	{
+		if s.Column.Set {
+			e.FieldStart("column")
+			s.Column.Encode(e)
+		}
+	}
+	{
+		if s.Snippet.Set {
+			e.FieldStart("snippet")
+			s.Snippet.Encode(e)
+		}
+	}
+	{
+		if s.Detail.Set {
+			e.FieldStart("detail")
+			s.Detail.Encode(e)
+		}
+	}
+	{
+		if s.Partial.Set {
+			e.FieldStart("partial")
+			s.Partial.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfProcessErrorTemplate = [5]string{
+	0: "line",
+	1: "column",
+	2: "snippet",
+	3: "detail",
+	4: "partial",
+}
+
+// Decode decodes ProcessErrorTemplate from json.
+func (s *ProcessErrorTemplate) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProcessErrorTemplate to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "line":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Line = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"line\"")
+			}
+		case "column":
+			if err := func() error {
+				s.Column.Reset()
+				if err := s.Column.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"column\"")
+			}
+		case "snippet":
+			if err := func() error {
+				s.Snippet.Reset()
+				if err := s.Snippet.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"snippet\"")
+			}
+		case "detail":
+			if err := func() error {
+				s.Detail.Reset()
+				if err := s.Detail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"detail\"")
+			}
+		case "partial":
+			if err := func() error {
+				s.Partial.Reset()
+				if err := s.Partial.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"partial\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProcessErrorTemplate")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProcessErrorTemplate) {
+					name = jsonFieldsNameOfProcessErrorTemplate[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProcessErrorTemplate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProcessErrorTemplate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProcessErrorVariableErrorsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProcessErrorVariableErrorsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("title")
+		e.Str(s.Title)
+	}
+	{
+		if s.Value.Set {
+			e.FieldStart("value")
+			s.Value.Encode(e)
+		}
+	}
+	{
+		if s.Path.Set {
+			e.FieldStart("path")
+			s.Path.Encode(e)
+		}
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.ConstraintErrors != nil {
+			e.FieldStart("constraintErrors")
+			e.ArrStart()
+			for _, elem := range s.ConstraintErrors {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfProcessErrorVariableErrorsItem = [7]string{
+	0: "id",
+	1: "name",
+	2: "title",
+	3: "value",
+	4: "path",
+	5: "message",
+	6: "constraintErrors",
+}
+
+// Decode decodes ProcessErrorVariableErrorsItem from json.
+func (s *ProcessErrorVariableErrorsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProcessErrorVariableErrorsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "title":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Title = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "value":
+			if err := func() error {
+				s.Value.Reset()
+				if err := s.Value.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		case "path":
+			if err := func() error {
+				s.Path.Reset()
+				if err := s.Path.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"path\"")
+			}
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "constraintErrors":
+			if err := func() error {
+				s.ConstraintErrors = make([]ProcessErrorVariableErrorsItemConstraintErrorsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProcessErrorVariableErrorsItemConstraintErrorsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ConstraintErrors = append(s.ConstraintErrors, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"constraintErrors\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProcessErrorVariableErrorsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProcessErrorVariableErrorsItem) {
+					name = jsonFieldsNameOfProcessErrorVariableErrorsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProcessErrorVariableErrorsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProcessErrorVariableErrorsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("expression")
+		e.Str(s.Expression)
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.Variables != nil {
+			e.FieldStart("variables")
+			e.ArrStart()
+			for _, elem := range s.Variables {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfProcessErrorVariableErrorsItemConstraintErrorsItem = [5]string{
+	0: "id",
+	1: "name",
+	2: "expression",
+	3: "message",
+	4: "variables",
+}
+
+// Decode decodes ProcessErrorVariableErrorsItemConstraintErrorsItem from json.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProcessErrorVariableErrorsItemConstraintErrorsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "expression":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Expression = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expression\"")
+			}
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "variables":
+			if err := func() error {
+				s.Variables = make([]ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Variables = append(s.Variables, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"variables\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProcessErrorVariableErrorsItemConstraintErrorsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProcessErrorVariableErrorsItemConstraintErrorsItem) {
+					name = jsonFieldsNameOfProcessErrorVariableErrorsItemConstraintErrorsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Value.Set {
+			e.FieldStart("value")
+			s.Value.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem = [2]string{
+	0: "name",
+	1: "value",
+}
+
+// Decode decodes ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem from json.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "value":
+			if err := func() error {
+				s.Value.Reset()
+				if err := s.Value.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem) {
+					name = jsonFieldsNameOfProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectConstantListResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectConstantListResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("constants")
+		e.ArrStart()
+		for _, elem := range s.Constants {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfProjectConstantListResponse = [1]string{
+	0: "constants",
+}
+
+// Decode decodes ProjectConstantListResponse from json.
+func (s *ProjectConstantListResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectConstantListResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "constants":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Constants = make([]ProjectConstantListResponseConstantsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectConstantListResponseConstantsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Constants = append(s.Constants, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"constants\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectConstantListResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectConstantListResponse) {
+					name = jsonFieldsNameOfProjectConstantListResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectConstantListResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectConstantListResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectConstantListResponseConstantsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectConstantListResponseConstantsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("value")
+		e.Str(s.Value)
+	}
+}
+
+var jsonFieldsNameOfProjectConstantListResponseConstantsItem = [2]string{
+	0: "name",
+	1: "value",
+}
+
+// Decode decodes ProjectConstantListResponseConstantsItem from json.
+func (s *ProjectConstantListResponseConstantsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectConstantListResponseConstantsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "value":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Value = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectConstantListResponseConstantsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectConstantListResponseConstantsItem) {
+					name = jsonFieldsNameOfProjectConstantListResponseConstantsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectConstantListResponseConstantsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectConstantListResponseConstantsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectConstantSaveRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectConstantSaveRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("constants")
+		e.ArrStart()
+		for _, elem := range s.Constants {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfProjectConstantSaveRequest = [1]string{
+	0: "constants",
+}
+
+// Decode decodes ProjectConstantSaveRequest from json.
+func (s *ProjectConstantSaveRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectConstantSaveRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "constants":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Constants = make([]ProjectConstantSaveRequestConstantsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectConstantSaveRequestConstantsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Constants = append(s.Constants, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"constants\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectConstantSaveRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectConstantSaveRequest) {
+					name = jsonFieldsNameOfProjectConstantSaveRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectConstantSaveRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectConstantSaveRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectConstantSaveRequestConstantsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectConstantSaveRequestConstantsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("value")
+		e.Str(s.Value)
+	}
+}
+
+var jsonFieldsNameOfProjectConstantSaveRequestConstantsItem = [2]string{
+	0: "name",
+	1: "value",
+}
+
+// Decode decodes ProjectConstantSaveRequestConstantsItem from json.
+func (s *ProjectConstantSaveRequestConstantsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectConstantSaveRequestConstantsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "value":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Value = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectConstantSaveRequestConstantsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectConstantSaveRequestConstantsItem) {
+					name = jsonFieldsNameOfProjectConstantSaveRequestConstantsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectConstantSaveRequestConstantsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectConstantSaveRequestConstantsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectCreateRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectCreateRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+}
+
+var jsonFieldsNameOfProjectCreateRequest = [1]string{
+	0: "name",
+}
+
+// Decode decodes ProjectCreateRequest from json.
+func (s *ProjectCreateRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectCreateRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectCreateRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectCreateRequest) {
+					name = jsonFieldsNameOfProjectCreateRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectCreateRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectCreateRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionaryGetByIDResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionaryGetByIDResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("columns")
+		e.ArrStart()
+		for _, elem := range s.Columns {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("rows")
+		e.ArrStart()
+		for _, elem := range s.Rows {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("number")
+		e.Int64(s.Number)
+	}
+	{
+		e.FieldStart("updatedAt")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+}
+
+var jsonFieldsNameOfProjectDictionaryGetByIDResponse = [6]string{
+	0: "id",
+	1: "name",
+	2: "columns",
+	3: "rows",
+	4: "number",
+	5: "updatedAt",
+}
+
+// Decode decodes ProjectDictionaryGetByIDResponse from json.
+func (s *ProjectDictionaryGetByIDResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionaryGetByIDResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "columns":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Columns = make([]ProjectDictionaryGetByIDResponseColumnsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectDictionaryGetByIDResponseColumnsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Columns = append(s.Columns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"columns\"")
+			}
+		case "rows":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Rows = make([]ProjectDictionaryGetByIDResponseRowsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectDictionaryGetByIDResponseRowsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Rows = append(s.Rows, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rows\"")
+			}
+		case "number":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.Number = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"number\"")
+			}
+		case "updatedAt":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updatedAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionaryGetByIDResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionaryGetByIDResponse) {
+					name = jsonFieldsNameOfProjectDictionaryGetByIDResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionaryGetByIDResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionaryGetByIDResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionaryGetByIDResponseColumnsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionaryGetByIDResponseColumnsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("type")
+		e.Str(s.Type)
+	}
+}
+
+var jsonFieldsNameOfProjectDictionaryGetByIDResponseColumnsItem = [2]string{
+	0: "name",
+	1: "type",
+}
+
+// Decode decodes ProjectDictionaryGetByIDResponseColumnsItem from json.
+func (s *ProjectDictionaryGetByIDResponseColumnsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionaryGetByIDResponseColumnsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Type = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionaryGetByIDResponseColumnsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionaryGetByIDResponseColumnsItem) {
+					name = jsonFieldsNameOfProjectDictionaryGetByIDResponseColumnsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionaryGetByIDResponseColumnsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionaryGetByIDResponseColumnsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionaryGetByIDResponseRowsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionaryGetByIDResponseRowsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("key")
+		e.Str(s.Key)
+	}
+	{
+		e.FieldStart("values")
+		s.Values.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfProjectDictionaryGetByIDResponseRowsItem = [2]string{
+	0: "key",
+	1: "values",
+}
+
+// Decode decodes ProjectDictionaryGetByIDResponseRowsItem from json.
+func (s *ProjectDictionaryGetByIDResponseRowsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionaryGetByIDResponseRowsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "key":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Key = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"key\"")
+			}
+		case "values":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Values.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"values\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionaryGetByIDResponseRowsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionaryGetByIDResponseRowsItem) {
+					name = jsonFieldsNameOfProjectDictionaryGetByIDResponseRowsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionaryGetByIDResponseRowsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionaryGetByIDResponseRowsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s ProjectDictionaryGetByIDResponseRowsItemValues) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ProjectDictionaryGetByIDResponseRowsItemValues) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
+	}
+}
+
+// Decode decodes ProjectDictionaryGetByIDResponseRowsItemValues from json.
+func (s *ProjectDictionaryGetByIDResponseRowsItemValues) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionaryGetByIDResponseRowsItemValues to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionaryGetByIDResponseRowsItemValues")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ProjectDictionaryGetByIDResponseRowsItemValues) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionaryGetByIDResponseRowsItemValues) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionaryImportRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionaryImportRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("data")
+		e.Str(s.Data)
+	}
+}
+
+var jsonFieldsNameOfProjectDictionaryImportRequest = [2]string{
+	0: "name",
+	1: "data",
+}
+
+// Decode decodes ProjectDictionaryImportRequest from json.
+func (s *ProjectDictionaryImportRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionaryImportRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "data":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Data = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionaryImportRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionaryImportRequest) {
+					name = jsonFieldsNameOfProjectDictionaryImportRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionaryImportRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionaryImportRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionaryImportResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionaryImportResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("number")
+		e.Int64(s.Number)
+	}
+}
+
+var jsonFieldsNameOfProjectDictionaryImportResponse = [2]string{
+	0: "id",
+	1: "number",
+}
+
+// Decode decodes ProjectDictionaryImportResponse from json.
+func (s *ProjectDictionaryImportResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionaryImportResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "number":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.Number = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"number\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionaryImportResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionaryImportResponse) {
+					name = jsonFieldsNameOfProjectDictionaryImportResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionaryImportResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionaryImportResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionaryListResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionaryListResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("dictionaries")
+		e.ArrStart()
+		for _, elem := range s.Dictionaries {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfProjectDictionaryListResponse = [1]string{
+	0: "dictionaries",
+}
+
+// Decode decodes ProjectDictionaryListResponse from json.
+func (s *ProjectDictionaryListResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionaryListResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "dictionaries":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Dictionaries = make([]ProjectDictionaryListResponseDictionariesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectDictionaryListResponseDictionariesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Dictionaries = append(s.Dictionaries, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dictionaries\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionaryListResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionaryListResponse) {
+					name = jsonFieldsNameOfProjectDictionaryListResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionaryListResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionaryListResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionaryListResponseDictionariesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionaryListResponseDictionariesItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("columns")
+		e.ArrStart()
+		for _, elem := range s.Columns {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("rowCount")
+		e.Int64(s.RowCount)
+	}
+	{
+		e.FieldStart("number")
+		e.Int64(s.Number)
+	}
+	{
+		e.FieldStart("updatedAt")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+}
+
+var jsonFieldsNameOfProjectDictionaryListResponseDictionariesItem = [6]string{
+	0: "id",
+	1: "name",
+	2: "columns",
+	3: "rowCount",
+	4: "number",
+	5: "updatedAt",
+}
+
+// Decode decodes ProjectDictionaryListResponseDictionariesItem from json.
+func (s *ProjectDictionaryListResponseDictionariesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionaryListResponseDictionariesItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "columns":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Columns = make([]ProjectDictionaryListResponseDictionariesItemColumnsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectDictionaryListResponseDictionariesItemColumnsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Columns = append(s.Columns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"columns\"")
+			}
+		case "rowCount":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.RowCount = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rowCount\"")
+			}
+		case "number":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.Number = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"number\"")
+			}
+		case "updatedAt":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updatedAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionaryListResponseDictionariesItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionaryListResponseDictionariesItem) {
+					name = jsonFieldsNameOfProjectDictionaryListResponseDictionariesItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionaryListResponseDictionariesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionaryListResponseDictionariesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionaryListResponseDictionariesItemColumnsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionaryListResponseDictionariesItemColumnsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("type")
+		e.Str(s.Type)
+	}
+}
+
+var jsonFieldsNameOfProjectDictionaryListResponseDictionariesItemColumnsItem = [2]string{
+	0: "name",
+	1: "type",
+}
+
+// Decode decodes ProjectDictionaryListResponseDictionariesItemColumnsItem from json.
+func (s *ProjectDictionaryListResponseDictionariesItemColumnsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionaryListResponseDictionariesItemColumnsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Type = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionaryListResponseDictionariesItemColumnsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionaryListResponseDictionariesItemColumnsItem) {
+					name = jsonFieldsNameOfProjectDictionaryListResponseDictionariesItemColumnsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionaryListResponseDictionariesItemColumnsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionaryListResponseDictionariesItemColumnsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionarySaveRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionarySaveRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("columns")
+		e.ArrStart()
+		for _, elem := range s.Columns {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("rows")
+		e.ArrStart()
+		for _, elem := range s.Rows {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfProjectDictionarySaveRequest = [3]string{
+	0: "name",
+	1: "columns",
+	2: "rows",
+}
+
+// Decode decodes ProjectDictionarySaveRequest from json.
+func (s *ProjectDictionarySaveRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionarySaveRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "columns":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Columns = make([]ProjectDictionarySaveRequestColumnsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectDictionarySaveRequestColumnsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Columns = append(s.Columns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"columns\"")
+			}
+		case "rows":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Rows = make([]ProjectDictionarySaveRequestRowsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectDictionarySaveRequestRowsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Rows = append(s.Rows, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rows\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionarySaveRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionarySaveRequest) {
+					name = jsonFieldsNameOfProjectDictionarySaveRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionarySaveRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionarySaveRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionarySaveRequestColumnsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionarySaveRequestColumnsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfProjectDictionarySaveRequestColumnsItem = [2]string{
+	0: "name",
+	1: "type",
+}
+
+// Decode decodes ProjectDictionarySaveRequestColumnsItem from json.
+func (s *ProjectDictionarySaveRequestColumnsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionarySaveRequestColumnsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionarySaveRequestColumnsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionarySaveRequestColumnsItem) {
+					name = jsonFieldsNameOfProjectDictionarySaveRequestColumnsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionarySaveRequestColumnsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionarySaveRequestColumnsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProjectDictionarySaveRequestColumnsItemType as json.
+func (s ProjectDictionarySaveRequestColumnsItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ProjectDictionarySaveRequestColumnsItemType from json.
+func (s *ProjectDictionarySaveRequestColumnsItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionarySaveRequestColumnsItemType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ProjectDictionarySaveRequestColumnsItemType(v) {
+	case ProjectDictionarySaveRequestColumnsItemTypeString:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeString
+	case ProjectDictionarySaveRequestColumnsItemTypeInteger:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeInteger
+	case ProjectDictionarySaveRequestColumnsItemTypeFloat:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeFloat
+	case ProjectDictionarySaveRequestColumnsItemTypeDecimal:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeDecimal
+	case ProjectDictionarySaveRequestColumnsItemTypeBoolean:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeBoolean
+	case ProjectDictionarySaveRequestColumnsItemTypeDate:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeDate
+	default:
+		*s = ProjectDictionarySaveRequestColumnsItemType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ProjectDictionarySaveRequestColumnsItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionarySaveRequestColumnsItemType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionarySaveRequestRowsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionarySaveRequestRowsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("key")
+		e.Str(s.Key)
+	}
+	{
+		e.FieldStart("values")
+		s.Values.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfProjectDictionarySaveRequestRowsItem = [2]string{
+	0: "key",
+	1: "values",
+}
+
+// Decode decodes ProjectDictionarySaveRequestRowsItem from json.
+func (s *ProjectDictionarySaveRequestRowsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionarySaveRequestRowsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "key":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Key = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"key\"")
+			}
+		case "values":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Values.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"values\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionarySaveRequestRowsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionarySaveRequestRowsItem) {
+					name = jsonFieldsNameOfProjectDictionarySaveRequestRowsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionarySaveRequestRowsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionarySaveRequestRowsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s ProjectDictionarySaveRequestRowsItemValues) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ProjectDictionarySaveRequestRowsItemValues) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
+	}
+}
+
+// Decode decodes ProjectDictionarySaveRequestRowsItemValues from json.
+func (s *ProjectDictionarySaveRequestRowsItemValues) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionarySaveRequestRowsItemValues to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionarySaveRequestRowsItemValues")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ProjectDictionarySaveRequestRowsItemValues) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionarySaveRequestRowsItemValues) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectDictionarySaveResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectDictionarySaveResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("number")
+		e.Int64(s.Number)
+	}
+}
+
+var jsonFieldsNameOfProjectDictionarySaveResponse = [2]string{
+	0: "id",
+	1: "number",
+}
+
+// Decode decodes ProjectDictionarySaveResponse from json.
+func (s *ProjectDictionarySaveResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectDictionarySaveResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "number":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.Number = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"number\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectDictionarySaveResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectDictionarySaveResponse) {
+					name = jsonFieldsNameOfProjectDictionarySaveResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectDictionarySaveResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectDictionarySaveResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectFunctionListResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectFunctionListResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("functions")
+		e.ArrStart()
+		for _, elem := range s.Functions {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfProjectFunctionListResponse = [1]string{
+	0: "functions",
+}
+
+// Decode decodes ProjectFunctionListResponse from json.
+func (s *ProjectFunctionListResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectFunctionListResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "functions":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Functions = make([]ProjectFunctionListResponseFunctionsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectFunctionListResponseFunctionsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Functions = append(s.Functions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"functions\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectFunctionListResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectFunctionListResponse) {
+					name = jsonFieldsNameOfProjectFunctionListResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectFunctionListResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectFunctionListResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectFunctionListResponseFunctionsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectFunctionListResponseFunctionsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("params")
+		e.ArrStart()
+		for _, elem := range s.Params {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("body")
+		e.Str(s.Body)
+	}
+	{
+		e.FieldStart("number")
+		e.Int64(s.Number)
+	}
+	{
+		e.FieldStart("updatedAt")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+}
+
+var jsonFieldsNameOfProjectFunctionListResponseFunctionsItem = [6]string{
+	0: "id",
+	1: "name",
+	2: "params",
+	3: "body",
+	4: "number",
+	5: "updatedAt",
+}
+
+// Decode decodes ProjectFunctionListResponseFunctionsItem from json.
+func (s *ProjectFunctionListResponseFunctionsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectFunctionListResponseFunctionsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "params":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Params = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Params = append(s.Params, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"params\"")
+			}
+		case "body":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Body = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"body\"")
+			}
+		case "number":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.Number = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"number\"")
+			}
+		case "updatedAt":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updatedAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectFunctionListResponseFunctionsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectFunctionListResponseFunctionsItem) {
+					name = jsonFieldsNameOfProjectFunctionListResponseFunctionsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectFunctionListResponseFunctionsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectFunctionListResponseFunctionsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectFunctionSaveRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectFunctionSaveRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("params")
+		e.ArrStart()
+		for _, elem := range s.Params {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("body")
+		e.Str(s.Body)
+	}
+}
+
+var jsonFieldsNameOfProjectFunctionSaveRequest = [3]string{
+	0: "name",
+	1: "params",
+	2: "body",
+}
+
+// Decode decodes ProjectFunctionSaveRequest from json.
+func (s *ProjectFunctionSaveRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectFunctionSaveRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "params":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Params = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Params = append(s.Params, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"params\"")
+			}
+		case "body":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Body = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"body\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectFunctionSaveRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectFunctionSaveRequest) {
+					name = jsonFieldsNameOfProjectFunctionSaveRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectFunctionSaveRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectFunctionSaveRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectFunctionSaveResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectFunctionSaveResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("number")
+		e.Int64(s.Number)
+	}
+}
+
+var jsonFieldsNameOfProjectFunctionSaveResponse = [2]string{
+	0: "id",
+	1: "number",
+}
+
+// Decode decodes ProjectFunctionSaveResponse from json.
+func (s *ProjectFunctionSaveResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectFunctionSaveResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "number":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.Number = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"number\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectFunctionSaveResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectFunctionSaveResponse) {
+					name = jsonFieldsNameOfProjectFunctionSaveResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectFunctionSaveResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectFunctionSaveResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectGetByIDResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectGetByIDResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("authorName")
+		e.Str(s.AuthorName)
+	}
+}
+
+var jsonFieldsNameOfProjectGetByIDResponse = [2]string{
+	0: "name",
+	1: "authorName",
+}
+
+// Decode decodes ProjectGetByIDResponse from json.
+func (s *ProjectGetByIDResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectGetByIDResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "authorName":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.AuthorName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"authorName\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectGetByIDResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectGetByIDResponse) {
+					name = jsonFieldsNameOfProjectGetByIDResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectGetByIDResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectGetByIDResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectListResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectListResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("projects")
+		e.ArrStart()
+		for _, elem := range s.Projects {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("totalProjects")
+		e.Int64(s.TotalProjects)
+	}
+	{
+		e.FieldStart("totalPages")
+		e.Int64(s.TotalPages)
+	}
+}
+
+var jsonFieldsNameOfProjectListResponse = [3]string{
+	0: "projects",
+	1: "totalProjects",
+	2: "totalPages",
+}
+
+// Decode decodes ProjectListResponse from json.
+func (s *ProjectListResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectListResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "projects":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Projects = make([]ProjectListResponseProjectsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectListResponseProjectsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Projects = append(s.Projects, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"projects\"")
+			}
+		case "totalProjects":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.TotalProjects = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"totalProjects\"")
+			}
+		case "totalPages":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.TotalPages = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"totalPages\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectListResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectListResponse) {
+					name = jsonFieldsNameOfProjectListResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectListResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectListResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectListResponseProjectsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectListResponseProjectsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("authorName")
+		e.Str(s.AuthorName)
+	}
+}
+
+var jsonFieldsNameOfProjectListResponseProjectsItem = [3]string{
+	0: "id",
+	1: "name",
+	2: "authorName",
+}
+
+// Decode decodes ProjectListResponseProjectsItem from json.
+func (s *ProjectListResponseProjectsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectListResponseProjectsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "authorName":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.AuthorName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"authorName\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectListResponseProjectsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectListResponseProjectsItem) {
+					name = jsonFieldsNameOfProjectListResponseProjectsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectListResponseProjectsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectListResponseProjectsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectPartialListResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectPartialListResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("partials")
+		e.ArrStart()
+		for _, elem := range s.Partials {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfProjectPartialListResponse = [1]string{
+	0: "partials",
+}
+
+// Decode decodes ProjectPartialListResponse from json.
+func (s *ProjectPartialListResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectPartialListResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "partials":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Partials = make([]ProjectPartialListResponsePartialsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectPartialListResponsePartialsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Partials = append(s.Partials, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"partials\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectPartialListResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectPartialListResponse) {
+					name = jsonFieldsNameOfProjectPartialListResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectPartialListResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectPartialListResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectPartialListResponsePartialsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectPartialListResponsePartialsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("data")
+		e.Str(s.Data)
+	}
+	{
+		e.FieldStart("number")
+		e.Int64(s.Number)
+	}
+	{
+		e.FieldStart("updatedAt")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+}
+
+var jsonFieldsNameOfProjectPartialListResponsePartialsItem = [5]string{
+	0: "id",
+	1: "name",
+	2: "data",
+	3: "number",
+	4: "updatedAt",
+}
+
+// Decode decodes ProjectPartialListResponsePartialsItem from json.
+func (s *ProjectPartialListResponsePartialsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectPartialListResponsePartialsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "data":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Data = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		case "number":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.Number = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"number\"")
+			}
+		case "updatedAt":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updatedAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectPartialListResponsePartialsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectPartialListResponsePartialsItem) {
+					name = jsonFieldsNameOfProjectPartialListResponsePartialsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectPartialListResponsePartialsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectPartialListResponsePartialsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectPartialSaveRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectPartialSaveRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("data")
+		e.Str(s.Data)
+	}
+}
+
+var jsonFieldsNameOfProjectPartialSaveRequest = [2]string{
+	0: "name",
+	1: "data",
+}
+
+// Decode decodes ProjectPartialSaveRequest from json.
+func (s *ProjectPartialSaveRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectPartialSaveRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "data":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Data = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectPartialSaveRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectPartialSaveRequest) {
+					name = jsonFieldsNameOfProjectPartialSaveRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectPartialSaveRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectPartialSaveRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectPartialSaveResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectPartialSaveResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("number")
+		e.Int64(s.Number)
+	}
+}
+
+var jsonFieldsNameOfProjectPartialSaveResponse = [2]string{
+	0: "id",
+	1: "number",
+}
+
+// Decode decodes ProjectPartialSaveResponse from json.
+func (s *ProjectPartialSaveResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectPartialSaveResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "number":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.Number = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"number\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectPartialSaveResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectPartialSaveResponse) {
+					name = jsonFieldsNameOfProjectPartialSaveResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectPartialSaveResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectPartialSaveResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectUpdateRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectUpdateRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+}
+
+var jsonFieldsNameOfProjectUpdateRequest = [1]string{
+	0: "name",
+}
+
+// Decode decodes ProjectUpdateRequest from json.
+func (s *ProjectUpdateRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectUpdateRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectUpdateRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectUpdateRequest) {
+					name = jsonFieldsNameOfProjectUpdateRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectUpdateRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectUpdateRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectUpdateUsersRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectUpdateUsersRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("users")
+		e.ArrStart()
+		for _, elem := range s.Users {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfProjectUpdateUsersRequest = [1]string{
+	0: "users",
+}
+
+// Decode decodes ProjectUpdateUsersRequest from json.
+func (s *ProjectUpdateUsersRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectUpdateUsersRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "users":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Users = make([]ProjectUpdateUsersRequestUsersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectUpdateUsersRequestUsersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Users = append(s.Users, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"users\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectUpdateUsersRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectUpdateUsersRequest) {
+					name = jsonFieldsNameOfProjectUpdateUsersRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectUpdateUsersRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectUpdateUsersRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectUpdateUsersRequestUsersItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectUpdateUsersRequestUsersItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("role")
+		s.Role.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfProjectUpdateUsersRequestUsersItem = [2]string{
+	0: "id",
+	1: "role",
+}
+
+// Decode decodes ProjectUpdateUsersRequestUsersItem from json.
+func (s *ProjectUpdateUsersRequestUsersItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectUpdateUsersRequestUsersItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "role":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectUpdateUsersRequestUsersItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectUpdateUsersRequestUsersItem) {
+					name = jsonFieldsNameOfProjectUpdateUsersRequestUsersItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectUpdateUsersRequestUsersItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectUpdateUsersRequestUsersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProjectUpdateUsersRequestUsersItemRole as json.
+func (s ProjectUpdateUsersRequestUsersItemRole) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ProjectUpdateUsersRequestUsersItemRole from json.
+func (s *ProjectUpdateUsersRequestUsersItemRole) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectUpdateUsersRequestUsersItemRole to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ProjectUpdateUsersRequestUsersItemRole(v) {
+	case ProjectUpdateUsersRequestUsersItemRoleRead:
+		*s = ProjectUpdateUsersRequestUsersItemRoleRead
+	case ProjectUpdateUsersRequestUsersItemRoleWrite:
+		*s = ProjectUpdateUsersRequestUsersItemRoleWrite
+	case ProjectUpdateUsersRequestUsersItemRoleMaintain:
+		*s = ProjectUpdateUsersRequestUsersItemRoleMaintain
+	default:
+		*s = ProjectUpdateUsersRequestUsersItemRole(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ProjectUpdateUsersRequestUsersItemRole) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectUpdateUsersRequestUsersItemRole) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectUsersResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectUsersResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("users")
+		e.ArrStart()
+		for _, elem := range s.Users {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfProjectUsersResponse = [1]string{
+	0: "users",
+}
+
+// Decode decodes ProjectUsersResponse from json.
+func (s *ProjectUsersResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectUsersResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "users":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Users = make([]ProjectUsersResponseUsersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectUsersResponseUsersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Users = append(s.Users, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"users\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectUsersResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectUsersResponse) {
+					name = jsonFieldsNameOfProjectUsersResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectUsersResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectUsersResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectUsersResponseUsersItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectUsersResponseUsersItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("role")
+		s.Role.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfProjectUsersResponseUsersItem = [4]string{
+	0: "id",
+	1: "name",
+	2: "email",
+	3: "role",
+}
+
+// Decode decodes ProjectUsersResponseUsersItem from json.
+func (s *ProjectUsersResponseUsersItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectUsersResponseUsersItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "email":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "role":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectUsersResponseUsersItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectUsersResponseUsersItem) {
+					name = jsonFieldsNameOfProjectUsersResponseUsersItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectUsersResponseUsersItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectUsersResponseUsersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProjectUsersResponseUsersItemRole as json.
+func (s ProjectUsersResponseUsersItemRole) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ProjectUsersResponseUsersItemRole from json.
+func (s *ProjectUsersResponseUsersItemRole) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectUsersResponseUsersItemRole to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ProjectUsersResponseUsersItemRole(v) {
+	case ProjectUsersResponseUsersItemRoleRead:
+		*s = ProjectUsersResponseUsersItemRoleRead
+	case ProjectUsersResponseUsersItemRoleWrite:
+		*s = ProjectUsersResponseUsersItemRoleWrite
+	case ProjectUsersResponseUsersItemRoleMaintain:
+		*s = ProjectUsersResponseUsersItemRoleMaintain
+	default:
+		*s = ProjectUsersResponseUsersItemRole(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ProjectUsersResponseUsersItemRole) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectUsersResponseUsersItemRole) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TaskCreateRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TaskCreateRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("versionID")
+		e.Int64(s.VersionID)
+	}
+	{
+		e.FieldStart("payload")
+		s.Payload.Encode(e)
+	}
+	{
+		if s.Trace.Set {
+			e.FieldStart("trace")
+			s.Trace.Encode(e)
+		}
+	}
+	{
+		if s.OutputFormat.Set {
+			e.FieldStart("outputFormat")
+			s.OutputFormat.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfTaskCreateRequest = [4]string{
+	0: "versionID",
+	1: "payload",
+	2: "trace",
+	3: "outputFormat",
+}
+
+// Decode decodes TaskCreateRequest from json.
+func (s *TaskCreateRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TaskCreateRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "versionID":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.VersionID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"versionID\"")
+			}
+		case "payload":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Payload.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"payload\"")
+			}
+		case "trace":
+			if err := func() error {
+				s.Trace.Reset()
+				if err := s.Trace.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"trace\"")
+			}
+		case "outputFormat":
+			if err := func() error {
+				s.OutputFormat.Reset()
+				if err := s.OutputFormat.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"outputFormat\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TaskCreateRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTaskCreateRequest) {
+					name = jsonFieldsNameOfTaskCreateRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TaskCreateRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TaskCreateRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s TaskCreateRequestPayload) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s TaskCreateRequestPayload) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes TaskCreateRequestPayload from json.
+func (s *TaskCreateRequestPayload) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TaskCreateRequestPayload to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TaskCreateRequestPayload")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TaskCreateRequestPayload) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TaskCreateRequestPayload) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TaskGetByIDResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TaskGetByIDResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("task")
+		s.Task.Encode(e)
+	}
+	{
+		e.FieldStart("result")
+		e.Base64(s.Result)
+	}
+	{
+		if s.ResultFormat.Set {
+			e.FieldStart("resultFormat")
+			s.ResultFormat.Encode(e)
+		}
+	}
+	{
+		if s.ResultContentType.Set {
+			e.FieldStart("resultContentType")
+			s.ResultContentType.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfTaskGetByIDResponse = [4]string{
+	0: "task",
+	1: "result",
+	2: "resultFormat",
+	3: "resultContentType",
+}
+
+// Decode decodes TaskGetByIDResponse from json.
+func (s *TaskGetByIDResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TaskGetByIDResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "task":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Task.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"task\"")
+			}
+		case "result":
+			if err := func() error {
+				v, err := d.Base64()
+				s.Result = []byte(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"result\"")
+			}
+		case "resultFormat":
+			if err := func() error {
+				s.ResultFormat.Reset()
+				if err := s.ResultFormat.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resultFormat\"")
+			}
+		case "resultContentType":
+			if err := func() error {
+				s.ResultContentType.Reset()
+				if err := s.ResultContentType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resultContentType\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TaskGetByIDResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTaskGetByIDResponse) {
+					name = jsonFieldsNameOfTaskGetByIDResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TaskGetByIDResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TaskGetByIDResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TaskGetByIDResponseTask) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TaskGetByIDResponseTask) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("versionID")
+		e.Int64(s.VersionID)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		e.FieldStart("payload")
+		s.Payload.Encode(e)
+	}
+	{
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
+		}
+	}
+	{
+		if s.Trace != nil {
+			e.FieldStart("trace")
+			s.Trace.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("creatorName")
+		e.Str(s.CreatorName)
+	}
+	{
+		e.FieldStart("clock")
+		json.EncodeDateTime(e, s.Clock)
+	}
+	{
+		if s.Constants.Set {
+			e.FieldStart("constants")
+			s.Constants.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updatedAt")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfTaskGetByIDResponseTask = [11]string{
+	0:  "id",
+	1:  "versionID",
+	2:  "status",
+	3:  "payload",
+	4:  "error",
+	5:  "trace",
+	6:  "creatorName",
+	7:  "clock",
+	8:  "constants",
+	9:  "createdAt",
+	10: "updatedAt",
+}
+
+// Decode decodes TaskGetByIDResponseTask from json.
+func (s *TaskGetByIDResponseTask) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TaskGetByIDResponseTask to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "versionID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.VersionID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"versionID\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "payload":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Payload.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"payload\"")
+			}
+		case "error":
+			if err := func() error {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		case "trace":
+			if err := func() error {
+				if err := s.Trace.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"trace\"")
+			}
+		case "creatorName":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Str()
+				s.CreatorName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"creatorName\"")
+			}
+		case "clock":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.Clock = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"clock\"")
+			}
+		case "constants":
+			if err := func() error {
+				s.Constants.Reset()
+				if err := s.Constants.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"constants\"")
+			}
+		case "createdAt":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "updatedAt":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updatedAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TaskGetByIDResponseTask")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11001111,
+		0b00000010,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTaskGetByIDResponseTask) {
+					name = jsonFieldsNameOfTaskGetByIDResponseTask[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
 
-// Decode decodes ProjectCreateRequest from json.
-func (s *ProjectCreateRequest) Decode(d *jx.Decoder) error {
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TaskGetByIDResponseTask) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TaskGetByIDResponseTask) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s TaskGetByIDResponseTaskConstants) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s TaskGetByIDResponseTaskConstants) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
+	}
+}
+
+// Decode decodes TaskGetByIDResponseTaskConstants from json.
+func (s *TaskGetByIDResponseTaskConstants) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectCreateRequest to nil")
+		return errors.New("invalid: unable to decode TaskGetByIDResponseTaskConstants to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TaskGetByIDResponseTaskConstants")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TaskGetByIDResponseTaskConstants) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TaskGetByIDResponseTaskConstants) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s TaskGetByIDResponseTaskPayload) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s TaskGetByIDResponseTaskPayload) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes TaskGetByIDResponseTaskPayload from json.
+func (s *TaskGetByIDResponseTaskPayload) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TaskGetByIDResponseTaskPayload to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TaskGetByIDResponseTaskPayload")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TaskGetByIDResponseTaskPayload) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TaskGetByIDResponseTaskPayload) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TaskListResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TaskListResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("tasks")
+		e.ArrStart()
+		for _, elem := range s.Tasks {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("totalTasks")
+		e.Int64(s.TotalTasks)
+	}
+	{
+		e.FieldStart("totalPages")
+		e.Int64(s.TotalPages)
+	}
+}
+
+var jsonFieldsNameOfTaskListResponse = [3]string{
+	0: "tasks",
+	1: "totalTasks",
+	2: "totalPages",
+}
+
+// Decode decodes TaskListResponse from json.
+func (s *TaskListResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TaskListResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "tasks":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
+				s.Tasks = make([]TaskListResponseTasksItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TaskListResponseTasksItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Tasks = append(s.Tasks, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tasks\"")
+			}
+		case "totalTasks":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.TotalTasks = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"totalTasks\"")
+			}
+		case "totalPages":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.TotalPages = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"totalPages\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ProjectCreateRequest")
+		return errors.Wrap(err, "decode TaskListResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -408,8 +6747,8 @@ func (s *ProjectCreateRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectCreateRequest) {
-					name = jsonFieldsNameOfProjectCreateRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTaskListResponse) {
+					name = jsonFieldsNameOfTaskListResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -430,86 +6769,152 @@ func (s *ProjectCreateRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectCreateRequest) MarshalJSON() ([]byte, error) {
+func (s *TaskListResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectCreateRequest) UnmarshalJSON(data []byte) error {
+func (s *TaskListResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ProjectGetByIDResponse) Encode(e *jx.Encoder) {
+func (s *TaskListResponseTasksItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ProjectGetByIDResponse) encodeFields(e *jx.Encoder) {
+func (s *TaskListResponseTasksItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("versionNumber")
+		e.Int64(s.VersionNumber)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		e.FieldStart("creatorName")
+		e.Str(s.CreatorName)
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 	{
-		e.FieldStart("authorName")
-		e.Str(s.AuthorName)
+		if s.UpdatedAt.Set {
+			e.FieldStart("updatedAt")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
 }
 
-var jsonFieldsNameOfProjectGetByIDResponse = [2]string{
-	0: "name",
-	1: "authorName",
+var jsonFieldsNameOfTaskListResponseTasksItem = [6]string{
+	0: "id",
+	1: "versionNumber",
+	2: "status",
+	3: "creatorName",
+	4: "createdAt",
+	5: "updatedAt",
 }
 
-// Decode decodes ProjectGetByIDResponse from json.
-func (s *ProjectGetByIDResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TaskListResponseTasksItem from json.
+func (s *TaskListResponseTasksItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectGetByIDResponse to nil")
+		return errors.New("invalid: unable to decode TaskListResponseTasksItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
+				v, err := d.Int64()
+				s.ID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "authorName":
+		case "versionNumber":
 			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.VersionNumber = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"versionNumber\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "creatorName":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
 				v, err := d.Str()
-				s.AuthorName = string(v)
+				s.CreatorName = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"authorName\"")
+				return errors.Wrap(err, "decode field \"creatorName\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "updatedAt":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updatedAt\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ProjectGetByIDResponse")
+		return errors.Wrap(err, "decode TaskListResponseTasksItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -521,8 +6926,8 @@ func (s *ProjectGetByIDResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectGetByIDResponse) {
-					name = jsonFieldsNameOfProjectGetByIDResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTaskListResponseTasksItem) {
+					name = jsonFieldsNameOfTaskListResponseTasksItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -543,108 +6948,142 @@ func (s *ProjectGetByIDResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectGetByIDResponse) MarshalJSON() ([]byte, error) {
+func (s *TaskListResponseTasksItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectGetByIDResponse) UnmarshalJSON(data []byte) error {
+func (s *TaskListResponseTasksItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TaskStatus as json.
+func (s TaskStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes TaskStatus from json.
+func (s *TaskStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TaskStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch TaskStatus(v) {
+	case TaskStatusCreated:
+		*s = TaskStatusCreated
+	case TaskStatusInProgress:
+		*s = TaskStatusInProgress
+	case TaskStatusSucceed:
+		*s = TaskStatusSucceed
+	case TaskStatusFailed:
+		*s = TaskStatusFailed
+	default:
+		*s = TaskStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TaskStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TaskStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ProjectListResponse) Encode(e *jx.Encoder) {
+func (s *TemplateCreateFromDefaultRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ProjectListResponse) encodeFields(e *jx.Encoder) {
+func (s *TemplateCreateFromDefaultRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("projects")
-		e.ArrStart()
-		for _, elem := range s.Projects {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("sourceTemplateID")
+		e.Int64(s.SourceTemplateID)
 	}
 	{
-		e.FieldStart("totalProjects")
-		e.Int64(s.TotalProjects)
+		e.FieldStart("projectID")
+		e.Int64(s.ProjectID)
 	}
 	{
-		e.FieldStart("totalPages")
-		e.Int64(s.TotalPages)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 }
 
-var jsonFieldsNameOfProjectListResponse = [3]string{
-	0: "projects",
-	1: "totalProjects",
-	2: "totalPages",
+var jsonFieldsNameOfTemplateCreateFromDefaultRequest = [3]string{
+	0: "sourceTemplateID",
+	1: "projectID",
+	2: "name",
 }
 
-// Decode decodes ProjectListResponse from json.
-func (s *ProjectListResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateCreateFromDefaultRequest from json.
+func (s *TemplateCreateFromDefaultRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectListResponse to nil")
+		return errors.New("invalid: unable to decode TemplateCreateFromDefaultRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "projects":
+		case "sourceTemplateID":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Projects = make([]ProjectListResponseProjectsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem ProjectListResponseProjectsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Projects = append(s.Projects, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Int64()
+				s.SourceTemplateID = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"projects\"")
+				return errors.Wrap(err, "decode field \"sourceTemplateID\"")
 			}
-		case "totalProjects":
+		case "projectID":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Int64()
-				s.TotalProjects = int64(v)
+				s.ProjectID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"totalProjects\"")
+				return errors.Wrap(err, "decode field \"projectID\"")
 			}
-		case "totalPages":
+		case "name":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Int64()
-				s.TotalPages = int64(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"totalPages\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ProjectListResponse")
+		return errors.Wrap(err, "decode TemplateCreateFromDefaultRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -661,8 +7100,8 @@ func (s *ProjectListResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectListResponse) {
-					name = jsonFieldsNameOfProjectListResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateCreateFromDefaultRequest) {
+					name = jsonFieldsNameOfTemplateCreateFromDefaultRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -683,51 +7122,41 @@ func (s *ProjectListResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectListResponse) MarshalJSON() ([]byte, error) {
+func (s *TemplateCreateFromDefaultRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectListResponse) UnmarshalJSON(data []byte) error {
+func (s *TemplateCreateFromDefaultRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ProjectListResponseProjectsItem) Encode(e *jx.Encoder) {
+func (s *TemplateCreateFromDefaultResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ProjectListResponseProjectsItem) encodeFields(e *jx.Encoder) {
+func (s *TemplateCreateFromDefaultResponse) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("id")
 		e.Int64(s.ID)
 	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		e.FieldStart("authorName")
-		e.Str(s.AuthorName)
-	}
 }
 
-var jsonFieldsNameOfProjectListResponseProjectsItem = [3]string{
+var jsonFieldsNameOfTemplateCreateFromDefaultResponse = [1]string{
 	0: "id",
-	1: "name",
-	2: "authorName",
 }
 
-// Decode decodes ProjectListResponseProjectsItem from json.
-func (s *ProjectListResponseProjectsItem) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateCreateFromDefaultResponse from json.
+func (s *TemplateCreateFromDefaultResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectListResponseProjectsItem to nil")
+		return errors.New("invalid: unable to decode TemplateCreateFromDefaultResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -745,41 +7174,17 @@ func (s *ProjectListResponseProjectsItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "authorName":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.AuthorName = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"authorName\"")
-			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ProjectListResponseProjectsItem")
+		return errors.Wrap(err, "decode TemplateCreateFromDefaultResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -791,8 +7196,8 @@ func (s *ProjectListResponseProjectsItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectListResponseProjectsItem) {
-					name = jsonFieldsNameOfProjectListResponseProjectsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateCreateFromDefaultResponse) {
+					name = jsonFieldsNameOfTemplateCreateFromDefaultResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -813,41 +7218,46 @@ func (s *ProjectListResponseProjectsItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectListResponseProjectsItem) MarshalJSON() ([]byte, error) {
+func (s *TemplateCreateFromDefaultResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectListResponseProjectsItem) UnmarshalJSON(data []byte) error {
+func (s *TemplateCreateFromDefaultResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ProjectUpdateRequest) Encode(e *jx.Encoder) {
+func (s *TemplateCreateRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ProjectUpdateRequest) encodeFields(e *jx.Encoder) {
+func (s *TemplateCreateRequest) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
+	{
+		e.FieldStart("projectID")
+		e.Int64(s.ProjectID)
+	}
 }
 
-var jsonFieldsNameOfProjectUpdateRequest = [1]string{
+var jsonFieldsNameOfTemplateCreateRequest = [2]string{
 	0: "name",
+	1: "projectID",
 }
 
-// Decode decodes ProjectUpdateRequest from json.
-func (s *ProjectUpdateRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateCreateRequest from json.
+func (s *TemplateCreateRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectUpdateRequest to nil")
+		return errors.New("invalid: unable to decode TemplateCreateRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -865,17 +7275,29 @@ func (s *ProjectUpdateRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
+		case "projectID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.ProjectID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"projectID\"")
+			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ProjectUpdateRequest")
+		return errors.Wrap(err, "decode TemplateCreateRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -887,8 +7309,8 @@ func (s *ProjectUpdateRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectUpdateRequest) {
-					name = jsonFieldsNameOfProjectUpdateRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateCreateRequest) {
+					name = jsonFieldsNameOfTemplateCreateRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -909,79 +7331,113 @@ func (s *ProjectUpdateRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectUpdateRequest) MarshalJSON() ([]byte, error) {
+func (s *TemplateCreateRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectUpdateRequest) UnmarshalJSON(data []byte) error {
+func (s *TemplateCreateRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ProjectUpdateUsersRequest) Encode(e *jx.Encoder) {
+func (s *TemplateDefaultListResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ProjectUpdateUsersRequest) encodeFields(e *jx.Encoder) {
+func (s *TemplateDefaultListResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("users")
+		e.FieldStart("templates")
 		e.ArrStart()
-		for _, elem := range s.Users {
+		for _, elem := range s.Templates {
 			elem.Encode(e)
 		}
 		e.ArrEnd()
 	}
+	{
+		e.FieldStart("totalTemplates")
+		e.Int64(s.TotalTemplates)
+	}
+	{
+		e.FieldStart("totalPages")
+		e.Int64(s.TotalPages)
+	}
 }
 
-var jsonFieldsNameOfProjectUpdateUsersRequest = [1]string{
-	0: "users",
+var jsonFieldsNameOfTemplateDefaultListResponse = [3]string{
+	0: "templates",
+	1: "totalTemplates",
+	2: "totalPages",
 }
 
-// Decode decodes ProjectUpdateUsersRequest from json.
-func (s *ProjectUpdateUsersRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateDefaultListResponse from json.
+func (s *TemplateDefaultListResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectUpdateUsersRequest to nil")
+		return errors.New("invalid: unable to decode TemplateDefaultListResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "users":
+		case "templates":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Users = make([]ProjectUpdateUsersRequestUsersItem, 0)
+				s.Templates = make([]TemplateDefaultListResponseTemplatesItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem ProjectUpdateUsersRequestUsersItem
+					var elem TemplateDefaultListResponseTemplatesItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Users = append(s.Users, elem)
+					s.Templates = append(s.Templates, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"users\"")
+				return errors.Wrap(err, "decode field \"templates\"")
+			}
+		case "totalTemplates":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.TotalTemplates = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"totalTemplates\"")
+			}
+		case "totalPages":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.TotalPages = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"totalPages\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ProjectUpdateUsersRequest")
+		return errors.Wrap(err, "decode TemplateDefaultListResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -993,8 +7449,8 @@ func (s *ProjectUpdateUsersRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectUpdateUsersRequest) {
-					name = jsonFieldsNameOfProjectUpdateUsersRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateDefaultListResponse) {
+					name = jsonFieldsNameOfTemplateDefaultListResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1015,46 +7471,58 @@ func (s *ProjectUpdateUsersRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectUpdateUsersRequest) MarshalJSON() ([]byte, error) {
+func (s *TemplateDefaultListResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectUpdateUsersRequest) UnmarshalJSON(data []byte) error {
+func (s *TemplateDefaultListResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ProjectUpdateUsersRequestUsersItem) Encode(e *jx.Encoder) {
+func (s *TemplateDefaultListResponseTemplatesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ProjectUpdateUsersRequestUsersItem) encodeFields(e *jx.Encoder) {
+func (s *TemplateDefaultListResponseTemplatesItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("id")
 		e.Int64(s.ID)
 	}
 	{
-		e.FieldStart("role")
-		s.Role.Encode(e)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updatedAt")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
 }
 
-var jsonFieldsNameOfProjectUpdateUsersRequestUsersItem = [2]string{
+var jsonFieldsNameOfTemplateDefaultListResponseTemplatesItem = [4]string{
 	0: "id",
-	1: "role",
+	1: "name",
+	2: "createdAt",
+	3: "updatedAt",
 }
 
-// Decode decodes ProjectUpdateUsersRequestUsersItem from json.
-func (s *ProjectUpdateUsersRequestUsersItem) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateDefaultListResponseTemplatesItem from json.
+func (s *TemplateDefaultListResponseTemplatesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectUpdateUsersRequestUsersItem to nil")
+		return errors.New("invalid: unable to decode TemplateDefaultListResponseTemplatesItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -1072,27 +7540,51 @@ func (s *ProjectUpdateUsersRequestUsersItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "role":
+		case "name":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Role.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"role\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "updatedAt":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updatedAt\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ProjectUpdateUsersRequestUsersItem")
+		return errors.Wrap(err, "decode TemplateDefaultListResponseTemplatesItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1104,8 +7596,8 @@ func (s *ProjectUpdateUsersRequestUsersItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectUpdateUsersRequestUsersItem) {
-					name = jsonFieldsNameOfProjectUpdateUsersRequestUsersItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateDefaultListResponseTemplatesItem) {
+					name = jsonFieldsNameOfTemplateDefaultListResponseTemplatesItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1126,121 +7618,118 @@ func (s *ProjectUpdateUsersRequestUsersItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectUpdateUsersRequestUsersItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectUpdateUsersRequestUsersItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ProjectUpdateUsersRequestUsersItemRole as json.
-func (s ProjectUpdateUsersRequestUsersItemRole) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes ProjectUpdateUsersRequestUsersItemRole from json.
-func (s *ProjectUpdateUsersRequestUsersItemRole) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ProjectUpdateUsersRequestUsersItemRole to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch ProjectUpdateUsersRequestUsersItemRole(v) {
-	case ProjectUpdateUsersRequestUsersItemRoleRead:
-		*s = ProjectUpdateUsersRequestUsersItemRoleRead
-	case ProjectUpdateUsersRequestUsersItemRoleWrite:
-		*s = ProjectUpdateUsersRequestUsersItemRoleWrite
-	case ProjectUpdateUsersRequestUsersItemRoleMaintain:
-		*s = ProjectUpdateUsersRequestUsersItemRoleMaintain
-	default:
-		*s = ProjectUpdateUsersRequestUsersItemRole(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ProjectUpdateUsersRequestUsersItemRole) MarshalJSON() ([]byte, error) {
+func (s *TemplateDefaultListResponseTemplatesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectUpdateUsersRequestUsersItemRole) UnmarshalJSON(data []byte) error {
+func (s *TemplateDefaultListResponseTemplatesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ProjectUsersResponse) Encode(e *jx.Encoder) {
+func (s *TemplateGetByIDResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ProjectUsersResponse) encodeFields(e *jx.Encoder) {
+func (s *TemplateGetByIDResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("users")
-		e.ArrStart()
-		for _, elem := range s.Users {
-			elem.Encode(e)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("outputFormat")
+		s.OutputFormat.Encode(e)
+	}
+	{
+		if s.StyleProfile.Set {
+			e.FieldStart("styleProfile")
+			s.StyleProfile.Encode(e)
+		}
+	}
+	{
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfProjectUsersResponse = [1]string{
-	0: "users",
+var jsonFieldsNameOfTemplateGetByIDResponse = [4]string{
+	0: "name",
+	1: "outputFormat",
+	2: "styleProfile",
+	3: "version",
 }
 
-// Decode decodes ProjectUsersResponse from json.
-func (s *ProjectUsersResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateGetByIDResponse from json.
+func (s *TemplateGetByIDResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectUsersResponse to nil")
+		return errors.New("invalid: unable to decode TemplateGetByIDResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "users":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Users = make([]ProjectUsersResponseUsersItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem ProjectUsersResponseUsersItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Users = append(s.Users, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"users\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "outputFormat":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.OutputFormat.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"outputFormat\"")
+			}
+		case "styleProfile":
+			if err := func() error {
+				s.StyleProfile.Reset()
+				if err := s.StyleProfile.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"styleProfile\"")
+			}
+		case "version":
+			if err := func() error {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ProjectUsersResponse")
+		return errors.Wrap(err, "decode TemplateGetByIDResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1252,8 +7741,8 @@ func (s *ProjectUsersResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectUsersResponse) {
-					name = jsonFieldsNameOfProjectUsersResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateGetByIDResponse) {
+					name = jsonFieldsNameOfTemplateGetByIDResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1274,56 +7763,70 @@ func (s *ProjectUsersResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectUsersResponse) MarshalJSON() ([]byte, error) {
+func (s *TemplateGetByIDResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectUsersResponse) UnmarshalJSON(data []byte) error {
+func (s *TemplateGetByIDResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ProjectUsersResponseUsersItem) Encode(e *jx.Encoder) {
+func (s *TemplateGetByIDVersion) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ProjectUsersResponseUsersItem) encodeFields(e *jx.Encoder) {
+func (s *TemplateGetByIDVersion) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("id")
 		e.Int64(s.ID)
 	}
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("number")
+		e.Int64(s.Number)
 	}
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 	{
-		e.FieldStart("role")
-		s.Role.Encode(e)
+		e.FieldStart("data")
+		e.Base64(s.Data)
+	}
+	{
+		e.FieldStart("kind")
+		s.Kind.Encode(e)
+	}
+	{
+		e.FieldStart("variables")
+		e.ArrStart()
+		for _, elem := range s.Variables {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfProjectUsersResponseUsersItem = [4]string{
+var jsonFieldsNameOfTemplateGetByIDVersion = [6]string{
 	0: "id",
-	1: "name",
-	2: "email",
-	3: "role",
+	1: "number",
+	2: "createdAt",
+	3: "data",
+	4: "kind",
+	5: "variables",
 }
 
-// Decode decodes ProjectUsersResponseUsersItem from json.
-func (s *ProjectUsersResponseUsersItem) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateGetByIDVersion from json.
+func (s *TemplateGetByIDVersion) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectUsersResponseUsersItem to nil")
+		return errors.New("invalid: unable to decode TemplateGetByIDVersion to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -1341,51 +7844,81 @@ func (s *ProjectUsersResponseUsersItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "name":
+		case "number":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
+				v, err := d.Int64()
+				s.Number = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"number\"")
 			}
-		case "email":
+		case "createdAt":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.Email = string(v)
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"createdAt\"")
 			}
-		case "role":
+		case "data":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				if err := s.Role.Decode(d); err != nil {
+				v, err := d.Base64()
+				s.Data = []byte(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"role\"")
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		case "kind":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Kind.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kind\"")
+			}
+		case "variables":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				s.Variables = make([]TemplateGetByIDVersionVariablesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TemplateGetByIDVersionVariablesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Variables = append(s.Variables, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"variables\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ProjectUsersResponseUsersItem")
+		return errors.Wrap(err, "decode TemplateGetByIDVersion")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1397,8 +7930,8 @@ func (s *ProjectUsersResponseUsersItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectUsersResponseUsersItem) {
-					name = jsonFieldsNameOfProjectUsersResponseUsersItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateGetByIDVersion) {
+					name = jsonFieldsNameOfTemplateGetByIDVersion[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1419,126 +7952,363 @@ func (s *ProjectUsersResponseUsersItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectUsersResponseUsersItem) MarshalJSON() ([]byte, error) {
+func (s *TemplateGetByIDVersion) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectUsersResponseUsersItem) UnmarshalJSON(data []byte) error {
+func (s *TemplateGetByIDVersion) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ProjectUsersResponseUsersItemRole as json.
-func (s ProjectUsersResponseUsersItemRole) Encode(e *jx.Encoder) {
+// Encode encodes TemplateGetByIDVersionKind as json.
+func (s TemplateGetByIDVersionKind) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes ProjectUsersResponseUsersItemRole from json.
-func (s *ProjectUsersResponseUsersItemRole) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateGetByIDVersionKind from json.
+func (s *TemplateGetByIDVersionKind) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectUsersResponseUsersItemRole to nil")
+		return errors.New("invalid: unable to decode TemplateGetByIDVersionKind to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch ProjectUsersResponseUsersItemRole(v) {
-	case ProjectUsersResponseUsersItemRoleRead:
-		*s = ProjectUsersResponseUsersItemRoleRead
-	case ProjectUsersResponseUsersItemRoleWrite:
-		*s = ProjectUsersResponseUsersItemRoleWrite
-	case ProjectUsersResponseUsersItemRoleMaintain:
-		*s = ProjectUsersResponseUsersItemRoleMaintain
+	switch TemplateGetByIDVersionKind(v) {
+	case TemplateGetByIDVersionKindMd:
+		*s = TemplateGetByIDVersionKindMd
+	case TemplateGetByIDVersionKindDocx:
+		*s = TemplateGetByIDVersionKindDocx
 	default:
-		*s = ProjectUsersResponseUsersItemRole(v)
+		*s = TemplateGetByIDVersionKind(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ProjectUsersResponseUsersItemRole) MarshalJSON() ([]byte, error) {
+func (s TemplateGetByIDVersionKind) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectUsersResponseUsersItemRole) UnmarshalJSON(data []byte) error {
+func (s *TemplateGetByIDVersionKind) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TaskCreateRequest) Encode(e *jx.Encoder) {
+func (s *TemplateGetByIDVersionVariablesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TaskCreateRequest) encodeFields(e *jx.Encoder) {
+func (s *TemplateGetByIDVersionVariablesItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("versionID")
-		e.Int64(s.VersionID)
+		e.FieldStart("id")
+		e.Int64(s.ID)
 	}
 	{
-		e.FieldStart("payload")
-		s.Payload.Encode(e)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("title")
+		e.Str(s.Title)
+	}
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		if s.Expression.Set {
+			e.FieldStart("expression")
+			s.Expression.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("isInput")
+		e.Bool(s.IsInput)
+	}
+	{
+		if s.DefaultExpression.Set {
+			e.FieldStart("defaultExpression")
+			s.DefaultExpression.Encode(e)
+		}
+	}
+	{
+		if s.EnabledIf.Set {
+			e.FieldStart("enabledIf")
+			s.EnabledIf.Encode(e)
+		}
+	}
+	{
+		if s.Unit.Set {
+			e.FieldStart("unit")
+			s.Unit.Encode(e)
+		}
+	}
+	{
+		if s.Options != nil {
+			e.FieldStart("options")
+			e.ArrStart()
+			for _, elem := range s.Options {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.OptionsFrom.Set {
+			e.FieldStart("optionsFrom")
+			s.OptionsFrom.Encode(e)
+		}
+	}
+	{
+		if s.ItemType.Set {
+			e.FieldStart("itemType")
+			s.ItemType.Encode(e)
+		}
+	}
+	{
+		if s.Columns != nil {
+			e.FieldStart("columns")
+			e.ArrStart()
+			for _, elem := range s.Columns {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		e.FieldStart("constraints")
+		e.ArrStart()
+		for _, elem := range s.Constraints {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTaskCreateRequest = [2]string{
-	0: "versionID",
-	1: "payload",
+var jsonFieldsNameOfTemplateGetByIDVersionVariablesItem = [14]string{
+	0:  "id",
+	1:  "name",
+	2:  "title",
+	3:  "type",
+	4:  "expression",
+	5:  "isInput",
+	6:  "defaultExpression",
+	7:  "enabledIf",
+	8:  "unit",
+	9:  "options",
+	10: "optionsFrom",
+	11: "itemType",
+	12: "columns",
+	13: "constraints",
 }
 
-// Decode decodes TaskCreateRequest from json.
-func (s *TaskCreateRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateGetByIDVersionVariablesItem from json.
+func (s *TemplateGetByIDVersionVariablesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TaskCreateRequest to nil")
+		return errors.New("invalid: unable to decode TemplateGetByIDVersionVariablesItem to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "versionID":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int64()
-				s.VersionID = int64(v)
+				s.ID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"versionID\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "payload":
+		case "name":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Payload.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"payload\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "title":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Title = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "expression":
+			if err := func() error {
+				s.Expression.Reset()
+				if err := s.Expression.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expression\"")
+			}
+		case "isInput":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.IsInput = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"isInput\"")
+			}
+		case "defaultExpression":
+			if err := func() error {
+				s.DefaultExpression.Reset()
+				if err := s.DefaultExpression.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"defaultExpression\"")
+			}
+		case "enabledIf":
+			if err := func() error {
+				s.EnabledIf.Reset()
+				if err := s.EnabledIf.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"enabledIf\"")
+			}
+		case "unit":
+			if err := func() error {
+				s.Unit.Reset()
+				if err := s.Unit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unit\"")
+			}
+		case "options":
+			if err := func() error {
+				s.Options = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Options = append(s.Options, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"options\"")
+			}
+		case "optionsFrom":
+			if err := func() error {
+				s.OptionsFrom.Reset()
+				if err := s.OptionsFrom.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"optionsFrom\"")
+			}
+		case "itemType":
+			if err := func() error {
+				s.ItemType.Reset()
+				if err := s.ItemType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"itemType\"")
+			}
+		case "columns":
+			if err := func() error {
+				s.Columns = make([]TemplateGetByIDVersionVariablesItemColumnsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TemplateGetByIDVersionVariablesItemColumnsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Columns = append(s.Columns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"columns\"")
+			}
+		case "constraints":
+			requiredBitSet[1] |= 1 << 5
+			if err := func() error {
+				s.Constraints = make([]TemplateGetByIDVersionVariablesItemConstraintsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TemplateGetByIDVersionVariablesItemConstraintsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Constraints = append(s.Constraints, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"constraints\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TaskCreateRequest")
+		return errors.Wrap(err, "decode TemplateGetByIDVersionVariablesItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
+	for i, mask := range [2]uint8{
+		0b00101111,
+		0b00100000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1550,8 +8320,8 @@ func (s *TaskCreateRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTaskCreateRequest) {
-					name = jsonFieldsNameOfTaskCreateRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateGetByIDVersionVariablesItem) {
+					name = jsonFieldsNameOfTemplateGetByIDVersionVariablesItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1572,139 +8342,131 @@ func (s *TaskCreateRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TaskCreateRequest) MarshalJSON() ([]byte, error) {
+func (s *TemplateGetByIDVersionVariablesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskCreateRequest) UnmarshalJSON(data []byte) error {
+func (s *TemplateGetByIDVersionVariablesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s TaskCreateRequestPayload) Encode(e *jx.Encoder) {
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s TaskCreateRequestPayload) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.Str(elem)
-	}
-}
-
-// Decode decodes TaskCreateRequestPayload from json.
-func (s *TaskCreateRequestPayload) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode TaskCreateRequestPayload to nil")
+// encodeFields encodes fields.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem string
-		if err := func() error {
-			v, err := d.Str()
-			elem = string(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode TaskCreateRequestPayload")
+	{
+		e.FieldStart("title")
+		e.Str(s.Title)
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s TaskCreateRequestPayload) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskCreateRequestPayload) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *TaskGetByIDResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *TaskGetByIDResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("task")
-		s.Task.Encode(e)
+		e.FieldStart("type")
+		s.Type.Encode(e)
 	}
 	{
-		e.FieldStart("result")
-		e.Base64(s.Result)
+		if s.Options != nil {
+			e.FieldStart("options")
+			e.ArrStart()
+			for _, elem := range s.Options {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
 	}
 }
 
-var jsonFieldsNameOfTaskGetByIDResponse = [2]string{
-	0: "task",
-	1: "result",
+var jsonFieldsNameOfTemplateGetByIDVersionVariablesItemColumnsItem = [4]string{
+	0: "name",
+	1: "title",
+	2: "type",
+	3: "options",
 }
 
-// Decode decodes TaskGetByIDResponse from json.
-func (s *TaskGetByIDResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateGetByIDVersionVariablesItemColumnsItem from json.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TaskGetByIDResponse to nil")
+		return errors.New("invalid: unable to decode TemplateGetByIDVersionVariablesItemColumnsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "task":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Task.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"task\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "result":
+		case "title":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Base64()
-				s.Result = []byte(v)
+				v, err := d.Str()
+				s.Title = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"result\"")
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "options":
+			if err := func() error {
+				s.Options = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Options = append(s.Options, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"options\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TaskGetByIDResponse")
+		return errors.Wrap(err, "decode TemplateGetByIDVersionVariablesItemColumnsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1716,8 +8478,8 @@ func (s *TaskGetByIDResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTaskGetByIDResponse) {
-					name = jsonFieldsNameOfTaskGetByIDResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateGetByIDVersionVariablesItemColumnsItem) {
+					name = jsonFieldsNameOfTemplateGetByIDVersionVariablesItemColumnsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1738,80 +8500,106 @@ func (s *TaskGetByIDResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TaskGetByIDResponse) MarshalJSON() ([]byte, error) {
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskGetByIDResponse) UnmarshalJSON(data []byte) error {
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TemplateGetByIDVersionVariablesItemColumnsItemType as json.
+func (s TemplateGetByIDVersionVariablesItemColumnsItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes TemplateGetByIDVersionVariablesItemColumnsItemType from json.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TemplateGetByIDVersionVariablesItemColumnsItemType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch TemplateGetByIDVersionVariablesItemColumnsItemType(v) {
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeString:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeString
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeInteger:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeInteger
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeFloat:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeFloat
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeDecimal:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeDecimal
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeBoolean:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeBoolean
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeDate:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeDate
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeEnum:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeEnum
+	default:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TemplateGetByIDVersionVariablesItemColumnsItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItemType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TaskGetByIDResponseTask) Encode(e *jx.Encoder) {
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TaskGetByIDResponseTask) encodeFields(e *jx.Encoder) {
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("id")
 		e.Int64(s.ID)
 	}
 	{
-		e.FieldStart("versionID")
-		e.Int64(s.VersionID)
-	}
-	{
-		e.FieldStart("status")
-		s.Status.Encode(e)
-	}
-	{
-		e.FieldStart("payload")
-		s.Payload.Encode(e)
-	}
-	{
-		if s.Error.Set {
-			e.FieldStart("error")
-			s.Error.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("creatorName")
-		e.Str(s.CreatorName)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("createdAt")
-		json.EncodeDateTime(e, s.CreatedAt)
+		e.FieldStart("expression")
+		e.Str(s.Expression)
 	}
 	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updatedAt")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("isActive")
+		e.Bool(s.IsActive)
 	}
 }
 
-var jsonFieldsNameOfTaskGetByIDResponseTask = [8]string{
+var jsonFieldsNameOfTemplateGetByIDVersionVariablesItemConstraintsItem = [4]string{
 	0: "id",
-	1: "versionID",
-	2: "status",
-	3: "payload",
-	4: "error",
-	5: "creatorName",
-	6: "createdAt",
-	7: "updatedAt",
+	1: "name",
+	2: "expression",
+	3: "isActive",
 }
 
-// Decode decodes TaskGetByIDResponseTask from json.
-func (s *TaskGetByIDResponseTask) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateGetByIDVersionVariablesItemConstraintsItem from json.
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TaskGetByIDResponseTask to nil")
+		return errors.New("invalid: unable to decode TemplateGetByIDVersionVariablesItemConstraintsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -1829,93 +8617,53 @@ func (s *TaskGetByIDResponseTask) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "versionID":
+		case "name":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int64()
-				s.VersionID = int64(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"versionID\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "status":
+		case "expression":
 			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
-			}
-		case "payload":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				if err := s.Payload.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"payload\"")
-			}
-		case "error":
-			if err := func() error {
-				s.Error.Reset()
-				if err := s.Error.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
-			}
-		case "creatorName":
-			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
 				v, err := d.Str()
-				s.CreatorName = string(v)
+				s.Expression = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"creatorName\"")
+				return errors.Wrap(err, "decode field \"expression\"")
 			}
-		case "createdAt":
-			requiredBitSet[0] |= 1 << 6
+		case "isActive":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				v, err := d.Bool()
+				s.IsActive = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
-			}
-		case "updatedAt":
-			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updatedAt\"")
+				return errors.Wrap(err, "decode field \"isActive\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TaskGetByIDResponseTask")
+		return errors.Wrap(err, "decode TemplateGetByIDVersionVariablesItemConstraintsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01101111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1927,8 +8675,8 @@ func (s *TaskGetByIDResponseTask) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTaskGetByIDResponseTask) {
-					name = jsonFieldsNameOfTaskGetByIDResponseTask[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateGetByIDVersionVariablesItemConstraintsItem) {
+					name = jsonFieldsNameOfTemplateGetByIDVersionVariablesItemConstraintsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1949,223 +8697,168 @@ func (s *TaskGetByIDResponseTask) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TaskGetByIDResponseTask) MarshalJSON() ([]byte, error) {
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskGetByIDResponseTask) UnmarshalJSON(data []byte) error {
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *TaskGetByIDResponseTaskError) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes TemplateGetByIDVersionVariablesItemItemType as json.
+func (s TemplateGetByIDVersionVariablesItemItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// encodeFields encodes fields.
-func (s *TaskGetByIDResponseTaskError) encodeFields(e *jx.Encoder) {
-	{
-		if s.Message.Set {
-			e.FieldStart("message")
-			s.Message.Encode(e)
-		}
+// Decode decodes TemplateGetByIDVersionVariablesItemItemType from json.
+func (s *TemplateGetByIDVersionVariablesItemItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TemplateGetByIDVersionVariablesItemItemType to nil")
 	}
-	{
-		if s.Template.Set {
-			e.FieldStart("template")
-			s.Template.Encode(e)
-		}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	{
-		if s.VariableErrors != nil {
-			e.FieldStart("variableErrors")
-			e.ArrStart()
-			for _, elem := range s.VariableErrors {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+	// Try to use constant string.
+	switch TemplateGetByIDVersionVariablesItemItemType(v) {
+	case TemplateGetByIDVersionVariablesItemItemTypeString:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeString
+	case TemplateGetByIDVersionVariablesItemItemTypeInteger:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeInteger
+	case TemplateGetByIDVersionVariablesItemItemTypeFloat:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeFloat
+	case TemplateGetByIDVersionVariablesItemItemTypeDecimal:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeDecimal
+	case TemplateGetByIDVersionVariablesItemItemTypeBoolean:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeBoolean
+	case TemplateGetByIDVersionVariablesItemItemTypeDate:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeDate
+	case TemplateGetByIDVersionVariablesItemItemTypeEnum:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeEnum
+	default:
+		*s = TemplateGetByIDVersionVariablesItemItemType(v)
 	}
+
+	return nil
 }
 
-var jsonFieldsNameOfTaskGetByIDResponseTaskError = [3]string{
-	0: "message",
-	1: "template",
-	2: "variableErrors",
+// MarshalJSON implements stdjson.Marshaler.
+func (s TemplateGetByIDVersionVariablesItemItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TemplateGetByIDVersionVariablesItemItemType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TemplateGetByIDVersionVariablesItemType as json.
+func (s TemplateGetByIDVersionVariablesItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes TaskGetByIDResponseTaskError from json.
-func (s *TaskGetByIDResponseTaskError) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateGetByIDVersionVariablesItemType from json.
+func (s *TemplateGetByIDVersionVariablesItemType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TaskGetByIDResponseTaskError to nil")
+		return errors.New("invalid: unable to decode TemplateGetByIDVersionVariablesItemType to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "message":
-			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
-			}
-		case "template":
-			if err := func() error {
-				s.Template.Reset()
-				if err := s.Template.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"template\"")
-			}
-		case "variableErrors":
-			if err := func() error {
-				s.VariableErrors = make([]TaskGetByIDResponseTaskErrorVariableErrorsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TaskGetByIDResponseTaskErrorVariableErrorsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.VariableErrors = append(s.VariableErrors, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"variableErrors\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode TaskGetByIDResponseTaskError")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch TemplateGetByIDVersionVariablesItemType(v) {
+	case TemplateGetByIDVersionVariablesItemTypeString:
+		*s = TemplateGetByIDVersionVariablesItemTypeString
+	case TemplateGetByIDVersionVariablesItemTypeInteger:
+		*s = TemplateGetByIDVersionVariablesItemTypeInteger
+	case TemplateGetByIDVersionVariablesItemTypeFloat:
+		*s = TemplateGetByIDVersionVariablesItemTypeFloat
+	case TemplateGetByIDVersionVariablesItemTypeDecimal:
+		*s = TemplateGetByIDVersionVariablesItemTypeDecimal
+	case TemplateGetByIDVersionVariablesItemTypeBoolean:
+		*s = TemplateGetByIDVersionVariablesItemTypeBoolean
+	case TemplateGetByIDVersionVariablesItemTypeDate:
+		*s = TemplateGetByIDVersionVariablesItemTypeDate
+	case TemplateGetByIDVersionVariablesItemTypeEnum:
+		*s = TemplateGetByIDVersionVariablesItemTypeEnum
+	case TemplateGetByIDVersionVariablesItemTypeList:
+		*s = TemplateGetByIDVersionVariablesItemTypeList
+	case TemplateGetByIDVersionVariablesItemTypeTable:
+		*s = TemplateGetByIDVersionVariablesItemTypeTable
+	default:
+		*s = TemplateGetByIDVersionVariablesItemType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TaskGetByIDResponseTaskError) MarshalJSON() ([]byte, error) {
+func (s TemplateGetByIDVersionVariablesItemType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskGetByIDResponseTaskError) UnmarshalJSON(data []byte) error {
+func (s *TemplateGetByIDVersionVariablesItemType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TaskGetByIDResponseTaskErrorTemplate) Encode(e *jx.Encoder) {
+func (s *TemplateGetMetaByIDResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TaskGetByIDResponseTaskErrorTemplate) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("line")
-		e.Int(s.Line)
-	}
-	{
-		if s.Column.Set {
-			e.FieldStart("column")
-			s.Column.Encode(e)
-		}
-	}
-	{
-		if s.Snippet.Set {
-			e.FieldStart("snippet")
-			s.Snippet.Encode(e)
-		}
-	}
+func (s *TemplateGetMetaByIDResponse) encodeFields(e *jx.Encoder) {
 	{
-		if s.Detail.Set {
-			e.FieldStart("detail")
-			s.Detail.Encode(e)
-		}
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 }
 
-var jsonFieldsNameOfTaskGetByIDResponseTaskErrorTemplate = [4]string{
-	0: "line",
-	1: "column",
-	2: "snippet",
-	3: "detail",
+var jsonFieldsNameOfTemplateGetMetaByIDResponse = [1]string{
+	0: "name",
 }
 
-// Decode decodes TaskGetByIDResponseTaskErrorTemplate from json.
-func (s *TaskGetByIDResponseTaskErrorTemplate) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateGetMetaByIDResponse from json.
+func (s *TemplateGetMetaByIDResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TaskGetByIDResponseTaskErrorTemplate to nil")
+		return errors.New("invalid: unable to decode TemplateGetMetaByIDResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "line":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.Line = int(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"line\"")
-			}
-		case "column":
-			if err := func() error {
-				s.Column.Reset()
-				if err := s.Column.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"column\"")
-			}
-		case "snippet":
-			if err := func() error {
-				s.Snippet.Reset()
-				if err := s.Snippet.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"snippet\"")
-			}
-		case "detail":
-			if err := func() error {
-				s.Detail.Reset()
-				if err := s.Detail.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"detail\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TaskGetByIDResponseTaskErrorTemplate")
+		return errors.Wrap(err, "decode TemplateGetMetaByIDResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2182,8 +8875,8 @@ func (s *TaskGetByIDResponseTaskErrorTemplate) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTaskGetByIDResponseTaskErrorTemplate) {
-					name = jsonFieldsNameOfTaskGetByIDResponseTaskErrorTemplate[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateGetMetaByIDResponse) {
+					name = jsonFieldsNameOfTemplateGetMetaByIDResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2204,95 +8897,55 @@ func (s *TaskGetByIDResponseTaskErrorTemplate) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TaskGetByIDResponseTaskErrorTemplate) MarshalJSON() ([]byte, error) {
+func (s *TemplateGetMetaByIDResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskGetByIDResponseTaskErrorTemplate) UnmarshalJSON(data []byte) error {
+func (s *TemplateGetMetaByIDResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) Encode(e *jx.Encoder) {
+func (s *TemplateImportPayload) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
+func (s *TemplateImportPayload) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("title")
-		e.Str(s.Title)
-	}
-	{
-		if s.Value.Set {
-			e.FieldStart("value")
-			s.Value.Encode(e)
-		}
-	}
-	{
-		if s.Message.Set {
-			e.FieldStart("message")
-			s.Message.Encode(e)
-		}
-	}
-	{
-		if s.ConstraintErrors != nil {
-			e.FieldStart("constraintErrors")
-			e.ArrStart()
-			for _, elem := range s.ConstraintErrors {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfTaskGetByIDResponseTaskErrorVariableErrorsItem = [6]string{
-	0: "id",
-	1: "name",
-	2: "title",
-	3: "value",
-	4: "message",
-	5: "constraintErrors",
+var jsonFieldsNameOfTemplateImportPayload = [2]string{
+	0: "name",
+	1: "version",
 }
 
-// Decode decodes TaskGetByIDResponseTaskErrorVariableErrorsItem from json.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateImportPayload from json.
+func (s *TemplateImportPayload) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TaskGetByIDResponseTaskErrorVariableErrorsItem to nil")
+		return errors.New("invalid: unable to decode TemplateImportPayload to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
 		case "name":
-			requiredBitSet[0] |= 1 << 1
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
 				s.Name = string(v)
@@ -2303,66 +8956,27 @@ func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) Decode(d *jx.Decoder) e
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "title":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Title = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
-			}
-		case "value":
-			if err := func() error {
-				s.Value.Reset()
-				if err := s.Value.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"value\"")
-			}
-		case "message":
-			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
-			}
-		case "constraintErrors":
+		case "version":
 			if err := func() error {
-				s.ConstraintErrors = make([]TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.ConstraintErrors = append(s.ConstraintErrors, elem)
-					return nil
-				}); err != nil {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"constraintErrors\"")
+				return errors.Wrap(err, "decode field \"version\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TaskGetByIDResponseTaskErrorVariableErrorsItem")
+		return errors.Wrap(err, "decode TemplateImportPayload")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2374,8 +8988,8 @@ func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) Decode(d *jx.Decoder) e
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTaskGetByIDResponseTaskErrorVariableErrorsItem) {
-					name = jsonFieldsNameOfTaskGetByIDResponseTaskErrorVariableErrorsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateImportPayload) {
+					name = jsonFieldsNameOfTemplateImportPayload[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2396,120 +9010,84 @@ func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) Decode(d *jx.Decoder) e
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) MarshalJSON() ([]byte, error) {
+func (s *TemplateImportPayload) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) UnmarshalJSON(data []byte) error {
+func (s *TemplateImportPayload) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) Encode(e *jx.Encoder) {
+func (s *TemplateImportRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
+func (s *TemplateImportRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("expression")
-		e.Str(s.Expression)
+		e.FieldStart("projectID")
+		e.Int64(s.ProjectID)
 	}
 	{
-		if s.Message.Set {
-			e.FieldStart("message")
-			s.Message.Encode(e)
-		}
+		e.FieldStart("template")
+		s.Template.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfTaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem = [4]string{
-	0: "id",
-	1: "name",
-	2: "expression",
-	3: "message",
+var jsonFieldsNameOfTemplateImportRequest = [2]string{
+	0: "projectID",
+	1: "template",
 }
 
-// Decode decodes TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem from json.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateImportRequest from json.
+func (s *TemplateImportRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem to nil")
+		return errors.New("invalid: unable to decode TemplateImportRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "expression":
-			requiredBitSet[0] |= 1 << 2
+		case "projectID":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Expression = string(v)
+				v, err := d.Int64()
+				s.ProjectID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expression\"")
+				return errors.Wrap(err, "decode field \"projectID\"")
 			}
-		case "message":
+		case "template":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
+				if err := s.Template.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"template\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem")
+		return errors.Wrap(err, "decode TemplateImportRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2521,8 +9099,8 @@ func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) Dec
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) {
-					name = jsonFieldsNameOfTaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateImportRequest) {
+					name = jsonFieldsNameOfTemplateImportRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2543,169 +9121,69 @@ func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) Dec
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s TaskGetByIDResponseTaskPayload) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s TaskGetByIDResponseTaskPayload) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.Str(elem)
-	}
-}
-
-// Decode decodes TaskGetByIDResponseTaskPayload from json.
-func (s *TaskGetByIDResponseTaskPayload) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode TaskGetByIDResponseTaskPayload to nil")
-	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem string
-		if err := func() error {
-			v, err := d.Str()
-			elem = string(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode TaskGetByIDResponseTaskPayload")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s TaskGetByIDResponseTaskPayload) MarshalJSON() ([]byte, error) {
+func (s *TemplateImportRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskGetByIDResponseTaskPayload) UnmarshalJSON(data []byte) error {
+func (s *TemplateImportRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TaskListResponse) Encode(e *jx.Encoder) {
+func (s *TemplateImportResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TaskListResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("tasks")
-		e.ArrStart()
-		for _, elem := range s.Tasks {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
-	}
-	{
-		e.FieldStart("totalTasks")
-		e.Int64(s.TotalTasks)
-	}
+func (s *TemplateImportResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("totalPages")
-		e.Int64(s.TotalPages)
+		e.FieldStart("id")
+		e.Int64(s.ID)
 	}
 }
 
-var jsonFieldsNameOfTaskListResponse = [3]string{
-	0: "tasks",
-	1: "totalTasks",
-	2: "totalPages",
+var jsonFieldsNameOfTemplateImportResponse = [1]string{
+	0: "id",
 }
 
-// Decode decodes TaskListResponse from json.
-func (s *TaskListResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateImportResponse from json.
+func (s *TemplateImportResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TaskListResponse to nil")
+		return errors.New("invalid: unable to decode TemplateImportResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "tasks":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				s.Tasks = make([]TaskListResponseTasksItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TaskListResponseTasksItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Tasks = append(s.Tasks, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tasks\"")
-			}
-		case "totalTasks":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int64()
-				s.TotalTasks = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"totalTasks\"")
-			}
-		case "totalPages":
-			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Int64()
-				s.TotalPages = int64(v)
+				s.ID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"totalPages\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TaskListResponse")
+		return errors.Wrap(err, "decode TemplateImportResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2717,8 +9195,8 @@ func (s *TaskListResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTaskListResponse) {
-					name = jsonFieldsNameOfTaskListResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateImportResponse) {
+					name = jsonFieldsNameOfTemplateImportResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2739,152 +9217,96 @@ func (s *TaskListResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TaskListResponse) MarshalJSON() ([]byte, error) {
+func (s *TemplateImportResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskListResponse) UnmarshalJSON(data []byte) error {
+func (s *TemplateImportResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TaskListResponseTasksItem) Encode(e *jx.Encoder) {
+func (s *TemplateImportVersion) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TaskListResponseTasksItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
-	{
-		e.FieldStart("versionNumber")
-		e.Int64(s.VersionNumber)
-	}
-	{
-		e.FieldStart("status")
-		s.Status.Encode(e)
-	}
-	{
-		e.FieldStart("creatorName")
-		e.Str(s.CreatorName)
-	}
+func (s *TemplateImportVersion) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("createdAt")
-		json.EncodeDateTime(e, s.CreatedAt)
+		e.FieldStart("data")
+		e.Base64(s.Data)
 	}
 	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updatedAt")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		e.FieldStart("variables")
+		e.ArrStart()
+		for _, elem := range s.Variables {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTaskListResponseTasksItem = [6]string{
-	0: "id",
-	1: "versionNumber",
-	2: "status",
-	3: "creatorName",
-	4: "createdAt",
-	5: "updatedAt",
+var jsonFieldsNameOfTemplateImportVersion = [2]string{
+	0: "data",
+	1: "variables",
 }
 
-// Decode decodes TaskListResponseTasksItem from json.
-func (s *TaskListResponseTasksItem) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateImportVersion from json.
+func (s *TemplateImportVersion) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TaskListResponseTasksItem to nil")
+		return errors.New("invalid: unable to decode TemplateImportVersion to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "versionNumber":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int64()
-				s.VersionNumber = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"versionNumber\"")
-			}
-		case "status":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
-			}
-		case "creatorName":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.CreatorName = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"creatorName\"")
-			}
-		case "createdAt":
-			requiredBitSet[0] |= 1 << 4
+		switch string(k) {
+		case "data":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				v, err := d.Base64()
+				s.Data = []byte(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
+				return errors.Wrap(err, "decode field \"data\"")
 			}
-		case "updatedAt":
+		case "variables":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.Variables = make([]TemplateImportVersionVariablesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TemplateImportVersionVariablesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Variables = append(s.Variables, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updatedAt\"")
+				return errors.Wrap(err, "decode field \"variables\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TaskListResponseTasksItem")
+		return errors.Wrap(err, "decode TemplateImportVersion")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00011111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2896,8 +9318,8 @@ func (s *TaskListResponseTasksItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTaskListResponseTasksItem) {
-					name = jsonFieldsNameOfTaskListResponseTasksItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateImportVersion) {
+					name = jsonFieldsNameOfTemplateImportVersion[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2918,147 +9340,306 @@ func (s *TaskListResponseTasksItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TaskListResponseTasksItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskListResponseTasksItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes TaskStatus as json.
-func (s TaskStatus) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes TaskStatus from json.
-func (s *TaskStatus) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode TaskStatus to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch TaskStatus(v) {
-	case TaskStatusCreated:
-		*s = TaskStatusCreated
-	case TaskStatusInProgress:
-		*s = TaskStatusInProgress
-	case TaskStatusSucceed:
-		*s = TaskStatusSucceed
-	case TaskStatusFailed:
-		*s = TaskStatusFailed
-	default:
-		*s = TaskStatus(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s TaskStatus) MarshalJSON() ([]byte, error) {
+func (s *TemplateImportVersion) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TaskStatus) UnmarshalJSON(data []byte) error {
+func (s *TemplateImportVersion) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateCreateFromDefaultRequest) Encode(e *jx.Encoder) {
+func (s *TemplateImportVersionVariablesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateCreateFromDefaultRequest) encodeFields(e *jx.Encoder) {
+func (s *TemplateImportVersionVariablesItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("sourceTemplateID")
-		e.Int64(s.SourceTemplateID)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("projectID")
-		e.Int64(s.ProjectID)
+		e.FieldStart("title")
+		e.Str(s.Title)
 	}
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		if s.Expression.Set {
+			e.FieldStart("expression")
+			s.Expression.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("isInput")
+		e.Bool(s.IsInput)
+	}
+	{
+		if s.DefaultExpression.Set {
+			e.FieldStart("defaultExpression")
+			s.DefaultExpression.Encode(e)
+		}
+	}
+	{
+		if s.EnabledIf.Set {
+			e.FieldStart("enabledIf")
+			s.EnabledIf.Encode(e)
+		}
+	}
+	{
+		if s.Unit.Set {
+			e.FieldStart("unit")
+			s.Unit.Encode(e)
+		}
+	}
+	{
+		if s.Options != nil {
+			e.FieldStart("options")
+			e.ArrStart()
+			for _, elem := range s.Options {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.OptionsFrom.Set {
+			e.FieldStart("optionsFrom")
+			s.OptionsFrom.Encode(e)
+		}
+	}
+	{
+		if s.ItemType.Set {
+			e.FieldStart("itemType")
+			s.ItemType.Encode(e)
+		}
+	}
+	{
+		if s.Columns != nil {
+			e.FieldStart("columns")
+			e.ArrStart()
+			for _, elem := range s.Columns {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		e.FieldStart("constraints")
+		e.ArrStart()
+		for _, elem := range s.Constraints {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTemplateCreateFromDefaultRequest = [3]string{
-	0: "sourceTemplateID",
-	1: "projectID",
-	2: "name",
+var jsonFieldsNameOfTemplateImportVersionVariablesItem = [13]string{
+	0:  "name",
+	1:  "title",
+	2:  "type",
+	3:  "expression",
+	4:  "isInput",
+	5:  "defaultExpression",
+	6:  "enabledIf",
+	7:  "unit",
+	8:  "options",
+	9:  "optionsFrom",
+	10: "itemType",
+	11: "columns",
+	12: "constraints",
 }
 
-// Decode decodes TemplateCreateFromDefaultRequest from json.
-func (s *TemplateCreateFromDefaultRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateImportVersionVariablesItem from json.
+func (s *TemplateImportVersionVariablesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateCreateFromDefaultRequest to nil")
+		return errors.New("invalid: unable to decode TemplateImportVersionVariablesItem to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "sourceTemplateID":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.SourceTemplateID = int64(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"sourceTemplateID\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "projectID":
+		case "title":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int64()
-				s.ProjectID = int64(v)
+				v, err := d.Str()
+				s.Title = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"projectID\"")
+				return errors.Wrap(err, "decode field \"title\"")
 			}
-		case "name":
+		case "type":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "expression":
+			if err := func() error {
+				s.Expression.Reset()
+				if err := s.Expression.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expression\"")
+			}
+		case "isInput":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.IsInput = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"isInput\"")
+			}
+		case "defaultExpression":
+			if err := func() error {
+				s.DefaultExpression.Reset()
+				if err := s.DefaultExpression.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"defaultExpression\"")
+			}
+		case "enabledIf":
+			if err := func() error {
+				s.EnabledIf.Reset()
+				if err := s.EnabledIf.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"enabledIf\"")
+			}
+		case "unit":
+			if err := func() error {
+				s.Unit.Reset()
+				if err := s.Unit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unit\"")
+			}
+		case "options":
+			if err := func() error {
+				s.Options = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Options = append(s.Options, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"options\"")
+			}
+		case "optionsFrom":
+			if err := func() error {
+				s.OptionsFrom.Reset()
+				if err := s.OptionsFrom.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"optionsFrom\"")
+			}
+		case "itemType":
+			if err := func() error {
+				s.ItemType.Reset()
+				if err := s.ItemType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"itemType\"")
+			}
+		case "columns":
+			if err := func() error {
+				s.Columns = make([]TemplateImportVersionVariablesItemColumnsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TemplateImportVersionVariablesItemColumnsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Columns = append(s.Columns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"columns\"")
+			}
+		case "constraints":
+			requiredBitSet[1] |= 1 << 4
+			if err := func() error {
+				s.Constraints = make([]TemplateImportVersionVariablesItemConstraintsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TemplateImportVersionVariablesItemConstraintsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Constraints = append(s.Constraints, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"constraints\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateCreateFromDefaultRequest")
+		return errors.Wrap(err, "decode TemplateImportVersionVariablesItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
+	for i, mask := range [2]uint8{
+		0b00010111,
+		0b00010000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3070,8 +9651,8 @@ func (s *TemplateCreateFromDefaultRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateCreateFromDefaultRequest) {
-					name = jsonFieldsNameOfTemplateCreateFromDefaultRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateImportVersionVariablesItem) {
+					name = jsonFieldsNameOfTemplateImportVersionVariablesItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3092,69 +9673,131 @@ func (s *TemplateCreateFromDefaultRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateCreateFromDefaultRequest) MarshalJSON() ([]byte, error) {
+func (s *TemplateImportVersionVariablesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateCreateFromDefaultRequest) UnmarshalJSON(data []byte) error {
+func (s *TemplateImportVersionVariablesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateCreateFromDefaultResponse) Encode(e *jx.Encoder) {
+func (s *TemplateImportVersionVariablesItemColumnsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateCreateFromDefaultResponse) encodeFields(e *jx.Encoder) {
+func (s *TemplateImportVersionVariablesItemColumnsItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("title")
+		e.Str(s.Title)
+	}
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		if s.Options != nil {
+			e.FieldStart("options")
+			e.ArrStart()
+			for _, elem := range s.Options {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
 	}
 }
 
-var jsonFieldsNameOfTemplateCreateFromDefaultResponse = [1]string{
-	0: "id",
+var jsonFieldsNameOfTemplateImportVersionVariablesItemColumnsItem = [4]string{
+	0: "name",
+	1: "title",
+	2: "type",
+	3: "options",
 }
 
-// Decode decodes TemplateCreateFromDefaultResponse from json.
-func (s *TemplateCreateFromDefaultResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateImportVersionVariablesItemColumnsItem from json.
+func (s *TemplateImportVersionVariablesItemColumnsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateCreateFromDefaultResponse to nil")
+		return errors.New("invalid: unable to decode TemplateImportVersionVariablesItemColumnsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "title":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Title = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "options":
+			if err := func() error {
+				s.Options = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Options = append(s.Options, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"options\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateCreateFromDefaultResponse")
+		return errors.Wrap(err, "decode TemplateImportVersionVariablesItemColumnsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3166,8 +9809,8 @@ func (s *TemplateCreateFromDefaultResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateCreateFromDefaultResponse) {
-					name = jsonFieldsNameOfTemplateCreateFromDefaultResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateImportVersionVariablesItemColumnsItem) {
+					name = jsonFieldsNameOfTemplateImportVersionVariablesItemColumnsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3188,46 +9831,101 @@ func (s *TemplateCreateFromDefaultResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateCreateFromDefaultResponse) MarshalJSON() ([]byte, error) {
+func (s *TemplateImportVersionVariablesItemColumnsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateCreateFromDefaultResponse) UnmarshalJSON(data []byte) error {
+func (s *TemplateImportVersionVariablesItemColumnsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TemplateImportVersionVariablesItemColumnsItemType as json.
+func (s TemplateImportVersionVariablesItemColumnsItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes TemplateImportVersionVariablesItemColumnsItemType from json.
+func (s *TemplateImportVersionVariablesItemColumnsItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TemplateImportVersionVariablesItemColumnsItemType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch TemplateImportVersionVariablesItemColumnsItemType(v) {
+	case TemplateImportVersionVariablesItemColumnsItemTypeString:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeString
+	case TemplateImportVersionVariablesItemColumnsItemTypeInteger:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeInteger
+	case TemplateImportVersionVariablesItemColumnsItemTypeFloat:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeFloat
+	case TemplateImportVersionVariablesItemColumnsItemTypeDecimal:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeDecimal
+	case TemplateImportVersionVariablesItemColumnsItemTypeBoolean:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeBoolean
+	case TemplateImportVersionVariablesItemColumnsItemTypeDate:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeDate
+	case TemplateImportVersionVariablesItemColumnsItemTypeEnum:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeEnum
+	default:
+		*s = TemplateImportVersionVariablesItemColumnsItemType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TemplateImportVersionVariablesItemColumnsItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TemplateImportVersionVariablesItemColumnsItemType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateCreateRequest) Encode(e *jx.Encoder) {
+func (s *TemplateImportVersionVariablesItemConstraintsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateCreateRequest) encodeFields(e *jx.Encoder) {
+func (s *TemplateImportVersionVariablesItemConstraintsItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("projectID")
-		e.Int64(s.ProjectID)
+		e.FieldStart("expression")
+		e.Str(s.Expression)
+	}
+	{
+		e.FieldStart("isActive")
+		e.Bool(s.IsActive)
 	}
 }
 
-var jsonFieldsNameOfTemplateCreateRequest = [2]string{
+var jsonFieldsNameOfTemplateImportVersionVariablesItemConstraintsItem = [3]string{
 	0: "name",
-	1: "projectID",
+	1: "expression",
+	2: "isActive",
 }
 
-// Decode decodes TemplateCreateRequest from json.
-func (s *TemplateCreateRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateImportVersionVariablesItemConstraintsItem from json.
+func (s *TemplateImportVersionVariablesItemConstraintsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateCreateRequest to nil")
+		return errors.New("invalid: unable to decode TemplateImportVersionVariablesItemConstraintsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -3245,29 +9943,41 @@ func (s *TemplateCreateRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "projectID":
+		case "expression":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int64()
-				s.ProjectID = int64(v)
+				v, err := d.Str()
+				s.Expression = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"projectID\"")
+				return errors.Wrap(err, "decode field \"expression\"")
+			}
+		case "isActive":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Bool()
+				s.IsActive = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"isActive\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateCreateRequest")
+		return errors.Wrap(err, "decode TemplateImportVersionVariablesItemConstraintsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3279,8 +9989,8 @@ func (s *TemplateCreateRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateCreateRequest) {
-					name = jsonFieldsNameOfTemplateCreateRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateImportVersionVariablesItemConstraintsItem) {
+					name = jsonFieldsNameOfTemplateImportVersionVariablesItemConstraintsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3301,27 +10011,131 @@ func (s *TemplateCreateRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateCreateRequest) MarshalJSON() ([]byte, error) {
+func (s *TemplateImportVersionVariablesItemConstraintsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateCreateRequest) UnmarshalJSON(data []byte) error {
+func (s *TemplateImportVersionVariablesItemConstraintsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TemplateImportVersionVariablesItemItemType as json.
+func (s TemplateImportVersionVariablesItemItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes TemplateImportVersionVariablesItemItemType from json.
+func (s *TemplateImportVersionVariablesItemItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TemplateImportVersionVariablesItemItemType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch TemplateImportVersionVariablesItemItemType(v) {
+	case TemplateImportVersionVariablesItemItemTypeString:
+		*s = TemplateImportVersionVariablesItemItemTypeString
+	case TemplateImportVersionVariablesItemItemTypeInteger:
+		*s = TemplateImportVersionVariablesItemItemTypeInteger
+	case TemplateImportVersionVariablesItemItemTypeFloat:
+		*s = TemplateImportVersionVariablesItemItemTypeFloat
+	case TemplateImportVersionVariablesItemItemTypeDecimal:
+		*s = TemplateImportVersionVariablesItemItemTypeDecimal
+	case TemplateImportVersionVariablesItemItemTypeBoolean:
+		*s = TemplateImportVersionVariablesItemItemTypeBoolean
+	case TemplateImportVersionVariablesItemItemTypeDate:
+		*s = TemplateImportVersionVariablesItemItemTypeDate
+	case TemplateImportVersionVariablesItemItemTypeEnum:
+		*s = TemplateImportVersionVariablesItemItemTypeEnum
+	default:
+		*s = TemplateImportVersionVariablesItemItemType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TemplateImportVersionVariablesItemItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TemplateImportVersionVariablesItemItemType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TemplateImportVersionVariablesItemType as json.
+func (s TemplateImportVersionVariablesItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes TemplateImportVersionVariablesItemType from json.
+func (s *TemplateImportVersionVariablesItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TemplateImportVersionVariablesItemType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch TemplateImportVersionVariablesItemType(v) {
+	case TemplateImportVersionVariablesItemTypeString:
+		*s = TemplateImportVersionVariablesItemTypeString
+	case TemplateImportVersionVariablesItemTypeInteger:
+		*s = TemplateImportVersionVariablesItemTypeInteger
+	case TemplateImportVersionVariablesItemTypeFloat:
+		*s = TemplateImportVersionVariablesItemTypeFloat
+	case TemplateImportVersionVariablesItemTypeDecimal:
+		*s = TemplateImportVersionVariablesItemTypeDecimal
+	case TemplateImportVersionVariablesItemTypeBoolean:
+		*s = TemplateImportVersionVariablesItemTypeBoolean
+	case TemplateImportVersionVariablesItemTypeDate:
+		*s = TemplateImportVersionVariablesItemTypeDate
+	case TemplateImportVersionVariablesItemTypeEnum:
+		*s = TemplateImportVersionVariablesItemTypeEnum
+	case TemplateImportVersionVariablesItemTypeList:
+		*s = TemplateImportVersionVariablesItemTypeList
+	case TemplateImportVersionVariablesItemTypeTable:
+		*s = TemplateImportVersionVariablesItemTypeTable
+	default:
+		*s = TemplateImportVersionVariablesItemType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TemplateImportVersionVariablesItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TemplateImportVersionVariablesItemType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateDefaultListResponse) Encode(e *jx.Encoder) {
+func (s *TemplateListResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateDefaultListResponse) encodeFields(e *jx.Encoder) {
+func (s *TemplateListResponse) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("templates")
 		e.ArrStart()
@@ -3340,16 +10154,16 @@ func (s *TemplateDefaultListResponse) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfTemplateDefaultListResponse = [3]string{
+var jsonFieldsNameOfTemplateListResponse = [3]string{
 	0: "templates",
 	1: "totalTemplates",
 	2: "totalPages",
 }
 
-// Decode decodes TemplateDefaultListResponse from json.
-func (s *TemplateDefaultListResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateListResponse from json.
+func (s *TemplateListResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateDefaultListResponse to nil")
+		return errors.New("invalid: unable to decode TemplateListResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -3358,9 +10172,9 @@ func (s *TemplateDefaultListResponse) Decode(d *jx.Decoder) error {
 		case "templates":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Templates = make([]TemplateDefaultListResponseTemplatesItem, 0)
+				s.Templates = make([]TemplateListResponseTemplatesItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TemplateDefaultListResponseTemplatesItem
+					var elem TemplateListResponseTemplatesItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -3402,7 +10216,7 @@ func (s *TemplateDefaultListResponse) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateDefaultListResponse")
+		return errors.Wrap(err, "decode TemplateListResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3419,8 +10233,8 @@ func (s *TemplateDefaultListResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateDefaultListResponse) {
-					name = jsonFieldsNameOfTemplateDefaultListResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateListResponse) {
+					name = jsonFieldsNameOfTemplateListResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3441,27 +10255,27 @@ func (s *TemplateDefaultListResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateDefaultListResponse) MarshalJSON() ([]byte, error) {
+func (s *TemplateListResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateDefaultListResponse) UnmarshalJSON(data []byte) error {
+func (s *TemplateListResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateDefaultListResponseTemplatesItem) Encode(e *jx.Encoder) {
+func (s *TemplateListResponseTemplatesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateDefaultListResponseTemplatesItem) encodeFields(e *jx.Encoder) {
+func (s *TemplateListResponseTemplatesItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("id")
 		e.Int64(s.ID)
@@ -3470,6 +10284,10 @@ func (s *TemplateDefaultListResponseTemplatesItem) encodeFields(e *jx.Encoder) {
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
+	{
+		e.FieldStart("authorName")
+		e.Str(s.AuthorName)
+	}
 	{
 		e.FieldStart("createdAt")
 		json.EncodeDateTime(e, s.CreatedAt)
@@ -3482,17 +10300,18 @@ func (s *TemplateDefaultListResponseTemplatesItem) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfTemplateDefaultListResponseTemplatesItem = [4]string{
+var jsonFieldsNameOfTemplateListResponseTemplatesItem = [5]string{
 	0: "id",
 	1: "name",
-	2: "createdAt",
-	3: "updatedAt",
+	2: "authorName",
+	3: "createdAt",
+	4: "updatedAt",
 }
 
-// Decode decodes TemplateDefaultListResponseTemplatesItem from json.
-func (s *TemplateDefaultListResponseTemplatesItem) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateListResponseTemplatesItem from json.
+func (s *TemplateListResponseTemplatesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateDefaultListResponseTemplatesItem to nil")
+		return errors.New("invalid: unable to decode TemplateListResponseTemplatesItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -3522,8 +10341,20 @@ func (s *TemplateDefaultListResponseTemplatesItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "createdAt":
+		case "authorName":
 			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.AuthorName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"authorName\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
 				v, err := json.DecodeDateTime(d)
 				s.CreatedAt = v
@@ -3549,12 +10380,12 @@ func (s *TemplateDefaultListResponseTemplatesItem) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateDefaultListResponseTemplatesItem")
+		return errors.Wrap(err, "decode TemplateListResponseTemplatesItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3566,8 +10397,8 @@ func (s *TemplateDefaultListResponseTemplatesItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateDefaultListResponseTemplatesItem) {
-					name = jsonFieldsNameOfTemplateDefaultListResponseTemplatesItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateListResponseTemplatesItem) {
+					name = jsonFieldsNameOfTemplateListResponseTemplatesItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3588,48 +10419,55 @@ func (s *TemplateDefaultListResponseTemplatesItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateDefaultListResponseTemplatesItem) MarshalJSON() ([]byte, error) {
+func (s *TemplateListResponseTemplatesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateDefaultListResponseTemplatesItem) UnmarshalJSON(data []byte) error {
+func (s *TemplateListResponseTemplatesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateGetByIDResponse) Encode(e *jx.Encoder) {
+func (s *TemplateUpdateRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateGetByIDResponse) encodeFields(e *jx.Encoder) {
+func (s *TemplateUpdateRequest) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		if s.Version.Set {
-			e.FieldStart("version")
-			s.Version.Encode(e)
+		if s.OutputFormat.Set {
+			e.FieldStart("outputFormat")
+			s.OutputFormat.Encode(e)
+		}
+	}
+	{
+		if s.StyleProfile.Set {
+			e.FieldStart("styleProfile")
+			s.StyleProfile.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfTemplateGetByIDResponse = [2]string{
+var jsonFieldsNameOfTemplateUpdateRequest = [3]string{
 	0: "name",
-	1: "version",
+	1: "outputFormat",
+	2: "styleProfile",
 }
 
-// Decode decodes TemplateGetByIDResponse from json.
-func (s *TemplateGetByIDResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateUpdateRequest from json.
+func (s *TemplateUpdateRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateGetByIDResponse to nil")
+		return errors.New("invalid: unable to decode TemplateUpdateRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -3647,22 +10485,32 @@ func (s *TemplateGetByIDResponse) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "version":
+		case "outputFormat":
 			if err := func() error {
-				s.Version.Reset()
-				if err := s.Version.Decode(d); err != nil {
+				s.OutputFormat.Reset()
+				if err := s.OutputFormat.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"version\"")
+				return errors.Wrap(err, "decode field \"outputFormat\"")
+			}
+		case "styleProfile":
+			if err := func() error {
+				s.StyleProfile.Reset()
+				if err := s.StyleProfile.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"styleProfile\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateGetByIDResponse")
+		return errors.Wrap(err, "decode TemplateUpdateRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3679,8 +10527,8 @@ func (s *TemplateGetByIDResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateGetByIDResponse) {
-					name = jsonFieldsNameOfTemplateGetByIDResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateUpdateRequest) {
+					name = jsonFieldsNameOfTemplateUpdateRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3701,147 +10549,190 @@ func (s *TemplateGetByIDResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateGetByIDResponse) MarshalJSON() ([]byte, error) {
+func (s *TemplateUpdateRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateGetByIDResponse) UnmarshalJSON(data []byte) error {
+func (s *TemplateUpdateRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateGetByIDVersion) Encode(e *jx.Encoder) {
+func (s *TemplateUpdateUsersRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateGetByIDVersion) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
-	{
-		e.FieldStart("number")
-		e.Int64(s.Number)
-	}
-	{
-		e.FieldStart("createdAt")
-		json.EncodeDateTime(e, s.CreatedAt)
-	}
-	{
-		e.FieldStart("data")
-		e.Base64(s.Data)
-	}
+func (s *TemplateUpdateUsersRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("variables")
+		e.FieldStart("users")
 		e.ArrStart()
-		for _, elem := range s.Variables {
+		for _, elem := range s.Users {
 			elem.Encode(e)
 		}
 		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTemplateGetByIDVersion = [5]string{
-	0: "id",
-	1: "number",
-	2: "createdAt",
-	3: "data",
-	4: "variables",
+var jsonFieldsNameOfTemplateUpdateUsersRequest = [1]string{
+	0: "users",
 }
 
-// Decode decodes TemplateGetByIDVersion from json.
-func (s *TemplateGetByIDVersion) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateUpdateUsersRequest from json.
+func (s *TemplateUpdateUsersRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateGetByIDVersion to nil")
+		return errors.New("invalid: unable to decode TemplateUpdateUsersRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "users":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "number":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int64()
-				s.Number = int64(v)
-				if err != nil {
+				s.Users = make([]TemplateUpdateUsersRequestUsersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TemplateUpdateUsersRequestUsersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Users = append(s.Users, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"number\"")
+				return errors.Wrap(err, "decode field \"users\"")
 			}
-		case "createdAt":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
-				if err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TemplateUpdateUsersRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTemplateUpdateUsersRequest) {
+					name = jsonFieldsNameOfTemplateUpdateUsersRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "data":
-			requiredBitSet[0] |= 1 << 3
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TemplateUpdateUsersRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TemplateUpdateUsersRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TemplateUpdateUsersRequestUsersItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TemplateUpdateUsersRequestUsersItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("role")
+		s.Role.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfTemplateUpdateUsersRequestUsersItem = [2]string{
+	0: "id",
+	1: "role",
+}
+
+// Decode decodes TemplateUpdateUsersRequestUsersItem from json.
+func (s *TemplateUpdateUsersRequestUsersItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TemplateUpdateUsersRequestUsersItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Base64()
-				s.Data = []byte(v)
+				v, err := d.Int64()
+				s.ID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "variables":
-			requiredBitSet[0] |= 1 << 4
+		case "role":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Variables = make([]TemplateGetByIDVersionVariablesItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TemplateGetByIDVersionVariablesItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Variables = append(s.Variables, elem)
-					return nil
-				}); err != nil {
+				if err := s.Role.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"variables\"")
+				return errors.Wrap(err, "decode field \"role\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateGetByIDVersion")
+		return errors.Wrap(err, "decode TemplateUpdateUsersRequestUsersItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00011111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3853,8 +10744,8 @@ func (s *TemplateGetByIDVersion) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateGetByIDVersion) {
-					name = jsonFieldsNameOfTemplateGetByIDVersion[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateUpdateUsersRequestUsersItem) {
+					name = jsonFieldsNameOfTemplateUpdateUsersRequestUsersItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3875,179 +10766,119 @@ func (s *TemplateGetByIDVersion) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateGetByIDVersion) MarshalJSON() ([]byte, error) {
+func (s *TemplateUpdateUsersRequestUsersItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateGetByIDVersion) UnmarshalJSON(data []byte) error {
+func (s *TemplateUpdateUsersRequestUsersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TemplateUpdateUsersRequestUsersItemRole as json.
+func (s TemplateUpdateUsersRequestUsersItemRole) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes TemplateUpdateUsersRequestUsersItemRole from json.
+func (s *TemplateUpdateUsersRequestUsersItemRole) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TemplateUpdateUsersRequestUsersItemRole to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch TemplateUpdateUsersRequestUsersItemRole(v) {
+	case TemplateUpdateUsersRequestUsersItemRoleRead:
+		*s = TemplateUpdateUsersRequestUsersItemRoleRead
+	case TemplateUpdateUsersRequestUsersItemRoleWrite:
+		*s = TemplateUpdateUsersRequestUsersItemRoleWrite
+	default:
+		*s = TemplateUpdateUsersRequestUsersItemRole(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TemplateUpdateUsersRequestUsersItemRole) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TemplateUpdateUsersRequestUsersItemRole) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateGetByIDVersionVariablesItem) Encode(e *jx.Encoder) {
+func (s *TemplateUsersResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateGetByIDVersionVariablesItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		e.FieldStart("title")
-		e.Str(s.Title)
-	}
-	{
-		e.FieldStart("type")
-		s.Type.Encode(e)
-	}
-	{
-		if s.Expression.Set {
-			e.FieldStart("expression")
-			s.Expression.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("isInput")
-		e.Bool(s.IsInput)
-	}
+func (s *TemplateUsersResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("constraints")
+		e.FieldStart("users")
 		e.ArrStart()
-		for _, elem := range s.Constraints {
+		for _, elem := range s.Users {
 			elem.Encode(e)
 		}
 		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTemplateGetByIDVersionVariablesItem = [7]string{
-	0: "id",
-	1: "name",
-	2: "title",
-	3: "type",
-	4: "expression",
-	5: "isInput",
-	6: "constraints",
+var jsonFieldsNameOfTemplateUsersResponse = [1]string{
+	0: "users",
 }
 
-// Decode decodes TemplateGetByIDVersionVariablesItem from json.
-func (s *TemplateGetByIDVersionVariablesItem) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateUsersResponse from json.
+func (s *TemplateUsersResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateGetByIDVersionVariablesItem to nil")
+		return errors.New("invalid: unable to decode TemplateUsersResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "users":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "title":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Title = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
-			}
-		case "type":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				if err := s.Type.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
-			}
-		case "expression":
-			if err := func() error {
-				s.Expression.Reset()
-				if err := s.Expression.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expression\"")
-			}
-		case "isInput":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Bool()
-				s.IsInput = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"isInput\"")
-			}
-		case "constraints":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				s.Constraints = make([]TemplateGetByIDVersionVariablesItemConstraintsItem, 0)
+				s.Users = make([]TemplateUsersResponseUsersItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TemplateGetByIDVersionVariablesItemConstraintsItem
+					var elem TemplateUsersResponseUsersItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Constraints = append(s.Constraints, elem)
+					s.Users = append(s.Users, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"constraints\"")
+				return errors.Wrap(err, "decode field \"users\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateGetByIDVersionVariablesItem")
+		return errors.Wrap(err, "decode TemplateUsersResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01101111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4059,8 +10890,8 @@ func (s *TemplateGetByIDVersionVariablesItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateGetByIDVersionVariablesItem) {
-					name = jsonFieldsNameOfTemplateGetByIDVersionVariablesItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateUsersResponse) {
+					name = jsonFieldsNameOfTemplateUsersResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4081,27 +10912,27 @@ func (s *TemplateGetByIDVersionVariablesItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateGetByIDVersionVariablesItem) MarshalJSON() ([]byte, error) {
+func (s *TemplateUsersResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateGetByIDVersionVariablesItem) UnmarshalJSON(data []byte) error {
+func (s *TemplateUsersResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) Encode(e *jx.Encoder) {
+func (s *TemplateUsersResponseUsersItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) encodeFields(e *jx.Encoder) {
+func (s *TemplateUsersResponseUsersItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("id")
 		e.Int64(s.ID)
@@ -4111,26 +10942,26 @@ func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) encodeFields(e *jx.
 		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("expression")
-		e.Str(s.Expression)
+		e.FieldStart("email")
+		e.Str(s.Email)
 	}
 	{
-		e.FieldStart("isActive")
-		e.Bool(s.IsActive)
+		e.FieldStart("role")
+		s.Role.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfTemplateGetByIDVersionVariablesItemConstraintsItem = [4]string{
+var jsonFieldsNameOfTemplateUsersResponseUsersItem = [4]string{
 	0: "id",
 	1: "name",
-	2: "expression",
-	3: "isActive",
+	2: "email",
+	3: "role",
 }
 
-// Decode decodes TemplateGetByIDVersionVariablesItemConstraintsItem from json.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateUsersResponseUsersItem from json.
+func (s *TemplateUsersResponseUsersItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateGetByIDVersionVariablesItemConstraintsItem to nil")
+		return errors.New("invalid: unable to decode TemplateUsersResponseUsersItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -4160,36 +10991,34 @@ func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) Decode(d *jx.Decode
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "expression":
+		case "email":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.Expression = string(v)
+				s.Email = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expression\"")
+				return errors.Wrap(err, "decode field \"email\"")
 			}
-		case "isActive":
+		case "role":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := d.Bool()
-				s.IsActive = bool(v)
-				if err != nil {
+				if err := s.Role.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"isActive\"")
+				return errors.Wrap(err, "decode field \"role\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateGetByIDVersionVariablesItemConstraintsItem")
+		return errors.Wrap(err, "decode TemplateUsersResponseUsersItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -4206,8 +11035,8 @@ func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) Decode(d *jx.Decode
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateGetByIDVersionVariablesItemConstraintsItem) {
-					name = jsonFieldsNameOfTemplateGetByIDVersionVariablesItemConstraintsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTemplateUsersResponseUsersItem) {
+					name = jsonFieldsNameOfTemplateUsersResponseUsersItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4228,224 +11057,340 @@ func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) Decode(d *jx.Decode
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) MarshalJSON() ([]byte, error) {
+func (s *TemplateUsersResponseUsersItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) UnmarshalJSON(data []byte) error {
+func (s *TemplateUsersResponseUsersItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TemplateGetByIDVersionVariablesItemType as json.
-func (s TemplateGetByIDVersionVariablesItemType) Encode(e *jx.Encoder) {
+// Encode encodes TemplateUsersResponseUsersItemRole as json.
+func (s TemplateUsersResponseUsersItemRole) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes TemplateGetByIDVersionVariablesItemType from json.
-func (s *TemplateGetByIDVersionVariablesItemType) Decode(d *jx.Decoder) error {
+// Decode decodes TemplateUsersResponseUsersItemRole from json.
+func (s *TemplateUsersResponseUsersItemRole) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateGetByIDVersionVariablesItemType to nil")
+		return errors.New("invalid: unable to decode TemplateUsersResponseUsersItemRole to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch TemplateGetByIDVersionVariablesItemType(v) {
-	case TemplateGetByIDVersionVariablesItemTypeString:
-		*s = TemplateGetByIDVersionVariablesItemTypeString
-	case TemplateGetByIDVersionVariablesItemTypeInteger:
-		*s = TemplateGetByIDVersionVariablesItemTypeInteger
-	case TemplateGetByIDVersionVariablesItemTypeFloat:
-		*s = TemplateGetByIDVersionVariablesItemTypeFloat
+	switch TemplateUsersResponseUsersItemRole(v) {
+	case TemplateUsersResponseUsersItemRoleRead:
+		*s = TemplateUsersResponseUsersItemRoleRead
+	case TemplateUsersResponseUsersItemRoleWrite:
+		*s = TemplateUsersResponseUsersItemRoleWrite
 	default:
-		*s = TemplateGetByIDVersionVariablesItemType(v)
+		*s = TemplateUsersResponseUsersItemRole(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s TemplateGetByIDVersionVariablesItemType) MarshalJSON() ([]byte, error) {
+func (s TemplateUsersResponseUsersItemRole) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateGetByIDVersionVariablesItemType) UnmarshalJSON(data []byte) error {
+func (s *TemplateUsersResponseUsersItemRole) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *TemplateGetMetaByIDResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *TemplateGetMetaByIDResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+// Encode encodes Trace as json.
+func (s Trace) Encode(e *jx.Encoder) {
+	unwrapped := []TraceItem(s)
+	if unwrapped == nil {
+		e.ArrEmpty()
+		return
+	}
+	if unwrapped != nil {
+		e.ArrStart()
+		for _, elem := range unwrapped {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTemplateGetMetaByIDResponse = [1]string{
-	0: "name",
-}
-
-// Decode decodes TemplateGetMetaByIDResponse from json.
-func (s *TemplateGetMetaByIDResponse) Decode(d *jx.Decoder) error {
+// Decode decodes Trace from json.
+func (s *Trace) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateGetMetaByIDResponse to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+		return errors.New("invalid: unable to decode Trace to nil")
+	}
+	var unwrapped []TraceItem
+	if err := func() error {
+		unwrapped = make([]TraceItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem TraceItem
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode TemplateGetMetaByIDResponse")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateGetMetaByIDResponse) {
-					name = jsonFieldsNameOfTemplateGetMetaByIDResponse[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = Trace(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateGetMetaByIDResponse) MarshalJSON() ([]byte, error) {
+func (s Trace) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateGetMetaByIDResponse) UnmarshalJSON(data []byte) error {
+func (s *Trace) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateImportPayload) Encode(e *jx.Encoder) {
+func (s *TraceItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateImportPayload) encodeFields(e *jx.Encoder) {
+func (s *TraceItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("order")
+		e.Int(s.Order)
+	}
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		if s.Version.Set {
-			e.FieldStart("version")
-			s.Version.Encode(e)
+		if s.Source.Set {
+			e.FieldStart("source")
+			s.Source.Encode(e)
+		}
+	}
+	{
+		if s.Condition.Set {
+			e.FieldStart("condition")
+			s.Condition.Encode(e)
+		}
+	}
+	{
+		if s.Expression.Set {
+			e.FieldStart("expression")
+			s.Expression.Encode(e)
+		}
+	}
+	{
+		if s.Dependencies != nil {
+			e.FieldStart("dependencies")
+			e.ArrStart()
+			for _, elem := range s.Dependencies {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Value.Set {
+			e.FieldStart("value")
+			s.Value.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.Constraints != nil {
+			e.FieldStart("constraints")
+			e.ArrStart()
+			for _, elem := range s.Constraints {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfTemplateImportPayload = [2]string{
-	0: "name",
-	1: "version",
+var jsonFieldsNameOfTraceItem = [10]string{
+	0: "order",
+	1: "name",
+	2: "source",
+	3: "condition",
+	4: "expression",
+	5: "dependencies",
+	6: "value",
+	7: "type",
+	8: "message",
+	9: "constraints",
 }
 
-// Decode decodes TemplateImportPayload from json.
-func (s *TemplateImportPayload) Decode(d *jx.Decoder) error {
+// Decode decodes TraceItem from json.
+func (s *TraceItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateImportPayload to nil")
+		return errors.New("invalid: unable to decode TraceItem to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "order":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				v, err := d.Int()
+				s.Order = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"order\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "source":
+			if err := func() error {
+				s.Source.Reset()
+				if err := s.Source.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source\"")
+			}
+		case "condition":
+			if err := func() error {
+				s.Condition.Reset()
+				if err := s.Condition.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"condition\"")
+			}
+		case "expression":
+			if err := func() error {
+				s.Expression.Reset()
+				if err := s.Expression.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expression\"")
+			}
+		case "dependencies":
+			if err := func() error {
+				s.Dependencies = make([]TraceItemDependenciesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TraceItemDependenciesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Dependencies = append(s.Dependencies, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dependencies\"")
+			}
+		case "value":
+			if err := func() error {
+				s.Value.Reset()
+				if err := s.Value.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"message\"")
 			}
-		case "version":
+		case "constraints":
 			if err := func() error {
-				s.Version.Reset()
-				if err := s.Version.Decode(d); err != nil {
+				s.Constraints = make([]TraceItemConstraintsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TraceItemConstraintsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Constraints = append(s.Constraints, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"version\"")
+				return errors.Wrap(err, "decode field \"constraints\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateImportPayload")
+		return errors.Wrap(err, "decode TraceItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
+	for i, mask := range [2]uint8{
+		0b00000011,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4457,8 +11402,8 @@ func (s *TemplateImportPayload) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateImportPayload) {
-					name = jsonFieldsNameOfTemplateImportPayload[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTraceItem) {
+					name = jsonFieldsNameOfTraceItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4479,84 +11424,120 @@ func (s *TemplateImportPayload) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateImportPayload) MarshalJSON() ([]byte, error) {
+func (s *TraceItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateImportPayload) UnmarshalJSON(data []byte) error {
+func (s *TraceItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateImportRequest) Encode(e *jx.Encoder) {
+func (s *TraceItemConstraintsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateImportRequest) encodeFields(e *jx.Encoder) {
+func (s *TraceItemConstraintsItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("projectID")
-		e.Int64(s.ProjectID)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("template")
-		s.Template.Encode(e)
+		e.FieldStart("expression")
+		e.Str(s.Expression)
+	}
+	{
+		e.FieldStart("passed")
+		e.Bool(s.Passed)
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfTemplateImportRequest = [2]string{
-	0: "projectID",
-	1: "template",
+var jsonFieldsNameOfTraceItemConstraintsItem = [4]string{
+	0: "name",
+	1: "expression",
+	2: "passed",
+	3: "message",
 }
 
-// Decode decodes TemplateImportRequest from json.
-func (s *TemplateImportRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TraceItemConstraintsItem from json.
+func (s *TraceItemConstraintsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateImportRequest to nil")
+		return errors.New("invalid: unable to decode TraceItemConstraintsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "projectID":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ProjectID = int64(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"projectID\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "template":
+		case "expression":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Template.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Expression = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"template\"")
+				return errors.Wrap(err, "decode field \"expression\"")
+			}
+		case "passed":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Bool()
+				s.Passed = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"passed\"")
+			}
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateImportRequest")
+		return errors.Wrap(err, "decode TraceItemConstraintsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4568,8 +11549,8 @@ func (s *TemplateImportRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateImportRequest) {
-					name = jsonFieldsNameOfTemplateImportRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTraceItemConstraintsItem) {
+					name = jsonFieldsNameOfTraceItemConstraintsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4590,64 +11571,98 @@ func (s *TemplateImportRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateImportRequest) MarshalJSON() ([]byte, error) {
+func (s *TraceItemConstraintsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateImportRequest) UnmarshalJSON(data []byte) error {
+func (s *TraceItemConstraintsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateImportResponse) Encode(e *jx.Encoder) {
+func (s *TraceItemDependenciesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateImportResponse) encodeFields(e *jx.Encoder) {
+func (s *TraceItemDependenciesItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Value.Set {
+			e.FieldStart("value")
+			s.Value.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfTemplateImportResponse = [1]string{
-	0: "id",
+var jsonFieldsNameOfTraceItemDependenciesItem = [3]string{
+	0: "name",
+	1: "value",
+	2: "type",
 }
 
-// Decode decodes TemplateImportResponse from json.
-func (s *TemplateImportResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TraceItemDependenciesItem from json.
+func (s *TraceItemDependenciesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateImportResponse to nil")
+		return errors.New("invalid: unable to decode TraceItemDependenciesItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "value":
+			if err := func() error {
+				s.Value.Reset()
+				if err := s.Value.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateImportResponse")
+		return errors.Wrap(err, "decode TraceItemDependenciesItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -4664,8 +11679,8 @@ func (s *TemplateImportResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateImportResponse) {
-					name = jsonFieldsNameOfTemplateImportResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTraceItemDependenciesItem) {
+					name = jsonFieldsNameOfTraceItemDependenciesItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4686,195 +11701,95 @@ func (s *TemplateImportResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateImportResponse) MarshalJSON() ([]byte, error) {
+func (s *TraceItemDependenciesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateImportResponse) UnmarshalJSON(data []byte) error {
+func (s *TraceItemDependenciesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *TemplateImportVersion) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *TemplateImportVersion) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("data")
-		e.Base64(s.Data)
-	}
-	{
-		e.FieldStart("variables")
-		e.ArrStart()
-		for _, elem := range s.Variables {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
-	}
-}
-
-var jsonFieldsNameOfTemplateImportVersion = [2]string{
-	0: "data",
-	1: "variables",
+// Encode encodes TraceItemSource as json.
+func (s TraceItemSource) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes TemplateImportVersion from json.
-func (s *TemplateImportVersion) Decode(d *jx.Decoder) error {
+// Decode decodes TraceItemSource from json.
+func (s *TraceItemSource) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateImportVersion to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "data":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Base64()
-				s.Data = []byte(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
-			}
-		case "variables":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				s.Variables = make([]TemplateImportVersionVariablesItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TemplateImportVersionVariablesItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Variables = append(s.Variables, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"variables\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode TemplateImportVersion")
+		return errors.New("invalid: unable to decode TraceItemSource to nil")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateImportVersion) {
-					name = jsonFieldsNameOfTemplateImportVersion[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	// Try to use constant string.
+	switch TraceItemSource(v) {
+	case TraceItemSourcePayload:
+		*s = TraceItemSourcePayload
+	case TraceItemSourceDefault:
+		*s = TraceItemSourceDefault
+	case TraceItemSourceExpression:
+		*s = TraceItemSourceExpression
+	case TraceItemSourceDisabled:
+		*s = TraceItemSourceDisabled
+	default:
+		*s = TraceItemSource(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateImportVersion) MarshalJSON() ([]byte, error) {
+func (s TraceItemSource) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateImportVersion) UnmarshalJSON(data []byte) error {
+func (s *TraceItemSource) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateImportVersionVariablesItem) Encode(e *jx.Encoder) {
+func (s *UserCreateRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateImportVersionVariablesItem) encodeFields(e *jx.Encoder) {
+func (s *UserCreateRequest) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("title")
-		e.Str(s.Title)
-	}
-	{
-		e.FieldStart("type")
-		s.Type.Encode(e)
-	}
-	{
-		if s.Expression.Set {
-			e.FieldStart("expression")
-			s.Expression.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("isInput")
-		e.Bool(s.IsInput)
+		e.FieldStart("email")
+		e.Str(s.Email)
 	}
 	{
-		e.FieldStart("constraints")
-		e.ArrStart()
-		for _, elem := range s.Constraints {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("password")
+		e.Str(s.Password)
 	}
 }
 
-var jsonFieldsNameOfTemplateImportVersionVariablesItem = [6]string{
+var jsonFieldsNameOfUserCreateRequest = [3]string{
 	0: "name",
-	1: "title",
-	2: "type",
-	3: "expression",
-	4: "isInput",
-	5: "constraints",
+	1: "email",
+	2: "password",
 }
 
-// Decode decodes TemplateImportVersionVariablesItem from json.
-func (s *TemplateImportVersionVariablesItem) Decode(d *jx.Decoder) error {
+// Decode decodes UserCreateRequest from json.
+func (s *UserCreateRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateImportVersionVariablesItem to nil")
+		return errors.New("invalid: unable to decode UserCreateRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -4892,79 +11807,41 @@ func (s *TemplateImportVersionVariablesItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "title":
+		case "email":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Title = string(v)
+				s.Email = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
+				return errors.Wrap(err, "decode field \"email\"")
 			}
-		case "type":
+		case "password":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				if err := s.Type.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
-			}
-		case "expression":
-			if err := func() error {
-				s.Expression.Reset()
-				if err := s.Expression.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expression\"")
-			}
-		case "isInput":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Bool()
-				s.IsInput = bool(v)
+				v, err := d.Str()
+				s.Password = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"isInput\"")
-			}
-		case "constraints":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				s.Constraints = make([]TemplateImportVersionVariablesItemConstraintsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TemplateImportVersionVariablesItemConstraintsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Constraints = append(s.Constraints, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"constraints\"")
+				return errors.Wrap(err, "decode field \"password\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateImportVersionVariablesItem")
+		return errors.Wrap(err, "decode UserCreateRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00110111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4976,8 +11853,8 @@ func (s *TemplateImportVersionVariablesItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateImportVersionVariablesItem) {
-					name = jsonFieldsNameOfTemplateImportVersionVariablesItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserCreateRequest) {
+					name = jsonFieldsNameOfUserCreateRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4998,58 +11875,75 @@ func (s *TemplateImportVersionVariablesItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateImportVersionVariablesItem) MarshalJSON() ([]byte, error) {
+func (s *UserCreateRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateImportVersionVariablesItem) UnmarshalJSON(data []byte) error {
+func (s *UserCreateRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateImportVersionVariablesItemConstraintsItem) Encode(e *jx.Encoder) {
+func (s *UserGetByIDResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateImportVersionVariablesItemConstraintsItem) encodeFields(e *jx.Encoder) {
+func (s *UserGetByIDResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("expression")
-		e.Str(s.Expression)
+		e.FieldStart("email")
+		e.Str(s.Email)
 	}
 	{
-		e.FieldStart("isActive")
-		e.Bool(s.IsActive)
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 }
 
-var jsonFieldsNameOfTemplateImportVersionVariablesItemConstraintsItem = [3]string{
-	0: "name",
-	1: "expression",
-	2: "isActive",
+var jsonFieldsNameOfUserGetByIDResponse = [4]string{
+	0: "id",
+	1: "name",
+	2: "email",
+	3: "createdAt",
 }
 
-// Decode decodes TemplateImportVersionVariablesItemConstraintsItem from json.
-func (s *TemplateImportVersionVariablesItemConstraintsItem) Decode(d *jx.Decoder) error {
+// Decode decodes UserGetByIDResponse from json.
+func (s *UserGetByIDResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateImportVersionVariablesItemConstraintsItem to nil")
+		return errors.New("invalid: unable to decode UserGetByIDResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
 				s.Name = string(v)
@@ -5060,41 +11954,41 @@ func (s *TemplateImportVersionVariablesItemConstraintsItem) Decode(d *jx.Decoder
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "expression":
-			requiredBitSet[0] |= 1 << 1
+		case "email":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.Expression = string(v)
+				s.Email = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expression\"")
+				return errors.Wrap(err, "decode field \"email\"")
 			}
-		case "isActive":
-			requiredBitSet[0] |= 1 << 2
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := d.Bool()
-				s.IsActive = bool(v)
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"isActive\"")
+				return errors.Wrap(err, "decode field \"createdAt\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateImportVersionVariablesItemConstraintsItem")
+		return errors.Wrap(err, "decode UserGetByIDResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5106,8 +12000,8 @@ func (s *TemplateImportVersionVariablesItemConstraintsItem) Decode(d *jx.Decoder
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateImportVersionVariablesItemConstraintsItem) {
-					name = jsonFieldsNameOfTemplateImportVersionVariablesItemConstraintsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserGetByIDResponse) {
+					name = jsonFieldsNameOfUserGetByIDResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5128,80 +12022,38 @@ func (s *TemplateImportVersionVariablesItemConstraintsItem) Decode(d *jx.Decoder
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateImportVersionVariablesItemConstraintsItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateImportVersionVariablesItemConstraintsItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes TemplateImportVersionVariablesItemType as json.
-func (s TemplateImportVersionVariablesItemType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes TemplateImportVersionVariablesItemType from json.
-func (s *TemplateImportVersionVariablesItemType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode TemplateImportVersionVariablesItemType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch TemplateImportVersionVariablesItemType(v) {
-	case TemplateImportVersionVariablesItemTypeString:
-		*s = TemplateImportVersionVariablesItemTypeString
-	case TemplateImportVersionVariablesItemTypeInteger:
-		*s = TemplateImportVersionVariablesItemTypeInteger
-	case TemplateImportVersionVariablesItemTypeFloat:
-		*s = TemplateImportVersionVariablesItemTypeFloat
-	default:
-		*s = TemplateImportVersionVariablesItemType(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s TemplateImportVersionVariablesItemType) MarshalJSON() ([]byte, error) {
+func (s *UserGetByIDResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateImportVersionVariablesItemType) UnmarshalJSON(data []byte) error {
+func (s *UserGetByIDResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateListResponse) Encode(e *jx.Encoder) {
+func (s *UserListResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateListResponse) encodeFields(e *jx.Encoder) {
+func (s *UserListResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("templates")
+		e.FieldStart("users")
 		e.ArrStart()
-		for _, elem := range s.Templates {
+		for _, elem := range s.Users {
 			elem.Encode(e)
 		}
 		e.ArrEnd()
 	}
 	{
-		e.FieldStart("totalTemplates")
-		e.Int64(s.TotalTemplates)
+		e.FieldStart("totalUsers")
+		e.Int64(s.TotalUsers)
 	}
 	{
 		e.FieldStart("totalPages")
@@ -5209,50 +12061,50 @@ func (s *TemplateListResponse) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfTemplateListResponse = [3]string{
-	0: "templates",
-	1: "totalTemplates",
+var jsonFieldsNameOfUserListResponse = [3]string{
+	0: "users",
+	1: "totalUsers",
 	2: "totalPages",
 }
 
-// Decode decodes TemplateListResponse from json.
-func (s *TemplateListResponse) Decode(d *jx.Decoder) error {
+// Decode decodes UserListResponse from json.
+func (s *UserListResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateListResponse to nil")
+		return errors.New("invalid: unable to decode UserListResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "templates":
+		case "users":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Templates = make([]TemplateListResponseTemplatesItem, 0)
+				s.Users = make([]UserListResponseUsersItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TemplateListResponseTemplatesItem
+					var elem UserListResponseUsersItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Templates = append(s.Templates, elem)
+					s.Users = append(s.Users, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"templates\"")
+				return errors.Wrap(err, "decode field \"users\"")
 			}
-		case "totalTemplates":
+		case "totalUsers":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Int64()
-				s.TotalTemplates = int64(v)
+				s.TotalUsers = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"totalTemplates\"")
+				return errors.Wrap(err, "decode field \"totalUsers\"")
 			}
 		case "totalPages":
 			requiredBitSet[0] |= 1 << 2
@@ -5271,7 +12123,7 @@ func (s *TemplateListResponse) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateListResponse")
+		return errors.Wrap(err, "decode UserListResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -5288,8 +12140,8 @@ func (s *TemplateListResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateListResponse) {
-					name = jsonFieldsNameOfTemplateListResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserListResponse) {
+					name = jsonFieldsNameOfUserListResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5310,27 +12162,27 @@ func (s *TemplateListResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateListResponse) MarshalJSON() ([]byte, error) {
+func (s *UserListResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateListResponse) UnmarshalJSON(data []byte) error {
+func (s *UserListResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateListResponseTemplatesItem) Encode(e *jx.Encoder) {
+func (s *UserListResponseUsersItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateListResponseTemplatesItem) encodeFields(e *jx.Encoder) {
+func (s *UserListResponseUsersItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("id")
 		e.Int64(s.ID)
@@ -5340,33 +12192,21 @@ func (s *TemplateListResponseTemplatesItem) encodeFields(e *jx.Encoder) {
 		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("authorName")
-		e.Str(s.AuthorName)
-	}
-	{
-		e.FieldStart("createdAt")
-		json.EncodeDateTime(e, s.CreatedAt)
-	}
-	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updatedAt")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("email")
+		e.Str(s.Email)
 	}
 }
 
-var jsonFieldsNameOfTemplateListResponseTemplatesItem = [5]string{
+var jsonFieldsNameOfUserListResponseUsersItem = [3]string{
 	0: "id",
 	1: "name",
-	2: "authorName",
-	3: "createdAt",
-	4: "updatedAt",
+	2: "email",
 }
 
-// Decode decodes TemplateListResponseTemplatesItem from json.
-func (s *TemplateListResponseTemplatesItem) Decode(d *jx.Decoder) error {
+// Decode decodes UserListResponseUsersItem from json.
+func (s *UserListResponseUsersItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateListResponseTemplatesItem to nil")
+		return errors.New("invalid: unable to decode UserListResponseUsersItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -5396,51 +12236,29 @@ func (s *TemplateListResponseTemplatesItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "authorName":
+		case "email":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.AuthorName = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"authorName\"")
-			}
-		case "createdAt":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				s.Email = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
-			}
-		case "updatedAt":
-			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updatedAt\"")
+				return errors.Wrap(err, "decode field \"email\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateListResponseTemplatesItem")
+		return errors.Wrap(err, "decode UserListResponseUsersItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5452,8 +12270,8 @@ func (s *TemplateListResponseTemplatesItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateListResponseTemplatesItem) {
-					name = jsonFieldsNameOfTemplateListResponseTemplatesItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserListResponseUsersItem) {
+					name = jsonFieldsNameOfUserListResponseUsersItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5474,41 +12292,51 @@ func (s *TemplateListResponseTemplatesItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateListResponseTemplatesItem) MarshalJSON() ([]byte, error) {
+func (s *UserListResponseUsersItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateListResponseTemplatesItem) UnmarshalJSON(data []byte) error {
+func (s *UserListResponseUsersItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateUpdateRequest) Encode(e *jx.Encoder) {
+func (s *UserTokenCreateRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateUpdateRequest) encodeFields(e *jx.Encoder) {
+func (s *UserTokenCreateRequest) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+	{
+		e.FieldStart("remember")
+		e.Bool(s.Remember)
+	}
 }
 
-var jsonFieldsNameOfTemplateUpdateRequest = [1]string{
+var jsonFieldsNameOfUserTokenCreateRequest = [3]string{
 	0: "name",
+	1: "password",
+	2: "remember",
 }
 
-// Decode decodes TemplateUpdateRequest from json.
-func (s *TemplateUpdateRequest) Decode(d *jx.Decoder) error {
+// Decode decodes UserTokenCreateRequest from json.
+func (s *UserTokenCreateRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateUpdateRequest to nil")
+		return errors.New("invalid: unable to decode UserTokenCreateRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -5526,123 +12354,41 @@ func (s *TemplateUpdateRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode TemplateUpdateRequest")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateUpdateRequest) {
-					name = jsonFieldsNameOfTemplateUpdateRequest[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "password":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateUpdateRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateUpdateRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *TemplateUpdateUsersRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *TemplateUpdateUsersRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("users")
-		e.ArrStart()
-		for _, elem := range s.Users {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
-	}
-}
-
-var jsonFieldsNameOfTemplateUpdateUsersRequest = [1]string{
-	0: "users",
-}
-
-// Decode decodes TemplateUpdateUsersRequest from json.
-func (s *TemplateUpdateUsersRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode TemplateUpdateUsersRequest to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "users":
-			requiredBitSet[0] |= 1 << 0
+		case "remember":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Users = make([]TemplateUpdateUsersRequestUsersItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TemplateUpdateUsersRequestUsersItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Users = append(s.Users, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Bool()
+				s.Remember = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"users\"")
+				return errors.Wrap(err, "decode field \"remember\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateUpdateUsersRequest")
+		return errors.Wrap(err, "decode UserTokenCreateRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5654,8 +12400,8 @@ func (s *TemplateUpdateUsersRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateUpdateUsersRequest) {
-					name = jsonFieldsNameOfTemplateUpdateUsersRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserTokenCreateRequest) {
+					name = jsonFieldsNameOfUserTokenCreateRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5676,79 +12422,81 @@ func (s *TemplateUpdateUsersRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateUpdateUsersRequest) MarshalJSON() ([]byte, error) {
+func (s *UserTokenCreateRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateUpdateUsersRequest) UnmarshalJSON(data []byte) error {
+func (s *UserTokenCreateRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateUpdateUsersRequestUsersItem) Encode(e *jx.Encoder) {
+func (s *VersionCreateFromRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateUpdateUsersRequestUsersItem) encodeFields(e *jx.Encoder) {
+func (s *VersionCreateFromRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
+		e.FieldStart("templateID")
+		e.Int64(s.TemplateID)
 	}
 	{
-		e.FieldStart("role")
-		s.Role.Encode(e)
+		e.FieldStart("versionID")
+		e.Int64(s.VersionID)
 	}
 }
 
-var jsonFieldsNameOfTemplateUpdateUsersRequestUsersItem = [2]string{
-	0: "id",
-	1: "role",
+var jsonFieldsNameOfVersionCreateFromRequest = [2]string{
+	0: "templateID",
+	1: "versionID",
 }
 
-// Decode decodes TemplateUpdateUsersRequestUsersItem from json.
-func (s *TemplateUpdateUsersRequestUsersItem) Decode(d *jx.Decoder) error {
+// Decode decodes VersionCreateFromRequest from json.
+func (s *VersionCreateFromRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateUpdateUsersRequestUsersItem to nil")
+		return errors.New("invalid: unable to decode VersionCreateFromRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "templateID":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int64()
-				s.ID = int64(v)
+				s.TemplateID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"templateID\"")
 			}
-		case "role":
+		case "versionID":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Role.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.VersionID = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"role\"")
+				return errors.Wrap(err, "decode field \"versionID\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateUpdateUsersRequestUsersItem")
+		return errors.Wrap(err, "decode VersionCreateFromRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -5765,8 +12513,8 @@ func (s *TemplateUpdateUsersRequestUsersItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateUpdateUsersRequestUsersItem) {
-					name = jsonFieldsNameOfTemplateUpdateUsersRequestUsersItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionCreateFromRequest) {
+					name = jsonFieldsNameOfVersionCreateFromRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5787,119 +12535,113 @@ func (s *TemplateUpdateUsersRequestUsersItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateUpdateUsersRequestUsersItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateUpdateUsersRequestUsersItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes TemplateUpdateUsersRequestUsersItemRole as json.
-func (s TemplateUpdateUsersRequestUsersItemRole) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes TemplateUpdateUsersRequestUsersItemRole from json.
-func (s *TemplateUpdateUsersRequestUsersItemRole) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode TemplateUpdateUsersRequestUsersItemRole to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch TemplateUpdateUsersRequestUsersItemRole(v) {
-	case TemplateUpdateUsersRequestUsersItemRoleRead:
-		*s = TemplateUpdateUsersRequestUsersItemRoleRead
-	case TemplateUpdateUsersRequestUsersItemRoleWrite:
-		*s = TemplateUpdateUsersRequestUsersItemRoleWrite
-	default:
-		*s = TemplateUpdateUsersRequestUsersItemRole(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s TemplateUpdateUsersRequestUsersItemRole) MarshalJSON() ([]byte, error) {
+func (s *VersionCreateFromRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateUpdateUsersRequestUsersItemRole) UnmarshalJSON(data []byte) error {
+func (s *VersionCreateFromRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateUsersResponse) Encode(e *jx.Encoder) {
+func (s *VersionCreateRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateUsersResponse) encodeFields(e *jx.Encoder) {
+func (s *VersionCreateRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("users")
+		e.FieldStart("templateID")
+		e.Int64(s.TemplateID)
+	}
+	{
+		e.FieldStart("data")
+		e.Base64(s.Data)
+	}
+	{
+		e.FieldStart("variables")
 		e.ArrStart()
-		for _, elem := range s.Users {
+		for _, elem := range s.Variables {
 			elem.Encode(e)
 		}
 		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTemplateUsersResponse = [1]string{
-	0: "users",
+var jsonFieldsNameOfVersionCreateRequest = [3]string{
+	0: "templateID",
+	1: "data",
+	2: "variables",
 }
 
-// Decode decodes TemplateUsersResponse from json.
-func (s *TemplateUsersResponse) Decode(d *jx.Decoder) error {
+// Decode decodes VersionCreateRequest from json.
+func (s *VersionCreateRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateUsersResponse to nil")
+		return errors.New("invalid: unable to decode VersionCreateRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "users":
+		case "templateID":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Users = make([]TemplateUsersResponseUsersItem, 0)
+				v, err := d.Int64()
+				s.TemplateID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"templateID\"")
+			}
+		case "data":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Base64()
+				s.Data = []byte(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		case "variables":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Variables = make([]VersionCreateRequestVariablesItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TemplateUsersResponseUsersItem
+					var elem VersionCreateRequestVariablesItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Users = append(s.Users, elem)
+					s.Variables = append(s.Variables, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"users\"")
+				return errors.Wrap(err, "decode field \"variables\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateUsersResponse")
+		return errors.Wrap(err, "decode VersionCreateRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5911,8 +12653,8 @@ func (s *TemplateUsersResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateUsersResponse) {
-					name = jsonFieldsNameOfTemplateUsersResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionCreateRequest) {
+					name = jsonFieldsNameOfVersionCreateRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5933,118 +12675,306 @@ func (s *TemplateUsersResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateUsersResponse) MarshalJSON() ([]byte, error) {
+func (s *VersionCreateRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateUsersResponse) UnmarshalJSON(data []byte) error {
+func (s *VersionCreateRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TemplateUsersResponseUsersItem) Encode(e *jx.Encoder) {
+func (s *VersionCreateRequestVariablesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TemplateUsersResponseUsersItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
+func (s *VersionCreateRequestVariablesItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
+		e.FieldStart("title")
+		e.Str(s.Title)
 	}
 	{
-		e.FieldStart("role")
-		s.Role.Encode(e)
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		if s.Expression.Set {
+			e.FieldStart("expression")
+			s.Expression.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("isInput")
+		e.Bool(s.IsInput)
+	}
+	{
+		if s.DefaultExpression.Set {
+			e.FieldStart("defaultExpression")
+			s.DefaultExpression.Encode(e)
+		}
+	}
+	{
+		if s.EnabledIf.Set {
+			e.FieldStart("enabledIf")
+			s.EnabledIf.Encode(e)
+		}
+	}
+	{
+		if s.Unit.Set {
+			e.FieldStart("unit")
+			s.Unit.Encode(e)
+		}
+	}
+	{
+		if s.Options != nil {
+			e.FieldStart("options")
+			e.ArrStart()
+			for _, elem := range s.Options {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.OptionsFrom.Set {
+			e.FieldStart("optionsFrom")
+			s.OptionsFrom.Encode(e)
+		}
+	}
+	{
+		if s.ItemType.Set {
+			e.FieldStart("itemType")
+			s.ItemType.Encode(e)
+		}
+	}
+	{
+		if s.Columns != nil {
+			e.FieldStart("columns")
+			e.ArrStart()
+			for _, elem := range s.Columns {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		e.FieldStart("constraints")
+		e.ArrStart()
+		for _, elem := range s.Constraints {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTemplateUsersResponseUsersItem = [4]string{
-	0: "id",
-	1: "name",
-	2: "email",
-	3: "role",
+var jsonFieldsNameOfVersionCreateRequestVariablesItem = [13]string{
+	0:  "name",
+	1:  "title",
+	2:  "type",
+	3:  "expression",
+	4:  "isInput",
+	5:  "defaultExpression",
+	6:  "enabledIf",
+	7:  "unit",
+	8:  "options",
+	9:  "optionsFrom",
+	10: "itemType",
+	11: "columns",
+	12: "constraints",
 }
 
-// Decode decodes TemplateUsersResponseUsersItem from json.
-func (s *TemplateUsersResponseUsersItem) Decode(d *jx.Decoder) error {
+// Decode decodes VersionCreateRequestVariablesItem from json.
+func (s *VersionCreateRequestVariablesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TemplateUsersResponseUsersItem to nil")
+		return errors.New("invalid: unable to decode VersionCreateRequestVariablesItem to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "title":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Title = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "expression":
+			if err := func() error {
+				s.Expression.Reset()
+				if err := s.Expression.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expression\"")
+			}
+		case "isInput":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.IsInput = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"isInput\"")
 			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
+		case "defaultExpression":
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				s.DefaultExpression.Reset()
+				if err := s.DefaultExpression.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"defaultExpression\"")
 			}
-		case "email":
-			requiredBitSet[0] |= 1 << 2
+		case "enabledIf":
 			if err := func() error {
-				v, err := d.Str()
-				s.Email = string(v)
-				if err != nil {
+				s.EnabledIf.Reset()
+				if err := s.EnabledIf.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"enabledIf\"")
 			}
-		case "role":
-			requiredBitSet[0] |= 1 << 3
+		case "unit":
 			if err := func() error {
-				if err := s.Role.Decode(d); err != nil {
+				s.Unit.Reset()
+				if err := s.Unit.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"role\"")
+				return errors.Wrap(err, "decode field \"unit\"")
+			}
+		case "options":
+			if err := func() error {
+				s.Options = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Options = append(s.Options, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"options\"")
+			}
+		case "optionsFrom":
+			if err := func() error {
+				s.OptionsFrom.Reset()
+				if err := s.OptionsFrom.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"optionsFrom\"")
+			}
+		case "itemType":
+			if err := func() error {
+				s.ItemType.Reset()
+				if err := s.ItemType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"itemType\"")
+			}
+		case "columns":
+			if err := func() error {
+				s.Columns = make([]VersionCreateRequestVariablesItemColumnsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VersionCreateRequestVariablesItemColumnsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Columns = append(s.Columns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"columns\"")
+			}
+		case "constraints":
+			requiredBitSet[1] |= 1 << 4
+			if err := func() error {
+				s.Constraints = make([]VersionCreateRequestVariablesItemConstraintsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VersionCreateRequestVariablesItemConstraintsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Constraints = append(s.Constraints, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"constraints\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TemplateUsersResponseUsersItem")
+		return errors.Wrap(err, "decode VersionCreateRequestVariablesItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001111,
+	for i, mask := range [2]uint8{
+		0b00010111,
+		0b00010000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6056,8 +12986,8 @@ func (s *TemplateUsersResponseUsersItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTemplateUsersResponseUsersItem) {
-					name = jsonFieldsNameOfTemplateUsersResponseUsersItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionCreateRequestVariablesItem) {
+					name = jsonFieldsNameOfVersionCreateRequestVariablesItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6078,91 +13008,62 @@ func (s *TemplateUsersResponseUsersItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TemplateUsersResponseUsersItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateUsersResponseUsersItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes TemplateUsersResponseUsersItemRole as json.
-func (s TemplateUsersResponseUsersItemRole) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes TemplateUsersResponseUsersItemRole from json.
-func (s *TemplateUsersResponseUsersItemRole) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode TemplateUsersResponseUsersItemRole to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch TemplateUsersResponseUsersItemRole(v) {
-	case TemplateUsersResponseUsersItemRoleRead:
-		*s = TemplateUsersResponseUsersItemRoleRead
-	case TemplateUsersResponseUsersItemRoleWrite:
-		*s = TemplateUsersResponseUsersItemRoleWrite
-	default:
-		*s = TemplateUsersResponseUsersItemRole(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s TemplateUsersResponseUsersItemRole) MarshalJSON() ([]byte, error) {
+func (s *VersionCreateRequestVariablesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TemplateUsersResponseUsersItemRole) UnmarshalJSON(data []byte) error {
+func (s *VersionCreateRequestVariablesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserCreateRequest) Encode(e *jx.Encoder) {
+func (s *VersionCreateRequestVariablesItemColumnsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserCreateRequest) encodeFields(e *jx.Encoder) {
+func (s *VersionCreateRequestVariablesItemColumnsItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
+		e.FieldStart("title")
+		e.Str(s.Title)
 	}
 	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		if s.Options != nil {
+			e.FieldStart("options")
+			e.ArrStart()
+			for _, elem := range s.Options {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
 	}
 }
 
-var jsonFieldsNameOfUserCreateRequest = [3]string{
+var jsonFieldsNameOfVersionCreateRequestVariablesItemColumnsItem = [4]string{
 	0: "name",
-	1: "email",
-	2: "password",
+	1: "title",
+	2: "type",
+	3: "options",
 }
 
-// Decode decodes UserCreateRequest from json.
-func (s *UserCreateRequest) Decode(d *jx.Decoder) error {
+// Decode decodes VersionCreateRequestVariablesItemColumnsItem from json.
+func (s *VersionCreateRequestVariablesItemColumnsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserCreateRequest to nil")
+		return errors.New("invalid: unable to decode VersionCreateRequestVariablesItemColumnsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -6180,36 +13081,53 @@ func (s *UserCreateRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "email":
+		case "title":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Email = string(v)
+				s.Title = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"title\"")
 			}
-		case "password":
+		case "type":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
-				if err != nil {
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "options":
+			if err := func() error {
+				s.Options = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Options = append(s.Options, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"options\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserCreateRequest")
+		return errors.Wrap(err, "decode VersionCreateRequestVariablesItemColumnsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -6226,8 +13144,8 @@ func (s *UserCreateRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUserCreateRequest) {
-					name = jsonFieldsNameOfUserCreateRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionCreateRequestVariablesItemColumnsItem) {
+					name = jsonFieldsNameOfVersionCreateRequestVariablesItemColumnsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6248,75 +13166,108 @@ func (s *UserCreateRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserCreateRequest) MarshalJSON() ([]byte, error) {
+func (s *VersionCreateRequestVariablesItemColumnsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserCreateRequest) UnmarshalJSON(data []byte) error {
+func (s *VersionCreateRequestVariablesItemColumnsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VersionCreateRequestVariablesItemColumnsItemType as json.
+func (s VersionCreateRequestVariablesItemColumnsItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes VersionCreateRequestVariablesItemColumnsItemType from json.
+func (s *VersionCreateRequestVariablesItemColumnsItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VersionCreateRequestVariablesItemColumnsItemType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch VersionCreateRequestVariablesItemColumnsItemType(v) {
+	case VersionCreateRequestVariablesItemColumnsItemTypeString:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeString
+	case VersionCreateRequestVariablesItemColumnsItemTypeInteger:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeInteger
+	case VersionCreateRequestVariablesItemColumnsItemTypeFloat:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeFloat
+	case VersionCreateRequestVariablesItemColumnsItemTypeDecimal:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeDecimal
+	case VersionCreateRequestVariablesItemColumnsItemTypeBoolean:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeBoolean
+	case VersionCreateRequestVariablesItemColumnsItemTypeDate:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeDate
+	case VersionCreateRequestVariablesItemColumnsItemTypeEnum:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeEnum
+	default:
+		*s = VersionCreateRequestVariablesItemColumnsItemType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s VersionCreateRequestVariablesItemColumnsItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VersionCreateRequestVariablesItemColumnsItemType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserGetByIDResponse) Encode(e *jx.Encoder) {
+func (s *VersionCreateRequestVariablesItemConstraintsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserGetByIDResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
+func (s *VersionCreateRequestVariablesItemConstraintsItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
+		e.FieldStart("expression")
+		e.Str(s.Expression)
 	}
 	{
-		e.FieldStart("createdAt")
-		json.EncodeDateTime(e, s.CreatedAt)
+		e.FieldStart("isActive")
+		e.Bool(s.IsActive)
 	}
 }
 
-var jsonFieldsNameOfUserGetByIDResponse = [4]string{
-	0: "id",
-	1: "name",
-	2: "email",
-	3: "createdAt",
+var jsonFieldsNameOfVersionCreateRequestVariablesItemConstraintsItem = [3]string{
+	0: "name",
+	1: "expression",
+	2: "isActive",
 }
 
-// Decode decodes UserGetByIDResponse from json.
-func (s *UserGetByIDResponse) Decode(d *jx.Decoder) error {
+// Decode decodes VersionCreateRequestVariablesItemConstraintsItem from json.
+func (s *VersionCreateRequestVariablesItemConstraintsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserGetByIDResponse to nil")
+		return errors.New("invalid: unable to decode VersionCreateRequestVariablesItemConstraintsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
 		case "name":
-			requiredBitSet[0] |= 1 << 1
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
 				s.Name = string(v)
@@ -6327,41 +13278,41 @@ func (s *UserGetByIDResponse) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "email":
-			requiredBitSet[0] |= 1 << 2
+		case "expression":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Email = string(v)
+				s.Expression = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"expression\"")
 			}
-		case "createdAt":
-			requiredBitSet[0] |= 1 << 3
+		case "isActive":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				v, err := d.Bool()
+				s.IsActive = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
+				return errors.Wrap(err, "decode field \"isActive\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserGetByIDResponse")
+		return errors.Wrap(err, "decode VersionCreateRequestVariablesItemConstraintsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6373,8 +13324,8 @@ func (s *UserGetByIDResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUserGetByIDResponse) {
-					name = jsonFieldsNameOfUserGetByIDResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionCreateRequestVariablesItemConstraintsItem) {
+					name = jsonFieldsNameOfVersionCreateRequestVariablesItemConstraintsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6395,113 +13346,173 @@ func (s *UserGetByIDResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserGetByIDResponse) MarshalJSON() ([]byte, error) {
+func (s *VersionCreateRequestVariablesItemConstraintsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserGetByIDResponse) UnmarshalJSON(data []byte) error {
+func (s *VersionCreateRequestVariablesItemConstraintsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VersionCreateRequestVariablesItemItemType as json.
+func (s VersionCreateRequestVariablesItemItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes VersionCreateRequestVariablesItemItemType from json.
+func (s *VersionCreateRequestVariablesItemItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VersionCreateRequestVariablesItemItemType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch VersionCreateRequestVariablesItemItemType(v) {
+	case VersionCreateRequestVariablesItemItemTypeString:
+		*s = VersionCreateRequestVariablesItemItemTypeString
+	case VersionCreateRequestVariablesItemItemTypeInteger:
+		*s = VersionCreateRequestVariablesItemItemTypeInteger
+	case VersionCreateRequestVariablesItemItemTypeFloat:
+		*s = VersionCreateRequestVariablesItemItemTypeFloat
+	case VersionCreateRequestVariablesItemItemTypeDecimal:
+		*s = VersionCreateRequestVariablesItemItemTypeDecimal
+	case VersionCreateRequestVariablesItemItemTypeBoolean:
+		*s = VersionCreateRequestVariablesItemItemTypeBoolean
+	case VersionCreateRequestVariablesItemItemTypeDate:
+		*s = VersionCreateRequestVariablesItemItemTypeDate
+	case VersionCreateRequestVariablesItemItemTypeEnum:
+		*s = VersionCreateRequestVariablesItemItemTypeEnum
+	default:
+		*s = VersionCreateRequestVariablesItemItemType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s VersionCreateRequestVariablesItemItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VersionCreateRequestVariablesItemItemType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VersionCreateRequestVariablesItemType as json.
+func (s VersionCreateRequestVariablesItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes VersionCreateRequestVariablesItemType from json.
+func (s *VersionCreateRequestVariablesItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VersionCreateRequestVariablesItemType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch VersionCreateRequestVariablesItemType(v) {
+	case VersionCreateRequestVariablesItemTypeString:
+		*s = VersionCreateRequestVariablesItemTypeString
+	case VersionCreateRequestVariablesItemTypeInteger:
+		*s = VersionCreateRequestVariablesItemTypeInteger
+	case VersionCreateRequestVariablesItemTypeFloat:
+		*s = VersionCreateRequestVariablesItemTypeFloat
+	case VersionCreateRequestVariablesItemTypeDecimal:
+		*s = VersionCreateRequestVariablesItemTypeDecimal
+	case VersionCreateRequestVariablesItemTypeBoolean:
+		*s = VersionCreateRequestVariablesItemTypeBoolean
+	case VersionCreateRequestVariablesItemTypeDate:
+		*s = VersionCreateRequestVariablesItemTypeDate
+	case VersionCreateRequestVariablesItemTypeEnum:
+		*s = VersionCreateRequestVariablesItemTypeEnum
+	case VersionCreateRequestVariablesItemTypeList:
+		*s = VersionCreateRequestVariablesItemTypeList
+	case VersionCreateRequestVariablesItemTypeTable:
+		*s = VersionCreateRequestVariablesItemTypeTable
+	default:
+		*s = VersionCreateRequestVariablesItemType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s VersionCreateRequestVariablesItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VersionCreateRequestVariablesItemType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserListResponse) Encode(e *jx.Encoder) {
+func (s *VersionCreateResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserListResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("users")
-		e.ArrStart()
-		for _, elem := range s.Users {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
-	}
-	{
-		e.FieldStart("totalUsers")
-		e.Int64(s.TotalUsers)
-	}
+func (s *VersionCreateResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("totalPages")
-		e.Int64(s.TotalPages)
+		e.FieldStart("id")
+		e.Int64(s.ID)
 	}
 }
 
-var jsonFieldsNameOfUserListResponse = [3]string{
-	0: "users",
-	1: "totalUsers",
-	2: "totalPages",
+var jsonFieldsNameOfVersionCreateResponse = [1]string{
+	0: "id",
 }
 
-// Decode decodes UserListResponse from json.
-func (s *UserListResponse) Decode(d *jx.Decoder) error {
+// Decode decodes VersionCreateResponse from json.
+func (s *VersionCreateResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserListResponse to nil")
+		return errors.New("invalid: unable to decode VersionCreateResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "users":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				s.Users = make([]UserListResponseUsersItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem UserListResponseUsersItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Users = append(s.Users, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"users\"")
-			}
-		case "totalUsers":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int64()
-				s.TotalUsers = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"totalUsers\"")
-			}
-		case "totalPages":
-			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Int64()
-				s.TotalPages = int64(v)
+				s.ID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"totalPages\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserListResponse")
+		return errors.Wrap(err, "decode VersionCreateResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6513,8 +13524,8 @@ func (s *UserListResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUserListResponse) {
-					name = jsonFieldsNameOfUserListResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionCreateResponse) {
+					name = jsonFieldsNameOfVersionCreateResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6535,103 +13546,79 @@ func (s *UserListResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserListResponse) MarshalJSON() ([]byte, error) {
+func (s *VersionCreateResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserListResponse) UnmarshalJSON(data []byte) error {
+func (s *VersionCreateResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserListResponseUsersItem) Encode(e *jx.Encoder) {
+func (s *VersionListResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserListResponseUsersItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
+func (s *VersionListResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
+		e.FieldStart("versions")
+		e.ArrStart()
+		for _, elem := range s.Versions {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfUserListResponseUsersItem = [3]string{
-	0: "id",
-	1: "name",
-	2: "email",
+var jsonFieldsNameOfVersionListResponse = [1]string{
+	0: "versions",
 }
 
-// Decode decodes UserListResponseUsersItem from json.
-func (s *UserListResponseUsersItem) Decode(d *jx.Decoder) error {
+// Decode decodes VersionListResponse from json.
+func (s *VersionListResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserListResponseUsersItem to nil")
+		return errors.New("invalid: unable to decode VersionListResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "versions":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "email":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Email = string(v)
-				if err != nil {
+				s.Versions = make([]VersionListResponseVersionsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VersionListResponseVersionsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Versions = append(s.Versions, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"versions\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserListResponseUsersItem")
+		return errors.Wrap(err, "decode VersionListResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6643,8 +13630,8 @@ func (s *UserListResponseUsersItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUserListResponseUsersItem) {
-					name = jsonFieldsNameOfUserListResponseUsersItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionListResponse) {
+					name = jsonFieldsNameOfVersionListResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6665,103 +13652,120 @@ func (s *UserListResponseUsersItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserListResponseUsersItem) MarshalJSON() ([]byte, error) {
+func (s *VersionListResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserListResponseUsersItem) UnmarshalJSON(data []byte) error {
+func (s *VersionListResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserTokenCreateRequest) Encode(e *jx.Encoder) {
+func (s *VersionListResponseVersionsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserTokenCreateRequest) encodeFields(e *jx.Encoder) {
+func (s *VersionListResponseVersionsItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("id")
+		e.Int64(s.ID)
 	}
 	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		e.FieldStart("number")
+		e.Int64(s.Number)
 	}
 	{
-		e.FieldStart("remember")
-		e.Bool(s.Remember)
+		e.FieldStart("authorName")
+		e.Str(s.AuthorName)
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 }
 
-var jsonFieldsNameOfUserTokenCreateRequest = [3]string{
-	0: "name",
-	1: "password",
-	2: "remember",
+var jsonFieldsNameOfVersionListResponseVersionsItem = [4]string{
+	0: "id",
+	1: "number",
+	2: "authorName",
+	3: "createdAt",
 }
 
-// Decode decodes UserTokenCreateRequest from json.
-func (s *UserTokenCreateRequest) Decode(d *jx.Decoder) error {
+// Decode decodes VersionListResponseVersionsItem from json.
+func (s *VersionListResponseVersionsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserTokenCreateRequest to nil")
+		return errors.New("invalid: unable to decode VersionListResponseVersionsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
+				v, err := d.Int64()
+				s.ID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "password":
+		case "number":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
+				v, err := d.Int64()
+				s.Number = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"number\"")
 			}
-		case "remember":
+		case "authorName":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Bool()
-				s.Remember = bool(v)
+				v, err := d.Str()
+				s.AuthorName = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"remember\"")
+				return errors.Wrap(err, "decode field \"authorName\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserTokenCreateRequest")
+		return errors.Wrap(err, "decode VersionListResponseVersionsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6773,8 +13777,8 @@ func (s *UserTokenCreateRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUserTokenCreateRequest) {
-					name = jsonFieldsNameOfUserTokenCreateRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionListResponseVersionsItem) {
+					name = jsonFieldsNameOfVersionListResponseVersionsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6795,46 +13799,67 @@ func (s *UserTokenCreateRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserTokenCreateRequest) MarshalJSON() ([]byte, error) {
+func (s *VersionListResponseVersionsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserTokenCreateRequest) UnmarshalJSON(data []byte) error {
+func (s *VersionListResponseVersionsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *VersionCreateFromRequest) Encode(e *jx.Encoder) {
+func (s *VersionPreviewDraftRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *VersionCreateFromRequest) encodeFields(e *jx.Encoder) {
+func (s *VersionPreviewDraftRequest) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("templateID")
 		e.Int64(s.TemplateID)
 	}
 	{
-		e.FieldStart("versionID")
-		e.Int64(s.VersionID)
+		e.FieldStart("data")
+		e.Base64(s.Data)
+	}
+	{
+		e.FieldStart("variables")
+		e.ArrStart()
+		for _, elem := range s.Variables {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("payload")
+		s.Payload.Encode(e)
+	}
+	{
+		if s.Trace.Set {
+			e.FieldStart("trace")
+			s.Trace.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfVersionCreateFromRequest = [2]string{
+var jsonFieldsNameOfVersionPreviewDraftRequest = [5]string{
 	0: "templateID",
-	1: "versionID",
+	1: "data",
+	2: "variables",
+	3: "payload",
+	4: "trace",
 }
 
-// Decode decodes VersionCreateFromRequest from json.
-func (s *VersionCreateFromRequest) Decode(d *jx.Decoder) error {
+// Decode decodes VersionPreviewDraftRequest from json.
+func (s *VersionPreviewDraftRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VersionCreateFromRequest to nil")
+		return errors.New("invalid: unable to decode VersionPreviewDraftRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -6852,29 +13877,67 @@ func (s *VersionCreateFromRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"templateID\"")
 			}
-		case "versionID":
+		case "data":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int64()
-				s.VersionID = int64(v)
+				v, err := d.Base64()
+				s.Data = []byte(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"versionID\"")
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		case "variables":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Variables = make([]VersionPreviewDraftRequestVariablesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VersionPreviewDraftRequestVariablesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Variables = append(s.Variables, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"variables\"")
+			}
+		case "payload":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Payload.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"payload\"")
+			}
+		case "trace":
+			if err := func() error {
+				s.Trace.Reset()
+				if err := s.Trace.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"trace\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode VersionCreateFromRequest")
+		return errors.Wrap(err, "decode VersionPreviewDraftRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6886,8 +13949,8 @@ func (s *VersionCreateFromRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfVersionCreateFromRequest) {
-					name = jsonFieldsNameOfVersionCreateFromRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionPreviewDraftRequest) {
+					name = jsonFieldsNameOfVersionPreviewDraftRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6908,113 +13971,364 @@ func (s *VersionCreateFromRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VersionCreateFromRequest) MarshalJSON() ([]byte, error) {
+func (s *VersionPreviewDraftRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VersionCreateFromRequest) UnmarshalJSON(data []byte) error {
+func (s *VersionPreviewDraftRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *VersionCreateRequest) Encode(e *jx.Encoder) {
+func (s VersionPreviewDraftRequestPayload) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s VersionPreviewDraftRequestPayload) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes VersionPreviewDraftRequestPayload from json.
+func (s *VersionPreviewDraftRequestPayload) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VersionPreviewDraftRequestPayload to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode VersionPreviewDraftRequestPayload")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s VersionPreviewDraftRequestPayload) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VersionPreviewDraftRequestPayload) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *VersionPreviewDraftRequestVariablesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *VersionCreateRequest) encodeFields(e *jx.Encoder) {
+func (s *VersionPreviewDraftRequestVariablesItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("templateID")
-		e.Int64(s.TemplateID)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("title")
+		e.Str(s.Title)
+	}
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		if s.Expression.Set {
+			e.FieldStart("expression")
+			s.Expression.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("isInput")
+		e.Bool(s.IsInput)
+	}
+	{
+		if s.DefaultExpression.Set {
+			e.FieldStart("defaultExpression")
+			s.DefaultExpression.Encode(e)
+		}
+	}
+	{
+		if s.EnabledIf.Set {
+			e.FieldStart("enabledIf")
+			s.EnabledIf.Encode(e)
+		}
+	}
+	{
+		if s.Unit.Set {
+			e.FieldStart("unit")
+			s.Unit.Encode(e)
+		}
+	}
+	{
+		if s.Options != nil {
+			e.FieldStart("options")
+			e.ArrStart()
+			for _, elem := range s.Options {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.OptionsFrom.Set {
+			e.FieldStart("optionsFrom")
+			s.OptionsFrom.Encode(e)
+		}
+	}
+	{
+		if s.ItemType.Set {
+			e.FieldStart("itemType")
+			s.ItemType.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("data")
-		e.Base64(s.Data)
+		if s.Columns != nil {
+			e.FieldStart("columns")
+			e.ArrStart()
+			for _, elem := range s.Columns {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
 	{
-		e.FieldStart("variables")
+		e.FieldStart("constraints")
 		e.ArrStart()
-		for _, elem := range s.Variables {
+		for _, elem := range s.Constraints {
 			elem.Encode(e)
 		}
 		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfVersionCreateRequest = [3]string{
-	0: "templateID",
-	1: "data",
-	2: "variables",
+var jsonFieldsNameOfVersionPreviewDraftRequestVariablesItem = [13]string{
+	0:  "name",
+	1:  "title",
+	2:  "type",
+	3:  "expression",
+	4:  "isInput",
+	5:  "defaultExpression",
+	6:  "enabledIf",
+	7:  "unit",
+	8:  "options",
+	9:  "optionsFrom",
+	10: "itemType",
+	11: "columns",
+	12: "constraints",
 }
 
-// Decode decodes VersionCreateRequest from json.
-func (s *VersionCreateRequest) Decode(d *jx.Decoder) error {
+// Decode decodes VersionPreviewDraftRequestVariablesItem from json.
+func (s *VersionPreviewDraftRequestVariablesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VersionCreateRequest to nil")
+		return errors.New("invalid: unable to decode VersionPreviewDraftRequestVariablesItem to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "templateID":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.TemplateID = int64(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"templateID\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "data":
+		case "title":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Base64()
-				s.Data = []byte(v)
+				v, err := d.Str()
+				s.Title = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
+				return errors.Wrap(err, "decode field \"title\"")
 			}
-		case "variables":
+		case "type":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Variables = make([]VersionCreateRequestVariablesItem, 0)
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "expression":
+			if err := func() error {
+				s.Expression.Reset()
+				if err := s.Expression.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expression\"")
+			}
+		case "isInput":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.IsInput = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"isInput\"")
+			}
+		case "defaultExpression":
+			if err := func() error {
+				s.DefaultExpression.Reset()
+				if err := s.DefaultExpression.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"defaultExpression\"")
+			}
+		case "enabledIf":
+			if err := func() error {
+				s.EnabledIf.Reset()
+				if err := s.EnabledIf.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"enabledIf\"")
+			}
+		case "unit":
+			if err := func() error {
+				s.Unit.Reset()
+				if err := s.Unit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unit\"")
+			}
+		case "options":
+			if err := func() error {
+				s.Options = make([]string, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem VersionCreateRequestVariablesItem
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Options = append(s.Options, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"options\"")
+			}
+		case "optionsFrom":
+			if err := func() error {
+				s.OptionsFrom.Reset()
+				if err := s.OptionsFrom.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"optionsFrom\"")
+			}
+		case "itemType":
+			if err := func() error {
+				s.ItemType.Reset()
+				if err := s.ItemType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"itemType\"")
+			}
+		case "columns":
+			if err := func() error {
+				s.Columns = make([]VersionPreviewDraftRequestVariablesItemColumnsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VersionPreviewDraftRequestVariablesItemColumnsItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Variables = append(s.Variables, elem)
+					s.Columns = append(s.Columns, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"variables\"")
+				return errors.Wrap(err, "decode field \"columns\"")
+			}
+		case "constraints":
+			requiredBitSet[1] |= 1 << 4
+			if err := func() error {
+				s.Constraints = make([]VersionPreviewDraftRequestVariablesItemConstraintsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VersionPreviewDraftRequestVariablesItemConstraintsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Constraints = append(s.Constraints, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"constraints\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode VersionCreateRequest")
+		return errors.Wrap(err, "decode VersionPreviewDraftRequestVariablesItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
+	for i, mask := range [2]uint8{
+		0b00010111,
+		0b00010000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -7026,8 +14340,8 @@ func (s *VersionCreateRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfVersionCreateRequest) {
-					name = jsonFieldsNameOfVersionCreateRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionPreviewDraftRequestVariablesItem) {
+					name = jsonFieldsNameOfVersionPreviewDraftRequestVariablesItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7048,27 +14362,27 @@ func (s *VersionCreateRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VersionCreateRequest) MarshalJSON() ([]byte, error) {
+func (s *VersionPreviewDraftRequestVariablesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VersionCreateRequest) UnmarshalJSON(data []byte) error {
+func (s *VersionPreviewDraftRequestVariablesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *VersionCreateRequestVariablesItem) Encode(e *jx.Encoder) {
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *VersionCreateRequestVariablesItem) encodeFields(e *jx.Encoder) {
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
@@ -7082,38 +14396,28 @@ func (s *VersionCreateRequestVariablesItem) encodeFields(e *jx.Encoder) {
 		s.Type.Encode(e)
 	}
 	{
-		if s.Expression.Set {
-			e.FieldStart("expression")
-			s.Expression.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("isInput")
-		e.Bool(s.IsInput)
-	}
-	{
-		e.FieldStart("constraints")
-		e.ArrStart()
-		for _, elem := range s.Constraints {
-			elem.Encode(e)
+		if s.Options != nil {
+			e.FieldStart("options")
+			e.ArrStart()
+			for _, elem := range s.Options {
+				e.Str(elem)
+			}
+			e.ArrEnd()
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfVersionCreateRequestVariablesItem = [6]string{
+var jsonFieldsNameOfVersionPreviewDraftRequestVariablesItemColumnsItem = [4]string{
 	0: "name",
 	1: "title",
 	2: "type",
-	3: "expression",
-	4: "isInput",
-	5: "constraints",
+	3: "options",
 }
 
-// Decode decodes VersionCreateRequestVariablesItem from json.
-func (s *VersionCreateRequestVariablesItem) Decode(d *jx.Decoder) error {
+// Decode decodes VersionPreviewDraftRequestVariablesItemColumnsItem from json.
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VersionCreateRequestVariablesItem to nil")
+		return errors.New("invalid: unable to decode VersionPreviewDraftRequestVariablesItemColumnsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -7153,57 +14457,36 @@ func (s *VersionCreateRequestVariablesItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "expression":
-			if err := func() error {
-				s.Expression.Reset()
-				if err := s.Expression.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expression\"")
-			}
-		case "isInput":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Bool()
-				s.IsInput = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"isInput\"")
-			}
-		case "constraints":
-			requiredBitSet[0] |= 1 << 5
+		case "options":
 			if err := func() error {
-				s.Constraints = make([]VersionCreateRequestVariablesItemConstraintsItem, 0)
+				s.Options = make([]string, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem VersionCreateRequestVariablesItemConstraintsItem
-					if err := elem.Decode(d); err != nil {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
 						return err
 					}
-					s.Constraints = append(s.Constraints, elem)
+					s.Options = append(s.Options, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"constraints\"")
+				return errors.Wrap(err, "decode field \"options\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode VersionCreateRequestVariablesItem")
+		return errors.Wrap(err, "decode VersionPreviewDraftRequestVariablesItemColumnsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00110111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -7215,8 +14498,8 @@ func (s *VersionCreateRequestVariablesItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfVersionCreateRequestVariablesItem) {
-					name = jsonFieldsNameOfVersionCreateRequestVariablesItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionPreviewDraftRequestVariablesItemColumnsItem) {
+					name = jsonFieldsNameOfVersionPreviewDraftRequestVariablesItemColumnsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7237,27 +14520,77 @@ func (s *VersionCreateRequestVariablesItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VersionCreateRequestVariablesItem) MarshalJSON() ([]byte, error) {
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VersionCreateRequestVariablesItem) UnmarshalJSON(data []byte) error {
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VersionPreviewDraftRequestVariablesItemColumnsItemType as json.
+func (s VersionPreviewDraftRequestVariablesItemColumnsItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes VersionPreviewDraftRequestVariablesItemColumnsItemType from json.
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VersionPreviewDraftRequestVariablesItemColumnsItemType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch VersionPreviewDraftRequestVariablesItemColumnsItemType(v) {
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeString:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeString
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeInteger:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeInteger
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeFloat:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeFloat
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeDecimal:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeDecimal
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeBoolean:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeBoolean
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeDate:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeDate
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeEnum:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeEnum
+	default:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s VersionPreviewDraftRequestVariablesItemColumnsItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItemType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *VersionCreateRequestVariablesItemConstraintsItem) Encode(e *jx.Encoder) {
+func (s *VersionPreviewDraftRequestVariablesItemConstraintsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *VersionCreateRequestVariablesItemConstraintsItem) encodeFields(e *jx.Encoder) {
+func (s *VersionPreviewDraftRequestVariablesItemConstraintsItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
@@ -7272,16 +14605,16 @@ func (s *VersionCreateRequestVariablesItemConstraintsItem) encodeFields(e *jx.En
 	}
 }
 
-var jsonFieldsNameOfVersionCreateRequestVariablesItemConstraintsItem = [3]string{
+var jsonFieldsNameOfVersionPreviewDraftRequestVariablesItemConstraintsItem = [3]string{
 	0: "name",
 	1: "expression",
 	2: "isActive",
 }
 
-// Decode decodes VersionCreateRequestVariablesItemConstraintsItem from json.
-func (s *VersionCreateRequestVariablesItemConstraintsItem) Decode(d *jx.Decoder) error {
+// Decode decodes VersionPreviewDraftRequestVariablesItemConstraintsItem from json.
+func (s *VersionPreviewDraftRequestVariablesItemConstraintsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VersionCreateRequestVariablesItemConstraintsItem to nil")
+		return errors.New("invalid: unable to decode VersionPreviewDraftRequestVariablesItemConstraintsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -7328,7 +14661,7 @@ func (s *VersionCreateRequestVariablesItemConstraintsItem) Decode(d *jx.Decoder)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode VersionCreateRequestVariablesItemConstraintsItem")
+		return errors.Wrap(err, "decode VersionPreviewDraftRequestVariablesItemConstraintsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -7345,8 +14678,8 @@ func (s *VersionCreateRequestVariablesItemConstraintsItem) Decode(d *jx.Decoder)
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfVersionCreateRequestVariablesItemConstraintsItem) {
-					name = jsonFieldsNameOfVersionCreateRequestVariablesItemConstraintsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionPreviewDraftRequestVariablesItemConstraintsItem) {
+					name = jsonFieldsNameOfVersionPreviewDraftRequestVariablesItemConstraintsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7359,119 +14692,213 @@ func (s *VersionCreateRequestVariablesItemConstraintsItem) Decode(d *jx.Decoder)
 			}
 		}
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VersionPreviewDraftRequestVariablesItemConstraintsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VersionPreviewDraftRequestVariablesItemConstraintsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VersionPreviewDraftRequestVariablesItemItemType as json.
+func (s VersionPreviewDraftRequestVariablesItemItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes VersionPreviewDraftRequestVariablesItemItemType from json.
+func (s *VersionPreviewDraftRequestVariablesItemItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VersionPreviewDraftRequestVariablesItemItemType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch VersionPreviewDraftRequestVariablesItemItemType(v) {
+	case VersionPreviewDraftRequestVariablesItemItemTypeString:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeString
+	case VersionPreviewDraftRequestVariablesItemItemTypeInteger:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeInteger
+	case VersionPreviewDraftRequestVariablesItemItemTypeFloat:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeFloat
+	case VersionPreviewDraftRequestVariablesItemItemTypeDecimal:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeDecimal
+	case VersionPreviewDraftRequestVariablesItemItemTypeBoolean:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeBoolean
+	case VersionPreviewDraftRequestVariablesItemItemTypeDate:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeDate
+	case VersionPreviewDraftRequestVariablesItemItemTypeEnum:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeEnum
+	default:
+		*s = VersionPreviewDraftRequestVariablesItemItemType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VersionCreateRequestVariablesItemConstraintsItem) MarshalJSON() ([]byte, error) {
+func (s VersionPreviewDraftRequestVariablesItemItemType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VersionCreateRequestVariablesItemConstraintsItem) UnmarshalJSON(data []byte) error {
+func (s *VersionPreviewDraftRequestVariablesItemItemType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VersionCreateRequestVariablesItemType as json.
-func (s VersionCreateRequestVariablesItemType) Encode(e *jx.Encoder) {
+// Encode encodes VersionPreviewDraftRequestVariablesItemType as json.
+func (s VersionPreviewDraftRequestVariablesItemType) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes VersionCreateRequestVariablesItemType from json.
-func (s *VersionCreateRequestVariablesItemType) Decode(d *jx.Decoder) error {
+// Decode decodes VersionPreviewDraftRequestVariablesItemType from json.
+func (s *VersionPreviewDraftRequestVariablesItemType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VersionCreateRequestVariablesItemType to nil")
+		return errors.New("invalid: unable to decode VersionPreviewDraftRequestVariablesItemType to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch VersionCreateRequestVariablesItemType(v) {
-	case VersionCreateRequestVariablesItemTypeString:
-		*s = VersionCreateRequestVariablesItemTypeString
-	case VersionCreateRequestVariablesItemTypeInteger:
-		*s = VersionCreateRequestVariablesItemTypeInteger
-	case VersionCreateRequestVariablesItemTypeFloat:
-		*s = VersionCreateRequestVariablesItemTypeFloat
+	switch VersionPreviewDraftRequestVariablesItemType(v) {
+	case VersionPreviewDraftRequestVariablesItemTypeString:
+		*s = VersionPreviewDraftRequestVariablesItemTypeString
+	case VersionPreviewDraftRequestVariablesItemTypeInteger:
+		*s = VersionPreviewDraftRequestVariablesItemTypeInteger
+	case VersionPreviewDraftRequestVariablesItemTypeFloat:
+		*s = VersionPreviewDraftRequestVariablesItemTypeFloat
+	case VersionPreviewDraftRequestVariablesItemTypeDecimal:
+		*s = VersionPreviewDraftRequestVariablesItemTypeDecimal
+	case VersionPreviewDraftRequestVariablesItemTypeBoolean:
+		*s = VersionPreviewDraftRequestVariablesItemTypeBoolean
+	case VersionPreviewDraftRequestVariablesItemTypeDate:
+		*s = VersionPreviewDraftRequestVariablesItemTypeDate
+	case VersionPreviewDraftRequestVariablesItemTypeEnum:
+		*s = VersionPreviewDraftRequestVariablesItemTypeEnum
+	case VersionPreviewDraftRequestVariablesItemTypeList:
+		*s = VersionPreviewDraftRequestVariablesItemTypeList
+	case VersionPreviewDraftRequestVariablesItemTypeTable:
+		*s = VersionPreviewDraftRequestVariablesItemTypeTable
 	default:
-		*s = VersionCreateRequestVariablesItemType(v)
+		*s = VersionPreviewDraftRequestVariablesItemType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s VersionCreateRequestVariablesItemType) MarshalJSON() ([]byte, error) {
+func (s VersionPreviewDraftRequestVariablesItemType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VersionCreateRequestVariablesItemType) UnmarshalJSON(data []byte) error {
+func (s *VersionPreviewDraftRequestVariablesItemType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *VersionCreateResponse) Encode(e *jx.Encoder) {
+func (s *VersionPreviewRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *VersionCreateResponse) encodeFields(e *jx.Encoder) {
+func (s *VersionPreviewRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
+		e.FieldStart("versionID")
+		e.Int64(s.VersionID)
+	}
+	{
+		e.FieldStart("payload")
+		s.Payload.Encode(e)
+	}
+	{
+		if s.Trace.Set {
+			e.FieldStart("trace")
+			s.Trace.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfVersionCreateResponse = [1]string{
-	0: "id",
+var jsonFieldsNameOfVersionPreviewRequest = [3]string{
+	0: "versionID",
+	1: "payload",
+	2: "trace",
 }
 
-// Decode decodes VersionCreateResponse from json.
-func (s *VersionCreateResponse) Decode(d *jx.Decoder) error {
+// Decode decodes VersionPreviewRequest from json.
+func (s *VersionPreviewRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VersionCreateResponse to nil")
+		return errors.New("invalid: unable to decode VersionPreviewRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "versionID":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int64()
-				s.ID = int64(v)
+				s.VersionID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"versionID\"")
+			}
+		case "payload":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Payload.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"payload\"")
+			}
+		case "trace":
+			if err := func() error {
+				s.Trace.Reset()
+				if err := s.Trace.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"trace\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode VersionCreateResponse")
+		return errors.Wrap(err, "decode VersionPreviewRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -7483,8 +14910,8 @@ func (s *VersionCreateResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfVersionCreateResponse) {
-					name = jsonFieldsNameOfVersionCreateResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVersionPreviewRequest) {
+					name = jsonFieldsNameOfVersionPreviewRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7505,267 +14932,167 @@ func (s *VersionCreateResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VersionCreateResponse) MarshalJSON() ([]byte, error) {
+func (s *VersionPreviewRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VersionCreateResponse) UnmarshalJSON(data []byte) error {
+func (s *VersionPreviewRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *VersionListResponse) Encode(e *jx.Encoder) {
+func (s VersionPreviewRequestPayload) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *VersionListResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("versions")
-		e.ArrStart()
-		for _, elem := range s.Versions {
-			elem.Encode(e)
+// encodeFields implements json.Marshaler.
+func (s VersionPreviewRequestPayload) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfVersionListResponse = [1]string{
-	0: "versions",
-}
-
-// Decode decodes VersionListResponse from json.
-func (s *VersionListResponse) Decode(d *jx.Decoder) error {
+// Decode decodes VersionPreviewRequestPayload from json.
+func (s *VersionPreviewRequestPayload) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VersionListResponse to nil")
+		return errors.New("invalid: unable to decode VersionPreviewRequestPayload to nil")
 	}
-	var requiredBitSet [1]uint8
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "versions":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				s.Versions = make([]VersionListResponseVersionsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem VersionListResponseVersionsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Versions = append(s.Versions, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"versions\"")
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode VersionListResponse")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfVersionListResponse) {
-					name = jsonFieldsNameOfVersionListResponse[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode VersionPreviewRequestPayload")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VersionListResponse) MarshalJSON() ([]byte, error) {
+func (s VersionPreviewRequestPayload) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VersionListResponse) UnmarshalJSON(data []byte) error {
+func (s *VersionPreviewRequestPayload) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *VersionListResponseVersionsItem) Encode(e *jx.Encoder) {
+func (s *VersionPreviewResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *VersionListResponseVersionsItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
+func (s *VersionPreviewResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("number")
-		e.Int64(s.Number)
+		e.FieldStart("result")
+		e.Base64(s.Result)
 	}
 	{
-		e.FieldStart("authorName")
-		e.Str(s.AuthorName)
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("createdAt")
-		json.EncodeDateTime(e, s.CreatedAt)
+		if s.Trace != nil {
+			e.FieldStart("trace")
+			s.Trace.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfVersionListResponseVersionsItem = [4]string{
-	0: "id",
-	1: "number",
-	2: "authorName",
-	3: "createdAt",
+var jsonFieldsNameOfVersionPreviewResponse = [3]string{
+	0: "result",
+	1: "error",
+	2: "trace",
 }
 
-// Decode decodes VersionListResponseVersionsItem from json.
-func (s *VersionListResponseVersionsItem) Decode(d *jx.Decoder) error {
+// Decode decodes VersionPreviewResponse from json.
+func (s *VersionPreviewResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VersionListResponseVersionsItem to nil")
+		return errors.New("invalid: unable to decode VersionPreviewResponse to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "number":
-			requiredBitSet[0] |= 1 << 1
+		case "result":
 			if err := func() error {
-				v, err := d.Int64()
-				s.Number = int64(v)
+				v, err := d.Base64()
+				s.Result = []byte(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"number\"")
+				return errors.Wrap(err, "decode field \"result\"")
 			}
-		case "authorName":
-			requiredBitSet[0] |= 1 << 2
+		case "error":
 			if err := func() error {
-				v, err := d.Str()
-				s.AuthorName = string(v)
-				if err != nil {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"authorName\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
-		case "createdAt":
-			requiredBitSet[0] |= 1 << 3
+		case "trace":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
-				if err != nil {
+				if err := s.Trace.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
+				return errors.Wrap(err, "decode field \"trace\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode VersionListResponseVersionsItem")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfVersionListResponseVersionsItem) {
-					name = jsonFieldsNameOfVersionListResponseVersionsItem[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode VersionPreviewResponse")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VersionListResponseVersionsItem) MarshalJSON() ([]byte, error) {
+func (s *VersionPreviewResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VersionListResponseVersionsItem) UnmarshalJSON(data []byte) error {
+func (s *VersionPreviewResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
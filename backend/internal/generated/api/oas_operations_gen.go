@@ -6,33 +6,46 @@ package api
 type OperationName = string
 
 const (
-	ProjectCreateOperation             OperationName = "ProjectCreate"
-	ProjectDeleteByIDOperation         OperationName = "ProjectDeleteByID"
-	ProjectGetByIDOperation            OperationName = "ProjectGetByID"
-	ProjectListOperation               OperationName = "ProjectList"
-	ProjectUpdateByIDOperation         OperationName = "ProjectUpdateByID"
-	ProjectUpdateUsersOperation        OperationName = "ProjectUpdateUsers"
-	ProjectUsersOperation              OperationName = "ProjectUsers"
-	TaskCreateOperation                OperationName = "TaskCreate"
-	TaskGetByIDOperation               OperationName = "TaskGetByID"
-	TaskListOperation                  OperationName = "TaskList"
-	TemplateCreateOperation            OperationName = "TemplateCreate"
-	TemplateCreateFromDefaultOperation OperationName = "TemplateCreateFromDefault"
-	TemplateDefaultListOperation       OperationName = "TemplateDefaultList"
-	TemplateDeleteByIDOperation        OperationName = "TemplateDeleteByID"
-	TemplateGetByIDOperation           OperationName = "TemplateGetByID"
-	TemplateGetMetaByIDOperation       OperationName = "TemplateGetMetaByID"
-	TemplateImportOperation            OperationName = "TemplateImport"
-	TemplateListOperation              OperationName = "TemplateList"
-	TemplateUpdateByIDOperation        OperationName = "TemplateUpdateByID"
-	TemplateUpdateUsersOperation       OperationName = "TemplateUpdateUsers"
-	TemplateUsersOperation             OperationName = "TemplateUsers"
-	UserCreateOperation                OperationName = "UserCreate"
-	UserGetByIDOperation               OperationName = "UserGetByID"
-	UserListOperation                  OperationName = "UserList"
-	UserTokenCreateOperation           OperationName = "UserTokenCreate"
-	UserTokenDeleteOperation           OperationName = "UserTokenDelete"
-	VersionCreateOperation             OperationName = "VersionCreate"
-	VersionCreateFromOperation         OperationName = "VersionCreateFrom"
-	VersionListOperation               OperationName = "VersionList"
+	ProjectConstantListOperation         OperationName = "ProjectConstantList"
+	ProjectConstantSaveOperation         OperationName = "ProjectConstantSave"
+	ProjectCreateOperation               OperationName = "ProjectCreate"
+	ProjectDeleteByIDOperation           OperationName = "ProjectDeleteByID"
+	ProjectDictionaryDeleteByIDOperation OperationName = "ProjectDictionaryDeleteByID"
+	ProjectDictionaryGetByIDOperation    OperationName = "ProjectDictionaryGetByID"
+	ProjectDictionaryImportOperation     OperationName = "ProjectDictionaryImport"
+	ProjectDictionaryListOperation       OperationName = "ProjectDictionaryList"
+	ProjectDictionarySaveOperation       OperationName = "ProjectDictionarySave"
+	ProjectFunctionListOperation         OperationName = "ProjectFunctionList"
+	ProjectFunctionSaveOperation         OperationName = "ProjectFunctionSave"
+	ProjectGetByIDOperation              OperationName = "ProjectGetByID"
+	ProjectListOperation                 OperationName = "ProjectList"
+	ProjectPartialListOperation          OperationName = "ProjectPartialList"
+	ProjectPartialSaveOperation          OperationName = "ProjectPartialSave"
+	ProjectUpdateByIDOperation           OperationName = "ProjectUpdateByID"
+	ProjectUpdateUsersOperation          OperationName = "ProjectUpdateUsers"
+	ProjectUsersOperation                OperationName = "ProjectUsers"
+	TaskCreateOperation                  OperationName = "TaskCreate"
+	TaskGetByIDOperation                 OperationName = "TaskGetByID"
+	TaskListOperation                    OperationName = "TaskList"
+	TemplateCreateOperation              OperationName = "TemplateCreate"
+	TemplateCreateFromDefaultOperation   OperationName = "TemplateCreateFromDefault"
+	TemplateDefaultListOperation         OperationName = "TemplateDefaultList"
+	TemplateDeleteByIDOperation          OperationName = "TemplateDeleteByID"
+	TemplateGetByIDOperation             OperationName = "TemplateGetByID"
+	TemplateGetMetaByIDOperation         OperationName = "TemplateGetMetaByID"
+	TemplateImportOperation              OperationName = "TemplateImport"
+	TemplateListOperation                OperationName = "TemplateList"
+	TemplateUpdateByIDOperation          OperationName = "TemplateUpdateByID"
+	TemplateUpdateUsersOperation         OperationName = "TemplateUpdateUsers"
+	TemplateUsersOperation               OperationName = "TemplateUsers"
+	UserCreateOperation                  OperationName = "UserCreate"
+	UserGetByIDOperation                 OperationName = "UserGetByID"
+	UserListOperation                    OperationName = "UserList"
+	UserTokenCreateOperation             OperationName = "UserTokenCreate"
+	UserTokenDeleteOperation             OperationName = "UserTokenDelete"
+	VersionCreateOperation               OperationName = "VersionCreate"
+	VersionCreateFromOperation           OperationName = "VersionCreateFrom"
+	VersionListOperation                 OperationName = "VersionList"
+	VersionPreviewOperation              OperationName = "VersionPreview"
+	VersionPreviewDraftOperation         OperationName = "VersionPreviewDraft"
 )
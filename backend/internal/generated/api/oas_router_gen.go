@@ -73,111 +73,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'c': // Prefix: "create"
-
-					if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleProjectCreateRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
-						}
-
-						return
-					}
-
-				case 'd': // Prefix: "delete/"
-
-					if l := len("delete/"); len(elem) >= l && elem[0:l] == "delete/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "projectID"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "DELETE":
-							s.handleProjectDeleteByIDRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "DELETE")
-						}
-
-						return
-					}
-
-				case 'g': // Prefix: "get/"
-
-					if l := len("get/"); len(elem) >= l && elem[0:l] == "get/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "projectID"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleProjectGetByIDRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
-
-						return
-					}
-
-				case 'l': // Prefix: "list"
-
-					if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleProjectListRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
-
-						return
-					}
-
-				case 'u': // Prefix: "u"
+				case 'c': // Prefix: "c"
 
-					if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+					if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
 						elem = elem[l:]
 					} else {
 						break
@@ -187,9 +85,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'p': // Prefix: "pdate"
+					case 'o': // Prefix: "onstant/"
 
-						if l := len("pdate"); len(elem) >= l && elem[0:l] == "pdate" {
+						if l := len("onstant/"); len(elem) >= l && elem[0:l] == "onstant/" {
 							elem = elem[l:]
 						} else {
 							break
@@ -199,9 +97,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
+						case 'l': // Prefix: "list/"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("list/"); len(elem) >= l && elem[0:l] == "list/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -219,20 +117,20 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "POST":
-									s.handleProjectUpdateByIDRequest([1]string{
+								case "GET":
+									s.handleProjectConstantListRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "POST")
+									s.notAllowed(w, r, "GET")
 								}
 
 								return
 							}
 
-						case '_': // Prefix: "_users/"
+						case 's': // Prefix: "save/"
 
-							if l := len("_users/"); len(elem) >= l && elem[0:l] == "_users/" {
+							if l := len("save/"); len(elem) >= l && elem[0:l] == "save/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -251,7 +149,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "POST":
-									s.handleProjectUpdateUsersRequest([1]string{
+									s.handleProjectConstantSaveRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
@@ -263,32 +161,21 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						}
 
-					case 's': // Prefix: "sers/"
+					case 'r': // Prefix: "reate"
 
-						if l := len("sers/"); len(elem) >= l && elem[0:l] == "sers/" {
+						if l := len("reate"); len(elem) >= l && elem[0:l] == "reate" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "projectID"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
-
 						if len(elem) == 0 {
 							// Leaf node.
 							switch r.Method {
-							case "GET":
-								s.handleProjectUsersRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleProjectCreateRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, "POST")
 							}
 
 							return
@@ -296,23 +183,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					}
 
-				}
-
-			case 't': // Prefix: "t"
-
-				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'a': // Prefix: "ask/"
+				case 'd': // Prefix: "d"
 
-					if l := len("ask/"); len(elem) >= l && elem[0:l] == "ask/" {
+					if l := len("d"); len(elem) >= l && elem[0:l] == "d" {
 						elem = elem[l:]
 					} else {
 						break
@@ -322,35 +195,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'c': // Prefix: "create"
-
-						if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handleTaskCreateRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
-							}
+					case 'e': // Prefix: "elete/"
 
-							return
-						}
-
-					case 'g': // Prefix: "get/"
-
-						if l := len("get/"); len(elem) >= l && elem[0:l] == "get/" {
+						if l := len("elete/"); len(elem) >= l && elem[0:l] == "elete/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "taskID"
+						// Param: "projectID"
 						// Leaf parameter, slashes are prohibited
 						idx := strings.IndexByte(elem, '/')
 						if idx >= 0 {
@@ -362,133 +215,100 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch r.Method {
-							case "GET":
-								s.handleTaskGetByIDRequest([1]string{
+							case "DELETE":
+								s.handleProjectDeleteByIDRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, "DELETE")
 							}
 
 							return
 						}
 
-					case 'l': // Prefix: "list"
+					case 'i': // Prefix: "ictionary/"
 
-						if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+						if l := len("ictionary/"); len(elem) >= l && elem[0:l] == "ictionary/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleTaskListRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
-						}
-
-					}
-
-				case 'e': // Prefix: "emplate/"
-
-					if l := len("emplate/"); len(elem) >= l && elem[0:l] == "emplate/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'c': // Prefix: "create"
-
-						if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
-							elem = elem[l:]
-						} else {
 							break
 						}
-
-						if len(elem) == 0 {
-							switch r.Method {
-							case "POST":
-								s.handleTemplateCreateRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
-							}
-
-							return
-						}
 						switch elem[0] {
-						case '_': // Prefix: "_from_default"
+						case 'd': // Prefix: "delete/"
 
-							if l := len("_from_default"); len(elem) >= l && elem[0:l] == "_from_default" {
+							if l := len("delete/"); len(elem) >= l && elem[0:l] == "delete/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							// Param: "dictionaryID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "POST":
-									s.handleTemplateCreateFromDefaultRequest([0]string{}, elemIsEscaped, w, r)
+								case "DELETE":
+									s.handleProjectDictionaryDeleteByIDRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "POST")
+									s.notAllowed(w, r, "DELETE")
 								}
 
 								return
 							}
 
-						}
-
-					case 'd': // Prefix: "de"
-
-						if l := len("de"); len(elem) >= l && elem[0:l] == "de" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'f': // Prefix: "fault/list"
+						case 'g': // Prefix: "get/"
 
-							if l := len("fault/list"); len(elem) >= l && elem[0:l] == "fault/list" {
+							if l := len("get/"); len(elem) >= l && elem[0:l] == "get/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleTemplateDefaultListRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
+							// Param: "dictionaryID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleProjectDictionaryGetByIDRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
 								}
 
 								return
 							}
 
-						case 'l': // Prefix: "lete/"
+						case 'i': // Prefix: "import/"
 
-							if l := len("lete/"); len(elem) >= l && elem[0:l] == "lete/" {
+							if l := len("import/"); len(elem) >= l && elem[0:l] == "import/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "templateID"
+							// Param: "projectID"
 							// Leaf parameter, slashes are prohibited
 							idx := strings.IndexByte(elem, '/')
 							if idx >= 0 {
@@ -500,40 +320,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "DELETE":
-									s.handleTemplateDeleteByIDRequest([1]string{
+								case "POST":
+									s.handleProjectDictionaryImportRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "DELETE")
+									s.notAllowed(w, r, "POST")
 								}
 
 								return
 							}
 
-						}
-
-					case 'g': // Prefix: "get"
-
-						if l := len("get"); len(elem) >= l && elem[0:l] == "get" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
+						case 'l': // Prefix: "list/"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("list/"); len(elem) >= l && elem[0:l] == "list/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "templateID"
+							// Param: "projectID"
 							// Leaf parameter, slashes are prohibited
 							idx := strings.IndexByte(elem, '/')
 							if idx >= 0 {
@@ -546,7 +352,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleTemplateGetByIDRequest([1]string{
+									s.handleProjectDictionaryListRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
@@ -556,15 +362,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								return
 							}
 
-						case '_': // Prefix: "_meta/"
+						case 's': // Prefix: "save/"
 
-							if l := len("_meta/"); len(elem) >= l && elem[0:l] == "_meta/" {
+							if l := len("save/"); len(elem) >= l && elem[0:l] == "save/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "templateID"
+							// Param: "projectID"
 							// Leaf parameter, slashes are prohibited
 							idx := strings.IndexByte(elem, '/')
 							if idx >= 0 {
@@ -576,12 +382,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "GET":
-									s.handleTemplateGetMetaByIDRequest([1]string{
+								case "POST":
+									s.handleProjectDictionarySaveRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, "POST")
 								}
 
 								return
@@ -589,26 +395,20 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						}
 
-					case 'i': // Prefix: "import"
-
-						if l := len("import"); len(elem) >= l && elem[0:l] == "import" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+					}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handleTemplateImportRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
-							}
+				case 'f': // Prefix: "function/"
 
-							return
-						}
+					if l := len("function/"); len(elem) >= l && elem[0:l] == "function/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
 					case 'l': // Prefix: "list/"
 
 						if l := len("list/"); len(elem) >= l && elem[0:l] == "list/" {
@@ -630,7 +430,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "GET":
-								s.handleTemplateListRequest([1]string{
+								s.handleProjectFunctionListRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
@@ -640,176 +440,63 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 
-					case 'u': // Prefix: "u"
+					case 's': // Prefix: "save/"
 
-						if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+						if l := len("save/"); len(elem) >= l && elem[0:l] == "save/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						if len(elem) == 0 {
+						// Param: "projectID"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
 							break
 						}
-						switch elem[0] {
-						case 'p': // Prefix: "pdate"
-
-							if l := len("pdate"); len(elem) >= l && elem[0:l] == "pdate" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
-
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								// Param: "templateID"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
-								}
-								args[0] = elem
-								elem = ""
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleTemplateUpdateByIDRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
-									}
-
-									return
-								}
-
-							case '_': // Prefix: "_users/"
-
-								if l := len("_users/"); len(elem) >= l && elem[0:l] == "_users/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								// Param: "templateID"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
-								}
-								args[0] = elem
-								elem = ""
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleTemplateUpdateUsersRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
-									}
-
-									return
-								}
-
-							}
-
-						case 's': // Prefix: "sers/"
-
-							if l := len("sers/"); len(elem) >= l && elem[0:l] == "sers/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							// Param: "templateID"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
-								break
-							}
-							args[0] = elem
-							elem = ""
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleTemplateUsersRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
+						args[0] = elem
+						elem = ""
 
-								return
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleProjectFunctionSaveRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
 							}
 
+							return
 						}
 
 					}
 
-				}
-
-			case 'u': // Prefix: "user/"
-
-				if l := len("user/"); len(elem) >= l && elem[0:l] == "user/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'c': // Prefix: "create"
+				case 'g': // Prefix: "get/"
 
-					if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+					if l := len("get/"); len(elem) >= l && elem[0:l] == "get/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleUserCreateRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
-						}
-
-						return
-					}
-
-				case 'g': // Prefix: "get"
-
-					if l := len("get"); len(elem) >= l && elem[0:l] == "get" {
-						elem = elem[l:]
-					} else {
+					// Param: "projectID"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
 						break
 					}
+					args[0] = elem
+					elem = ""
 
 					if len(elem) == 0 {
 						// Leaf node.
 						switch r.Method {
 						case "GET":
-							s.handleUserGetByIDRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleProjectGetByIDRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "GET")
 						}
@@ -829,7 +516,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						// Leaf node.
 						switch r.Method {
 						case "GET":
-							s.handleUserListRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleProjectListRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "GET")
 						}
@@ -837,9 +524,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						return
 					}
 
-				case 't': // Prefix: "token/"
+				case 'p': // Prefix: "partial/"
 
-					if l := len("token/"); len(elem) >= l && elem[0:l] == "token/" {
+					if l := len("partial/"); len(elem) >= l && elem[0:l] == "partial/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -849,9 +536,747 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'c': // Prefix: "create"
+					case 'l': // Prefix: "list/"
 
-						if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+						if l := len("list/"); len(elem) >= l && elem[0:l] == "list/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "projectID"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleProjectPartialListRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					case 's': // Prefix: "save/"
+
+						if l := len("save/"); len(elem) >= l && elem[0:l] == "save/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "projectID"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleProjectPartialSaveRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					}
+
+				case 'u': // Prefix: "u"
+
+					if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'p': // Prefix: "pdate"
+
+						if l := len("pdate"); len(elem) >= l && elem[0:l] == "pdate" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "projectID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleProjectUpdateByIDRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case '_': // Prefix: "_users/"
+
+							if l := len("_users/"); len(elem) >= l && elem[0:l] == "_users/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "projectID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleProjectUpdateUsersRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					case 's': // Prefix: "sers/"
+
+						if l := len("sers/"); len(elem) >= l && elem[0:l] == "sers/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "projectID"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleProjectUsersRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			case 't': // Prefix: "t"
+
+				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "ask/"
+
+					if l := len("ask/"); len(elem) >= l && elem[0:l] == "ask/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "create"
+
+						if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleTaskCreateRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'g': // Prefix: "get/"
+
+						if l := len("get/"); len(elem) >= l && elem[0:l] == "get/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "taskID"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleTaskGetByIDRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					case 'l': // Prefix: "list"
+
+						if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleTaskListRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				case 'e': // Prefix: "emplate/"
+
+					if l := len("emplate/"); len(elem) >= l && elem[0:l] == "emplate/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "create"
+
+						if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "POST":
+								s.handleTemplateCreateRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '_': // Prefix: "_from_default"
+
+							if l := len("_from_default"); len(elem) >= l && elem[0:l] == "_from_default" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleTemplateCreateFromDefaultRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					case 'd': // Prefix: "de"
+
+						if l := len("de"); len(elem) >= l && elem[0:l] == "de" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'f': // Prefix: "fault/list"
+
+							if l := len("fault/list"); len(elem) >= l && elem[0:l] == "fault/list" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleTemplateDefaultListRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 'l': // Prefix: "lete/"
+
+							if l := len("lete/"); len(elem) >= l && elem[0:l] == "lete/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "templateID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleTemplateDeleteByIDRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
+
+						}
+
+					case 'g': // Prefix: "get"
+
+						if l := len("get"); len(elem) >= l && elem[0:l] == "get" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "templateID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleTemplateGetByIDRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case '_': // Prefix: "_meta/"
+
+							if l := len("_meta/"); len(elem) >= l && elem[0:l] == "_meta/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "templateID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleTemplateGetMetaByIDRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					case 'i': // Prefix: "import"
+
+						if l := len("import"); len(elem) >= l && elem[0:l] == "import" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleTemplateImportRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'l': // Prefix: "list/"
+
+						if l := len("list/"); len(elem) >= l && elem[0:l] == "list/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "projectID"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleTemplateListRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					case 'u': // Prefix: "u"
+
+						if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'p': // Prefix: "pdate"
+
+							if l := len("pdate"); len(elem) >= l && elem[0:l] == "pdate" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "templateID"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleTemplateUpdateByIDRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case '_': // Prefix: "_users/"
+
+								if l := len("_users/"); len(elem) >= l && elem[0:l] == "_users/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "templateID"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleTemplateUpdateUsersRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						case 's': // Prefix: "sers/"
+
+							if l := len("sers/"); len(elem) >= l && elem[0:l] == "sers/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "templateID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleTemplateUsersRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'u': // Prefix: "user/"
+
+				if l := len("user/"); len(elem) >= l && elem[0:l] == "user/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "create"
+
+					if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleUserCreateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+				case 'g': // Prefix: "get"
+
+					if l := len("get"); len(elem) >= l && elem[0:l] == "get" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleUserGetByIDRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 'l': // Prefix: "list"
+
+					if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleUserListRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 't': // Prefix: "token/"
+
+					if l := len("token/"); len(elem) >= l && elem[0:l] == "token/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "create"
+
+						if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
 							elem = elem[l:]
 						} else {
 							break
@@ -974,7 +1399,48 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							s.notAllowed(w, r, "GET")
 						}
 
-						return
+						return
+					}
+
+				case 'p': // Prefix: "preview"
+
+					if l := len("preview"); len(elem) >= l && elem[0:l] == "preview" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "POST":
+							s.handleVersionPreviewRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '_': // Prefix: "_draft"
+
+						if l := len("_draft"); len(elem) >= l && elem[0:l] == "_draft" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleVersionPreviewDraftRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
 					}
 
 				}
@@ -1069,85 +1535,461 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 		switch elem[0] {
 		case '/': // Prefix: "/"
 
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'p': // Prefix: "project/"
+
+				if l := len("project/"); len(elem) >= l && elem[0:l] == "project/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "c"
+
+					if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'o': // Prefix: "onstant/"
+
+						if l := len("onstant/"); len(elem) >= l && elem[0:l] == "onstant/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'l': // Prefix: "list/"
+
+							if l := len("list/"); len(elem) >= l && elem[0:l] == "list/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "projectID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ProjectConstantListOperation
+									r.summary = "Получить список констант проекта"
+									r.operationID = "projectConstantList"
+									r.operationGroup = "ProjectConstantList"
+									r.pathPattern = "/project/constant/list/{projectID}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 's': // Prefix: "save/"
+
+							if l := len("save/"); len(elem) >= l && elem[0:l] == "save/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "projectID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ProjectConstantSaveOperation
+									r.summary = "Заменить список констант проекта"
+									r.operationID = "projectConstantSave"
+									r.operationGroup = "ProjectConstantSave"
+									r.pathPattern = "/project/constant/save/{projectID}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'r': // Prefix: "reate"
+
+						if l := len("reate"); len(elem) >= l && elem[0:l] == "reate" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = ProjectCreateOperation
+								r.summary = "Создать проект"
+								r.operationID = "projectCreate"
+								r.operationGroup = "ProjectCreate"
+								r.pathPattern = "/project/create"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'd': // Prefix: "d"
+
+					if l := len("d"); len(elem) >= l && elem[0:l] == "d" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'e': // Prefix: "elete/"
+
+						if l := len("elete/"); len(elem) >= l && elem[0:l] == "elete/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "projectID"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = ProjectDeleteByIDOperation
+								r.summary = "Удалить проект"
+								r.operationID = "projectDeleteByID"
+								r.operationGroup = "ProjectDeleteByID"
+								r.pathPattern = "/project/delete/{projectID}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'i': // Prefix: "ictionary/"
+
+						if l := len("ictionary/"); len(elem) >= l && elem[0:l] == "ictionary/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'd': // Prefix: "delete/"
+
+							if l := len("delete/"); len(elem) >= l && elem[0:l] == "delete/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "dictionaryID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = ProjectDictionaryDeleteByIDOperation
+									r.summary = "Удалить справочник проекта"
+									r.operationID = "projectDictionaryDeleteByID"
+									r.operationGroup = "ProjectDictionaryDeleteByID"
+									r.pathPattern = "/project/dictionary/delete/{dictionaryID}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'g': // Prefix: "get/"
+
+							if l := len("get/"); len(elem) >= l && elem[0:l] == "get/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "dictionaryID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ProjectDictionaryGetByIDOperation
+									r.summary = "Получить справочник проекта по ID"
+									r.operationID = "projectDictionaryGetByID"
+									r.operationGroup = "ProjectDictionaryGetByID"
+									r.pathPattern = "/project/dictionary/get/{dictionaryID}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'i': // Prefix: "import/"
+
+							if l := len("import/"); len(elem) >= l && elem[0:l] == "import/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "projectID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ProjectDictionaryImportOperation
+									r.summary = "Импортировать справочник проекта из CSV"
+									r.operationID = "projectDictionaryImport"
+									r.operationGroup = "ProjectDictionaryImport"
+									r.pathPattern = "/project/dictionary/import/{projectID}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'l': // Prefix: "list/"
+
+							if l := len("list/"); len(elem) >= l && elem[0:l] == "list/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "projectID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'p': // Prefix: "project/"
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ProjectDictionaryListOperation
+									r.summary = "Получить список справочников проекта"
+									r.operationID = "projectDictionaryList"
+									r.operationGroup = "ProjectDictionaryList"
+									r.pathPattern = "/project/dictionary/list/{projectID}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
 
-				if l := len("project/"); len(elem) >= l && elem[0:l] == "project/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						case 's': // Prefix: "save/"
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'c': // Prefix: "create"
+							if l := len("save/"); len(elem) >= l && elem[0:l] == "save/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-					if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							// Param: "projectID"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ProjectDictionarySaveOperation
+									r.summary = "Сохранить справочник проекта"
+									r.operationID = "projectDictionarySave"
+									r.operationGroup = "ProjectDictionarySave"
+									r.pathPattern = "/project/dictionary/save/{projectID}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = ProjectCreateOperation
-							r.summary = "Создать проект"
-							r.operationID = "projectCreate"
-							r.operationGroup = "ProjectCreate"
-							r.pathPattern = "/project/create"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
 						}
+
 					}
 
-				case 'd': // Prefix: "delete/"
+				case 'f': // Prefix: "function/"
 
-					if l := len("delete/"); len(elem) >= l && elem[0:l] == "delete/" {
+					if l := len("function/"); len(elem) >= l && elem[0:l] == "function/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "projectID"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
+					if len(elem) == 0 {
 						break
 					}
-					args[0] = elem
-					elem = ""
+					switch elem[0] {
+					case 'l': // Prefix: "list/"
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "DELETE":
-							r.name = ProjectDeleteByIDOperation
-							r.summary = "Удалить проект"
-							r.operationID = "projectDeleteByID"
-							r.operationGroup = "ProjectDeleteByID"
-							r.pathPattern = "/project/delete/{projectID}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
+						if l := len("list/"); len(elem) >= l && elem[0:l] == "list/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "projectID"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = ProjectFunctionListOperation
+								r.summary = "Получить список функций проекта"
+								r.operationID = "projectFunctionList"
+								r.operationGroup = "ProjectFunctionList"
+								r.pathPattern = "/project/function/list/{projectID}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 's': // Prefix: "save/"
+
+						if l := len("save/"); len(elem) >= l && elem[0:l] == "save/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "projectID"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = ProjectFunctionSaveOperation
+								r.summary = "Сохранить функцию проекта"
+								r.operationID = "projectFunctionSave"
+								r.operationGroup = "ProjectFunctionSave"
+								r.pathPattern = "/project/function/save/{projectID}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
 						}
+
 					}
 
 				case 'g': // Prefix: "get/"
@@ -1209,6 +2051,88 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 
+				case 'p': // Prefix: "partial/"
+
+					if l := len("partial/"); len(elem) >= l && elem[0:l] == "partial/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'l': // Prefix: "list/"
+
+						if l := len("list/"); len(elem) >= l && elem[0:l] == "list/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "projectID"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = ProjectPartialListOperation
+								r.summary = "Получить список фрагментов проекта"
+								r.operationID = "projectPartialList"
+								r.operationGroup = "ProjectPartialList"
+								r.pathPattern = "/project/partial/list/{projectID}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 's': // Prefix: "save/"
+
+						if l := len("save/"); len(elem) >= l && elem[0:l] == "save/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "projectID"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = ProjectPartialSaveOperation
+								r.summary = "Сохранить фрагмент проекта"
+								r.operationID = "projectPartialSave"
+								r.operationGroup = "ProjectPartialSave"
+								r.pathPattern = "/project/partial/save/{projectID}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
 				case 'u': // Prefix: "u"
 
 					if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
@@ -2112,6 +3036,57 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 
+				case 'p': // Prefix: "preview"
+
+					if l := len("preview"); len(elem) >= l && elem[0:l] == "preview" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							r.name = VersionPreviewOperation
+							r.summary = "Сгенерировать документ по версии шаблона без создания задачи"
+							r.operationID = "versionPreview"
+							r.operationGroup = "VersionPreview"
+							r.pathPattern = "/version/preview"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '_': // Prefix: "_draft"
+
+						if l := len("_draft"); len(elem) >= l && elem[0:l] == "_draft" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = VersionPreviewDraftOperation
+								r.summary = "Сгенерировать документ по несохранённому черновику версии шаблона"
+								r.operationID = "versionPreviewDraft"
+								r.operationGroup = "VersionPreviewDraft"
+								r.pathPattern = "/version/preview_draft"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
 				}
 
 			}
@@ -6,12 +6,15 @@ import (
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 )
 
 // Ошибка.
 // Ref: #/components/schemas/Error
 type Error struct {
 	Message string `json:"message"`
+	// Ошибки валидации отдельных полей.
+	Details []ErrorDetailsItem `json:"details"`
 }
 
 // GetMessage returns the value of Message.
@@ -19,39 +22,135 @@ func (s *Error) GetMessage() string {
 	return s.Message
 }
 
+// GetDetails returns the value of Details.
+func (s *Error) GetDetails() []ErrorDetailsItem {
+	return s.Details
+}
+
 // SetMessage sets the value of Message.
 func (s *Error) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*Error) projectCreateRes()             {}
-func (*Error) projectDeleteByIDRes()         {}
-func (*Error) projectGetByIDRes()            {}
-func (*Error) projectListRes()               {}
-func (*Error) projectUpdateByIDRes()         {}
-func (*Error) projectUpdateUsersRes()        {}
-func (*Error) projectUsersRes()              {}
-func (*Error) taskCreateRes()                {}
-func (*Error) taskGetByIDRes()               {}
-func (*Error) taskListRes()                  {}
-func (*Error) templateCreateFromDefaultRes() {}
-func (*Error) templateCreateRes()            {}
-func (*Error) templateDefaultListRes()       {}
-func (*Error) templateDeleteByIDRes()        {}
-func (*Error) templateGetByIDRes()           {}
-func (*Error) templateGetMetaByIDRes()       {}
-func (*Error) templateImportRes()            {}
-func (*Error) templateListRes()              {}
-func (*Error) templateUpdateByIDRes()        {}
-func (*Error) templateUpdateUsersRes()       {}
-func (*Error) templateUsersRes()             {}
-func (*Error) userCreateRes()                {}
-func (*Error) userGetByIDRes()               {}
-func (*Error) userListRes()                  {}
-func (*Error) userTokenCreateRes()           {}
-func (*Error) versionCreateFromRes()         {}
-func (*Error) versionCreateRes()             {}
-func (*Error) versionListRes()               {}
+// SetDetails sets the value of Details.
+func (s *Error) SetDetails(val []ErrorDetailsItem) {
+	s.Details = val
+}
+
+func (*Error) projectConstantListRes()         {}
+func (*Error) projectConstantSaveRes()         {}
+func (*Error) projectCreateRes()               {}
+func (*Error) projectDeleteByIDRes()           {}
+func (*Error) projectDictionaryDeleteByIDRes() {}
+func (*Error) projectDictionaryGetByIDRes()    {}
+func (*Error) projectDictionaryImportRes()     {}
+func (*Error) projectDictionaryListRes()       {}
+func (*Error) projectDictionarySaveRes()       {}
+func (*Error) projectFunctionListRes()         {}
+func (*Error) projectFunctionSaveRes()         {}
+func (*Error) projectGetByIDRes()              {}
+func (*Error) projectListRes()                 {}
+func (*Error) projectPartialListRes()          {}
+func (*Error) projectPartialSaveRes()          {}
+func (*Error) projectUpdateByIDRes()           {}
+func (*Error) projectUpdateUsersRes()          {}
+func (*Error) projectUsersRes()                {}
+func (*Error) taskCreateRes()                  {}
+func (*Error) taskGetByIDRes()                 {}
+func (*Error) taskListRes()                    {}
+func (*Error) templateCreateFromDefaultRes()   {}
+func (*Error) templateCreateRes()              {}
+func (*Error) templateDefaultListRes()         {}
+func (*Error) templateDeleteByIDRes()          {}
+func (*Error) templateGetByIDRes()             {}
+func (*Error) templateGetMetaByIDRes()         {}
+func (*Error) templateImportRes()              {}
+func (*Error) templateListRes()                {}
+func (*Error) templateUpdateByIDRes()          {}
+func (*Error) templateUpdateUsersRes()         {}
+func (*Error) templateUsersRes()               {}
+func (*Error) userCreateRes()                  {}
+func (*Error) userGetByIDRes()                 {}
+func (*Error) userListRes()                    {}
+func (*Error) userTokenCreateRes()             {}
+func (*Error) versionCreateFromRes()           {}
+func (*Error) versionCreateRes()               {}
+func (*Error) versionListRes()                 {}
+func (*Error) versionPreviewDraftRes()         {}
+func (*Error) versionPreviewRes()              {}
+
+type ErrorDetailsItem struct {
+	// Путь к полю (например, variables.0.expression).
+	Field string `json:"field"`
+	// Сообщение ошибки.
+	Message string `json:"message"`
+}
+
+// GetField returns the value of Field.
+func (s *ErrorDetailsItem) GetField() string {
+	return s.Field
+}
+
+// GetMessage returns the value of Message.
+func (s *ErrorDetailsItem) GetMessage() string {
+	return s.Message
+}
+
+// SetField sets the value of Field.
+func (s *ErrorDetailsItem) SetField(val string) {
+	s.Field = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ErrorDetailsItem) SetMessage(val string) {
+	s.Message = val
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
 // NewOptDateTime returns new OptDateTime with value set to v.
 func NewOptDateTime(v time.Time) OptDateTime {
@@ -191,6 +290,144 @@ func (o OptInt64) Or(d int64) int64 {
 	return d
 }
 
+// NewOptOutputFormat returns new OptOutputFormat with value set to v.
+func NewOptOutputFormat(v OutputFormat) OptOutputFormat {
+	return OptOutputFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOutputFormat is optional OutputFormat.
+type OptOutputFormat struct {
+	Value OutputFormat
+	Set   bool
+}
+
+// IsSet returns true if OptOutputFormat was set.
+func (o OptOutputFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOutputFormat) Reset() {
+	var v OutputFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOutputFormat) SetTo(v OutputFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOutputFormat) Get() (v OutputFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOutputFormat) Or(d OutputFormat) OutputFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptProcessError returns new OptProcessError with value set to v.
+func NewOptProcessError(v ProcessError) OptProcessError {
+	return OptProcessError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptProcessError is optional ProcessError.
+type OptProcessError struct {
+	Value ProcessError
+	Set   bool
+}
+
+// IsSet returns true if OptProcessError was set.
+func (o OptProcessError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptProcessError) Reset() {
+	var v ProcessError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptProcessError) SetTo(v ProcessError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptProcessError) Get() (v ProcessError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptProcessError) Or(d ProcessError) ProcessError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptProcessErrorTemplate returns new OptProcessErrorTemplate with value set to v.
+func NewOptProcessErrorTemplate(v ProcessErrorTemplate) OptProcessErrorTemplate {
+	return OptProcessErrorTemplate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptProcessErrorTemplate is optional ProcessErrorTemplate.
+type OptProcessErrorTemplate struct {
+	Value ProcessErrorTemplate
+	Set   bool
+}
+
+// IsSet returns true if OptProcessErrorTemplate was set.
+func (o OptProcessErrorTemplate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptProcessErrorTemplate) Reset() {
+	var v ProcessErrorTemplate
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptProcessErrorTemplate) SetTo(v ProcessErrorTemplate) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptProcessErrorTemplate) Get() (v ProcessErrorTemplate, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptProcessErrorTemplate) Or(d ProcessErrorTemplate) ProcessErrorTemplate {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptSorting returns new OptSorting with value set to v.
 func NewOptSorting(v Sorting) OptSorting {
 	return OptSorting{
@@ -283,38 +520,38 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
-// NewOptTaskGetByIDResponseTaskError returns new OptTaskGetByIDResponseTaskError with value set to v.
-func NewOptTaskGetByIDResponseTaskError(v TaskGetByIDResponseTaskError) OptTaskGetByIDResponseTaskError {
-	return OptTaskGetByIDResponseTaskError{
+// NewOptTaskGetByIDResponseTaskConstants returns new OptTaskGetByIDResponseTaskConstants with value set to v.
+func NewOptTaskGetByIDResponseTaskConstants(v TaskGetByIDResponseTaskConstants) OptTaskGetByIDResponseTaskConstants {
+	return OptTaskGetByIDResponseTaskConstants{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptTaskGetByIDResponseTaskError is optional TaskGetByIDResponseTaskError.
-type OptTaskGetByIDResponseTaskError struct {
-	Value TaskGetByIDResponseTaskError
+// OptTaskGetByIDResponseTaskConstants is optional TaskGetByIDResponseTaskConstants.
+type OptTaskGetByIDResponseTaskConstants struct {
+	Value TaskGetByIDResponseTaskConstants
 	Set   bool
 }
 
-// IsSet returns true if OptTaskGetByIDResponseTaskError was set.
-func (o OptTaskGetByIDResponseTaskError) IsSet() bool { return o.Set }
+// IsSet returns true if OptTaskGetByIDResponseTaskConstants was set.
+func (o OptTaskGetByIDResponseTaskConstants) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptTaskGetByIDResponseTaskError) Reset() {
-	var v TaskGetByIDResponseTaskError
+func (o *OptTaskGetByIDResponseTaskConstants) Reset() {
+	var v TaskGetByIDResponseTaskConstants
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptTaskGetByIDResponseTaskError) SetTo(v TaskGetByIDResponseTaskError) {
+func (o *OptTaskGetByIDResponseTaskConstants) SetTo(v TaskGetByIDResponseTaskConstants) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptTaskGetByIDResponseTaskError) Get() (v TaskGetByIDResponseTaskError, ok bool) {
+func (o OptTaskGetByIDResponseTaskConstants) Get() (v TaskGetByIDResponseTaskConstants, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -322,45 +559,45 @@ func (o OptTaskGetByIDResponseTaskError) Get() (v TaskGetByIDResponseTaskError,
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptTaskGetByIDResponseTaskError) Or(d TaskGetByIDResponseTaskError) TaskGetByIDResponseTaskError {
+func (o OptTaskGetByIDResponseTaskConstants) Or(d TaskGetByIDResponseTaskConstants) TaskGetByIDResponseTaskConstants {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptTaskGetByIDResponseTaskErrorTemplate returns new OptTaskGetByIDResponseTaskErrorTemplate with value set to v.
-func NewOptTaskGetByIDResponseTaskErrorTemplate(v TaskGetByIDResponseTaskErrorTemplate) OptTaskGetByIDResponseTaskErrorTemplate {
-	return OptTaskGetByIDResponseTaskErrorTemplate{
+// NewOptTemplateGetByIDVersion returns new OptTemplateGetByIDVersion with value set to v.
+func NewOptTemplateGetByIDVersion(v TemplateGetByIDVersion) OptTemplateGetByIDVersion {
+	return OptTemplateGetByIDVersion{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptTaskGetByIDResponseTaskErrorTemplate is optional TaskGetByIDResponseTaskErrorTemplate.
-type OptTaskGetByIDResponseTaskErrorTemplate struct {
-	Value TaskGetByIDResponseTaskErrorTemplate
+// OptTemplateGetByIDVersion is optional TemplateGetByIDVersion.
+type OptTemplateGetByIDVersion struct {
+	Value TemplateGetByIDVersion
 	Set   bool
 }
 
-// IsSet returns true if OptTaskGetByIDResponseTaskErrorTemplate was set.
-func (o OptTaskGetByIDResponseTaskErrorTemplate) IsSet() bool { return o.Set }
+// IsSet returns true if OptTemplateGetByIDVersion was set.
+func (o OptTemplateGetByIDVersion) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptTaskGetByIDResponseTaskErrorTemplate) Reset() {
-	var v TaskGetByIDResponseTaskErrorTemplate
+func (o *OptTemplateGetByIDVersion) Reset() {
+	var v TemplateGetByIDVersion
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptTaskGetByIDResponseTaskErrorTemplate) SetTo(v TaskGetByIDResponseTaskErrorTemplate) {
+func (o *OptTemplateGetByIDVersion) SetTo(v TemplateGetByIDVersion) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptTaskGetByIDResponseTaskErrorTemplate) Get() (v TaskGetByIDResponseTaskErrorTemplate, ok bool) {
+func (o OptTemplateGetByIDVersion) Get() (v TemplateGetByIDVersion, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -368,45 +605,45 @@ func (o OptTaskGetByIDResponseTaskErrorTemplate) Get() (v TaskGetByIDResponseTas
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptTaskGetByIDResponseTaskErrorTemplate) Or(d TaskGetByIDResponseTaskErrorTemplate) TaskGetByIDResponseTaskErrorTemplate {
+func (o OptTemplateGetByIDVersion) Or(d TemplateGetByIDVersion) TemplateGetByIDVersion {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptTemplateGetByIDVersion returns new OptTemplateGetByIDVersion with value set to v.
-func NewOptTemplateGetByIDVersion(v TemplateGetByIDVersion) OptTemplateGetByIDVersion {
-	return OptTemplateGetByIDVersion{
+// NewOptTemplateGetByIDVersionVariablesItemItemType returns new OptTemplateGetByIDVersionVariablesItemItemType with value set to v.
+func NewOptTemplateGetByIDVersionVariablesItemItemType(v TemplateGetByIDVersionVariablesItemItemType) OptTemplateGetByIDVersionVariablesItemItemType {
+	return OptTemplateGetByIDVersionVariablesItemItemType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptTemplateGetByIDVersion is optional TemplateGetByIDVersion.
-type OptTemplateGetByIDVersion struct {
-	Value TemplateGetByIDVersion
+// OptTemplateGetByIDVersionVariablesItemItemType is optional TemplateGetByIDVersionVariablesItemItemType.
+type OptTemplateGetByIDVersionVariablesItemItemType struct {
+	Value TemplateGetByIDVersionVariablesItemItemType
 	Set   bool
 }
 
-// IsSet returns true if OptTemplateGetByIDVersion was set.
-func (o OptTemplateGetByIDVersion) IsSet() bool { return o.Set }
+// IsSet returns true if OptTemplateGetByIDVersionVariablesItemItemType was set.
+func (o OptTemplateGetByIDVersionVariablesItemItemType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptTemplateGetByIDVersion) Reset() {
-	var v TemplateGetByIDVersion
+func (o *OptTemplateGetByIDVersionVariablesItemItemType) Reset() {
+	var v TemplateGetByIDVersionVariablesItemItemType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptTemplateGetByIDVersion) SetTo(v TemplateGetByIDVersion) {
+func (o *OptTemplateGetByIDVersionVariablesItemItemType) SetTo(v TemplateGetByIDVersionVariablesItemItemType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptTemplateGetByIDVersion) Get() (v TemplateGetByIDVersion, ok bool) {
+func (o OptTemplateGetByIDVersionVariablesItemItemType) Get() (v TemplateGetByIDVersionVariablesItemItemType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -414,7 +651,7 @@ func (o OptTemplateGetByIDVersion) Get() (v TemplateGetByIDVersion, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptTemplateGetByIDVersion) Or(d TemplateGetByIDVersion) TemplateGetByIDVersion {
+func (o OptTemplateGetByIDVersionVariablesItemItemType) Or(d TemplateGetByIDVersionVariablesItemItemType) TemplateGetByIDVersionVariablesItemItemType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -467,240 +704,222 @@ func (o OptTemplateImportVersion) Or(d TemplateImportVersion) TemplateImportVers
 	return d
 }
 
-// ProjectCreateCreated is response for ProjectCreate operation.
-type ProjectCreateCreated struct{}
-
-func (*ProjectCreateCreated) projectCreateRes() {}
-
-// Ref: #/components/schemas/ProjectCreateRequest
-type ProjectCreateRequest struct {
-	// Название проекта.
-	Name string `json:"name"`
-}
-
-// GetName returns the value of Name.
-func (s *ProjectCreateRequest) GetName() string {
-	return s.Name
+// NewOptTemplateImportVersionVariablesItemItemType returns new OptTemplateImportVersionVariablesItemItemType with value set to v.
+func NewOptTemplateImportVersionVariablesItemItemType(v TemplateImportVersionVariablesItemItemType) OptTemplateImportVersionVariablesItemItemType {
+	return OptTemplateImportVersionVariablesItemItemType{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetName sets the value of Name.
-func (s *ProjectCreateRequest) SetName(val string) {
-	s.Name = val
+// OptTemplateImportVersionVariablesItemItemType is optional TemplateImportVersionVariablesItemItemType.
+type OptTemplateImportVersionVariablesItemItemType struct {
+	Value TemplateImportVersionVariablesItemItemType
+	Set   bool
 }
 
-// ProjectDeleteByIDNoContent is response for ProjectDeleteByID operation.
-type ProjectDeleteByIDNoContent struct{}
+// IsSet returns true if OptTemplateImportVersionVariablesItemItemType was set.
+func (o OptTemplateImportVersionVariablesItemItemType) IsSet() bool { return o.Set }
 
-func (*ProjectDeleteByIDNoContent) projectDeleteByIDRes() {}
+// Reset unsets value.
+func (o *OptTemplateImportVersionVariablesItemItemType) Reset() {
+	var v TemplateImportVersionVariablesItemItemType
+	o.Value = v
+	o.Set = false
+}
 
-// Ref: #/components/schemas/ProjectGetByIDResponse
-type ProjectGetByIDResponse struct {
-	// Название проекта.
-	Name string `json:"name"`
-	// Имя автора проекта.
-	AuthorName string `json:"authorName"`
+// SetTo sets value to v.
+func (o *OptTemplateImportVersionVariablesItemItemType) SetTo(v TemplateImportVersionVariablesItemItemType) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetName returns the value of Name.
-func (s *ProjectGetByIDResponse) GetName() string {
-	return s.Name
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTemplateImportVersionVariablesItemItemType) Get() (v TemplateImportVersionVariablesItemItemType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetAuthorName returns the value of AuthorName.
-func (s *ProjectGetByIDResponse) GetAuthorName() string {
-	return s.AuthorName
+// Or returns value if set, or given parameter if does not.
+func (o OptTemplateImportVersionVariablesItemItemType) Or(d TemplateImportVersionVariablesItemItemType) TemplateImportVersionVariablesItemItemType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetName sets the value of Name.
-func (s *ProjectGetByIDResponse) SetName(val string) {
-	s.Name = val
+// NewOptTraceItemSource returns new OptTraceItemSource with value set to v.
+func NewOptTraceItemSource(v TraceItemSource) OptTraceItemSource {
+	return OptTraceItemSource{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetAuthorName sets the value of AuthorName.
-func (s *ProjectGetByIDResponse) SetAuthorName(val string) {
-	s.AuthorName = val
+// OptTraceItemSource is optional TraceItemSource.
+type OptTraceItemSource struct {
+	Value TraceItemSource
+	Set   bool
 }
 
-func (*ProjectGetByIDResponse) projectGetByIDRes() {}
+// IsSet returns true if OptTraceItemSource was set.
+func (o OptTraceItemSource) IsSet() bool { return o.Set }
 
-// Ref: #/components/schemas/ProjectListResponse
-type ProjectListResponse struct {
-	// Список проектов.
-	Projects []ProjectListResponseProjectsItem `json:"projects"`
-	// Общее количество проектов.
-	TotalProjects int64 `json:"totalProjects"`
-	// Общее количество страниц.
-	TotalPages int64 `json:"totalPages"`
+// Reset unsets value.
+func (o *OptTraceItemSource) Reset() {
+	var v TraceItemSource
+	o.Value = v
+	o.Set = false
 }
 
-// GetProjects returns the value of Projects.
-func (s *ProjectListResponse) GetProjects() []ProjectListResponseProjectsItem {
-	return s.Projects
+// SetTo sets value to v.
+func (o *OptTraceItemSource) SetTo(v TraceItemSource) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetTotalProjects returns the value of TotalProjects.
-func (s *ProjectListResponse) GetTotalProjects() int64 {
-	return s.TotalProjects
-}
-
-// GetTotalPages returns the value of TotalPages.
-func (s *ProjectListResponse) GetTotalPages() int64 {
-	return s.TotalPages
-}
-
-// SetProjects sets the value of Projects.
-func (s *ProjectListResponse) SetProjects(val []ProjectListResponseProjectsItem) {
-	s.Projects = val
-}
-
-// SetTotalProjects sets the value of TotalProjects.
-func (s *ProjectListResponse) SetTotalProjects(val int64) {
-	s.TotalProjects = val
-}
-
-// SetTotalPages sets the value of TotalPages.
-func (s *ProjectListResponse) SetTotalPages(val int64) {
-	s.TotalPages = val
-}
-
-func (*ProjectListResponse) projectListRes() {}
-
-// Проект.
-type ProjectListResponseProjectsItem struct {
-	// ID проекта.
-	ID int64 `json:"id"`
-	// Название проекта.
-	Name string `json:"name"`
-	// Имя автора проекта.
-	AuthorName string `json:"authorName"`
-}
-
-// GetID returns the value of ID.
-func (s *ProjectListResponseProjectsItem) GetID() int64 {
-	return s.ID
-}
-
-// GetName returns the value of Name.
-func (s *ProjectListResponseProjectsItem) GetName() string {
-	return s.Name
-}
-
-// GetAuthorName returns the value of AuthorName.
-func (s *ProjectListResponseProjectsItem) GetAuthorName() string {
-	return s.AuthorName
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTraceItemSource) Get() (v TraceItemSource, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetID sets the value of ID.
-func (s *ProjectListResponseProjectsItem) SetID(val int64) {
-	s.ID = val
+// Or returns value if set, or given parameter if does not.
+func (o OptTraceItemSource) Or(d TraceItemSource) TraceItemSource {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetName sets the value of Name.
-func (s *ProjectListResponseProjectsItem) SetName(val string) {
-	s.Name = val
+// NewOptVersionCreateRequestVariablesItemItemType returns new OptVersionCreateRequestVariablesItemItemType with value set to v.
+func NewOptVersionCreateRequestVariablesItemItemType(v VersionCreateRequestVariablesItemItemType) OptVersionCreateRequestVariablesItemItemType {
+	return OptVersionCreateRequestVariablesItemItemType{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetAuthorName sets the value of AuthorName.
-func (s *ProjectListResponseProjectsItem) SetAuthorName(val string) {
-	s.AuthorName = val
+// OptVersionCreateRequestVariablesItemItemType is optional VersionCreateRequestVariablesItemItemType.
+type OptVersionCreateRequestVariablesItemItemType struct {
+	Value VersionCreateRequestVariablesItemItemType
+	Set   bool
 }
 
-// ProjectUpdateByIDNoContent is response for ProjectUpdateByID operation.
-type ProjectUpdateByIDNoContent struct{}
-
-func (*ProjectUpdateByIDNoContent) projectUpdateByIDRes() {}
+// IsSet returns true if OptVersionCreateRequestVariablesItemItemType was set.
+func (o OptVersionCreateRequestVariablesItemItemType) IsSet() bool { return o.Set }
 
-// Ref: #/components/schemas/ProjectUpdateRequest
-type ProjectUpdateRequest struct {
-	// Название проекта.
-	Name string `json:"name"`
+// Reset unsets value.
+func (o *OptVersionCreateRequestVariablesItemItemType) Reset() {
+	var v VersionCreateRequestVariablesItemItemType
+	o.Value = v
+	o.Set = false
 }
 
-// GetName returns the value of Name.
-func (s *ProjectUpdateRequest) GetName() string {
-	return s.Name
+// SetTo sets value to v.
+func (o *OptVersionCreateRequestVariablesItemItemType) SetTo(v VersionCreateRequestVariablesItemItemType) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetName sets the value of Name.
-func (s *ProjectUpdateRequest) SetName(val string) {
-	s.Name = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptVersionCreateRequestVariablesItemItemType) Get() (v VersionCreateRequestVariablesItemItemType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// ProjectUpdateUsersNoContent is response for ProjectUpdateUsers operation.
-type ProjectUpdateUsersNoContent struct{}
-
-func (*ProjectUpdateUsersNoContent) projectUpdateUsersRes() {}
-
-// Ref: #/components/schemas/ProjectUpdateUsersRequest
-type ProjectUpdateUsersRequest struct {
-	// Список пользователей проекта.
-	Users []ProjectUpdateUsersRequestUsersItem `json:"users"`
+// Or returns value if set, or given parameter if does not.
+func (o OptVersionCreateRequestVariablesItemItemType) Or(d VersionCreateRequestVariablesItemItemType) VersionCreateRequestVariablesItemItemType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetUsers returns the value of Users.
-func (s *ProjectUpdateUsersRequest) GetUsers() []ProjectUpdateUsersRequestUsersItem {
-	return s.Users
+// NewOptVersionPreviewDraftRequestVariablesItemItemType returns new OptVersionPreviewDraftRequestVariablesItemItemType with value set to v.
+func NewOptVersionPreviewDraftRequestVariablesItemItemType(v VersionPreviewDraftRequestVariablesItemItemType) OptVersionPreviewDraftRequestVariablesItemItemType {
+	return OptVersionPreviewDraftRequestVariablesItemItemType{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetUsers sets the value of Users.
-func (s *ProjectUpdateUsersRequest) SetUsers(val []ProjectUpdateUsersRequestUsersItem) {
-	s.Users = val
+// OptVersionPreviewDraftRequestVariablesItemItemType is optional VersionPreviewDraftRequestVariablesItemItemType.
+type OptVersionPreviewDraftRequestVariablesItemItemType struct {
+	Value VersionPreviewDraftRequestVariablesItemItemType
+	Set   bool
 }
 
-// Пользователь проекта.
-type ProjectUpdateUsersRequestUsersItem struct {
-	// ID пользователя.
-	ID int64 `json:"id"`
-	// Роль пользователя в проекте.
-	Role ProjectUpdateUsersRequestUsersItemRole `json:"role"`
-}
+// IsSet returns true if OptVersionPreviewDraftRequestVariablesItemItemType was set.
+func (o OptVersionPreviewDraftRequestVariablesItemItemType) IsSet() bool { return o.Set }
 
-// GetID returns the value of ID.
-func (s *ProjectUpdateUsersRequestUsersItem) GetID() int64 {
-	return s.ID
+// Reset unsets value.
+func (o *OptVersionPreviewDraftRequestVariablesItemItemType) Reset() {
+	var v VersionPreviewDraftRequestVariablesItemItemType
+	o.Value = v
+	o.Set = false
 }
 
-// GetRole returns the value of Role.
-func (s *ProjectUpdateUsersRequestUsersItem) GetRole() ProjectUpdateUsersRequestUsersItemRole {
-	return s.Role
+// SetTo sets value to v.
+func (o *OptVersionPreviewDraftRequestVariablesItemItemType) SetTo(v VersionPreviewDraftRequestVariablesItemItemType) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetID sets the value of ID.
-func (s *ProjectUpdateUsersRequestUsersItem) SetID(val int64) {
-	s.ID = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptVersionPreviewDraftRequestVariablesItemItemType) Get() (v VersionPreviewDraftRequestVariablesItemItemType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetRole sets the value of Role.
-func (s *ProjectUpdateUsersRequestUsersItem) SetRole(val ProjectUpdateUsersRequestUsersItemRole) {
-	s.Role = val
+// Or returns value if set, or given parameter if does not.
+func (o OptVersionPreviewDraftRequestVariablesItemItemType) Or(d VersionPreviewDraftRequestVariablesItemItemType) VersionPreviewDraftRequestVariablesItemItemType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// Роль пользователя в проекте.
-type ProjectUpdateUsersRequestUsersItemRole string
+// Формат результата (md — Markdown, docx — документ Word, pdf —
+// документ PDF, xlsx — книга Excel).
+// Ref: #/components/schemas/OutputFormat
+type OutputFormat string
 
 const (
-	ProjectUpdateUsersRequestUsersItemRoleRead     ProjectUpdateUsersRequestUsersItemRole = "read"
-	ProjectUpdateUsersRequestUsersItemRoleWrite    ProjectUpdateUsersRequestUsersItemRole = "write"
-	ProjectUpdateUsersRequestUsersItemRoleMaintain ProjectUpdateUsersRequestUsersItemRole = "maintain"
+	OutputFormatMd   OutputFormat = "md"
+	OutputFormatDocx OutputFormat = "docx"
+	OutputFormatPdf  OutputFormat = "pdf"
+	OutputFormatXlsx OutputFormat = "xlsx"
 )
 
-// AllValues returns all ProjectUpdateUsersRequestUsersItemRole values.
-func (ProjectUpdateUsersRequestUsersItemRole) AllValues() []ProjectUpdateUsersRequestUsersItemRole {
-	return []ProjectUpdateUsersRequestUsersItemRole{
-		ProjectUpdateUsersRequestUsersItemRoleRead,
-		ProjectUpdateUsersRequestUsersItemRoleWrite,
-		ProjectUpdateUsersRequestUsersItemRoleMaintain,
+// AllValues returns all OutputFormat values.
+func (OutputFormat) AllValues() []OutputFormat {
+	return []OutputFormat{
+		OutputFormatMd,
+		OutputFormatDocx,
+		OutputFormatPdf,
+		OutputFormatXlsx,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s ProjectUpdateUsersRequestUsersItemRole) MarshalText() ([]byte, error) {
+func (s OutputFormat) MarshalText() ([]byte, error) {
 	switch s {
-	case ProjectUpdateUsersRequestUsersItemRoleRead:
+	case OutputFormatMd:
 		return []byte(s), nil
-	case ProjectUpdateUsersRequestUsersItemRoleWrite:
+	case OutputFormatDocx:
 		return []byte(s), nil
-	case ProjectUpdateUsersRequestUsersItemRoleMaintain:
+	case OutputFormatPdf:
+		return []byte(s), nil
+	case OutputFormatXlsx:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -708,763 +927,4007 @@ func (s ProjectUpdateUsersRequestUsersItemRole) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ProjectUpdateUsersRequestUsersItemRole) UnmarshalText(data []byte) error {
-	switch ProjectUpdateUsersRequestUsersItemRole(data) {
-	case ProjectUpdateUsersRequestUsersItemRoleRead:
-		*s = ProjectUpdateUsersRequestUsersItemRoleRead
+func (s *OutputFormat) UnmarshalText(data []byte) error {
+	switch OutputFormat(data) {
+	case OutputFormatMd:
+		*s = OutputFormatMd
 		return nil
-	case ProjectUpdateUsersRequestUsersItemRoleWrite:
-		*s = ProjectUpdateUsersRequestUsersItemRoleWrite
+	case OutputFormatDocx:
+		*s = OutputFormatDocx
 		return nil
-	case ProjectUpdateUsersRequestUsersItemRoleMaintain:
-		*s = ProjectUpdateUsersRequestUsersItemRoleMaintain
+	case OutputFormatPdf:
+		*s = OutputFormatPdf
+		return nil
+	case OutputFormatXlsx:
+		*s = OutputFormatXlsx
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Ref: #/components/schemas/ProjectUsersResponse
-type ProjectUsersResponse struct {
-	// Список пользователей проекта.
-	Users []ProjectUsersResponseUsersItem `json:"users"`
+// Ошибка обработки задачи генерации.
+// Ref: #/components/schemas/ProcessError
+type ProcessError struct {
+	// Сообщение ошибки.
+	Message OptString `json:"message"`
+	// Путь цикличной зависимости переменных (например, a, b, c,
+	//  a).
+	Cycle []string `json:"cycle"`
+	// Локализация ошибки внутри текста шаблона.
+	Template       OptProcessErrorTemplate          `json:"template"`
+	VariableErrors []ProcessErrorVariableErrorsItem `json:"variableErrors"`
 }
 
-// GetUsers returns the value of Users.
-func (s *ProjectUsersResponse) GetUsers() []ProjectUsersResponseUsersItem {
-	return s.Users
+// GetMessage returns the value of Message.
+func (s *ProcessError) GetMessage() OptString {
+	return s.Message
 }
 
-// SetUsers sets the value of Users.
-func (s *ProjectUsersResponse) SetUsers(val []ProjectUsersResponseUsersItem) {
-	s.Users = val
+// GetCycle returns the value of Cycle.
+func (s *ProcessError) GetCycle() []string {
+	return s.Cycle
 }
 
-func (*ProjectUsersResponse) projectUsersRes() {}
+// GetTemplate returns the value of Template.
+func (s *ProcessError) GetTemplate() OptProcessErrorTemplate {
+	return s.Template
+}
 
-// Пользователь проекта.
-type ProjectUsersResponseUsersItem struct {
-	// ID пользователя.
-	ID int64 `json:"id"`
-	// Имя пользователя.
-	Name string `json:"name"`
-	// Электронный адрес пользователя.
-	Email string `json:"email"`
-	// Роль пользователя в проекте.
-	Role ProjectUsersResponseUsersItemRole `json:"role"`
+// GetVariableErrors returns the value of VariableErrors.
+func (s *ProcessError) GetVariableErrors() []ProcessErrorVariableErrorsItem {
+	return s.VariableErrors
 }
 
-// GetID returns the value of ID.
-func (s *ProjectUsersResponseUsersItem) GetID() int64 {
-	return s.ID
+// SetMessage sets the value of Message.
+func (s *ProcessError) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// GetName returns the value of Name.
-func (s *ProjectUsersResponseUsersItem) GetName() string {
-	return s.Name
+// SetCycle sets the value of Cycle.
+func (s *ProcessError) SetCycle(val []string) {
+	s.Cycle = val
 }
 
-// GetEmail returns the value of Email.
-func (s *ProjectUsersResponseUsersItem) GetEmail() string {
-	return s.Email
+// SetTemplate sets the value of Template.
+func (s *ProcessError) SetTemplate(val OptProcessErrorTemplate) {
+	s.Template = val
 }
 
-// GetRole returns the value of Role.
-func (s *ProjectUsersResponseUsersItem) GetRole() ProjectUsersResponseUsersItemRole {
-	return s.Role
+// SetVariableErrors sets the value of VariableErrors.
+func (s *ProcessError) SetVariableErrors(val []ProcessErrorVariableErrorsItem) {
+	s.VariableErrors = val
 }
 
-// SetID sets the value of ID.
-func (s *ProjectUsersResponseUsersItem) SetID(val int64) {
-	s.ID = val
+// Локализация ошибки внутри текста шаблона.
+type ProcessErrorTemplate struct {
+	// Номер строки в шаблоне (начиная с 1).
+	Line int `json:"line"`
+	// Номер столбца в шаблоне (начиная с 1); отсутствует,
+	// если неизвестен.
+	Column OptInt `json:"column"`
+	// Содержимое строки шаблона, на которой произошла
+	// ошибка.
+	Snippet OptString `json:"snippet"`
+	// Подробное диагностическое сообщение из движка
+	// шаблонов.
+	Detail OptString `json:"detail"`
+	// Имя фрагмента проекта, в котором произошла ошибка;
+	// отсутствует, если ошибка в самом шаблоне.
+	Partial OptString `json:"partial"`
 }
 
-// SetName sets the value of Name.
-func (s *ProjectUsersResponseUsersItem) SetName(val string) {
-	s.Name = val
+// GetLine returns the value of Line.
+func (s *ProcessErrorTemplate) GetLine() int {
+	return s.Line
 }
 
-// SetEmail sets the value of Email.
-func (s *ProjectUsersResponseUsersItem) SetEmail(val string) {
-	s.Email = val
+// GetColumn returns the value of Column.
+func (s *ProcessErrorTemplate) GetColumn() OptInt {
+	return s.Column
 }
 
-// SetRole sets the value of Role.
-func (s *ProjectUsersResponseUsersItem) SetRole(val ProjectUsersResponseUsersItemRole) {
-	s.Role = val
+// GetSnippet returns the value of Snippet.
+func (s *ProcessErrorTemplate) GetSnippet() OptString {
+	return s.Snippet
 }
 
-// Роль пользователя в проекте.
-type ProjectUsersResponseUsersItemRole string
-
-const (
-	ProjectUsersResponseUsersItemRoleRead     ProjectUsersResponseUsersItemRole = "read"
-	ProjectUsersResponseUsersItemRoleWrite    ProjectUsersResponseUsersItemRole = "write"
-	ProjectUsersResponseUsersItemRoleMaintain ProjectUsersResponseUsersItemRole = "maintain"
-)
-
-// AllValues returns all ProjectUsersResponseUsersItemRole values.
-func (ProjectUsersResponseUsersItemRole) AllValues() []ProjectUsersResponseUsersItemRole {
-	return []ProjectUsersResponseUsersItemRole{
-		ProjectUsersResponseUsersItemRoleRead,
-		ProjectUsersResponseUsersItemRoleWrite,
-		ProjectUsersResponseUsersItemRoleMaintain,
-	}
+// GetDetail returns the value of Detail.
+func (s *ProcessErrorTemplate) GetDetail() OptString {
+	return s.Detail
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s ProjectUsersResponseUsersItemRole) MarshalText() ([]byte, error) {
-	switch s {
-	case ProjectUsersResponseUsersItemRoleRead:
-		return []byte(s), nil
-	case ProjectUsersResponseUsersItemRoleWrite:
-		return []byte(s), nil
-	case ProjectUsersResponseUsersItemRoleMaintain:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetPartial returns the value of Partial.
+func (s *ProcessErrorTemplate) GetPartial() OptString {
+	return s.Partial
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ProjectUsersResponseUsersItemRole) UnmarshalText(data []byte) error {
-	switch ProjectUsersResponseUsersItemRole(data) {
-	case ProjectUsersResponseUsersItemRoleRead:
-		*s = ProjectUsersResponseUsersItemRoleRead
-		return nil
-	case ProjectUsersResponseUsersItemRoleWrite:
-		*s = ProjectUsersResponseUsersItemRoleWrite
-		return nil
-	case ProjectUsersResponseUsersItemRoleMaintain:
-		*s = ProjectUsersResponseUsersItemRoleMaintain
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetLine sets the value of Line.
+func (s *ProcessErrorTemplate) SetLine(val int) {
+	s.Line = val
 }
 
-type Sorting struct {
-	// Атрибут, по которому сортируется список.
-	Attribute string `json:"attribute"`
-	// Направление, по которому сортируется список.
-	Direction SortingDirection `json:"direction"`
+// SetColumn sets the value of Column.
+func (s *ProcessErrorTemplate) SetColumn(val OptInt) {
+	s.Column = val
 }
 
-// GetAttribute returns the value of Attribute.
-func (s *Sorting) GetAttribute() string {
-	return s.Attribute
+// SetSnippet sets the value of Snippet.
+func (s *ProcessErrorTemplate) SetSnippet(val OptString) {
+	s.Snippet = val
 }
 
-// GetDirection returns the value of Direction.
-func (s *Sorting) GetDirection() SortingDirection {
-	return s.Direction
+// SetDetail sets the value of Detail.
+func (s *ProcessErrorTemplate) SetDetail(val OptString) {
+	s.Detail = val
 }
 
-// SetAttribute sets the value of Attribute.
-func (s *Sorting) SetAttribute(val string) {
-	s.Attribute = val
+// SetPartial sets the value of Partial.
+func (s *ProcessErrorTemplate) SetPartial(val OptString) {
+	s.Partial = val
 }
 
-// SetDirection sets the value of Direction.
-func (s *Sorting) SetDirection(val SortingDirection) {
-	s.Direction = val
+// Ошибка обработки переменных.
+type ProcessErrorVariableErrorsItem struct {
+	// ID переменной.
+	ID int64 `json:"id"`
+	// Слаг переменной.
+	Name string `json:"name"`
+	// Человекочитаемое название переменной.
+	Title string `json:"title"`
+	// Вычисленное значение переменной, на котором
+	// сработала проверка ограничений.
+	Value OptString `json:"value"`
+	// Положение ошибочного значения внутри списка или
+	// таблицы (например, 2.cost).
+	Path OptString `json:"path"`
+	// Сообщение ошибки.
+	Message          OptString                                            `json:"message"`
+	ConstraintErrors []ProcessErrorVariableErrorsItemConstraintErrorsItem `json:"constraintErrors"`
 }
 
-// Направление, по которому сортируется список.
-type SortingDirection string
-
-const (
-	SortingDirectionASC  SortingDirection = "ASC"
-	SortingDirectionDESC SortingDirection = "DESC"
-)
-
-// AllValues returns all SortingDirection values.
-func (SortingDirection) AllValues() []SortingDirection {
-	return []SortingDirection{
-		SortingDirectionASC,
-		SortingDirectionDESC,
-	}
+// GetID returns the value of ID.
+func (s *ProcessErrorVariableErrorsItem) GetID() int64 {
+	return s.ID
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s SortingDirection) MarshalText() ([]byte, error) {
-	switch s {
-	case SortingDirectionASC:
-		return []byte(s), nil
-	case SortingDirectionDESC:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetName returns the value of Name.
+func (s *ProcessErrorVariableErrorsItem) GetName() string {
+	return s.Name
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *SortingDirection) UnmarshalText(data []byte) error {
-	switch SortingDirection(data) {
-	case SortingDirectionASC:
-		*s = SortingDirectionASC
-		return nil
-	case SortingDirectionDESC:
-		*s = SortingDirectionDESC
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetTitle returns the value of Title.
+func (s *ProcessErrorVariableErrorsItem) GetTitle() string {
+	return s.Title
 }
 
-// TaskCreateCreated is response for TaskCreate operation.
-type TaskCreateCreated struct{}
-
-func (*TaskCreateCreated) taskCreateRes() {}
-
-// Ref: #/components/schemas/TaskCreateRequest
-type TaskCreateRequest struct {
-	// ID версии шаблона.
-	VersionID int64 `json:"versionID"`
-	// Пэйлоад задачи.
-	Payload TaskCreateRequestPayload `json:"payload"`
+// GetValue returns the value of Value.
+func (s *ProcessErrorVariableErrorsItem) GetValue() OptString {
+	return s.Value
 }
 
-// GetVersionID returns the value of VersionID.
-func (s *TaskCreateRequest) GetVersionID() int64 {
-	return s.VersionID
+// GetPath returns the value of Path.
+func (s *ProcessErrorVariableErrorsItem) GetPath() OptString {
+	return s.Path
 }
 
-// GetPayload returns the value of Payload.
-func (s *TaskCreateRequest) GetPayload() TaskCreateRequestPayload {
-	return s.Payload
+// GetMessage returns the value of Message.
+func (s *ProcessErrorVariableErrorsItem) GetMessage() OptString {
+	return s.Message
 }
 
-// SetVersionID sets the value of VersionID.
-func (s *TaskCreateRequest) SetVersionID(val int64) {
-	s.VersionID = val
+// GetConstraintErrors returns the value of ConstraintErrors.
+func (s *ProcessErrorVariableErrorsItem) GetConstraintErrors() []ProcessErrorVariableErrorsItemConstraintErrorsItem {
+	return s.ConstraintErrors
 }
 
-// SetPayload sets the value of Payload.
-func (s *TaskCreateRequest) SetPayload(val TaskCreateRequestPayload) {
-	s.Payload = val
+// SetID sets the value of ID.
+func (s *ProcessErrorVariableErrorsItem) SetID(val int64) {
+	s.ID = val
 }
 
-// Пэйлоад задачи.
-type TaskCreateRequestPayload map[string]string
-
-func (s *TaskCreateRequestPayload) init() TaskCreateRequestPayload {
-	m := *s
-	if m == nil {
-		m = map[string]string{}
-		*s = m
-	}
-	return m
+// SetName sets the value of Name.
+func (s *ProcessErrorVariableErrorsItem) SetName(val string) {
+	s.Name = val
 }
 
-// Ref: #/components/schemas/TaskGetByIDResponse
-type TaskGetByIDResponse struct {
-	Task   TaskGetByIDResponseTask `json:"task"`
-	Result []byte                  `json:"result"`
+// SetTitle sets the value of Title.
+func (s *ProcessErrorVariableErrorsItem) SetTitle(val string) {
+	s.Title = val
 }
 
-// GetTask returns the value of Task.
-func (s *TaskGetByIDResponse) GetTask() TaskGetByIDResponseTask {
-	return s.Task
+// SetValue sets the value of Value.
+func (s *ProcessErrorVariableErrorsItem) SetValue(val OptString) {
+	s.Value = val
 }
 
-// GetResult returns the value of Result.
-func (s *TaskGetByIDResponse) GetResult() []byte {
-	return s.Result
+// SetPath sets the value of Path.
+func (s *ProcessErrorVariableErrorsItem) SetPath(val OptString) {
+	s.Path = val
 }
 
-// SetTask sets the value of Task.
-func (s *TaskGetByIDResponse) SetTask(val TaskGetByIDResponseTask) {
-	s.Task = val
+// SetMessage sets the value of Message.
+func (s *ProcessErrorVariableErrorsItem) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// SetResult sets the value of Result.
-func (s *TaskGetByIDResponse) SetResult(val []byte) {
-	s.Result = val
+// SetConstraintErrors sets the value of ConstraintErrors.
+func (s *ProcessErrorVariableErrorsItem) SetConstraintErrors(val []ProcessErrorVariableErrorsItemConstraintErrorsItem) {
+	s.ConstraintErrors = val
 }
 
-func (*TaskGetByIDResponse) taskGetByIDRes() {}
-
-type TaskGetByIDResponseTask struct {
-	// ID задачи.
+// Ошибка обработки ограничений.
+type ProcessErrorVariableErrorsItemConstraintErrorsItem struct {
+	// ID ограничения.
 	ID int64 `json:"id"`
-	// ID версии.
-	VersionID int64      `json:"versionID"`
-	Status    TaskStatus `json:"status"`
-	// Пэйлоад задачи.
-	Payload TaskGetByIDResponseTaskPayload `json:"payload"`
-	// Ошибка обработки задачи.
-	Error OptTaskGetByIDResponseTaskError `json:"error"`
-	// Имя создателя задачи.
-	CreatorName string `json:"creatorName"`
-	// Дата и время создания задачи.
-	CreatedAt time.Time `json:"createdAt"`
-	// Дата и время обновления задачи.
-	UpdatedAt OptDateTime `json:"updatedAt"`
+	// Название ограничения.
+	Name string `json:"name"`
+	// Выражение ограничения.
+	Expression string `json:"expression"`
+	// Сообщение ошибки.
+	Message OptString `json:"message"`
+	// Переменные, участвовавшие в проверке ограничения.
+	Variables []ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem `json:"variables"`
 }
 
 // GetID returns the value of ID.
-func (s *TaskGetByIDResponseTask) GetID() int64 {
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) GetID() int64 {
 	return s.ID
 }
 
-// GetVersionID returns the value of VersionID.
-func (s *TaskGetByIDResponseTask) GetVersionID() int64 {
-	return s.VersionID
+// GetName returns the value of Name.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) GetName() string {
+	return s.Name
 }
 
-// GetStatus returns the value of Status.
-func (s *TaskGetByIDResponseTask) GetStatus() TaskStatus {
-	return s.Status
+// GetExpression returns the value of Expression.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) GetExpression() string {
+	return s.Expression
 }
 
-// GetPayload returns the value of Payload.
-func (s *TaskGetByIDResponseTask) GetPayload() TaskGetByIDResponseTaskPayload {
-	return s.Payload
+// GetMessage returns the value of Message.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) GetMessage() OptString {
+	return s.Message
 }
 
-// GetError returns the value of Error.
-func (s *TaskGetByIDResponseTask) GetError() OptTaskGetByIDResponseTaskError {
-	return s.Error
+// GetVariables returns the value of Variables.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) GetVariables() []ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem {
+	return s.Variables
 }
 
-// GetCreatorName returns the value of CreatorName.
-func (s *TaskGetByIDResponseTask) GetCreatorName() string {
-	return s.CreatorName
+// SetID sets the value of ID.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) SetID(val int64) {
+	s.ID = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *TaskGetByIDResponseTask) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// SetName sets the value of Name.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) SetName(val string) {
+	s.Name = val
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *TaskGetByIDResponseTask) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// SetExpression sets the value of Expression.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) SetExpression(val string) {
+	s.Expression = val
 }
 
-// SetID sets the value of ID.
-func (s *TaskGetByIDResponseTask) SetID(val int64) {
-	s.ID = val
+// SetMessage sets the value of Message.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// SetVersionID sets the value of VersionID.
-func (s *TaskGetByIDResponseTask) SetVersionID(val int64) {
-	s.VersionID = val
-}
+// SetVariables sets the value of Variables.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItem) SetVariables(val []ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem) {
+	s.Variables = val
+}
 
-// SetStatus sets the value of Status.
-func (s *TaskGetByIDResponseTask) SetStatus(val TaskStatus) {
-	s.Status = val
+type ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem struct {
+	// Слаг переменной.
+	Name string `json:"name"`
+	// Значение переменной на момент проверки.
+	Value OptString `json:"value"`
 }
 
-// SetPayload sets the value of Payload.
-func (s *TaskGetByIDResponseTask) SetPayload(val TaskGetByIDResponseTaskPayload) {
-	s.Payload = val
+// GetName returns the value of Name.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem) GetName() string {
+	return s.Name
 }
 
-// SetError sets the value of Error.
-func (s *TaskGetByIDResponseTask) SetError(val OptTaskGetByIDResponseTaskError) {
-	s.Error = val
+// GetValue returns the value of Value.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem) GetValue() OptString {
+	return s.Value
 }
 
-// SetCreatorName sets the value of CreatorName.
-func (s *TaskGetByIDResponseTask) SetCreatorName(val string) {
-	s.CreatorName = val
+// SetName sets the value of Name.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem) SetName(val string) {
+	s.Name = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *TaskGetByIDResponseTask) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetValue sets the value of Value.
+func (s *ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem) SetValue(val OptString) {
+	s.Value = val
+}
+
+// Ref: #/components/schemas/ProjectConstantListResponse
+type ProjectConstantListResponse struct {
+	// Список констант проекта, доступных в шаблонах как .
+	// project.<название>.
+	Constants []ProjectConstantListResponseConstantsItem `json:"constants"`
+}
+
+// GetConstants returns the value of Constants.
+func (s *ProjectConstantListResponse) GetConstants() []ProjectConstantListResponseConstantsItem {
+	return s.Constants
+}
+
+// SetConstants sets the value of Constants.
+func (s *ProjectConstantListResponse) SetConstants(val []ProjectConstantListResponseConstantsItem) {
+	s.Constants = val
+}
+
+func (*ProjectConstantListResponse) projectConstantListRes() {}
+
+// Константа проекта.
+type ProjectConstantListResponseConstantsItem struct {
+	// Название константы.
+	Name string `json:"name"`
+	// Значение константы.
+	Value string `json:"value"`
+}
+
+// GetName returns the value of Name.
+func (s *ProjectConstantListResponseConstantsItem) GetName() string {
+	return s.Name
+}
+
+// GetValue returns the value of Value.
+func (s *ProjectConstantListResponseConstantsItem) GetValue() string {
+	return s.Value
+}
+
+// SetName sets the value of Name.
+func (s *ProjectConstantListResponseConstantsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetValue sets the value of Value.
+func (s *ProjectConstantListResponseConstantsItem) SetValue(val string) {
+	s.Value = val
+}
+
+// ProjectConstantSaveNoContent is response for ProjectConstantSave operation.
+type ProjectConstantSaveNoContent struct{}
+
+func (*ProjectConstantSaveNoContent) projectConstantSaveRes() {}
+
+// Ref: #/components/schemas/ProjectConstantSaveRequest
+type ProjectConstantSaveRequest struct {
+	// Список констант проекта, доступных в шаблонах как .
+	// project.<название>.
+	Constants []ProjectConstantSaveRequestConstantsItem `json:"constants"`
+}
+
+// GetConstants returns the value of Constants.
+func (s *ProjectConstantSaveRequest) GetConstants() []ProjectConstantSaveRequestConstantsItem {
+	return s.Constants
+}
+
+// SetConstants sets the value of Constants.
+func (s *ProjectConstantSaveRequest) SetConstants(val []ProjectConstantSaveRequestConstantsItem) {
+	s.Constants = val
+}
+
+// Константа проекта.
+type ProjectConstantSaveRequestConstantsItem struct {
+	// Название константы.
+	Name string `json:"name"`
+	// Значение константы.
+	Value string `json:"value"`
+}
+
+// GetName returns the value of Name.
+func (s *ProjectConstantSaveRequestConstantsItem) GetName() string {
+	return s.Name
+}
+
+// GetValue returns the value of Value.
+func (s *ProjectConstantSaveRequestConstantsItem) GetValue() string {
+	return s.Value
+}
+
+// SetName sets the value of Name.
+func (s *ProjectConstantSaveRequestConstantsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetValue sets the value of Value.
+func (s *ProjectConstantSaveRequestConstantsItem) SetValue(val string) {
+	s.Value = val
+}
+
+// ProjectCreateCreated is response for ProjectCreate operation.
+type ProjectCreateCreated struct{}
+
+func (*ProjectCreateCreated) projectCreateRes() {}
+
+// Ref: #/components/schemas/ProjectCreateRequest
+type ProjectCreateRequest struct {
+	// Название проекта.
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *ProjectCreateRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *ProjectCreateRequest) SetName(val string) {
+	s.Name = val
+}
+
+// ProjectDeleteByIDNoContent is response for ProjectDeleteByID operation.
+type ProjectDeleteByIDNoContent struct{}
+
+func (*ProjectDeleteByIDNoContent) projectDeleteByIDRes() {}
+
+// ProjectDictionaryDeleteByIDNoContent is response for ProjectDictionaryDeleteByID operation.
+type ProjectDictionaryDeleteByIDNoContent struct{}
+
+func (*ProjectDictionaryDeleteByIDNoContent) projectDictionaryDeleteByIDRes() {}
+
+// Ref: #/components/schemas/ProjectDictionaryGetByIDResponse
+type ProjectDictionaryGetByIDResponse struct {
+	// ID справочника.
+	ID int64 `json:"id"`
+	// Название справочника.
+	Name string `json:"name"`
+	// Столбцы справочника кроме ключа.
+	Columns []ProjectDictionaryGetByIDResponseColumnsItem `json:"columns"`
+	// Строки справочника.
+	Rows []ProjectDictionaryGetByIDResponseRowsItem `json:"rows"`
+	// Номер последней версии справочника.
+	Number int64 `json:"number"`
+	// Время сохранения последней версии.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectDictionaryGetByIDResponse) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ProjectDictionaryGetByIDResponse) GetName() string {
+	return s.Name
+}
+
+// GetColumns returns the value of Columns.
+func (s *ProjectDictionaryGetByIDResponse) GetColumns() []ProjectDictionaryGetByIDResponseColumnsItem {
+	return s.Columns
+}
+
+// GetRows returns the value of Rows.
+func (s *ProjectDictionaryGetByIDResponse) GetRows() []ProjectDictionaryGetByIDResponseRowsItem {
+	return s.Rows
+}
+
+// GetNumber returns the value of Number.
+func (s *ProjectDictionaryGetByIDResponse) GetNumber() int64 {
+	return s.Number
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ProjectDictionaryGetByIDResponse) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ProjectDictionaryGetByIDResponse) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ProjectDictionaryGetByIDResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetColumns sets the value of Columns.
+func (s *ProjectDictionaryGetByIDResponse) SetColumns(val []ProjectDictionaryGetByIDResponseColumnsItem) {
+	s.Columns = val
+}
+
+// SetRows sets the value of Rows.
+func (s *ProjectDictionaryGetByIDResponse) SetRows(val []ProjectDictionaryGetByIDResponseRowsItem) {
+	s.Rows = val
+}
+
+// SetNumber sets the value of Number.
+func (s *ProjectDictionaryGetByIDResponse) SetNumber(val int64) {
+	s.Number = val
 }
 
 // SetUpdatedAt sets the value of UpdatedAt.
-func (s *TaskGetByIDResponseTask) SetUpdatedAt(val OptDateTime) {
+func (s *ProjectDictionaryGetByIDResponse) SetUpdatedAt(val time.Time) {
 	s.UpdatedAt = val
 }
 
-// Ошибка обработки задачи.
-type TaskGetByIDResponseTaskError struct {
-	// Сообщение ошибки.
-	Message OptString `json:"message"`
-	// Локализация ошибки внутри текста шаблона.
-	Template       OptTaskGetByIDResponseTaskErrorTemplate          `json:"template"`
-	VariableErrors []TaskGetByIDResponseTaskErrorVariableErrorsItem `json:"variableErrors"`
+func (*ProjectDictionaryGetByIDResponse) projectDictionaryGetByIDRes() {}
+
+type ProjectDictionaryGetByIDResponseColumnsItem struct {
+	// Название столбца.
+	Name string `json:"name"`
+	// Тип значений столбца.
+	Type string `json:"type"`
 }
 
-// GetMessage returns the value of Message.
-func (s *TaskGetByIDResponseTaskError) GetMessage() OptString {
-	return s.Message
+// GetName returns the value of Name.
+func (s *ProjectDictionaryGetByIDResponseColumnsItem) GetName() string {
+	return s.Name
 }
 
-// GetTemplate returns the value of Template.
-func (s *TaskGetByIDResponseTaskError) GetTemplate() OptTaskGetByIDResponseTaskErrorTemplate {
-	return s.Template
+// GetType returns the value of Type.
+func (s *ProjectDictionaryGetByIDResponseColumnsItem) GetType() string {
+	return s.Type
 }
 
-// GetVariableErrors returns the value of VariableErrors.
-func (s *TaskGetByIDResponseTaskError) GetVariableErrors() []TaskGetByIDResponseTaskErrorVariableErrorsItem {
-	return s.VariableErrors
+// SetName sets the value of Name.
+func (s *ProjectDictionaryGetByIDResponseColumnsItem) SetName(val string) {
+	s.Name = val
 }
 
-// SetMessage sets the value of Message.
-func (s *TaskGetByIDResponseTaskError) SetMessage(val OptString) {
-	s.Message = val
+// SetType sets the value of Type.
+func (s *ProjectDictionaryGetByIDResponseColumnsItem) SetType(val string) {
+	s.Type = val
 }
 
-// SetTemplate sets the value of Template.
-func (s *TaskGetByIDResponseTaskError) SetTemplate(val OptTaskGetByIDResponseTaskErrorTemplate) {
-	s.Template = val
+type ProjectDictionaryGetByIDResponseRowsItem struct {
+	// Ключ строки.
+	Key string `json:"key"`
+	// Значения строки по названиям столбцов в текстовом
+	// виде.
+	Values ProjectDictionaryGetByIDResponseRowsItemValues `json:"values"`
 }
 
-// SetVariableErrors sets the value of VariableErrors.
-func (s *TaskGetByIDResponseTaskError) SetVariableErrors(val []TaskGetByIDResponseTaskErrorVariableErrorsItem) {
-	s.VariableErrors = val
+// GetKey returns the value of Key.
+func (s *ProjectDictionaryGetByIDResponseRowsItem) GetKey() string {
+	return s.Key
 }
 
-// Локализация ошибки внутри текста шаблона.
-type TaskGetByIDResponseTaskErrorTemplate struct {
-	// Номер строки в шаблоне (начиная с 1).
-	Line int `json:"line"`
-	// Номер столбца в шаблоне (начиная с 1); отсутствует,
-	// если неизвестен.
-	Column OptInt `json:"column"`
-	// Содержимое строки шаблона, на которой произошла
-	// ошибка.
-	Snippet OptString `json:"snippet"`
-	// Подробное диагностическое сообщение из движка
-	// шаблонов.
-	Detail OptString `json:"detail"`
+// GetValues returns the value of Values.
+func (s *ProjectDictionaryGetByIDResponseRowsItem) GetValues() ProjectDictionaryGetByIDResponseRowsItemValues {
+	return s.Values
+}
+
+// SetKey sets the value of Key.
+func (s *ProjectDictionaryGetByIDResponseRowsItem) SetKey(val string) {
+	s.Key = val
+}
+
+// SetValues sets the value of Values.
+func (s *ProjectDictionaryGetByIDResponseRowsItem) SetValues(val ProjectDictionaryGetByIDResponseRowsItemValues) {
+	s.Values = val
+}
+
+// Значения строки по названиям столбцов в текстовом
+// виде.
+type ProjectDictionaryGetByIDResponseRowsItemValues map[string]string
+
+func (s *ProjectDictionaryGetByIDResponseRowsItemValues) init() ProjectDictionaryGetByIDResponseRowsItemValues {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/ProjectDictionaryImportRequest
+type ProjectDictionaryImportRequest struct {
+	// Название справочника (идентификатор).
+	Name string `json:"name"`
+	// Содержимое CSV-файла.
+	Data string `json:"data"`
+}
+
+// GetName returns the value of Name.
+func (s *ProjectDictionaryImportRequest) GetName() string {
+	return s.Name
+}
+
+// GetData returns the value of Data.
+func (s *ProjectDictionaryImportRequest) GetData() string {
+	return s.Data
+}
+
+// SetName sets the value of Name.
+func (s *ProjectDictionaryImportRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetData sets the value of Data.
+func (s *ProjectDictionaryImportRequest) SetData(val string) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/ProjectDictionaryImportResponse
+type ProjectDictionaryImportResponse struct {
+	// ID справочника.
+	ID int64 `json:"id"`
+	// Номер сохраненной версии справочника.
+	Number int64 `json:"number"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectDictionaryImportResponse) GetID() int64 {
+	return s.ID
+}
+
+// GetNumber returns the value of Number.
+func (s *ProjectDictionaryImportResponse) GetNumber() int64 {
+	return s.Number
+}
+
+// SetID sets the value of ID.
+func (s *ProjectDictionaryImportResponse) SetID(val int64) {
+	s.ID = val
+}
+
+// SetNumber sets the value of Number.
+func (s *ProjectDictionaryImportResponse) SetNumber(val int64) {
+	s.Number = val
+}
+
+func (*ProjectDictionaryImportResponse) projectDictionaryImportRes() {}
+
+// Ref: #/components/schemas/ProjectDictionaryListResponse
+type ProjectDictionaryListResponse struct {
+	// Список справочников проекта в последних версиях.
+	Dictionaries []ProjectDictionaryListResponseDictionariesItem `json:"dictionaries"`
+}
+
+// GetDictionaries returns the value of Dictionaries.
+func (s *ProjectDictionaryListResponse) GetDictionaries() []ProjectDictionaryListResponseDictionariesItem {
+	return s.Dictionaries
+}
+
+// SetDictionaries sets the value of Dictionaries.
+func (s *ProjectDictionaryListResponse) SetDictionaries(val []ProjectDictionaryListResponseDictionariesItem) {
+	s.Dictionaries = val
+}
+
+func (*ProjectDictionaryListResponse) projectDictionaryListRes() {}
+
+// Справочник проекта.
+type ProjectDictionaryListResponseDictionariesItem struct {
+	// ID справочника.
+	ID int64 `json:"id"`
+	// Название справочника.
+	Name string `json:"name"`
+	// Столбцы справочника кроме ключа.
+	Columns []ProjectDictionaryListResponseDictionariesItemColumnsItem `json:"columns"`
+	// Количество строк.
+	RowCount int64 `json:"rowCount"`
+	// Номер последней версии справочника.
+	Number int64 `json:"number"`
+	// Время сохранения последней версии.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectDictionaryListResponseDictionariesItem) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ProjectDictionaryListResponseDictionariesItem) GetName() string {
+	return s.Name
+}
+
+// GetColumns returns the value of Columns.
+func (s *ProjectDictionaryListResponseDictionariesItem) GetColumns() []ProjectDictionaryListResponseDictionariesItemColumnsItem {
+	return s.Columns
+}
+
+// GetRowCount returns the value of RowCount.
+func (s *ProjectDictionaryListResponseDictionariesItem) GetRowCount() int64 {
+	return s.RowCount
+}
+
+// GetNumber returns the value of Number.
+func (s *ProjectDictionaryListResponseDictionariesItem) GetNumber() int64 {
+	return s.Number
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ProjectDictionaryListResponseDictionariesItem) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ProjectDictionaryListResponseDictionariesItem) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ProjectDictionaryListResponseDictionariesItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetColumns sets the value of Columns.
+func (s *ProjectDictionaryListResponseDictionariesItem) SetColumns(val []ProjectDictionaryListResponseDictionariesItemColumnsItem) {
+	s.Columns = val
+}
+
+// SetRowCount sets the value of RowCount.
+func (s *ProjectDictionaryListResponseDictionariesItem) SetRowCount(val int64) {
+	s.RowCount = val
+}
+
+// SetNumber sets the value of Number.
+func (s *ProjectDictionaryListResponseDictionariesItem) SetNumber(val int64) {
+	s.Number = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ProjectDictionaryListResponseDictionariesItem) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+type ProjectDictionaryListResponseDictionariesItemColumnsItem struct {
+	// Название столбца.
+	Name string `json:"name"`
+	// Тип значений столбца.
+	Type string `json:"type"`
+}
+
+// GetName returns the value of Name.
+func (s *ProjectDictionaryListResponseDictionariesItemColumnsItem) GetName() string {
+	return s.Name
+}
+
+// GetType returns the value of Type.
+func (s *ProjectDictionaryListResponseDictionariesItemColumnsItem) GetType() string {
+	return s.Type
+}
+
+// SetName sets the value of Name.
+func (s *ProjectDictionaryListResponseDictionariesItemColumnsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetType sets the value of Type.
+func (s *ProjectDictionaryListResponseDictionariesItemColumnsItem) SetType(val string) {
+	s.Type = val
+}
+
+// Ref: #/components/schemas/ProjectDictionarySaveRequest
+type ProjectDictionarySaveRequest struct {
+	// Название справочника (идентификатор).
+	Name string `json:"name"`
+	// Столбцы справочника кроме ключа.
+	Columns []ProjectDictionarySaveRequestColumnsItem `json:"columns"`
+	// Строки справочника.
+	Rows []ProjectDictionarySaveRequestRowsItem `json:"rows"`
+}
+
+// GetName returns the value of Name.
+func (s *ProjectDictionarySaveRequest) GetName() string {
+	return s.Name
+}
+
+// GetColumns returns the value of Columns.
+func (s *ProjectDictionarySaveRequest) GetColumns() []ProjectDictionarySaveRequestColumnsItem {
+	return s.Columns
+}
+
+// GetRows returns the value of Rows.
+func (s *ProjectDictionarySaveRequest) GetRows() []ProjectDictionarySaveRequestRowsItem {
+	return s.Rows
+}
+
+// SetName sets the value of Name.
+func (s *ProjectDictionarySaveRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetColumns sets the value of Columns.
+func (s *ProjectDictionarySaveRequest) SetColumns(val []ProjectDictionarySaveRequestColumnsItem) {
+	s.Columns = val
+}
+
+// SetRows sets the value of Rows.
+func (s *ProjectDictionarySaveRequest) SetRows(val []ProjectDictionarySaveRequestRowsItem) {
+	s.Rows = val
+}
+
+type ProjectDictionarySaveRequestColumnsItem struct {
+	// Название столбца (идентификатор).
+	Name string `json:"name"`
+	// Тип значений столбца.
+	Type ProjectDictionarySaveRequestColumnsItemType `json:"type"`
+}
+
+// GetName returns the value of Name.
+func (s *ProjectDictionarySaveRequestColumnsItem) GetName() string {
+	return s.Name
+}
+
+// GetType returns the value of Type.
+func (s *ProjectDictionarySaveRequestColumnsItem) GetType() ProjectDictionarySaveRequestColumnsItemType {
+	return s.Type
+}
+
+// SetName sets the value of Name.
+func (s *ProjectDictionarySaveRequestColumnsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetType sets the value of Type.
+func (s *ProjectDictionarySaveRequestColumnsItem) SetType(val ProjectDictionarySaveRequestColumnsItemType) {
+	s.Type = val
+}
+
+// Тип значений столбца.
+type ProjectDictionarySaveRequestColumnsItemType string
+
+const (
+	ProjectDictionarySaveRequestColumnsItemTypeString  ProjectDictionarySaveRequestColumnsItemType = "string"
+	ProjectDictionarySaveRequestColumnsItemTypeInteger ProjectDictionarySaveRequestColumnsItemType = "integer"
+	ProjectDictionarySaveRequestColumnsItemTypeFloat   ProjectDictionarySaveRequestColumnsItemType = "float"
+	ProjectDictionarySaveRequestColumnsItemTypeDecimal ProjectDictionarySaveRequestColumnsItemType = "decimal"
+	ProjectDictionarySaveRequestColumnsItemTypeBoolean ProjectDictionarySaveRequestColumnsItemType = "boolean"
+	ProjectDictionarySaveRequestColumnsItemTypeDate    ProjectDictionarySaveRequestColumnsItemType = "date"
+)
+
+// AllValues returns all ProjectDictionarySaveRequestColumnsItemType values.
+func (ProjectDictionarySaveRequestColumnsItemType) AllValues() []ProjectDictionarySaveRequestColumnsItemType {
+	return []ProjectDictionarySaveRequestColumnsItemType{
+		ProjectDictionarySaveRequestColumnsItemTypeString,
+		ProjectDictionarySaveRequestColumnsItemTypeInteger,
+		ProjectDictionarySaveRequestColumnsItemTypeFloat,
+		ProjectDictionarySaveRequestColumnsItemTypeDecimal,
+		ProjectDictionarySaveRequestColumnsItemTypeBoolean,
+		ProjectDictionarySaveRequestColumnsItemTypeDate,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ProjectDictionarySaveRequestColumnsItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case ProjectDictionarySaveRequestColumnsItemTypeString:
+		return []byte(s), nil
+	case ProjectDictionarySaveRequestColumnsItemTypeInteger:
+		return []byte(s), nil
+	case ProjectDictionarySaveRequestColumnsItemTypeFloat:
+		return []byte(s), nil
+	case ProjectDictionarySaveRequestColumnsItemTypeDecimal:
+		return []byte(s), nil
+	case ProjectDictionarySaveRequestColumnsItemTypeBoolean:
+		return []byte(s), nil
+	case ProjectDictionarySaveRequestColumnsItemTypeDate:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ProjectDictionarySaveRequestColumnsItemType) UnmarshalText(data []byte) error {
+	switch ProjectDictionarySaveRequestColumnsItemType(data) {
+	case ProjectDictionarySaveRequestColumnsItemTypeString:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeString
+		return nil
+	case ProjectDictionarySaveRequestColumnsItemTypeInteger:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeInteger
+		return nil
+	case ProjectDictionarySaveRequestColumnsItemTypeFloat:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeFloat
+		return nil
+	case ProjectDictionarySaveRequestColumnsItemTypeDecimal:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeDecimal
+		return nil
+	case ProjectDictionarySaveRequestColumnsItemTypeBoolean:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeBoolean
+		return nil
+	case ProjectDictionarySaveRequestColumnsItemTypeDate:
+		*s = ProjectDictionarySaveRequestColumnsItemTypeDate
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ProjectDictionarySaveRequestRowsItem struct {
+	// Уникальный ключ строки.
+	Key string `json:"key"`
+	// Значения строки по названиям столбцов в текстовом
+	// виде.
+	Values ProjectDictionarySaveRequestRowsItemValues `json:"values"`
+}
+
+// GetKey returns the value of Key.
+func (s *ProjectDictionarySaveRequestRowsItem) GetKey() string {
+	return s.Key
+}
+
+// GetValues returns the value of Values.
+func (s *ProjectDictionarySaveRequestRowsItem) GetValues() ProjectDictionarySaveRequestRowsItemValues {
+	return s.Values
+}
+
+// SetKey sets the value of Key.
+func (s *ProjectDictionarySaveRequestRowsItem) SetKey(val string) {
+	s.Key = val
+}
+
+// SetValues sets the value of Values.
+func (s *ProjectDictionarySaveRequestRowsItem) SetValues(val ProjectDictionarySaveRequestRowsItemValues) {
+	s.Values = val
+}
+
+// Значения строки по названиям столбцов в текстовом
+// виде.
+type ProjectDictionarySaveRequestRowsItemValues map[string]string
+
+func (s *ProjectDictionarySaveRequestRowsItemValues) init() ProjectDictionarySaveRequestRowsItemValues {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/ProjectDictionarySaveResponse
+type ProjectDictionarySaveResponse struct {
+	// ID справочника.
+	ID int64 `json:"id"`
+	// Номер сохраненной версии справочника.
+	Number int64 `json:"number"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectDictionarySaveResponse) GetID() int64 {
+	return s.ID
+}
+
+// GetNumber returns the value of Number.
+func (s *ProjectDictionarySaveResponse) GetNumber() int64 {
+	return s.Number
+}
+
+// SetID sets the value of ID.
+func (s *ProjectDictionarySaveResponse) SetID(val int64) {
+	s.ID = val
+}
+
+// SetNumber sets the value of Number.
+func (s *ProjectDictionarySaveResponse) SetNumber(val int64) {
+	s.Number = val
+}
+
+func (*ProjectDictionarySaveResponse) projectDictionarySaveRes() {}
+
+// Ref: #/components/schemas/ProjectFunctionListResponse
+type ProjectFunctionListResponse struct {
+	// Список функций проекта в последних версиях.
+	Functions []ProjectFunctionListResponseFunctionsItem `json:"functions"`
+}
+
+// GetFunctions returns the value of Functions.
+func (s *ProjectFunctionListResponse) GetFunctions() []ProjectFunctionListResponseFunctionsItem {
+	return s.Functions
+}
+
+// SetFunctions sets the value of Functions.
+func (s *ProjectFunctionListResponse) SetFunctions(val []ProjectFunctionListResponseFunctionsItem) {
+	s.Functions = val
+}
+
+func (*ProjectFunctionListResponse) projectFunctionListRes() {}
+
+// Функция проекта.
+type ProjectFunctionListResponseFunctionsItem struct {
+	// ID функции.
+	ID int64 `json:"id"`
+	// Название функции.
+	Name string `json:"name"`
+	// Названия параметров функции.
+	Params []string `json:"params"`
+	// Тело функции.
+	Body string `json:"body"`
+	// Номер последней версии функции.
+	Number int64 `json:"number"`
+	// Время сохранения последней версии.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectFunctionListResponseFunctionsItem) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ProjectFunctionListResponseFunctionsItem) GetName() string {
+	return s.Name
+}
+
+// GetParams returns the value of Params.
+func (s *ProjectFunctionListResponseFunctionsItem) GetParams() []string {
+	return s.Params
+}
+
+// GetBody returns the value of Body.
+func (s *ProjectFunctionListResponseFunctionsItem) GetBody() string {
+	return s.Body
+}
+
+// GetNumber returns the value of Number.
+func (s *ProjectFunctionListResponseFunctionsItem) GetNumber() int64 {
+	return s.Number
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ProjectFunctionListResponseFunctionsItem) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ProjectFunctionListResponseFunctionsItem) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ProjectFunctionListResponseFunctionsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetParams sets the value of Params.
+func (s *ProjectFunctionListResponseFunctionsItem) SetParams(val []string) {
+	s.Params = val
+}
+
+// SetBody sets the value of Body.
+func (s *ProjectFunctionListResponseFunctionsItem) SetBody(val string) {
+	s.Body = val
+}
+
+// SetNumber sets the value of Number.
+func (s *ProjectFunctionListResponseFunctionsItem) SetNumber(val int64) {
+	s.Number = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ProjectFunctionListResponseFunctionsItem) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// Ref: #/components/schemas/ProjectFunctionSaveRequest
+type ProjectFunctionSaveRequest struct {
+	// Название функции (идентификатор).
+	Name string `json:"name"`
+	// Названия параметров функции.
+	Params []string `json:"params"`
+	// Тело функции — выражение над параметрами.
+	Body string `json:"body"`
+}
+
+// GetName returns the value of Name.
+func (s *ProjectFunctionSaveRequest) GetName() string {
+	return s.Name
+}
+
+// GetParams returns the value of Params.
+func (s *ProjectFunctionSaveRequest) GetParams() []string {
+	return s.Params
+}
+
+// GetBody returns the value of Body.
+func (s *ProjectFunctionSaveRequest) GetBody() string {
+	return s.Body
+}
+
+// SetName sets the value of Name.
+func (s *ProjectFunctionSaveRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetParams sets the value of Params.
+func (s *ProjectFunctionSaveRequest) SetParams(val []string) {
+	s.Params = val
+}
+
+// SetBody sets the value of Body.
+func (s *ProjectFunctionSaveRequest) SetBody(val string) {
+	s.Body = val
+}
+
+// Ref: #/components/schemas/ProjectFunctionSaveResponse
+type ProjectFunctionSaveResponse struct {
+	// ID функции.
+	ID int64 `json:"id"`
+	// Номер сохраненной версии функции.
+	Number int64 `json:"number"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectFunctionSaveResponse) GetID() int64 {
+	return s.ID
+}
+
+// GetNumber returns the value of Number.
+func (s *ProjectFunctionSaveResponse) GetNumber() int64 {
+	return s.Number
+}
+
+// SetID sets the value of ID.
+func (s *ProjectFunctionSaveResponse) SetID(val int64) {
+	s.ID = val
+}
+
+// SetNumber sets the value of Number.
+func (s *ProjectFunctionSaveResponse) SetNumber(val int64) {
+	s.Number = val
+}
+
+func (*ProjectFunctionSaveResponse) projectFunctionSaveRes() {}
+
+// Ref: #/components/schemas/ProjectGetByIDResponse
+type ProjectGetByIDResponse struct {
+	// Название проекта.
+	Name string `json:"name"`
+	// Имя автора проекта.
+	AuthorName string `json:"authorName"`
+}
+
+// GetName returns the value of Name.
+func (s *ProjectGetByIDResponse) GetName() string {
+	return s.Name
+}
+
+// GetAuthorName returns the value of AuthorName.
+func (s *ProjectGetByIDResponse) GetAuthorName() string {
+	return s.AuthorName
+}
+
+// SetName sets the value of Name.
+func (s *ProjectGetByIDResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetAuthorName sets the value of AuthorName.
+func (s *ProjectGetByIDResponse) SetAuthorName(val string) {
+	s.AuthorName = val
+}
+
+func (*ProjectGetByIDResponse) projectGetByIDRes() {}
+
+// Ref: #/components/schemas/ProjectListResponse
+type ProjectListResponse struct {
+	// Список проектов.
+	Projects []ProjectListResponseProjectsItem `json:"projects"`
+	// Общее количество проектов.
+	TotalProjects int64 `json:"totalProjects"`
+	// Общее количество страниц.
+	TotalPages int64 `json:"totalPages"`
+}
+
+// GetProjects returns the value of Projects.
+func (s *ProjectListResponse) GetProjects() []ProjectListResponseProjectsItem {
+	return s.Projects
+}
+
+// GetTotalProjects returns the value of TotalProjects.
+func (s *ProjectListResponse) GetTotalProjects() int64 {
+	return s.TotalProjects
+}
+
+// GetTotalPages returns the value of TotalPages.
+func (s *ProjectListResponse) GetTotalPages() int64 {
+	return s.TotalPages
+}
+
+// SetProjects sets the value of Projects.
+func (s *ProjectListResponse) SetProjects(val []ProjectListResponseProjectsItem) {
+	s.Projects = val
+}
+
+// SetTotalProjects sets the value of TotalProjects.
+func (s *ProjectListResponse) SetTotalProjects(val int64) {
+	s.TotalProjects = val
+}
+
+// SetTotalPages sets the value of TotalPages.
+func (s *ProjectListResponse) SetTotalPages(val int64) {
+	s.TotalPages = val
+}
+
+func (*ProjectListResponse) projectListRes() {}
+
+// Проект.
+type ProjectListResponseProjectsItem struct {
+	// ID проекта.
+	ID int64 `json:"id"`
+	// Название проекта.
+	Name string `json:"name"`
+	// Имя автора проекта.
+	AuthorName string `json:"authorName"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectListResponseProjectsItem) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ProjectListResponseProjectsItem) GetName() string {
+	return s.Name
+}
+
+// GetAuthorName returns the value of AuthorName.
+func (s *ProjectListResponseProjectsItem) GetAuthorName() string {
+	return s.AuthorName
+}
+
+// SetID sets the value of ID.
+func (s *ProjectListResponseProjectsItem) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ProjectListResponseProjectsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetAuthorName sets the value of AuthorName.
+func (s *ProjectListResponseProjectsItem) SetAuthorName(val string) {
+	s.AuthorName = val
+}
+
+// Ref: #/components/schemas/ProjectPartialListResponse
+type ProjectPartialListResponse struct {
+	// Список фрагментов проекта в последних версиях.
+	Partials []ProjectPartialListResponsePartialsItem `json:"partials"`
+}
+
+// GetPartials returns the value of Partials.
+func (s *ProjectPartialListResponse) GetPartials() []ProjectPartialListResponsePartialsItem {
+	return s.Partials
+}
+
+// SetPartials sets the value of Partials.
+func (s *ProjectPartialListResponse) SetPartials(val []ProjectPartialListResponsePartialsItem) {
+	s.Partials = val
+}
+
+func (*ProjectPartialListResponse) projectPartialListRes() {}
+
+// Фрагмент проекта.
+type ProjectPartialListResponsePartialsItem struct {
+	// ID фрагмента.
+	ID int64 `json:"id"`
+	// Название фрагмента.
+	Name string `json:"name"`
+	// Текст фрагмента.
+	Data string `json:"data"`
+	// Номер последней версии фрагмента.
+	Number int64 `json:"number"`
+	// Время сохранения последней версии.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectPartialListResponsePartialsItem) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ProjectPartialListResponsePartialsItem) GetName() string {
+	return s.Name
+}
+
+// GetData returns the value of Data.
+func (s *ProjectPartialListResponsePartialsItem) GetData() string {
+	return s.Data
+}
+
+// GetNumber returns the value of Number.
+func (s *ProjectPartialListResponsePartialsItem) GetNumber() int64 {
+	return s.Number
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ProjectPartialListResponsePartialsItem) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ProjectPartialListResponsePartialsItem) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ProjectPartialListResponsePartialsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetData sets the value of Data.
+func (s *ProjectPartialListResponsePartialsItem) SetData(val string) {
+	s.Data = val
+}
+
+// SetNumber sets the value of Number.
+func (s *ProjectPartialListResponsePartialsItem) SetNumber(val int64) {
+	s.Number = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ProjectPartialListResponsePartialsItem) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// Ref: #/components/schemas/ProjectPartialSaveRequest
+type ProjectPartialSaveRequest struct {
+	// Название фрагмента (идентификатор).
+	Name string `json:"name"`
+	// Текст фрагмента на языке шаблонов.
+	Data string `json:"data"`
+}
+
+// GetName returns the value of Name.
+func (s *ProjectPartialSaveRequest) GetName() string {
+	return s.Name
+}
+
+// GetData returns the value of Data.
+func (s *ProjectPartialSaveRequest) GetData() string {
+	return s.Data
+}
+
+// SetName sets the value of Name.
+func (s *ProjectPartialSaveRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetData sets the value of Data.
+func (s *ProjectPartialSaveRequest) SetData(val string) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/ProjectPartialSaveResponse
+type ProjectPartialSaveResponse struct {
+	// ID фрагмента.
+	ID int64 `json:"id"`
+	// Номер сохраненной версии фрагмента.
+	Number int64 `json:"number"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectPartialSaveResponse) GetID() int64 {
+	return s.ID
+}
+
+// GetNumber returns the value of Number.
+func (s *ProjectPartialSaveResponse) GetNumber() int64 {
+	return s.Number
+}
+
+// SetID sets the value of ID.
+func (s *ProjectPartialSaveResponse) SetID(val int64) {
+	s.ID = val
+}
+
+// SetNumber sets the value of Number.
+func (s *ProjectPartialSaveResponse) SetNumber(val int64) {
+	s.Number = val
+}
+
+func (*ProjectPartialSaveResponse) projectPartialSaveRes() {}
+
+// ProjectUpdateByIDNoContent is response for ProjectUpdateByID operation.
+type ProjectUpdateByIDNoContent struct{}
+
+func (*ProjectUpdateByIDNoContent) projectUpdateByIDRes() {}
+
+// Ref: #/components/schemas/ProjectUpdateRequest
+type ProjectUpdateRequest struct {
+	// Название проекта.
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *ProjectUpdateRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *ProjectUpdateRequest) SetName(val string) {
+	s.Name = val
+}
+
+// ProjectUpdateUsersNoContent is response for ProjectUpdateUsers operation.
+type ProjectUpdateUsersNoContent struct{}
+
+func (*ProjectUpdateUsersNoContent) projectUpdateUsersRes() {}
+
+// Ref: #/components/schemas/ProjectUpdateUsersRequest
+type ProjectUpdateUsersRequest struct {
+	// Список пользователей проекта.
+	Users []ProjectUpdateUsersRequestUsersItem `json:"users"`
+}
+
+// GetUsers returns the value of Users.
+func (s *ProjectUpdateUsersRequest) GetUsers() []ProjectUpdateUsersRequestUsersItem {
+	return s.Users
+}
+
+// SetUsers sets the value of Users.
+func (s *ProjectUpdateUsersRequest) SetUsers(val []ProjectUpdateUsersRequestUsersItem) {
+	s.Users = val
+}
+
+// Пользователь проекта.
+type ProjectUpdateUsersRequestUsersItem struct {
+	// ID пользователя.
+	ID int64 `json:"id"`
+	// Роль пользователя в проекте.
+	Role ProjectUpdateUsersRequestUsersItemRole `json:"role"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectUpdateUsersRequestUsersItem) GetID() int64 {
+	return s.ID
+}
+
+// GetRole returns the value of Role.
+func (s *ProjectUpdateUsersRequestUsersItem) GetRole() ProjectUpdateUsersRequestUsersItemRole {
+	return s.Role
+}
+
+// SetID sets the value of ID.
+func (s *ProjectUpdateUsersRequestUsersItem) SetID(val int64) {
+	s.ID = val
+}
+
+// SetRole sets the value of Role.
+func (s *ProjectUpdateUsersRequestUsersItem) SetRole(val ProjectUpdateUsersRequestUsersItemRole) {
+	s.Role = val
+}
+
+// Роль пользователя в проекте.
+type ProjectUpdateUsersRequestUsersItemRole string
+
+const (
+	ProjectUpdateUsersRequestUsersItemRoleRead     ProjectUpdateUsersRequestUsersItemRole = "read"
+	ProjectUpdateUsersRequestUsersItemRoleWrite    ProjectUpdateUsersRequestUsersItemRole = "write"
+	ProjectUpdateUsersRequestUsersItemRoleMaintain ProjectUpdateUsersRequestUsersItemRole = "maintain"
+)
+
+// AllValues returns all ProjectUpdateUsersRequestUsersItemRole values.
+func (ProjectUpdateUsersRequestUsersItemRole) AllValues() []ProjectUpdateUsersRequestUsersItemRole {
+	return []ProjectUpdateUsersRequestUsersItemRole{
+		ProjectUpdateUsersRequestUsersItemRoleRead,
+		ProjectUpdateUsersRequestUsersItemRoleWrite,
+		ProjectUpdateUsersRequestUsersItemRoleMaintain,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ProjectUpdateUsersRequestUsersItemRole) MarshalText() ([]byte, error) {
+	switch s {
+	case ProjectUpdateUsersRequestUsersItemRoleRead:
+		return []byte(s), nil
+	case ProjectUpdateUsersRequestUsersItemRoleWrite:
+		return []byte(s), nil
+	case ProjectUpdateUsersRequestUsersItemRoleMaintain:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ProjectUpdateUsersRequestUsersItemRole) UnmarshalText(data []byte) error {
+	switch ProjectUpdateUsersRequestUsersItemRole(data) {
+	case ProjectUpdateUsersRequestUsersItemRoleRead:
+		*s = ProjectUpdateUsersRequestUsersItemRoleRead
+		return nil
+	case ProjectUpdateUsersRequestUsersItemRoleWrite:
+		*s = ProjectUpdateUsersRequestUsersItemRoleWrite
+		return nil
+	case ProjectUpdateUsersRequestUsersItemRoleMaintain:
+		*s = ProjectUpdateUsersRequestUsersItemRoleMaintain
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ProjectUsersResponse
+type ProjectUsersResponse struct {
+	// Список пользователей проекта.
+	Users []ProjectUsersResponseUsersItem `json:"users"`
+}
+
+// GetUsers returns the value of Users.
+func (s *ProjectUsersResponse) GetUsers() []ProjectUsersResponseUsersItem {
+	return s.Users
+}
+
+// SetUsers sets the value of Users.
+func (s *ProjectUsersResponse) SetUsers(val []ProjectUsersResponseUsersItem) {
+	s.Users = val
+}
+
+func (*ProjectUsersResponse) projectUsersRes() {}
+
+// Пользователь проекта.
+type ProjectUsersResponseUsersItem struct {
+	// ID пользователя.
+	ID int64 `json:"id"`
+	// Имя пользователя.
+	Name string `json:"name"`
+	// Электронный адрес пользователя.
+	Email string `json:"email"`
+	// Роль пользователя в проекте.
+	Role ProjectUsersResponseUsersItemRole `json:"role"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectUsersResponseUsersItem) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ProjectUsersResponseUsersItem) GetName() string {
+	return s.Name
+}
+
+// GetEmail returns the value of Email.
+func (s *ProjectUsersResponseUsersItem) GetEmail() string {
+	return s.Email
+}
+
+// GetRole returns the value of Role.
+func (s *ProjectUsersResponseUsersItem) GetRole() ProjectUsersResponseUsersItemRole {
+	return s.Role
+}
+
+// SetID sets the value of ID.
+func (s *ProjectUsersResponseUsersItem) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ProjectUsersResponseUsersItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetEmail sets the value of Email.
+func (s *ProjectUsersResponseUsersItem) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetRole sets the value of Role.
+func (s *ProjectUsersResponseUsersItem) SetRole(val ProjectUsersResponseUsersItemRole) {
+	s.Role = val
+}
+
+// Роль пользователя в проекте.
+type ProjectUsersResponseUsersItemRole string
+
+const (
+	ProjectUsersResponseUsersItemRoleRead     ProjectUsersResponseUsersItemRole = "read"
+	ProjectUsersResponseUsersItemRoleWrite    ProjectUsersResponseUsersItemRole = "write"
+	ProjectUsersResponseUsersItemRoleMaintain ProjectUsersResponseUsersItemRole = "maintain"
+)
+
+// AllValues returns all ProjectUsersResponseUsersItemRole values.
+func (ProjectUsersResponseUsersItemRole) AllValues() []ProjectUsersResponseUsersItemRole {
+	return []ProjectUsersResponseUsersItemRole{
+		ProjectUsersResponseUsersItemRoleRead,
+		ProjectUsersResponseUsersItemRoleWrite,
+		ProjectUsersResponseUsersItemRoleMaintain,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ProjectUsersResponseUsersItemRole) MarshalText() ([]byte, error) {
+	switch s {
+	case ProjectUsersResponseUsersItemRoleRead:
+		return []byte(s), nil
+	case ProjectUsersResponseUsersItemRoleWrite:
+		return []byte(s), nil
+	case ProjectUsersResponseUsersItemRoleMaintain:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ProjectUsersResponseUsersItemRole) UnmarshalText(data []byte) error {
+	switch ProjectUsersResponseUsersItemRole(data) {
+	case ProjectUsersResponseUsersItemRoleRead:
+		*s = ProjectUsersResponseUsersItemRoleRead
+		return nil
+	case ProjectUsersResponseUsersItemRoleWrite:
+		*s = ProjectUsersResponseUsersItemRoleWrite
+		return nil
+	case ProjectUsersResponseUsersItemRoleMaintain:
+		*s = ProjectUsersResponseUsersItemRoleMaintain
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type Sorting struct {
+	// Атрибут, по которому сортируется список.
+	Attribute string `json:"attribute"`
+	// Направление, по которому сортируется список.
+	Direction SortingDirection `json:"direction"`
+}
+
+// GetAttribute returns the value of Attribute.
+func (s *Sorting) GetAttribute() string {
+	return s.Attribute
+}
+
+// GetDirection returns the value of Direction.
+func (s *Sorting) GetDirection() SortingDirection {
+	return s.Direction
+}
+
+// SetAttribute sets the value of Attribute.
+func (s *Sorting) SetAttribute(val string) {
+	s.Attribute = val
+}
+
+// SetDirection sets the value of Direction.
+func (s *Sorting) SetDirection(val SortingDirection) {
+	s.Direction = val
+}
+
+// Направление, по которому сортируется список.
+type SortingDirection string
+
+const (
+	SortingDirectionASC  SortingDirection = "ASC"
+	SortingDirectionDESC SortingDirection = "DESC"
+)
+
+// AllValues returns all SortingDirection values.
+func (SortingDirection) AllValues() []SortingDirection {
+	return []SortingDirection{
+		SortingDirectionASC,
+		SortingDirectionDESC,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SortingDirection) MarshalText() ([]byte, error) {
+	switch s {
+	case SortingDirectionASC:
+		return []byte(s), nil
+	case SortingDirectionDESC:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SortingDirection) UnmarshalText(data []byte) error {
+	switch SortingDirection(data) {
+	case SortingDirectionASC:
+		*s = SortingDirectionASC
+		return nil
+	case SortingDirectionDESC:
+		*s = SortingDirectionDESC
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// TaskCreateCreated is response for TaskCreate operation.
+type TaskCreateCreated struct{}
+
+func (*TaskCreateCreated) taskCreateRes() {}
+
+// Ref: #/components/schemas/TaskCreateRequest
+type TaskCreateRequest struct {
+	// ID версии шаблона.
+	VersionID int64 `json:"versionID"`
+	// Пэйлоад задачи (скаляры строками, списки и таблицы
+	// массивами).
+	Payload TaskCreateRequestPayload `json:"payload"`
+	// Сохранить трассировку вычисления переменных вместе
+	// с задачей.
+	Trace        OptBool         `json:"trace"`
+	OutputFormat OptOutputFormat `json:"outputFormat"`
+}
+
+// GetVersionID returns the value of VersionID.
+func (s *TaskCreateRequest) GetVersionID() int64 {
+	return s.VersionID
+}
+
+// GetPayload returns the value of Payload.
+func (s *TaskCreateRequest) GetPayload() TaskCreateRequestPayload {
+	return s.Payload
+}
+
+// GetTrace returns the value of Trace.
+func (s *TaskCreateRequest) GetTrace() OptBool {
+	return s.Trace
+}
+
+// GetOutputFormat returns the value of OutputFormat.
+func (s *TaskCreateRequest) GetOutputFormat() OptOutputFormat {
+	return s.OutputFormat
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *TaskCreateRequest) SetVersionID(val int64) {
+	s.VersionID = val
+}
+
+// SetPayload sets the value of Payload.
+func (s *TaskCreateRequest) SetPayload(val TaskCreateRequestPayload) {
+	s.Payload = val
+}
+
+// SetTrace sets the value of Trace.
+func (s *TaskCreateRequest) SetTrace(val OptBool) {
+	s.Trace = val
+}
+
+// SetOutputFormat sets the value of OutputFormat.
+func (s *TaskCreateRequest) SetOutputFormat(val OptOutputFormat) {
+	s.OutputFormat = val
+}
+
+// Пэйлоад задачи (скаляры строками, списки и таблицы
+// массивами).
+type TaskCreateRequestPayload map[string]jx.Raw
+
+func (s *TaskCreateRequestPayload) init() TaskCreateRequestPayload {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/TaskGetByIDResponse
+type TaskGetByIDResponse struct {
+	Task         TaskGetByIDResponseTask `json:"task"`
+	Result       []byte                  `json:"result"`
+	ResultFormat OptOutputFormat         `json:"resultFormat"`
+	// MIME-тип результата.
+	ResultContentType OptString `json:"resultContentType"`
+}
+
+// GetTask returns the value of Task.
+func (s *TaskGetByIDResponse) GetTask() TaskGetByIDResponseTask {
+	return s.Task
+}
+
+// GetResult returns the value of Result.
+func (s *TaskGetByIDResponse) GetResult() []byte {
+	return s.Result
+}
+
+// GetResultFormat returns the value of ResultFormat.
+func (s *TaskGetByIDResponse) GetResultFormat() OptOutputFormat {
+	return s.ResultFormat
+}
+
+// GetResultContentType returns the value of ResultContentType.
+func (s *TaskGetByIDResponse) GetResultContentType() OptString {
+	return s.ResultContentType
+}
+
+// SetTask sets the value of Task.
+func (s *TaskGetByIDResponse) SetTask(val TaskGetByIDResponseTask) {
+	s.Task = val
+}
+
+// SetResult sets the value of Result.
+func (s *TaskGetByIDResponse) SetResult(val []byte) {
+	s.Result = val
+}
+
+// SetResultFormat sets the value of ResultFormat.
+func (s *TaskGetByIDResponse) SetResultFormat(val OptOutputFormat) {
+	s.ResultFormat = val
+}
+
+// SetResultContentType sets the value of ResultContentType.
+func (s *TaskGetByIDResponse) SetResultContentType(val OptString) {
+	s.ResultContentType = val
+}
+
+func (*TaskGetByIDResponse) taskGetByIDRes() {}
+
+type TaskGetByIDResponseTask struct {
+	// ID задачи.
+	ID int64 `json:"id"`
+	// ID версии.
+	VersionID int64      `json:"versionID"`
+	Status    TaskStatus `json:"status"`
+	// Пэйлоад задачи (скаляры строками, списки и таблицы
+	// массивами).
+	Payload TaskGetByIDResponseTaskPayload `json:"payload"`
+	Error   OptProcessError                `json:"error"`
+	Trace   Trace                          `json:"trace"`
+	// Имя создателя задачи.
+	CreatorName string `json:"creatorName"`
+	// Время рендера, которое возвращает now (фиксируется при
+	// создании задачи).
+	Clock time.Time `json:"clock"`
+	// Константы проекта, с которыми сформирована задача
+	// (фиксируются при создании задачи).
+	Constants OptTaskGetByIDResponseTaskConstants `json:"constants"`
+	// Дата и время создания задачи.
+	CreatedAt time.Time `json:"createdAt"`
+	// Дата и время обновления задачи.
+	UpdatedAt OptDateTime `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *TaskGetByIDResponseTask) GetID() int64 {
+	return s.ID
+}
+
+// GetVersionID returns the value of VersionID.
+func (s *TaskGetByIDResponseTask) GetVersionID() int64 {
+	return s.VersionID
+}
+
+// GetStatus returns the value of Status.
+func (s *TaskGetByIDResponseTask) GetStatus() TaskStatus {
+	return s.Status
+}
+
+// GetPayload returns the value of Payload.
+func (s *TaskGetByIDResponseTask) GetPayload() TaskGetByIDResponseTaskPayload {
+	return s.Payload
+}
+
+// GetError returns the value of Error.
+func (s *TaskGetByIDResponseTask) GetError() OptProcessError {
+	return s.Error
+}
+
+// GetTrace returns the value of Trace.
+func (s *TaskGetByIDResponseTask) GetTrace() Trace {
+	return s.Trace
+}
+
+// GetCreatorName returns the value of CreatorName.
+func (s *TaskGetByIDResponseTask) GetCreatorName() string {
+	return s.CreatorName
+}
+
+// GetClock returns the value of Clock.
+func (s *TaskGetByIDResponseTask) GetClock() time.Time {
+	return s.Clock
+}
+
+// GetConstants returns the value of Constants.
+func (s *TaskGetByIDResponseTask) GetConstants() OptTaskGetByIDResponseTaskConstants {
+	return s.Constants
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TaskGetByIDResponseTask) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *TaskGetByIDResponseTask) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *TaskGetByIDResponseTask) SetID(val int64) {
+	s.ID = val
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *TaskGetByIDResponseTask) SetVersionID(val int64) {
+	s.VersionID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *TaskGetByIDResponseTask) SetStatus(val TaskStatus) {
+	s.Status = val
+}
+
+// SetPayload sets the value of Payload.
+func (s *TaskGetByIDResponseTask) SetPayload(val TaskGetByIDResponseTaskPayload) {
+	s.Payload = val
+}
+
+// SetError sets the value of Error.
+func (s *TaskGetByIDResponseTask) SetError(val OptProcessError) {
+	s.Error = val
+}
+
+// SetTrace sets the value of Trace.
+func (s *TaskGetByIDResponseTask) SetTrace(val Trace) {
+	s.Trace = val
+}
+
+// SetCreatorName sets the value of CreatorName.
+func (s *TaskGetByIDResponseTask) SetCreatorName(val string) {
+	s.CreatorName = val
+}
+
+// SetClock sets the value of Clock.
+func (s *TaskGetByIDResponseTask) SetClock(val time.Time) {
+	s.Clock = val
+}
+
+// SetConstants sets the value of Constants.
+func (s *TaskGetByIDResponseTask) SetConstants(val OptTaskGetByIDResponseTaskConstants) {
+	s.Constants = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TaskGetByIDResponseTask) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *TaskGetByIDResponseTask) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// Константы проекта, с которыми сформирована задача
+// (фиксируются при создании задачи).
+type TaskGetByIDResponseTaskConstants map[string]string
+
+func (s *TaskGetByIDResponseTaskConstants) init() TaskGetByIDResponseTaskConstants {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Пэйлоад задачи (скаляры строками, списки и таблицы
+// массивами).
+type TaskGetByIDResponseTaskPayload map[string]jx.Raw
+
+func (s *TaskGetByIDResponseTaskPayload) init() TaskGetByIDResponseTaskPayload {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/TaskListResponse
+type TaskListResponse struct {
+	// Список задач генерации.
+	Tasks []TaskListResponseTasksItem `json:"tasks"`
+	// Общее количество задач.
+	TotalTasks int64 `json:"totalTasks"`
+	// Общее количество страниц.
+	TotalPages int64 `json:"totalPages"`
+}
+
+// GetTasks returns the value of Tasks.
+func (s *TaskListResponse) GetTasks() []TaskListResponseTasksItem {
+	return s.Tasks
+}
+
+// GetTotalTasks returns the value of TotalTasks.
+func (s *TaskListResponse) GetTotalTasks() int64 {
+	return s.TotalTasks
+}
+
+// GetTotalPages returns the value of TotalPages.
+func (s *TaskListResponse) GetTotalPages() int64 {
+	return s.TotalPages
+}
+
+// SetTasks sets the value of Tasks.
+func (s *TaskListResponse) SetTasks(val []TaskListResponseTasksItem) {
+	s.Tasks = val
+}
+
+// SetTotalTasks sets the value of TotalTasks.
+func (s *TaskListResponse) SetTotalTasks(val int64) {
+	s.TotalTasks = val
+}
+
+// SetTotalPages sets the value of TotalPages.
+func (s *TaskListResponse) SetTotalPages(val int64) {
+	s.TotalPages = val
+}
+
+func (*TaskListResponse) taskListRes() {}
+
+// Задача генерации.
+type TaskListResponseTasksItem struct {
+	// ID задачи генерации.
+	ID int64 `json:"id"`
+	// Номер версии.
+	VersionNumber int64      `json:"versionNumber"`
+	Status        TaskStatus `json:"status"`
+	// Имя создателя задачи.
+	CreatorName string `json:"creatorName"`
+	// Дата и время создания задачи.
+	CreatedAt time.Time `json:"createdAt"`
+	// Дата и время обновления задачи.
+	UpdatedAt OptDateTime `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *TaskListResponseTasksItem) GetID() int64 {
+	return s.ID
+}
+
+// GetVersionNumber returns the value of VersionNumber.
+func (s *TaskListResponseTasksItem) GetVersionNumber() int64 {
+	return s.VersionNumber
+}
+
+// GetStatus returns the value of Status.
+func (s *TaskListResponseTasksItem) GetStatus() TaskStatus {
+	return s.Status
+}
+
+// GetCreatorName returns the value of CreatorName.
+func (s *TaskListResponseTasksItem) GetCreatorName() string {
+	return s.CreatorName
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TaskListResponseTasksItem) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *TaskListResponseTasksItem) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *TaskListResponseTasksItem) SetID(val int64) {
+	s.ID = val
+}
+
+// SetVersionNumber sets the value of VersionNumber.
+func (s *TaskListResponseTasksItem) SetVersionNumber(val int64) {
+	s.VersionNumber = val
+}
+
+// SetStatus sets the value of Status.
+func (s *TaskListResponseTasksItem) SetStatus(val TaskStatus) {
+	s.Status = val
+}
+
+// SetCreatorName sets the value of CreatorName.
+func (s *TaskListResponseTasksItem) SetCreatorName(val string) {
+	s.CreatorName = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TaskListResponseTasksItem) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *TaskListResponseTasksItem) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// Статус задачи.
+// Ref: #/components/schemas/TaskStatus
+type TaskStatus string
+
+const (
+	TaskStatusCreated    TaskStatus = "created"
+	TaskStatusInProgress TaskStatus = "in_progress"
+	TaskStatusSucceed    TaskStatus = "succeed"
+	TaskStatusFailed     TaskStatus = "failed"
+)
+
+// AllValues returns all TaskStatus values.
+func (TaskStatus) AllValues() []TaskStatus {
+	return []TaskStatus{
+		TaskStatusCreated,
+		TaskStatusInProgress,
+		TaskStatusSucceed,
+		TaskStatusFailed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskStatusCreated:
+		return []byte(s), nil
+	case TaskStatusInProgress:
+		return []byte(s), nil
+	case TaskStatusSucceed:
+		return []byte(s), nil
+	case TaskStatusFailed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskStatus) UnmarshalText(data []byte) error {
+	switch TaskStatus(data) {
+	case TaskStatusCreated:
+		*s = TaskStatusCreated
+		return nil
+	case TaskStatusInProgress:
+		*s = TaskStatusInProgress
+		return nil
+	case TaskStatusSucceed:
+		*s = TaskStatusSucceed
+		return nil
+	case TaskStatusFailed:
+		*s = TaskStatusFailed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// TemplateCreateCreated is response for TemplateCreate operation.
+type TemplateCreateCreated struct{}
+
+func (*TemplateCreateCreated) templateCreateRes() {}
+
+// Ref: #/components/schemas/TemplateCreateFromDefaultRequest
+type TemplateCreateFromDefaultRequest struct {
+	// ID библиотечного шаблона-источника.
+	SourceTemplateID int64 `json:"sourceTemplateID"`
+	// ID проекта, в котором будет создан новый шаблон.
+	ProjectID int64 `json:"projectID"`
+	// Название нового шаблона.
+	Name string `json:"name"`
+}
+
+// GetSourceTemplateID returns the value of SourceTemplateID.
+func (s *TemplateCreateFromDefaultRequest) GetSourceTemplateID() int64 {
+	return s.SourceTemplateID
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *TemplateCreateFromDefaultRequest) GetProjectID() int64 {
+	return s.ProjectID
+}
+
+// GetName returns the value of Name.
+func (s *TemplateCreateFromDefaultRequest) GetName() string {
+	return s.Name
+}
+
+// SetSourceTemplateID sets the value of SourceTemplateID.
+func (s *TemplateCreateFromDefaultRequest) SetSourceTemplateID(val int64) {
+	s.SourceTemplateID = val
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *TemplateCreateFromDefaultRequest) SetProjectID(val int64) {
+	s.ProjectID = val
+}
+
+// SetName sets the value of Name.
+func (s *TemplateCreateFromDefaultRequest) SetName(val string) {
+	s.Name = val
+}
+
+// Ref: #/components/schemas/TemplateCreateFromDefaultResponse
+type TemplateCreateFromDefaultResponse struct {
+	// ID созданного шаблона.
+	ID int64 `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *TemplateCreateFromDefaultResponse) GetID() int64 {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *TemplateCreateFromDefaultResponse) SetID(val int64) {
+	s.ID = val
+}
+
+func (*TemplateCreateFromDefaultResponse) templateCreateFromDefaultRes() {}
+
+// Ref: #/components/schemas/TemplateCreateRequest
+type TemplateCreateRequest struct {
+	// Название шаблона.
+	Name string `json:"name"`
+	// ID проекта.
+	ProjectID int64 `json:"projectID"`
+}
+
+// GetName returns the value of Name.
+func (s *TemplateCreateRequest) GetName() string {
+	return s.Name
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *TemplateCreateRequest) GetProjectID() int64 {
+	return s.ProjectID
+}
+
+// SetName sets the value of Name.
+func (s *TemplateCreateRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *TemplateCreateRequest) SetProjectID(val int64) {
+	s.ProjectID = val
+}
+
+// Ref: #/components/schemas/TemplateDefaultListResponse
+type TemplateDefaultListResponse struct {
+	// Список библиотечных шаблонов.
+	Templates []TemplateDefaultListResponseTemplatesItem `json:"templates"`
+	// Общее количество шаблонов.
+	TotalTemplates int64 `json:"totalTemplates"`
+	// Общее количество страниц.
+	TotalPages int64 `json:"totalPages"`
+}
+
+// GetTemplates returns the value of Templates.
+func (s *TemplateDefaultListResponse) GetTemplates() []TemplateDefaultListResponseTemplatesItem {
+	return s.Templates
+}
+
+// GetTotalTemplates returns the value of TotalTemplates.
+func (s *TemplateDefaultListResponse) GetTotalTemplates() int64 {
+	return s.TotalTemplates
+}
+
+// GetTotalPages returns the value of TotalPages.
+func (s *TemplateDefaultListResponse) GetTotalPages() int64 {
+	return s.TotalPages
+}
+
+// SetTemplates sets the value of Templates.
+func (s *TemplateDefaultListResponse) SetTemplates(val []TemplateDefaultListResponseTemplatesItem) {
+	s.Templates = val
+}
+
+// SetTotalTemplates sets the value of TotalTemplates.
+func (s *TemplateDefaultListResponse) SetTotalTemplates(val int64) {
+	s.TotalTemplates = val
+}
+
+// SetTotalPages sets the value of TotalPages.
+func (s *TemplateDefaultListResponse) SetTotalPages(val int64) {
+	s.TotalPages = val
+}
+
+func (*TemplateDefaultListResponse) templateDefaultListRes() {}
+
+// Библиотечный шаблон.
+type TemplateDefaultListResponseTemplatesItem struct {
+	// ID шаблона.
+	ID int64 `json:"id"`
+	// Название шаблона.
+	Name string `json:"name"`
+	// Дата и время создания шаблона.
+	CreatedAt time.Time `json:"createdAt"`
+	// Дата и время обновления шаблона.
+	UpdatedAt OptDateTime `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *TemplateDefaultListResponseTemplatesItem) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *TemplateDefaultListResponseTemplatesItem) GetName() string {
+	return s.Name
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TemplateDefaultListResponseTemplatesItem) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *TemplateDefaultListResponseTemplatesItem) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *TemplateDefaultListResponseTemplatesItem) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *TemplateDefaultListResponseTemplatesItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TemplateDefaultListResponseTemplatesItem) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *TemplateDefaultListResponseTemplatesItem) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// TemplateDeleteByIDNoContent is response for TemplateDeleteByID operation.
+type TemplateDeleteByIDNoContent struct{}
+
+func (*TemplateDeleteByIDNoContent) templateDeleteByIDRes() {}
+
+// Ref: #/components/schemas/TemplateGetByIDResponse
+type TemplateGetByIDResponse struct {
+	// Название шаблона.
+	Name         string       `json:"name"`
+	OutputFormat OutputFormat `json:"outputFormat"`
+	// Профиль оформления документа, если выбран.
+	StyleProfile OptString                 `json:"styleProfile"`
+	Version      OptTemplateGetByIDVersion `json:"version"`
+}
+
+// GetName returns the value of Name.
+func (s *TemplateGetByIDResponse) GetName() string {
+	return s.Name
+}
+
+// GetOutputFormat returns the value of OutputFormat.
+func (s *TemplateGetByIDResponse) GetOutputFormat() OutputFormat {
+	return s.OutputFormat
+}
+
+// GetStyleProfile returns the value of StyleProfile.
+func (s *TemplateGetByIDResponse) GetStyleProfile() OptString {
+	return s.StyleProfile
+}
+
+// GetVersion returns the value of Version.
+func (s *TemplateGetByIDResponse) GetVersion() OptTemplateGetByIDVersion {
+	return s.Version
+}
+
+// SetName sets the value of Name.
+func (s *TemplateGetByIDResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetOutputFormat sets the value of OutputFormat.
+func (s *TemplateGetByIDResponse) SetOutputFormat(val OutputFormat) {
+	s.OutputFormat = val
+}
+
+// SetStyleProfile sets the value of StyleProfile.
+func (s *TemplateGetByIDResponse) SetStyleProfile(val OptString) {
+	s.StyleProfile = val
+}
+
+// SetVersion sets the value of Version.
+func (s *TemplateGetByIDResponse) SetVersion(val OptTemplateGetByIDVersion) {
+	s.Version = val
+}
+
+func (*TemplateGetByIDResponse) templateGetByIDRes() {}
+
+// Ref: #/components/schemas/TemplateGetByIDVersion
+type TemplateGetByIDVersion struct {
+	// ID последней версии шаблона.
+	ID int64 `json:"id"`
+	// Номер последней версии шаблона.
+	Number int64 `json:"number"`
+	// Дата и время создания версии.
+	CreatedAt time.Time `json:"createdAt"`
+	// Данные шаблона.
+	Data []byte `json:"data"`
+	// Вид шаблона (md — Markdown, docx — документ Word).
+	Kind TemplateGetByIDVersionKind `json:"kind"`
+	// Список переменных шаблона.
+	Variables []TemplateGetByIDVersionVariablesItem `json:"variables"`
+}
+
+// GetID returns the value of ID.
+func (s *TemplateGetByIDVersion) GetID() int64 {
+	return s.ID
+}
+
+// GetNumber returns the value of Number.
+func (s *TemplateGetByIDVersion) GetNumber() int64 {
+	return s.Number
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TemplateGetByIDVersion) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetData returns the value of Data.
+func (s *TemplateGetByIDVersion) GetData() []byte {
+	return s.Data
+}
+
+// GetKind returns the value of Kind.
+func (s *TemplateGetByIDVersion) GetKind() TemplateGetByIDVersionKind {
+	return s.Kind
+}
+
+// GetVariables returns the value of Variables.
+func (s *TemplateGetByIDVersion) GetVariables() []TemplateGetByIDVersionVariablesItem {
+	return s.Variables
+}
+
+// SetID sets the value of ID.
+func (s *TemplateGetByIDVersion) SetID(val int64) {
+	s.ID = val
+}
+
+// SetNumber sets the value of Number.
+func (s *TemplateGetByIDVersion) SetNumber(val int64) {
+	s.Number = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TemplateGetByIDVersion) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetData sets the value of Data.
+func (s *TemplateGetByIDVersion) SetData(val []byte) {
+	s.Data = val
+}
+
+// SetKind sets the value of Kind.
+func (s *TemplateGetByIDVersion) SetKind(val TemplateGetByIDVersionKind) {
+	s.Kind = val
+}
+
+// SetVariables sets the value of Variables.
+func (s *TemplateGetByIDVersion) SetVariables(val []TemplateGetByIDVersionVariablesItem) {
+	s.Variables = val
+}
+
+// Вид шаблона (md — Markdown, docx — документ Word).
+type TemplateGetByIDVersionKind string
+
+const (
+	TemplateGetByIDVersionKindMd   TemplateGetByIDVersionKind = "md"
+	TemplateGetByIDVersionKindDocx TemplateGetByIDVersionKind = "docx"
+)
+
+// AllValues returns all TemplateGetByIDVersionKind values.
+func (TemplateGetByIDVersionKind) AllValues() []TemplateGetByIDVersionKind {
+	return []TemplateGetByIDVersionKind{
+		TemplateGetByIDVersionKindMd,
+		TemplateGetByIDVersionKindDocx,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TemplateGetByIDVersionKind) MarshalText() ([]byte, error) {
+	switch s {
+	case TemplateGetByIDVersionKindMd:
+		return []byte(s), nil
+	case TemplateGetByIDVersionKindDocx:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TemplateGetByIDVersionKind) UnmarshalText(data []byte) error {
+	switch TemplateGetByIDVersionKind(data) {
+	case TemplateGetByIDVersionKindMd:
+		*s = TemplateGetByIDVersionKindMd
+		return nil
+	case TemplateGetByIDVersionKindDocx:
+		*s = TemplateGetByIDVersionKindDocx
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Переменная шаблона.
+type TemplateGetByIDVersionVariablesItem struct {
+	// ID переменной.
+	ID int64 `json:"id"`
+	// Слаг переменной (идентификатор).
+	Name string `json:"name"`
+	// Человекочитаемое название переменной.
+	Title string `json:"title"`
+	// Тип переменной.
+	Type TemplateGetByIDVersionVariablesItemType `json:"type"`
+	// Выражение переменной.
+	Expression OptString `json:"expression"`
+	// Являтеся ли переменная входной.
+	IsInput bool `json:"isInput"`
+	// Выражение значения по умолчанию для необязательной
+	// входной переменной.
+	DefaultExpression OptString `json:"defaultExpression"`
+	// Условие, при котором переменная используется; если
+	// ложно, переменная скрыта и равна nil.
+	EnabledIf OptString `json:"enabledIf"`
+	// Единица измерения числовой переменной, например mm, kN
+	// или m/s^2.
+	Unit OptString `json:"unit"`
+	// Список допустимых значений (для типа enum).
+	Options []string `json:"options"`
+	// Столбец справочника проекта, из которого берутся
+	// допустимые значения (для типа enum) вместо options,
+	// например materials.key.
+	OptionsFrom OptString `json:"optionsFrom"`
+	// Тип элементов (для типа list).
+	ItemType OptTemplateGetByIDVersionVariablesItemItemType `json:"itemType"`
+	// Список колонок (для типа table).
+	Columns []TemplateGetByIDVersionVariablesItemColumnsItem `json:"columns"`
+	// Список ограничений переменной.
+	Constraints []TemplateGetByIDVersionVariablesItemConstraintsItem `json:"constraints"`
+}
+
+// GetID returns the value of ID.
+func (s *TemplateGetByIDVersionVariablesItem) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *TemplateGetByIDVersionVariablesItem) GetName() string {
+	return s.Name
+}
+
+// GetTitle returns the value of Title.
+func (s *TemplateGetByIDVersionVariablesItem) GetTitle() string {
+	return s.Title
+}
+
+// GetType returns the value of Type.
+func (s *TemplateGetByIDVersionVariablesItem) GetType() TemplateGetByIDVersionVariablesItemType {
+	return s.Type
+}
+
+// GetExpression returns the value of Expression.
+func (s *TemplateGetByIDVersionVariablesItem) GetExpression() OptString {
+	return s.Expression
+}
+
+// GetIsInput returns the value of IsInput.
+func (s *TemplateGetByIDVersionVariablesItem) GetIsInput() bool {
+	return s.IsInput
+}
+
+// GetDefaultExpression returns the value of DefaultExpression.
+func (s *TemplateGetByIDVersionVariablesItem) GetDefaultExpression() OptString {
+	return s.DefaultExpression
+}
+
+// GetEnabledIf returns the value of EnabledIf.
+func (s *TemplateGetByIDVersionVariablesItem) GetEnabledIf() OptString {
+	return s.EnabledIf
+}
+
+// GetUnit returns the value of Unit.
+func (s *TemplateGetByIDVersionVariablesItem) GetUnit() OptString {
+	return s.Unit
+}
+
+// GetOptions returns the value of Options.
+func (s *TemplateGetByIDVersionVariablesItem) GetOptions() []string {
+	return s.Options
+}
+
+// GetOptionsFrom returns the value of OptionsFrom.
+func (s *TemplateGetByIDVersionVariablesItem) GetOptionsFrom() OptString {
+	return s.OptionsFrom
+}
+
+// GetItemType returns the value of ItemType.
+func (s *TemplateGetByIDVersionVariablesItem) GetItemType() OptTemplateGetByIDVersionVariablesItemItemType {
+	return s.ItemType
+}
+
+// GetColumns returns the value of Columns.
+func (s *TemplateGetByIDVersionVariablesItem) GetColumns() []TemplateGetByIDVersionVariablesItemColumnsItem {
+	return s.Columns
+}
+
+// GetConstraints returns the value of Constraints.
+func (s *TemplateGetByIDVersionVariablesItem) GetConstraints() []TemplateGetByIDVersionVariablesItemConstraintsItem {
+	return s.Constraints
+}
+
+// SetID sets the value of ID.
+func (s *TemplateGetByIDVersionVariablesItem) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *TemplateGetByIDVersionVariablesItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetTitle sets the value of Title.
+func (s *TemplateGetByIDVersionVariablesItem) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetType sets the value of Type.
+func (s *TemplateGetByIDVersionVariablesItem) SetType(val TemplateGetByIDVersionVariablesItemType) {
+	s.Type = val
+}
+
+// SetExpression sets the value of Expression.
+func (s *TemplateGetByIDVersionVariablesItem) SetExpression(val OptString) {
+	s.Expression = val
+}
+
+// SetIsInput sets the value of IsInput.
+func (s *TemplateGetByIDVersionVariablesItem) SetIsInput(val bool) {
+	s.IsInput = val
+}
+
+// SetDefaultExpression sets the value of DefaultExpression.
+func (s *TemplateGetByIDVersionVariablesItem) SetDefaultExpression(val OptString) {
+	s.DefaultExpression = val
+}
+
+// SetEnabledIf sets the value of EnabledIf.
+func (s *TemplateGetByIDVersionVariablesItem) SetEnabledIf(val OptString) {
+	s.EnabledIf = val
+}
+
+// SetUnit sets the value of Unit.
+func (s *TemplateGetByIDVersionVariablesItem) SetUnit(val OptString) {
+	s.Unit = val
+}
+
+// SetOptions sets the value of Options.
+func (s *TemplateGetByIDVersionVariablesItem) SetOptions(val []string) {
+	s.Options = val
+}
+
+// SetOptionsFrom sets the value of OptionsFrom.
+func (s *TemplateGetByIDVersionVariablesItem) SetOptionsFrom(val OptString) {
+	s.OptionsFrom = val
+}
+
+// SetItemType sets the value of ItemType.
+func (s *TemplateGetByIDVersionVariablesItem) SetItemType(val OptTemplateGetByIDVersionVariablesItemItemType) {
+	s.ItemType = val
+}
+
+// SetColumns sets the value of Columns.
+func (s *TemplateGetByIDVersionVariablesItem) SetColumns(val []TemplateGetByIDVersionVariablesItemColumnsItem) {
+	s.Columns = val
+}
+
+// SetConstraints sets the value of Constraints.
+func (s *TemplateGetByIDVersionVariablesItem) SetConstraints(val []TemplateGetByIDVersionVariablesItemConstraintsItem) {
+	s.Constraints = val
+}
+
+// Колонка таблицы.
+type TemplateGetByIDVersionVariablesItemColumnsItem struct {
+	// Слаг колонки (идентификатор).
+	Name string `json:"name"`
+	// Человекочитаемое название колонки.
+	Title string `json:"title"`
+	// Тип колонки.
+	Type TemplateGetByIDVersionVariablesItemColumnsItemType `json:"type"`
+	// Список допустимых значений (для типа enum).
+	Options []string `json:"options"`
+}
+
+// GetName returns the value of Name.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) GetName() string {
+	return s.Name
+}
+
+// GetTitle returns the value of Title.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) GetTitle() string {
+	return s.Title
+}
+
+// GetType returns the value of Type.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) GetType() TemplateGetByIDVersionVariablesItemColumnsItemType {
+	return s.Type
+}
+
+// GetOptions returns the value of Options.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) GetOptions() []string {
+	return s.Options
+}
+
+// SetName sets the value of Name.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetTitle sets the value of Title.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetType sets the value of Type.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) SetType(val TemplateGetByIDVersionVariablesItemColumnsItemType) {
+	s.Type = val
+}
+
+// SetOptions sets the value of Options.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItem) SetOptions(val []string) {
+	s.Options = val
+}
+
+// Тип колонки.
+type TemplateGetByIDVersionVariablesItemColumnsItemType string
+
+const (
+	TemplateGetByIDVersionVariablesItemColumnsItemTypeString  TemplateGetByIDVersionVariablesItemColumnsItemType = "string"
+	TemplateGetByIDVersionVariablesItemColumnsItemTypeInteger TemplateGetByIDVersionVariablesItemColumnsItemType = "integer"
+	TemplateGetByIDVersionVariablesItemColumnsItemTypeFloat   TemplateGetByIDVersionVariablesItemColumnsItemType = "float"
+	TemplateGetByIDVersionVariablesItemColumnsItemTypeDecimal TemplateGetByIDVersionVariablesItemColumnsItemType = "decimal"
+	TemplateGetByIDVersionVariablesItemColumnsItemTypeBoolean TemplateGetByIDVersionVariablesItemColumnsItemType = "boolean"
+	TemplateGetByIDVersionVariablesItemColumnsItemTypeDate    TemplateGetByIDVersionVariablesItemColumnsItemType = "date"
+	TemplateGetByIDVersionVariablesItemColumnsItemTypeEnum    TemplateGetByIDVersionVariablesItemColumnsItemType = "enum"
+)
+
+// AllValues returns all TemplateGetByIDVersionVariablesItemColumnsItemType values.
+func (TemplateGetByIDVersionVariablesItemColumnsItemType) AllValues() []TemplateGetByIDVersionVariablesItemColumnsItemType {
+	return []TemplateGetByIDVersionVariablesItemColumnsItemType{
+		TemplateGetByIDVersionVariablesItemColumnsItemTypeString,
+		TemplateGetByIDVersionVariablesItemColumnsItemTypeInteger,
+		TemplateGetByIDVersionVariablesItemColumnsItemTypeFloat,
+		TemplateGetByIDVersionVariablesItemColumnsItemTypeDecimal,
+		TemplateGetByIDVersionVariablesItemColumnsItemTypeBoolean,
+		TemplateGetByIDVersionVariablesItemColumnsItemTypeDate,
+		TemplateGetByIDVersionVariablesItemColumnsItemTypeEnum,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TemplateGetByIDVersionVariablesItemColumnsItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeString:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeInteger:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeFloat:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeDecimal:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeBoolean:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeDate:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeEnum:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TemplateGetByIDVersionVariablesItemColumnsItemType) UnmarshalText(data []byte) error {
+	switch TemplateGetByIDVersionVariablesItemColumnsItemType(data) {
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeString:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeString
+		return nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeInteger:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeInteger
+		return nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeFloat:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeFloat
+		return nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeDecimal:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeDecimal
+		return nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeBoolean:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeBoolean
+		return nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeDate:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeDate
+		return nil
+	case TemplateGetByIDVersionVariablesItemColumnsItemTypeEnum:
+		*s = TemplateGetByIDVersionVariablesItemColumnsItemTypeEnum
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ограничение переменной.
+type TemplateGetByIDVersionVariablesItemConstraintsItem struct {
+	// ID ограничения.
+	ID int64 `json:"id"`
+	// Название ограничения.
+	Name string `json:"name"`
+	// Выражение ограничения.
+	Expression string `json:"expression"`
+	// Активно ли ограничение.
+	IsActive bool `json:"isActive"`
+}
+
+// GetID returns the value of ID.
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) GetName() string {
+	return s.Name
+}
+
+// GetExpression returns the value of Expression.
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) GetExpression() string {
+	return s.Expression
+}
+
+// GetIsActive returns the value of IsActive.
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) GetIsActive() bool {
+	return s.IsActive
+}
+
+// SetID sets the value of ID.
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetExpression sets the value of Expression.
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) SetExpression(val string) {
+	s.Expression = val
+}
+
+// SetIsActive sets the value of IsActive.
+func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) SetIsActive(val bool) {
+	s.IsActive = val
+}
+
+// Тип элементов (для типа list).
+type TemplateGetByIDVersionVariablesItemItemType string
+
+const (
+	TemplateGetByIDVersionVariablesItemItemTypeString  TemplateGetByIDVersionVariablesItemItemType = "string"
+	TemplateGetByIDVersionVariablesItemItemTypeInteger TemplateGetByIDVersionVariablesItemItemType = "integer"
+	TemplateGetByIDVersionVariablesItemItemTypeFloat   TemplateGetByIDVersionVariablesItemItemType = "float"
+	TemplateGetByIDVersionVariablesItemItemTypeDecimal TemplateGetByIDVersionVariablesItemItemType = "decimal"
+	TemplateGetByIDVersionVariablesItemItemTypeBoolean TemplateGetByIDVersionVariablesItemItemType = "boolean"
+	TemplateGetByIDVersionVariablesItemItemTypeDate    TemplateGetByIDVersionVariablesItemItemType = "date"
+	TemplateGetByIDVersionVariablesItemItemTypeEnum    TemplateGetByIDVersionVariablesItemItemType = "enum"
+)
+
+// AllValues returns all TemplateGetByIDVersionVariablesItemItemType values.
+func (TemplateGetByIDVersionVariablesItemItemType) AllValues() []TemplateGetByIDVersionVariablesItemItemType {
+	return []TemplateGetByIDVersionVariablesItemItemType{
+		TemplateGetByIDVersionVariablesItemItemTypeString,
+		TemplateGetByIDVersionVariablesItemItemTypeInteger,
+		TemplateGetByIDVersionVariablesItemItemTypeFloat,
+		TemplateGetByIDVersionVariablesItemItemTypeDecimal,
+		TemplateGetByIDVersionVariablesItemItemTypeBoolean,
+		TemplateGetByIDVersionVariablesItemItemTypeDate,
+		TemplateGetByIDVersionVariablesItemItemTypeEnum,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TemplateGetByIDVersionVariablesItemItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case TemplateGetByIDVersionVariablesItemItemTypeString:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemItemTypeInteger:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemItemTypeFloat:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemItemTypeDecimal:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemItemTypeBoolean:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemItemTypeDate:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemItemTypeEnum:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TemplateGetByIDVersionVariablesItemItemType) UnmarshalText(data []byte) error {
+	switch TemplateGetByIDVersionVariablesItemItemType(data) {
+	case TemplateGetByIDVersionVariablesItemItemTypeString:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeString
+		return nil
+	case TemplateGetByIDVersionVariablesItemItemTypeInteger:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeInteger
+		return nil
+	case TemplateGetByIDVersionVariablesItemItemTypeFloat:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeFloat
+		return nil
+	case TemplateGetByIDVersionVariablesItemItemTypeDecimal:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeDecimal
+		return nil
+	case TemplateGetByIDVersionVariablesItemItemTypeBoolean:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeBoolean
+		return nil
+	case TemplateGetByIDVersionVariablesItemItemTypeDate:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeDate
+		return nil
+	case TemplateGetByIDVersionVariablesItemItemTypeEnum:
+		*s = TemplateGetByIDVersionVariablesItemItemTypeEnum
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Тип переменной.
+type TemplateGetByIDVersionVariablesItemType string
+
+const (
+	TemplateGetByIDVersionVariablesItemTypeString  TemplateGetByIDVersionVariablesItemType = "string"
+	TemplateGetByIDVersionVariablesItemTypeInteger TemplateGetByIDVersionVariablesItemType = "integer"
+	TemplateGetByIDVersionVariablesItemTypeFloat   TemplateGetByIDVersionVariablesItemType = "float"
+	TemplateGetByIDVersionVariablesItemTypeDecimal TemplateGetByIDVersionVariablesItemType = "decimal"
+	TemplateGetByIDVersionVariablesItemTypeBoolean TemplateGetByIDVersionVariablesItemType = "boolean"
+	TemplateGetByIDVersionVariablesItemTypeDate    TemplateGetByIDVersionVariablesItemType = "date"
+	TemplateGetByIDVersionVariablesItemTypeEnum    TemplateGetByIDVersionVariablesItemType = "enum"
+	TemplateGetByIDVersionVariablesItemTypeList    TemplateGetByIDVersionVariablesItemType = "list"
+	TemplateGetByIDVersionVariablesItemTypeTable   TemplateGetByIDVersionVariablesItemType = "table"
+)
+
+// AllValues returns all TemplateGetByIDVersionVariablesItemType values.
+func (TemplateGetByIDVersionVariablesItemType) AllValues() []TemplateGetByIDVersionVariablesItemType {
+	return []TemplateGetByIDVersionVariablesItemType{
+		TemplateGetByIDVersionVariablesItemTypeString,
+		TemplateGetByIDVersionVariablesItemTypeInteger,
+		TemplateGetByIDVersionVariablesItemTypeFloat,
+		TemplateGetByIDVersionVariablesItemTypeDecimal,
+		TemplateGetByIDVersionVariablesItemTypeBoolean,
+		TemplateGetByIDVersionVariablesItemTypeDate,
+		TemplateGetByIDVersionVariablesItemTypeEnum,
+		TemplateGetByIDVersionVariablesItemTypeList,
+		TemplateGetByIDVersionVariablesItemTypeTable,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TemplateGetByIDVersionVariablesItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case TemplateGetByIDVersionVariablesItemTypeString:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemTypeInteger:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemTypeFloat:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemTypeDecimal:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemTypeBoolean:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemTypeDate:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemTypeEnum:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemTypeList:
+		return []byte(s), nil
+	case TemplateGetByIDVersionVariablesItemTypeTable:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TemplateGetByIDVersionVariablesItemType) UnmarshalText(data []byte) error {
+	switch TemplateGetByIDVersionVariablesItemType(data) {
+	case TemplateGetByIDVersionVariablesItemTypeString:
+		*s = TemplateGetByIDVersionVariablesItemTypeString
+		return nil
+	case TemplateGetByIDVersionVariablesItemTypeInteger:
+		*s = TemplateGetByIDVersionVariablesItemTypeInteger
+		return nil
+	case TemplateGetByIDVersionVariablesItemTypeFloat:
+		*s = TemplateGetByIDVersionVariablesItemTypeFloat
+		return nil
+	case TemplateGetByIDVersionVariablesItemTypeDecimal:
+		*s = TemplateGetByIDVersionVariablesItemTypeDecimal
+		return nil
+	case TemplateGetByIDVersionVariablesItemTypeBoolean:
+		*s = TemplateGetByIDVersionVariablesItemTypeBoolean
+		return nil
+	case TemplateGetByIDVersionVariablesItemTypeDate:
+		*s = TemplateGetByIDVersionVariablesItemTypeDate
+		return nil
+	case TemplateGetByIDVersionVariablesItemTypeEnum:
+		*s = TemplateGetByIDVersionVariablesItemTypeEnum
+		return nil
+	case TemplateGetByIDVersionVariablesItemTypeList:
+		*s = TemplateGetByIDVersionVariablesItemTypeList
+		return nil
+	case TemplateGetByIDVersionVariablesItemTypeTable:
+		*s = TemplateGetByIDVersionVariablesItemTypeTable
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/TemplateGetMetaByIDResponse
+type TemplateGetMetaByIDResponse struct {
+	// Название шаблона.
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *TemplateGetMetaByIDResponse) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *TemplateGetMetaByIDResponse) SetName(val string) {
+	s.Name = val
+}
+
+func (*TemplateGetMetaByIDResponse) templateGetMetaByIDRes() {}
+
+// Ref: #/components/schemas/TemplateImportPayload
+type TemplateImportPayload struct {
+	// Название шаблона.
+	Name    string                   `json:"name"`
+	Version OptTemplateImportVersion `json:"version"`
+}
+
+// GetName returns the value of Name.
+func (s *TemplateImportPayload) GetName() string {
+	return s.Name
+}
+
+// GetVersion returns the value of Version.
+func (s *TemplateImportPayload) GetVersion() OptTemplateImportVersion {
+	return s.Version
+}
+
+// SetName sets the value of Name.
+func (s *TemplateImportPayload) SetName(val string) {
+	s.Name = val
+}
+
+// SetVersion sets the value of Version.
+func (s *TemplateImportPayload) SetVersion(val OptTemplateImportVersion) {
+	s.Version = val
+}
+
+// Ref: #/components/schemas/TemplateImportRequest
+type TemplateImportRequest struct {
+	// ID проекта.
+	ProjectID int64                 `json:"projectID"`
+	Template  TemplateImportPayload `json:"template"`
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *TemplateImportRequest) GetProjectID() int64 {
+	return s.ProjectID
+}
+
+// GetTemplate returns the value of Template.
+func (s *TemplateImportRequest) GetTemplate() TemplateImportPayload {
+	return s.Template
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *TemplateImportRequest) SetProjectID(val int64) {
+	s.ProjectID = val
+}
+
+// SetTemplate sets the value of Template.
+func (s *TemplateImportRequest) SetTemplate(val TemplateImportPayload) {
+	s.Template = val
+}
+
+// Ref: #/components/schemas/TemplateImportResponse
+type TemplateImportResponse struct {
+	// ID созданного шаблона.
+	ID int64 `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *TemplateImportResponse) GetID() int64 {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *TemplateImportResponse) SetID(val int64) {
+	s.ID = val
+}
+
+func (*TemplateImportResponse) templateImportRes() {}
+
+// Ref: #/components/schemas/TemplateImportVersion
+type TemplateImportVersion struct {
+	// Данные шаблона.
+	Data []byte `json:"data"`
+	// Список переменных шаблона.
+	Variables []TemplateImportVersionVariablesItem `json:"variables"`
+}
+
+// GetData returns the value of Data.
+func (s *TemplateImportVersion) GetData() []byte {
+	return s.Data
+}
+
+// GetVariables returns the value of Variables.
+func (s *TemplateImportVersion) GetVariables() []TemplateImportVersionVariablesItem {
+	return s.Variables
+}
+
+// SetData sets the value of Data.
+func (s *TemplateImportVersion) SetData(val []byte) {
+	s.Data = val
+}
+
+// SetVariables sets the value of Variables.
+func (s *TemplateImportVersion) SetVariables(val []TemplateImportVersionVariablesItem) {
+	s.Variables = val
+}
+
+// Переменная шаблона.
+type TemplateImportVersionVariablesItem struct {
+	// Слаг переменной (идентификатор).
+	Name string `json:"name"`
+	// Человекочитаемое название переменной.
+	Title string `json:"title"`
+	// Тип переменной.
+	Type TemplateImportVersionVariablesItemType `json:"type"`
+	// Выражение переменной.
+	Expression OptString `json:"expression"`
+	// Является ли переменная входной.
+	IsInput bool `json:"isInput"`
+	// Выражение значения по умолчанию для необязательной
+	// входной переменной.
+	DefaultExpression OptString `json:"defaultExpression"`
+	// Условие, при котором переменная используется; если
+	// ложно, переменная скрыта и равна nil.
+	EnabledIf OptString `json:"enabledIf"`
+	// Единица измерения числовой переменной, например mm, kN
+	// или m/s^2.
+	Unit OptString `json:"unit"`
+	// Список допустимых значений (для типа enum).
+	Options []string `json:"options"`
+	// Столбец справочника проекта, из которого берутся
+	// допустимые значения (для типа enum) вместо options,
+	// например materials.key.
+	OptionsFrom OptString `json:"optionsFrom"`
+	// Тип элементов (для типа list).
+	ItemType OptTemplateImportVersionVariablesItemItemType `json:"itemType"`
+	// Список колонок (для типа table).
+	Columns []TemplateImportVersionVariablesItemColumnsItem `json:"columns"`
+	// Список ограничений переменной.
+	Constraints []TemplateImportVersionVariablesItemConstraintsItem `json:"constraints"`
+}
+
+// GetName returns the value of Name.
+func (s *TemplateImportVersionVariablesItem) GetName() string {
+	return s.Name
+}
+
+// GetTitle returns the value of Title.
+func (s *TemplateImportVersionVariablesItem) GetTitle() string {
+	return s.Title
+}
+
+// GetType returns the value of Type.
+func (s *TemplateImportVersionVariablesItem) GetType() TemplateImportVersionVariablesItemType {
+	return s.Type
+}
+
+// GetExpression returns the value of Expression.
+func (s *TemplateImportVersionVariablesItem) GetExpression() OptString {
+	return s.Expression
+}
+
+// GetIsInput returns the value of IsInput.
+func (s *TemplateImportVersionVariablesItem) GetIsInput() bool {
+	return s.IsInput
+}
+
+// GetDefaultExpression returns the value of DefaultExpression.
+func (s *TemplateImportVersionVariablesItem) GetDefaultExpression() OptString {
+	return s.DefaultExpression
+}
+
+// GetEnabledIf returns the value of EnabledIf.
+func (s *TemplateImportVersionVariablesItem) GetEnabledIf() OptString {
+	return s.EnabledIf
+}
+
+// GetUnit returns the value of Unit.
+func (s *TemplateImportVersionVariablesItem) GetUnit() OptString {
+	return s.Unit
+}
+
+// GetOptions returns the value of Options.
+func (s *TemplateImportVersionVariablesItem) GetOptions() []string {
+	return s.Options
+}
+
+// GetOptionsFrom returns the value of OptionsFrom.
+func (s *TemplateImportVersionVariablesItem) GetOptionsFrom() OptString {
+	return s.OptionsFrom
+}
+
+// GetItemType returns the value of ItemType.
+func (s *TemplateImportVersionVariablesItem) GetItemType() OptTemplateImportVersionVariablesItemItemType {
+	return s.ItemType
+}
+
+// GetColumns returns the value of Columns.
+func (s *TemplateImportVersionVariablesItem) GetColumns() []TemplateImportVersionVariablesItemColumnsItem {
+	return s.Columns
+}
+
+// GetConstraints returns the value of Constraints.
+func (s *TemplateImportVersionVariablesItem) GetConstraints() []TemplateImportVersionVariablesItemConstraintsItem {
+	return s.Constraints
+}
+
+// SetName sets the value of Name.
+func (s *TemplateImportVersionVariablesItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetTitle sets the value of Title.
+func (s *TemplateImportVersionVariablesItem) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetType sets the value of Type.
+func (s *TemplateImportVersionVariablesItem) SetType(val TemplateImportVersionVariablesItemType) {
+	s.Type = val
+}
+
+// SetExpression sets the value of Expression.
+func (s *TemplateImportVersionVariablesItem) SetExpression(val OptString) {
+	s.Expression = val
+}
+
+// SetIsInput sets the value of IsInput.
+func (s *TemplateImportVersionVariablesItem) SetIsInput(val bool) {
+	s.IsInput = val
+}
+
+// SetDefaultExpression sets the value of DefaultExpression.
+func (s *TemplateImportVersionVariablesItem) SetDefaultExpression(val OptString) {
+	s.DefaultExpression = val
+}
+
+// SetEnabledIf sets the value of EnabledIf.
+func (s *TemplateImportVersionVariablesItem) SetEnabledIf(val OptString) {
+	s.EnabledIf = val
+}
+
+// SetUnit sets the value of Unit.
+func (s *TemplateImportVersionVariablesItem) SetUnit(val OptString) {
+	s.Unit = val
+}
+
+// SetOptions sets the value of Options.
+func (s *TemplateImportVersionVariablesItem) SetOptions(val []string) {
+	s.Options = val
+}
+
+// SetOptionsFrom sets the value of OptionsFrom.
+func (s *TemplateImportVersionVariablesItem) SetOptionsFrom(val OptString) {
+	s.OptionsFrom = val
+}
+
+// SetItemType sets the value of ItemType.
+func (s *TemplateImportVersionVariablesItem) SetItemType(val OptTemplateImportVersionVariablesItemItemType) {
+	s.ItemType = val
+}
+
+// SetColumns sets the value of Columns.
+func (s *TemplateImportVersionVariablesItem) SetColumns(val []TemplateImportVersionVariablesItemColumnsItem) {
+	s.Columns = val
+}
+
+// SetConstraints sets the value of Constraints.
+func (s *TemplateImportVersionVariablesItem) SetConstraints(val []TemplateImportVersionVariablesItemConstraintsItem) {
+	s.Constraints = val
+}
+
+// Колонка таблицы.
+type TemplateImportVersionVariablesItemColumnsItem struct {
+	// Слаг колонки (идентификатор).
+	Name string `json:"name"`
+	// Человекочитаемое название колонки.
+	Title string `json:"title"`
+	// Тип колонки.
+	Type TemplateImportVersionVariablesItemColumnsItemType `json:"type"`
+	// Список допустимых значений (для типа enum).
+	Options []string `json:"options"`
+}
+
+// GetName returns the value of Name.
+func (s *TemplateImportVersionVariablesItemColumnsItem) GetName() string {
+	return s.Name
+}
+
+// GetTitle returns the value of Title.
+func (s *TemplateImportVersionVariablesItemColumnsItem) GetTitle() string {
+	return s.Title
+}
+
+// GetType returns the value of Type.
+func (s *TemplateImportVersionVariablesItemColumnsItem) GetType() TemplateImportVersionVariablesItemColumnsItemType {
+	return s.Type
+}
+
+// GetOptions returns the value of Options.
+func (s *TemplateImportVersionVariablesItemColumnsItem) GetOptions() []string {
+	return s.Options
+}
+
+// SetName sets the value of Name.
+func (s *TemplateImportVersionVariablesItemColumnsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetTitle sets the value of Title.
+func (s *TemplateImportVersionVariablesItemColumnsItem) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetType sets the value of Type.
+func (s *TemplateImportVersionVariablesItemColumnsItem) SetType(val TemplateImportVersionVariablesItemColumnsItemType) {
+	s.Type = val
+}
+
+// SetOptions sets the value of Options.
+func (s *TemplateImportVersionVariablesItemColumnsItem) SetOptions(val []string) {
+	s.Options = val
+}
+
+// Тип колонки.
+type TemplateImportVersionVariablesItemColumnsItemType string
+
+const (
+	TemplateImportVersionVariablesItemColumnsItemTypeString  TemplateImportVersionVariablesItemColumnsItemType = "string"
+	TemplateImportVersionVariablesItemColumnsItemTypeInteger TemplateImportVersionVariablesItemColumnsItemType = "integer"
+	TemplateImportVersionVariablesItemColumnsItemTypeFloat   TemplateImportVersionVariablesItemColumnsItemType = "float"
+	TemplateImportVersionVariablesItemColumnsItemTypeDecimal TemplateImportVersionVariablesItemColumnsItemType = "decimal"
+	TemplateImportVersionVariablesItemColumnsItemTypeBoolean TemplateImportVersionVariablesItemColumnsItemType = "boolean"
+	TemplateImportVersionVariablesItemColumnsItemTypeDate    TemplateImportVersionVariablesItemColumnsItemType = "date"
+	TemplateImportVersionVariablesItemColumnsItemTypeEnum    TemplateImportVersionVariablesItemColumnsItemType = "enum"
+)
+
+// AllValues returns all TemplateImportVersionVariablesItemColumnsItemType values.
+func (TemplateImportVersionVariablesItemColumnsItemType) AllValues() []TemplateImportVersionVariablesItemColumnsItemType {
+	return []TemplateImportVersionVariablesItemColumnsItemType{
+		TemplateImportVersionVariablesItemColumnsItemTypeString,
+		TemplateImportVersionVariablesItemColumnsItemTypeInteger,
+		TemplateImportVersionVariablesItemColumnsItemTypeFloat,
+		TemplateImportVersionVariablesItemColumnsItemTypeDecimal,
+		TemplateImportVersionVariablesItemColumnsItemTypeBoolean,
+		TemplateImportVersionVariablesItemColumnsItemTypeDate,
+		TemplateImportVersionVariablesItemColumnsItemTypeEnum,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TemplateImportVersionVariablesItemColumnsItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case TemplateImportVersionVariablesItemColumnsItemTypeString:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeInteger:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeFloat:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeDecimal:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeBoolean:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeDate:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeEnum:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TemplateImportVersionVariablesItemColumnsItemType) UnmarshalText(data []byte) error {
+	switch TemplateImportVersionVariablesItemColumnsItemType(data) {
+	case TemplateImportVersionVariablesItemColumnsItemTypeString:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeString
+		return nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeInteger:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeInteger
+		return nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeFloat:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeFloat
+		return nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeDecimal:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeDecimal
+		return nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeBoolean:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeBoolean
+		return nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeDate:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeDate
+		return nil
+	case TemplateImportVersionVariablesItemColumnsItemTypeEnum:
+		*s = TemplateImportVersionVariablesItemColumnsItemTypeEnum
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ограничение переменной.
+type TemplateImportVersionVariablesItemConstraintsItem struct {
+	// Название ограничения.
+	Name string `json:"name"`
+	// Выражение ограничения.
+	Expression string `json:"expression"`
+	// Активно ли ограничение.
+	IsActive bool `json:"isActive"`
+}
+
+// GetName returns the value of Name.
+func (s *TemplateImportVersionVariablesItemConstraintsItem) GetName() string {
+	return s.Name
+}
+
+// GetExpression returns the value of Expression.
+func (s *TemplateImportVersionVariablesItemConstraintsItem) GetExpression() string {
+	return s.Expression
+}
+
+// GetIsActive returns the value of IsActive.
+func (s *TemplateImportVersionVariablesItemConstraintsItem) GetIsActive() bool {
+	return s.IsActive
+}
+
+// SetName sets the value of Name.
+func (s *TemplateImportVersionVariablesItemConstraintsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetExpression sets the value of Expression.
+func (s *TemplateImportVersionVariablesItemConstraintsItem) SetExpression(val string) {
+	s.Expression = val
+}
+
+// SetIsActive sets the value of IsActive.
+func (s *TemplateImportVersionVariablesItemConstraintsItem) SetIsActive(val bool) {
+	s.IsActive = val
+}
+
+// Тип элементов (для типа list).
+type TemplateImportVersionVariablesItemItemType string
+
+const (
+	TemplateImportVersionVariablesItemItemTypeString  TemplateImportVersionVariablesItemItemType = "string"
+	TemplateImportVersionVariablesItemItemTypeInteger TemplateImportVersionVariablesItemItemType = "integer"
+	TemplateImportVersionVariablesItemItemTypeFloat   TemplateImportVersionVariablesItemItemType = "float"
+	TemplateImportVersionVariablesItemItemTypeDecimal TemplateImportVersionVariablesItemItemType = "decimal"
+	TemplateImportVersionVariablesItemItemTypeBoolean TemplateImportVersionVariablesItemItemType = "boolean"
+	TemplateImportVersionVariablesItemItemTypeDate    TemplateImportVersionVariablesItemItemType = "date"
+	TemplateImportVersionVariablesItemItemTypeEnum    TemplateImportVersionVariablesItemItemType = "enum"
+)
+
+// AllValues returns all TemplateImportVersionVariablesItemItemType values.
+func (TemplateImportVersionVariablesItemItemType) AllValues() []TemplateImportVersionVariablesItemItemType {
+	return []TemplateImportVersionVariablesItemItemType{
+		TemplateImportVersionVariablesItemItemTypeString,
+		TemplateImportVersionVariablesItemItemTypeInteger,
+		TemplateImportVersionVariablesItemItemTypeFloat,
+		TemplateImportVersionVariablesItemItemTypeDecimal,
+		TemplateImportVersionVariablesItemItemTypeBoolean,
+		TemplateImportVersionVariablesItemItemTypeDate,
+		TemplateImportVersionVariablesItemItemTypeEnum,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TemplateImportVersionVariablesItemItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case TemplateImportVersionVariablesItemItemTypeString:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemItemTypeInteger:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemItemTypeFloat:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemItemTypeDecimal:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemItemTypeBoolean:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemItemTypeDate:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemItemTypeEnum:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TemplateImportVersionVariablesItemItemType) UnmarshalText(data []byte) error {
+	switch TemplateImportVersionVariablesItemItemType(data) {
+	case TemplateImportVersionVariablesItemItemTypeString:
+		*s = TemplateImportVersionVariablesItemItemTypeString
+		return nil
+	case TemplateImportVersionVariablesItemItemTypeInteger:
+		*s = TemplateImportVersionVariablesItemItemTypeInteger
+		return nil
+	case TemplateImportVersionVariablesItemItemTypeFloat:
+		*s = TemplateImportVersionVariablesItemItemTypeFloat
+		return nil
+	case TemplateImportVersionVariablesItemItemTypeDecimal:
+		*s = TemplateImportVersionVariablesItemItemTypeDecimal
+		return nil
+	case TemplateImportVersionVariablesItemItemTypeBoolean:
+		*s = TemplateImportVersionVariablesItemItemTypeBoolean
+		return nil
+	case TemplateImportVersionVariablesItemItemTypeDate:
+		*s = TemplateImportVersionVariablesItemItemTypeDate
+		return nil
+	case TemplateImportVersionVariablesItemItemTypeEnum:
+		*s = TemplateImportVersionVariablesItemItemTypeEnum
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Тип переменной.
+type TemplateImportVersionVariablesItemType string
+
+const (
+	TemplateImportVersionVariablesItemTypeString  TemplateImportVersionVariablesItemType = "string"
+	TemplateImportVersionVariablesItemTypeInteger TemplateImportVersionVariablesItemType = "integer"
+	TemplateImportVersionVariablesItemTypeFloat   TemplateImportVersionVariablesItemType = "float"
+	TemplateImportVersionVariablesItemTypeDecimal TemplateImportVersionVariablesItemType = "decimal"
+	TemplateImportVersionVariablesItemTypeBoolean TemplateImportVersionVariablesItemType = "boolean"
+	TemplateImportVersionVariablesItemTypeDate    TemplateImportVersionVariablesItemType = "date"
+	TemplateImportVersionVariablesItemTypeEnum    TemplateImportVersionVariablesItemType = "enum"
+	TemplateImportVersionVariablesItemTypeList    TemplateImportVersionVariablesItemType = "list"
+	TemplateImportVersionVariablesItemTypeTable   TemplateImportVersionVariablesItemType = "table"
+)
+
+// AllValues returns all TemplateImportVersionVariablesItemType values.
+func (TemplateImportVersionVariablesItemType) AllValues() []TemplateImportVersionVariablesItemType {
+	return []TemplateImportVersionVariablesItemType{
+		TemplateImportVersionVariablesItemTypeString,
+		TemplateImportVersionVariablesItemTypeInteger,
+		TemplateImportVersionVariablesItemTypeFloat,
+		TemplateImportVersionVariablesItemTypeDecimal,
+		TemplateImportVersionVariablesItemTypeBoolean,
+		TemplateImportVersionVariablesItemTypeDate,
+		TemplateImportVersionVariablesItemTypeEnum,
+		TemplateImportVersionVariablesItemTypeList,
+		TemplateImportVersionVariablesItemTypeTable,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TemplateImportVersionVariablesItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case TemplateImportVersionVariablesItemTypeString:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemTypeInteger:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemTypeFloat:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemTypeDecimal:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemTypeBoolean:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemTypeDate:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemTypeEnum:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemTypeList:
+		return []byte(s), nil
+	case TemplateImportVersionVariablesItemTypeTable:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TemplateImportVersionVariablesItemType) UnmarshalText(data []byte) error {
+	switch TemplateImportVersionVariablesItemType(data) {
+	case TemplateImportVersionVariablesItemTypeString:
+		*s = TemplateImportVersionVariablesItemTypeString
+		return nil
+	case TemplateImportVersionVariablesItemTypeInteger:
+		*s = TemplateImportVersionVariablesItemTypeInteger
+		return nil
+	case TemplateImportVersionVariablesItemTypeFloat:
+		*s = TemplateImportVersionVariablesItemTypeFloat
+		return nil
+	case TemplateImportVersionVariablesItemTypeDecimal:
+		*s = TemplateImportVersionVariablesItemTypeDecimal
+		return nil
+	case TemplateImportVersionVariablesItemTypeBoolean:
+		*s = TemplateImportVersionVariablesItemTypeBoolean
+		return nil
+	case TemplateImportVersionVariablesItemTypeDate:
+		*s = TemplateImportVersionVariablesItemTypeDate
+		return nil
+	case TemplateImportVersionVariablesItemTypeEnum:
+		*s = TemplateImportVersionVariablesItemTypeEnum
+		return nil
+	case TemplateImportVersionVariablesItemTypeList:
+		*s = TemplateImportVersionVariablesItemTypeList
+		return nil
+	case TemplateImportVersionVariablesItemTypeTable:
+		*s = TemplateImportVersionVariablesItemTypeTable
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetLine returns the value of Line.
-func (s *TaskGetByIDResponseTaskErrorTemplate) GetLine() int {
-	return s.Line
+// Ref: #/components/schemas/TemplateListResponse
+type TemplateListResponse struct {
+	// Список шаблонов.
+	Templates []TemplateListResponseTemplatesItem `json:"templates"`
+	// Общее количество шаблонов.
+	TotalTemplates int64 `json:"totalTemplates"`
+	// Общее количество страниц.
+	TotalPages int64 `json:"totalPages"`
 }
 
-// GetColumn returns the value of Column.
-func (s *TaskGetByIDResponseTaskErrorTemplate) GetColumn() OptInt {
-	return s.Column
+// GetTemplates returns the value of Templates.
+func (s *TemplateListResponse) GetTemplates() []TemplateListResponseTemplatesItem {
+	return s.Templates
 }
 
-// GetSnippet returns the value of Snippet.
-func (s *TaskGetByIDResponseTaskErrorTemplate) GetSnippet() OptString {
-	return s.Snippet
+// GetTotalTemplates returns the value of TotalTemplates.
+func (s *TemplateListResponse) GetTotalTemplates() int64 {
+	return s.TotalTemplates
 }
 
-// GetDetail returns the value of Detail.
-func (s *TaskGetByIDResponseTaskErrorTemplate) GetDetail() OptString {
-	return s.Detail
+// GetTotalPages returns the value of TotalPages.
+func (s *TemplateListResponse) GetTotalPages() int64 {
+	return s.TotalPages
 }
 
-// SetLine sets the value of Line.
-func (s *TaskGetByIDResponseTaskErrorTemplate) SetLine(val int) {
-	s.Line = val
+// SetTemplates sets the value of Templates.
+func (s *TemplateListResponse) SetTemplates(val []TemplateListResponseTemplatesItem) {
+	s.Templates = val
 }
 
-// SetColumn sets the value of Column.
-func (s *TaskGetByIDResponseTaskErrorTemplate) SetColumn(val OptInt) {
-	s.Column = val
+// SetTotalTemplates sets the value of TotalTemplates.
+func (s *TemplateListResponse) SetTotalTemplates(val int64) {
+	s.TotalTemplates = val
 }
 
-// SetSnippet sets the value of Snippet.
-func (s *TaskGetByIDResponseTaskErrorTemplate) SetSnippet(val OptString) {
-	s.Snippet = val
+// SetTotalPages sets the value of TotalPages.
+func (s *TemplateListResponse) SetTotalPages(val int64) {
+	s.TotalPages = val
 }
 
-// SetDetail sets the value of Detail.
-func (s *TaskGetByIDResponseTaskErrorTemplate) SetDetail(val OptString) {
-	s.Detail = val
-}
+func (*TemplateListResponse) templateListRes() {}
 
-// Ошибка обработки переменных.
-type TaskGetByIDResponseTaskErrorVariableErrorsItem struct {
-	// ID переменной.
+// Шаблон.
+type TemplateListResponseTemplatesItem struct {
+	// ID шаблона.
 	ID int64 `json:"id"`
-	// Слаг переменной.
+	// Название шаблона.
 	Name string `json:"name"`
-	// Человекочитаемое название переменной.
-	Title string `json:"title"`
-	// Вычисленное значение переменной, на котором
-	// сработала проверка ограничений.
-	Value OptString `json:"value"`
-	// Сообщение ошибки.
-	Message          OptString                                                            `json:"message"`
-	ConstraintErrors []TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem `json:"constraintErrors"`
+	// Имя автора шаблона.
+	AuthorName string `json:"authorName"`
+	// Дата и время создания шаблона.
+	CreatedAt time.Time `json:"createdAt"`
+	// Дата и время обновления шаблона.
+	UpdatedAt OptDateTime `json:"updatedAt"`
 }
 
 // GetID returns the value of ID.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) GetID() int64 {
+func (s *TemplateListResponseTemplatesItem) GetID() int64 {
 	return s.ID
 }
 
 // GetName returns the value of Name.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) GetName() string {
+func (s *TemplateListResponseTemplatesItem) GetName() string {
 	return s.Name
 }
 
-// GetTitle returns the value of Title.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) GetTitle() string {
-	return s.Title
-}
-
-// GetValue returns the value of Value.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) GetValue() OptString {
-	return s.Value
+// GetAuthorName returns the value of AuthorName.
+func (s *TemplateListResponseTemplatesItem) GetAuthorName() string {
+	return s.AuthorName
 }
 
-// GetMessage returns the value of Message.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) GetMessage() OptString {
-	return s.Message
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TemplateListResponseTemplatesItem) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// GetConstraintErrors returns the value of ConstraintErrors.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) GetConstraintErrors() []TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem {
-	return s.ConstraintErrors
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *TemplateListResponseTemplatesItem) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
 // SetID sets the value of ID.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) SetID(val int64) {
+func (s *TemplateListResponseTemplatesItem) SetID(val int64) {
 	s.ID = val
 }
 
 // SetName sets the value of Name.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) SetName(val string) {
+func (s *TemplateListResponseTemplatesItem) SetName(val string) {
 	s.Name = val
 }
 
-// SetTitle sets the value of Title.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) SetTitle(val string) {
-	s.Title = val
+// SetAuthorName sets the value of AuthorName.
+func (s *TemplateListResponseTemplatesItem) SetAuthorName(val string) {
+	s.AuthorName = val
 }
 
-// SetValue sets the value of Value.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) SetValue(val OptString) {
-	s.Value = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TemplateListResponseTemplatesItem) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// SetMessage sets the value of Message.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) SetMessage(val OptString) {
-	s.Message = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *TemplateListResponseTemplatesItem) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetConstraintErrors sets the value of ConstraintErrors.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItem) SetConstraintErrors(val []TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) {
-	s.ConstraintErrors = val
-}
+// TemplateUpdateByIDNoContent is response for TemplateUpdateByID operation.
+type TemplateUpdateByIDNoContent struct{}
 
-// Ошибка обработки ограничений.
-type TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem struct {
-	// ID ограничения.
-	ID int64 `json:"id"`
-	// Название ограничения.
-	Name string `json:"name"`
-	// Выражение ограничения.
-	Expression string `json:"expression"`
-	// Сообщение ошибки.
-	Message OptString `json:"message"`
-}
+func (*TemplateUpdateByIDNoContent) templateUpdateByIDRes() {}
 
-// GetID returns the value of ID.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) GetID() int64 {
-	return s.ID
+// Ref: #/components/schemas/TemplateUpdateRequest
+type TemplateUpdateRequest struct {
+	// Название шаблона.
+	Name         string          `json:"name"`
+	OutputFormat OptOutputFormat `json:"outputFormat"`
+	// Профиль оформления документа (gost_34, gost_19 или plain);
+	// пустая строка — профиль по умолчанию.
+	StyleProfile OptString `json:"styleProfile"`
 }
 
 // GetName returns the value of Name.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) GetName() string {
+func (s *TemplateUpdateRequest) GetName() string {
 	return s.Name
 }
 
-// GetExpression returns the value of Expression.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) GetExpression() string {
-	return s.Expression
-}
-
-// GetMessage returns the value of Message.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) GetMessage() OptString {
-	return s.Message
+// GetOutputFormat returns the value of OutputFormat.
+func (s *TemplateUpdateRequest) GetOutputFormat() OptOutputFormat {
+	return s.OutputFormat
 }
 
-// SetID sets the value of ID.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) SetID(val int64) {
-	s.ID = val
+// GetStyleProfile returns the value of StyleProfile.
+func (s *TemplateUpdateRequest) GetStyleProfile() OptString {
+	return s.StyleProfile
 }
 
 // SetName sets the value of Name.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) SetName(val string) {
+func (s *TemplateUpdateRequest) SetName(val string) {
 	s.Name = val
 }
 
-// SetExpression sets the value of Expression.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) SetExpression(val string) {
-	s.Expression = val
+// SetOutputFormat sets the value of OutputFormat.
+func (s *TemplateUpdateRequest) SetOutputFormat(val OptOutputFormat) {
+	s.OutputFormat = val
 }
 
-// SetMessage sets the value of Message.
-func (s *TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem) SetMessage(val OptString) {
-	s.Message = val
+// SetStyleProfile sets the value of StyleProfile.
+func (s *TemplateUpdateRequest) SetStyleProfile(val OptString) {
+	s.StyleProfile = val
 }
 
-// Пэйлоад задачи.
-type TaskGetByIDResponseTaskPayload map[string]string
+// TemplateUpdateUsersNoContent is response for TemplateUpdateUsers operation.
+type TemplateUpdateUsersNoContent struct{}
 
-func (s *TaskGetByIDResponseTaskPayload) init() TaskGetByIDResponseTaskPayload {
-	m := *s
-	if m == nil {
-		m = map[string]string{}
-		*s = m
-	}
-	return m
+func (*TemplateUpdateUsersNoContent) templateUpdateUsersRes() {}
+
+// Ref: #/components/schemas/TemplateUpdateUsersRequest
+type TemplateUpdateUsersRequest struct {
+	// Список пользователей шаблона.
+	Users []TemplateUpdateUsersRequestUsersItem `json:"users"`
 }
 
-// Ref: #/components/schemas/TaskListResponse
-type TaskListResponse struct {
-	// Список задач генерации.
-	Tasks []TaskListResponseTasksItem `json:"tasks"`
-	// Общее количество задач.
-	TotalTasks int64 `json:"totalTasks"`
-	// Общее количество страниц.
-	TotalPages int64 `json:"totalPages"`
+// GetUsers returns the value of Users.
+func (s *TemplateUpdateUsersRequest) GetUsers() []TemplateUpdateUsersRequestUsersItem {
+	return s.Users
 }
 
-// GetTasks returns the value of Tasks.
-func (s *TaskListResponse) GetTasks() []TaskListResponseTasksItem {
-	return s.Tasks
+// SetUsers sets the value of Users.
+func (s *TemplateUpdateUsersRequest) SetUsers(val []TemplateUpdateUsersRequestUsersItem) {
+	s.Users = val
 }
 
-// GetTotalTasks returns the value of TotalTasks.
-func (s *TaskListResponse) GetTotalTasks() int64 {
-	return s.TotalTasks
+// Пользователь шаблона.
+type TemplateUpdateUsersRequestUsersItem struct {
+	// ID пользователя.
+	ID int64 `json:"id"`
+	// Роль пользователя в шаблоне.
+	Role TemplateUpdateUsersRequestUsersItemRole `json:"role"`
 }
 
-// GetTotalPages returns the value of TotalPages.
-func (s *TaskListResponse) GetTotalPages() int64 {
-	return s.TotalPages
+// GetID returns the value of ID.
+func (s *TemplateUpdateUsersRequestUsersItem) GetID() int64 {
+	return s.ID
 }
 
-// SetTasks sets the value of Tasks.
-func (s *TaskListResponse) SetTasks(val []TaskListResponseTasksItem) {
-	s.Tasks = val
+// GetRole returns the value of Role.
+func (s *TemplateUpdateUsersRequestUsersItem) GetRole() TemplateUpdateUsersRequestUsersItemRole {
+	return s.Role
 }
 
-// SetTotalTasks sets the value of TotalTasks.
-func (s *TaskListResponse) SetTotalTasks(val int64) {
-	s.TotalTasks = val
+// SetID sets the value of ID.
+func (s *TemplateUpdateUsersRequestUsersItem) SetID(val int64) {
+	s.ID = val
 }
 
-// SetTotalPages sets the value of TotalPages.
-func (s *TaskListResponse) SetTotalPages(val int64) {
-	s.TotalPages = val
+// SetRole sets the value of Role.
+func (s *TemplateUpdateUsersRequestUsersItem) SetRole(val TemplateUpdateUsersRequestUsersItemRole) {
+	s.Role = val
 }
 
-func (*TaskListResponse) taskListRes() {}
+// Роль пользователя в шаблоне.
+type TemplateUpdateUsersRequestUsersItemRole string
 
-// Задача генерации.
-type TaskListResponseTasksItem struct {
-	// ID задачи генерации.
-	ID int64 `json:"id"`
-	// Номер версии.
-	VersionNumber int64      `json:"versionNumber"`
-	Status        TaskStatus `json:"status"`
-	// Имя создателя задачи.
-	CreatorName string `json:"creatorName"`
-	// Дата и время создания задачи.
-	CreatedAt time.Time `json:"createdAt"`
-	// Дата и время обновления задачи.
-	UpdatedAt OptDateTime `json:"updatedAt"`
+const (
+	TemplateUpdateUsersRequestUsersItemRoleRead  TemplateUpdateUsersRequestUsersItemRole = "read"
+	TemplateUpdateUsersRequestUsersItemRoleWrite TemplateUpdateUsersRequestUsersItemRole = "write"
+)
+
+// AllValues returns all TemplateUpdateUsersRequestUsersItemRole values.
+func (TemplateUpdateUsersRequestUsersItemRole) AllValues() []TemplateUpdateUsersRequestUsersItemRole {
+	return []TemplateUpdateUsersRequestUsersItemRole{
+		TemplateUpdateUsersRequestUsersItemRoleRead,
+		TemplateUpdateUsersRequestUsersItemRoleWrite,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TemplateUpdateUsersRequestUsersItemRole) MarshalText() ([]byte, error) {
+	switch s {
+	case TemplateUpdateUsersRequestUsersItemRoleRead:
+		return []byte(s), nil
+	case TemplateUpdateUsersRequestUsersItemRoleWrite:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TemplateUpdateUsersRequestUsersItemRole) UnmarshalText(data []byte) error {
+	switch TemplateUpdateUsersRequestUsersItemRole(data) {
+	case TemplateUpdateUsersRequestUsersItemRoleRead:
+		*s = TemplateUpdateUsersRequestUsersItemRoleRead
+		return nil
+	case TemplateUpdateUsersRequestUsersItemRoleWrite:
+		*s = TemplateUpdateUsersRequestUsersItemRoleWrite
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetID returns the value of ID.
-func (s *TaskListResponseTasksItem) GetID() int64 {
-	return s.ID
+// Ref: #/components/schemas/TemplateUsersResponse
+type TemplateUsersResponse struct {
+	// Список пользователей шаблона.
+	Users []TemplateUsersResponseUsersItem `json:"users"`
 }
 
-// GetVersionNumber returns the value of VersionNumber.
-func (s *TaskListResponseTasksItem) GetVersionNumber() int64 {
-	return s.VersionNumber
+// GetUsers returns the value of Users.
+func (s *TemplateUsersResponse) GetUsers() []TemplateUsersResponseUsersItem {
+	return s.Users
 }
 
-// GetStatus returns the value of Status.
-func (s *TaskListResponseTasksItem) GetStatus() TaskStatus {
-	return s.Status
+// SetUsers sets the value of Users.
+func (s *TemplateUsersResponse) SetUsers(val []TemplateUsersResponseUsersItem) {
+	s.Users = val
 }
 
-// GetCreatorName returns the value of CreatorName.
-func (s *TaskListResponseTasksItem) GetCreatorName() string {
-	return s.CreatorName
+func (*TemplateUsersResponse) templateUsersRes() {}
+
+// Пользователь шаблона.
+type TemplateUsersResponseUsersItem struct {
+	// ID пользователя.
+	ID int64 `json:"id"`
+	// Имя пользователя.
+	Name string `json:"name"`
+	// Электронный адрес пользователя.
+	Email string `json:"email"`
+	// Роль пользователя в шаблоне.
+	Role TemplateUsersResponseUsersItemRole `json:"role"`
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *TaskListResponseTasksItem) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetID returns the value of ID.
+func (s *TemplateUsersResponseUsersItem) GetID() int64 {
+	return s.ID
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *TaskListResponseTasksItem) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// GetName returns the value of Name.
+func (s *TemplateUsersResponseUsersItem) GetName() string {
+	return s.Name
 }
 
-// SetID sets the value of ID.
-func (s *TaskListResponseTasksItem) SetID(val int64) {
-	s.ID = val
+// GetEmail returns the value of Email.
+func (s *TemplateUsersResponseUsersItem) GetEmail() string {
+	return s.Email
 }
 
-// SetVersionNumber sets the value of VersionNumber.
-func (s *TaskListResponseTasksItem) SetVersionNumber(val int64) {
-	s.VersionNumber = val
+// GetRole returns the value of Role.
+func (s *TemplateUsersResponseUsersItem) GetRole() TemplateUsersResponseUsersItemRole {
+	return s.Role
 }
 
-// SetStatus sets the value of Status.
-func (s *TaskListResponseTasksItem) SetStatus(val TaskStatus) {
-	s.Status = val
+// SetID sets the value of ID.
+func (s *TemplateUsersResponseUsersItem) SetID(val int64) {
+	s.ID = val
 }
 
-// SetCreatorName sets the value of CreatorName.
-func (s *TaskListResponseTasksItem) SetCreatorName(val string) {
-	s.CreatorName = val
+// SetName sets the value of Name.
+func (s *TemplateUsersResponseUsersItem) SetName(val string) {
+	s.Name = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *TaskListResponseTasksItem) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetEmail sets the value of Email.
+func (s *TemplateUsersResponseUsersItem) SetEmail(val string) {
+	s.Email = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *TaskListResponseTasksItem) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// SetRole sets the value of Role.
+func (s *TemplateUsersResponseUsersItem) SetRole(val TemplateUsersResponseUsersItemRole) {
+	s.Role = val
 }
 
-// Статус задачи.
-// Ref: #/components/schemas/TaskStatus
-type TaskStatus string
+// Роль пользователя в шаблоне.
+type TemplateUsersResponseUsersItemRole string
 
 const (
-	TaskStatusCreated    TaskStatus = "created"
-	TaskStatusInProgress TaskStatus = "in_progress"
-	TaskStatusSucceed    TaskStatus = "succeed"
-	TaskStatusFailed     TaskStatus = "failed"
+	TemplateUsersResponseUsersItemRoleRead  TemplateUsersResponseUsersItemRole = "read"
+	TemplateUsersResponseUsersItemRoleWrite TemplateUsersResponseUsersItemRole = "write"
 )
 
-// AllValues returns all TaskStatus values.
-func (TaskStatus) AllValues() []TaskStatus {
-	return []TaskStatus{
-		TaskStatusCreated,
-		TaskStatusInProgress,
-		TaskStatusSucceed,
-		TaskStatusFailed,
+// AllValues returns all TemplateUsersResponseUsersItemRole values.
+func (TemplateUsersResponseUsersItemRole) AllValues() []TemplateUsersResponseUsersItemRole {
+	return []TemplateUsersResponseUsersItemRole{
+		TemplateUsersResponseUsersItemRoleRead,
+		TemplateUsersResponseUsersItemRoleWrite,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s TaskStatus) MarshalText() ([]byte, error) {
+func (s TemplateUsersResponseUsersItemRole) MarshalText() ([]byte, error) {
 	switch s {
-	case TaskStatusCreated:
-		return []byte(s), nil
-	case TaskStatusInProgress:
-		return []byte(s), nil
-	case TaskStatusSucceed:
+	case TemplateUsersResponseUsersItemRoleRead:
 		return []byte(s), nil
-	case TaskStatusFailed:
+	case TemplateUsersResponseUsersItemRoleWrite:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -1472,978 +4935,880 @@ func (s TaskStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TaskStatus) UnmarshalText(data []byte) error {
-	switch TaskStatus(data) {
-	case TaskStatusCreated:
-		*s = TaskStatusCreated
-		return nil
-	case TaskStatusInProgress:
-		*s = TaskStatusInProgress
-		return nil
-	case TaskStatusSucceed:
-		*s = TaskStatusSucceed
+func (s *TemplateUsersResponseUsersItemRole) UnmarshalText(data []byte) error {
+	switch TemplateUsersResponseUsersItemRole(data) {
+	case TemplateUsersResponseUsersItemRoleRead:
+		*s = TemplateUsersResponseUsersItemRoleRead
 		return nil
-	case TaskStatusFailed:
-		*s = TaskStatusFailed
+	case TemplateUsersResponseUsersItemRoleWrite:
+		*s = TemplateUsersResponseUsersItemRoleWrite
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// TemplateCreateCreated is response for TemplateCreate operation.
-type TemplateCreateCreated struct{}
-
-func (*TemplateCreateCreated) templateCreateRes() {}
+type Trace []TraceItem
 
-// Ref: #/components/schemas/TemplateCreateFromDefaultRequest
-type TemplateCreateFromDefaultRequest struct {
-	// ID библиотечного шаблона-источника.
-	SourceTemplateID int64 `json:"sourceTemplateID"`
-	// ID проекта, в котором будет создан новый шаблон.
-	ProjectID int64 `json:"projectID"`
-	// Название нового шаблона.
+// Трассировка вычисления переменной.
+type TraceItem struct {
+	// Порядковый номер вычисления (начиная с 1).
+	Order int `json:"order"`
+	// Слаг переменной.
 	Name string `json:"name"`
+	// Источник значения.
+	Source OptTraceItemSource `json:"source"`
+	// Условие использования переменной.
+	Condition OptString `json:"condition"`
+	// Вычисленное выражение (значение по умолчанию для
+	// входной переменной).
+	Expression OptString `json:"expression"`
+	// Переменные, от которых зависит переменная, со
+	// значениями на момент вычисления.
+	Dependencies []TraceItemDependenciesItem `json:"dependencies"`
+	// Вычисленное значение переменной.
+	Value OptString `json:"value"`
+	// Go-тип вычисленного значения.
+	Type OptString `json:"type"`
+	// Сообщение ошибки, если переменную не удалось
+	// вычислить.
+	Message OptString `json:"message"`
+	// Результаты проверки активных ограничений.
+	Constraints []TraceItemConstraintsItem `json:"constraints"`
 }
 
-// GetSourceTemplateID returns the value of SourceTemplateID.
-func (s *TemplateCreateFromDefaultRequest) GetSourceTemplateID() int64 {
-	return s.SourceTemplateID
-}
-
-// GetProjectID returns the value of ProjectID.
-func (s *TemplateCreateFromDefaultRequest) GetProjectID() int64 {
-	return s.ProjectID
+// GetOrder returns the value of Order.
+func (s *TraceItem) GetOrder() int {
+	return s.Order
 }
 
 // GetName returns the value of Name.
-func (s *TemplateCreateFromDefaultRequest) GetName() string {
+func (s *TraceItem) GetName() string {
 	return s.Name
 }
 
-// SetSourceTemplateID sets the value of SourceTemplateID.
-func (s *TemplateCreateFromDefaultRequest) SetSourceTemplateID(val int64) {
-	s.SourceTemplateID = val
+// GetSource returns the value of Source.
+func (s *TraceItem) GetSource() OptTraceItemSource {
+	return s.Source
 }
 
-// SetProjectID sets the value of ProjectID.
-func (s *TemplateCreateFromDefaultRequest) SetProjectID(val int64) {
-	s.ProjectID = val
+// GetCondition returns the value of Condition.
+func (s *TraceItem) GetCondition() OptString {
+	return s.Condition
 }
 
-// SetName sets the value of Name.
-func (s *TemplateCreateFromDefaultRequest) SetName(val string) {
-	s.Name = val
+// GetExpression returns the value of Expression.
+func (s *TraceItem) GetExpression() OptString {
+	return s.Expression
 }
 
-// Ref: #/components/schemas/TemplateCreateFromDefaultResponse
-type TemplateCreateFromDefaultResponse struct {
-	// ID созданного шаблона.
-	ID int64 `json:"id"`
+// GetDependencies returns the value of Dependencies.
+func (s *TraceItem) GetDependencies() []TraceItemDependenciesItem {
+	return s.Dependencies
 }
 
-// GetID returns the value of ID.
-func (s *TemplateCreateFromDefaultResponse) GetID() int64 {
-	return s.ID
+// GetValue returns the value of Value.
+func (s *TraceItem) GetValue() OptString {
+	return s.Value
 }
 
-// SetID sets the value of ID.
-func (s *TemplateCreateFromDefaultResponse) SetID(val int64) {
-	s.ID = val
+// GetType returns the value of Type.
+func (s *TraceItem) GetType() OptString {
+	return s.Type
 }
 
-func (*TemplateCreateFromDefaultResponse) templateCreateFromDefaultRes() {}
-
-// Ref: #/components/schemas/TemplateCreateRequest
-type TemplateCreateRequest struct {
-	// Название шаблона.
-	Name string `json:"name"`
-	// ID проекта.
-	ProjectID int64 `json:"projectID"`
+// GetMessage returns the value of Message.
+func (s *TraceItem) GetMessage() OptString {
+	return s.Message
 }
 
-// GetName returns the value of Name.
-func (s *TemplateCreateRequest) GetName() string {
-	return s.Name
+// GetConstraints returns the value of Constraints.
+func (s *TraceItem) GetConstraints() []TraceItemConstraintsItem {
+	return s.Constraints
 }
 
-// GetProjectID returns the value of ProjectID.
-func (s *TemplateCreateRequest) GetProjectID() int64 {
-	return s.ProjectID
+// SetOrder sets the value of Order.
+func (s *TraceItem) SetOrder(val int) {
+	s.Order = val
 }
 
 // SetName sets the value of Name.
-func (s *TemplateCreateRequest) SetName(val string) {
+func (s *TraceItem) SetName(val string) {
 	s.Name = val
 }
 
-// SetProjectID sets the value of ProjectID.
-func (s *TemplateCreateRequest) SetProjectID(val int64) {
-	s.ProjectID = val
-}
-
-// Ref: #/components/schemas/TemplateDefaultListResponse
-type TemplateDefaultListResponse struct {
-	// Список библиотечных шаблонов.
-	Templates []TemplateDefaultListResponseTemplatesItem `json:"templates"`
-	// Общее количество шаблонов.
-	TotalTemplates int64 `json:"totalTemplates"`
-	// Общее количество страниц.
-	TotalPages int64 `json:"totalPages"`
-}
-
-// GetTemplates returns the value of Templates.
-func (s *TemplateDefaultListResponse) GetTemplates() []TemplateDefaultListResponseTemplatesItem {
-	return s.Templates
-}
-
-// GetTotalTemplates returns the value of TotalTemplates.
-func (s *TemplateDefaultListResponse) GetTotalTemplates() int64 {
-	return s.TotalTemplates
+// SetSource sets the value of Source.
+func (s *TraceItem) SetSource(val OptTraceItemSource) {
+	s.Source = val
 }
 
-// GetTotalPages returns the value of TotalPages.
-func (s *TemplateDefaultListResponse) GetTotalPages() int64 {
-	return s.TotalPages
+// SetCondition sets the value of Condition.
+func (s *TraceItem) SetCondition(val OptString) {
+	s.Condition = val
 }
 
-// SetTemplates sets the value of Templates.
-func (s *TemplateDefaultListResponse) SetTemplates(val []TemplateDefaultListResponseTemplatesItem) {
-	s.Templates = val
+// SetExpression sets the value of Expression.
+func (s *TraceItem) SetExpression(val OptString) {
+	s.Expression = val
 }
 
-// SetTotalTemplates sets the value of TotalTemplates.
-func (s *TemplateDefaultListResponse) SetTotalTemplates(val int64) {
-	s.TotalTemplates = val
+// SetDependencies sets the value of Dependencies.
+func (s *TraceItem) SetDependencies(val []TraceItemDependenciesItem) {
+	s.Dependencies = val
 }
 
-// SetTotalPages sets the value of TotalPages.
-func (s *TemplateDefaultListResponse) SetTotalPages(val int64) {
-	s.TotalPages = val
+// SetValue sets the value of Value.
+func (s *TraceItem) SetValue(val OptString) {
+	s.Value = val
 }
-
-func (*TemplateDefaultListResponse) templateDefaultListRes() {}
-
-// Библиотечный шаблон.
-type TemplateDefaultListResponseTemplatesItem struct {
-	// ID шаблона.
-	ID int64 `json:"id"`
-	// Название шаблона.
-	Name string `json:"name"`
-	// Дата и время создания шаблона.
-	CreatedAt time.Time `json:"createdAt"`
-	// Дата и время обновления шаблона.
-	UpdatedAt OptDateTime `json:"updatedAt"`
+
+// SetType sets the value of Type.
+func (s *TraceItem) SetType(val OptString) {
+	s.Type = val
 }
 
-// GetID returns the value of ID.
-func (s *TemplateDefaultListResponseTemplatesItem) GetID() int64 {
-	return s.ID
+// SetMessage sets the value of Message.
+func (s *TraceItem) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetConstraints sets the value of Constraints.
+func (s *TraceItem) SetConstraints(val []TraceItemConstraintsItem) {
+	s.Constraints = val
+}
+
+type TraceItemConstraintsItem struct {
+	// Название ограничения.
+	Name string `json:"name"`
+	// Выражение ограничения.
+	Expression string `json:"expression"`
+	// Пройдена ли проверка.
+	Passed bool `json:"passed"`
+	// Сообщение ошибки.
+	Message OptString `json:"message"`
 }
 
 // GetName returns the value of Name.
-func (s *TemplateDefaultListResponseTemplatesItem) GetName() string {
+func (s *TraceItemConstraintsItem) GetName() string {
 	return s.Name
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *TemplateDefaultListResponseTemplatesItem) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetExpression returns the value of Expression.
+func (s *TraceItemConstraintsItem) GetExpression() string {
+	return s.Expression
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *TemplateDefaultListResponseTemplatesItem) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// GetPassed returns the value of Passed.
+func (s *TraceItemConstraintsItem) GetPassed() bool {
+	return s.Passed
 }
 
-// SetID sets the value of ID.
-func (s *TemplateDefaultListResponseTemplatesItem) SetID(val int64) {
-	s.ID = val
+// GetMessage returns the value of Message.
+func (s *TraceItemConstraintsItem) GetMessage() OptString {
+	return s.Message
 }
 
 // SetName sets the value of Name.
-func (s *TemplateDefaultListResponseTemplatesItem) SetName(val string) {
+func (s *TraceItemConstraintsItem) SetName(val string) {
 	s.Name = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *TemplateDefaultListResponseTemplatesItem) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetExpression sets the value of Expression.
+func (s *TraceItemConstraintsItem) SetExpression(val string) {
+	s.Expression = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *TemplateDefaultListResponseTemplatesItem) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// SetPassed sets the value of Passed.
+func (s *TraceItemConstraintsItem) SetPassed(val bool) {
+	s.Passed = val
 }
 
-// TemplateDeleteByIDNoContent is response for TemplateDeleteByID operation.
-type TemplateDeleteByIDNoContent struct{}
-
-func (*TemplateDeleteByIDNoContent) templateDeleteByIDRes() {}
+// SetMessage sets the value of Message.
+func (s *TraceItemConstraintsItem) SetMessage(val OptString) {
+	s.Message = val
+}
 
-// Ref: #/components/schemas/TemplateGetByIDResponse
-type TemplateGetByIDResponse struct {
-	// Название шаблона.
-	Name    string                    `json:"name"`
-	Version OptTemplateGetByIDVersion `json:"version"`
+type TraceItemDependenciesItem struct {
+	// Слаг переменной.
+	Name string `json:"name"`
+	// Значение переменной.
+	Value OptString `json:"value"`
+	// Go-тип значения.
+	Type OptString `json:"type"`
 }
 
 // GetName returns the value of Name.
-func (s *TemplateGetByIDResponse) GetName() string {
+func (s *TraceItemDependenciesItem) GetName() string {
 	return s.Name
 }
 
-// GetVersion returns the value of Version.
-func (s *TemplateGetByIDResponse) GetVersion() OptTemplateGetByIDVersion {
-	return s.Version
+// GetValue returns the value of Value.
+func (s *TraceItemDependenciesItem) GetValue() OptString {
+	return s.Value
+}
+
+// GetType returns the value of Type.
+func (s *TraceItemDependenciesItem) GetType() OptString {
+	return s.Type
 }
 
 // SetName sets the value of Name.
-func (s *TemplateGetByIDResponse) SetName(val string) {
+func (s *TraceItemDependenciesItem) SetName(val string) {
 	s.Name = val
 }
 
-// SetVersion sets the value of Version.
-func (s *TemplateGetByIDResponse) SetVersion(val OptTemplateGetByIDVersion) {
-	s.Version = val
+// SetValue sets the value of Value.
+func (s *TraceItemDependenciesItem) SetValue(val OptString) {
+	s.Value = val
 }
 
-func (*TemplateGetByIDResponse) templateGetByIDRes() {}
-
-// Ref: #/components/schemas/TemplateGetByIDVersion
-type TemplateGetByIDVersion struct {
-	// ID последней версии шаблона.
-	ID int64 `json:"id"`
-	// Номер последней версии шаблона.
-	Number int64 `json:"number"`
-	// Дата и время создания версии.
-	CreatedAt time.Time `json:"createdAt"`
-	// Данные шаблона.
-	Data []byte `json:"data"`
-	// Список переменных шаблона.
-	Variables []TemplateGetByIDVersionVariablesItem `json:"variables"`
+// SetType sets the value of Type.
+func (s *TraceItemDependenciesItem) SetType(val OptString) {
+	s.Type = val
 }
 
-// GetID returns the value of ID.
-func (s *TemplateGetByIDVersion) GetID() int64 {
-	return s.ID
+// Источник значения.
+type TraceItemSource string
+
+const (
+	TraceItemSourcePayload    TraceItemSource = "payload"
+	TraceItemSourceDefault    TraceItemSource = "default"
+	TraceItemSourceExpression TraceItemSource = "expression"
+	TraceItemSourceDisabled   TraceItemSource = "disabled"
+)
+
+// AllValues returns all TraceItemSource values.
+func (TraceItemSource) AllValues() []TraceItemSource {
+	return []TraceItemSource{
+		TraceItemSourcePayload,
+		TraceItemSourceDefault,
+		TraceItemSourceExpression,
+		TraceItemSourceDisabled,
+	}
 }
 
-// GetNumber returns the value of Number.
-func (s *TemplateGetByIDVersion) GetNumber() int64 {
-	return s.Number
+// MarshalText implements encoding.TextMarshaler.
+func (s TraceItemSource) MarshalText() ([]byte, error) {
+	switch s {
+	case TraceItemSourcePayload:
+		return []byte(s), nil
+	case TraceItemSourceDefault:
+		return []byte(s), nil
+	case TraceItemSourceExpression:
+		return []byte(s), nil
+	case TraceItemSourceDisabled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *TemplateGetByIDVersion) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TraceItemSource) UnmarshalText(data []byte) error {
+	switch TraceItemSource(data) {
+	case TraceItemSourcePayload:
+		*s = TraceItemSourcePayload
+		return nil
+	case TraceItemSourceDefault:
+		*s = TraceItemSourceDefault
+		return nil
+	case TraceItemSourceExpression:
+		*s = TraceItemSourceExpression
+		return nil
+	case TraceItemSourceDisabled:
+		*s = TraceItemSourceDisabled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetData returns the value of Data.
-func (s *TemplateGetByIDVersion) GetData() []byte {
-	return s.Data
+// UserCreateCreated is response for UserCreate operation.
+type UserCreateCreated struct{}
+
+func (*UserCreateCreated) userCreateRes() {}
+
+// Ref: #/components/schemas/UserCreateRequest
+type UserCreateRequest struct {
+	// Имя пользователя.
+	Name string `json:"name"`
+	// Электронный адрес пользователя.
+	Email string `json:"email"`
+	// Пароль пользователя.
+	Password string `json:"password"`
 }
 
-// GetVariables returns the value of Variables.
-func (s *TemplateGetByIDVersion) GetVariables() []TemplateGetByIDVersionVariablesItem {
-	return s.Variables
+// GetName returns the value of Name.
+func (s *UserCreateRequest) GetName() string {
+	return s.Name
 }
 
-// SetID sets the value of ID.
-func (s *TemplateGetByIDVersion) SetID(val int64) {
-	s.ID = val
+// GetEmail returns the value of Email.
+func (s *UserCreateRequest) GetEmail() string {
+	return s.Email
 }
 
-// SetNumber sets the value of Number.
-func (s *TemplateGetByIDVersion) SetNumber(val int64) {
-	s.Number = val
+// GetPassword returns the value of Password.
+func (s *UserCreateRequest) GetPassword() string {
+	return s.Password
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *TemplateGetByIDVersion) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetName sets the value of Name.
+func (s *UserCreateRequest) SetName(val string) {
+	s.Name = val
 }
 
-// SetData sets the value of Data.
-func (s *TemplateGetByIDVersion) SetData(val []byte) {
-	s.Data = val
+// SetEmail sets the value of Email.
+func (s *UserCreateRequest) SetEmail(val string) {
+	s.Email = val
 }
 
-// SetVariables sets the value of Variables.
-func (s *TemplateGetByIDVersion) SetVariables(val []TemplateGetByIDVersionVariablesItem) {
-	s.Variables = val
+// SetPassword sets the value of Password.
+func (s *UserCreateRequest) SetPassword(val string) {
+	s.Password = val
 }
 
-// Переменная шаблона.
-type TemplateGetByIDVersionVariablesItem struct {
-	// ID переменной.
+// Ref: #/components/schemas/UserGetByIDResponse
+type UserGetByIDResponse struct {
+	// ID пользователя.
 	ID int64 `json:"id"`
-	// Слаг переменной (идентификатор).
+	// Имя пользователя.
 	Name string `json:"name"`
-	// Человекочитаемое название переменной.
-	Title string `json:"title"`
-	// Тип переменной.
-	Type TemplateGetByIDVersionVariablesItemType `json:"type"`
-	// Выражение переменной.
-	Expression OptString `json:"expression"`
-	// Являтеся ли переменная входной.
-	IsInput bool `json:"isInput"`
-	// Список ограничений переменной.
-	Constraints []TemplateGetByIDVersionVariablesItemConstraintsItem `json:"constraints"`
+	// Электронный адрес пользователя.
+	Email string `json:"email"`
+	// Дата и время создания пользователя.
+	CreatedAt time.Time `json:"createdAt"`
 }
 
 // GetID returns the value of ID.
-func (s *TemplateGetByIDVersionVariablesItem) GetID() int64 {
+func (s *UserGetByIDResponse) GetID() int64 {
 	return s.ID
 }
 
 // GetName returns the value of Name.
-func (s *TemplateGetByIDVersionVariablesItem) GetName() string {
+func (s *UserGetByIDResponse) GetName() string {
 	return s.Name
 }
 
-// GetTitle returns the value of Title.
-func (s *TemplateGetByIDVersionVariablesItem) GetTitle() string {
-	return s.Title
+// GetEmail returns the value of Email.
+func (s *UserGetByIDResponse) GetEmail() string {
+	return s.Email
 }
 
-// GetType returns the value of Type.
-func (s *TemplateGetByIDVersionVariablesItem) GetType() TemplateGetByIDVersionVariablesItemType {
-	return s.Type
+// GetCreatedAt returns the value of CreatedAt.
+func (s *UserGetByIDResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// GetExpression returns the value of Expression.
-func (s *TemplateGetByIDVersionVariablesItem) GetExpression() OptString {
-	return s.Expression
+// SetID sets the value of ID.
+func (s *UserGetByIDResponse) SetID(val int64) {
+	s.ID = val
 }
 
-// GetIsInput returns the value of IsInput.
-func (s *TemplateGetByIDVersionVariablesItem) GetIsInput() bool {
-	return s.IsInput
+// SetName sets the value of Name.
+func (s *UserGetByIDResponse) SetName(val string) {
+	s.Name = val
 }
 
-// GetConstraints returns the value of Constraints.
-func (s *TemplateGetByIDVersionVariablesItem) GetConstraints() []TemplateGetByIDVersionVariablesItemConstraintsItem {
-	return s.Constraints
+// SetEmail sets the value of Email.
+func (s *UserGetByIDResponse) SetEmail(val string) {
+	s.Email = val
 }
 
-// SetID sets the value of ID.
-func (s *TemplateGetByIDVersionVariablesItem) SetID(val int64) {
-	s.ID = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *UserGetByIDResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// SetName sets the value of Name.
-func (s *TemplateGetByIDVersionVariablesItem) SetName(val string) {
-	s.Name = val
+func (*UserGetByIDResponse) userGetByIDRes() {}
+
+// Ref: #/components/schemas/UserListResponse
+type UserListResponse struct {
+	// Список пользователей.
+	Users []UserListResponseUsersItem `json:"users"`
+	// Общее количество пользователей.
+	TotalUsers int64 `json:"totalUsers"`
+	// Общее количество страниц.
+	TotalPages int64 `json:"totalPages"`
+}
+
+// GetUsers returns the value of Users.
+func (s *UserListResponse) GetUsers() []UserListResponseUsersItem {
+	return s.Users
+}
+
+// GetTotalUsers returns the value of TotalUsers.
+func (s *UserListResponse) GetTotalUsers() int64 {
+	return s.TotalUsers
 }
 
-// SetTitle sets the value of Title.
-func (s *TemplateGetByIDVersionVariablesItem) SetTitle(val string) {
-	s.Title = val
+// GetTotalPages returns the value of TotalPages.
+func (s *UserListResponse) GetTotalPages() int64 {
+	return s.TotalPages
 }
 
-// SetType sets the value of Type.
-func (s *TemplateGetByIDVersionVariablesItem) SetType(val TemplateGetByIDVersionVariablesItemType) {
-	s.Type = val
+// SetUsers sets the value of Users.
+func (s *UserListResponse) SetUsers(val []UserListResponseUsersItem) {
+	s.Users = val
 }
 
-// SetExpression sets the value of Expression.
-func (s *TemplateGetByIDVersionVariablesItem) SetExpression(val OptString) {
-	s.Expression = val
+// SetTotalUsers sets the value of TotalUsers.
+func (s *UserListResponse) SetTotalUsers(val int64) {
+	s.TotalUsers = val
 }
 
-// SetIsInput sets the value of IsInput.
-func (s *TemplateGetByIDVersionVariablesItem) SetIsInput(val bool) {
-	s.IsInput = val
+// SetTotalPages sets the value of TotalPages.
+func (s *UserListResponse) SetTotalPages(val int64) {
+	s.TotalPages = val
 }
 
-// SetConstraints sets the value of Constraints.
-func (s *TemplateGetByIDVersionVariablesItem) SetConstraints(val []TemplateGetByIDVersionVariablesItemConstraintsItem) {
-	s.Constraints = val
-}
+func (*UserListResponse) userListRes() {}
 
-// Ограничение переменной.
-type TemplateGetByIDVersionVariablesItemConstraintsItem struct {
-	// ID ограничения.
+// Пользователь.
+type UserListResponseUsersItem struct {
+	// ID пользователя.
 	ID int64 `json:"id"`
-	// Название ограничения.
+	// Имя пользователя.
 	Name string `json:"name"`
-	// Выражение ограничения.
-	Expression string `json:"expression"`
-	// Активно ли ограничение.
-	IsActive bool `json:"isActive"`
+	// Электронный адрес пользователя.
+	Email string `json:"email"`
 }
 
 // GetID returns the value of ID.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) GetID() int64 {
+func (s *UserListResponseUsersItem) GetID() int64 {
 	return s.ID
 }
 
 // GetName returns the value of Name.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) GetName() string {
+func (s *UserListResponseUsersItem) GetName() string {
 	return s.Name
 }
 
-// GetExpression returns the value of Expression.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) GetExpression() string {
-	return s.Expression
-}
-
-// GetIsActive returns the value of IsActive.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) GetIsActive() bool {
-	return s.IsActive
+// GetEmail returns the value of Email.
+func (s *UserListResponseUsersItem) GetEmail() string {
+	return s.Email
 }
 
 // SetID sets the value of ID.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) SetID(val int64) {
+func (s *UserListResponseUsersItem) SetID(val int64) {
 	s.ID = val
 }
 
 // SetName sets the value of Name.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) SetName(val string) {
+func (s *UserListResponseUsersItem) SetName(val string) {
 	s.Name = val
 }
 
-// SetExpression sets the value of Expression.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) SetExpression(val string) {
-	s.Expression = val
+// SetEmail sets the value of Email.
+func (s *UserListResponseUsersItem) SetEmail(val string) {
+	s.Email = val
 }
 
-// SetIsActive sets the value of IsActive.
-func (s *TemplateGetByIDVersionVariablesItemConstraintsItem) SetIsActive(val bool) {
-	s.IsActive = val
+// UserTokenCreateCreated is response for UserTokenCreate operation.
+type UserTokenCreateCreated struct {
+	SetCookie string
 }
 
-// Тип переменной.
-type TemplateGetByIDVersionVariablesItemType string
-
-const (
-	TemplateGetByIDVersionVariablesItemTypeString  TemplateGetByIDVersionVariablesItemType = "string"
-	TemplateGetByIDVersionVariablesItemTypeInteger TemplateGetByIDVersionVariablesItemType = "integer"
-	TemplateGetByIDVersionVariablesItemTypeFloat   TemplateGetByIDVersionVariablesItemType = "float"
-)
-
-// AllValues returns all TemplateGetByIDVersionVariablesItemType values.
-func (TemplateGetByIDVersionVariablesItemType) AllValues() []TemplateGetByIDVersionVariablesItemType {
-	return []TemplateGetByIDVersionVariablesItemType{
-		TemplateGetByIDVersionVariablesItemTypeString,
-		TemplateGetByIDVersionVariablesItemTypeInteger,
-		TemplateGetByIDVersionVariablesItemTypeFloat,
-	}
+// GetSetCookie returns the value of SetCookie.
+func (s *UserTokenCreateCreated) GetSetCookie() string {
+	return s.SetCookie
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s TemplateGetByIDVersionVariablesItemType) MarshalText() ([]byte, error) {
-	switch s {
-	case TemplateGetByIDVersionVariablesItemTypeString:
-		return []byte(s), nil
-	case TemplateGetByIDVersionVariablesItemTypeInteger:
-		return []byte(s), nil
-	case TemplateGetByIDVersionVariablesItemTypeFloat:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetSetCookie sets the value of SetCookie.
+func (s *UserTokenCreateCreated) SetSetCookie(val string) {
+	s.SetCookie = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TemplateGetByIDVersionVariablesItemType) UnmarshalText(data []byte) error {
-	switch TemplateGetByIDVersionVariablesItemType(data) {
-	case TemplateGetByIDVersionVariablesItemTypeString:
-		*s = TemplateGetByIDVersionVariablesItemTypeString
-		return nil
-	case TemplateGetByIDVersionVariablesItemTypeInteger:
-		*s = TemplateGetByIDVersionVariablesItemTypeInteger
-		return nil
-	case TemplateGetByIDVersionVariablesItemTypeFloat:
-		*s = TemplateGetByIDVersionVariablesItemTypeFloat
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
-}
+func (*UserTokenCreateCreated) userTokenCreateRes() {}
 
-// Ref: #/components/schemas/TemplateGetMetaByIDResponse
-type TemplateGetMetaByIDResponse struct {
-	// Название шаблона.
+// Ref: #/components/schemas/UserTokenCreateRequest
+type UserTokenCreateRequest struct {
+	// Имя пользователя.
 	Name string `json:"name"`
+	// Пароль пользователя.
+	Password string `json:"password"`
+	// При true cookie сохраняется между сессиями браузера; при
+	// false выставляется session-cookie.
+	Remember bool `json:"remember"`
 }
 
 // GetName returns the value of Name.
-func (s *TemplateGetMetaByIDResponse) GetName() string {
+func (s *UserTokenCreateRequest) GetName() string {
 	return s.Name
 }
 
-// SetName sets the value of Name.
-func (s *TemplateGetMetaByIDResponse) SetName(val string) {
-	s.Name = val
-}
-
-func (*TemplateGetMetaByIDResponse) templateGetMetaByIDRes() {}
-
-// Ref: #/components/schemas/TemplateImportPayload
-type TemplateImportPayload struct {
-	// Название шаблона.
-	Name    string                   `json:"name"`
-	Version OptTemplateImportVersion `json:"version"`
-}
-
-// GetName returns the value of Name.
-func (s *TemplateImportPayload) GetName() string {
-	return s.Name
+// GetPassword returns the value of Password.
+func (s *UserTokenCreateRequest) GetPassword() string {
+	return s.Password
 }
 
-// GetVersion returns the value of Version.
-func (s *TemplateImportPayload) GetVersion() OptTemplateImportVersion {
-	return s.Version
+// GetRemember returns the value of Remember.
+func (s *UserTokenCreateRequest) GetRemember() bool {
+	return s.Remember
 }
 
 // SetName sets the value of Name.
-func (s *TemplateImportPayload) SetName(val string) {
+func (s *UserTokenCreateRequest) SetName(val string) {
 	s.Name = val
 }
 
-// SetVersion sets the value of Version.
-func (s *TemplateImportPayload) SetVersion(val OptTemplateImportVersion) {
-	s.Version = val
+// SetPassword sets the value of Password.
+func (s *UserTokenCreateRequest) SetPassword(val string) {
+	s.Password = val
 }
 
-// Ref: #/components/schemas/TemplateImportRequest
-type TemplateImportRequest struct {
-	// ID проекта.
-	ProjectID int64                 `json:"projectID"`
-	Template  TemplateImportPayload `json:"template"`
+// SetRemember sets the value of Remember.
+func (s *UserTokenCreateRequest) SetRemember(val bool) {
+	s.Remember = val
 }
 
-// GetProjectID returns the value of ProjectID.
-func (s *TemplateImportRequest) GetProjectID() int64 {
-	return s.ProjectID
+// UserTokenDeleteNoContent is response for UserTokenDelete operation.
+type UserTokenDeleteNoContent struct {
+	SetCookie string
 }
 
-// GetTemplate returns the value of Template.
-func (s *TemplateImportRequest) GetTemplate() TemplateImportPayload {
-	return s.Template
+// GetSetCookie returns the value of SetCookie.
+func (s *UserTokenDeleteNoContent) GetSetCookie() string {
+	return s.SetCookie
 }
 
-// SetProjectID sets the value of ProjectID.
-func (s *TemplateImportRequest) SetProjectID(val int64) {
-	s.ProjectID = val
+// SetSetCookie sets the value of SetCookie.
+func (s *UserTokenDeleteNoContent) SetSetCookie(val string) {
+	s.SetCookie = val
 }
 
-// SetTemplate sets the value of Template.
-func (s *TemplateImportRequest) SetTemplate(val TemplateImportPayload) {
-	s.Template = val
+// VersionCreateFromCreated is response for VersionCreateFrom operation.
+type VersionCreateFromCreated struct{}
+
+func (*VersionCreateFromCreated) versionCreateFromRes() {}
+
+// Ref: #/components/schemas/VersionCreateFromRequest
+type VersionCreateFromRequest struct {
+	// ID шаблона.
+	TemplateID int64 `json:"templateID"`
+	// ID версии.
+	VersionID int64 `json:"versionID"`
 }
 
-// Ref: #/components/schemas/TemplateImportResponse
-type TemplateImportResponse struct {
-	// ID созданного шаблона.
-	ID int64 `json:"id"`
+// GetTemplateID returns the value of TemplateID.
+func (s *VersionCreateFromRequest) GetTemplateID() int64 {
+	return s.TemplateID
 }
 
-// GetID returns the value of ID.
-func (s *TemplateImportResponse) GetID() int64 {
-	return s.ID
+// GetVersionID returns the value of VersionID.
+func (s *VersionCreateFromRequest) GetVersionID() int64 {
+	return s.VersionID
 }
 
-// SetID sets the value of ID.
-func (s *TemplateImportResponse) SetID(val int64) {
-	s.ID = val
+// SetTemplateID sets the value of TemplateID.
+func (s *VersionCreateFromRequest) SetTemplateID(val int64) {
+	s.TemplateID = val
 }
 
-func (*TemplateImportResponse) templateImportRes() {}
+// SetVersionID sets the value of VersionID.
+func (s *VersionCreateFromRequest) SetVersionID(val int64) {
+	s.VersionID = val
+}
 
-// Ref: #/components/schemas/TemplateImportVersion
-type TemplateImportVersion struct {
+// Ref: #/components/schemas/VersionCreateRequest
+type VersionCreateRequest struct {
+	// ID шаблона.
+	TemplateID int64 `json:"templateID"`
 	// Данные шаблона.
 	Data []byte `json:"data"`
 	// Список переменных шаблона.
-	Variables []TemplateImportVersionVariablesItem `json:"variables"`
+	Variables []VersionCreateRequestVariablesItem `json:"variables"`
+}
+
+// GetTemplateID returns the value of TemplateID.
+func (s *VersionCreateRequest) GetTemplateID() int64 {
+	return s.TemplateID
 }
 
 // GetData returns the value of Data.
-func (s *TemplateImportVersion) GetData() []byte {
+func (s *VersionCreateRequest) GetData() []byte {
 	return s.Data
 }
 
-// GetVariables returns the value of Variables.
-func (s *TemplateImportVersion) GetVariables() []TemplateImportVersionVariablesItem {
-	return s.Variables
+// GetVariables returns the value of Variables.
+func (s *VersionCreateRequest) GetVariables() []VersionCreateRequestVariablesItem {
+	return s.Variables
+}
+
+// SetTemplateID sets the value of TemplateID.
+func (s *VersionCreateRequest) SetTemplateID(val int64) {
+	s.TemplateID = val
 }
 
 // SetData sets the value of Data.
-func (s *TemplateImportVersion) SetData(val []byte) {
+func (s *VersionCreateRequest) SetData(val []byte) {
 	s.Data = val
 }
 
 // SetVariables sets the value of Variables.
-func (s *TemplateImportVersion) SetVariables(val []TemplateImportVersionVariablesItem) {
+func (s *VersionCreateRequest) SetVariables(val []VersionCreateRequestVariablesItem) {
 	s.Variables = val
 }
 
 // Переменная шаблона.
-type TemplateImportVersionVariablesItem struct {
+type VersionCreateRequestVariablesItem struct {
 	// Слаг переменной (идентификатор).
 	Name string `json:"name"`
 	// Человекочитаемое название переменной.
 	Title string `json:"title"`
 	// Тип переменной.
-	Type TemplateImportVersionVariablesItemType `json:"type"`
+	Type VersionCreateRequestVariablesItemType `json:"type"`
 	// Выражение переменной.
 	Expression OptString `json:"expression"`
 	// Является ли переменная входной.
 	IsInput bool `json:"isInput"`
+	// Выражение значения по умолчанию для необязательной
+	// входной переменной.
+	DefaultExpression OptString `json:"defaultExpression"`
+	// Условие, при котором переменная используется; если
+	// ложно, переменная скрыта и равна nil.
+	EnabledIf OptString `json:"enabledIf"`
+	// Единица измерения числовой переменной, например mm, kN
+	// или m/s^2.
+	Unit OptString `json:"unit"`
+	// Список допустимых значений (для типа enum).
+	Options []string `json:"options"`
+	// Столбец справочника проекта, из которого берутся
+	// допустимые значения (для типа enum) вместо options,
+	// например materials.key.
+	OptionsFrom OptString `json:"optionsFrom"`
+	// Тип элементов (для типа list).
+	ItemType OptVersionCreateRequestVariablesItemItemType `json:"itemType"`
+	// Список колонок (для типа table).
+	Columns []VersionCreateRequestVariablesItemColumnsItem `json:"columns"`
 	// Список ограничений переменной.
-	Constraints []TemplateImportVersionVariablesItemConstraintsItem `json:"constraints"`
+	Constraints []VersionCreateRequestVariablesItemConstraintsItem `json:"constraints"`
 }
 
 // GetName returns the value of Name.
-func (s *TemplateImportVersionVariablesItem) GetName() string {
+func (s *VersionCreateRequestVariablesItem) GetName() string {
 	return s.Name
 }
 
 // GetTitle returns the value of Title.
-func (s *TemplateImportVersionVariablesItem) GetTitle() string {
+func (s *VersionCreateRequestVariablesItem) GetTitle() string {
 	return s.Title
 }
 
 // GetType returns the value of Type.
-func (s *TemplateImportVersionVariablesItem) GetType() TemplateImportVersionVariablesItemType {
+func (s *VersionCreateRequestVariablesItem) GetType() VersionCreateRequestVariablesItemType {
 	return s.Type
 }
 
 // GetExpression returns the value of Expression.
-func (s *TemplateImportVersionVariablesItem) GetExpression() OptString {
+func (s *VersionCreateRequestVariablesItem) GetExpression() OptString {
 	return s.Expression
 }
 
 // GetIsInput returns the value of IsInput.
-func (s *TemplateImportVersionVariablesItem) GetIsInput() bool {
+func (s *VersionCreateRequestVariablesItem) GetIsInput() bool {
 	return s.IsInput
 }
 
-// GetConstraints returns the value of Constraints.
-func (s *TemplateImportVersionVariablesItem) GetConstraints() []TemplateImportVersionVariablesItemConstraintsItem {
-	return s.Constraints
-}
-
-// SetName sets the value of Name.
-func (s *TemplateImportVersionVariablesItem) SetName(val string) {
-	s.Name = val
-}
-
-// SetTitle sets the value of Title.
-func (s *TemplateImportVersionVariablesItem) SetTitle(val string) {
-	s.Title = val
-}
-
-// SetType sets the value of Type.
-func (s *TemplateImportVersionVariablesItem) SetType(val TemplateImportVersionVariablesItemType) {
-	s.Type = val
+// GetDefaultExpression returns the value of DefaultExpression.
+func (s *VersionCreateRequestVariablesItem) GetDefaultExpression() OptString {
+	return s.DefaultExpression
 }
 
-// SetExpression sets the value of Expression.
-func (s *TemplateImportVersionVariablesItem) SetExpression(val OptString) {
-	s.Expression = val
+// GetEnabledIf returns the value of EnabledIf.
+func (s *VersionCreateRequestVariablesItem) GetEnabledIf() OptString {
+	return s.EnabledIf
 }
 
-// SetIsInput sets the value of IsInput.
-func (s *TemplateImportVersionVariablesItem) SetIsInput(val bool) {
-	s.IsInput = val
+// GetUnit returns the value of Unit.
+func (s *VersionCreateRequestVariablesItem) GetUnit() OptString {
+	return s.Unit
 }
 
-// SetConstraints sets the value of Constraints.
-func (s *TemplateImportVersionVariablesItem) SetConstraints(val []TemplateImportVersionVariablesItemConstraintsItem) {
-	s.Constraints = val
+// GetOptions returns the value of Options.
+func (s *VersionCreateRequestVariablesItem) GetOptions() []string {
+	return s.Options
 }
 
-// Ограничение переменной.
-type TemplateImportVersionVariablesItemConstraintsItem struct {
-	// Название ограничения.
-	Name string `json:"name"`
-	// Выражение ограничения.
-	Expression string `json:"expression"`
-	// Активно ли ограничение.
-	IsActive bool `json:"isActive"`
+// GetOptionsFrom returns the value of OptionsFrom.
+func (s *VersionCreateRequestVariablesItem) GetOptionsFrom() OptString {
+	return s.OptionsFrom
 }
 
-// GetName returns the value of Name.
-func (s *TemplateImportVersionVariablesItemConstraintsItem) GetName() string {
-	return s.Name
+// GetItemType returns the value of ItemType.
+func (s *VersionCreateRequestVariablesItem) GetItemType() OptVersionCreateRequestVariablesItemItemType {
+	return s.ItemType
 }
 
-// GetExpression returns the value of Expression.
-func (s *TemplateImportVersionVariablesItemConstraintsItem) GetExpression() string {
-	return s.Expression
+// GetColumns returns the value of Columns.
+func (s *VersionCreateRequestVariablesItem) GetColumns() []VersionCreateRequestVariablesItemColumnsItem {
+	return s.Columns
 }
 
-// GetIsActive returns the value of IsActive.
-func (s *TemplateImportVersionVariablesItemConstraintsItem) GetIsActive() bool {
-	return s.IsActive
+// GetConstraints returns the value of Constraints.
+func (s *VersionCreateRequestVariablesItem) GetConstraints() []VersionCreateRequestVariablesItemConstraintsItem {
+	return s.Constraints
 }
 
 // SetName sets the value of Name.
-func (s *TemplateImportVersionVariablesItemConstraintsItem) SetName(val string) {
+func (s *VersionCreateRequestVariablesItem) SetName(val string) {
 	s.Name = val
 }
 
-// SetExpression sets the value of Expression.
-func (s *TemplateImportVersionVariablesItemConstraintsItem) SetExpression(val string) {
-	s.Expression = val
-}
-
-// SetIsActive sets the value of IsActive.
-func (s *TemplateImportVersionVariablesItemConstraintsItem) SetIsActive(val bool) {
-	s.IsActive = val
-}
-
-// Тип переменной.
-type TemplateImportVersionVariablesItemType string
-
-const (
-	TemplateImportVersionVariablesItemTypeString  TemplateImportVersionVariablesItemType = "string"
-	TemplateImportVersionVariablesItemTypeInteger TemplateImportVersionVariablesItemType = "integer"
-	TemplateImportVersionVariablesItemTypeFloat   TemplateImportVersionVariablesItemType = "float"
-)
-
-// AllValues returns all TemplateImportVersionVariablesItemType values.
-func (TemplateImportVersionVariablesItemType) AllValues() []TemplateImportVersionVariablesItemType {
-	return []TemplateImportVersionVariablesItemType{
-		TemplateImportVersionVariablesItemTypeString,
-		TemplateImportVersionVariablesItemTypeInteger,
-		TemplateImportVersionVariablesItemTypeFloat,
-	}
-}
-
-// MarshalText implements encoding.TextMarshaler.
-func (s TemplateImportVersionVariablesItemType) MarshalText() ([]byte, error) {
-	switch s {
-	case TemplateImportVersionVariablesItemTypeString:
-		return []byte(s), nil
-	case TemplateImportVersionVariablesItemTypeInteger:
-		return []byte(s), nil
-	case TemplateImportVersionVariablesItemTypeFloat:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
-}
-
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TemplateImportVersionVariablesItemType) UnmarshalText(data []byte) error {
-	switch TemplateImportVersionVariablesItemType(data) {
-	case TemplateImportVersionVariablesItemTypeString:
-		*s = TemplateImportVersionVariablesItemTypeString
-		return nil
-	case TemplateImportVersionVariablesItemTypeInteger:
-		*s = TemplateImportVersionVariablesItemTypeInteger
-		return nil
-	case TemplateImportVersionVariablesItemTypeFloat:
-		*s = TemplateImportVersionVariablesItemTypeFloat
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
-}
-
-// Ref: #/components/schemas/TemplateListResponse
-type TemplateListResponse struct {
-	// Список шаблонов.
-	Templates []TemplateListResponseTemplatesItem `json:"templates"`
-	// Общее количество шаблонов.
-	TotalTemplates int64 `json:"totalTemplates"`
-	// Общее количество страниц.
-	TotalPages int64 `json:"totalPages"`
-}
-
-// GetTemplates returns the value of Templates.
-func (s *TemplateListResponse) GetTemplates() []TemplateListResponseTemplatesItem {
-	return s.Templates
-}
-
-// GetTotalTemplates returns the value of TotalTemplates.
-func (s *TemplateListResponse) GetTotalTemplates() int64 {
-	return s.TotalTemplates
-}
-
-// GetTotalPages returns the value of TotalPages.
-func (s *TemplateListResponse) GetTotalPages() int64 {
-	return s.TotalPages
-}
-
-// SetTemplates sets the value of Templates.
-func (s *TemplateListResponse) SetTemplates(val []TemplateListResponseTemplatesItem) {
-	s.Templates = val
-}
-
-// SetTotalTemplates sets the value of TotalTemplates.
-func (s *TemplateListResponse) SetTotalTemplates(val int64) {
-	s.TotalTemplates = val
-}
-
-// SetTotalPages sets the value of TotalPages.
-func (s *TemplateListResponse) SetTotalPages(val int64) {
-	s.TotalPages = val
+// SetTitle sets the value of Title.
+func (s *VersionCreateRequestVariablesItem) SetTitle(val string) {
+	s.Title = val
 }
 
-func (*TemplateListResponse) templateListRes() {}
-
-// Шаблон.
-type TemplateListResponseTemplatesItem struct {
-	// ID шаблона.
-	ID int64 `json:"id"`
-	// Название шаблона.
-	Name string `json:"name"`
-	// Имя автора шаблона.
-	AuthorName string `json:"authorName"`
-	// Дата и время создания шаблона.
-	CreatedAt time.Time `json:"createdAt"`
-	// Дата и время обновления шаблона.
-	UpdatedAt OptDateTime `json:"updatedAt"`
+// SetType sets the value of Type.
+func (s *VersionCreateRequestVariablesItem) SetType(val VersionCreateRequestVariablesItemType) {
+	s.Type = val
 }
 
-// GetID returns the value of ID.
-func (s *TemplateListResponseTemplatesItem) GetID() int64 {
-	return s.ID
+// SetExpression sets the value of Expression.
+func (s *VersionCreateRequestVariablesItem) SetExpression(val OptString) {
+	s.Expression = val
 }
 
-// GetName returns the value of Name.
-func (s *TemplateListResponseTemplatesItem) GetName() string {
-	return s.Name
+// SetIsInput sets the value of IsInput.
+func (s *VersionCreateRequestVariablesItem) SetIsInput(val bool) {
+	s.IsInput = val
 }
 
-// GetAuthorName returns the value of AuthorName.
-func (s *TemplateListResponseTemplatesItem) GetAuthorName() string {
-	return s.AuthorName
+// SetDefaultExpression sets the value of DefaultExpression.
+func (s *VersionCreateRequestVariablesItem) SetDefaultExpression(val OptString) {
+	s.DefaultExpression = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *TemplateListResponseTemplatesItem) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// SetEnabledIf sets the value of EnabledIf.
+func (s *VersionCreateRequestVariablesItem) SetEnabledIf(val OptString) {
+	s.EnabledIf = val
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *TemplateListResponseTemplatesItem) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// SetUnit sets the value of Unit.
+func (s *VersionCreateRequestVariablesItem) SetUnit(val OptString) {
+	s.Unit = val
 }
 
-// SetID sets the value of ID.
-func (s *TemplateListResponseTemplatesItem) SetID(val int64) {
-	s.ID = val
+// SetOptions sets the value of Options.
+func (s *VersionCreateRequestVariablesItem) SetOptions(val []string) {
+	s.Options = val
 }
 
-// SetName sets the value of Name.
-func (s *TemplateListResponseTemplatesItem) SetName(val string) {
-	s.Name = val
+// SetOptionsFrom sets the value of OptionsFrom.
+func (s *VersionCreateRequestVariablesItem) SetOptionsFrom(val OptString) {
+	s.OptionsFrom = val
 }
 
-// SetAuthorName sets the value of AuthorName.
-func (s *TemplateListResponseTemplatesItem) SetAuthorName(val string) {
-	s.AuthorName = val
+// SetItemType sets the value of ItemType.
+func (s *VersionCreateRequestVariablesItem) SetItemType(val OptVersionCreateRequestVariablesItemItemType) {
+	s.ItemType = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *TemplateListResponseTemplatesItem) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetColumns sets the value of Columns.
+func (s *VersionCreateRequestVariablesItem) SetColumns(val []VersionCreateRequestVariablesItemColumnsItem) {
+	s.Columns = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *TemplateListResponseTemplatesItem) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// SetConstraints sets the value of Constraints.
+func (s *VersionCreateRequestVariablesItem) SetConstraints(val []VersionCreateRequestVariablesItemConstraintsItem) {
+	s.Constraints = val
 }
 
-// TemplateUpdateByIDNoContent is response for TemplateUpdateByID operation.
-type TemplateUpdateByIDNoContent struct{}
-
-func (*TemplateUpdateByIDNoContent) templateUpdateByIDRes() {}
-
-// Ref: #/components/schemas/TemplateUpdateRequest
-type TemplateUpdateRequest struct {
-	// Название шаблона.
+// Колонка таблицы.
+type VersionCreateRequestVariablesItemColumnsItem struct {
+	// Слаг колонки (идентификатор).
 	Name string `json:"name"`
-}
-
-// GetName returns the value of Name.
-func (s *TemplateUpdateRequest) GetName() string {
-	return s.Name
-}
-
-// SetName sets the value of Name.
-func (s *TemplateUpdateRequest) SetName(val string) {
-	s.Name = val
-}
-
-// TemplateUpdateUsersNoContent is response for TemplateUpdateUsers operation.
-type TemplateUpdateUsersNoContent struct{}
-
-func (*TemplateUpdateUsersNoContent) templateUpdateUsersRes() {}
+	// Человекочитаемое название колонки.
+	Title string `json:"title"`
+	// Тип колонки.
+	Type VersionCreateRequestVariablesItemColumnsItemType `json:"type"`
+	// Список допустимых значений (для типа enum).
+	Options []string `json:"options"`
+}
 
-// Ref: #/components/schemas/TemplateUpdateUsersRequest
-type TemplateUpdateUsersRequest struct {
-	// Список пользователей шаблона.
-	Users []TemplateUpdateUsersRequestUsersItem `json:"users"`
+// GetName returns the value of Name.
+func (s *VersionCreateRequestVariablesItemColumnsItem) GetName() string {
+	return s.Name
 }
 
-// GetUsers returns the value of Users.
-func (s *TemplateUpdateUsersRequest) GetUsers() []TemplateUpdateUsersRequestUsersItem {
-	return s.Users
+// GetTitle returns the value of Title.
+func (s *VersionCreateRequestVariablesItemColumnsItem) GetTitle() string {
+	return s.Title
 }
 
-// SetUsers sets the value of Users.
-func (s *TemplateUpdateUsersRequest) SetUsers(val []TemplateUpdateUsersRequestUsersItem) {
-	s.Users = val
+// GetType returns the value of Type.
+func (s *VersionCreateRequestVariablesItemColumnsItem) GetType() VersionCreateRequestVariablesItemColumnsItemType {
+	return s.Type
 }
 
-// Пользователь шаблона.
-type TemplateUpdateUsersRequestUsersItem struct {
-	// ID пользователя.
-	ID int64 `json:"id"`
-	// Роль пользователя в шаблоне.
-	Role TemplateUpdateUsersRequestUsersItemRole `json:"role"`
+// GetOptions returns the value of Options.
+func (s *VersionCreateRequestVariablesItemColumnsItem) GetOptions() []string {
+	return s.Options
 }
 
-// GetID returns the value of ID.
-func (s *TemplateUpdateUsersRequestUsersItem) GetID() int64 {
-	return s.ID
+// SetName sets the value of Name.
+func (s *VersionCreateRequestVariablesItemColumnsItem) SetName(val string) {
+	s.Name = val
 }
 
-// GetRole returns the value of Role.
-func (s *TemplateUpdateUsersRequestUsersItem) GetRole() TemplateUpdateUsersRequestUsersItemRole {
-	return s.Role
+// SetTitle sets the value of Title.
+func (s *VersionCreateRequestVariablesItemColumnsItem) SetTitle(val string) {
+	s.Title = val
 }
 
-// SetID sets the value of ID.
-func (s *TemplateUpdateUsersRequestUsersItem) SetID(val int64) {
-	s.ID = val
+// SetType sets the value of Type.
+func (s *VersionCreateRequestVariablesItemColumnsItem) SetType(val VersionCreateRequestVariablesItemColumnsItemType) {
+	s.Type = val
 }
 
-// SetRole sets the value of Role.
-func (s *TemplateUpdateUsersRequestUsersItem) SetRole(val TemplateUpdateUsersRequestUsersItemRole) {
-	s.Role = val
+// SetOptions sets the value of Options.
+func (s *VersionCreateRequestVariablesItemColumnsItem) SetOptions(val []string) {
+	s.Options = val
 }
 
-// Роль пользователя в шаблоне.
-type TemplateUpdateUsersRequestUsersItemRole string
+// Тип колонки.
+type VersionCreateRequestVariablesItemColumnsItemType string
 
 const (
-	TemplateUpdateUsersRequestUsersItemRoleRead  TemplateUpdateUsersRequestUsersItemRole = "read"
-	TemplateUpdateUsersRequestUsersItemRoleWrite TemplateUpdateUsersRequestUsersItemRole = "write"
+	VersionCreateRequestVariablesItemColumnsItemTypeString  VersionCreateRequestVariablesItemColumnsItemType = "string"
+	VersionCreateRequestVariablesItemColumnsItemTypeInteger VersionCreateRequestVariablesItemColumnsItemType = "integer"
+	VersionCreateRequestVariablesItemColumnsItemTypeFloat   VersionCreateRequestVariablesItemColumnsItemType = "float"
+	VersionCreateRequestVariablesItemColumnsItemTypeDecimal VersionCreateRequestVariablesItemColumnsItemType = "decimal"
+	VersionCreateRequestVariablesItemColumnsItemTypeBoolean VersionCreateRequestVariablesItemColumnsItemType = "boolean"
+	VersionCreateRequestVariablesItemColumnsItemTypeDate    VersionCreateRequestVariablesItemColumnsItemType = "date"
+	VersionCreateRequestVariablesItemColumnsItemTypeEnum    VersionCreateRequestVariablesItemColumnsItemType = "enum"
 )
 
-// AllValues returns all TemplateUpdateUsersRequestUsersItemRole values.
-func (TemplateUpdateUsersRequestUsersItemRole) AllValues() []TemplateUpdateUsersRequestUsersItemRole {
-	return []TemplateUpdateUsersRequestUsersItemRole{
-		TemplateUpdateUsersRequestUsersItemRoleRead,
-		TemplateUpdateUsersRequestUsersItemRoleWrite,
+// AllValues returns all VersionCreateRequestVariablesItemColumnsItemType values.
+func (VersionCreateRequestVariablesItemColumnsItemType) AllValues() []VersionCreateRequestVariablesItemColumnsItemType {
+	return []VersionCreateRequestVariablesItemColumnsItemType{
+		VersionCreateRequestVariablesItemColumnsItemTypeString,
+		VersionCreateRequestVariablesItemColumnsItemTypeInteger,
+		VersionCreateRequestVariablesItemColumnsItemTypeFloat,
+		VersionCreateRequestVariablesItemColumnsItemTypeDecimal,
+		VersionCreateRequestVariablesItemColumnsItemTypeBoolean,
+		VersionCreateRequestVariablesItemColumnsItemTypeDate,
+		VersionCreateRequestVariablesItemColumnsItemTypeEnum,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s TemplateUpdateUsersRequestUsersItemRole) MarshalText() ([]byte, error) {
+func (s VersionCreateRequestVariablesItemColumnsItemType) MarshalText() ([]byte, error) {
 	switch s {
-	case TemplateUpdateUsersRequestUsersItemRoleRead:
+	case VersionCreateRequestVariablesItemColumnsItemTypeString:
 		return []byte(s), nil
-	case TemplateUpdateUsersRequestUsersItemRoleWrite:
+	case VersionCreateRequestVariablesItemColumnsItemTypeInteger:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemColumnsItemTypeFloat:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemColumnsItemTypeDecimal:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemColumnsItemTypeBoolean:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemColumnsItemTypeDate:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemColumnsItemTypeEnum:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -2451,111 +5816,116 @@ func (s TemplateUpdateUsersRequestUsersItemRole) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TemplateUpdateUsersRequestUsersItemRole) UnmarshalText(data []byte) error {
-	switch TemplateUpdateUsersRequestUsersItemRole(data) {
-	case TemplateUpdateUsersRequestUsersItemRoleRead:
-		*s = TemplateUpdateUsersRequestUsersItemRoleRead
+func (s *VersionCreateRequestVariablesItemColumnsItemType) UnmarshalText(data []byte) error {
+	switch VersionCreateRequestVariablesItemColumnsItemType(data) {
+	case VersionCreateRequestVariablesItemColumnsItemTypeString:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeString
 		return nil
-	case TemplateUpdateUsersRequestUsersItemRoleWrite:
-		*s = TemplateUpdateUsersRequestUsersItemRoleWrite
+	case VersionCreateRequestVariablesItemColumnsItemTypeInteger:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeInteger
+		return nil
+	case VersionCreateRequestVariablesItemColumnsItemTypeFloat:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeFloat
+		return nil
+	case VersionCreateRequestVariablesItemColumnsItemTypeDecimal:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeDecimal
+		return nil
+	case VersionCreateRequestVariablesItemColumnsItemTypeBoolean:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeBoolean
+		return nil
+	case VersionCreateRequestVariablesItemColumnsItemTypeDate:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeDate
+		return nil
+	case VersionCreateRequestVariablesItemColumnsItemTypeEnum:
+		*s = VersionCreateRequestVariablesItemColumnsItemTypeEnum
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Ref: #/components/schemas/TemplateUsersResponse
-type TemplateUsersResponse struct {
-	// Список пользователей шаблона.
-	Users []TemplateUsersResponseUsersItem `json:"users"`
-}
-
-// GetUsers returns the value of Users.
-func (s *TemplateUsersResponse) GetUsers() []TemplateUsersResponseUsersItem {
-	return s.Users
-}
-
-// SetUsers sets the value of Users.
-func (s *TemplateUsersResponse) SetUsers(val []TemplateUsersResponseUsersItem) {
-	s.Users = val
-}
-
-func (*TemplateUsersResponse) templateUsersRes() {}
-
-// Пользователь шаблона.
-type TemplateUsersResponseUsersItem struct {
-	// ID пользователя.
-	ID int64 `json:"id"`
-	// Имя пользователя.
+// Ограничение переменной.
+type VersionCreateRequestVariablesItemConstraintsItem struct {
+	// Название ограничения.
 	Name string `json:"name"`
-	// Электронный адрес пользователя.
-	Email string `json:"email"`
-	// Роль пользователя в шаблоне.
-	Role TemplateUsersResponseUsersItemRole `json:"role"`
-}
-
-// GetID returns the value of ID.
-func (s *TemplateUsersResponseUsersItem) GetID() int64 {
-	return s.ID
+	// Выражение ограничения.
+	Expression string `json:"expression"`
+	// Активно ли ограничение.
+	IsActive bool `json:"isActive"`
 }
 
 // GetName returns the value of Name.
-func (s *TemplateUsersResponseUsersItem) GetName() string {
+func (s *VersionCreateRequestVariablesItemConstraintsItem) GetName() string {
 	return s.Name
 }
 
-// GetEmail returns the value of Email.
-func (s *TemplateUsersResponseUsersItem) GetEmail() string {
-	return s.Email
-}
-
-// GetRole returns the value of Role.
-func (s *TemplateUsersResponseUsersItem) GetRole() TemplateUsersResponseUsersItemRole {
-	return s.Role
+// GetExpression returns the value of Expression.
+func (s *VersionCreateRequestVariablesItemConstraintsItem) GetExpression() string {
+	return s.Expression
 }
 
-// SetID sets the value of ID.
-func (s *TemplateUsersResponseUsersItem) SetID(val int64) {
-	s.ID = val
+// GetIsActive returns the value of IsActive.
+func (s *VersionCreateRequestVariablesItemConstraintsItem) GetIsActive() bool {
+	return s.IsActive
 }
 
 // SetName sets the value of Name.
-func (s *TemplateUsersResponseUsersItem) SetName(val string) {
+func (s *VersionCreateRequestVariablesItemConstraintsItem) SetName(val string) {
 	s.Name = val
 }
 
-// SetEmail sets the value of Email.
-func (s *TemplateUsersResponseUsersItem) SetEmail(val string) {
-	s.Email = val
+// SetExpression sets the value of Expression.
+func (s *VersionCreateRequestVariablesItemConstraintsItem) SetExpression(val string) {
+	s.Expression = val
 }
 
-// SetRole sets the value of Role.
-func (s *TemplateUsersResponseUsersItem) SetRole(val TemplateUsersResponseUsersItemRole) {
-	s.Role = val
+// SetIsActive sets the value of IsActive.
+func (s *VersionCreateRequestVariablesItemConstraintsItem) SetIsActive(val bool) {
+	s.IsActive = val
 }
 
-// Роль пользователя в шаблоне.
-type TemplateUsersResponseUsersItemRole string
+// Тип элементов (для типа list).
+type VersionCreateRequestVariablesItemItemType string
 
 const (
-	TemplateUsersResponseUsersItemRoleRead  TemplateUsersResponseUsersItemRole = "read"
-	TemplateUsersResponseUsersItemRoleWrite TemplateUsersResponseUsersItemRole = "write"
+	VersionCreateRequestVariablesItemItemTypeString  VersionCreateRequestVariablesItemItemType = "string"
+	VersionCreateRequestVariablesItemItemTypeInteger VersionCreateRequestVariablesItemItemType = "integer"
+	VersionCreateRequestVariablesItemItemTypeFloat   VersionCreateRequestVariablesItemItemType = "float"
+	VersionCreateRequestVariablesItemItemTypeDecimal VersionCreateRequestVariablesItemItemType = "decimal"
+	VersionCreateRequestVariablesItemItemTypeBoolean VersionCreateRequestVariablesItemItemType = "boolean"
+	VersionCreateRequestVariablesItemItemTypeDate    VersionCreateRequestVariablesItemItemType = "date"
+	VersionCreateRequestVariablesItemItemTypeEnum    VersionCreateRequestVariablesItemItemType = "enum"
 )
 
-// AllValues returns all TemplateUsersResponseUsersItemRole values.
-func (TemplateUsersResponseUsersItemRole) AllValues() []TemplateUsersResponseUsersItemRole {
-	return []TemplateUsersResponseUsersItemRole{
-		TemplateUsersResponseUsersItemRoleRead,
-		TemplateUsersResponseUsersItemRoleWrite,
+// AllValues returns all VersionCreateRequestVariablesItemItemType values.
+func (VersionCreateRequestVariablesItemItemType) AllValues() []VersionCreateRequestVariablesItemItemType {
+	return []VersionCreateRequestVariablesItemItemType{
+		VersionCreateRequestVariablesItemItemTypeString,
+		VersionCreateRequestVariablesItemItemTypeInteger,
+		VersionCreateRequestVariablesItemItemTypeFloat,
+		VersionCreateRequestVariablesItemItemTypeDecimal,
+		VersionCreateRequestVariablesItemItemTypeBoolean,
+		VersionCreateRequestVariablesItemItemTypeDate,
+		VersionCreateRequestVariablesItemItemTypeEnum,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s TemplateUsersResponseUsersItemRole) MarshalText() ([]byte, error) {
+func (s VersionCreateRequestVariablesItemItemType) MarshalText() ([]byte, error) {
 	switch s {
-	case TemplateUsersResponseUsersItemRoleRead:
+	case VersionCreateRequestVariablesItemItemTypeString:
 		return []byte(s), nil
-	case TemplateUsersResponseUsersItemRoleWrite:
+	case VersionCreateRequestVariablesItemItemTypeInteger:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemItemTypeFloat:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemItemTypeDecimal:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemItemTypeBoolean:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemItemTypeDate:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemItemTypeEnum:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -2563,424 +5933,587 @@ func (s TemplateUsersResponseUsersItemRole) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TemplateUsersResponseUsersItemRole) UnmarshalText(data []byte) error {
-	switch TemplateUsersResponseUsersItemRole(data) {
-	case TemplateUsersResponseUsersItemRoleRead:
-		*s = TemplateUsersResponseUsersItemRoleRead
+func (s *VersionCreateRequestVariablesItemItemType) UnmarshalText(data []byte) error {
+	switch VersionCreateRequestVariablesItemItemType(data) {
+	case VersionCreateRequestVariablesItemItemTypeString:
+		*s = VersionCreateRequestVariablesItemItemTypeString
 		return nil
-	case TemplateUsersResponseUsersItemRoleWrite:
-		*s = TemplateUsersResponseUsersItemRoleWrite
+	case VersionCreateRequestVariablesItemItemTypeInteger:
+		*s = VersionCreateRequestVariablesItemItemTypeInteger
+		return nil
+	case VersionCreateRequestVariablesItemItemTypeFloat:
+		*s = VersionCreateRequestVariablesItemItemTypeFloat
+		return nil
+	case VersionCreateRequestVariablesItemItemTypeDecimal:
+		*s = VersionCreateRequestVariablesItemItemTypeDecimal
+		return nil
+	case VersionCreateRequestVariablesItemItemTypeBoolean:
+		*s = VersionCreateRequestVariablesItemItemTypeBoolean
+		return nil
+	case VersionCreateRequestVariablesItemItemTypeDate:
+		*s = VersionCreateRequestVariablesItemItemTypeDate
+		return nil
+	case VersionCreateRequestVariablesItemItemTypeEnum:
+		*s = VersionCreateRequestVariablesItemItemTypeEnum
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// UserCreateCreated is response for UserCreate operation.
-type UserCreateCreated struct{}
+// Тип переменной.
+type VersionCreateRequestVariablesItemType string
 
-func (*UserCreateCreated) userCreateRes() {}
+const (
+	VersionCreateRequestVariablesItemTypeString  VersionCreateRequestVariablesItemType = "string"
+	VersionCreateRequestVariablesItemTypeInteger VersionCreateRequestVariablesItemType = "integer"
+	VersionCreateRequestVariablesItemTypeFloat   VersionCreateRequestVariablesItemType = "float"
+	VersionCreateRequestVariablesItemTypeDecimal VersionCreateRequestVariablesItemType = "decimal"
+	VersionCreateRequestVariablesItemTypeBoolean VersionCreateRequestVariablesItemType = "boolean"
+	VersionCreateRequestVariablesItemTypeDate    VersionCreateRequestVariablesItemType = "date"
+	VersionCreateRequestVariablesItemTypeEnum    VersionCreateRequestVariablesItemType = "enum"
+	VersionCreateRequestVariablesItemTypeList    VersionCreateRequestVariablesItemType = "list"
+	VersionCreateRequestVariablesItemTypeTable   VersionCreateRequestVariablesItemType = "table"
+)
 
-// Ref: #/components/schemas/UserCreateRequest
-type UserCreateRequest struct {
-	// Имя пользователя.
-	Name string `json:"name"`
-	// Электронный адрес пользователя.
-	Email string `json:"email"`
-	// Пароль пользователя.
-	Password string `json:"password"`
+// AllValues returns all VersionCreateRequestVariablesItemType values.
+func (VersionCreateRequestVariablesItemType) AllValues() []VersionCreateRequestVariablesItemType {
+	return []VersionCreateRequestVariablesItemType{
+		VersionCreateRequestVariablesItemTypeString,
+		VersionCreateRequestVariablesItemTypeInteger,
+		VersionCreateRequestVariablesItemTypeFloat,
+		VersionCreateRequestVariablesItemTypeDecimal,
+		VersionCreateRequestVariablesItemTypeBoolean,
+		VersionCreateRequestVariablesItemTypeDate,
+		VersionCreateRequestVariablesItemTypeEnum,
+		VersionCreateRequestVariablesItemTypeList,
+		VersionCreateRequestVariablesItemTypeTable,
+	}
 }
 
-// GetName returns the value of Name.
-func (s *UserCreateRequest) GetName() string {
-	return s.Name
+// MarshalText implements encoding.TextMarshaler.
+func (s VersionCreateRequestVariablesItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case VersionCreateRequestVariablesItemTypeString:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemTypeInteger:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemTypeFloat:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemTypeDecimal:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemTypeBoolean:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemTypeDate:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemTypeEnum:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemTypeList:
+		return []byte(s), nil
+	case VersionCreateRequestVariablesItemTypeTable:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetEmail returns the value of Email.
-func (s *UserCreateRequest) GetEmail() string {
-	return s.Email
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *VersionCreateRequestVariablesItemType) UnmarshalText(data []byte) error {
+	switch VersionCreateRequestVariablesItemType(data) {
+	case VersionCreateRequestVariablesItemTypeString:
+		*s = VersionCreateRequestVariablesItemTypeString
+		return nil
+	case VersionCreateRequestVariablesItemTypeInteger:
+		*s = VersionCreateRequestVariablesItemTypeInteger
+		return nil
+	case VersionCreateRequestVariablesItemTypeFloat:
+		*s = VersionCreateRequestVariablesItemTypeFloat
+		return nil
+	case VersionCreateRequestVariablesItemTypeDecimal:
+		*s = VersionCreateRequestVariablesItemTypeDecimal
+		return nil
+	case VersionCreateRequestVariablesItemTypeBoolean:
+		*s = VersionCreateRequestVariablesItemTypeBoolean
+		return nil
+	case VersionCreateRequestVariablesItemTypeDate:
+		*s = VersionCreateRequestVariablesItemTypeDate
+		return nil
+	case VersionCreateRequestVariablesItemTypeEnum:
+		*s = VersionCreateRequestVariablesItemTypeEnum
+		return nil
+	case VersionCreateRequestVariablesItemTypeList:
+		*s = VersionCreateRequestVariablesItemTypeList
+		return nil
+	case VersionCreateRequestVariablesItemTypeTable:
+		*s = VersionCreateRequestVariablesItemTypeTable
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetPassword returns the value of Password.
-func (s *UserCreateRequest) GetPassword() string {
-	return s.Password
+// Ref: #/components/schemas/VersionCreateResponse
+type VersionCreateResponse struct {
+	// ID версии.
+	ID int64 `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *VersionCreateResponse) GetID() int64 {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *VersionCreateResponse) SetID(val int64) {
+	s.ID = val
 }
 
-// SetName sets the value of Name.
-func (s *UserCreateRequest) SetName(val string) {
-	s.Name = val
+func (*VersionCreateResponse) versionCreateRes() {}
+
+// Ref: #/components/schemas/VersionListResponse
+type VersionListResponse struct {
+	// Список версий.
+	Versions []VersionListResponseVersionsItem `json:"versions"`
 }
 
-// SetEmail sets the value of Email.
-func (s *UserCreateRequest) SetEmail(val string) {
-	s.Email = val
+// GetVersions returns the value of Versions.
+func (s *VersionListResponse) GetVersions() []VersionListResponseVersionsItem {
+	return s.Versions
 }
 
-// SetPassword sets the value of Password.
-func (s *UserCreateRequest) SetPassword(val string) {
-	s.Password = val
+// SetVersions sets the value of Versions.
+func (s *VersionListResponse) SetVersions(val []VersionListResponseVersionsItem) {
+	s.Versions = val
 }
 
-// Ref: #/components/schemas/UserGetByIDResponse
-type UserGetByIDResponse struct {
-	// ID пользователя.
+func (*VersionListResponse) versionListRes() {}
+
+// Версия шаблона.
+type VersionListResponseVersionsItem struct {
+	// ID версии.
 	ID int64 `json:"id"`
-	// Имя пользователя.
-	Name string `json:"name"`
-	// Электронный адрес пользователя.
-	Email string `json:"email"`
-	// Дата и время создания пользователя.
+	// Номер версии.
+	Number int64 `json:"number"`
+	// Имя автора версии.
+	AuthorName string `json:"authorName"`
+	// Дата и время создания версии.
 	CreatedAt time.Time `json:"createdAt"`
 }
 
 // GetID returns the value of ID.
-func (s *UserGetByIDResponse) GetID() int64 {
+func (s *VersionListResponseVersionsItem) GetID() int64 {
 	return s.ID
 }
 
-// GetName returns the value of Name.
-func (s *UserGetByIDResponse) GetName() string {
-	return s.Name
+// GetNumber returns the value of Number.
+func (s *VersionListResponseVersionsItem) GetNumber() int64 {
+	return s.Number
 }
 
-// GetEmail returns the value of Email.
-func (s *UserGetByIDResponse) GetEmail() string {
-	return s.Email
+// GetAuthorName returns the value of AuthorName.
+func (s *VersionListResponseVersionsItem) GetAuthorName() string {
+	return s.AuthorName
 }
 
 // GetCreatedAt returns the value of CreatedAt.
-func (s *UserGetByIDResponse) GetCreatedAt() time.Time {
+func (s *VersionListResponseVersionsItem) GetCreatedAt() time.Time {
 	return s.CreatedAt
 }
 
 // SetID sets the value of ID.
-func (s *UserGetByIDResponse) SetID(val int64) {
+func (s *VersionListResponseVersionsItem) SetID(val int64) {
 	s.ID = val
 }
 
-// SetName sets the value of Name.
-func (s *UserGetByIDResponse) SetName(val string) {
-	s.Name = val
+// SetNumber sets the value of Number.
+func (s *VersionListResponseVersionsItem) SetNumber(val int64) {
+	s.Number = val
 }
 
-// SetEmail sets the value of Email.
-func (s *UserGetByIDResponse) SetEmail(val string) {
-	s.Email = val
+// SetAuthorName sets the value of AuthorName.
+func (s *VersionListResponseVersionsItem) SetAuthorName(val string) {
+	s.AuthorName = val
 }
 
 // SetCreatedAt sets the value of CreatedAt.
-func (s *UserGetByIDResponse) SetCreatedAt(val time.Time) {
+func (s *VersionListResponseVersionsItem) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-func (*UserGetByIDResponse) userGetByIDRes() {}
-
-// Ref: #/components/schemas/UserListResponse
-type UserListResponse struct {
-	// Список пользователей.
-	Users []UserListResponseUsersItem `json:"users"`
-	// Общее количество пользователей.
-	TotalUsers int64 `json:"totalUsers"`
-	// Общее количество страниц.
-	TotalPages int64 `json:"totalPages"`
+// Ref: #/components/schemas/VersionPreviewDraftRequest
+type VersionPreviewDraftRequest struct {
+	// ID шаблона.
+	TemplateID int64 `json:"templateID"`
+	// Данные черновика шаблона.
+	Data []byte `json:"data"`
+	// Список переменных шаблона.
+	Variables []VersionPreviewDraftRequestVariablesItem `json:"variables"`
+	// Пэйлоад задачи (скаляры строками, списки и таблицы
+	// массивами).
+	Payload VersionPreviewDraftRequestPayload `json:"payload"`
+	// Вернуть трассировку вычисления переменных.
+	Trace OptBool `json:"trace"`
 }
 
-// GetUsers returns the value of Users.
-func (s *UserListResponse) GetUsers() []UserListResponseUsersItem {
-	return s.Users
+// GetTemplateID returns the value of TemplateID.
+func (s *VersionPreviewDraftRequest) GetTemplateID() int64 {
+	return s.TemplateID
 }
 
-// GetTotalUsers returns the value of TotalUsers.
-func (s *UserListResponse) GetTotalUsers() int64 {
-	return s.TotalUsers
+// GetData returns the value of Data.
+func (s *VersionPreviewDraftRequest) GetData() []byte {
+	return s.Data
 }
 
-// GetTotalPages returns the value of TotalPages.
-func (s *UserListResponse) GetTotalPages() int64 {
-	return s.TotalPages
+// GetVariables returns the value of Variables.
+func (s *VersionPreviewDraftRequest) GetVariables() []VersionPreviewDraftRequestVariablesItem {
+	return s.Variables
 }
 
-// SetUsers sets the value of Users.
-func (s *UserListResponse) SetUsers(val []UserListResponseUsersItem) {
-	s.Users = val
+// GetPayload returns the value of Payload.
+func (s *VersionPreviewDraftRequest) GetPayload() VersionPreviewDraftRequestPayload {
+	return s.Payload
 }
 
-// SetTotalUsers sets the value of TotalUsers.
-func (s *UserListResponse) SetTotalUsers(val int64) {
-	s.TotalUsers = val
+// GetTrace returns the value of Trace.
+func (s *VersionPreviewDraftRequest) GetTrace() OptBool {
+	return s.Trace
 }
 
-// SetTotalPages sets the value of TotalPages.
-func (s *UserListResponse) SetTotalPages(val int64) {
-	s.TotalPages = val
+// SetTemplateID sets the value of TemplateID.
+func (s *VersionPreviewDraftRequest) SetTemplateID(val int64) {
+	s.TemplateID = val
 }
 
-func (*UserListResponse) userListRes() {}
-
-// Пользователь.
-type UserListResponseUsersItem struct {
-	// ID пользователя.
-	ID int64 `json:"id"`
-	// Имя пользователя.
-	Name string `json:"name"`
-	// Электронный адрес пользователя.
-	Email string `json:"email"`
+// SetData sets the value of Data.
+func (s *VersionPreviewDraftRequest) SetData(val []byte) {
+	s.Data = val
 }
 
-// GetID returns the value of ID.
-func (s *UserListResponseUsersItem) GetID() int64 {
-	return s.ID
+// SetVariables sets the value of Variables.
+func (s *VersionPreviewDraftRequest) SetVariables(val []VersionPreviewDraftRequestVariablesItem) {
+	s.Variables = val
 }
 
-// GetName returns the value of Name.
-func (s *UserListResponseUsersItem) GetName() string {
-	return s.Name
+// SetPayload sets the value of Payload.
+func (s *VersionPreviewDraftRequest) SetPayload(val VersionPreviewDraftRequestPayload) {
+	s.Payload = val
 }
 
-// GetEmail returns the value of Email.
-func (s *UserListResponseUsersItem) GetEmail() string {
-	return s.Email
+// SetTrace sets the value of Trace.
+func (s *VersionPreviewDraftRequest) SetTrace(val OptBool) {
+	s.Trace = val
 }
 
-// SetID sets the value of ID.
-func (s *UserListResponseUsersItem) SetID(val int64) {
-	s.ID = val
-}
+// Пэйлоад задачи (скаляры строками, списки и таблицы
+// массивами).
+type VersionPreviewDraftRequestPayload map[string]jx.Raw
 
-// SetName sets the value of Name.
-func (s *UserListResponseUsersItem) SetName(val string) {
-	s.Name = val
+func (s *VersionPreviewDraftRequestPayload) init() VersionPreviewDraftRequestPayload {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetEmail sets the value of Email.
-func (s *UserListResponseUsersItem) SetEmail(val string) {
-	s.Email = val
+// Переменная шаблона.
+type VersionPreviewDraftRequestVariablesItem struct {
+	// Слаг переменной (идентификатор).
+	Name string `json:"name"`
+	// Человекочитаемое название переменной.
+	Title string `json:"title"`
+	// Тип переменной.
+	Type VersionPreviewDraftRequestVariablesItemType `json:"type"`
+	// Выражение переменной.
+	Expression OptString `json:"expression"`
+	// Является ли переменная входной.
+	IsInput bool `json:"isInput"`
+	// Выражение значения по умолчанию для необязательной
+	// входной переменной.
+	DefaultExpression OptString `json:"defaultExpression"`
+	// Условие, при котором переменная используется; если
+	// ложно, переменная скрыта и равна nil.
+	EnabledIf OptString `json:"enabledIf"`
+	// Единица измерения числовой переменной, например mm, kN
+	// или m/s^2.
+	Unit OptString `json:"unit"`
+	// Список допустимых значений (для типа enum).
+	Options []string `json:"options"`
+	// Столбец справочника проекта, из которого берутся
+	// допустимые значения (для типа enum) вместо options,
+	// например materials.key.
+	OptionsFrom OptString `json:"optionsFrom"`
+	// Тип элементов (для типа list).
+	ItemType OptVersionPreviewDraftRequestVariablesItemItemType `json:"itemType"`
+	// Список колонок (для типа table).
+	Columns []VersionPreviewDraftRequestVariablesItemColumnsItem `json:"columns"`
+	// Список ограничений переменной.
+	Constraints []VersionPreviewDraftRequestVariablesItemConstraintsItem `json:"constraints"`
 }
 
-// UserTokenCreateCreated is response for UserTokenCreate operation.
-type UserTokenCreateCreated struct {
-	SetCookie string
+// GetName returns the value of Name.
+func (s *VersionPreviewDraftRequestVariablesItem) GetName() string {
+	return s.Name
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *UserTokenCreateCreated) GetSetCookie() string {
-	return s.SetCookie
+// GetTitle returns the value of Title.
+func (s *VersionPreviewDraftRequestVariablesItem) GetTitle() string {
+	return s.Title
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *UserTokenCreateCreated) SetSetCookie(val string) {
-	s.SetCookie = val
+// GetType returns the value of Type.
+func (s *VersionPreviewDraftRequestVariablesItem) GetType() VersionPreviewDraftRequestVariablesItemType {
+	return s.Type
 }
 
-func (*UserTokenCreateCreated) userTokenCreateRes() {}
-
-// Ref: #/components/schemas/UserTokenCreateRequest
-type UserTokenCreateRequest struct {
-	// Имя пользователя.
-	Name string `json:"name"`
-	// Пароль пользователя.
-	Password string `json:"password"`
-	// При true cookie сохраняется между сессиями браузера; при
-	// false выставляется session-cookie.
-	Remember bool `json:"remember"`
+// GetExpression returns the value of Expression.
+func (s *VersionPreviewDraftRequestVariablesItem) GetExpression() OptString {
+	return s.Expression
 }
 
-// GetName returns the value of Name.
-func (s *UserTokenCreateRequest) GetName() string {
-	return s.Name
+// GetIsInput returns the value of IsInput.
+func (s *VersionPreviewDraftRequestVariablesItem) GetIsInput() bool {
+	return s.IsInput
 }
 
-// GetPassword returns the value of Password.
-func (s *UserTokenCreateRequest) GetPassword() string {
-	return s.Password
+// GetDefaultExpression returns the value of DefaultExpression.
+func (s *VersionPreviewDraftRequestVariablesItem) GetDefaultExpression() OptString {
+	return s.DefaultExpression
 }
 
-// GetRemember returns the value of Remember.
-func (s *UserTokenCreateRequest) GetRemember() bool {
-	return s.Remember
+// GetEnabledIf returns the value of EnabledIf.
+func (s *VersionPreviewDraftRequestVariablesItem) GetEnabledIf() OptString {
+	return s.EnabledIf
 }
 
-// SetName sets the value of Name.
-func (s *UserTokenCreateRequest) SetName(val string) {
-	s.Name = val
+// GetUnit returns the value of Unit.
+func (s *VersionPreviewDraftRequestVariablesItem) GetUnit() OptString {
+	return s.Unit
 }
 
-// SetPassword sets the value of Password.
-func (s *UserTokenCreateRequest) SetPassword(val string) {
-	s.Password = val
+// GetOptions returns the value of Options.
+func (s *VersionPreviewDraftRequestVariablesItem) GetOptions() []string {
+	return s.Options
 }
 
-// SetRemember sets the value of Remember.
-func (s *UserTokenCreateRequest) SetRemember(val bool) {
-	s.Remember = val
+// GetOptionsFrom returns the value of OptionsFrom.
+func (s *VersionPreviewDraftRequestVariablesItem) GetOptionsFrom() OptString {
+	return s.OptionsFrom
 }
 
-// UserTokenDeleteNoContent is response for UserTokenDelete operation.
-type UserTokenDeleteNoContent struct {
-	SetCookie string
+// GetItemType returns the value of ItemType.
+func (s *VersionPreviewDraftRequestVariablesItem) GetItemType() OptVersionPreviewDraftRequestVariablesItemItemType {
+	return s.ItemType
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *UserTokenDeleteNoContent) GetSetCookie() string {
-	return s.SetCookie
+// GetColumns returns the value of Columns.
+func (s *VersionPreviewDraftRequestVariablesItem) GetColumns() []VersionPreviewDraftRequestVariablesItemColumnsItem {
+	return s.Columns
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *UserTokenDeleteNoContent) SetSetCookie(val string) {
-	s.SetCookie = val
+// GetConstraints returns the value of Constraints.
+func (s *VersionPreviewDraftRequestVariablesItem) GetConstraints() []VersionPreviewDraftRequestVariablesItemConstraintsItem {
+	return s.Constraints
 }
 
-// VersionCreateFromCreated is response for VersionCreateFrom operation.
-type VersionCreateFromCreated struct{}
-
-func (*VersionCreateFromCreated) versionCreateFromRes() {}
-
-// Ref: #/components/schemas/VersionCreateFromRequest
-type VersionCreateFromRequest struct {
-	// ID шаблона.
-	TemplateID int64 `json:"templateID"`
-	// ID версии.
-	VersionID int64 `json:"versionID"`
+// SetName sets the value of Name.
+func (s *VersionPreviewDraftRequestVariablesItem) SetName(val string) {
+	s.Name = val
 }
 
-// GetTemplateID returns the value of TemplateID.
-func (s *VersionCreateFromRequest) GetTemplateID() int64 {
-	return s.TemplateID
+// SetTitle sets the value of Title.
+func (s *VersionPreviewDraftRequestVariablesItem) SetTitle(val string) {
+	s.Title = val
 }
 
-// GetVersionID returns the value of VersionID.
-func (s *VersionCreateFromRequest) GetVersionID() int64 {
-	return s.VersionID
+// SetType sets the value of Type.
+func (s *VersionPreviewDraftRequestVariablesItem) SetType(val VersionPreviewDraftRequestVariablesItemType) {
+	s.Type = val
 }
 
-// SetTemplateID sets the value of TemplateID.
-func (s *VersionCreateFromRequest) SetTemplateID(val int64) {
-	s.TemplateID = val
+// SetExpression sets the value of Expression.
+func (s *VersionPreviewDraftRequestVariablesItem) SetExpression(val OptString) {
+	s.Expression = val
 }
 
-// SetVersionID sets the value of VersionID.
-func (s *VersionCreateFromRequest) SetVersionID(val int64) {
-	s.VersionID = val
+// SetIsInput sets the value of IsInput.
+func (s *VersionPreviewDraftRequestVariablesItem) SetIsInput(val bool) {
+	s.IsInput = val
 }
 
-// Ref: #/components/schemas/VersionCreateRequest
-type VersionCreateRequest struct {
-	// ID шаблона.
-	TemplateID int64 `json:"templateID"`
-	// Данные шаблона.
-	Data []byte `json:"data"`
-	// Список переменных шаблона.
-	Variables []VersionCreateRequestVariablesItem `json:"variables"`
+// SetDefaultExpression sets the value of DefaultExpression.
+func (s *VersionPreviewDraftRequestVariablesItem) SetDefaultExpression(val OptString) {
+	s.DefaultExpression = val
 }
 
-// GetTemplateID returns the value of TemplateID.
-func (s *VersionCreateRequest) GetTemplateID() int64 {
-	return s.TemplateID
+// SetEnabledIf sets the value of EnabledIf.
+func (s *VersionPreviewDraftRequestVariablesItem) SetEnabledIf(val OptString) {
+	s.EnabledIf = val
 }
 
-// GetData returns the value of Data.
-func (s *VersionCreateRequest) GetData() []byte {
-	return s.Data
+// SetUnit sets the value of Unit.
+func (s *VersionPreviewDraftRequestVariablesItem) SetUnit(val OptString) {
+	s.Unit = val
 }
 
-// GetVariables returns the value of Variables.
-func (s *VersionCreateRequest) GetVariables() []VersionCreateRequestVariablesItem {
-	return s.Variables
+// SetOptions sets the value of Options.
+func (s *VersionPreviewDraftRequestVariablesItem) SetOptions(val []string) {
+	s.Options = val
 }
 
-// SetTemplateID sets the value of TemplateID.
-func (s *VersionCreateRequest) SetTemplateID(val int64) {
-	s.TemplateID = val
+// SetOptionsFrom sets the value of OptionsFrom.
+func (s *VersionPreviewDraftRequestVariablesItem) SetOptionsFrom(val OptString) {
+	s.OptionsFrom = val
 }
 
-// SetData sets the value of Data.
-func (s *VersionCreateRequest) SetData(val []byte) {
-	s.Data = val
+// SetItemType sets the value of ItemType.
+func (s *VersionPreviewDraftRequestVariablesItem) SetItemType(val OptVersionPreviewDraftRequestVariablesItemItemType) {
+	s.ItemType = val
 }
 
-// SetVariables sets the value of Variables.
-func (s *VersionCreateRequest) SetVariables(val []VersionCreateRequestVariablesItem) {
-	s.Variables = val
+// SetColumns sets the value of Columns.
+func (s *VersionPreviewDraftRequestVariablesItem) SetColumns(val []VersionPreviewDraftRequestVariablesItemColumnsItem) {
+	s.Columns = val
 }
 
-// Переменная шаблона.
-type VersionCreateRequestVariablesItem struct {
-	// Слаг переменной (идентификатор).
+// SetConstraints sets the value of Constraints.
+func (s *VersionPreviewDraftRequestVariablesItem) SetConstraints(val []VersionPreviewDraftRequestVariablesItemConstraintsItem) {
+	s.Constraints = val
+}
+
+// Колонка таблицы.
+type VersionPreviewDraftRequestVariablesItemColumnsItem struct {
+	// Слаг колонки (идентификатор).
 	Name string `json:"name"`
-	// Человекочитаемое название переменной.
+	// Человекочитаемое название колонки.
 	Title string `json:"title"`
-	// Тип переменной.
-	Type VersionCreateRequestVariablesItemType `json:"type"`
-	// Выражение переменной.
-	Expression OptString `json:"expression"`
-	// Является ли переменная входной.
-	IsInput bool `json:"isInput"`
-	// Список ограничений переменной.
-	Constraints []VersionCreateRequestVariablesItemConstraintsItem `json:"constraints"`
+	// Тип колонки.
+	Type VersionPreviewDraftRequestVariablesItemColumnsItemType `json:"type"`
+	// Список допустимых значений (для типа enum).
+	Options []string `json:"options"`
 }
 
 // GetName returns the value of Name.
-func (s *VersionCreateRequestVariablesItem) GetName() string {
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) GetName() string {
 	return s.Name
 }
 
 // GetTitle returns the value of Title.
-func (s *VersionCreateRequestVariablesItem) GetTitle() string {
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) GetTitle() string {
 	return s.Title
 }
 
 // GetType returns the value of Type.
-func (s *VersionCreateRequestVariablesItem) GetType() VersionCreateRequestVariablesItemType {
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) GetType() VersionPreviewDraftRequestVariablesItemColumnsItemType {
 	return s.Type
 }
 
-// GetExpression returns the value of Expression.
-func (s *VersionCreateRequestVariablesItem) GetExpression() OptString {
-	return s.Expression
-}
-
-// GetIsInput returns the value of IsInput.
-func (s *VersionCreateRequestVariablesItem) GetIsInput() bool {
-	return s.IsInput
-}
-
-// GetConstraints returns the value of Constraints.
-func (s *VersionCreateRequestVariablesItem) GetConstraints() []VersionCreateRequestVariablesItemConstraintsItem {
-	return s.Constraints
+// GetOptions returns the value of Options.
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) GetOptions() []string {
+	return s.Options
 }
 
 // SetName sets the value of Name.
-func (s *VersionCreateRequestVariablesItem) SetName(val string) {
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) SetName(val string) {
 	s.Name = val
 }
 
 // SetTitle sets the value of Title.
-func (s *VersionCreateRequestVariablesItem) SetTitle(val string) {
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) SetTitle(val string) {
 	s.Title = val
 }
 
 // SetType sets the value of Type.
-func (s *VersionCreateRequestVariablesItem) SetType(val VersionCreateRequestVariablesItemType) {
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) SetType(val VersionPreviewDraftRequestVariablesItemColumnsItemType) {
 	s.Type = val
 }
 
-// SetExpression sets the value of Expression.
-func (s *VersionCreateRequestVariablesItem) SetExpression(val OptString) {
-	s.Expression = val
+// SetOptions sets the value of Options.
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItem) SetOptions(val []string) {
+	s.Options = val
 }
 
-// SetIsInput sets the value of IsInput.
-func (s *VersionCreateRequestVariablesItem) SetIsInput(val bool) {
-	s.IsInput = val
+// Тип колонки.
+type VersionPreviewDraftRequestVariablesItemColumnsItemType string
+
+const (
+	VersionPreviewDraftRequestVariablesItemColumnsItemTypeString  VersionPreviewDraftRequestVariablesItemColumnsItemType = "string"
+	VersionPreviewDraftRequestVariablesItemColumnsItemTypeInteger VersionPreviewDraftRequestVariablesItemColumnsItemType = "integer"
+	VersionPreviewDraftRequestVariablesItemColumnsItemTypeFloat   VersionPreviewDraftRequestVariablesItemColumnsItemType = "float"
+	VersionPreviewDraftRequestVariablesItemColumnsItemTypeDecimal VersionPreviewDraftRequestVariablesItemColumnsItemType = "decimal"
+	VersionPreviewDraftRequestVariablesItemColumnsItemTypeBoolean VersionPreviewDraftRequestVariablesItemColumnsItemType = "boolean"
+	VersionPreviewDraftRequestVariablesItemColumnsItemTypeDate    VersionPreviewDraftRequestVariablesItemColumnsItemType = "date"
+	VersionPreviewDraftRequestVariablesItemColumnsItemTypeEnum    VersionPreviewDraftRequestVariablesItemColumnsItemType = "enum"
+)
+
+// AllValues returns all VersionPreviewDraftRequestVariablesItemColumnsItemType values.
+func (VersionPreviewDraftRequestVariablesItemColumnsItemType) AllValues() []VersionPreviewDraftRequestVariablesItemColumnsItemType {
+	return []VersionPreviewDraftRequestVariablesItemColumnsItemType{
+		VersionPreviewDraftRequestVariablesItemColumnsItemTypeString,
+		VersionPreviewDraftRequestVariablesItemColumnsItemTypeInteger,
+		VersionPreviewDraftRequestVariablesItemColumnsItemTypeFloat,
+		VersionPreviewDraftRequestVariablesItemColumnsItemTypeDecimal,
+		VersionPreviewDraftRequestVariablesItemColumnsItemTypeBoolean,
+		VersionPreviewDraftRequestVariablesItemColumnsItemTypeDate,
+		VersionPreviewDraftRequestVariablesItemColumnsItemTypeEnum,
+	}
 }
 
-// SetConstraints sets the value of Constraints.
-func (s *VersionCreateRequestVariablesItem) SetConstraints(val []VersionCreateRequestVariablesItemConstraintsItem) {
-	s.Constraints = val
+// MarshalText implements encoding.TextMarshaler.
+func (s VersionPreviewDraftRequestVariablesItemColumnsItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeString:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeInteger:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeFloat:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeDecimal:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeBoolean:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeDate:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeEnum:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *VersionPreviewDraftRequestVariablesItemColumnsItemType) UnmarshalText(data []byte) error {
+	switch VersionPreviewDraftRequestVariablesItemColumnsItemType(data) {
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeString:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeString
+		return nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeInteger:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeInteger
+		return nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeFloat:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeFloat
+		return nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeDecimal:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeDecimal
+		return nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeBoolean:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeBoolean
+		return nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeDate:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeDate
+		return nil
+	case VersionPreviewDraftRequestVariablesItemColumnsItemTypeEnum:
+		*s = VersionPreviewDraftRequestVariablesItemColumnsItemTypeEnum
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
 // Ограничение переменной.
-type VersionCreateRequestVariablesItemConstraintsItem struct {
+type VersionPreviewDraftRequestVariablesItemConstraintsItem struct {
 	// Название ограничения.
 	Name string `json:"name"`
 	// Выражение ограничения.
@@ -2990,61 +6523,77 @@ type VersionCreateRequestVariablesItemConstraintsItem struct {
 }
 
 // GetName returns the value of Name.
-func (s *VersionCreateRequestVariablesItemConstraintsItem) GetName() string {
+func (s *VersionPreviewDraftRequestVariablesItemConstraintsItem) GetName() string {
 	return s.Name
 }
 
 // GetExpression returns the value of Expression.
-func (s *VersionCreateRequestVariablesItemConstraintsItem) GetExpression() string {
+func (s *VersionPreviewDraftRequestVariablesItemConstraintsItem) GetExpression() string {
 	return s.Expression
 }
 
 // GetIsActive returns the value of IsActive.
-func (s *VersionCreateRequestVariablesItemConstraintsItem) GetIsActive() bool {
+func (s *VersionPreviewDraftRequestVariablesItemConstraintsItem) GetIsActive() bool {
 	return s.IsActive
 }
 
 // SetName sets the value of Name.
-func (s *VersionCreateRequestVariablesItemConstraintsItem) SetName(val string) {
+func (s *VersionPreviewDraftRequestVariablesItemConstraintsItem) SetName(val string) {
 	s.Name = val
 }
 
 // SetExpression sets the value of Expression.
-func (s *VersionCreateRequestVariablesItemConstraintsItem) SetExpression(val string) {
+func (s *VersionPreviewDraftRequestVariablesItemConstraintsItem) SetExpression(val string) {
 	s.Expression = val
 }
 
 // SetIsActive sets the value of IsActive.
-func (s *VersionCreateRequestVariablesItemConstraintsItem) SetIsActive(val bool) {
+func (s *VersionPreviewDraftRequestVariablesItemConstraintsItem) SetIsActive(val bool) {
 	s.IsActive = val
 }
 
-// Тип переменной.
-type VersionCreateRequestVariablesItemType string
+// Тип элементов (для типа list).
+type VersionPreviewDraftRequestVariablesItemItemType string
 
 const (
-	VersionCreateRequestVariablesItemTypeString  VersionCreateRequestVariablesItemType = "string"
-	VersionCreateRequestVariablesItemTypeInteger VersionCreateRequestVariablesItemType = "integer"
-	VersionCreateRequestVariablesItemTypeFloat   VersionCreateRequestVariablesItemType = "float"
+	VersionPreviewDraftRequestVariablesItemItemTypeString  VersionPreviewDraftRequestVariablesItemItemType = "string"
+	VersionPreviewDraftRequestVariablesItemItemTypeInteger VersionPreviewDraftRequestVariablesItemItemType = "integer"
+	VersionPreviewDraftRequestVariablesItemItemTypeFloat   VersionPreviewDraftRequestVariablesItemItemType = "float"
+	VersionPreviewDraftRequestVariablesItemItemTypeDecimal VersionPreviewDraftRequestVariablesItemItemType = "decimal"
+	VersionPreviewDraftRequestVariablesItemItemTypeBoolean VersionPreviewDraftRequestVariablesItemItemType = "boolean"
+	VersionPreviewDraftRequestVariablesItemItemTypeDate    VersionPreviewDraftRequestVariablesItemItemType = "date"
+	VersionPreviewDraftRequestVariablesItemItemTypeEnum    VersionPreviewDraftRequestVariablesItemItemType = "enum"
 )
 
-// AllValues returns all VersionCreateRequestVariablesItemType values.
-func (VersionCreateRequestVariablesItemType) AllValues() []VersionCreateRequestVariablesItemType {
-	return []VersionCreateRequestVariablesItemType{
-		VersionCreateRequestVariablesItemTypeString,
-		VersionCreateRequestVariablesItemTypeInteger,
-		VersionCreateRequestVariablesItemTypeFloat,
+// AllValues returns all VersionPreviewDraftRequestVariablesItemItemType values.
+func (VersionPreviewDraftRequestVariablesItemItemType) AllValues() []VersionPreviewDraftRequestVariablesItemItemType {
+	return []VersionPreviewDraftRequestVariablesItemItemType{
+		VersionPreviewDraftRequestVariablesItemItemTypeString,
+		VersionPreviewDraftRequestVariablesItemItemTypeInteger,
+		VersionPreviewDraftRequestVariablesItemItemTypeFloat,
+		VersionPreviewDraftRequestVariablesItemItemTypeDecimal,
+		VersionPreviewDraftRequestVariablesItemItemTypeBoolean,
+		VersionPreviewDraftRequestVariablesItemItemTypeDate,
+		VersionPreviewDraftRequestVariablesItemItemTypeEnum,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s VersionCreateRequestVariablesItemType) MarshalText() ([]byte, error) {
+func (s VersionPreviewDraftRequestVariablesItemItemType) MarshalText() ([]byte, error) {
 	switch s {
-	case VersionCreateRequestVariablesItemTypeString:
+	case VersionPreviewDraftRequestVariablesItemItemTypeString:
 		return []byte(s), nil
-	case VersionCreateRequestVariablesItemTypeInteger:
+	case VersionPreviewDraftRequestVariablesItemItemTypeInteger:
 		return []byte(s), nil
-	case VersionCreateRequestVariablesItemTypeFloat:
+	case VersionPreviewDraftRequestVariablesItemItemTypeFloat:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemItemTypeDecimal:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemItemTypeBoolean:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemItemTypeDate:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemItemTypeEnum:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -3052,106 +6601,217 @@ func (s VersionCreateRequestVariablesItemType) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *VersionCreateRequestVariablesItemType) UnmarshalText(data []byte) error {
-	switch VersionCreateRequestVariablesItemType(data) {
-	case VersionCreateRequestVariablesItemTypeString:
-		*s = VersionCreateRequestVariablesItemTypeString
+func (s *VersionPreviewDraftRequestVariablesItemItemType) UnmarshalText(data []byte) error {
+	switch VersionPreviewDraftRequestVariablesItemItemType(data) {
+	case VersionPreviewDraftRequestVariablesItemItemTypeString:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeString
 		return nil
-	case VersionCreateRequestVariablesItemTypeInteger:
-		*s = VersionCreateRequestVariablesItemTypeInteger
+	case VersionPreviewDraftRequestVariablesItemItemTypeInteger:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeInteger
 		return nil
-	case VersionCreateRequestVariablesItemTypeFloat:
-		*s = VersionCreateRequestVariablesItemTypeFloat
+	case VersionPreviewDraftRequestVariablesItemItemTypeFloat:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeFloat
+		return nil
+	case VersionPreviewDraftRequestVariablesItemItemTypeDecimal:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeDecimal
+		return nil
+	case VersionPreviewDraftRequestVariablesItemItemTypeBoolean:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeBoolean
+		return nil
+	case VersionPreviewDraftRequestVariablesItemItemTypeDate:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeDate
+		return nil
+	case VersionPreviewDraftRequestVariablesItemItemTypeEnum:
+		*s = VersionPreviewDraftRequestVariablesItemItemTypeEnum
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Ref: #/components/schemas/VersionCreateResponse
-type VersionCreateResponse struct {
-	// ID версии.
-	ID int64 `json:"id"`
+// Тип переменной.
+type VersionPreviewDraftRequestVariablesItemType string
+
+const (
+	VersionPreviewDraftRequestVariablesItemTypeString  VersionPreviewDraftRequestVariablesItemType = "string"
+	VersionPreviewDraftRequestVariablesItemTypeInteger VersionPreviewDraftRequestVariablesItemType = "integer"
+	VersionPreviewDraftRequestVariablesItemTypeFloat   VersionPreviewDraftRequestVariablesItemType = "float"
+	VersionPreviewDraftRequestVariablesItemTypeDecimal VersionPreviewDraftRequestVariablesItemType = "decimal"
+	VersionPreviewDraftRequestVariablesItemTypeBoolean VersionPreviewDraftRequestVariablesItemType = "boolean"
+	VersionPreviewDraftRequestVariablesItemTypeDate    VersionPreviewDraftRequestVariablesItemType = "date"
+	VersionPreviewDraftRequestVariablesItemTypeEnum    VersionPreviewDraftRequestVariablesItemType = "enum"
+	VersionPreviewDraftRequestVariablesItemTypeList    VersionPreviewDraftRequestVariablesItemType = "list"
+	VersionPreviewDraftRequestVariablesItemTypeTable   VersionPreviewDraftRequestVariablesItemType = "table"
+)
+
+// AllValues returns all VersionPreviewDraftRequestVariablesItemType values.
+func (VersionPreviewDraftRequestVariablesItemType) AllValues() []VersionPreviewDraftRequestVariablesItemType {
+	return []VersionPreviewDraftRequestVariablesItemType{
+		VersionPreviewDraftRequestVariablesItemTypeString,
+		VersionPreviewDraftRequestVariablesItemTypeInteger,
+		VersionPreviewDraftRequestVariablesItemTypeFloat,
+		VersionPreviewDraftRequestVariablesItemTypeDecimal,
+		VersionPreviewDraftRequestVariablesItemTypeBoolean,
+		VersionPreviewDraftRequestVariablesItemTypeDate,
+		VersionPreviewDraftRequestVariablesItemTypeEnum,
+		VersionPreviewDraftRequestVariablesItemTypeList,
+		VersionPreviewDraftRequestVariablesItemTypeTable,
+	}
 }
 
-// GetID returns the value of ID.
-func (s *VersionCreateResponse) GetID() int64 {
-	return s.ID
+// MarshalText implements encoding.TextMarshaler.
+func (s VersionPreviewDraftRequestVariablesItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case VersionPreviewDraftRequestVariablesItemTypeString:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemTypeInteger:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemTypeFloat:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemTypeDecimal:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemTypeBoolean:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemTypeDate:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemTypeEnum:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemTypeList:
+		return []byte(s), nil
+	case VersionPreviewDraftRequestVariablesItemTypeTable:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetID sets the value of ID.
-func (s *VersionCreateResponse) SetID(val int64) {
-	s.ID = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *VersionPreviewDraftRequestVariablesItemType) UnmarshalText(data []byte) error {
+	switch VersionPreviewDraftRequestVariablesItemType(data) {
+	case VersionPreviewDraftRequestVariablesItemTypeString:
+		*s = VersionPreviewDraftRequestVariablesItemTypeString
+		return nil
+	case VersionPreviewDraftRequestVariablesItemTypeInteger:
+		*s = VersionPreviewDraftRequestVariablesItemTypeInteger
+		return nil
+	case VersionPreviewDraftRequestVariablesItemTypeFloat:
+		*s = VersionPreviewDraftRequestVariablesItemTypeFloat
+		return nil
+	case VersionPreviewDraftRequestVariablesItemTypeDecimal:
+		*s = VersionPreviewDraftRequestVariablesItemTypeDecimal
+		return nil
+	case VersionPreviewDraftRequestVariablesItemTypeBoolean:
+		*s = VersionPreviewDraftRequestVariablesItemTypeBoolean
+		return nil
+	case VersionPreviewDraftRequestVariablesItemTypeDate:
+		*s = VersionPreviewDraftRequestVariablesItemTypeDate
+		return nil
+	case VersionPreviewDraftRequestVariablesItemTypeEnum:
+		*s = VersionPreviewDraftRequestVariablesItemTypeEnum
+		return nil
+	case VersionPreviewDraftRequestVariablesItemTypeList:
+		*s = VersionPreviewDraftRequestVariablesItemTypeList
+		return nil
+	case VersionPreviewDraftRequestVariablesItemTypeTable:
+		*s = VersionPreviewDraftRequestVariablesItemTypeTable
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-func (*VersionCreateResponse) versionCreateRes() {}
+// Ref: #/components/schemas/VersionPreviewRequest
+type VersionPreviewRequest struct {
+	// ID версии шаблона.
+	VersionID int64 `json:"versionID"`
+	// Пэйлоад задачи (скаляры строками, списки и таблицы
+	// массивами).
+	Payload VersionPreviewRequestPayload `json:"payload"`
+	// Вернуть трассировку вычисления переменных.
+	Trace OptBool `json:"trace"`
+}
 
-// Ref: #/components/schemas/VersionListResponse
-type VersionListResponse struct {
-	// Список версий.
-	Versions []VersionListResponseVersionsItem `json:"versions"`
+// GetVersionID returns the value of VersionID.
+func (s *VersionPreviewRequest) GetVersionID() int64 {
+	return s.VersionID
 }
 
-// GetVersions returns the value of Versions.
-func (s *VersionListResponse) GetVersions() []VersionListResponseVersionsItem {
-	return s.Versions
+// GetPayload returns the value of Payload.
+func (s *VersionPreviewRequest) GetPayload() VersionPreviewRequestPayload {
+	return s.Payload
 }
 
-// SetVersions sets the value of Versions.
-func (s *VersionListResponse) SetVersions(val []VersionListResponseVersionsItem) {
-	s.Versions = val
+// GetTrace returns the value of Trace.
+func (s *VersionPreviewRequest) GetTrace() OptBool {
+	return s.Trace
 }
 
-func (*VersionListResponse) versionListRes() {}
+// SetVersionID sets the value of VersionID.
+func (s *VersionPreviewRequest) SetVersionID(val int64) {
+	s.VersionID = val
+}
 
-// Версия шаблона.
-type VersionListResponseVersionsItem struct {
-	// ID версии.
-	ID int64 `json:"id"`
-	// Номер версии.
-	Number int64 `json:"number"`
-	// Имя автора версии.
-	AuthorName string `json:"authorName"`
-	// Дата и время создания версии.
-	CreatedAt time.Time `json:"createdAt"`
+// SetPayload sets the value of Payload.
+func (s *VersionPreviewRequest) SetPayload(val VersionPreviewRequestPayload) {
+	s.Payload = val
 }
 
-// GetID returns the value of ID.
-func (s *VersionListResponseVersionsItem) GetID() int64 {
-	return s.ID
+// SetTrace sets the value of Trace.
+func (s *VersionPreviewRequest) SetTrace(val OptBool) {
+	s.Trace = val
 }
 
-// GetNumber returns the value of Number.
-func (s *VersionListResponseVersionsItem) GetNumber() int64 {
-	return s.Number
+// Пэйлоад задачи (скаляры строками, списки и таблицы
+// массивами).
+type VersionPreviewRequestPayload map[string]jx.Raw
+
+func (s *VersionPreviewRequestPayload) init() VersionPreviewRequestPayload {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// GetAuthorName returns the value of AuthorName.
-func (s *VersionListResponseVersionsItem) GetAuthorName() string {
-	return s.AuthorName
+// Результат генерации либо ошибка обработки, с которой
+// завершилась бы задача.
+// Ref: #/components/schemas/VersionPreviewResponse
+type VersionPreviewResponse struct {
+	Result []byte          `json:"result"`
+	Error  OptProcessError `json:"error"`
+	Trace  Trace           `json:"trace"`
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *VersionListResponseVersionsItem) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetResult returns the value of Result.
+func (s *VersionPreviewResponse) GetResult() []byte {
+	return s.Result
 }
 
-// SetID sets the value of ID.
-func (s *VersionListResponseVersionsItem) SetID(val int64) {
-	s.ID = val
+// GetError returns the value of Error.
+func (s *VersionPreviewResponse) GetError() OptProcessError {
+	return s.Error
 }
 
-// SetNumber sets the value of Number.
-func (s *VersionListResponseVersionsItem) SetNumber(val int64) {
-	s.Number = val
+// GetTrace returns the value of Trace.
+func (s *VersionPreviewResponse) GetTrace() Trace {
+	return s.Trace
 }
 
-// SetAuthorName sets the value of AuthorName.
-func (s *VersionListResponseVersionsItem) SetAuthorName(val string) {
-	s.AuthorName = val
+// SetResult sets the value of Result.
+func (s *VersionPreviewResponse) SetResult(val []byte) {
+	s.Result = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *VersionListResponseVersionsItem) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetError sets the value of Error.
+func (s *VersionPreviewResponse) SetError(val OptProcessError) {
+	s.Error = val
+}
+
+// SetTrace sets the value of Trace.
+func (s *VersionPreviewResponse) SetTrace(val Trace) {
+	s.Trace = val
 }
+
+func (*VersionPreviewResponse) versionPreviewDraftRes() {}
+func (*VersionPreviewResponse) versionPreviewRes()      {}
@@ -8,10 +8,21 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	ProjectConstantListHandler
+	ProjectConstantSaveHandler
 	ProjectCreateHandler
 	ProjectDeleteByIDHandler
+	ProjectDictionaryDeleteByIDHandler
+	ProjectDictionaryGetByIDHandler
+	ProjectDictionaryImportHandler
+	ProjectDictionaryListHandler
+	ProjectDictionarySaveHandler
+	ProjectFunctionListHandler
+	ProjectFunctionSaveHandler
 	ProjectGetByIDHandler
 	ProjectListHandler
+	ProjectPartialListHandler
+	ProjectPartialSaveHandler
 	ProjectUpdateByIDHandler
 	ProjectUpdateUsersHandler
 	ProjectUsersHandler
@@ -37,6 +48,34 @@ type Handler interface {
 	VersionCreateHandler
 	VersionCreateFromHandler
 	VersionListHandler
+	VersionPreviewHandler
+	VersionPreviewDraftHandler
+}
+
+// ProjectConstantListHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: ProjectConstantList
+type ProjectConstantListHandler interface {
+	// ProjectConstantList implements projectConstantList operation.
+	//
+	// Получить список констант проекта.
+	//
+	// GET /project/constant/list/{projectID}
+	ProjectConstantList(ctx context.Context, params ProjectConstantListParams) (ProjectConstantListRes, error)
+}
+
+// ProjectConstantSaveHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: ProjectConstantSave
+type ProjectConstantSaveHandler interface {
+	// ProjectConstantSave implements projectConstantSave operation.
+	//
+	// Новые значения используются в задачах, созданных
+	// после сохранения. Обработанные задачи сохраняют
+	// значения, с которыми они были сформированы.
+	//
+	// POST /project/constant/save/{projectID}
+	ProjectConstantSave(ctx context.Context, req *ProjectConstantSaveRequest, params ProjectConstantSaveParams) (ProjectConstantSaveRes, error)
 }
 
 // ProjectCreateHandler handles operations described by OpenAPI v3 specification.
@@ -63,6 +102,108 @@ type ProjectDeleteByIDHandler interface {
 	ProjectDeleteByID(ctx context.Context, params ProjectDeleteByIDParams) (ProjectDeleteByIDRes, error)
 }
 
+// ProjectDictionaryDeleteByIDHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: ProjectDictionaryDeleteByID
+type ProjectDictionaryDeleteByIDHandler interface {
+	// ProjectDictionaryDeleteByID implements projectDictionaryDeleteByID operation.
+	//
+	// Справочник перестает быть доступен шаблонам, но его
+	// версии сохраняются,
+	// чтобы ранее созданные задачи генерировались так же.
+	//
+	// DELETE /project/dictionary/delete/{dictionaryID}
+	ProjectDictionaryDeleteByID(ctx context.Context, params ProjectDictionaryDeleteByIDParams) (ProjectDictionaryDeleteByIDRes, error)
+}
+
+// ProjectDictionaryGetByIDHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: ProjectDictionaryGetByID
+type ProjectDictionaryGetByIDHandler interface {
+	// ProjectDictionaryGetByID implements projectDictionaryGetByID operation.
+	//
+	// Получить справочник проекта по ID.
+	//
+	// GET /project/dictionary/get/{dictionaryID}
+	ProjectDictionaryGetByID(ctx context.Context, params ProjectDictionaryGetByIDParams) (ProjectDictionaryGetByIDRes, error)
+}
+
+// ProjectDictionaryImportHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: ProjectDictionaryImport
+type ProjectDictionaryImportHandler interface {
+	// ProjectDictionaryImport implements projectDictionaryImport operation.
+	//
+	// Создает справочник проекта или новую версию
+	// справочника с тем же названием из CSV.
+	// Первая строка — заголовок: первый столбец содержит
+	// ключи, остальные задаются как
+	// name или name:type. Столбец без типа сохраняет тип из
+	// текущей версии справочника
+	// или становится строковым. Разделитель — запятая или
+	// точка с запятой; в числах
+	// допускается десятичная запятая.
+	//
+	// POST /project/dictionary/import/{projectID}
+	ProjectDictionaryImport(ctx context.Context, req *ProjectDictionaryImportRequest, params ProjectDictionaryImportParams) (ProjectDictionaryImportRes, error)
+}
+
+// ProjectDictionaryListHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: ProjectDictionaryList
+type ProjectDictionaryListHandler interface {
+	// ProjectDictionaryList implements projectDictionaryList operation.
+	//
+	// Получить список справочников проекта.
+	//
+	// GET /project/dictionary/list/{projectID}
+	ProjectDictionaryList(ctx context.Context, params ProjectDictionaryListParams) (ProjectDictionaryListRes, error)
+}
+
+// ProjectDictionarySaveHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: ProjectDictionarySave
+type ProjectDictionarySaveHandler interface {
+	// ProjectDictionarySave implements projectDictionarySave operation.
+	//
+	// Создает справочник проекта или новую версию
+	// справочника с тем же названием.
+	// Выражения переменных читают справочник функцией
+	// lookup(dict, key, column),
+	// а перечисления могут брать из его столбца допустимые
+	// значения.
+	//
+	// POST /project/dictionary/save/{projectID}
+	ProjectDictionarySave(ctx context.Context, req *ProjectDictionarySaveRequest, params ProjectDictionarySaveParams) (ProjectDictionarySaveRes, error)
+}
+
+// ProjectFunctionListHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: ProjectFunctionList
+type ProjectFunctionListHandler interface {
+	// ProjectFunctionList implements projectFunctionList operation.
+	//
+	// Получить список функций проекта.
+	//
+	// GET /project/function/list/{projectID}
+	ProjectFunctionList(ctx context.Context, params ProjectFunctionListParams) (ProjectFunctionListRes, error)
+}
+
+// ProjectFunctionSaveHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: ProjectFunctionSave
+type ProjectFunctionSaveHandler interface {
+	// ProjectFunctionSave implements projectFunctionSave operation.
+	//
+	// Создает функцию проекта или новую версию функции с
+	// тем же названием.
+	// Функцию могут вызывать выражения переменных всех
+	// шаблонов проекта.
+	//
+	// POST /project/function/save/{projectID}
+	ProjectFunctionSave(ctx context.Context, req *ProjectFunctionSaveRequest, params ProjectFunctionSaveParams) (ProjectFunctionSaveRes, error)
+}
+
 // ProjectGetByIDHandler handles operations described by OpenAPI v3 specification.
 //
 // x-ogen-operation-group: ProjectGetByID
@@ -87,6 +228,37 @@ type ProjectListHandler interface {
 	ProjectList(ctx context.Context, params ProjectListParams) (ProjectListRes, error)
 }
 
+// ProjectPartialListHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: ProjectPartialList
+type ProjectPartialListHandler interface {
+	// ProjectPartialList implements projectPartialList operation.
+	//
+	// Получить список фрагментов проекта.
+	//
+	// GET /project/partial/list/{projectID}
+	ProjectPartialList(ctx context.Context, params ProjectPartialListParams) (ProjectPartialListRes, error)
+}
+
+// ProjectPartialSaveHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: ProjectPartialSave
+type ProjectPartialSaveHandler interface {
+	// ProjectPartialSave implements projectPartialSave operation.
+	//
+	// Создает фрагмент проекта или новую версию фрагмента
+	// с тем же названием.
+	// Шаблоны проекта вызывают фрагмент как {{ template
+	// "название" . }} и могут
+	// переопределять объявленные в нем блоки ({{ block }}), что
+	// делает фрагмент макетом.
+	// Версия шаблона сохраняется с версиями фрагментов,
+	// которые она вызывает.
+	//
+	// POST /project/partial/save/{projectID}
+	ProjectPartialSave(ctx context.Context, req *ProjectPartialSaveRequest, params ProjectPartialSaveParams) (ProjectPartialSaveRes, error)
+}
+
 // ProjectUpdateByIDHandler handles operations described by OpenAPI v3 specification.
 //
 // x-ogen-operation-group: ProjectUpdateByID
@@ -387,6 +559,32 @@ type VersionListHandler interface {
 	VersionList(ctx context.Context, params VersionListParams) (VersionListRes, error)
 }
 
+// VersionPreviewHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: VersionPreview
+type VersionPreviewHandler interface {
+	// VersionPreview implements versionPreview operation.
+	//
+	// Сгенерировать документ по версии шаблона без
+	// создания задачи.
+	//
+	// POST /version/preview
+	VersionPreview(ctx context.Context, req *VersionPreviewRequest, params VersionPreviewParams) (VersionPreviewRes, error)
+}
+
+// VersionPreviewDraftHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: VersionPreviewDraft
+type VersionPreviewDraftHandler interface {
+	// VersionPreviewDraft implements versionPreviewDraft operation.
+	//
+	// Сгенерировать документ по несохранённому черновику
+	// версии шаблона.
+	//
+	// POST /version/preview_draft
+	VersionPreviewDraft(ctx context.Context, req *VersionPreviewDraftRequest, params VersionPreviewDraftParams) (VersionPreviewDraftRes, error)
+}
+
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
@@ -0,0 +1,301 @@
+package docx
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/markdown"
+)
+
+// numbering instances, see writer.numbering
+const (
+	numHeadings = 1
+	numBullets  = 2
+	// every ordered list has an instance of its own from numOrdered on, so
+	// that it is numbered from its start
+	numOrdered = 3
+)
+
+const (
+	pageWidth  = 210
+	pageHeight = 297
+	// maxListLevel is the deepest list level of the numbering definitions.
+	maxListLevel = 8
+)
+
+// headingNumberRe matches the number written before a heading, such as "4.1."
+var headingNumberRe = regexp.MustCompile(`^\d+(\.\d+)*\.?\s+`)
+
+type writer struct {
+	profile output_domain.Profile
+	body    strings.Builder
+	// links are the hyperlink targets in the order of their relationships
+	links     []string
+	linkIDs   map[string]int
+	ordered   []orderedList
+	titlePage bool
+}
+
+type orderedList struct {
+	level int
+	start int
+}
+
+// scope is the context the blocks are written in.
+type scope struct {
+	style     string
+	listLevel int
+}
+
+func newWriter(profile output_domain.Profile) *writer {
+	return &writer{
+		profile: profile,
+		linkIDs: make(map[string]int),
+	}
+}
+
+func (w *writer) writeDocument(blocks []markdown.Block) {
+	w.body.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	fmt.Fprintf(&w.body, `<w:document xmlns:w="%s" xmlns:r="%s"><w:body>`, nsMain, nsRel)
+
+	if end := w.titlePageEnd(blocks); end > 0 {
+		w.titlePage = true
+		w.writeBlocks(blocks[:end], scope{style: "TitlePage"})
+		w.body.WriteString(`<w:p><w:r><w:br w:type="page"/></w:r></w:p>`)
+		blocks = blocks[end:]
+	}
+
+	w.writeBlocks(blocks, scope{})
+	w.writeSection()
+
+	w.body.WriteString(`</w:body></w:document>`)
+}
+
+// titlePageEnd is the index of the first heading of the second level if the
+// profile has a title page and there is something above the heading.
+func (w *writer) titlePageEnd(blocks []markdown.Block) int {
+	if !w.profile.TitlePage {
+		return 0
+	}
+
+	for i, block := range blocks {
+		if heading, ok := block.(markdown.Heading); ok && heading.Level >= 2 {
+			return i
+		}
+	}
+
+	return 0
+}
+
+func (w *writer) writeBlocks(blocks []markdown.Block, s scope) {
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case markdown.Heading:
+			w.writeHeading(b)
+		case markdown.Paragraph:
+			w.writeParagraph(paragraphProps(s.style, ""), b.Text, false)
+		case markdown.List:
+			w.writeList(b, s)
+		case markdown.Table:
+			w.writeTable(b)
+		case markdown.Quote:
+			style := "Quote"
+			if s.style != "" {
+				style = s.style
+			}
+			w.writeBlocks(b.Blocks, scope{style: style, listLevel: s.listLevel})
+		case markdown.Code:
+			for line := range strings.SplitSeq(b.Text, "\n") {
+				w.writeParagraph(paragraphProps("Code", ""), []markdown.Span{{Text: line}}, false)
+			}
+		case markdown.Rule:
+			w.body.WriteString(`<w:p><w:pPr><w:pBdr><w:bottom w:val="single" w:sz="6" w:space="1" w:color="auto"/></w:pBdr></w:pPr></w:p>`)
+		}
+	}
+}
+
+// writeHeading writes the first level as the document title and the others as
+// Heading1 and below.
+func (w *writer) writeHeading(heading markdown.Heading) {
+	if heading.Level == 1 {
+		w.writeParagraph(paragraphProps("Title", ""), heading.Text, false)
+		return
+	}
+
+	text := heading.Text
+	if w.profile.NumberHeadings && len(text) > 0 {
+		text = append([]markdown.Span(nil), text...)
+		text[0].Text = headingNumberRe.ReplaceAllString(text[0].Text, "")
+	}
+
+	w.writeParagraph(paragraphProps(fmt.Sprintf("Heading%d", heading.Level-1), ""), text, false)
+}
+
+func (w *writer) writeList(list markdown.List, s scope) {
+	level := min(s.listLevel, maxListLevel)
+
+	numID := numBullets
+	if list.Ordered {
+		w.ordered = append(w.ordered, orderedList{level: level, start: max(list.Start, 1)})
+		numID = numOrdered + len(w.ordered) - 1
+	}
+
+	numbering := fmt.Sprintf(`<w:numPr><w:ilvl w:val="%d"/><w:numId w:val="%d"/></w:numPr>`, level, numID)
+	for _, item := range list.Items {
+		w.writeParagraph(paragraphProps("ListParagraph", numbering), item.Text, false)
+		w.writeBlocks(item.Children, scope{style: s.style, listLevel: s.listLevel + 1})
+	}
+}
+
+func (w *writer) writeTable(table markdown.Table) {
+	columns := len(table.Header)
+	if columns == 0 {
+		return
+	}
+
+	width := w.textWidth() / columns
+
+	w.body.WriteString(`<w:tbl><w:tblPr><w:tblStyle w:val="TableGrid"/><w:tblW w:w="5000" w:type="pct"/></w:tblPr><w:tblGrid>`)
+	for range columns {
+		fmt.Fprintf(&w.body, `<w:gridCol w:w="%d"/>`, width)
+	}
+	w.body.WriteString(`</w:tblGrid>`)
+
+	w.writeRow(table.Header, table.Align, width, true)
+	for _, row := range table.Rows {
+		w.writeRow(row, table.Align, width, false)
+	}
+
+	// keeps adjacent tables apart and the body from ending with a table
+	w.body.WriteString(`</w:tbl><w:p/>`)
+}
+
+func (w *writer) writeRow(cells []markdown.Cell, align []markdown.Align, width int, header bool) {
+	w.body.WriteString(`<w:tr>`)
+	if header {
+		w.body.WriteString(`<w:trPr><w:tblHeader/></w:trPr>`)
+	}
+
+	for i, cell := range cells {
+		jc := ""
+		switch {
+		case align[i] == markdown.AlignLeft:
+			jc = `<w:jc w:val="left"/>`
+		case align[i] == markdown.AlignCenter || header:
+			jc = `<w:jc w:val="center"/>`
+		case align[i] == markdown.AlignRight:
+			jc = `<w:jc w:val="right"/>`
+		}
+
+		fmt.Fprintf(&w.body, `<w:tc><w:tcPr><w:tcW w:w="%d" w:type="dxa"/></w:tcPr>`, width)
+		w.writeParagraph(paragraphProps("TableText", jc), cell.Text, header)
+		w.body.WriteString(`</w:tc>`)
+	}
+
+	w.body.WriteString(`</w:tr>`)
+}
+
+func (w *writer) writeSection() {
+	w.body.WriteString(`<w:sectPr>`)
+	if w.profile.PageNumbers {
+		w.body.WriteString(`<w:footerReference w:type="default" r:id="rIdFooter"/>`)
+	}
+
+	fmt.Fprintf(&w.body, `<w:pgSz w:w="%d" w:h="%d"/>`, twips(pageWidth), twips(pageHeight))
+	fmt.Fprintf(&w.body, `<w:pgMar w:top="%d" w:right="%d" w:bottom="%d" w:left="%d" w:header="709" w:footer="709" w:gutter="0"/>`,
+		twips(w.profile.MarginTop), twips(w.profile.MarginRight), twips(w.profile.MarginBottom), twips(w.profile.MarginLeft))
+
+	if w.titlePage {
+		w.body.WriteString(`<w:titlePg/>`)
+	}
+
+	w.body.WriteString(`</w:sectPr>`)
+}
+
+func (w *writer) writeParagraph(props string, spans []markdown.Span, bold bool) {
+	w.body.WriteString(`<w:p>`)
+	w.body.WriteString(props)
+
+	for _, span := range spans {
+		if span.Text == "\n" {
+			w.body.WriteString(`<w:r><w:br/></w:r>`)
+			continue
+		}
+
+		run := fmt.Sprintf(`<w:r>%s<w:t xml:space="preserve">%s</w:t></w:r>`, runProps(span, bold), escape(span.Text))
+		if span.Link != "" {
+			run = fmt.Sprintf(`<w:hyperlink r:id="rIdLink%d">%s</w:hyperlink>`, w.linkID(span.Link), run)
+		}
+		w.body.WriteString(run)
+	}
+
+	w.body.WriteString(`</w:p>`)
+}
+
+func (w *writer) linkID(link string) int {
+	id, found := w.linkIDs[link]
+	if !found {
+		w.links = append(w.links, link)
+		id = len(w.links)
+		w.linkIDs[link] = id
+	}
+	return id
+}
+
+// textWidth is the width between the margins in twips.
+func (w *writer) textWidth() int {
+	return twips(pageWidth - w.profile.MarginLeft - w.profile.MarginRight)
+}
+
+func paragraphProps(style, extra string) string {
+	if style == "" && extra == "" {
+		return ""
+	}
+
+	props := "<w:pPr>"
+	if style != "" {
+		props += fmt.Sprintf(`<w:pStyle w:val="%s"/>`, style)
+	}
+	return props + extra + "</w:pPr>"
+}
+
+func runProps(span markdown.Span, bold bool) string {
+	var props strings.Builder
+	if span.Link != "" {
+		props.WriteString(`<w:rStyle w:val="Hyperlink"/>`)
+	}
+	if span.Code {
+		props.WriteString(`<w:rFonts w:ascii="Courier New" w:hAnsi="Courier New" w:cs="Courier New"/>`)
+	}
+	if span.Bold || bold {
+		props.WriteString(`<w:b/><w:bCs/>`)
+	}
+	if span.Italic {
+		props.WriteString(`<w:i/><w:iCs/>`)
+	}
+
+	if props.Len() == 0 {
+		return ""
+	}
+	return "<w:rPr>" + props.String() + "</w:rPr>"
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// twips converts millimetres to twentieths of a point.
+func twips(mm float64) int {
+	return int(math.Round(mm * 1440 / 25.4))
+}
+
+// halfPoints converts points to half-points.
+func halfPoints(pt float64) int {
+	return int(math.Round(pt * 2))
+}
@@ -0,0 +1,98 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"strings"
+
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/markdown"
+)
+
+const (
+	nsMain = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+	nsRel  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+
+	relTypeStyles    = nsRel + "/styles"
+	relTypeNumbering = nsRel + "/numbering"
+	relTypeFooter    = nsRel + "/footer"
+	relTypeLink      = nsRel + "/hyperlink"
+)
+
+const contentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>` +
+	`<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>` +
+	`<Override PartName="/word/numbering.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml"/>` +
+	`<Override PartName="/word/footer1.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.footer+xml"/>` +
+	`</Types>`
+
+const packageRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>` +
+	`</Relationships>`
+
+const footer = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:ftr xmlns:w="` + nsMain + `">` +
+	`<w:p><w:pPr><w:pStyle w:val="Footer"/></w:pPr>` +
+	`<w:r><w:fldChar w:fldCharType="begin"/></w:r>` +
+	`<w:r><w:instrText xml:space="preserve"> PAGE </w:instrText></w:r>` +
+	`<w:r><w:fldChar w:fldCharType="separate"/></w:r>` +
+	`<w:r><w:t>1</w:t></w:r>` +
+	`<w:r><w:fldChar w:fldCharType="end"/></w:r>` +
+	`</w:p></w:ftr>`
+
+// Render lays out the Markdown document as DOCX by the profile.
+func Render(blocks []markdown.Block, profile output_domain.Profile) ([]byte, error) {
+	w := newWriter(profile)
+	w.writeDocument(blocks)
+
+	parts := []struct {
+		name string
+		data string
+	}{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", packageRels},
+		{"word/_rels/document.xml.rels", w.documentRels()},
+		{"word/document.xml", w.body.String()},
+		{"word/styles.xml", styles(profile)},
+		{"word/numbering.xml", w.numbering()},
+		{"word/footer1.xml", footer},
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, part := range parts {
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: part.name, Method: zip.Deflate})
+		if err != nil {
+			return nil, fmt.Errorf("create %q: %w", part.name, err)
+		}
+
+		if _, err := f.Write([]byte(part.data)); err != nil {
+			return nil, fmt.Errorf("write %q: %w", part.name, err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("close: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (w *writer) documentRels() string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	fmt.Fprintf(&b, `<Relationship Id="rIdStyles" Type="%s" Target="styles.xml"/>`, relTypeStyles)
+	fmt.Fprintf(&b, `<Relationship Id="rIdNumbering" Type="%s" Target="numbering.xml"/>`, relTypeNumbering)
+	fmt.Fprintf(&b, `<Relationship Id="rIdFooter" Type="%s" Target="footer1.xml"/>`, relTypeFooter)
+	for i, link := range w.links {
+		fmt.Fprintf(&b, `<Relationship Id="rIdLink%d" Type="%s" Target="%s" TargetMode="External"/>`, i+1, relTypeLink, escape(link))
+	}
+	b.WriteString(`</Relationships>`)
+	return b.String()
+}
@@ -0,0 +1,112 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/markdown"
+)
+
+const source = "# Техническое задание\n" +
+	"\n" +
+	"| Реквизит | Значение |\n" +
+	"| --- | --- |\n" +
+	"| Шифр | АБВГ & Ко |\n" +
+	"\n" +
+	"## 1. Общие сведения\n" +
+	"\n" +
+	"Текст со **ссылкой** на [ГОСТ](https://example.com?a=1&b=2).\n" +
+	"\n" +
+	"### 1.1. Цели\n" +
+	"\n" +
+	"1. первая\n" +
+	"   - вложенная\n" +
+	"2. вторая\n" +
+	"\n" +
+	"5. пятая\n"
+
+func readParts(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	parts := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+
+		// every part is well-formed
+		decoder := xml.NewDecoder(bytes.NewReader(content))
+		for {
+			_, err := decoder.Token()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err, f.Name)
+		}
+
+		parts[f.Name] = string(content)
+	}
+
+	return parts
+}
+
+func TestRender(t *testing.T) {
+	data, err := Render(markdown.Parse([]byte(source)), output_domain.Profiles["gost_34"])
+	require.NoError(t, err)
+
+	parts := readParts(t, data)
+	require.Len(t, parts, 7)
+
+	document := parts["word/document.xml"]
+
+	// title page
+	require.Contains(t, document, `<w:pStyle w:val="Title"/></w:pPr><w:r><w:t xml:space="preserve">Техническое задание</w:t></w:r>`)
+	require.Contains(t, document, `АБВГ &amp; Ко`)
+	require.Contains(t, document, `<w:br w:type="page"/>`)
+	require.Contains(t, document, `<w:titlePg/>`)
+
+	// numbered headings without the numbers of the template
+	require.Contains(t, document, `<w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t xml:space="preserve">Общие сведения</w:t></w:r>`)
+	require.Contains(t, document, `<w:pStyle w:val="Heading2"/></w:pPr><w:r><w:t xml:space="preserve">Цели</w:t></w:r>`)
+
+	// inline formatting and links
+	require.Contains(t, document, `<w:r><w:rPr><w:b/><w:bCs/></w:rPr><w:t xml:space="preserve">ссылкой</w:t></w:r>`)
+	require.Contains(t, document, `<w:hyperlink r:id="rIdLink1">`)
+	require.Contains(t, parts["word/_rels/document.xml.rels"], `Target="https://example.com?a=1&amp;b=2" TargetMode="External"`)
+
+	// lists
+	require.Contains(t, document, `<w:numPr><w:ilvl w:val="0"/><w:numId w:val="3"/></w:numPr>`)
+	require.Contains(t, document, `<w:numPr><w:ilvl w:val="1"/><w:numId w:val="2"/></w:numPr>`)
+	require.Contains(t, parts["word/numbering.xml"], `<w:num w:numId="3"><w:abstractNumId w:val="2"/><w:lvlOverride w:ilvl="0"><w:startOverride w:val="1"/></w:lvlOverride></w:num>`)
+
+	// page layout
+	require.Contains(t, document, `<w:pgMar w:top="1134" w:right="850" w:bottom="1134" w:left="1701" w:header="709" w:footer="709" w:gutter="0"/>`)
+	require.Contains(t, parts["word/styles.xml"], `<w:rFonts w:ascii="Times New Roman"`)
+	require.Contains(t, parts["word/styles.xml"], `<w:numPr><w:ilvl w:val="0"/><w:numId w:val="1"/></w:numPr>`)
+}
+
+func TestRender_Plain(t *testing.T) {
+	data, err := Render(markdown.Parse([]byte(source)), output_domain.Profiles["plain"])
+	require.NoError(t, err)
+
+	parts := readParts(t, data)
+	document := parts["word/document.xml"]
+
+	require.NotContains(t, document, `<w:br w:type="page"/>`)
+	require.NotContains(t, document, `<w:titlePg/>`)
+	require.Contains(t, document, `<w:t xml:space="preserve">1. Общие сведения</w:t>`)
+	require.NotContains(t, parts["word/styles.xml"], `<w:numId w:val="1"/>`)
+}
@@ -0,0 +1,132 @@
+package docx
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+)
+
+// headingLevels is the number of heading styles, for Markdown levels 2 to 6.
+const headingLevels = 5
+
+func styles(profile output_domain.Profile) string {
+	font := profile.Font
+	size := halfPoints(profile.FontSize)
+	small := halfPoints(max(profile.FontSize-2, 10))
+	line := int(math.Round(240 * profile.LineSpacing))
+	indent := twips(profile.FirstLineIndent)
+
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	fmt.Fprintf(&b, `<w:styles xmlns:w="%s">`, nsMain)
+
+	fmt.Fprintf(&b, `<w:docDefaults><w:rPrDefault><w:rPr>`+
+		`<w:rFonts w:ascii="%[1]s" w:hAnsi="%[1]s" w:eastAsia="%[1]s" w:cs="%[1]s"/>`+
+		`<w:sz w:val="%[2]d"/><w:szCs w:val="%[2]d"/><w:lang w:val="ru-RU" w:eastAsia="en-US" w:bidi="ar-SA"/>`+
+		`</w:rPr></w:rPrDefault><w:pPrDefault><w:pPr><w:spacing w:after="0" w:line="%[3]d" w:lineRule="auto"/></w:pPr></w:pPrDefault></w:docDefaults>`,
+		escape(font), size, line)
+
+	fmt.Fprintf(&b, `<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:qFormat/>`+
+		`<w:pPr><w:ind w:firstLine="%d"/><w:jc w:val="both"/></w:pPr></w:style>`, indent)
+
+	fmt.Fprintf(&b, `<w:style w:type="paragraph" w:styleId="Title"><w:name w:val="Title"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/>`+
+		`<w:pPr><w:keepNext/><w:spacing w:before="240" w:after="240"/><w:ind w:firstLine="0"/><w:jc w:val="center"/><w:outlineLvl w:val="0"/></w:pPr>`+
+		`<w:rPr><w:b/><w:bCs/><w:sz w:val="%[1]d"/><w:szCs w:val="%[1]d"/></w:rPr></w:style>`, size+4)
+
+	for i := range headingLevels {
+		numbering := ""
+		if profile.NumberHeadings {
+			numbering = fmt.Sprintf(`<w:numPr><w:ilvl w:val="%d"/><w:numId w:val="%d"/></w:numPr>`, i, numHeadings)
+		}
+
+		fmt.Fprintf(&b, `<w:style w:type="paragraph" w:styleId="Heading%[1]d"><w:name w:val="heading %[1]d"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/>`+
+			`<w:pPr><w:keepNext/><w:keepLines/>%[2]s<w:spacing w:before="240" w:after="120"/><w:jc w:val="left"/><w:outlineLvl w:val="%[3]d"/></w:pPr>`+
+			`<w:rPr><w:b/><w:bCs/></w:rPr></w:style>`, i+1, numbering, i+1)
+	}
+
+	b.WriteString(`<w:style w:type="paragraph" w:styleId="TitlePage"><w:name w:val="Title Page"/><w:basedOn w:val="Normal"/>` +
+		`<w:pPr><w:ind w:firstLine="0"/><w:jc w:val="center"/></w:pPr></w:style>`)
+
+	b.WriteString(`<w:style w:type="paragraph" w:styleId="ListParagraph"><w:name w:val="List Paragraph"/><w:basedOn w:val="Normal"/><w:qFormat/>` +
+		`<w:pPr><w:contextualSpacing/></w:pPr></w:style>`)
+
+	fmt.Fprintf(&b, `<w:style w:type="paragraph" w:styleId="Quote"><w:name w:val="Quote"/><w:basedOn w:val="Normal"/><w:qFormat/>`+
+		`<w:pPr><w:ind w:left="%d" w:firstLine="0"/></w:pPr><w:rPr><w:i/><w:iCs/></w:rPr></w:style>`, indent)
+
+	fmt.Fprintf(&b, `<w:style w:type="paragraph" w:styleId="Code"><w:name w:val="Code"/><w:basedOn w:val="Normal"/>`+
+		`<w:pPr><w:spacing w:line="240" w:lineRule="auto"/><w:ind w:firstLine="0"/><w:jc w:val="left"/></w:pPr>`+
+		`<w:rPr><w:rFonts w:ascii="Courier New" w:hAnsi="Courier New" w:cs="Courier New"/><w:sz w:val="%[1]d"/><w:szCs w:val="%[1]d"/></w:rPr></w:style>`, small)
+
+	fmt.Fprintf(&b, `<w:style w:type="paragraph" w:styleId="TableText"><w:name w:val="Table Text"/><w:basedOn w:val="Normal"/>`+
+		`<w:pPr><w:spacing w:line="240" w:lineRule="auto"/><w:ind w:firstLine="0"/><w:jc w:val="left"/></w:pPr>`+
+		`<w:rPr><w:sz w:val="%[1]d"/><w:szCs w:val="%[1]d"/></w:rPr></w:style>`, small)
+
+	b.WriteString(`<w:style w:type="paragraph" w:styleId="Footer"><w:name w:val="footer"/><w:basedOn w:val="Normal"/>` +
+		`<w:pPr><w:ind w:firstLine="0"/><w:jc w:val="center"/></w:pPr></w:style>`)
+
+	b.WriteString(`<w:style w:type="character" w:styleId="Hyperlink"><w:name w:val="Hyperlink"/>` +
+		`<w:rPr><w:color w:val="0563C1"/><w:u w:val="single"/></w:rPr></w:style>`)
+
+	b.WriteString(`<w:style w:type="table" w:styleId="TableGrid"><w:name w:val="Table Grid"/><w:tblPr><w:tblBorders>`)
+	for _, side := range []string{"top", "left", "bottom", "right", "insideH", "insideV"} {
+		fmt.Fprintf(&b, `<w:%s w:val="single" w:sz="4" w:space="0" w:color="auto"/>`, side)
+	}
+	b.WriteString(`</w:tblBorders><w:tblCellMar><w:left w:w="108" w:type="dxa"/><w:right w:w="108" w:type="dxa"/></w:tblCellMar></w:tblPr></w:style>`)
+
+	b.WriteString(`</w:styles>`)
+	return b.String()
+}
+
+// numbering defines the heading numbers, the bullets, and a number instance
+// for every ordered list.
+func (w *writer) numbering() string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	fmt.Fprintf(&b, `<w:numbering xmlns:w="%s">`, nsMain)
+
+	// headings: 1, 1.1, 1.1.1
+	b.WriteString(`<w:abstractNum w:abstractNumId="0"><w:multiLevelType w:val="multilevel"/>`)
+	for i := range headingLevels {
+		numbers := make([]string, i+1)
+		for j := range numbers {
+			numbers[j] = fmt.Sprintf("%%%d", j+1)
+		}
+
+		fmt.Fprintf(&b, `<w:lvl w:ilvl="%d"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:pStyle w:val="Heading%d"/>`+
+			`<w:suff w:val="space"/><w:lvlText w:val="%s"/><w:lvlJc w:val="left"/></w:lvl>`, i, i+1, strings.Join(numbers, "."))
+	}
+	b.WriteString(`</w:abstractNum>`)
+
+	// bullets: a dash on every level
+	b.WriteString(`<w:abstractNum w:abstractNumId="1"><w:multiLevelType w:val="hybridMultilevel"/>`)
+	for i := range maxListLevel + 1 {
+		fmt.Fprintf(&b, `<w:lvl w:ilvl="%d"><w:start w:val="1"/><w:numFmt w:val="bullet"/><w:lvlText w:val="–"/><w:lvlJc w:val="left"/>`+
+			`<w:pPr><w:ind w:left="%d" w:hanging="357"/></w:pPr></w:lvl>`, i, listIndent(i))
+	}
+	b.WriteString(`</w:abstractNum>`)
+
+	// ordered lists: 1., 2., 3.
+	b.WriteString(`<w:abstractNum w:abstractNumId="2"><w:multiLevelType w:val="hybridMultilevel"/>`)
+	for i := range maxListLevel + 1 {
+		fmt.Fprintf(&b, `<w:lvl w:ilvl="%d"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:lvlText w:val="%%%d."/><w:lvlJc w:val="left"/>`+
+			`<w:pPr><w:ind w:left="%d" w:hanging="357"/></w:pPr></w:lvl>`, i, i+1, listIndent(i))
+	}
+	b.WriteString(`</w:abstractNum>`)
+
+	fmt.Fprintf(&b, `<w:num w:numId="%d"><w:abstractNumId w:val="0"/></w:num>`, numHeadings)
+	fmt.Fprintf(&b, `<w:num w:numId="%d"><w:abstractNumId w:val="1"/></w:num>`, numBullets)
+	for i, list := range w.ordered {
+		fmt.Fprintf(&b, `<w:num w:numId="%d"><w:abstractNumId w:val="2"/><w:lvlOverride w:ilvl="%d"><w:startOverride w:val="%d"/></w:lvlOverride></w:num>`,
+			numOrdered+i, list.level, list.start)
+	}
+
+	b.WriteString(`</w:numbering>`)
+	return b.String()
+}
+
+// listIndent is the left indent of a list level in twips.
+func listIndent(level int) int {
+	return 709 + level*357
+}
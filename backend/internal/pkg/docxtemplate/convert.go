@@ -0,0 +1,604 @@
+package docxtemplate
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type tokenKind int
+
+const (
+	tokenOther tokenKind = iota
+	tokenStart
+	tokenEnd
+	tokenText
+)
+
+// token is an XML token with its source, so that the part is written back as
+// it is apart from the text of the paragraphs.
+type token struct {
+	kind tokenKind
+	name string
+	raw  []byte
+	text string
+}
+
+// textNode is a w:t element of a paragraph.
+type textNode struct {
+	start int
+	// data are the character tokens of the element
+	data []int
+	text string
+}
+
+type paragraph struct {
+	number     int
+	start, end int
+	nodes      []*textNode
+	cell       *cell
+	row        *row
+	// content is set for anything but text in the runs, such as drawings and
+	// breaks, which keeps the paragraph from being removed
+	content bool
+	// text is the text with the placeholders joined
+	text          string
+	before, after []string
+	removed       bool
+}
+
+type cell struct {
+	paragraphs []*paragraph
+}
+
+type row struct {
+	start, end    int
+	paragraphs    []*paragraph
+	before, after []string
+	removed       bool
+}
+
+// line is what a line of the converted part stands for.
+type line struct {
+	paragraph int
+	text      string
+}
+
+// plainElements may appear in a paragraph that consists of block actions and
+// is removed with them.
+var plainElements = map[string]bool{
+	"w:r":                     true,
+	"w:t":                     true,
+	"w:pPr":                   true,
+	"w:rPr":                   true,
+	"w:proofErr":              true,
+	"w:bookmarkStart":         true,
+	"w:bookmarkEnd":           true,
+	"w:lastRenderedPageBreak": true,
+}
+
+// convert turns the XML part into a text/template source. The placeholders
+// split across runs are joined in the first run, and the block actions that
+// are not closed within their paragraph are moved out of it: in front of the
+// paragraph, or of the table row for a paragraph in a table. A paragraph or a
+// row with nothing but such actions is removed.
+func convert(data []byte) (string, []line, error) {
+	tokens, err := tokenize(data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	paragraphs, rows := structure(tokens)
+	for _, p := range paragraphs {
+		joinPlaceholders(p)
+	}
+	hoistActions(paragraphs)
+
+	for _, r := range rows {
+		r.removed = len(r.before)+len(r.after) > 0 && lo.EveryBy(r.paragraphs, (*paragraph).blank)
+	}
+
+	src, lines := write(tokens, paragraphs, rows)
+	return src, lines, nil
+}
+
+func tokenize(data []byte) ([]token, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	var tokens []token
+	offset := int64(0)
+	for {
+		t, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decode xml: %w", err)
+		}
+
+		next := decoder.InputOffset()
+		tok := token{raw: data[offset:next]}
+		offset = next
+
+		switch t := t.(type) {
+		case xml.StartElement:
+			tok.kind, tok.name = tokenStart, qualifiedName(t.Name)
+		case xml.EndElement:
+			tok.kind, tok.name = tokenEnd, qualifiedName(t.Name)
+		case xml.CharData:
+			tok.kind, tok.text = tokenText, string(t)
+		}
+
+		tokens = append(tokens, tok)
+	}
+
+	return tokens, nil
+}
+
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// structure finds the paragraphs with their text and the table rows.
+func structure(tokens []token) ([]*paragraph, []*row) {
+	var (
+		paragraphs []*paragraph
+		rows       []*row
+
+		openParagraphs []*paragraph
+		openRows       []*row
+		openCells      []*cell
+		// containers tell whether the innermost paragraph container is a
+		// cell
+		containers []bool
+		node       *textNode
+		// props is the depth of the paragraph and run properties
+		props int
+	)
+
+	for i, tok := range tokens {
+		switch tok.kind {
+		case tokenStart:
+			var p *paragraph
+			if len(openParagraphs) > 0 {
+				p = openParagraphs[len(openParagraphs)-1]
+			}
+
+			switch {
+			case props > 0:
+				props++
+				continue
+			case tok.name == "w:pPr" || tok.name == "w:rPr":
+				props++
+			case p != nil && !plainElements[tok.name]:
+				p.content = true
+			}
+
+			switch tok.name {
+			case "w:p":
+				p := &paragraph{number: len(paragraphs) + 1, start: i}
+				if len(containers) > 0 && containers[len(containers)-1] {
+					p.cell = openCells[len(openCells)-1]
+					p.cell.paragraphs = append(p.cell.paragraphs, p)
+					p.row = openRows[len(openRows)-1]
+					p.row.paragraphs = append(p.row.paragraphs, p)
+				}
+				paragraphs = append(paragraphs, p)
+				openParagraphs = append(openParagraphs, p)
+			case "w:t":
+				if p != nil {
+					node = &textNode{start: i}
+					p.nodes = append(p.nodes, node)
+				}
+			case "w:tr":
+				r := &row{start: i}
+				rows = append(rows, r)
+				openRows = append(openRows, r)
+			case "w:tc":
+				openCells = append(openCells, &cell{})
+				containers = append(containers, true)
+			case "w:txbxContent", "w:sdtContent", "w:footnote", "w:endnote", "w:body", "w:hdr", "w:ftr":
+				containers = append(containers, false)
+			}
+		case tokenEnd:
+			if props > 0 {
+				props--
+				continue
+			}
+
+			switch tok.name {
+			case "w:p":
+				if len(openParagraphs) > 0 {
+					openParagraphs[len(openParagraphs)-1].end = i
+					openParagraphs = openParagraphs[:len(openParagraphs)-1]
+				}
+			case "w:t":
+				node = nil
+			case "w:tr":
+				if len(openRows) > 0 {
+					openRows[len(openRows)-1].end = i
+					openRows = openRows[:len(openRows)-1]
+				}
+			case "w:tc":
+				if len(openCells) > 0 {
+					openCells = openCells[:len(openCells)-1]
+					containers = containers[:len(containers)-1]
+				}
+			case "w:txbxContent", "w:sdtContent", "w:footnote", "w:endnote", "w:body", "w:hdr", "w:ftr":
+				if len(containers) > 0 {
+					containers = containers[:len(containers)-1]
+				}
+			}
+		case tokenText:
+			if node != nil {
+				node.data = append(node.data, i)
+				node.text += tok.text
+			}
+		}
+	}
+
+	return paragraphs, rows
+}
+
+// placeholderRe matches a placeholder, which may span lines of a code block.
+var placeholderRe = regexp.MustCompile(`(?s)\{\{.*?\}\}`)
+
+// quoteReplacer undoes the typographic quotes and spaces Word puts in place
+// of the ones typed in the placeholders.
+var quoteReplacer = strings.NewReplacer("“", `"`, "”", `"`, "„", `"`, "«", `"`, "»", `"`, "‘", "'", "’", "'", "\u00a0", " ")
+
+// joinPlaceholders moves every placeholder into the text node it starts in,
+// since Word splits the text into runs by spelling, edits and formatting.
+func joinPlaceholders(p *paragraph) {
+	var (
+		joined strings.Builder
+		owners []int
+	)
+	for i, node := range p.nodes {
+		joined.WriteString(node.text)
+		for range len(node.text) {
+			owners = append(owners, i)
+		}
+	}
+
+	text := joined.String()
+	for _, span := range placeholderRe.FindAllStringIndex(text, -1) {
+		for j := span[0]; j < span[1]; j++ {
+			owners[j] = owners[span[0]]
+		}
+	}
+
+	texts := make([]strings.Builder, len(p.nodes))
+	for j := range len(text) {
+		texts[owners[j]].WriteByte(text[j])
+	}
+
+	joined.Reset()
+	for i, node := range p.nodes {
+		node.text = placeholderRe.ReplaceAllStringFunc(texts[i].String(), quoteReplacer.Replace)
+		joined.WriteString(node.text)
+	}
+	p.text = joined.String()
+}
+
+type actionKind int
+
+const (
+	actionOther actionKind = iota
+	actionOpen
+	actionElse
+	actionEnd
+)
+
+// action is a placeholder in a text node.
+type action struct {
+	paragraph  *paragraph
+	node       *textNode
+	start, end int
+	kind       actionKind
+}
+
+func (a action) text() string {
+	return a.node.text[a.start:a.end]
+}
+
+func actionKindOf(placeholder string) actionKind {
+	inner := strings.TrimSuffix(strings.TrimPrefix(placeholder, "{{"), "}}")
+	inner = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(inner, "-"), "-"))
+
+	keyword, _, _ := strings.Cut(inner, " ")
+	switch keyword {
+	case "if", "range", "with", "block", "define":
+		return actionOpen
+	case "else":
+		return actionElse
+	case "end":
+		return actionEnd
+	}
+
+	return actionOther
+}
+
+// openActions are the block actions of the paragraph that are not closed
+// within it, in their order.
+func openActions(p *paragraph) []action {
+	var (
+		actions []action
+		depth   int
+	)
+
+	for _, node := range p.nodes {
+		for _, span := range placeholderRe.FindAllStringIndex(node.text, -1) {
+			a := action{paragraph: p, node: node, start: span[0], end: span[1], kind: actionKindOf(node.text[span[0]:span[1]])}
+			switch {
+			case a.kind == actionOpen:
+				depth++
+				actions = append(actions, a)
+			case a.kind == actionElse && depth == 0, a.kind == actionEnd && depth == 0:
+				actions = append(actions, a)
+			case a.kind == actionEnd:
+				depth--
+				// the opening action is closed within the paragraph
+				actions = actions[:len(actions)-1]
+			}
+		}
+	}
+
+	return actions
+}
+
+// hoistActions moves the block actions that are not closed within their
+// paragraph out of it. In a table cell the actions closed within the cell
+// stay with their paragraphs, and the others go to the row.
+func hoistActions(paragraphs []*paragraph) {
+	var (
+		hoisted []action
+		cells   []*cell
+	)
+	byCell := make(map[*cell][]action)
+
+	for _, p := range paragraphs {
+		actions := openActions(p)
+		if len(actions) == 0 {
+			continue
+		}
+		hoisted = append(hoisted, actions...)
+
+		if p.cell == nil {
+			for _, a := range actions {
+				hoistToParagraph(a)
+			}
+			continue
+		}
+
+		if _, found := byCell[p.cell]; !found {
+			cells = append(cells, p.cell)
+		}
+		byCell[p.cell] = append(byCell[p.cell], actions...)
+	}
+
+	for _, c := range cells {
+		hoistCell(byCell[c])
+	}
+
+	removeActions(hoisted)
+
+	for _, p := range paragraphs {
+		if len(p.before)+len(p.after) == 0 && !lo.ContainsBy(hoisted, func(a action) bool { return a.paragraph == p }) {
+			continue
+		}
+
+		if !p.blank() {
+			continue
+		}
+
+		// a cell keeps its last paragraph, which is left out of the blocks
+		if p.cell != nil && p.cell.paragraphs[len(p.cell.paragraphs)-1] == p {
+			p.before = append(p.before, p.after...)
+			p.after = nil
+			continue
+		}
+
+		p.removed = true
+	}
+}
+
+// hoistCell keeps the actions of the cell that are closed within it with
+// their paragraphs and moves the others to the row.
+func hoistCell(actions []action) {
+	type block struct {
+		open  int
+		elses []int
+	}
+
+	var blocks []block
+	toRow := make([]bool, len(actions))
+
+	for i, a := range actions {
+		switch {
+		case a.kind == actionOpen:
+			blocks = append(blocks, block{open: i})
+		case len(blocks) == 0:
+			toRow[i] = true
+		case a.kind == actionElse:
+			blocks[len(blocks)-1].elses = append(blocks[len(blocks)-1].elses, i)
+		case a.kind == actionEnd:
+			b := blocks[len(blocks)-1]
+			blocks = blocks[:len(blocks)-1]
+			hoistToParagraph(actions[b.open])
+			for _, e := range b.elses {
+				hoistToParagraph(actions[e])
+			}
+			hoistToParagraph(a)
+		}
+	}
+
+	for _, b := range blocks {
+		toRow[b.open] = true
+		for _, e := range b.elses {
+			toRow[e] = true
+		}
+	}
+
+	for i, a := range actions {
+		if !toRow[i] {
+			continue
+		}
+
+		r := a.paragraph.row
+		if a.kind == actionEnd {
+			r.after = append(r.after, a.text())
+		} else {
+			r.before = append(r.before, a.text())
+		}
+	}
+}
+
+func hoistToParagraph(a action) {
+	p := a.paragraph
+	if a.kind == actionEnd {
+		p.after = append(p.after, a.text())
+	} else {
+		p.before = append(p.before, a.text())
+	}
+}
+
+// removeActions cuts the hoisted actions out of their text nodes. The actions
+// of a node are in order, so they are cut from the last one.
+func removeActions(actions []action) {
+	for _, a := range slices.Backward(actions) {
+		a.node.text = a.node.text[:a.start] + a.node.text[a.end:]
+	}
+}
+
+// blank tells whether the paragraph has nothing to show.
+func (p *paragraph) blank() bool {
+	return !p.content && lo.EveryBy(p.nodes, func(node *textNode) bool {
+		return strings.TrimSpace(node.text) == ""
+	})
+}
+
+// write builds the template source from the tokens. Every paragraph and every
+// row with hoisted actions starts a line, so that a line of the source tells
+// the paragraph it comes from.
+func write(tokens []token, paragraphs []*paragraph, rows []*row) (string, []line) {
+	var (
+		b     strings.Builder
+		lines = []line{{}}
+	)
+
+	paragraphStarts := make(map[int]*paragraph, len(paragraphs))
+	paragraphEnds := make(map[int]*paragraph, len(paragraphs))
+	nodes := make(map[int]*textNode)
+	for _, p := range paragraphs {
+		paragraphStarts[p.start] = p
+		paragraphEnds[p.end] = p
+		for _, node := range p.nodes {
+			nodes[node.start] = node
+			for _, i := range node.data {
+				nodes[i] = node
+			}
+		}
+	}
+
+	rowStarts := make(map[int]*row, len(rows))
+	rowEnds := make(map[int]*row, len(rows))
+	for _, r := range rows {
+		rowStarts[r.start] = r
+		rowEnds[r.end] = r
+	}
+
+	newLine := func(p *paragraph) {
+		b.WriteByte('\n')
+		lines = append(lines, line{paragraph: p.number, text: p.text})
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+
+		if r := rowStarts[i]; r != nil && (r.removed || len(r.before) > 0) {
+			if len(r.paragraphs) > 0 {
+				newLine(r.paragraphs[0])
+			}
+			writeActions(&b, r.before)
+			if r.removed {
+				writeActions(&b, r.after)
+				i = r.end
+				continue
+			}
+		}
+
+		if p := paragraphStarts[i]; p != nil {
+			newLine(p)
+			writeActions(&b, p.before)
+			if p.removed {
+				writeActions(&b, p.after)
+				i = p.end
+				continue
+			}
+		}
+
+		node := nodes[i]
+		switch {
+		case node != nil && node.start == i:
+			writeTextStart(&b, tok, node)
+		case node != nil && node.data[0] == i:
+			writeText(&b, node.text)
+		case node != nil:
+			// the text of the node is written with its first character token
+		case tok.kind == tokenText && strings.TrimSpace(tok.text) == "" && strings.Contains(tok.text, "\n"):
+			// the whitespace between the elements is dropped to keep the lines
+		default:
+			b.WriteString(strings.ReplaceAll(string(tok.raw), "{{", `{{"{{"}}`))
+		}
+
+		if p := paragraphEnds[i]; p != nil && p.start != i {
+			writeActions(&b, p.after)
+		}
+		if r := rowEnds[i]; r != nil && r.start != i {
+			writeActions(&b, r.after)
+		}
+	}
+
+	return b.String(), lines
+}
+
+func writeActions(b *strings.Builder, actions []string) {
+	for _, a := range actions {
+		b.WriteString(a)
+	}
+}
+
+// writeTextStart writes the start of a text node, keeping the spaces of the
+// text, which may end up at its edges after the placeholders are replaced.
+func writeTextStart(b *strings.Builder, tok token, node *textNode) {
+	raw := string(tok.raw)
+	if !strings.Contains(node.text, "{{") || strings.Contains(raw, "xml:space") || strings.HasSuffix(raw, "/>") {
+		b.WriteString(raw)
+		return
+	}
+
+	b.WriteString(strings.TrimSuffix(raw, ">"))
+	b.WriteString(` xml:space="preserve">`)
+}
+
+// writeText writes the text with the placeholders as they are.
+func writeText(b *strings.Builder, text string) {
+	last := 0
+	for _, span := range placeholderRe.FindAllStringIndex(text, -1) {
+		_ = xml.EscapeText(b, []byte(text[last:span[0]]))
+		b.WriteString(text[span[0]:span[1]])
+		last = span[1]
+	}
+	_ = xml.EscapeText(b, []byte(text[last:]))
+}
@@ -0,0 +1,429 @@
+// Package docxtemplate renders Word documents with text/template placeholders
+// in the text of their paragraphs.
+//
+// The placeholders may be split across runs: they are joined in the first run
+// and take its formatting. A block action that is not closed within its
+// paragraph spans the paragraphs up to its end, so a paragraph holding nothing
+// but such actions only marks the block and is left out of the document. In a
+// table the blocks that are not closed within a cell span whole rows, which
+// repeats rows with range and drops them with if.
+//
+// The placeholders may call project partials, whose text is written as the text
+// of the run the call is in.
+package docxtemplate
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/partial"
+)
+
+// escapeFunc is appended to the pipeline of every action that prints a value,
+// so that the values are written as text of the document.
+const escapeFunc = "docxEscape"
+
+// DocumentPart is the main part of a document.
+const DocumentPart = "word/document.xml"
+
+// maxPartSize limits a part read from the document.
+const maxPartSize = 64 << 20
+
+var ErrNotDocument = errors.New("not a docx document")
+
+type part struct {
+	file      *zip.File
+	templated bool
+	lines     []line
+}
+
+// Template is a parsed document. It may be executed concurrently.
+type Template struct {
+	parts    []part
+	tmpl     *template.Template
+	partials []partial_domain.Partial
+	// own are the templates of the document, not of the partials
+	own map[string]*parse.Tree
+}
+
+// Error is a parse or execution error of a template part, with the paragraph
+// it comes from. Paragraphs are numbered from 1 in their part, 0 is unknown.
+type Error struct {
+	Part      string
+	Paragraph int
+	Text      string
+	Detail    string
+	Err       error
+}
+
+func (e *Error) Error() string {
+	if e.Paragraph == 0 {
+		return fmt.Sprintf("%s: %s", e.Part, e.Detail)
+	}
+	return fmt.Sprintf("%s: paragraph %d: %s", e.Part, e.Paragraph, e.Detail)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Parse parses the placeholders of the main document, its headers, footers and
+// notes. The functions are the ones the template may call, they are replaced
+// by Execute. The partials are the ones the placeholders may call.
+func Parse(data []byte, funcs template.FuncMap, partials []partial_domain.Partial) (*Template, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotDocument, err)
+	}
+
+	t := &Template{
+		tmpl:     template.New("").Funcs(funcs).Funcs(template.FuncMap{escapeFunc: escape}),
+		partials: partials,
+		own:      make(map[string]*parse.Tree),
+	}
+
+	if err := partial.Add(t.tmpl, partials); err != nil {
+		return nil, err
+	}
+
+	fromPartials := make(map[*parse.Tree]bool)
+	for _, tmpl := range t.tmpl.Templates() {
+		if tmpl.Tree != nil {
+			escapeText(tmpl.Tree.Root)
+			fromPartials[tmpl.Tree] = true
+		}
+	}
+
+	for _, file := range reader.File {
+		p := part{file: file, templated: templatedPart(file.Name)}
+		if p.templated {
+			src, err := readPart(file)
+			if err != nil {
+				return nil, err
+			}
+
+			converted, lines, err := convert(src)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", file.Name, err)
+			}
+			p.lines = lines
+
+			if _, err := t.tmpl.New(file.Name).Parse(converted); err != nil {
+				return nil, t.error(file.Name, lines, err)
+			}
+		}
+
+		t.parts = append(t.parts, p)
+	}
+
+	if !hasPart(t.parts, DocumentPart) {
+		return nil, ErrNotDocument
+	}
+
+	for _, tmpl := range t.tmpl.Templates() {
+		if tmpl.Tree != nil {
+			escapeNode(tmpl.Tree.Root)
+			if !fromPartials[tmpl.Tree] {
+				t.own[tmpl.Name()] = tmpl.Tree
+			}
+		}
+	}
+
+	return t, nil
+}
+
+// Execute renders the document. The functions replace the ones given to Parse.
+// The writer of every part is passed through wrap, if any, which may limit the
+// output.
+func (t *Template) Execute(data any, funcs template.FuncMap, wrap func(io.Writer) io.Writer) ([]byte, error) {
+	tmpl, err := t.tmpl.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("clone template: %w", err)
+	}
+	tmpl.Funcs(funcs)
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+
+	for _, p := range t.parts {
+		if !p.templated {
+			if err := writer.Copy(p.file); err != nil {
+				return nil, fmt.Errorf("copy %s: %w", p.file.Name, err)
+			}
+			continue
+		}
+
+		w, err := writer.CreateHeader(&zip.FileHeader{
+			Name:     p.file.Name,
+			Method:   zip.Deflate,
+			Modified: p.file.Modified,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create %s: %w", p.file.Name, err)
+		}
+
+		if wrap != nil {
+			w = wrap(w)
+		}
+
+		if err := tmpl.ExecuteTemplate(w, p.file.Name, data); err != nil {
+			return nil, t.error(p.file.Name, p.lines, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("close document: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Templates returns the templates of the document and of its partials. Their
+// trees may be changed before the document is first executed.
+func (t *Template) Templates() []*template.Template {
+	return t.tmpl.Templates()
+}
+
+// Fields returns the names of the fields the template reads from the data at
+// the top level, as .name or $.name, in order and without repeats.
+func (t *Template) Fields() []string {
+	var (
+		fields []string
+		seen   = make(map[string]bool)
+	)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			fields = append(fields, name)
+		}
+	}
+
+	for _, p := range t.parts {
+		if tmpl := t.tmpl.Lookup(p.file.Name); p.templated && tmpl != nil && tmpl.Tree != nil {
+			collectFields(tmpl.Tree.Root, true, add)
+		}
+	}
+
+	// the data of the defined templates is whatever they are called with
+	for _, tmpl := range t.tmpl.Templates() {
+		if tmpl.Tree != nil && !templatedPart(tmpl.Name()) {
+			collectFields(tmpl.Tree.Root, false, add)
+		}
+	}
+
+	return fields
+}
+
+// Partials returns the partials the document calls, directly or through other
+// partials, and the names it calls that neither the document nor the partials
+// define.
+func (t *Template) Partials() ([]partial_domain.Partial, []string, error) {
+	var roots []string
+	for _, p := range t.parts {
+		if p.templated {
+			roots = append(roots, p.file.Name)
+		}
+	}
+
+	return partial.Resolve(t.own, roots, t.partials)
+}
+
+func templatedPart(name string) bool {
+	dir, file := path.Split(name)
+	if dir != "word/" || path.Ext(file) != ".xml" {
+		return false
+	}
+
+	return file == "document.xml" || file == "footnotes.xml" || file == "endnotes.xml" ||
+		strings.HasPrefix(file, "header") || strings.HasPrefix(file, "footer")
+}
+
+func hasPart(parts []part, name string) bool {
+	for _, p := range parts {
+		if p.file.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func readPart(file *zip.File) ([]byte, error) {
+	r, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", file.Name, err)
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(io.LimitReader(r, maxPartSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", file.Name, err)
+	}
+
+	if len(data) > maxPartSize {
+		return nil, fmt.Errorf("read %s: part is too large", file.Name)
+	}
+
+	return data, nil
+}
+
+// errRe matches a text/template error: "template: <name>:<line>[:<col>]:
+// <message>".
+var errRe = regexp.MustCompile(`^template:\s*[^:]*:(\d+)(?::\d+)?:\s*(.+)$`)
+
+// error finds the paragraph of a template error by the line of the converted
+// part.
+func (t *Template) error(name string, lines []line, err error) error {
+	e := &Error{Part: name, Detail: err.Error(), Err: err}
+
+	m := errRe.FindStringSubmatch(err.Error())
+	if m == nil {
+		return e
+	}
+	e.Detail = strings.TrimSpace(m[2])
+
+	n, convErr := strconv.Atoi(m[1])
+	if convErr != nil || n < 1 || n > len(lines) {
+		return e
+	}
+
+	e.Paragraph, e.Text = lines[n-1].paragraph, lines[n-1].text
+	return e
+}
+
+// escape writes a value as the text of a w:t element. Line breaks and tabs
+// become the elements Word uses for them.
+func escape(value any) string {
+	if value == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(fmt.Sprint(value)))
+
+	return textReplacer.Replace(b.String())
+}
+
+var textReplacer = strings.NewReplacer(
+	"&#xD;&#xA;", `</w:t><w:br/><w:t xml:space="preserve">`,
+	"&#xA;", `</w:t><w:br/><w:t xml:space="preserve">`,
+	"&#x9;", `</w:t><w:tab/><w:t xml:space="preserve">`,
+)
+
+// escapeNode appends the escape function to the pipelines of the actions that
+// print their value.
+func escapeNode(node parse.Node) {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return
+		}
+		for _, n := range node.Nodes {
+			escapeNode(n)
+		}
+	case *parse.ActionNode:
+		if len(node.Pipe.Decl) != 0 {
+			return
+		}
+		node.Pipe.Cmds = append(node.Pipe.Cmds, &parse.CommandNode{
+			NodeType: parse.NodeCommand,
+			Pos:      node.Pos,
+			Args:     []parse.Node{parse.NewIdentifier(escapeFunc).SetTree(nil).SetPos(node.Pos)},
+		})
+	case *parse.IfNode:
+		escapeNode(node.List)
+		escapeNode(node.ElseList)
+	case *parse.RangeNode:
+		escapeNode(node.List)
+		escapeNode(node.ElseList)
+	case *parse.WithNode:
+		escapeNode(node.List)
+		escapeNode(node.ElseList)
+	}
+}
+
+// escapeText escapes the text of a partial, which is written inside a w:t
+// element like the values.
+func escapeText(node parse.Node) {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return
+		}
+		for _, n := range node.Nodes {
+			escapeText(n)
+		}
+	case *parse.TextNode:
+		node.Text = []byte(escape(string(node.Text)))
+	case *parse.IfNode:
+		escapeText(node.List)
+		escapeText(node.ElseList)
+	case *parse.RangeNode:
+		escapeText(node.List)
+		escapeText(node.ElseList)
+	case *parse.WithNode:
+		escapeText(node.List)
+		escapeText(node.ElseList)
+	}
+}
+
+// collectFields adds the top-level fields read by the node. Dot is the data
+// while root is set, range and with change it in their bodies.
+func collectFields(node parse.Node, root bool, add func(string)) {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return
+		}
+		for _, n := range node.Nodes {
+			collectFields(n, root, add)
+		}
+	case *parse.ActionNode:
+		collectFields(node.Pipe, root, add)
+	case *parse.PipeNode:
+		if node == nil {
+			return
+		}
+		for _, cmd := range node.Cmds {
+			collectFields(cmd, root, add)
+		}
+	case *parse.CommandNode:
+		for _, arg := range node.Args {
+			collectFields(arg, root, add)
+		}
+	case *parse.ChainNode:
+		collectFields(node.Node, root, add)
+	case *parse.FieldNode:
+		if root {
+			add(node.Ident[0])
+		}
+	case *parse.VariableNode:
+		if node.Ident[0] == "$" && len(node.Ident) > 1 {
+			add(node.Ident[1])
+		}
+	case *parse.IfNode:
+		collectFields(node.Pipe, root, add)
+		collectFields(node.List, root, add)
+		collectFields(node.ElseList, root, add)
+	case *parse.RangeNode:
+		collectFields(node.Pipe, root, add)
+		collectFields(node.List, false, add)
+		collectFields(node.ElseList, root, add)
+	case *parse.WithNode:
+		collectFields(node.Pipe, root, add)
+		collectFields(node.List, false, add)
+		collectFields(node.ElseList, root, add)
+	case *parse.TemplateNode:
+		collectFields(node.Pipe, root, add)
+	}
+}
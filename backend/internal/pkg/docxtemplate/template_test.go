@@ -0,0 +1,257 @@
+package docxtemplate
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+)
+
+const body = `<w:p><w:r><w:t>Заказчик: {{ .cust</w:t></w:r><w:r><w:rPr><w:b/></w:rPr><w:t>omer }}</w:t></w:r></w:p>` +
+	`<w:p><w:r><w:t>{{ if .urgent }}</w:t></w:r></w:p>` +
+	`<w:p><w:r><w:t>Срочно</w:t></w:r></w:p>` +
+	`<w:p><w:r><w:t>{{ end }}</w:t></w:r></w:p>` +
+	`<w:p><w:r><w:t>{{ if not .urgent }}В</w:t></w:r><w:r><w:t> срок{{ end }}</w:t></w:r></w:p>` +
+	`<w:tbl>` +
+	`<w:tr><w:tc><w:p><w:r><w:t>Наименование</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>Кол-во</w:t></w:r></w:p></w:tc></w:tr>` +
+	`<w:tr><w:tc><w:p><w:r><w:t>{{ range .items }}{{ .name }}</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>{{ .qty }}</w:t></w:r></w:p><w:p><w:r><w:t>{{ end }}</w:t></w:r></w:p></w:tc></w:tr>` +
+	`<w:tr><w:tc><w:p><w:r><w:t>{{ if .urgent }}{{ “Итого” }}</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>{{ len .items }}{{ end }}</w:t></w:r></w:p></w:tc></w:tr>` +
+	`</w:tbl>` +
+	`<w:p><w:r><w:t>{{ .note }}</w:t></w:r></w:p>`
+
+func document(t *testing.T, body string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	files := []struct{ name, data string }{
+		{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8"?><Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"/>`},
+		{"word/document.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+			`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` + body + `</w:body></w:document>`},
+		{"word/header1.xml", `<w:hdr xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:p><w:r><w:t>{{ .project.document_code }}</w:t></w:r></w:p></w:hdr>`},
+		{"word/styles.xml", `<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:style><w:name w:val="{{ .x }}"/></w:style></w:styles>`},
+	}
+	for _, file := range files {
+		f, err := w.Create(file.name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(file.data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+// paragraphs reads the text of the paragraphs of a part, with the paragraphs of
+// a table row joined by "|".
+func paragraphs(t *testing.T, data []byte, name string) ([]string, string) {
+	t.Helper()
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	f, err := reader.Open(name)
+	require.NoError(t, err)
+	src, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	var (
+		texts []string
+		text  strings.Builder
+		row   []string
+		inRow bool
+		inT   bool
+	)
+	decoder := xml.NewDecoder(bytes.NewReader(src))
+	for {
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			switch tok.Name.Local {
+			case "p":
+				text.Reset()
+			case "t":
+				inT = true
+			case "br":
+				text.WriteString("\n")
+			case "tr":
+				inRow, row = true, nil
+			}
+		case xml.EndElement:
+			switch tok.Name.Local {
+			case "p":
+				if inRow {
+					row = append(row, text.String())
+				} else {
+					texts = append(texts, text.String())
+				}
+			case "t":
+				inT = false
+			case "tr":
+				inRow = false
+				texts = append(texts, strings.Join(row, "|"))
+			}
+		case xml.CharData:
+			if inT {
+				text.Write(tok)
+			}
+		}
+	}
+
+	return texts, string(src)
+}
+
+func TestTemplate_Execute(t *testing.T) {
+	tmpl, err := Parse(document(t, body), template.FuncMap{}, nil)
+	require.NoError(t, err)
+
+	data := map[string]any{
+		"customer": `ООО "Ромашка" & <Ко>`,
+		"urgent":   true,
+		"items": []map[string]any{
+			{"name": "Болт", "qty": 10},
+			{"name": "Гайка", "qty": 20},
+		},
+		"note":    "первая строка\nвторая строка",
+		"project": map[string]any{"document_code": "АБВГ.001"},
+	}
+
+	out, err := tmpl.Execute(data, nil, nil)
+	require.NoError(t, err)
+
+	texts, _ := paragraphs(t, out, "word/document.xml")
+	require.Equal(t, []string{
+		`Заказчик: ООО "Ромашка" & <Ко>`,
+		"Срочно",
+		"",
+		"Наименование|Кол-во",
+		"Болт|10|",
+		"Гайка|20|",
+		"Итого|2",
+		"первая строка\nвторая строка",
+	}, texts)
+
+	texts, _ = paragraphs(t, out, "word/header1.xml")
+	require.Equal(t, []string{"АБВГ.001"}, texts)
+
+	// other parts are copied as they are
+	_, styles := paragraphs(t, out, "word/styles.xml")
+	require.Contains(t, styles, "{{ .x }}")
+
+	data["urgent"] = false
+	data["items"] = []map[string]any{}
+
+	out, err = tmpl.Execute(data, nil, nil)
+	require.NoError(t, err)
+
+	texts, src := paragraphs(t, out, "word/document.xml")
+	require.Equal(t, []string{
+		`Заказчик: ООО "Ромашка" & <Ко>`,
+		"В срок",
+		"Наименование|Кол-во",
+		"первая строка\nвторая строка",
+	}, texts)
+	require.Contains(t, src, `<w:t xml:space="preserve">Заказчик: `)
+}
+
+func TestTemplate_Execute_Funcs(t *testing.T) {
+	tmpl, err := Parse(document(t, `<w:p><w:r><w:t>{{ upper .customer }}</w:t></w:r></w:p>`), template.FuncMap{"upper": strings.ToUpper}, nil)
+	require.NoError(t, err)
+
+	var written int
+	out, err := tmpl.Execute(map[string]any{"customer": "ромашка"}, template.FuncMap{"upper": strings.ToTitle}, func(w io.Writer) io.Writer {
+		return writerFunc(func(p []byte) (int, error) {
+			written += len(p)
+			return w.Write(p)
+		})
+	})
+	require.NoError(t, err)
+	require.Positive(t, written)
+
+	texts, _ := paragraphs(t, out, "word/document.xml")
+	require.Equal(t, []string{"РОМАШКА"}, texts)
+}
+
+type writerFunc func([]byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
+func TestParse_Error(t *testing.T) {
+	_, err := Parse(document(t, `<w:p><w:r><w:t>Текст</w:t></w:r></w:p><w:p><w:r><w:t>{{ if .a }}</w:t></w:r></w:p><w:p><w:r><w:t>{{ .b </w:t></w:r></w:p>`), nil, nil)
+
+	var tmplErr *Error
+	require.ErrorAs(t, err, &tmplErr)
+	require.Equal(t, "word/document.xml", tmplErr.Part)
+	require.Equal(t, 3, tmplErr.Paragraph)
+	require.Equal(t, "{{ .b ", tmplErr.Text)
+	require.NotEmpty(t, tmplErr.Detail)
+
+	_, err = Parse([]byte("# Markdown"), nil, nil)
+	require.ErrorIs(t, err, ErrNotDocument)
+}
+
+func TestTemplate_Execute_Error(t *testing.T) {
+	tmpl, err := Parse(document(t, `<w:p><w:r><w:t>Текст</w:t></w:r></w:p><w:p><w:r><w:t>{{ index .items 5 }}</w:t></w:r></w:p>`), nil, nil)
+	require.NoError(t, err)
+
+	_, err = tmpl.Execute(map[string]any{"items": []int{1}}, nil, nil)
+
+	var tmplErr *Error
+	require.ErrorAs(t, err, &tmplErr)
+	require.Equal(t, 2, tmplErr.Paragraph)
+	require.Equal(t, "{{ index .items 5 }}", tmplErr.Text)
+	require.Contains(t, tmplErr.Detail, "index out of range")
+
+	errLimit := errors.New("limit")
+	_, err = tmpl.Execute(map[string]any{"items": []int{1, 2, 3, 4, 5, 6}}, nil, func(io.Writer) io.Writer {
+		return writerFunc(func([]byte) (int, error) { return 0, errLimit })
+	})
+	require.ErrorIs(t, err, errLimit)
+}
+
+func TestTemplate_Fields(t *testing.T) {
+	tmpl, err := Parse(document(t, body), nil, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"customer", "urgent", "items", "note", "project"}, tmpl.Fields())
+}
+
+func TestTemplate_Partials(t *testing.T) {
+	partials := []partial_domain.Partial{
+		{VersionID: 1, Name: "approval", Data: "УТВЕРЖДАЮ\n{{ .approver }} & К°"},
+		{VersionID: 2, Name: "unused", Data: "Текст"},
+	}
+
+	tmpl, err := Parse(document(t, `<w:p><w:r><w:t>{{ template "approval" . }}</w:t></w:r></w:p><w:p><w:r><w:t>{{ template "revisions" }}</w:t></w:r></w:p>`), nil, partials)
+	require.NoError(t, err)
+
+	used, missing, err := tmpl.Partials()
+	require.NoError(t, err)
+	require.Equal(t, partials[:1], used)
+	require.Equal(t, []string{"revisions"}, missing)
+
+	tmpl, err = Parse(document(t, `<w:p><w:r><w:t>{{ template "approval" . }}</w:t></w:r></w:p>`), nil, partials)
+	require.NoError(t, err)
+
+	out, err := tmpl.Execute(map[string]any{"approver": "<Иванов>"}, nil, nil)
+	require.NoError(t, err)
+
+	texts, _ := paragraphs(t, out, "word/document.xml")
+	require.Equal(t, []string{"УТВЕРЖДАЮ\n<Иванов> & К°"}, texts)
+}
@@ -1,24 +1,44 @@
-package variable_process_service
+package expression
 
 import (
 	"fmt"
+	"maps"
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/expr-lang/expr"
+	"github.com/shopspring/decimal"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
 )
 
-// mathConstants are injected into the evaluation environment so users can write
-// "2 * pi * r" instead of "pi() * ...". They are stripped from the returned
-// variable map so they never leak into the rendered template data.
-var mathConstants = map[string]any{
+// MathConstants are injected into the evaluation environment so users can write
+// "2 * pi * r" instead of "pi() * ...".
+var MathConstants = map[string]any{
 	"pi": math.Pi,
 	"e":  math.E,
 	"g":  9.80665,
 }
 
-var builtinOptions = []expr.Option{
+// Globals returns what is injected into the evaluation environment besides
+// variables: the math constants, the now function of the render clock, see
+// Clock, and the lookup function of the project dictionaries, see Lookup.
+// Callers must strip them from the returned variable map so they never leak
+// into the rendered template data.
+func Globals(now time.Time, dictionaries []dictionary_domain.Dictionary) map[string]any {
+	globals := maps.Clone(MathConstants)
+	globals["now"] = Clock(now)
+	globals["lookup"] = Lookup(dictionaries)
+	return globals
+}
+
+// BuiltinOptions register the builtin functions available to variable and
+// constraint expressions. Given a decimal, the builtins return a decimal;
+// mod, clamp, interpolate, round, roundStep, formatNumber and percent are exact
+// with decimals, the others are computed in float64.
+var BuiltinOptions = append([]expr.Option{
 	expr.Function("sqrt", fn1(math.Sqrt)),
 	expr.Function("exp", fn1(math.Exp)),
 	expr.Function("log", fn1(math.Log)),
@@ -35,7 +55,7 @@ var builtinOptions = []expr.Option{
 	expr.Function("pow", fn2(math.Pow)),
 	expr.Function("atan2", fn2(math.Atan2)),
 	expr.Function("hypot", fn2(math.Hypot)),
-	expr.Function("mod", fn2(math.Mod)),
+	expr.Function("mod", funcMod),
 
 	expr.Function("clamp", funcClamp),
 	expr.Function("interpolate", funcInterpolate),
@@ -44,7 +64,25 @@ var builtinOptions = []expr.Option{
 	expr.Function("formatNumber", funcFormatNumber),
 	expr.Function("percent", funcPercent),
 	expr.Function("scientific", funcScientific),
-}
+
+	expr.Function("numberToWords", funcNumberToWords),
+	expr.Function("amountToWords", funcAmountToWords),
+	expr.Function("pluralForm", funcPluralForm),
+	expr.Function("ordinal", funcOrdinal),
+
+	expr.Function("convert", funcConvert),
+	expr.Function("formatUnit", funcFormatUnit),
+
+	// now comes from the environment, see Globals
+	expr.DisableBuiltin("now"),
+	expr.Function("formatDate", funcFormatDate),
+	expr.Function("parseDate", funcParseDate),
+	expr.Function("addDays", funcAddDays),
+	expr.Function("addMonths", funcAddMonths),
+	expr.Function("addYears", funcAddYears),
+	expr.Function("addBusinessDays", funcAddBusinessDays),
+	expr.Function("businessDaysBetween", funcBusinessDaysBetween),
+}, DecimalOptions...)
 
 func fn1(f func(float64) float64) func(...any) (any, error) {
 	return func(params ...any) (any, error) {
@@ -52,7 +90,7 @@ func fn1(f func(float64) float64) func(...any) (any, error) {
 		if err != nil {
 			return nil, err
 		}
-		return f(x), nil
+		return floatResult(f(x), params...)
 	}
 }
 
@@ -66,8 +104,41 @@ func fn2(f func(float64, float64) float64) func(...any) (any, error) {
 		if err != nil {
 			return nil, err
 		}
-		return f(x, y), nil
+		return floatResult(f(x, y), params...)
+	}
+}
+
+// floatResult returns a result computed in float64 as a decimal if any of the
+// params is a decimal.
+func floatResult(x float64, params ...any) (any, error) {
+	if hasDecimal(params...) {
+		return toDecimal(x)
+	}
+	return x, nil
+}
+
+// toDecimals converts params to decimals.
+func toDecimals(params ...any) ([]decimal.Decimal, error) {
+	ds := make([]decimal.Decimal, len(params))
+	for i, p := range params {
+		d, err := toDecimal(p)
+		if err != nil {
+			return nil, err
+		}
+		ds[i] = d
 	}
+	return ds, nil
+}
+
+func funcMod(params ...any) (any, error) {
+	if hasDecimal(params...) {
+		ds, err := toDecimals(params[0], params[1])
+		if err != nil {
+			return nil, err
+		}
+		return decimalMod(ds[0], ds[1])
+	}
+	return fn2(math.Mod)(params...)
 }
 
 func funcSign(x float64) float64 {
@@ -82,6 +153,14 @@ func funcSign(x float64) float64 {
 }
 
 func funcClamp(params ...any) (any, error) {
+	if hasDecimal(params...) {
+		ds, err := toDecimals(params...)
+		if err != nil {
+			return nil, err
+		}
+		return decimal.Max(ds[1], decimal.Min(ds[2], ds[0])), nil
+	}
+
 	x, err := toFloat(params[0])
 	if err != nil {
 		return nil, err
@@ -98,6 +177,10 @@ func funcClamp(params ...any) (any, error) {
 }
 
 func funcInterpolate(params ...any) (any, error) {
+	if hasDecimal(params...) {
+		return decimalInterpolate(params...)
+	}
+
 	xs := make([]float64, len(params))
 	for i, p := range params {
 		v, err := toFloat(p)
@@ -116,7 +199,33 @@ func funcInterpolate(params ...any) (any, error) {
 	return y0 + (x-x0)*(y1-y0)/(x1-x0), nil
 }
 
+func decimalInterpolate(params ...any) (any, error) {
+	ds, err := toDecimals(params...)
+	if err != nil {
+		return nil, err
+	}
+	if len(ds) != 5 {
+		return nil, fmt.Errorf("interpolate: expected 5 arguments, got %d", len(ds))
+	}
+	x, x0, x1, y0, y1 := ds[0], ds[1], ds[2], ds[3], ds[4]
+	if x1.Equal(x0) {
+		return nil, fmt.Errorf("interpolate: x0 and x1 must differ")
+	}
+	return y0.Add(x.Sub(x0).Mul(y1.Sub(y0)).Div(x1.Sub(x0))), nil
+}
+
 func funcRound(params ...any) (any, error) {
+	if d, ok := params[0].(decimal.Decimal); ok {
+		if len(params) < 2 {
+			return d.Round(0), nil
+		}
+		n, err := toInt(params[1])
+		if err != nil {
+			return nil, err
+		}
+		return d.Round(int32(n)), nil
+	}
+
 	x, err := toFloat(params[0])
 	if err != nil {
 		return nil, err
@@ -133,6 +242,17 @@ func funcRound(params ...any) (any, error) {
 }
 
 func funcRoundStep(params ...any) (any, error) {
+	if hasDecimal(params...) {
+		ds, err := toDecimals(params[0], params[1])
+		if err != nil {
+			return nil, err
+		}
+		if ds[1].IsZero() {
+			return nil, fmt.Errorf("roundStep: step must be non-zero")
+		}
+		return ds[0].Div(ds[1]).Round(0).Mul(ds[1]), nil
+	}
+
 	x, err := toFloat(params[0])
 	if err != nil {
 		return nil, err
@@ -148,13 +268,18 @@ func funcRoundStep(params ...any) (any, error) {
 }
 
 func funcFormatNumber(params ...any) (any, error) {
-	x, err := toFloat(params[0])
-	if err != nil {
-		return nil, err
+	return FormatNumber(params...)
+}
+
+// FormatNumber implements the formatNumber builtin. It is also available to
+// templates, where decimals cannot be formatted with printf.
+func FormatNumber(params ...any) (string, error) {
+	if len(params) < 2 {
+		return "", fmt.Errorf("formatNumber: expected at least 2 arguments, got %d", len(params))
 	}
 	decimals, err := toInt(params[1])
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	// Default decimal separator is comma and thousand separator is U+00A0
 	// (NBSP) so digit groups don't wrap in rendered docs — matches Russian
@@ -164,25 +289,49 @@ func funcFormatNumber(params ...any) (any, error) {
 	if len(params) >= 3 {
 		s, ok := params[2].(string)
 		if !ok {
-			return nil, fmt.Errorf("formatNumber: decimal separator must be a string")
+			return "", fmt.Errorf("formatNumber: decimal separator must be a string")
 		}
 		decSep = s
 	}
 	if len(params) >= 4 {
 		s, ok := params[3].(string)
 		if !ok {
-			return nil, fmt.Errorf("formatNumber: thousand separator must be a string")
+			return "", fmt.Errorf("formatNumber: thousand separator must be a string")
 		}
 		thouSep = s
 	}
-	return formatNumber(x, decimals, decSep, thouSep), nil
+	raw, err := fixed(params[0], decimals)
+	if err != nil {
+		return "", err
+	}
+	return groupNumber(raw, decSep, thouSep), nil
+}
+
+// fixed formats a number with the given number of decimals.
+func fixed(v any, decimals int) (string, error) {
+	if decimals < 0 {
+		decimals = 0
+	}
+	if d, ok := v.(decimal.Decimal); ok {
+		return d.StringFixed(int32(decimals)), nil
+	}
+	x, err := toFloat(v)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatFloat(x, 'f', decimals, 64), nil
 }
 
 func formatNumber(x float64, decimals int, decSep, thouSep string) string {
 	if decimals < 0 {
 		decimals = 0
 	}
-	raw := strconv.FormatFloat(x, 'f', decimals, 64)
+	return groupNumber(strconv.FormatFloat(x, 'f', decimals, 64), decSep, thouSep)
+}
+
+// groupNumber replaces the separators of a number formatted with a point and
+// no thousand separator.
+func groupNumber(raw string, decSep, thouSep string) string {
 	sign := ""
 	if strings.HasPrefix(raw, "-") {
 		sign = "-"
@@ -210,18 +359,29 @@ func formatNumber(x float64, decimals int, decSep, thouSep string) string {
 }
 
 func funcPercent(params ...any) (any, error) {
-	x, err := toFloat(params[0])
-	if err != nil {
-		return nil, err
+	var x any
+	if d, ok := params[0].(decimal.Decimal); ok {
+		x = d.Shift(2)
+	} else {
+		f, err := toFloat(params[0])
+		if err != nil {
+			return nil, err
+		}
+		x = f * 100
 	}
 	decimals := 0
 	if len(params) >= 2 {
+		var err error
 		decimals, err = toInt(params[1])
 		if err != nil {
 			return nil, err
 		}
 	}
-	return formatNumber(x*100, decimals, ",", "") + "%", nil
+	raw, err := fixed(x, decimals)
+	if err != nil {
+		return nil, err
+	}
+	return groupNumber(raw, ",", "") + "%", nil
 }
 
 func funcScientific(params ...any) (any, error) {
@@ -268,6 +428,8 @@ func toFloat(v any) (float64, error) {
 		return float64(x), nil
 	case uint64:
 		return float64(x), nil
+	case decimal.Decimal:
+		return x.InexactFloat64(), nil
 	}
 	return 0, fmt.Errorf("cannot convert %T to float64", v)
 }
@@ -294,6 +456,8 @@ func toInt(v any) (int, error) {
 		return int(x), nil
 	case float64:
 		return int(x), nil
+	case decimal.Decimal:
+		return int(x.IntPart()), nil
 	}
 	return 0, fmt.Errorf("cannot convert %T to int", v)
 }
@@ -0,0 +1,196 @@
+package expression
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToFloat_AllTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   any
+		want float64
+	}{
+		{"float64", float64(1.5), 1.5},
+		{"float32", float32(1.5), 1.5},
+		{"int", int(42), 42},
+		{"int8", int8(-5), -5},
+		{"int16", int16(-300), -300},
+		{"int32", int32(-70000), -70000},
+		{"int64", int64(1 << 40), 1 << 40},
+		{"uint", uint(42), 42},
+		{"uint8", uint8(255), 255},
+		{"uint16", uint16(65535), 65535},
+		{"uint32", uint32(1 << 30), 1 << 30},
+		{"uint64", uint64(1 << 40), 1 << 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toFloat(tt.in)
+			require.NoError(t, err)
+			require.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
+
+func TestToFloat_Unsupported(t *testing.T) {
+	tests := []any{"string", true, nil, struct{}{}, []int{1, 2}}
+
+	for _, in := range tests {
+		_, err := toFloat(in)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "cannot convert")
+	}
+}
+
+func TestToInt_AllTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   any
+		want int
+	}{
+		{"int", int(42), 42},
+		{"int8", int8(-5), -5},
+		{"int16", int16(-300), -300},
+		{"int32", int32(-70000), -70000},
+		{"int64", int64(1 << 30), 1 << 30},
+		{"uint8", uint8(255), 255},
+		{"uint16", uint16(65535), 65535},
+		{"uint32", uint32(1 << 30), 1 << 30},
+		{"float32", float32(3.7), 3},
+		{"float64", float64(-3.7), -3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toInt(tt.in)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestToInt_Unsupported(t *testing.T) {
+	tests := []any{"string", true, nil, uint(1), uint64(1)}
+
+	for _, in := range tests {
+		_, err := toInt(in)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "cannot convert")
+	}
+}
+
+func TestFn1_TypeError(t *testing.T) {
+	wrapped := fn1(math.Sqrt)
+	_, err := wrapped("not a number")
+	require.Error(t, err)
+}
+
+func TestFn2_TypeError(t *testing.T) {
+	wrapped := fn2(math.Pow)
+
+	_, err := wrapped("nope", 2.0)
+	require.Error(t, err)
+
+	_, err = wrapped(2.0, "nope")
+	require.Error(t, err)
+}
+
+func TestFuncRound_Errors(t *testing.T) {
+	_, err := funcRound("not a number")
+	require.Error(t, err)
+
+	_, err = funcRound(1.5, "not an int")
+	require.Error(t, err)
+}
+
+func TestFuncRound_NegativeDigits(t *testing.T) {
+	got, err := funcRound(1234.0, -2)
+	require.NoError(t, err)
+	require.InDelta(t, 1200.0, got, 1e-9)
+}
+
+func TestFuncRoundStep_Errors(t *testing.T) {
+	_, err := funcRoundStep("nope", 1.0)
+	require.Error(t, err)
+
+	_, err = funcRoundStep(1.0, "nope")
+	require.Error(t, err)
+
+	_, err = funcRoundStep(1.0, 0.0)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "step must be non-zero")
+}
+
+func TestFuncClamp_Errors(t *testing.T) {
+	_, err := funcClamp("x", 0.0, 10.0)
+	require.Error(t, err)
+
+	_, err = funcClamp(1.0, "x", 10.0)
+	require.Error(t, err)
+
+	_, err = funcClamp(1.0, 0.0, "x")
+	require.Error(t, err)
+}
+
+func TestFuncInterpolate_Errors(t *testing.T) {
+	_, err := funcInterpolate(1.0, 0.0, 1.0)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expected 5 arguments")
+
+	_, err = funcInterpolate("nope", 0.0, 1.0, 0.0, 100.0)
+	require.Error(t, err)
+
+	_, err = funcInterpolate(0.5, 1.0, 1.0, 0.0, 100.0)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "x0 and x1 must differ")
+}
+
+func TestFuncFormatNumber_Errors(t *testing.T) {
+	_, err := funcFormatNumber("x", 2)
+	require.Error(t, err)
+
+	_, err = funcFormatNumber(1.0, "x")
+	require.Error(t, err)
+
+	_, err = funcFormatNumber(1.0, 2, 42)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "decimal separator must be a string")
+
+	_, err = funcFormatNumber(1.0, 2, ",", 42)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "thousand separator must be a string")
+}
+
+func TestFormatNumber_Edge(t *testing.T) {
+	require.Equal(t, "0", formatNumber(0, -1, ",", " "))
+	require.Equal(t, "5", formatNumber(5, 0, ",", " "))
+	require.Equal(t, "1,5", formatNumber(1.5, 1, ",", " "))
+	require.Equal(t, "100", formatNumber(100, 0, ",", " "))
+	require.Equal(t, "999", formatNumber(999, 0, ",", " "))
+}
+
+func TestFuncPercent_Errors(t *testing.T) {
+	_, err := funcPercent("nope")
+	require.Error(t, err)
+
+	_, err = funcPercent(0.5, "nope")
+	require.Error(t, err)
+}
+
+func TestFuncScientific_Errors(t *testing.T) {
+	_, err := funcScientific("nope")
+	require.Error(t, err)
+
+	_, err = funcScientific(1.0, "nope")
+	require.Error(t, err)
+}
+
+func TestFuncScientific_NegativeDecimals(t *testing.T) {
+	got, err := funcScientific(123000.0, -3)
+	require.NoError(t, err)
+	require.Equal(t, "1e+05", got)
+}
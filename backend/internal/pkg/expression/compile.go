@@ -0,0 +1,51 @@
+package expression
+
+import (
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/shopspring/decimal"
+
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+)
+
+// Compile compiles a variable expression against env with the builtins and
+// the given options, e.g. the project functions, see FunctionOptions.
+func Compile(source string, env map[string]any, options ...expr.Option) (*vm.Program, error) {
+	opts := append([]expr.Option{expr.Env(env)}, BuiltinOptions...)
+	return expr.Compile(source, append(opts, options...)...)
+}
+
+// CompileConstraint compiles a constraint expression against env with the
+// builtins and the given options. The expression must evaluate to bool.
+func CompileConstraint(source string, env map[string]any, options ...expr.Option) (*vm.Program, error) {
+	opts := append([]expr.Option{expr.Env(env), expr.AsBool()}, BuiltinOptions...)
+	return expr.Compile(source, append(opts, options...)...)
+}
+
+// ZeroValue returns a value of the Go type a variable of the given type is
+// parsed into. It stands in for real values when expressions are type checked
+// before any payload is known.
+func ZeroValue(typ variable_domain.Type) any {
+	switch typ {
+	case variable_domain.TypeInteger:
+		return int64(0)
+	case variable_domain.TypeFloat:
+		return float64(0)
+	case variable_domain.TypeDecimal:
+		return decimal.Decimal{}
+	case variable_domain.TypeString, variable_domain.TypeEnum:
+		return ""
+	case variable_domain.TypeBoolean:
+		return false
+	case variable_domain.TypeDate:
+		return time.Time{}
+	case variable_domain.TypeList:
+		return []any{}
+	case variable_domain.TypeTable:
+		return []map[string]any{}
+	default:
+		return nil
+	}
+}
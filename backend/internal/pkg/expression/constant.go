@@ -0,0 +1,16 @@
+package expression
+
+// ProjectNamespace is the name the project constants are available under, e.g.
+// project.org_name in expressions and .project.org_name in templates. A
+// variable of the same name shadows it.
+const ProjectNamespace = "project"
+
+// ProjectConstants returns the value of the project namespace. Constants are
+// strings, missing ones are nil.
+func ProjectConstants(constants map[string]string) map[string]any {
+	namespace := make(map[string]any, len(constants))
+	for name, value := range constants {
+		namespace[name] = value
+	}
+	return namespace
+}
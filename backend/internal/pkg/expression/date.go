@@ -0,0 +1,301 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultDateLayout is the layout of formatDate, "18 октября 2026 г.".
+const DefaultDateLayout = "2 January 2006 г."
+
+var (
+	genitiveMonths = [13]string{"", "января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"}
+	weekdays       = [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"}
+)
+
+// maxDays limits the number of days business day arithmetic walks through.
+const maxDays = 100000
+
+// dateLayouts are accepted by parseDate in addition to an explicit layout.
+var dateLayouts = []string{time.DateOnly, "02.01.2006", time.RFC3339}
+
+// Clock returns the now function of the evaluation environment. Expressions
+// call it instead of the expr builtin, so that a task rendered again sees the
+// same time. A zero t means the current time.
+func Clock(t time.Time) func() time.Time {
+	if t.IsZero() {
+		return time.Now
+	}
+	return func() time.Time { return t }
+}
+
+// FormatDate implements the formatDate builtin. The layout is a Go layout in
+// which "January" stands for the Russian month name in the genitive case and
+// "Monday" for the Russian weekday name.
+func FormatDate(params ...any) (string, error) {
+	if len(params) < 1 || len(params) > 2 {
+		return "", fmt.Errorf("formatDate: expected 1 or 2 arguments, got %d", len(params))
+	}
+	t, err := toTime("formatDate", params[0])
+	if err != nil {
+		return "", err
+	}
+	layout := DefaultDateLayout
+	if len(params) == 2 {
+		s, ok := params[1].(string)
+		if !ok {
+			return "", fmt.Errorf("formatDate: layout must be a string")
+		}
+		layout = s
+	}
+
+	months := strings.Split(layout, "January")
+	for i, part := range months {
+		days := strings.Split(part, "Monday")
+		for j, p := range days {
+			days[j] = t.Format(p)
+		}
+		months[i] = strings.Join(days, weekdays[t.Weekday()])
+	}
+	return strings.Join(months, genitiveMonths[t.Month()]), nil
+}
+
+// ParseDate implements the parseDate builtin. Without a layout, ISO and
+// Russian "02.01.2006" dates are accepted.
+func ParseDate(params ...any) (time.Time, error) {
+	if len(params) < 1 || len(params) > 2 {
+		return time.Time{}, fmt.Errorf("parseDate: expected 1 or 2 arguments, got %d", len(params))
+	}
+	s, ok := params[0].(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("parseDate: date must be a string")
+	}
+
+	layouts := dateLayouts
+	if len(params) == 2 {
+		layout, ok := params[1].(string)
+		if !ok {
+			return time.Time{}, fmt.Errorf("parseDate: layout must be a string")
+		}
+		layouts = []string{layout}
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("parseDate: cannot parse %q", s)
+}
+
+// AddDays implements the addDays builtin.
+func AddDays(params ...any) (time.Time, error) {
+	t, n, err := timeAndInt("addDays", params)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.AddDate(0, 0, n), nil
+}
+
+// AddMonths implements the addMonths builtin. The day is clamped to the end of
+// the month, so a month after January 31 is February 28 or 29.
+func AddMonths(params ...any) (time.Time, error) {
+	t, n, err := timeAndInt("addMonths", params)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return addMonths(t, n), nil
+}
+
+// AddYears implements the addYears builtin. A year after February 29 is
+// February 28.
+func AddYears(params ...any) (time.Time, error) {
+	t, n, err := timeAndInt("addYears", params)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return addMonths(t, 12*n), nil
+}
+
+// AddBusinessDays implements the addBusinessDays builtin: the date n business
+// days after the given one, or before it if n is negative. Saturdays, Sundays
+// and the dates of an optional list of holidays are skipped.
+func AddBusinessDays(params ...any) (time.Time, error) {
+	if len(params) < 2 || len(params) > 3 {
+		return time.Time{}, fmt.Errorf("addBusinessDays: expected 2 or 3 arguments, got %d", len(params))
+	}
+	t, n, err := timeAndInt("addBusinessDays", params[:2])
+	if err != nil {
+		return time.Time{}, err
+	}
+	holidays, err := toHolidays("addBusinessDays", params[2:])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	if n > maxDays {
+		return time.Time{}, fmt.Errorf("addBusinessDays: %d days is too many", n)
+	}
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if isBusinessDay(t, holidays) {
+			n--
+		}
+	}
+	return t, nil
+}
+
+// BusinessDaysBetween implements the businessDaysBetween builtin: the number of
+// business days after the first date up to and including the second one,
+// negative if the second date is earlier. Holidays are skipped as in
+// addBusinessDays.
+func BusinessDaysBetween(params ...any) (int, error) {
+	if len(params) < 2 || len(params) > 3 {
+		return 0, fmt.Errorf("businessDaysBetween: expected 2 or 3 arguments, got %d", len(params))
+	}
+	from, err := toTime("businessDaysBetween", params[0])
+	if err != nil {
+		return 0, err
+	}
+	to, err := toTime("businessDaysBetween", params[1])
+	if err != nil {
+		return 0, err
+	}
+	holidays, err := toHolidays("businessDaysBetween", params[2:])
+	if err != nil {
+		return 0, err
+	}
+
+	sign := 1
+	if to.Before(from) {
+		from, to, sign = to, from, -1
+	}
+
+	from, to = dateOf(from), dateOf(to)
+	if to.Sub(from) > maxDays*24*time.Hour {
+		return 0, fmt.Errorf("businessDaysBetween: dates are too far apart")
+	}
+	count := 0
+	for t := from.AddDate(0, 0, 1); !t.After(to); t = t.AddDate(0, 0, 1) {
+		if isBusinessDay(t, holidays) {
+			count++
+		}
+	}
+	return sign * count, nil
+}
+
+func funcFormatDate(params ...any) (any, error) {
+	return FormatDate(params...)
+}
+
+func funcParseDate(params ...any) (any, error) {
+	return ParseDate(params...)
+}
+
+func funcAddDays(params ...any) (any, error) {
+	return AddDays(params...)
+}
+
+func funcAddMonths(params ...any) (any, error) {
+	return AddMonths(params...)
+}
+
+func funcAddYears(params ...any) (any, error) {
+	return AddYears(params...)
+}
+
+func funcAddBusinessDays(params ...any) (any, error) {
+	return AddBusinessDays(params...)
+}
+
+func funcBusinessDaysBetween(params ...any) (any, error) {
+	return BusinessDaysBetween(params...)
+}
+
+func addMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month(), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	first = first.AddDate(0, n, 0)
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(t.Day(), last)-1)
+}
+
+func isBusinessDay(t time.Time, holidays map[time.Time]struct{}) bool {
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false
+	}
+	_, ok := holidays[dateOf(t)]
+	return !ok
+}
+
+// dateOf drops the time of day and the location, so that dates compare
+// as calendar days.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func timeAndInt(fn string, params []any) (time.Time, int, error) {
+	if len(params) != 2 {
+		return time.Time{}, 0, fmt.Errorf("%s: expected 2 arguments, got %d", fn, len(params))
+	}
+	t, err := toTime(fn, params[0])
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	n, err := toInteger(fn, params[1])
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	return t, int(n.IntPart()), nil
+}
+
+// toHolidays converts an optional list of dates or date strings.
+func toHolidays(fn string, params []any) (map[time.Time]struct{}, error) {
+	if len(params) == 0 || params[0] == nil {
+		return nil, nil
+	}
+	var items []any
+	switch v := params[0].(type) {
+	case []any:
+		items = v
+	case []time.Time:
+		for _, t := range v {
+			items = append(items, t)
+		}
+	case []string:
+		for _, s := range v {
+			items = append(items, s)
+		}
+	default:
+		return nil, fmt.Errorf("%s: holidays must be a list", fn)
+	}
+
+	holidays := make(map[time.Time]struct{}, len(items))
+	for _, item := range items {
+		t, err := toTime(fn, item)
+		if err != nil {
+			return nil, err
+		}
+		holidays[dateOf(t)] = struct{}{}
+	}
+	return holidays, nil
+}
+
+// toTime accepts dates and strings parseDate accepts.
+func toTime(fn string, v any) (time.Time, error) {
+	switch x := v.(type) {
+	case time.Time:
+		return x, nil
+	case string:
+		t, err := ParseDate(x)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%s: cannot parse %q", fn, x)
+		}
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%s: cannot convert %T to date", fn, v)
+}
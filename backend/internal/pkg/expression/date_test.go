@@ -0,0 +1,116 @@
+package expression
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatDate(t *testing.T) {
+	date := time.Date(2026, time.October, 18, 15, 4, 0, 0, time.UTC)
+
+	tests := []struct {
+		params []any
+		want   string
+	}{
+		{[]any{date}, "18 октября 2026 г."},
+		{[]any{date, "02.01.2006"}, "18.10.2026"},
+		{[]any{date, "«02» January 2006 г."}, "«18» октября 2026 г."},
+		{[]any{date, "Monday, 2 January"}, "воскресенье, 18 октября"},
+		{[]any{"2026-05-01"}, "1 мая 2026 г."},
+	}
+
+	for _, tt := range tests {
+		got, err := FormatDate(tt.params...)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got)
+	}
+
+	_, err := FormatDate(42)
+	require.Error(t, err)
+}
+
+func TestParseDate(t *testing.T) {
+	want := time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)
+
+	for _, params := range [][]any{{"2026-10-18"}, {"18.10.2026"}, {"18/10/2026", "02/01/2006"}} {
+		got, err := ParseDate(params...)
+		require.NoError(t, err, params)
+		require.Equal(t, want, got)
+	}
+
+	_, err := ParseDate("18 октября 2026")
+	require.Error(t, err)
+}
+
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		date string
+		n    int
+		want string
+	}{
+		{"2026-01-31", 1, "2026-02-28"},
+		{"2028-01-31", 1, "2028-02-29"},
+		{"2026-03-31", -1, "2026-02-28"},
+		{"2026-10-18", 14, "2027-12-18"},
+	}
+
+	for _, tt := range tests {
+		got, err := AddMonths(tt.date, tt.n)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got.Format(time.DateOnly))
+	}
+
+	got, err := AddYears("2028-02-29", 1)
+	require.NoError(t, err)
+	require.Equal(t, "2029-02-28", got.Format(time.DateOnly))
+
+	got, err = AddDays("2026-12-31", 1)
+	require.NoError(t, err)
+	require.Equal(t, "2027-01-01", got.Format(time.DateOnly))
+}
+
+func TestBusinessDays(t *testing.T) {
+	// 2026-10-16 is a Friday
+	tests := []struct {
+		date string
+		n    int
+		want string
+	}{
+		{"2026-10-16", 1, "2026-10-19"},
+		{"2026-10-16", 5, "2026-10-23"},
+		{"2026-10-19", -1, "2026-10-16"},
+		{"2026-10-17", 0, "2026-10-17"},
+	}
+
+	for _, tt := range tests {
+		got, err := AddBusinessDays(tt.date, tt.n)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got.Format(time.DateOnly))
+	}
+
+	got, err := AddBusinessDays("2026-10-16", 1, []any{"2026-10-19"})
+	require.NoError(t, err)
+	require.Equal(t, "2026-10-20", got.Format(time.DateOnly))
+
+	n, err := BusinessDaysBetween("2026-10-16", "2026-10-23")
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+
+	n, err = BusinessDaysBetween("2026-10-23", "2026-10-16", []string{"2026-10-20"})
+	require.NoError(t, err)
+	require.Equal(t, -4, n)
+}
+
+func TestGlobals(t *testing.T) {
+	clock := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	env := Globals(clock, nil)
+
+	program, err := Compile(`formatDate(addBusinessDays(now(), 3))`, env)
+	require.NoError(t, err)
+
+	got, err := Run(program, env, 0)
+	require.NoError(t, err)
+	require.Equal(t, "21 октября 2026 г.", got)
+}
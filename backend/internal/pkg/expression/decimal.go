@@ -0,0 +1,494 @@
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/builtin"
+	"github.com/expr-lang/expr/vm/runtime"
+	"github.com/shopspring/decimal"
+)
+
+var errDivisionByZero = errors.New("division by zero")
+
+var (
+	decimalType = reflect.TypeOf(decimal.Decimal{})
+	boolType    = reflect.TypeOf(true)
+	anyType     = reflect.TypeOf((*any)(nil)).Elem()
+	intType     = reflect.TypeOf(0)
+	floatType   = reflect.TypeOf(float64(0))
+)
+
+// decimalOperators name the functions that replace arithmetic and comparison
+// operators with a decimal operand. Operands of unknown type, such as table
+// cells or builtin results, may turn out to be decimals as well, so operators
+// with them are replaced too; the functions fall back to the expr runtime when
+// no operand is a decimal.
+var decimalOperators = map[string]string{
+	"+":  "decimalAdd",
+	"-":  "decimalSub",
+	"*":  "decimalMul",
+	"/":  "decimalDiv",
+	"%":  "decimalMod",
+	"**": "decimalPow",
+	"^":  "decimalPow",
+	"==": "decimalEqual",
+	"!=": "decimalNotEqual",
+	"<":  "decimalLess",
+	"<=": "decimalLessOrEqual",
+	">":  "decimalMore",
+	">=": "decimalMoreOrEqual",
+}
+
+// decimalBuiltins name the functions that replace the expr builtins with a
+// decimal argument. As with the operators, arguments of unknown type are
+// replaced too.
+var decimalBuiltins = map[string]string{
+	"abs":   "decimalAbs",
+	"floor": "decimalFloor",
+	"ceil":  "decimalCeil",
+	"int":   "decimalInt",
+	"float": "decimalFloat",
+	"min":   "decimalMin",
+	"max":   "decimalMax",
+	"sum":   "decimalSum",
+}
+
+// DecimalOptions make decimal values work with arithmetic and comparison
+// operators and with the abs, floor, ceil, int, float, min, max and sum
+// builtins. Mixing a decimal with a number gives a decimal.
+var DecimalOptions = []expr.Option{
+	expr.Patch(decimalPatcher{}),
+
+	expr.Function("decimalAdd", decimalArithmetic(decimalAdd, runtime.Add), decimalArithmeticTypes...),
+	expr.Function("decimalSub", decimalArithmetic(decimalSub, runtime.Subtract), decimalArithmeticTypes...),
+	expr.Function("decimalMul", decimalArithmetic(decimalMul, runtime.Multiply), decimalArithmeticTypes...),
+	expr.Function("decimalDiv", decimalArithmetic(decimalDiv, func(a, b any) any { return runtime.Divide(a, b) }), decimalArithmeticTypes...),
+	expr.Function("decimalMod", decimalArithmetic(decimalMod, func(a, b any) any { return runtime.Modulo(a, b) }), decimalArithmeticTypes...),
+	expr.Function("decimalPow", decimalArithmetic(decimalPow, func(a, b any) any { return runtime.Exponent(a, b) }), decimalArithmeticTypes...),
+	expr.Function("decimalNegate", decimalNegate, new(func(decimal.Decimal) decimal.Decimal)),
+
+	expr.Function("decimalAdd"+untypedSuffix, decimalArithmetic(decimalAdd, runtime.Add), untypedArithmeticType),
+	expr.Function("decimalSub"+untypedSuffix, decimalArithmetic(decimalSub, runtime.Subtract), untypedArithmeticType),
+	expr.Function("decimalMul"+untypedSuffix, decimalArithmetic(decimalMul, runtime.Multiply), untypedArithmeticType),
+	expr.Function("decimalDiv"+untypedSuffix, decimalArithmetic(decimalDiv, func(a, b any) any { return runtime.Divide(a, b) }), untypedArithmeticType),
+	expr.Function("decimalMod"+untypedSuffix, decimalArithmetic(decimalMod, func(a, b any) any { return runtime.Modulo(a, b) }), untypedArithmeticType),
+	expr.Function("decimalPow"+untypedSuffix, decimalArithmetic(decimalPow, func(a, b any) any { return runtime.Exponent(a, b) }), untypedArithmeticType),
+
+	expr.Function("decimalEqual", decimalEquality(true), new(func(any, any) bool)),
+	expr.Function("decimalNotEqual", decimalEquality(false), new(func(any, any) bool)),
+	expr.Function("decimalLess", decimalComparison(func(c int) bool { return c < 0 }, runtime.Less), new(func(any, any) bool)),
+	expr.Function("decimalLessOrEqual", decimalComparison(func(c int) bool { return c <= 0 }, runtime.LessOrEqual), new(func(any, any) bool)),
+	expr.Function("decimalMore", decimalComparison(func(c int) bool { return c > 0 }, runtime.More), new(func(any, any) bool)),
+	expr.Function("decimalMoreOrEqual", decimalComparison(func(c int) bool { return c >= 0 }, runtime.MoreOrEqual), new(func(any, any) bool)),
+
+	// the result types of the builtins are set by the patcher
+	expr.Function("decimalAbs", decimalUnary(decimal.Decimal.Abs, builtin.Abs)),
+	expr.Function("decimalFloor", decimalUnary(decimal.Decimal.Floor, builtin.Floor)),
+	expr.Function("decimalCeil", decimalUnary(decimal.Decimal.Ceil, builtin.Ceil)),
+	expr.Function("decimalInt", decimalUnary(func(d decimal.Decimal) int { return int(d.IntPart()) }, builtin.Int)),
+	expr.Function("decimalFloat", decimalUnary(decimal.Decimal.InexactFloat64, builtin.Float)),
+	expr.Function("decimalMin", decimalMinMax("min", decimal.Min)),
+	expr.Function("decimalMax", decimalMinMax("max", decimal.Max)),
+	expr.Function("decimalSum", decimalSum),
+}
+
+// decimalArithmeticTypes keep the result of decimal arithmetic known to be a
+// decimal, so that operators applied to it are replaced as well.
+var decimalArithmeticTypes = []any{
+	new(func(decimal.Decimal, decimal.Decimal) decimal.Decimal),
+	new(func(decimal.Decimal, int) decimal.Decimal),
+	new(func(decimal.Decimal, int64) decimal.Decimal),
+	new(func(decimal.Decimal, float64) decimal.Decimal),
+	new(func(int, decimal.Decimal) decimal.Decimal),
+	new(func(int64, decimal.Decimal) decimal.Decimal),
+	new(func(float64, decimal.Decimal) decimal.Decimal),
+	new(func(any, any) any),
+}
+
+// untypedSuffix names the variants of the arithmetic functions for operands
+// none of which is known to be a decimal, e.g. table cells or the params of
+// project functions. The overloads above would match such operands as
+// decimals, and abs(row.a - row.b) would not compile.
+const untypedSuffix = "Untyped"
+
+var untypedArithmeticType = new(func(any, any) any)
+
+type decimalPatcher struct{}
+
+func (decimalPatcher) Visit(node *ast.Node) {
+	switch n := (*node).(type) {
+	case *ast.BinaryNode:
+		name, ok := decimalOperators[n.Operator]
+		if !ok {
+			return
+		}
+
+		left, right := n.Left.Type(), n.Right.Type()
+		if !maybeDecimal(left) && !maybeDecimal(right) {
+			return
+		}
+
+		if !isComparison(n.Operator) && left != decimalType && right != decimalType {
+			name += untypedSuffix
+		}
+
+		call := &ast.CallNode{Callee: &ast.IdentifierNode{Value: name}, Arguments: []ast.Node{n.Left, n.Right}}
+		switch {
+		case isComparison(n.Operator):
+			call.SetType(boolType)
+		case left == decimalType && isNumber(right) || isNumber(left) && right == decimalType:
+			call.SetType(decimalType)
+		default:
+			call.SetType(anyType)
+		}
+		ast.Patch(node, call)
+	case *ast.UnaryNode:
+		if n.Operator != "-" || n.Node.Type() != decimalType {
+			return
+		}
+
+		call := &ast.CallNode{Callee: &ast.IdentifierNode{Value: "decimalNegate"}, Arguments: []ast.Node{n.Node}}
+		call.SetType(decimalType)
+		ast.Patch(node, call)
+	case *ast.BuiltinNode:
+		name, ok := decimalBuiltins[n.Name]
+		if !ok {
+			return
+		}
+
+		call := &ast.CallNode{Callee: &ast.IdentifierNode{Value: name}, Arguments: n.Arguments}
+		switch n.Name {
+		case "abs", "floor", "ceil", "int", "float":
+			if len(n.Arguments) != 1 || !maybeDecimal(n.Arguments[0].Type()) {
+				return
+			}
+
+			switch {
+			case n.Name == "int":
+				call.SetType(intType)
+			case n.Name == "float":
+				call.SetType(floatType)
+			case n.Arguments[0].Type() == decimalType:
+				call.SetType(decimalType)
+			default:
+				call.SetType(anyType)
+			}
+		case "min", "max":
+			types := make([]reflect.Type, len(n.Arguments))
+			for i, arg := range n.Arguments {
+				types[i] = arg.Type()
+			}
+			if !slices.ContainsFunc(types, func(t reflect.Type) bool { return maybeDecimal(t) || maybeDecimal(elemType(t)) }) {
+				return
+			}
+
+			if slices.Contains(types, decimalType) && !slices.ContainsFunc(types, func(t reflect.Type) bool { return !isNumber(t) }) {
+				call.SetType(decimalType)
+			} else {
+				call.SetType(anyType)
+			}
+		case "sum":
+			// sum adds with the operator, so the summed values are collected
+			// and passed to decimalSum
+			values, elem := n.Arguments[0], elemType(n.Arguments[0].Type())
+			if len(n.Arguments) == 2 {
+				predicate := n.Arguments[1].Type()
+				if predicate == nil || predicate.Kind() != reflect.Func || predicate.NumOut() != 1 {
+					return
+				}
+				elem = predicate.Out(0)
+
+				values = &ast.BuiltinNode{Name: "map", Arguments: n.Arguments}
+				values.SetType(reflect.SliceOf(elem))
+			}
+			if !maybeDecimal(elem) {
+				return
+			}
+
+			call.Arguments = []ast.Node{values}
+			if elem == decimalType {
+				call.SetType(decimalType)
+			} else {
+				call.SetType(anyType)
+			}
+		}
+		ast.Patch(node, call)
+	}
+}
+
+// elemType returns the type of the elements of an array, which is unknown for
+// a value of unknown type, or nil for other types.
+func elemType(t reflect.Type) reflect.Type {
+	if t == nil {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Array, reflect.Slice:
+		return t.Elem()
+	case reflect.Interface:
+		return anyType
+	default:
+		return nil
+	}
+}
+
+// maybeDecimal reports whether a value of type t may be a decimal. The type of
+// nil is nil.
+func maybeDecimal(t reflect.Type) bool {
+	return t != nil && (t == decimalType || t.Kind() == reflect.Interface)
+}
+
+func isNumber(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int64, reflect.Float64:
+		return true
+	default:
+		return t == decimalType
+	}
+}
+
+func isComparison(operator string) bool {
+	switch operator {
+	case "==", "!=", "<", "<=", ">", ">=":
+		return true
+	default:
+		return false
+	}
+}
+
+func decimalArithmetic(op func(a, b decimal.Decimal) (decimal.Decimal, error), fallback func(a, b any) any) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		if !hasDecimal(params...) {
+			return fallback(params[0], params[1]), nil
+		}
+
+		a, err := toDecimal(params[0])
+		if err != nil {
+			return nil, err
+		}
+		b, err := toDecimal(params[1])
+		if err != nil {
+			return nil, err
+		}
+
+		return op(a, b)
+	}
+}
+
+func decimalAdd(a, b decimal.Decimal) (decimal.Decimal, error) {
+	return a.Add(b), nil
+}
+
+func decimalSub(a, b decimal.Decimal) (decimal.Decimal, error) {
+	return a.Sub(b), nil
+}
+
+func decimalMul(a, b decimal.Decimal) (decimal.Decimal, error) {
+	return a.Mul(b), nil
+}
+
+func decimalDiv(a, b decimal.Decimal) (decimal.Decimal, error) {
+	if b.IsZero() {
+		return decimal.Decimal{}, errDivisionByZero
+	}
+	return a.Div(b), nil
+}
+
+func decimalMod(a, b decimal.Decimal) (decimal.Decimal, error) {
+	if b.IsZero() {
+		return decimal.Decimal{}, errDivisionByZero
+	}
+	return a.Mod(b), nil
+}
+
+// maxDecimalExponent limits integer exponents, since the digits of an exact
+// power grow with the exponent.
+const maxDecimalExponent = 1000
+
+// decimalPow is exact for integer exponents. Other exponents are computed in
+// float64.
+func decimalPow(a, b decimal.Decimal) (decimal.Decimal, error) {
+	if b.IsInteger() {
+		if b.Abs().GreaterThan(decimal.NewFromInt(maxDecimalExponent)) {
+			return decimal.Decimal{}, fmt.Errorf("pow: exponent %s is too large", b)
+		}
+		if a.IsZero() && b.IsNegative() {
+			return decimal.Decimal{}, errDivisionByZero
+		}
+		return a.PowInt32(int32(b.IntPart()))
+	}
+
+	return toDecimal(math.Pow(a.InexactFloat64(), b.InexactFloat64()))
+}
+
+func decimalNegate(params ...any) (any, error) {
+	return params[0].(decimal.Decimal).Neg(), nil
+}
+
+// decimalUnary applies op to a decimal argument and the builtin to others.
+func decimalUnary[T any](op func(decimal.Decimal) T, fallback func(any) any) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		if d, ok := params[0].(decimal.Decimal); ok {
+			return op(d), nil
+		}
+		return fallback(params[0]), nil
+	}
+}
+
+// decimalMinMax picks a decimal by op among the arguments and the elements of
+// the array arguments if any of them is a decimal, and calls the builtin
+// otherwise.
+func decimalMinMax(name string, op func(first decimal.Decimal, rest ...decimal.Decimal) decimal.Decimal) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		values := flatten(params)
+		if !hasDecimal(values...) {
+			return builtin.Builtins[builtin.Index[name]].Func(params...)
+		}
+
+		ds, err := toDecimals(values...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return op(ds[0], ds[1:]...), nil
+	}
+}
+
+// decimalSum adds up the elements of an array like the sum builtin, and as a
+// decimal if any of them is a decimal.
+func decimalSum(params ...any) (any, error) {
+	v := reflect.ValueOf(params[0])
+	if v.Kind() != reflect.Array && v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("sum: cannot sum %T", params[0])
+	}
+
+	add := decimalArithmetic(decimalAdd, runtime.Add)
+
+	var sum any = 0
+	for i := range v.Len() {
+		var err error
+		if sum, err = add(sum, v.Index(i).Interface()); err != nil {
+			return nil, fmt.Errorf("sum: %w", err)
+		}
+	}
+	return sum, nil
+}
+
+// flatten returns the values with the elements of the nested arrays in place
+// of the arrays.
+func flatten(values []any) []any {
+	var result []any
+	for _, v := range values {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Array && rv.Kind() != reflect.Slice {
+			result = append(result, v)
+			continue
+		}
+
+		nested := make([]any, rv.Len())
+		for i := range nested {
+			nested[i] = rv.Index(i).Interface()
+		}
+		result = append(result, flatten(nested)...)
+	}
+	return result
+}
+
+// decimalEquality compares a decimal with a number by value. A decimal is
+// never equal to anything but a number.
+func decimalEquality(equal bool) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		if !hasDecimal(params...) {
+			return runtime.Equal(params[0], params[1]) == equal, nil
+		}
+
+		a, err := toDecimal(params[0])
+		if err != nil {
+			return !equal, nil
+		}
+		b, err := toDecimal(params[1])
+		if err != nil {
+			return !equal, nil
+		}
+
+		return a.Equal(b) == equal, nil
+	}
+}
+
+func decimalComparison(cmp func(int) bool, fallback func(a, b any) bool) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		if !hasDecimal(params...) {
+			return fallback(params[0], params[1]), nil
+		}
+
+		a, err := toDecimal(params[0])
+		if err != nil {
+			return nil, err
+		}
+		b, err := toDecimal(params[1])
+		if err != nil {
+			return nil, err
+		}
+
+		return cmp(a.Cmp(b)), nil
+	}
+}
+
+func hasDecimal(params ...any) bool {
+	for _, p := range params {
+		if _, ok := p.(decimal.Decimal); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// toDecimal converts a number to a decimal. Floats are converted by their
+// shortest representation, so 0.1 becomes exactly 0.1.
+func toDecimal(v any) (decimal.Decimal, error) {
+	switch x := v.(type) {
+	case decimal.Decimal:
+		return x, nil
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return decimal.Decimal{}, fmt.Errorf("cannot convert %v to decimal", x)
+		}
+		return decimal.NewFromFloat(x), nil
+	case float32:
+		if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+			return decimal.Decimal{}, fmt.Errorf("cannot convert %v to decimal", x)
+		}
+		return decimal.NewFromFloat32(x), nil
+	case int:
+		return decimal.NewFromInt(int64(x)), nil
+	case int8:
+		return decimal.NewFromInt(int64(x)), nil
+	case int16:
+		return decimal.NewFromInt(int64(x)), nil
+	case int32:
+		return decimal.NewFromInt(int64(x)), nil
+	case int64:
+		return decimal.NewFromInt(x), nil
+	case uint:
+		return decimal.NewFromUint64(uint64(x)), nil
+	case uint8:
+		return decimal.NewFromUint64(uint64(x)), nil
+	case uint16:
+		return decimal.NewFromUint64(uint64(x)), nil
+	case uint32:
+		return decimal.NewFromUint64(uint64(x)), nil
+	case uint64:
+		return decimal.NewFromUint64(x), nil
+	}
+	return decimal.Decimal{}, fmt.Errorf("cannot convert %T to decimal", v)
+}
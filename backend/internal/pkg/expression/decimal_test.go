@@ -0,0 +1,81 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimal(t *testing.T) {
+	env := map[string]any{
+		"a":     decimal.RequireFromString("0.1"),
+		"b":     decimal.RequireFromString("0.2"),
+		"n":     int64(3),
+		"items": []any{decimal.RequireFromString("1.5"), 2},
+		"rows":  []map[string]any{{"cost": decimal.RequireFromString("0.7")}},
+	}
+
+	tests := []struct {
+		name   string
+		source string
+		want   any
+	}{
+		{"Add", "a + b", decimal.RequireFromString("0.3")},
+		{"Float", "a + 0.2", decimal.RequireFromString("0.3")},
+		{"Int", "n * a", decimal.RequireFromString("0.3")},
+		{"Nested", "(a + b) * 10 - 3", decimal.RequireFromString("0")},
+		{"Negate", "-a", decimal.RequireFromString("-0.1")},
+		{"Pow", "b ** 3", decimal.RequireFromString("0.008")},
+		{"Mod", "b % 0.15", decimal.RequireFromString("0.05")},
+		{"Unknown", "items[0] + items[1]", decimal.RequireFromString("3.5")},
+		{"Cell", "rows[0].cost + a", decimal.RequireFromString("0.8")},
+		{"Plain", "items[1] + 1", 3},
+		{"UntypedBuiltin", "abs(items[1] - 5)", 3},
+		{"Equal", "a + b == 0.3", true},
+		{"NotEqual", `a != "0.1"`, true},
+		{"Less", "a < b && b <= 0.2 && n > b && rows[0].cost >= 0.7", true},
+		{"Reduce", "reduce(rows, #acc + .cost, 0) == 0.7", true},
+		{"Abs", "abs(-a)", decimal.RequireFromString("0.1")},
+		{"UntypedAbs", "abs(items[0] - 2)", decimal.RequireFromString("0.5")},
+		{"Floor", "floor(a + 1.5)", decimal.RequireFromString("1")},
+		{"Ceil", "ceil(-a)", decimal.RequireFromString("0")},
+		{"IntBuiltin", "len(1..int(b * 10))", 2},
+		{"FloatBuiltin", "float(a)", 0.1},
+		{"Min", "min(b, a, 1)", decimal.RequireFromString("0.1")},
+		{"MaxArray", "max(items)", decimal.RequireFromString("2")},
+		{"PlainMax", "max(items[1], 5)", 5},
+		{"Sum", "sum(items)", decimal.RequireFromString("3.5")},
+		{"SumPredicate", "sum(rows, .cost * 2) + a", decimal.RequireFromString("1.5")},
+		{"PlainSum", "sum([1, 2], # * 2)", 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			program, err := Compile(tt.source, env)
+			require.NoError(t, err)
+
+			got, err := Run(program, env, 0)
+			require.NoError(t, err)
+
+			if want, ok := tt.want.(decimal.Decimal); ok {
+				require.IsType(t, decimal.Decimal{}, got)
+				require.True(t, want.Equal(got.(decimal.Decimal)), "got %v", got)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecimal_DivisionByZero(t *testing.T) {
+	env := map[string]any{"a": decimal.RequireFromString("1"), "z": 0}
+
+	for _, source := range []string{"a / z", "a % z", "mod(a, z)", "0 ** -1 + a"} {
+		program, err := Compile(source, env)
+		require.NoError(t, err, source)
+
+		_, err = Run(program, env, 0)
+		require.Error(t, err, source)
+	}
+}
@@ -0,0 +1,193 @@
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/parser"
+	"github.com/samber/lo"
+)
+
+var ErrCycle = errors.New("dependency cycle")
+
+// CycleError describes a dependency cycle. Path starts and ends with the same
+// name, e.g. [a b c a] for a → b → c → a.
+type CycleError struct {
+	Path []string
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrCycle, strings.Join(e.Path, " → "))
+}
+
+func (e *CycleError) Unwrap() error { return ErrCycle }
+
+// ExtractDependencies returns the names referenced by the source expression.
+// Only identifier nodes are considered, so names inside string literals,
+// member names, called functions and names bound with let are not reported.
+// Builtins and math constants never match unless one of the names shadows
+// them. A source that does not parse has no dependencies: compiling it
+// reports the error.
+func ExtractDependencies(source string, names []string) []string {
+	if source == "" {
+		return []string{}
+	}
+
+	tree, err := parser.Parse(source)
+	if err != nil {
+		return []string{}
+	}
+
+	identifiers := referencedNames(tree.Node)
+
+	return lo.Filter(names, func(name string, _ int) bool {
+		_, ok := identifiers[name]
+		return ok
+	})
+}
+
+// ExtractCalls returns the names called as functions by the source
+// expression. Like ExtractDependencies, it returns nothing for a source that
+// does not parse.
+func ExtractCalls(source string, names []string) []string {
+	if source == "" {
+		return []string{}
+	}
+
+	tree, err := parser.Parse(source)
+	if err != nil {
+		return []string{}
+	}
+
+	calls := calledNames(tree.Node)
+
+	return lo.Filter(names, func(name string, _ int) bool {
+		_, ok := calls[name]
+		return ok
+	})
+}
+
+// calledNames returns the names of the functions called by a tree. Builtins
+// of the language, such as len, are not calls but builtin nodes.
+func calledNames(node ast.Node) map[string]struct{} {
+	calls := make(map[string]struct{})
+	ast.Walk(&node, visitor(func(node ast.Node) {
+		if n, ok := node.(*ast.CallNode); ok {
+			if callee, ok := n.Callee.(*ast.IdentifierNode); ok {
+				calls[callee.Value] = struct{}{}
+			}
+		}
+	}))
+	return calls
+}
+
+// referencedNames returns the names of the identifier nodes of a tree except
+// called functions and names bound with let.
+func referencedNames(node ast.Node) map[string]struct{} {
+	callees := make(map[ast.Node]struct{})
+	declared := make(map[string]struct{})
+	ast.Walk(&node, visitor(func(node ast.Node) {
+		switch n := node.(type) {
+		case *ast.CallNode:
+			callees[n.Callee] = struct{}{}
+		case *ast.VariableDeclaratorNode:
+			declared[n.Name] = struct{}{}
+		}
+	}))
+
+	identifiers := make(map[string]struct{})
+	ast.Walk(&node, visitor(func(node ast.Node) {
+		n, ok := node.(*ast.IdentifierNode)
+		if !ok {
+			return
+		}
+
+		if _, ok := callees[n]; ok {
+			return
+		}
+
+		if _, ok := declared[n.Value]; ok {
+			return
+		}
+
+		identifiers[n.Value] = struct{}{}
+	}))
+
+	return identifiers
+}
+
+type visitor func(node ast.Node)
+
+func (v visitor) Visit(node *ast.Node) { v(*node) }
+
+// VariableDependencies returns the names a variable depends on: those
+// referenced by its expressions (value, default, condition) and by its
+// constraints. A constraint naturally refers to its own variable, which is not
+// a dependency.
+func VariableDependencies(name string, sources []string, constraints []string, names []string) []string {
+	var dependencies []string
+	for _, source := range sources {
+		dependencies = append(dependencies, ExtractDependencies(source, names)...)
+	}
+
+	for _, constraint := range constraints {
+		constraintDependencies := ExtractDependencies(constraint, names)
+		dependencies = append(dependencies, lo.Without(constraintDependencies, name)...)
+	}
+
+	return lo.Uniq(dependencies)
+}
+
+// SortDependencies orders names so that every name follows its dependencies.
+// Names are visited in lexical order so that the result is deterministic. It
+// returns a *CycleError if the dependencies are not acyclic.
+func SortDependencies(dependencies map[string][]string) ([]string, error) {
+	var dfs func(string) error
+
+	sorted := make([]string, 0, len(dependencies))
+
+	gray := make(map[string]int)
+	black := make(map[string]struct{})
+	var stack []string
+
+	dfs = func(dependent string) error {
+		if i, ok := gray[dependent]; ok {
+			path := append(slices.Clone(stack[i:]), dependent)
+			return &CycleError{Path: path}
+		}
+
+		gray[dependent] = len(stack)
+		stack = append(stack, dependent)
+		for _, dependency := range dependencies[dependent] {
+			if _, ok := black[dependency]; ok {
+				continue
+			}
+
+			if err := dfs(dependency); err != nil {
+				return err
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		delete(gray, dependent)
+		black[dependent] = struct{}{}
+		sorted = append(sorted, dependent)
+		return nil
+	}
+
+	for _, dependency := range slices.Sorted(maps.Keys(dependencies)) {
+		if _, ok := black[dependency]; ok {
+			continue
+		}
+
+		if err := dfs(dependency); err != nil {
+			return []string{}, err
+		}
+	}
+
+	return sorted, nil
+}
@@ -0,0 +1,76 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractDependencies(t *testing.T) {
+	names := []string{"a", "ab", "b", "round", "items", "cost"}
+
+	tests := []struct {
+		name   string
+		source string
+		want   []string
+	}{
+		{"Identifiers", "ab * 2 + a", []string{"a", "ab"}},
+		{"StringLiteral", `a + "b"`, []string{"a"}},
+		{"MemberName", "items[0].cost + a.b", []string{"a", "items"}},
+		{"Callee", "round(a, 2) + sqrt(b)", []string{"a", "b"}},
+		{"Builtin", "len(items) + pi", []string{"items"}},
+		{"Predicate", "filter(items, .cost > b)", []string{"b", "items"}},
+		{"Let", "let a = 1; a + b", []string{"b"}},
+		{"Empty", "", []string{}},
+		{"ParseError", "a +", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractDependencies(tt.source, names)
+			require.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestVariableDependencies(t *testing.T) {
+	names := []string{"width", "length", "height", "area"}
+
+	got := VariableDependencies("width", []string{"length / 2", "area > 0"}, []string{"width <= length", "width > height"}, names)
+	require.Equal(t, []string{"length", "area", "height"}, got)
+
+	got = VariableDependencies("width", []string{"width + 1"}, nil, names)
+	require.Equal(t, []string{"width"}, got)
+
+	got = VariableDependencies("width", []string{""}, nil, names)
+	require.Equal(t, []string{}, got)
+}
+
+func TestSortDependencies(t *testing.T) {
+	dependencies := map[string][]string{
+		"c": {"a", "b"},
+		"b": {"a"},
+		"a": {},
+	}
+
+	got, err := SortDependencies(dependencies)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, got)
+}
+
+func TestSortDependencies_Cycle(t *testing.T) {
+	dependencies := map[string][]string{
+		"a": {"x"},
+		"x": {"b"},
+		"b": {"c"},
+		"c": {"x"},
+	}
+
+	_, err := SortDependencies(dependencies)
+	require.ErrorIs(t, err, ErrCycle)
+
+	var cycleErr *CycleError
+	require.ErrorAs(t, err, &cycleErr)
+	require.Equal(t, []string{"x", "b", "c", "x"}, cycleErr.Path)
+	require.Equal(t, "dependency cycle: x → b → c → x", err.Error())
+}
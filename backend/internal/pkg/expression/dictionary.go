@@ -0,0 +1,127 @@
+package expression
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/parser"
+	"github.com/shopspring/decimal"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+)
+
+var (
+	ErrDictionaryNotFound = errors.New("dictionary not found")
+	ErrColumnNotFound     = errors.New("dictionary column not found")
+	ErrKeyNotFound        = errors.New("dictionary key not found")
+)
+
+// Lookup returns the lookup function of the evaluation environment, see
+// Globals. lookup(dict, key, column) returns the value of the column in the
+// row with the key, parsed by the type of the column. A number is accepted as
+// a key, so that lookup("gost", 2105, "title") finds the row "2105".
+func Lookup(dictionaries []dictionary_domain.Dictionary) func(dict string, key any, column string) (any, error) {
+	type index struct {
+		dictionary dictionary_domain.Dictionary
+		rows       map[string]dictionary_domain.Row
+	}
+
+	indexes := make(map[string]index, len(dictionaries))
+	for _, d := range dictionaries {
+		rows := make(map[string]dictionary_domain.Row, len(d.Rows))
+		for _, r := range d.Rows {
+			rows[r.Key] = r
+		}
+		indexes[d.Name] = index{dictionary: d, rows: rows}
+	}
+
+	return func(dict string, key any, column string) (any, error) {
+		i, ok := indexes[dict]
+		if !ok {
+			return nil, fmt.Errorf("lookup: %w: %s", ErrDictionaryNotFound, dict)
+		}
+
+		c, ok := i.dictionary.Column(column)
+		if !ok {
+			return nil, fmt.Errorf("lookup: %w: %s.%s", ErrColumnNotFound, dict, column)
+		}
+
+		k, ok := lookupKey(key)
+		if !ok {
+			return nil, fmt.Errorf("lookup: key must be a string or a number, got %T", key)
+		}
+
+		row, ok := i.rows[k]
+		if !ok {
+			return nil, fmt.Errorf("lookup: %w: %s[%s]", ErrKeyNotFound, dict, k)
+		}
+
+		if column == dictionary_domain.KeyColumn {
+			return row.Key, nil
+		}
+		return c.Type.Parse(row.Values[column])
+	}
+}
+
+func lookupKey(key any) (string, bool) {
+	switch k := key.(type) {
+	case string:
+		return k, true
+	case int:
+		return strconv.Itoa(k), true
+	case int64:
+		return strconv.FormatInt(k, 10), true
+	case float64:
+		return strconv.FormatFloat(k, 'f', -1, 64), true
+	case decimal.Decimal:
+		return k.String(), true
+	case json.Number:
+		return k.String(), true
+	default:
+		return "", false
+	}
+}
+
+// CheckLookups checks that the lookup calls of an expression name existing
+// dictionaries and columns. Only names written as string literals are
+// checked; the others are known at run time only.
+func CheckLookups(source string, dictionaries []dictionary_domain.Dictionary) error {
+	tree, err := parser.Parse(source)
+	if err != nil {
+		return nil
+	}
+
+	var errs []error
+	ast.Walk(&tree.Node, visitor(func(node ast.Node) {
+		n, ok := node.(*ast.CallNode)
+		if !ok || len(n.Arguments) != 3 {
+			return
+		}
+		if callee, ok := n.Callee.(*ast.IdentifierNode); !ok || callee.Value != "lookup" {
+			return
+		}
+
+		dict, ok := n.Arguments[0].(*ast.StringNode)
+		if !ok {
+			return
+		}
+		d, ok := dictionary_domain.Find(dictionaries, dict.Value)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrDictionaryNotFound, dict.Value))
+			return
+		}
+
+		column, ok := n.Arguments[2].(*ast.StringNode)
+		if !ok {
+			return
+		}
+		if _, ok := d.Column(column.Value); !ok {
+			errs = append(errs, fmt.Errorf("%w: %s.%s", ErrColumnNotFound, dict.Value, column.Value))
+		}
+	}))
+
+	return errors.Join(errs...)
+}
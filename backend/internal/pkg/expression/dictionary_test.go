@@ -0,0 +1,79 @@
+package expression
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+)
+
+var testDictionaries = []dictionary_domain.Dictionary{
+	{
+		Name: "materials",
+		Columns: []dictionary_domain.Column{
+			{Name: "title", Type: variable_domain.TypeString},
+			{Name: "density", Type: variable_domain.TypeDecimal},
+			{Name: "grade", Type: variable_domain.TypeInteger},
+		},
+		Rows: []dictionary_domain.Row{
+			{Key: "steel", Values: map[string]string{"title": "Сталь 20", "density": "7850", "grade": "20"}},
+			{Key: "2105", Values: map[string]string{"title": "Алюминий", "density": "2700.5", "grade": "1"}},
+		},
+	},
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		source string
+		want   any
+	}{
+		{`lookup("materials", "steel", "title")`, "Сталь 20"},
+		{`lookup("materials", "steel", "grade")`, int64(20)},
+		{`lookup("materials", 2105, "density")`, decimal.RequireFromString("2700.5")},
+		{`lookup("materials", m, "key")`, "steel"},
+	}
+
+	for _, tt := range tests {
+		env := Globals(time.Time{}, testDictionaries)
+		env["m"] = "steel"
+
+		program, err := Compile(tt.source, env)
+		require.NoError(t, err, tt.source)
+
+		got, err := Run(program, env, 0)
+		require.NoError(t, err, tt.source)
+		require.Equal(t, tt.want, got, tt.source)
+	}
+}
+
+func TestLookup_Error(t *testing.T) {
+	tests := []struct {
+		source string
+		want   error
+	}{
+		{`lookup("metals", "steel", "title")`, ErrDictionaryNotFound},
+		{`lookup("materials", "steel", "color")`, ErrColumnNotFound},
+		{`lookup("materials", "iron", "title")`, ErrKeyNotFound},
+	}
+
+	for _, tt := range tests {
+		env := Globals(time.Time{}, testDictionaries)
+
+		program, err := Compile(tt.source, env)
+		require.NoError(t, err, tt.source)
+
+		_, err = Run(program, env, 0)
+		require.ErrorIs(t, err, tt.want, tt.source)
+	}
+}
+
+func TestCheckLookups(t *testing.T) {
+	require.NoError(t, CheckLookups(`lookup("materials", x, "density") * 2`, testDictionaries))
+	require.NoError(t, CheckLookups(`lookup(d, x, c)`, testDictionaries))
+	require.ErrorIs(t, CheckLookups(`lookup("metals", x, "density")`, testDictionaries), ErrDictionaryNotFound)
+	require.ErrorIs(t, CheckLookups(`lookup("materials", x, "color")`, testDictionaries), ErrColumnNotFound)
+}
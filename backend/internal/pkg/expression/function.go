@@ -0,0 +1,152 @@
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/builtin"
+	"github.com/expr-lang/expr/conf"
+	"github.com/expr-lang/expr/parser"
+	"github.com/samber/lo"
+
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+)
+
+var ErrUndefinedName = errors.New("undefined name")
+
+// FunctionError describes a project function that does not compile.
+type FunctionError struct {
+	Name string
+	Err  error
+}
+
+func (e *FunctionError) Error() string {
+	return fmt.Sprintf("function %s: %s", e.Name, e.Err)
+}
+
+func (e *FunctionError) Unwrap() error { return e.Err }
+
+// keywords are the words of the expr language that cannot name a function.
+var keywords = []string{"true", "false", "nil", "and", "or", "not", "in", "matches", "contains", "startsWith", "endsWith", "let", "if", "else"}
+
+// builtinFunctions are the functions registered by BuiltinOptions.
+var builtinFunctions = func() conf.FunctionsTable {
+	c := conf.CreateNew()
+	for _, opt := range BuiltinOptions {
+		opt(c)
+	}
+	return c.Functions
+}()
+
+// IsReserved reports whether name is taken by the language: a keyword, a
+// builtin function, a math constant, now, lookup or the project namespace.
+// Project functions cannot be named so.
+func IsReserved(name string) bool {
+	if _, ok := MathConstants[name]; ok || name == "now" || name == "lookup" || name == ProjectNamespace || slices.Contains(keywords, name) {
+		return true
+	}
+
+	if _, ok := builtin.Index[name]; ok {
+		return true
+	}
+
+	_, ok := builtinFunctions[name]
+	return ok
+}
+
+// FunctionOptions compiles project functions and returns the options that
+// register them next to BuiltinOptions. A body refers to its params and the
+// math constants only; it may call builtins and other functions, but not
+// itself, directly or through others, which is reported as a *CycleError. A
+// function that does not compile is reported as a *FunctionError. Every call
+// runs the body within budget, see Run.
+func FunctionOptions(functions []function_domain.Function, budget uint) ([]expr.Option, error) {
+	functionsByName := lo.KeyBy(functions, func(f function_domain.Function) string { return f.Name })
+	names := slices.Sorted(maps.Keys(functionsByName))
+
+	calls := make(map[string][]string, len(functions))
+	for _, f := range functions {
+		calls[f.Name] = ExtractCalls(f.Body, names)
+	}
+
+	order, err := SortDependencies(calls)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make(map[string]expr.Option, len(order))
+	for _, name := range order {
+		callees := lo.SliceToMap(calls[name], func(callee string) (string, expr.Option) { return callee, options[callee] })
+
+		option, err := compileFunction(functionsByName[name], callees, budget)
+		if err != nil {
+			return nil, &FunctionError{Name: name, Err: err}
+		}
+		options[name] = option
+	}
+
+	return lo.Map(order, func(name string, _ int) expr.Option { return options[name] }), nil
+}
+
+// compileFunction compiles the body of a function with the functions it
+// calls. Params are untyped, so the body is compiled with undefined names
+// allowed and the names are checked apart.
+func compileFunction(f function_domain.Function, callees map[string]expr.Option, budget uint) (expr.Option, error) {
+	tree, err := parser.Parse(f.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var undefined []string
+	for name := range referencedNames(tree.Node) {
+		if _, ok := MathConstants[name]; !ok && !slices.Contains(f.Params, name) {
+			undefined = append(undefined, name)
+		}
+	}
+	for name := range calledNames(tree.Node) {
+		_, isCallee := callees[name]
+		_, isBuiltin := builtinFunctions[name]
+		if !isCallee && !isBuiltin {
+			undefined = append(undefined, name)
+		}
+	}
+	// the render clock is not in the env of a body, see Globals
+	ast.Walk(&tree.Node, visitor(func(node ast.Node) {
+		if n, ok := node.(*ast.BuiltinNode); ok && n.Name == "now" && !slices.Contains(undefined, n.Name) {
+			undefined = append(undefined, n.Name)
+		}
+	}))
+	if len(undefined) != 0 {
+		slices.Sort(undefined)
+		return nil, fmt.Errorf("%w: %s", ErrUndefinedName, strings.Join(undefined, ", "))
+	}
+
+	opts := append([]expr.Option{expr.Env(MathConstants), expr.AllowUndefinedVariables()}, BuiltinOptions...)
+	program, err := expr.Compile(f.Body, append(opts, slices.Collect(maps.Values(callees))...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	call := func(params ...any) (any, error) {
+		env := maps.Clone(MathConstants)
+		for i, param := range f.Params {
+			env[param] = params[i]
+		}
+		return Run(program, env, budget)
+	}
+
+	return expr.Function(f.Name, call, functionType(len(f.Params))), nil
+}
+
+// functionType returns a func(any, ...) any of n params, so that calls with a
+// wrong number of arguments fail to compile.
+func functionType(n int) any {
+	in := slices.Repeat([]reflect.Type{anyType}, n)
+	return reflect.New(reflect.FuncOf(in, []reflect.Type{anyType}, false)).Interface()
+}
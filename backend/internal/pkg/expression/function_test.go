@@ -0,0 +1,113 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+)
+
+func TestFunctionOptions(t *testing.T) {
+	functions := []function_domain.Function{
+		{Name: "gostCode", Params: []string{"series", "number"}, Body: `"ГОСТ " + series + "-" + tolerance(number, 2)`},
+		{Name: "tolerance", Params: []string{"x", "tol"}, Body: `formatNumber(x, 1) + " ± " + formatNumber(x * tol / 100, 1)`},
+		{Name: "area", Params: []string{"r"}, Body: `pi * r ** 2`},
+	}
+
+	options, err := FunctionOptions(functions, 0)
+	require.NoError(t, err)
+
+	tests := []struct {
+		source string
+		env    map[string]any
+		want   any
+	}{
+		{`tolerance(x, 5)`, map[string]any{"x": 10.0}, "10,0 ± 0,5"},
+		{`gostCode("2.105", x)`, map[string]any{"x": int64(100)}, "ГОСТ 2.105-100,0 ± 2,0"},
+		{`round(area(x), 2)`, map[string]any{"x": 1.0}, 3.14},
+		{`tolerance(x, 5)`, map[string]any{"x": decimal.RequireFromString("0.3")}, "0,3 ± 0,0"},
+	}
+
+	for _, tt := range tests {
+		program, err := Compile(tt.source, tt.env, options...)
+		require.NoError(t, err, tt.source)
+
+		got, err := Run(program, tt.env, 0)
+		require.NoError(t, err, tt.source)
+		require.Equal(t, tt.want, got, tt.source)
+	}
+
+	_, err = Compile(`tolerance(1)`, map[string]any{}, options...)
+	require.ErrorContains(t, err, "not enough arguments")
+}
+
+func TestFunctionOptions_Error(t *testing.T) {
+	tests := []struct {
+		name      string
+		functions []function_domain.Function
+		want      error
+	}{
+		{
+			name:      "UndefinedName",
+			functions: []function_domain.Function{{Name: "f", Params: []string{"x"}, Body: `x + y`}},
+			want:      ErrUndefinedName,
+		},
+		{
+			name:      "UndefinedFunction",
+			functions: []function_domain.Function{{Name: "f", Params: []string{"x"}, Body: `g(x)`}},
+			want:      ErrUndefinedName,
+		},
+		{
+			name:      "Now",
+			functions: []function_domain.Function{{Name: "f", Body: `now()`}},
+			want:      ErrUndefinedName,
+		},
+		{
+			name: "Cycle",
+			functions: []function_domain.Function{
+				{Name: "f", Params: []string{"x"}, Body: `g(x) + 1`},
+				{Name: "g", Params: []string{"x"}, Body: `f(x) - 1`},
+			},
+			want: ErrCycle,
+		},
+		{
+			name:      "Recursion",
+			functions: []function_domain.Function{{Name: "f", Params: []string{"x"}, Body: `x > 0 ? f(x - 1) : 0`}},
+			want:      ErrCycle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FunctionOptions(tt.functions, 0)
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+
+	_, err := FunctionOptions([]function_domain.Function{{Name: "f", Params: []string{"x"}, Body: `x +`}}, 0)
+	var functionErr *FunctionError
+	require.ErrorAs(t, err, &functionErr)
+	require.Equal(t, "f", functionErr.Name)
+}
+
+func TestFunctionOptions_Budget(t *testing.T) {
+	functions := []function_domain.Function{{Name: "big", Params: []string{"n"}, Body: `len(map(1..n, #))`}}
+
+	options, err := FunctionOptions(functions, 100)
+	require.NoError(t, err)
+
+	program, err := Compile(`big(1000)`, map[string]any{}, options...)
+	require.NoError(t, err)
+
+	_, err = Run(program, map[string]any{}, 0)
+	require.ErrorIs(t, err, ErrBudget)
+}
+
+func TestIsReserved(t *testing.T) {
+	for _, name := range []string{"len", "sqrt", "formatDate", "now", "lookup", "project", "pi", "in", "decimalAdd"} {
+		require.True(t, IsReserved(name), name)
+	}
+	require.False(t, IsReserved("tolerance"))
+}
@@ -0,0 +1,42 @@
+package expression
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/expr-lang/expr/file"
+	"github.com/expr-lang/expr/vm"
+)
+
+// ErrBudget is returned by Run when a program exceeds its budget.
+var ErrBudget = errors.New("budget exceeded")
+
+// budgetMessage is what the expr VM and builtins such as repeat fail with once
+// a program exceeds the memory budget.
+const budgetMessage = "memory budget exceeded"
+
+// Run runs a program against env within budget. The budget is the memory
+// budget of the expr VM: ranges, arrays, maps and builtin results such as
+// repeated strings are charged against it, which bounds the work a program
+// can do. Zero means the expr default.
+func Run(program *vm.Program, env map[string]any, budget uint) (any, error) {
+	machine := vm.VM{MemoryBudget: budget}
+
+	value, err := machine.Run(program, env)
+	if err != nil {
+		if exhausted(err) {
+			return nil, fmt.Errorf("%w: %w", ErrBudget, err)
+		}
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// exhausted reports whether a program failed by exceeding the memory budget.
+// The VM stops with an untyped panic once it does, which it reports as an
+// error with the panic message.
+func exhausted(err error) bool {
+	var fileErr *file.Error
+	return errors.As(err, &fileErr) && fileErr.Message == budgetMessage
+}
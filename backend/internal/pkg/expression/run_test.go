@@ -0,0 +1,41 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	env := map[string]any{"n": 1000}
+
+	tests := []struct {
+		name    string
+		source  string
+		budget  uint
+		want    any
+		wantErr error
+	}{
+		{"WithinBudget", "sum(1..n)", 2000, 500500, nil},
+		{"Range", "sum(1..n)", 500, nil, ErrBudget},
+		{"Repeat", `len(repeat("ab", n))`, 1000, nil, ErrBudget},
+		{"RepeatLarge", `len(repeat("a", 100000000))`, 0, nil, ErrBudget},
+		{"Default", "sum(1..n)", 0, 500500, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			program, err := Compile(tt.source, env)
+			require.NoError(t, err)
+
+			got, err := Run(program, env, tt.budget)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,404 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Russian numerals are spelled in the nominative case and without "ё", as in
+// contracts and other official documents.
+
+type gender int
+
+const (
+	masculine gender = iota
+	feminine
+	neuter
+)
+
+var genders = map[string]gender{
+	"m": masculine,
+	"f": feminine,
+	"n": neuter,
+}
+
+var (
+	unitWords = [3][10]string{
+		masculine: {"", "один", "два", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять"},
+		feminine:  {"", "одна", "две", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять"},
+		neuter:    {"", "одно", "два", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять"},
+	}
+	teenWords    = [10]string{"десять", "одиннадцать", "двенадцать", "тринадцать", "четырнадцать", "пятнадцать", "шестнадцать", "семнадцать", "восемнадцать", "девятнадцать"}
+	tenWords     = [10]string{"", "", "двадцать", "тридцать", "сорок", "пятьдесят", "шестьдесят", "семьдесят", "восемьдесят", "девяносто"}
+	hundredWords = [10]string{"", "сто", "двести", "триста", "четыреста", "пятьсот", "шестьсот", "семьсот", "восемьсот", "девятьсот"}
+
+	unitOrdinals    = [10]string{"нулевой", "первый", "второй", "третий", "четвертый", "пятый", "шестой", "седьмой", "восьмой", "девятый"}
+	teenOrdinals    = [10]string{"десятый", "одиннадцатый", "двенадцатый", "тринадцатый", "четырнадцатый", "пятнадцатый", "шестнадцатый", "семнадцатый", "восемнадцатый", "девятнадцатый"}
+	tenOrdinals     = [10]string{"", "", "двадцатый", "тридцатый", "сороковой", "пятидесятый", "шестидесятый", "семидесятый", "восьмидесятый", "девяностый"}
+	hundredOrdinals = [10]string{"", "сотый", "двухсотый", "трехсотый", "четырехсотый", "пятисотый", "шестисотый", "семисотый", "восьмисотый", "девятисотый"}
+
+	// prefixes form compound ordinals such as "двадцатипятитысячный"
+	unitPrefixes    = [10]string{"", "одно", "двух", "трех", "четырех", "пяти", "шести", "семи", "восьми", "девяти"}
+	teenPrefixes    = [10]string{"десяти", "одиннадцати", "двенадцати", "тринадцати", "четырнадцати", "пятнадцати", "шестнадцати", "семнадцати", "восемнадцати", "девятнадцати"}
+	tenPrefixes     = [10]string{"", "", "двадцати", "тридцати", "сорока", "пятидесяти", "шестидесяти", "семидесяти", "восьмидесяти", "девяноста"}
+	hundredPrefixes = [10]string{"", "сто", "двухсот", "трехсот", "четырехсот", "пятисот", "шестисот", "семисот", "восьмисот", "девятисот"}
+)
+
+// scale is a power of a thousand. Its forms agree with one, few and many.
+type scale struct {
+	forms   [3]string
+	gender  gender
+	ordinal string
+}
+
+var scales = []scale{
+	{},
+	{[3]string{"тысяча", "тысячи", "тысяч"}, feminine, "тысячный"},
+	{[3]string{"миллион", "миллиона", "миллионов"}, masculine, "миллионный"},
+	{[3]string{"миллиард", "миллиарда", "миллиардов"}, masculine, "миллиардный"},
+	{[3]string{"триллион", "триллиона", "триллионов"}, masculine, "триллионный"},
+	{[3]string{"квадриллион", "квадриллиона", "квадриллионов"}, masculine, "квадриллионный"},
+	{[3]string{"квинтиллион", "квинтиллиона", "квинтиллионов"}, masculine, "квинтиллионный"},
+}
+
+// currency is a monetary unit with its hundredth. Forms agree with one, few
+// and many.
+type currency struct {
+	major       [3]string
+	majorGender gender
+	minor       [3]string
+}
+
+var currencies = map[string]currency{
+	"RUB": {[3]string{"рубль", "рубля", "рублей"}, masculine, [3]string{"копейка", "копейки", "копеек"}},
+	"USD": {[3]string{"доллар", "доллара", "долларов"}, masculine, [3]string{"цент", "цента", "центов"}},
+	"EUR": {[3]string{"евро", "евро", "евро"}, masculine, [3]string{"цент", "цента", "центов"}},
+	"CNY": {[3]string{"юань", "юаня", "юаней"}, masculine, [3]string{"фэнь", "фэня", "фэней"}},
+}
+
+// NumberToWords implements the numberToWords builtin: an integer spelled in
+// Russian words, optionally in the feminine ("f") or neuter ("n") gender.
+func NumberToWords(params ...any) (string, error) {
+	if len(params) < 1 || len(params) > 2 {
+		return "", fmt.Errorf("numberToWords: expected 1 or 2 arguments, got %d", len(params))
+	}
+	n, err := toInteger("numberToWords", params[0])
+	if err != nil {
+		return "", err
+	}
+	g, err := toGender("numberToWords", params, 1)
+	if err != nil {
+		return "", err
+	}
+	words, err := integerWords(n, g)
+	if err != nil {
+		return "", fmt.Errorf("numberToWords: %w", err)
+	}
+	return strings.Join(words, " "), nil
+}
+
+// AmountToWords implements the amountToWords builtin: a sum of money spelled
+// out the way contracts do, "сто двадцать три рубля 45 копеек". The currency
+// is RUB by default; USD, EUR and CNY are supported as well. The hundredths
+// are kept in digits and joined to their unit with a no-break space, like the
+// digit groups of formatNumber.
+func AmountToWords(params ...any) (string, error) {
+	if len(params) < 1 || len(params) > 2 {
+		return "", fmt.Errorf("amountToWords: expected 1 or 2 arguments, got %d", len(params))
+	}
+	amount, err := toDecimal(params[0])
+	if err != nil {
+		return "", err
+	}
+	code := "RUB"
+	if len(params) == 2 {
+		s, ok := params[1].(string)
+		if !ok {
+			return "", fmt.Errorf("amountToWords: currency must be a string")
+		}
+		code = strings.ToUpper(s)
+	}
+	c, ok := currencies[code]
+	if !ok {
+		return "", fmt.Errorf("amountToWords: unknown currency %q", code)
+	}
+
+	amount = amount.Round(2)
+	major := amount.Truncate(0)
+	minor := amount.Sub(major).Abs().Shift(2).IntPart()
+
+	words, err := integerWords(major, c.majorGender)
+	if err != nil {
+		return "", fmt.Errorf("amountToWords: %w", err)
+	}
+	if amount.IsNegative() && major.IsZero() {
+		words = append([]string{"минус"}, words...)
+	}
+	words = append(words, c.major[pluralIndex(major)])
+
+	return fmt.Sprintf("%s %02d\u00a0%s", strings.Join(words, " "), minor, c.minor[pluralIndex(decimal.NewFromInt(minor))]), nil
+}
+
+// PluralForm implements the pluralForm builtin: the one of three noun forms
+// that agrees with a number, as in "1 лист", "2 листа", "5 листов". Fractions
+// take the second form, as in "2,5 листа".
+func PluralForm(params ...any) (string, error) {
+	if len(params) != 4 {
+		return "", fmt.Errorf("pluralForm: expected 4 arguments, got %d", len(params))
+	}
+	n, err := toDecimal(params[0])
+	if err != nil {
+		return "", err
+	}
+	var forms [3]string
+	for i := range forms {
+		s, ok := params[i+1].(string)
+		if !ok {
+			return "", fmt.Errorf("pluralForm: forms must be strings")
+		}
+		forms[i] = s
+	}
+	if !n.IsInteger() {
+		return forms[1], nil
+	}
+	return forms[pluralIndex(n)], nil
+}
+
+// Ordinal implements the ordinal builtin: a non-negative integer spelled as a
+// Russian ordinal numeral, optionally in the feminine ("f") or neuter ("n")
+// gender.
+func Ordinal(params ...any) (string, error) {
+	if len(params) < 1 || len(params) > 2 {
+		return "", fmt.Errorf("ordinal: expected 1 or 2 arguments, got %d", len(params))
+	}
+	n, err := toInteger("ordinal", params[0])
+	if err != nil {
+		return "", err
+	}
+	if n.IsNegative() {
+		return "", fmt.Errorf("ordinal: %s is negative", n)
+	}
+	g, err := toGender("ordinal", params, 1)
+	if err != nil {
+		return "", err
+	}
+	words, err := ordinalWords(n)
+	if err != nil {
+		return "", fmt.Errorf("ordinal: %w", err)
+	}
+	last := len(words) - 1
+	words[last] = inflectOrdinal(words[last], g)
+	return strings.Join(words, " "), nil
+}
+
+func funcNumberToWords(params ...any) (any, error) {
+	return NumberToWords(params...)
+}
+
+func funcAmountToWords(params ...any) (any, error) {
+	return AmountToWords(params...)
+}
+
+func funcPluralForm(params ...any) (any, error) {
+	return PluralForm(params...)
+}
+
+func funcOrdinal(params ...any) (any, error) {
+	return Ordinal(params...)
+}
+
+// integerWords spells an integer in cardinal words.
+func integerWords(n decimal.Decimal, g gender) ([]string, error) {
+	if n.IsZero() {
+		return []string{"ноль"}, nil
+	}
+
+	var words []string
+	if n.IsNegative() {
+		words = append(words, "минус")
+	}
+
+	groups, err := digitGroups(n.Abs())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(groups) - 1; i > 0; i-- {
+		if groups[i] == 0 {
+			continue
+		}
+		words = append(words, groupWords(groups[i], scales[i].gender)...)
+		words = append(words, scales[i].forms[pluralIndex(decimal.NewFromInt(int64(groups[i])))])
+	}
+	words = append(words, groupWords(groups[0], g)...)
+
+	return words, nil
+}
+
+// ordinalWords spells a positive integer in words of which the last one is
+// an ordinal in the masculine gender.
+func ordinalWords(n decimal.Decimal) ([]string, error) {
+	if n.IsZero() {
+		return []string{unitOrdinals[0]}, nil
+	}
+
+	groups, err := digitGroups(n)
+	if err != nil {
+		return nil, err
+	}
+
+	low := 0
+	for groups[low] == 0 {
+		low++
+	}
+
+	var words []string
+	for i := len(groups) - 1; i > low; i-- {
+		if groups[i] == 0 {
+			continue
+		}
+		words = append(words, groupWords(groups[i], scales[i].gender)...)
+		words = append(words, scales[i].forms[pluralIndex(decimal.NewFromInt(int64(groups[i])))])
+	}
+
+	if low > 0 {
+		return append(words, groupPrefix(groups[low])+scales[low].ordinal), nil
+	}
+
+	group := groups[0]
+	if group%100 == 0 {
+		return append(words, hundredOrdinals[group/100]), nil
+	}
+	if h := group / 100; h > 0 {
+		words = append(words, hundredWords[h])
+	}
+	switch rest := group % 100; {
+	case rest >= 10 && rest < 20:
+		words = append(words, teenOrdinals[rest-10])
+	case rest%10 == 0:
+		words = append(words, tenOrdinals[rest/10])
+	default:
+		if t := rest / 10; t > 0 {
+			words = append(words, tenWords[t])
+		}
+		words = append(words, unitOrdinals[rest%10])
+	}
+
+	return words, nil
+}
+
+// groupWords spells a number below a thousand.
+func groupWords(n int, g gender) []string {
+	var words []string
+	if h := n / 100; h > 0 {
+		words = append(words, hundredWords[h])
+	}
+	switch rest := n % 100; {
+	case rest >= 10 && rest < 20:
+		words = append(words, teenWords[rest-10])
+	default:
+		if t := rest / 10; t > 0 {
+			words = append(words, tenWords[t])
+		}
+		if u := rest % 10; u > 0 {
+			words = append(words, unitWords[g][u])
+		}
+	}
+	return words
+}
+
+// groupPrefix is the combining form of a number below a thousand in compound
+// ordinals. One thousand makes "тысячный" rather than "однотысячный".
+func groupPrefix(n int) string {
+	if n == 1 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(hundredPrefixes[n/100])
+	switch rest := n % 100; {
+	case rest >= 10 && rest < 20:
+		b.WriteString(teenPrefixes[rest-10])
+	default:
+		b.WriteString(tenPrefixes[rest/10])
+		b.WriteString(unitPrefixes[rest%10])
+	}
+	return b.String()
+}
+
+// inflectOrdinal puts an ordinal in the masculine gender into the given one.
+func inflectOrdinal(word string, g gender) string {
+	if g == masculine {
+		return word
+	}
+	if word == "третий" {
+		return map[gender]string{feminine: "третья", neuter: "третье"}[g]
+	}
+	stem := word[:len(word)-len("ый")]
+	if g == feminine {
+		return stem + "ая"
+	}
+	return stem + "ое"
+}
+
+// digitGroups splits a non-negative integer into groups of three digits,
+// starting from the lowest.
+func digitGroups(n decimal.Decimal) ([]int, error) {
+	digits := n.String()
+	if (len(digits)+2)/3 > len(scales) {
+		return nil, fmt.Errorf("%s is too large", n)
+	}
+
+	var groups []int
+	for end := len(digits); end > 0; end -= 3 {
+		group := 0
+		for _, c := range digits[max(end-3, 0):end] {
+			group = group*10 + int(c-'0')
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// pluralIndex selects the one, few or many form agreeing with an integer.
+func pluralIndex(n decimal.Decimal) int {
+	rest := int(n.Abs().Mod(decimal.NewFromInt(100)).IntPart())
+	if rest >= 11 && rest <= 14 {
+		return 2
+	}
+	switch rest % 10 {
+	case 1:
+		return 0
+	case 2, 3, 4:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func toInteger(fn string, v any) (decimal.Decimal, error) {
+	n, err := toDecimal(v)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if !n.IsInteger() {
+		return decimal.Decimal{}, fmt.Errorf("%s: %s is not an integer", fn, n)
+	}
+	return n, nil
+}
+
+func toGender(fn string, params []any, i int) (gender, error) {
+	if len(params) <= i {
+		return masculine, nil
+	}
+	s, ok := params[i].(string)
+	if !ok {
+		return 0, fmt.Errorf("%s: gender must be a string", fn)
+	}
+	g, ok := genders[s]
+	if !ok {
+		return 0, fmt.Errorf("%s: unknown gender %q", fn, s)
+	}
+	return g, nil
+}
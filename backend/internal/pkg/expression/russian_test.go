@@ -0,0 +1,141 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumberToWords(t *testing.T) {
+	tests := []struct {
+		params []any
+		want   string
+	}{
+		{[]any{0}, "ноль"},
+		{[]any{1}, "один"},
+		{[]any{1, "f"}, "одна"},
+		{[]any{2, "n"}, "два"},
+		{[]any{12}, "двенадцать"},
+		{[]any{40}, "сорок"},
+		{[]any{101}, "сто один"},
+		{[]any{1000}, "одна тысяча"},
+		{[]any{2002}, "две тысячи два"},
+		{[]any{5000}, "пять тысяч"},
+		{[]any{11000}, "одиннадцать тысяч"},
+		{[]any{21000, "f"}, "двадцать одна тысяча"},
+		{[]any{1000001}, "один миллион один"},
+		{[]any{int64(-1234567)}, "минус один миллион двести тридцать четыре тысячи пятьсот шестьдесят семь"},
+		{[]any{3.0}, "три"},
+		{[]any{decimal.RequireFromString("2000000000")}, "два миллиарда"},
+	}
+
+	for _, tt := range tests {
+		got, err := NumberToWords(tt.params...)
+		require.NoError(t, err, tt.params)
+		require.Equal(t, tt.want, got)
+	}
+}
+
+func TestNumberToWords_Error(t *testing.T) {
+	tests := [][]any{
+		{},
+		{1.5},
+		{"1"},
+		{1, "x"},
+		{decimal.RequireFromString("1e30")},
+	}
+
+	for _, params := range tests {
+		_, err := NumberToWords(params...)
+		require.Error(t, err, params)
+	}
+}
+
+func TestAmountToWords(t *testing.T) {
+	tests := []struct {
+		params []any
+		want   string
+	}{
+		{[]any{0}, "ноль рублей 00 копеек"},
+		{[]any{1.01}, "один рубль 01 копейка"},
+		{[]any{123.45}, "сто двадцать три рубля 45 копеек"},
+		{[]any{decimal.RequireFromString("21000.224")}, "двадцать одна тысяча рублей 22 копейки"},
+		{[]any{2.5, "usd"}, "два доллара 50 центов"},
+		{[]any{11, "EUR"}, "одиннадцать евро 00 центов"},
+		{[]any{-0.5}, "минус ноль рублей 50 копеек"},
+	}
+
+	for _, tt := range tests {
+		got, err := AmountToWords(tt.params...)
+		require.NoError(t, err, tt.params)
+		require.Equal(t, tt.want, got)
+	}
+
+	_, err := AmountToWords(1, "XXX")
+	require.Error(t, err)
+}
+
+func TestPluralForm(t *testing.T) {
+	tests := []struct {
+		n    any
+		want string
+	}{
+		{0, "листов"},
+		{1, "лист"},
+		{2, "листа"},
+		{5, "листов"},
+		{11, "листов"},
+		{14, "листов"},
+		{21, "лист"},
+		{22, "листа"},
+		{111, "листов"},
+		{-3, "листа"},
+		{2.5, "листа"},
+		{decimal.RequireFromString("101"), "лист"},
+	}
+
+	for _, tt := range tests {
+		got, err := PluralForm(tt.n, "лист", "листа", "листов")
+		require.NoError(t, err, tt.n)
+		require.Equal(t, tt.want, got, tt.n)
+	}
+
+	_, err := PluralForm(1, "лист", "листа")
+	require.Error(t, err)
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		params []any
+		want   string
+	}{
+		{[]any{0}, "нулевой"},
+		{[]any{1}, "первый"},
+		{[]any{2, "f"}, "вторая"},
+		{[]any{3}, "третий"},
+		{[]any{3, "f"}, "третья"},
+		{[]any{3, "n"}, "третье"},
+		{[]any{13}, "тринадцатый"},
+		{[]any{40}, "сороковой"},
+		{[]any{21, "f"}, "двадцать первая"},
+		{[]any{100}, "сотый"},
+		{[]any{120}, "сто двадцатый"},
+		{[]any{300, "n"}, "трехсотое"},
+		{[]any{1000}, "тысячный"},
+		{[]any{2000}, "двухтысячный"},
+		{[]any{2024}, "две тысячи двадцать четвертый"},
+		{[]any{25000}, "двадцатипятитысячный"},
+		{[]any{1000000}, "миллионный"},
+		{[]any{3001000}, "три миллиона тысячный"},
+	}
+
+	for _, tt := range tests {
+		got, err := Ordinal(tt.params...)
+		require.NoError(t, err, tt.params)
+		require.Equal(t, tt.want, got)
+	}
+
+	_, err := Ordinal(-1)
+	require.Error(t, err)
+}
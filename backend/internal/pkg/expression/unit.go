@@ -0,0 +1,436 @@
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/parser"
+	"github.com/shopspring/decimal"
+
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/unit"
+)
+
+var ErrUnitMismatch = errors.New("unit mismatch")
+
+// kind tells what is known about the unit of a value while checking units.
+type kind int
+
+const (
+	// kindUnknown is anything the checker cannot follow, such as table cells,
+	// results of most builtins and non-numbers. It is compatible with any unit.
+	kindUnknown kind = iota
+	// kindNumber is a number without a unit, such as a literal or a variable
+	// that declares no unit. It takes the unit of the other operand.
+	kindNumber
+	// kindUnit is a quantity of a known unit.
+	kindUnit
+)
+
+type quantity struct {
+	kind kind
+	unit unit.Unit
+}
+
+var (
+	unknown = quantity{kind: kindUnknown}
+	number  = quantity{kind: kindNumber}
+)
+
+// ConstantUnits returns the units of the math constants, to be checked along
+// with the units of variables: g is 9.80665 m/s², so mass * g is in newtons.
+func ConstantUnits() map[string]unit.Unit {
+	return map[string]unit.Unit{"g": unit.Must("m/s^2")}
+}
+
+// CheckUnits checks the units of a source expression given the units declared
+// by variables: only quantities of the same unit may be added, subtracted or
+// compared, so metres are never added to kilograms nor millimetres to metres
+// without convert. It returns the unit of the result and whether it is known.
+// A source that does not parse passes: compiling it reports the error.
+func CheckUnits(source string, units map[string]unit.Unit) (unit.Unit, bool, error) {
+	tree, err := parser.Parse(source)
+	if err != nil {
+		return unit.Unit{}, false, nil
+	}
+
+	c := unitChecker{units: units, scope: make(map[string]quantity)}
+	q, err := c.check(tree.Node)
+	if err != nil {
+		return unit.Unit{}, false, err
+	}
+	return q.unit, q.kind == kindUnit, nil
+}
+
+type unitChecker struct {
+	units map[string]unit.Unit
+	// scope holds the names bound with let
+	scope map[string]quantity
+}
+
+func (c unitChecker) check(node ast.Node) (quantity, error) {
+	switch n := node.(type) {
+	case *ast.IntegerNode, *ast.FloatNode, *ast.ConstantNode:
+		return number, nil
+	case *ast.IdentifierNode:
+		if q, ok := c.scope[n.Value]; ok {
+			return q, nil
+		}
+		if u, ok := c.units[n.Value]; ok {
+			return quantity{kind: kindUnit, unit: u}, nil
+		}
+		return number, nil
+	case *ast.UnaryNode:
+		q, err := c.check(n.Node)
+		if err != nil || n.Operator == "-" || n.Operator == "+" {
+			return q, err
+		}
+		return unknown, nil
+	case *ast.BinaryNode:
+		return c.checkBinary(n)
+	case *ast.ConditionalNode:
+		if _, err := c.check(n.Cond); err != nil {
+			return unknown, err
+		}
+		return c.checkAll("combine", n.Exp1, n.Exp2)
+	case *ast.CallNode:
+		callee, ok := n.Callee.(*ast.IdentifierNode)
+		if !ok {
+			return c.checkNodes(append([]ast.Node{n.Callee}, n.Arguments...)...)
+		}
+		return c.checkCall(callee.Value, n.Arguments)
+	case *ast.BuiltinNode:
+		return c.checkCall(n.Name, n.Arguments)
+	case *ast.VariableDeclaratorNode:
+		q, err := c.check(n.Value)
+		if err != nil {
+			return unknown, err
+		}
+		outer, shadowed := c.scope[n.Name]
+		c.scope[n.Name] = q
+		defer func() {
+			if shadowed {
+				c.scope[n.Name] = outer
+			} else {
+				delete(c.scope, n.Name)
+			}
+		}()
+		return c.check(n.Expr)
+	case *ast.SequenceNode:
+		q := unknown
+		for _, node := range n.Nodes {
+			var err error
+			if q, err = c.check(node); err != nil {
+				return unknown, err
+			}
+		}
+		return q, nil
+	case *ast.ChainNode:
+		return c.checkNodes(n.Node)
+	case *ast.MemberNode:
+		return c.checkNodes(n.Node, n.Property)
+	case *ast.SliceNode:
+		return c.checkNodes(n.Node, n.From, n.To)
+	case *ast.PredicateNode:
+		return c.checkNodes(n.Node)
+	case *ast.ArrayNode:
+		return c.checkNodes(n.Nodes...)
+	case *ast.MapNode:
+		return c.checkNodes(n.Pairs...)
+	case *ast.PairNode:
+		return c.checkNodes(n.Key, n.Value)
+	default:
+		return unknown, nil
+	}
+}
+
+// checkNodes checks nodes whose units do not matter to the result.
+func (c unitChecker) checkNodes(nodes ...ast.Node) (quantity, error) {
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+		if _, err := c.check(node); err != nil {
+			return unknown, err
+		}
+	}
+	return unknown, nil
+}
+
+// checkAll checks nodes that must all have the same unit, that of the result.
+func (c unitChecker) checkAll(verb string, nodes ...ast.Node) (quantity, error) {
+	result := number
+	for i, node := range nodes {
+		q, err := c.check(node)
+		if err != nil {
+			return unknown, err
+		}
+		if i == 0 {
+			result = q
+			continue
+		}
+		if result, err = same(verb, result, q); err != nil {
+			return unknown, err
+		}
+	}
+	return result, nil
+}
+
+func (c unitChecker) checkBinary(n *ast.BinaryNode) (quantity, error) {
+	left, err := c.check(n.Left)
+	if err != nil {
+		return unknown, err
+	}
+	right, err := c.check(n.Right)
+	if err != nil {
+		return unknown, err
+	}
+
+	switch n.Operator {
+	case "+":
+		return same("add", left, right)
+	case "-":
+		return same("subtract", left, right)
+	case "%", "??":
+		return same("combine", left, right)
+	case "==", "!=", "<", "<=", ">", ">=":
+		_, err := same("compare", left, right)
+		return unknown, err
+	case "*":
+		return product(left, right, false), nil
+	case "/":
+		return product(left, right, true), nil
+	case "**", "^":
+		if left.kind != kindUnit || right.kind == kindUnit {
+			return plain(left, right), nil
+		}
+		exponent, ok := integerLiteral(n.Right)
+		if !ok {
+			return unknown, nil
+		}
+		return quantity{kind: kindUnit, unit: left.unit.Pow(exponent)}, nil
+	default:
+		return unknown, nil
+	}
+}
+
+func (c unitChecker) checkCall(name string, arguments []ast.Node) (quantity, error) {
+	switch name {
+	case "convert":
+		return c.checkConvert(arguments)
+	case "abs", "ceil", "floor", "round":
+		if len(arguments) == 0 {
+			return unknown, nil
+		}
+		q, err := c.check(arguments[0])
+		if err != nil {
+			return unknown, err
+		}
+		_, err = c.checkNodes(arguments[1:]...)
+		return q, err
+	case "min", "max", "clamp", "roundStep":
+		return c.checkAll("combine", arguments...)
+	default:
+		return c.checkNodes(arguments...)
+	}
+}
+
+// checkConvert checks that convert gets a value of the unit it converts from
+// and units of the same dimension.
+func (c unitChecker) checkConvert(arguments []ast.Node) (quantity, error) {
+	if len(arguments) != 3 {
+		return c.checkNodes(arguments...)
+	}
+	x, err := c.check(arguments[0])
+	if err != nil {
+		return unknown, err
+	}
+
+	from, fromOK := arguments[1].(*ast.StringNode)
+	to, toOK := arguments[2].(*ast.StringNode)
+	if !fromOK || !toOK {
+		return unknown, nil
+	}
+
+	src, err := unit.Parse(from.Value)
+	if err != nil {
+		return unknown, fmt.Errorf("convert: %w", err)
+	}
+	dst, err := unit.Parse(to.Value)
+	if err != nil {
+		return unknown, fmt.Errorf("convert: %w", err)
+	}
+	if !src.Compatible(dst) {
+		return unknown, fmt.Errorf("%w: cannot convert %s to %s", ErrUnitMismatch, src, dst)
+	}
+
+	if x.kind == kindUnit && !x.unit.Equivalent(src) {
+		return unknown, fmt.Errorf("%w: convert from %s is given %s", ErrUnitMismatch, src, x.unit)
+	}
+	return quantity{kind: kindUnit, unit: dst}, nil
+}
+
+// same returns the unit of a result of operands that must have the same unit.
+func same(verb string, a, b quantity) (quantity, error) {
+	if a.kind == kindUnit && b.kind == kindUnit {
+		if !a.unit.Equivalent(b.unit) {
+			return unknown, fmt.Errorf("%w: cannot %s %s and %s", ErrUnitMismatch, verb, a.unit, b.unit)
+		}
+		return a, nil
+	}
+	return plain(a, b), nil
+}
+
+// plain returns the unit of a result of operands of which at most one has a
+// known unit.
+func plain(a, b quantity) quantity {
+	switch {
+	case a.kind == kindUnknown || b.kind == kindUnknown:
+		return unknown
+	case a.kind == kindUnit:
+		return a
+	default:
+		return b
+	}
+}
+
+// product returns the unit of a product or a quotient. Dimensionless units
+// such as percent act as plain numbers there, and so do dimensionless
+// results, e.g. of mm/mm.
+func product(a, b quantity, divide bool) quantity {
+	if a.kind == kindUnit && a.unit.Dimensionless() {
+		a = number
+	}
+	if b.kind == kindUnit && b.unit.Dimensionless() {
+		b = number
+	}
+
+	var u unit.Unit
+	switch {
+	case a.kind == kindUnknown || b.kind == kindUnknown:
+		return unknown
+	case a.kind == kindNumber && b.kind == kindNumber:
+		return number
+	case a.kind == kindNumber:
+		u = b.unit
+		if divide {
+			u = unit.One.Div(u)
+		}
+	case b.kind == kindNumber:
+		u = a.unit
+	case divide:
+		u = a.unit.Div(b.unit)
+	default:
+		u = a.unit.Mul(b.unit)
+	}
+
+	if u.Dimensionless() {
+		return number
+	}
+	return quantity{kind: kindUnit, unit: u}
+}
+
+func integerLiteral(node ast.Node) (int, bool) {
+	switch n := node.(type) {
+	case *ast.IntegerNode:
+		return n.Value, true
+	case *ast.UnaryNode:
+		if i, ok := integerLiteral(n.Node); ok && n.Operator == "-" {
+			return -i, true
+		}
+	}
+	return 0, false
+}
+
+func funcConvert(params ...any) (any, error) {
+	return Convert(params...)
+}
+
+// Convert implements the convert builtin: convert(x, "mm", "m") converts x
+// from millimetres to metres. Decimals are converted exactly, other numbers
+// give a float64.
+func Convert(params ...any) (any, error) {
+	if len(params) != 3 {
+		return nil, fmt.Errorf("convert: expected 3 arguments, got %d", len(params))
+	}
+	from, err := toUnit("convert", params[1])
+	if err != nil {
+		return nil, err
+	}
+	to, err := toUnit("convert", params[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if d, ok := params[0].(decimal.Decimal); ok {
+		y, err := unit.ConvertDecimal(d, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("convert: %w", err)
+		}
+		return y, nil
+	}
+
+	x, err := toFloat(params[0])
+	if err != nil {
+		return nil, fmt.Errorf("convert: %w", err)
+	}
+	y, err := unit.ConvertFloat(x, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("convert: %w", err)
+	}
+	return y, nil
+}
+
+func funcFormatUnit(params ...any) (any, error) {
+	return FormatUnit(params...)
+}
+
+// FormatUnit implements the formatUnit builtin: the number as formatNumber
+// formats it, followed by a no-break space and the Russian symbol of the
+// unit, e.g. formatUnit(9.81, "m/s^2", 2) is "9,81 м/с²". Without decimals
+// the number is written as is.
+func FormatUnit(params ...any) (string, error) {
+	if len(params) < 2 || len(params) > 3 {
+		return "", fmt.Errorf("formatUnit: expected 2 or 3 arguments, got %d", len(params))
+	}
+	u, err := toUnit("formatUnit", params[1])
+	if err != nil {
+		return "", err
+	}
+
+	var s string
+	if len(params) == 3 {
+		s, err = FormatNumber(params[0], params[2])
+	} else {
+		s, err = shortest(params[0])
+	}
+	if err != nil {
+		return "", fmt.Errorf("formatUnit: %w", err)
+	}
+	return s + "\u00a0" + u.String(), nil
+}
+
+// shortest formats a number with as many decimals as it takes.
+func shortest(v any) (string, error) {
+	if d, ok := v.(decimal.Decimal); ok {
+		return groupNumber(d.String(), ",", "\u00a0"), nil
+	}
+	x, err := toFloat(v)
+	if err != nil {
+		return "", err
+	}
+	return groupNumber(strconv.FormatFloat(x, 'f', -1, 64), ",", "\u00a0"), nil
+}
+
+func toUnit(fn string, v any) (unit.Unit, error) {
+	s, ok := v.(string)
+	if !ok {
+		return unit.Unit{}, fmt.Errorf("%s: unit must be a string", fn)
+	}
+	u, err := unit.Parse(s)
+	if err != nil {
+		return unit.Unit{}, fmt.Errorf("%s: %w", fn, err)
+	}
+	return u, nil
+}
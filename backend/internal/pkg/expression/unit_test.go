@@ -0,0 +1,126 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/unit"
+)
+
+func TestCheckUnits(t *testing.T) {
+	units := ConstantUnits()
+	units["length"] = unit.Must("mm")
+	units["width"] = unit.Must("mm")
+	units["height"] = unit.Must("m")
+	units["mass"] = unit.Must("kg")
+	units["time"] = unit.Must("s")
+	units["allowance"] = unit.Must("%")
+
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"length + width", "мм"},
+		{"length * 2 + 10", "мм"},
+		{"length * width", "мм·мм"},
+		{"length ** 2", "мм²"},
+		{"height / time / time", "м/с/с"},
+		{"mass * g", "кг·м/с²"},
+		{`convert(length, "mm", "m") + height`, "м"},
+		{"length * (1 + allowance / 100)", "мм"},
+		{"length / width", ""},
+		{"count + 1", ""},
+		{"max(length, width, 100)", "мм"},
+		{"round(height, 2)", "м"},
+		{"flag ? length : width", "мм"},
+		{"let x = height * 2; x - height", "м"},
+		{"sum(rows, .a + .b) + length", ""},
+		{"length > 0 && mass < 10", ""},
+		{"1 +", ""},
+	}
+
+	for _, tt := range tests {
+		got, known, err := CheckUnits(tt.source, units)
+		require.NoError(t, err, tt.source)
+		if tt.want == "" {
+			require.False(t, known, tt.source)
+			continue
+		}
+		require.True(t, known, tt.source)
+		require.Equal(t, tt.want, got.String(), tt.source)
+	}
+}
+
+func TestCheckUnits_Mismatch(t *testing.T) {
+	units := map[string]unit.Unit{
+		"length": unit.Must("mm"),
+		"height": unit.Must("m"),
+		"mass":   unit.Must("kg"),
+	}
+
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"height + mass", "cannot add м and кг"},
+		{"length - height", "cannot subtract мм and м"},
+		{"length < height", "cannot compare мм and м"},
+		{"flag ? length : mass", "cannot combine мм and кг"},
+		{"min(length, height)", "cannot combine мм and м"},
+		{"let x = mass; x + length", "cannot add кг and мм"},
+		{"map(rows, length + mass)", "cannot add мм and кг"},
+		{`convert(height, "mm", "m")`, "convert from мм is given м"},
+		{`convert(height, "m", "kg")`, "cannot convert м to кг"},
+	}
+
+	for _, tt := range tests {
+		_, _, err := CheckUnits(tt.source, units)
+		require.ErrorIs(t, err, ErrUnitMismatch, tt.source)
+		require.ErrorContains(t, err, tt.want, tt.source)
+	}
+
+	_, _, err := CheckUnits(`convert(height, "m", "furlong")`, units)
+	require.ErrorIs(t, err, unit.ErrUnknown)
+}
+
+func TestConvert(t *testing.T) {
+	env := map[string]any{
+		"length": int64(1500),
+		"price":  decimal.RequireFromString("2.5"),
+	}
+
+	tests := []struct {
+		source string
+		want   any
+	}{
+		{`convert(length, "mm", "m")`, 1.5},
+		{`convert(price, "m", "mm")`, decimal.RequireFromString("2500")},
+		{`convert(20, "°C", "K")`, 293.15},
+		{`formatUnit(convert(length, "mm", "m"), "m", 2)`, "1,50\u00a0м"},
+		{`formatUnit(12000, "N")`, "12\u00a0000\u00a0Н"},
+		{`formatUnit(price, "m/s^2")`, "2,5\u00a0м/с²"},
+	}
+
+	for _, tt := range tests {
+		program, err := Compile(tt.source, env)
+		require.NoError(t, err, tt.source)
+
+		got, err := Run(program, env, 0)
+		require.NoError(t, err, tt.source)
+		if d, ok := tt.want.(decimal.Decimal); ok {
+			require.True(t, d.Equal(got.(decimal.Decimal)), tt.source)
+			continue
+		}
+		require.Equal(t, tt.want, got, tt.source)
+	}
+
+	for _, source := range []string{`convert(length, "mm", "kg")`, `convert(length, "mm", "furlong")`, `formatUnit(length, 1)`} {
+		program, err := Compile(source, env)
+		require.NoError(t, err, source)
+
+		_, err = Run(program, env, 0)
+		require.Error(t, err, source)
+	}
+}
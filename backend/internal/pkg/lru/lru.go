@@ -0,0 +1,92 @@
+package lru
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Stats are the counters of a cache since it was created.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Len       int
+}
+
+// Cache is a fixed-size cache that evicts the least recently used entry once
+// it is full. It is safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[K]*list.Element
+	stats   Stats
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// New returns a cache holding at most size entries. A cache of non-positive
+// size stores nothing and reports every lookup as a miss.
+func New[K comparable, V any](size int) *Cache[K, V] {
+	return &Cache[K, V]{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[K]*list.Element),
+	}
+}
+
+// Get returns the value stored under key and marks it as recently used.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	c.stats.Hits++
+	c.order.MoveToFront(element)
+	return element.Value.(*entry[K, V]).value, true
+}
+
+// Add stores value under key, evicting the least recently used entry if the
+// cache is full.
+func (c *Cache[K, V]) Add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.size <= 0 {
+		return
+	}
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*entry[K, V]).value = value
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&entry[K, V]{key: key, value: value})
+
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry[K, V]).key)
+		c.stats.Evictions++
+	}
+}
+
+// Stats returns the current counters.
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Len = c.order.Len()
+	return stats
+}
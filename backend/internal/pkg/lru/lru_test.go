@@ -0,0 +1,48 @@
+package lru
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	cache := New[int64, string](2)
+
+	_, ok := cache.Get(1)
+	require.False(t, ok)
+
+	cache.Add(1, "a")
+	cache.Add(2, "b")
+
+	got, ok := cache.Get(1)
+	require.True(t, ok)
+	require.Equal(t, "a", got)
+
+	// 2 is the least recently used entry
+	cache.Add(3, "c")
+
+	_, ok = cache.Get(2)
+	require.False(t, ok)
+
+	got, ok = cache.Get(3)
+	require.True(t, ok)
+	require.Equal(t, "c", got)
+
+	cache.Add(3, "d")
+	got, ok = cache.Get(3)
+	require.True(t, ok)
+	require.Equal(t, "d", got)
+
+	require.Equal(t, Stats{Hits: 3, Misses: 2, Evictions: 1, Len: 2}, cache.Stats())
+}
+
+func TestCache_ZeroSize(t *testing.T) {
+	cache := New[int64, string](0)
+
+	cache.Add(1, "a")
+
+	_, ok := cache.Get(1)
+	require.False(t, ok)
+	require.Equal(t, Stats{Misses: 1}, cache.Stats())
+}
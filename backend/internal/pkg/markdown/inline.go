@@ -0,0 +1,223 @@
+package markdown
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	breakTagRe = regexp.MustCompile(`^(?i)<br\s*/?>`)
+	autolinkRe = regexp.MustCompile(`^<((?:https?|mailto):[^<>\s]+)>`)
+)
+
+type inlineParser struct {
+	src    string
+	spans  []Span
+	text   strings.Builder
+	bold   bool
+	italic bool
+	link   string
+}
+
+// parseInline parses emphasis, code spans, links, escapes and line breaks.
+// A delimiter without a closing pair is kept as text.
+func parseInline(src string) []Span {
+	p := inlineParser{src: src}
+	p.parse()
+	return p.spans
+}
+
+func (p *inlineParser) parse() {
+	src := p.src
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\\' && i+1 < len(src) && isPunct(src[i+1]):
+			p.text.WriteByte(src[i+1])
+			i += 2
+		case c == '\n':
+			p.flush()
+			p.spans = append(p.spans, Span{Text: "\n"})
+			i++
+		case c == '`':
+			i = p.parseCode(i)
+		case c == '*' || c == '_':
+			i = p.parseEmphasis(i)
+		case c == '[' || (c == '!' && strings.HasPrefix(src[i+1:], "[")):
+			i = p.parseLink(i)
+		case c == '<':
+			i = p.parseTag(i)
+		default:
+			p.text.WriteByte(c)
+			i++
+		}
+	}
+	p.flush()
+}
+
+// flush ends the current span.
+func (p *inlineParser) flush() {
+	if p.text.Len() == 0 {
+		return
+	}
+
+	p.spans = append(p.spans, Span{
+		Text:   html.UnescapeString(p.text.String()),
+		Bold:   p.bold,
+		Italic: p.italic,
+		Link:   p.link,
+	})
+	p.text.Reset()
+}
+
+func (p *inlineParser) parseCode(i int) int {
+	n := runLength(p.src, i)
+	fence := p.src[i : i+n]
+
+	end := -1
+	for j := i + n; j < len(p.src); {
+		k := strings.Index(p.src[j:], fence)
+		if k < 0 {
+			break
+		}
+
+		if m := runLength(p.src, j+k); m == n {
+			end = j + k
+			break
+		} else {
+			j += k + m
+		}
+	}
+
+	if end < 0 {
+		p.text.WriteString(fence)
+		return i + n
+	}
+
+	code := p.src[i+n : end]
+	if len(code) > 1 && strings.HasPrefix(code, " ") && strings.HasSuffix(code, " ") {
+		code = code[1 : len(code)-1]
+	}
+
+	p.flush()
+	p.spans = append(p.spans, Span{Text: code, Code: true, Bold: p.bold, Italic: p.italic, Link: p.link})
+	return end + n
+}
+
+// parseEmphasis toggles bold for "**" and "__", italic for "*" and "_" and
+// both for a run of three. "_" inside a word is kept as text.
+func (p *inlineParser) parseEmphasis(i int) int {
+	n := min(runLength(p.src, i), 3)
+	delimiter := p.src[i : i+n]
+
+	prev, _ := utf8.DecodeLastRuneInString(p.src[:i])
+	next, _ := utf8.DecodeRuneInString(p.src[i+n:])
+
+	if delimiter[0] == '_' && isWordRune(prev) && isWordRune(next) {
+		p.text.WriteString(delimiter)
+		return i + n
+	}
+
+	canOpen := i+n < len(p.src) && !unicode.IsSpace(next)
+	canClose := i > 0 && !unicode.IsSpace(prev)
+
+	bold, italic := n >= 2, n != 2
+	open := (bold && p.bold) || (italic && p.italic)
+
+	switch {
+	case open && canClose:
+		p.flush()
+		p.bold = p.bold && !bold
+		p.italic = p.italic && !italic
+	case !open && canOpen && strings.Contains(p.src[i+n:], delimiter):
+		p.flush()
+		p.bold = p.bold || bold
+		p.italic = p.italic || italic
+	default:
+		p.text.WriteString(delimiter)
+	}
+
+	return i + n
+}
+
+// parseLink keeps the text of a link with its target. An image is replaced
+// by its alternative text.
+func (p *inlineParser) parseLink(i int) int {
+	image := p.src[i] == '!'
+	start := i + 1
+	if image {
+		start++
+	}
+
+	textEnd := strings.Index(p.src[start:], "](")
+	if textEnd < 0 {
+		p.text.WriteByte(p.src[i])
+		return i + 1
+	}
+	textEnd += start
+
+	targetEnd := strings.IndexByte(p.src[textEnd+2:], ')')
+	if targetEnd < 0 {
+		p.text.WriteByte(p.src[i])
+		return i + 1
+	}
+	targetEnd += textEnd + 2
+
+	text := p.src[start:textEnd]
+	target := strings.TrimSpace(p.src[textEnd+2 : targetEnd])
+	if fields := strings.Fields(target); len(fields) > 0 {
+		target = fields[0]
+	}
+
+	p.flush()
+	for _, span := range parseInline(text) {
+		span.Bold = span.Bold || p.bold
+		span.Italic = span.Italic || p.italic
+		if !image {
+			span.Link = target
+		}
+		p.spans = append(p.spans, span)
+	}
+
+	return targetEnd + 1
+}
+
+// parseTag turns <br> into a line break and <http://...> into a link. Other
+// tags are kept as text.
+func (p *inlineParser) parseTag(i int) int {
+	rest := p.src[i:]
+
+	if match := breakTagRe.FindString(rest); match != "" {
+		p.flush()
+		p.spans = append(p.spans, Span{Text: "\n"})
+		return i + len(match)
+	}
+
+	if match := autolinkRe.FindStringSubmatch(rest); match != nil {
+		p.flush()
+		p.spans = append(p.spans, Span{Text: match[1], Bold: p.bold, Italic: p.italic, Link: match[1]})
+		return i + len(match[0])
+	}
+
+	p.text.WriteByte('<')
+	return i + 1
+}
+
+func runLength(s string, i int) int {
+	n := 1
+	for i+n < len(s) && s[i+n] == s[i] {
+		n++
+	}
+	return n
+}
+
+func isPunct(c byte) bool {
+	return c < utf8.RuneSelf && unicode.IsPunct(rune(c)) || strings.IndexByte("`*_<>|+-=~^$", c) >= 0
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
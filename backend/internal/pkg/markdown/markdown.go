@@ -0,0 +1,96 @@
+package markdown
+
+// Block is a block of a document: Heading, Paragraph, List, Table, Quote, Code
+// or Rule.
+type Block interface {
+	block()
+}
+
+type Heading struct {
+	// Level is 1 for "#" up to 6 for "######".
+	Level int
+	Text  []Span
+}
+
+type Paragraph struct {
+	Text []Span
+}
+
+type List struct {
+	Ordered bool
+	// Start is the number of the first item of an ordered list.
+	Start int
+	Items []ListItem
+}
+
+type ListItem struct {
+	Text []Span
+	// Children are the nested lists of the item.
+	Children []Block
+}
+
+type Align int
+
+const (
+	AlignNone Align = iota
+	AlignLeft
+	AlignCenter
+	AlignRight
+)
+
+type Table struct {
+	Header []Cell
+	Rows   [][]Cell
+	// Align holds the alignment of every column.
+	Align []Align
+}
+
+type Cell struct {
+	Text []Span
+}
+
+type Quote struct {
+	Blocks []Block
+}
+
+type Code struct {
+	Language string
+	Text     string
+}
+
+// Rule is a thematic break, "---".
+type Rule struct{}
+
+func (Heading) block()   {}
+func (Paragraph) block() {}
+func (List) block()      {}
+func (Table) block()     {}
+func (Quote) block()     {}
+func (Code) block()      {}
+func (Rule) block()      {}
+
+// Span is a piece of inline text with the same formatting. A Text of "\n" is a
+// hard line break.
+type Span struct {
+	Text   string
+	Bold   bool
+	Italic bool
+	Code   bool
+	// Link is the target of a link, empty if the span is not a link.
+	Link string
+}
+
+// PlainText joins the text of the spans without formatting.
+func PlainText(spans []Span) string {
+	n := 0
+	for _, s := range spans {
+		n += len(s.Text)
+	}
+
+	b := make([]byte, 0, n)
+	for _, s := range spans {
+		b = append(b, s.Text...)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,95 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	src := "# Техническое задание\n" +
+		"\n" +
+		"| Реквизит | Значение |\n" +
+		"| :--- | ---: |\n" +
+		"| Шифр | `АБВГ.01` |\n" +
+		"| Заказчик \\| Исполнитель |\n" +
+		"\n" +
+		"> Документ подготовлен по **ГОСТ 34.602-2020**.\n" +
+		"\n" +
+		"## 1. Общие сведения ##\n" +
+		"Первая строка\n" +
+		"вторая строка  \n" +
+		"третья строка.\n" +
+		"\n" +
+		"- первый\n" +
+		"  - вложенный\n" +
+		"- второй\n" +
+		"  продолжение\n" +
+		"\n" +
+		"3. третий\n" +
+		"\n" +
+		"4. четвёртый\n" +
+		"***\n" +
+		"```bash\n" +
+		"make run\n" +
+		"  --flag\n" +
+		"```\n"
+
+	want := []Block{
+		Heading{Level: 1, Text: []Span{{Text: "Техническое задание"}}},
+		Table{
+			Header: []Cell{{Text: []Span{{Text: "Реквизит"}}}, {Text: []Span{{Text: "Значение"}}}},
+			Rows: [][]Cell{
+				{{Text: []Span{{Text: "Шифр"}}}, {Text: []Span{{Text: "АБВГ.01", Code: true}}}},
+				{{Text: []Span{{Text: "Заказчик | Исполнитель"}}}, {}},
+			},
+			Align: []Align{AlignLeft, AlignRight},
+		},
+		Quote{Blocks: []Block{
+			Paragraph{Text: []Span{{Text: "Документ подготовлен по "}, {Text: "ГОСТ 34.602-2020", Bold: true}, {Text: "."}}},
+		}},
+		Heading{Level: 2, Text: []Span{{Text: "1. Общие сведения"}}},
+		Paragraph{Text: []Span{{Text: "Первая строка вторая строка"}, {Text: "\n"}, {Text: "третья строка."}}},
+		List{Items: []ListItem{
+			{Text: []Span{{Text: "первый"}}, Children: []Block{List{Items: []ListItem{{Text: []Span{{Text: "вложенный"}}}}}}},
+			{Text: []Span{{Text: "второй продолжение"}}},
+		}},
+		List{Ordered: true, Start: 3, Items: []ListItem{
+			{Text: []Span{{Text: "третий"}}},
+			{Text: []Span{{Text: "четвёртый"}}},
+		}},
+		Rule{},
+		Code{Language: "bash", Text: "make run\n  --flag"},
+	}
+
+	got := Parse([]byte(src))
+	require.Equal(t, want, got)
+}
+
+func TestParseInline(t *testing.T) {
+	tests := []struct {
+		src  string
+		want []Span
+	}{
+		{"plain", []Span{{Text: "plain"}}},
+		{"**a** *b* ***c***", []Span{{Text: "a", Bold: true}, {Text: " "}, {Text: "b", Italic: true}, {Text: " "}, {Text: "c", Bold: true, Italic: true}}},
+		{"__a__ _b_", []Span{{Text: "a", Bold: true}, {Text: " "}, {Text: "b", Italic: true}}},
+		{"snake_case_name", []Span{{Text: "snake_case_name"}}},
+		{"2 * 3 = 6", []Span{{Text: "2 * 3 = 6"}}},
+		{"**unclosed", []Span{{Text: "**unclosed"}}},
+		{"``a ` b``", []Span{{Text: "a ` b", Code: true}}},
+		{"см. [ГОСТ](https://example.com \"title\")", []Span{{Text: "см. "}, {Text: "ГОСТ", Link: "https://example.com"}}},
+		{"![схема](scheme.png)", []Span{{Text: "схема"}}},
+		{"a<br>b<br/>", []Span{{Text: "a"}, {Text: "\n"}, {Text: "b"}, {Text: "\n"}}},
+		{"<https://example.com>", []Span{{Text: "https://example.com", Link: "https://example.com"}}},
+		{"\\*a\\* &laquo;b&raquo; 1 < 2", []Span{{Text: "*a* «b» 1 < 2"}}},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, parseInline(tt.src), tt.src)
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	require.Equal(t, "a b", PlainText([]Span{{Text: "a", Bold: true}, {Text: " b"}}))
+}
@@ -0,0 +1,395 @@
+package markdown
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	headingRe  = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	listItemRe = regexp.MustCompile(`^( *)([-*+]|\d{1,9}[.)])[ \t]+(.*)$`)
+	alignRe    = regexp.MustCompile(`^:?-+:?$`)
+)
+
+// Parse parses the subset of Markdown the templates are written in: ATX
+// headings, paragraphs, nested lists, pipe tables, block quotes, fenced code
+// and thematic breaks. Anything else is kept as paragraph text.
+func Parse(src []byte) []Block {
+	text := strings.ReplaceAll(string(src), "\r\n", "\n")
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = expandTabs(line)
+	}
+
+	return parseBlocks(lines)
+}
+
+func parseBlocks(lines []string) []Block {
+	var (
+		blocks    []Block
+		paragraph []string
+	)
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, Paragraph{Text: parseInline(joinLines(paragraph))})
+			paragraph = nil
+		}
+	}
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		if trimmed == "" {
+			flush()
+			i++
+			continue
+		}
+
+		if !isBlockStart(lines, i) {
+			paragraph = append(paragraph, line)
+			i++
+			continue
+		}
+
+		flush()
+
+		var n int
+		switch {
+		case isFence(trimmed):
+			var code Code
+			code, n = parseCode(lines[i:])
+			blocks = append(blocks, code)
+		case isRule(trimmed):
+			blocks = append(blocks, Rule{})
+			n = 1
+		case headingRe.MatchString(line):
+			match := headingRe.FindStringSubmatch(line)
+			blocks = append(blocks, Heading{Level: len(match[1]), Text: parseInline(match[2])})
+			n = 1
+		case strings.HasPrefix(trimmed, ">"):
+			var quote Quote
+			quote, n = parseQuote(lines[i:])
+			blocks = append(blocks, quote)
+		case isTableStart(lines, i):
+			var table Table
+			table, n = parseTable(lines[i:])
+			blocks = append(blocks, table)
+		default:
+			var lists []Block
+			lists, n = parseList(lines[i:])
+			blocks = append(blocks, lists...)
+		}
+
+		i += n
+	}
+
+	flush()
+
+	return blocks
+}
+
+// isBlockStart reports whether lines[i] starts a block other than a paragraph.
+func isBlockStart(lines []string, i int) bool {
+	line := lines[i]
+	trimmed := strings.TrimSpace(line)
+
+	return isFence(trimmed) ||
+		isRule(trimmed) ||
+		headingRe.MatchString(line) ||
+		strings.HasPrefix(trimmed, ">") ||
+		isTableStart(lines, i) ||
+		listItemRe.MatchString(line)
+}
+
+// joinLines joins paragraph lines with a space, or with a hard line break
+// after a line ending in two spaces or a backslash.
+func joinLines(lines []string) string {
+	var b strings.Builder
+	for i, line := range lines {
+		text := strings.TrimLeft(line, " ")
+		hardBreak := strings.HasSuffix(text, "  ") || strings.HasSuffix(text, "\\")
+		text = strings.TrimRight(strings.TrimSuffix(strings.TrimRight(text, " "), "\\"), " ")
+
+		b.WriteString(text)
+		if i < len(lines)-1 {
+			if hardBreak {
+				b.WriteByte('\n')
+			} else {
+				b.WriteByte(' ')
+			}
+		}
+	}
+	return b.String()
+}
+
+func isFence(trimmed string) bool {
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+}
+
+func parseCode(lines []string) (Code, int) {
+	open := strings.TrimSpace(lines[0])
+	indent := len(lines[0]) - len(strings.TrimLeft(lines[0], " "))
+	fence := open[:len(open)-len(strings.TrimLeft(open, open[:1]))]
+
+	code := Code{}
+	if info := strings.Fields(open[len(fence):]); len(info) > 0 {
+		code.Language = info[0]
+	}
+
+	var text []string
+	i := 1
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			i++
+			break
+		}
+
+		line := lines[i]
+		for j := 0; j < indent && strings.HasPrefix(line, " "); j++ {
+			line = line[1:]
+		}
+		text = append(text, line)
+	}
+
+	code.Text = strings.Join(text, "\n")
+	return code, i
+}
+
+// isRule reports whether the line is three or more "-", "*" or "_" with
+// optional spaces in between.
+func isRule(trimmed string) bool {
+	if trimmed == "" || !strings.Contains("-*_", trimmed[:1]) {
+		return false
+	}
+
+	n := 0
+	for _, r := range trimmed {
+		switch {
+		case r == rune(trimmed[0]):
+			n++
+		case r == ' ' || r == '\t':
+		default:
+			return false
+		}
+	}
+
+	return n >= 3
+}
+
+func parseQuote(lines []string) (Quote, int) {
+	var inner []string
+	i := 0
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(trimmed, ">") {
+			break
+		}
+
+		text := strings.TrimPrefix(trimmed, ">")
+		text = strings.TrimPrefix(text, " ")
+		inner = append(inner, text)
+	}
+
+	return Quote{Blocks: parseBlocks(inner)}, i
+}
+
+// isTableStart reports whether lines[i] is a header row followed by a
+// delimiter row with the same number of columns.
+func isTableStart(lines []string, i int) bool {
+	if i+1 >= len(lines) || !strings.Contains(lines[i], "|") || !strings.Contains(lines[i+1], "|") {
+		return false
+	}
+
+	delimiter := splitRow(lines[i+1])
+	for _, cell := range delimiter {
+		if !alignRe.MatchString(cell) {
+			return false
+		}
+	}
+
+	return len(delimiter) == len(splitRow(lines[i]))
+}
+
+func parseTable(lines []string) (Table, int) {
+	header := splitRow(lines[0])
+
+	table := Table{
+		Header: parseCells(header),
+		Align:  make([]Align, len(header)),
+	}
+
+	for j, cell := range splitRow(lines[1]) {
+		left, right := strings.HasPrefix(cell, ":"), strings.HasSuffix(cell, ":")
+		switch {
+		case left && right:
+			table.Align[j] = AlignCenter
+		case left:
+			table.Align[j] = AlignLeft
+		case right:
+			table.Align[j] = AlignRight
+		}
+	}
+
+	i := 2
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if strings.TrimSpace(line) == "" || !strings.Contains(line, "|") {
+			break
+		}
+
+		row := splitRow(line)
+		if len(row) < len(header) {
+			row = append(row, make([]string, len(header)-len(row))...)
+		}
+		table.Rows = append(table.Rows, parseCells(row[:len(header)]))
+	}
+
+	return table, i
+}
+
+// splitRow splits a table row into trimmed cells on the pipes that are not
+// escaped. The outer pipes are optional.
+func splitRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, "\\|") {
+		line = line[:len(line)-1]
+	}
+
+	var (
+		cells []string
+		cell  strings.Builder
+	)
+
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+func parseCells(cells []string) []Cell {
+	result := make([]Cell, len(cells))
+	for i, cell := range cells {
+		result[i] = Cell{Text: parseInline(cell)}
+	}
+	return result
+}
+
+type listItem struct {
+	indent  int
+	ordered bool
+	start   int
+	text    string
+}
+
+// parseList parses consecutive list items and their continuation lines. Items
+// indented by two or more spaces more than the item above are nested.
+func parseList(lines []string) ([]Block, int) {
+	var items []listItem
+
+	i := 0
+	for i < len(lines) {
+		line := lines[i]
+
+		if strings.TrimSpace(line) == "" {
+			// a blank line ends the list unless another item follows
+			j := i
+			for j < len(lines) && strings.TrimSpace(lines[j]) == "" {
+				j++
+			}
+
+			if j == len(lines) || !listItemRe.MatchString(lines[j]) {
+				break
+			}
+
+			i = j
+			continue
+		}
+
+		if match := listItemRe.FindStringSubmatch(line); match != nil {
+			item := listItem{indent: len(match[1]), text: match[3]}
+			if marker := match[2]; strings.ContainsAny(marker[len(marker)-1:], ".)") {
+				item.ordered = true
+				item.start, _ = strconv.Atoi(marker[:len(marker)-1])
+			}
+			items = append(items, item)
+			i++
+			continue
+		}
+
+		if isBlockStart(lines, i) {
+			break
+		}
+
+		// continuation of the item text
+		last := &items[len(items)-1]
+		last.text += " " + strings.TrimSpace(line)
+		i++
+	}
+
+	return buildLists(items), i
+}
+
+// buildLists nests the items by indentation. Consecutive items of the same
+// level and kind form one list.
+func buildLists(items []listItem) []Block {
+	var lists []Block
+
+	for i := 0; i < len(items); {
+		base := items[i]
+		list := List{Ordered: base.ordered, Start: base.start}
+
+		for i < len(items) && items[i].indent < base.indent+2 && items[i].ordered == base.ordered {
+			item := ListItem{Text: parseInline(items[i].text)}
+
+			j := i + 1
+			for j < len(items) && items[j].indent >= items[i].indent+2 {
+				j++
+			}
+
+			item.Children = buildLists(items[i+1 : j])
+			list.Items = append(list.Items, item)
+			i = j
+		}
+
+		lists = append(lists, list)
+	}
+
+	return lists
+}
+
+func expandTabs(line string) string {
+	if !strings.Contains(line, "\t") {
+		return line
+	}
+
+	var b strings.Builder
+	for i, r := range line {
+		if r != '\t' && r != ' ' {
+			b.WriteString(line[i:])
+			return b.String()
+		}
+
+		if r == ' ' {
+			b.WriteByte(' ')
+		} else {
+			b.WriteString(strings.Repeat(" ", 4-b.Len()%4))
+		}
+	}
+	return b.String()
+}
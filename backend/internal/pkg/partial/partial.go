@@ -0,0 +1,124 @@
+// Package partial resolves the calls of project partials from templates.
+package partial
+
+import (
+	"text/template"
+	"text/template/parse"
+
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+)
+
+// Add parses the partials into the set, each as the template named after it.
+// The templates parsed into the set afterwards may call them and redefine the
+// blocks they declare, which makes a partial with blocks a layout.
+func Add(set *template.Template, partials []partial_domain.Partial) error {
+	for _, p := range partials {
+		if _, err := set.New(p.Name).Parse(p.Data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Trees parses the text into the templates it defines, the text itself being
+// named name. The functions are not checked.
+func Trees(name, text string) (map[string]*parse.Tree, error) {
+	trees := make(map[string]*parse.Tree)
+
+	tree := parse.New(name)
+	tree.Mode = parse.SkipFuncCheck
+	if _, err := tree.Parse(text, "", "", trees); err != nil {
+		return nil, err
+	}
+
+	return trees, nil
+}
+
+// Resolve follows the template calls from the roots through the trees of a
+// document and the partials. It returns the partials called, directly or
+// through other partials, in their order, and the names called that are
+// defined by neither. The trees take precedence over the partials, as the
+// document is parsed after them.
+func Resolve(trees map[string]*parse.Tree, roots []string, partials []partial_domain.Partial) ([]partial_domain.Partial, []string, error) {
+	// a partial defines its own name and the templates declared in it, the
+	// last partial declaring a name wins
+	owners := make(map[string]int)
+	defined := make(map[string]*parse.Tree)
+	for i, p := range partials {
+		partialTrees, err := Trees(p.Name, p.Data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for name, tree := range partialTrees {
+			owners[name] = i
+			defined[name] = tree
+		}
+	}
+
+	var (
+		used    = make([]bool, len(partials))
+		missing []string
+		visited = make(map[string]bool)
+		queue   = roots
+	)
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+
+		tree, found := trees[name]
+		if !found {
+			i, owned := owners[name]
+			if !owned {
+				missing = append(missing, name)
+				continue
+			}
+
+			used[i] = true
+			tree = defined[name]
+		}
+
+		queue = append(queue, calls(tree.Root)...)
+	}
+
+	var called []partial_domain.Partial
+	for i, p := range partials {
+		if used[i] {
+			called = append(called, p)
+		}
+	}
+
+	return called, missing, nil
+}
+
+// calls returns the names of the templates the node calls.
+func calls(node parse.Node) []string {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return nil
+		}
+		var names []string
+		for _, n := range node.Nodes {
+			names = append(names, calls(n)...)
+		}
+		return names
+	case *parse.TemplateNode:
+		return []string{node.Name}
+	case *parse.IfNode:
+		return append(calls(node.List), calls(node.ElseList)...)
+	case *parse.RangeNode:
+		return append(calls(node.List), calls(node.ElseList)...)
+	case *parse.WithNode:
+		return append(calls(node.List), calls(node.ElseList)...)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package partial
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+)
+
+var partials = []partial_domain.Partial{
+	{VersionID: 1, Name: "title_page", Data: `{{ .title }}{{ template "approval" . }}`},
+	{VersionID: 2, Name: "approval", Data: `УТВЕРЖДАЮ {{ .approver }}`},
+	{VersionID: 3, Name: "layout", Data: `[{{ block "body" . }}пусто{{ end }}]{{ define "revisions" }}Лист регистрации изменений{{ end }}`},
+	{VersionID: 4, Name: "unused", Data: `{{ template "nowhere" }}`},
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantUsed    []int64
+		wantMissing []string
+	}{
+		{
+			name: "NoCalls",
+			data: `{{ .title }}`,
+		},
+		{
+			name:     "Transitive",
+			data:     `{{ template "title_page" . }}`,
+			wantUsed: []int64{1, 2},
+		},
+		{
+			name:     "Layout",
+			data:     `{{ template "layout" . }}{{ define "body" }}Текст{{ end }}`,
+			wantUsed: []int64{3},
+		},
+		{
+			name:     "Defined",
+			data:     `{{ template "revisions" }}`,
+			wantUsed: []int64{3},
+		},
+		{
+			name: "Block",
+			data: `{{ block "approval" . }}Свой лист{{ end }}`,
+		},
+		{
+			name:        "Missing",
+			data:        `{{ if .x }}{{ template "approval_sheet" . }}{{ else }}{{ template "approval" . }}{{ end }}`,
+			wantUsed:    []int64{2},
+			wantMissing: []string{"approval_sheet"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trees, err := Trees("", tt.data)
+			require.NoError(t, err)
+
+			used, missing, err := Resolve(trees, []string{""}, partials)
+			require.NoError(t, err)
+
+			var usedIDs []int64
+			for _, p := range used {
+				usedIDs = append(usedIDs, p.VersionID)
+			}
+			require.Equal(t, tt.wantUsed, usedIDs)
+			require.Equal(t, tt.wantMissing, missing)
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	set := template.New("")
+	require.NoError(t, Add(set, partials))
+
+	_, err := set.Parse(`{{ template "title_page" . }} {{ template "layout" . }}{{ define "body" }}{{ .title }}{{ end }}`)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, set.Execute(&buf, map[string]any{"title": "ТЗ", "approver": "Иванов"}))
+	require.Equal(t, "ТЗУТВЕРЖДАЮ Иванов [ТЗ]", buf.String())
+}
@@ -0,0 +1,435 @@
+package pdf
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/markdown"
+)
+
+const (
+	pageWidth = 210
+	// headingLevels is the number of numbered levels, for Markdown levels 2
+	// to 6.
+	headingLevels = 5
+	// lineHeight is the height of a single-spaced line relative to the font
+	// size.
+	lineHeight = 1.15
+	// cellPadding is the padding of the table cells in millimetres.
+	cellPadding = 1.5
+	// quoteIndent is the indent of quotes without a first line indent.
+	quoteIndent = 10
+)
+
+// headingNumberRe matches the number written before a heading, such as "4.1."
+var headingNumberRe = regexp.MustCompile(`^\d+(\.\d+)*\.?\s+`)
+
+type writer struct {
+	doc     *gofpdf.Fpdf
+	profile output_domain.Profile
+	code    string
+	// headings counts the headings of every level for their numbers
+	headings     [headingLevels]int
+	outlineLevel int
+	titlePage    bool
+}
+
+// scope is the context the blocks are written in.
+type scope struct {
+	center    bool
+	italic    bool
+	indent    float64
+	listLevel int
+}
+
+func newWriter(doc *gofpdf.Fpdf, profile output_domain.Profile, code string) *writer {
+	return &writer{
+		doc:          doc,
+		profile:      profile,
+		code:         code,
+		outlineLevel: -1,
+	}
+}
+
+func (w *writer) writeDocument(blocks []markdown.Block) {
+	w.doc.SetMargins(w.profile.MarginLeft, w.profile.MarginTop, w.profile.MarginRight)
+	w.doc.SetAutoPageBreak(true, w.profile.MarginBottom)
+	w.doc.SetCellMargin(0)
+	w.doc.SetHeaderFuncMode(w.writeHeader, true)
+	w.doc.SetFooterFunc(w.writeFooter)
+	w.doc.SetTitle(title(blocks), true)
+
+	end := w.titlePageEnd(blocks)
+	w.titlePage = end > 0
+
+	w.doc.AddPage()
+	w.doc.SetFont(fontText, "", w.profile.FontSize)
+
+	if w.titlePage {
+		w.writeBlocks(blocks[:end], scope{center: true})
+		w.doc.AddPage()
+		blocks = blocks[end:]
+	}
+
+	w.writeBlocks(blocks, scope{})
+}
+
+// titlePageEnd is the index of the first heading of the second level if the
+// profile has a title page and there is something above the heading.
+func (w *writer) titlePageEnd(blocks []markdown.Block) int {
+	if !w.profile.TitlePage {
+		return 0
+	}
+
+	for i, block := range blocks {
+		if heading, ok := block.(markdown.Heading); ok && heading.Level >= 2 {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// writeHeader puts the document code at the top right of every page but the
+// title page.
+func (w *writer) writeHeader() {
+	if w.code == "" || w.isTitlePage() {
+		return
+	}
+
+	size := max(w.profile.FontSize-4, 9)
+	w.doc.SetFont(fontText, "", size)
+	w.doc.SetY(w.profile.MarginTop / 2)
+	w.doc.CellFormat(0, w.lineHeight(size, 1), text(w.code), "", 0, "R", false, 0, "")
+}
+
+// writeFooter puts the page number at the bottom center of every page but the
+// title page.
+func (w *writer) writeFooter() {
+	if !w.profile.PageNumbers || w.isTitlePage() {
+		return
+	}
+
+	size := max(w.profile.FontSize-2, 10)
+	w.doc.SetFont(fontText, "", size)
+	w.doc.SetY(-w.profile.MarginBottom / 2)
+	w.doc.CellFormat(0, w.lineHeight(size, 1), strconv.Itoa(w.doc.PageNo()), "", 0, "C", false, 0, "")
+}
+
+func (w *writer) isTitlePage() bool {
+	return w.titlePage && w.doc.PageNo() == 1
+}
+
+func (w *writer) writeBlocks(blocks []markdown.Block, s scope) {
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case markdown.Heading:
+			w.writeHeading(b, s)
+		case markdown.Paragraph:
+			w.writeParagraph(b.Text, s)
+		case markdown.List:
+			w.writeList(b, s)
+		case markdown.Table:
+			w.writeTable(b)
+		case markdown.Quote:
+			indent := w.profile.FirstLineIndent
+			if indent == 0 {
+				indent = quoteIndent
+			}
+			w.writeBlocks(b.Blocks, scope{center: s.center, italic: true, indent: s.indent + indent, listLevel: s.listLevel})
+		case markdown.Code:
+			w.writeCode(b, s)
+		case markdown.Rule:
+			w.doc.Ln(w.lineHeight(w.profile.FontSize, 1) / 2)
+			y := w.doc.GetY()
+			w.doc.Line(w.profile.MarginLeft+s.indent, y, pageWidth-w.profile.MarginRight, y)
+			w.doc.Ln(w.lineHeight(w.profile.FontSize, 1) / 2)
+		}
+	}
+}
+
+// writeHeading writes the first level as the document title and numbers the
+// others by the profile.
+func (w *writer) writeHeading(heading markdown.Heading, s scope) {
+	content := markdown.PlainText(heading.Text)
+	space := w.lineHeight(w.profile.FontSize, 1)
+
+	if heading.Level == 1 {
+		size := w.profile.FontSize + 2
+		w.doc.Ln(space)
+		w.doc.SetFont(fontText, "B", size)
+		w.doc.MultiCell(0, w.lineHeight(size, w.profile.LineSpacing), text(content), "", "C", false)
+		w.doc.Ln(space)
+		return
+	}
+
+	level := min(heading.Level-2, headingLevels-1)
+	if w.profile.NumberHeadings {
+		content = headingNumberRe.ReplaceAllString(content, "")
+		content = w.headingNumber(level) + " " + content
+	}
+
+	height := w.lineHeight(w.profile.FontSize, w.profile.LineSpacing)
+
+	// keeps the heading on a page with the first lines of its section
+	w.keepSpace(space + 3*height)
+	if w.doc.GetY() > w.profile.MarginTop {
+		w.doc.Ln(space)
+	}
+
+	w.outlineLevel = min(level, w.outlineLevel+1)
+	w.doc.Bookmark(text(content), w.outlineLevel, -1)
+
+	align := "L"
+	if s.center {
+		align = "C"
+	}
+
+	w.withIndent(s.indent+w.profile.FirstLineIndent, func() {
+		w.doc.SetFont(fontText, "B", w.profile.FontSize)
+		w.doc.MultiCell(0, height, text(content), "", align, false)
+	})
+	w.doc.Ln(space / 2)
+}
+
+// headingNumber counts the heading of the level and returns its number, such
+// as "1.2".
+func (w *writer) headingNumber(level int) string {
+	w.headings[level]++
+	for i := level + 1; i < headingLevels; i++ {
+		w.headings[i] = 0
+	}
+
+	numbers := make([]string, level+1)
+	for i := range numbers {
+		numbers[i] = strconv.Itoa(w.headings[i])
+	}
+
+	return strings.Join(numbers, ".")
+}
+
+// writeParagraph writes the spans with their formatting, wrapped at the right
+// margin. Centered paragraphs of the title page are written as plain text.
+func (w *writer) writeParagraph(spans []markdown.Span, s scope) {
+	height := w.lineHeight(w.profile.FontSize, w.profile.LineSpacing)
+
+	if s.center {
+		style := ""
+		if s.italic {
+			style = "I"
+		}
+		w.doc.SetFont(fontText, style, w.profile.FontSize)
+		w.doc.MultiCell(0, height, text(markdown.PlainText(spans)), "", "C", false)
+		return
+	}
+
+	w.withIndent(s.indent, func() {
+		w.doc.SetX(w.profile.MarginLeft + s.indent + w.profile.FirstLineIndent)
+		w.writeSpans(spans, height, s.italic)
+		w.doc.Ln(height)
+	})
+}
+
+func (w *writer) writeSpans(spans []markdown.Span, height float64, italic bool) {
+	for _, span := range spans {
+		if span.Text == "\n" {
+			w.doc.Ln(height)
+			continue
+		}
+
+		family, style := fontText, ""
+		if span.Bold {
+			style += "B"
+		}
+		if span.Code {
+			// the mono font has no italics
+			family = fontCode
+		} else if span.Italic || italic {
+			style += "I"
+		}
+
+		if span.Link == "" {
+			w.doc.SetFont(family, style, w.profile.FontSize)
+			w.doc.Write(height, text(span.Text))
+			continue
+		}
+
+		w.doc.SetFont(family, style+"U", w.profile.FontSize)
+		w.doc.SetTextColor(5, 99, 193)
+		w.doc.WriteLinkString(height, text(span.Text), span.Link)
+		w.doc.SetTextColor(0, 0, 0)
+	}
+}
+
+func (w *writer) writeList(list markdown.List, s scope) {
+	height := w.lineHeight(w.profile.FontSize, w.profile.LineSpacing)
+	indent := listIndent(s.listLevel)
+
+	for i, item := range list.Items {
+		marker := "–"
+		if list.Ordered {
+			marker = fmt.Sprintf("%d.", max(list.Start, 1)+i)
+		}
+
+		w.doc.SetFont(fontText, "", w.profile.FontSize)
+		w.doc.SetX(w.profile.MarginLeft + s.indent + indent - listHanging)
+		w.doc.CellFormat(listHanging, height, marker, "", 0, "L", false, 0, "")
+
+		w.withIndent(s.indent+indent, func() {
+			w.writeSpans(item.Text, height, s.italic)
+			w.doc.Ln(height)
+		})
+
+		w.writeBlocks(item.Children, scope{italic: s.italic, indent: s.indent, listLevel: s.listLevel + 1})
+	}
+}
+
+// listHanging is the space of the list markers in millimetres.
+const listHanging = 6.3
+
+// listIndent is the left indent of a list level in millimetres.
+func listIndent(level int) float64 {
+	return 12.5 + float64(level)*listHanging
+}
+
+func (w *writer) writeTable(table markdown.Table) {
+	columns := len(table.Header)
+	if columns == 0 {
+		return
+	}
+
+	width := (pageWidth - w.profile.MarginLeft - w.profile.MarginRight) / float64(columns)
+
+	w.doc.Ln(w.lineHeight(w.profile.FontSize, 1) / 2)
+	w.writeRow(table.Header, table.Align, width, true)
+	for _, row := range table.Rows {
+		if w.keepSpace(w.rowHeight(row, width, false)) {
+			// repeats the header on every page
+			w.writeRow(table.Header, table.Align, width, true)
+		}
+		w.writeRow(row, table.Align, width, false)
+	}
+	w.doc.Ln(w.lineHeight(w.profile.FontSize, 1) / 2)
+}
+
+func (w *writer) writeRow(cells []markdown.Cell, align []markdown.Align, width float64, header bool) {
+	size := w.tableFontSize()
+	height := w.lineHeight(size, 1)
+	rowHeight := w.rowHeight(cells, width, header)
+	w.keepSpace(rowHeight)
+
+	style := ""
+	if header {
+		style = "B"
+	}
+	w.doc.SetFont(fontText, style, size)
+
+	x, y := w.profile.MarginLeft, w.doc.GetY()
+	for i, cell := range cells {
+		cellAlign := "L"
+		switch {
+		case align[i] == markdown.AlignLeft:
+		case align[i] == markdown.AlignCenter || header:
+			cellAlign = "C"
+		case align[i] == markdown.AlignRight:
+			cellAlign = "R"
+		}
+
+		w.doc.Rect(x, y, width, rowHeight, "D")
+		w.doc.SetXY(x+cellPadding, y+cellPadding)
+		w.doc.MultiCell(width-2*cellPadding, height, text(markdown.PlainText(cell.Text)), "", cellAlign, false)
+		x += width
+	}
+
+	w.doc.SetXY(w.profile.MarginLeft, y+rowHeight)
+}
+
+// rowHeight is the height of the row with its cells wrapped to the width.
+func (w *writer) rowHeight(cells []markdown.Cell, width float64, header bool) float64 {
+	style := ""
+	if header {
+		style = "B"
+	}
+	size := w.tableFontSize()
+	w.doc.SetFont(fontText, style, size)
+
+	lines := 1
+	for _, cell := range cells {
+		content := markdown.PlainText(cell.Text)
+		if content == "" {
+			continue
+		}
+
+		lines = max(lines, len(w.doc.SplitText(text(content), width-2*cellPadding)))
+	}
+
+	return float64(lines)*w.lineHeight(size, 1) + 2*cellPadding
+}
+
+func (w *writer) tableFontSize() float64 {
+	return max(w.profile.FontSize-2, 10)
+}
+
+func (w *writer) writeCode(code markdown.Code, s scope) {
+	size := max(w.profile.FontSize-2, 10)
+	height := w.lineHeight(size, 1)
+
+	w.withIndent(s.indent, func() {
+		w.doc.SetFont(fontCode, "", size)
+		for line := range strings.SplitSeq(code.Text, "\n") {
+			w.doc.MultiCell(0, height, text(line), "", "L", false)
+		}
+	})
+}
+
+// keepSpace starts a new page unless the height fits on the current one and
+// reports whether it has.
+func (w *writer) keepSpace(height float64) bool {
+	_, pageHeight := w.doc.GetPageSize()
+	if w.doc.GetY()+height <= pageHeight-w.profile.MarginBottom || w.doc.GetY() <= w.profile.MarginTop {
+		return false
+	}
+
+	w.doc.AddPage()
+	return true
+}
+
+// withIndent writes with the left margin moved by the indent.
+func (w *writer) withIndent(indent float64, write func()) {
+	w.doc.SetLeftMargin(w.profile.MarginLeft + indent)
+	write()
+	w.doc.SetLeftMargin(w.profile.MarginLeft)
+	w.doc.SetX(w.profile.MarginLeft)
+}
+
+// lineHeight is the height of a line of the font size in millimetres.
+func (w *writer) lineHeight(size, spacing float64) float64 {
+	return size * lineHeight * spacing * 25.4 / 72
+}
+
+// title is the text of the first heading of the first level.
+func title(blocks []markdown.Block) string {
+	for _, block := range blocks {
+		if heading, ok := block.(markdown.Heading); ok && heading.Level == 1 {
+			return markdown.PlainText(heading.Text)
+		}
+	}
+
+	return ""
+}
+
+// text replaces the characters beyond the Basic Multilingual Plane, which the
+// fonts are not indexed by.
+func text(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r > 0xFFFF {
+			return '?'
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,57 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/jung-kurt/gofpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/gobolditalic"
+	"golang.org/x/image/font/gofont/goitalic"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/gomonobold"
+	"golang.org/x/image/font/gofont/goregular"
+
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/markdown"
+)
+
+// The Go fonts cover Cyrillic and are embedded into every document, so the
+// output does not depend on the fonts of the reader. They stand in for the
+// font of the profile.
+const (
+	fontText = "text"
+	fontCode = "code"
+)
+
+var fonts = []struct {
+	family string
+	style  string
+	data   []byte
+}{
+	{fontText, "", goregular.TTF},
+	{fontText, "B", gobold.TTF},
+	{fontText, "I", goitalic.TTF},
+	{fontText, "BI", gobolditalic.TTF},
+	{fontCode, "", gomono.TTF},
+	{fontCode, "B", gomonobold.TTF},
+}
+
+// Render lays out the Markdown document as A4 PDF by the profile. A non-empty
+// code is put in the page header.
+func Render(blocks []markdown.Block, profile output_domain.Profile, code string) ([]byte, error) {
+	doc := gofpdf.New("P", "mm", "A4", "")
+	for _, font := range fonts {
+		doc.AddUTF8FontFromBytes(font.family, font.style, font.data)
+	}
+
+	w := newWriter(doc, profile, code)
+	w.writeDocument(blocks)
+
+	var buf bytes.Buffer
+	if err := doc.Output(&buf); err != nil {
+		return nil, fmt.Errorf("output: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,89 @@
+package pdf
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/markdown"
+)
+
+const source = "# Техническое задание\n" +
+	"\n" +
+	"| Реквизит | Значение |\n" +
+	"| --- | --- |\n" +
+	"| Шифр | АБВГ & Ко |\n" +
+	"\n" +
+	"## 1. Общие сведения\n" +
+	"\n" +
+	"Текст со **ссылкой** на [ГОСТ](https://example.com?a=1&b=2) и `кодом` 😀.\n" +
+	"\n" +
+	"### 1.1. Цели\n" +
+	"\n" +
+	"1. первая\n" +
+	"   - вложенная\n" +
+	"2. вторая\n" +
+	"\n" +
+	"> цитата\n" +
+	"\n" +
+	"---\n" +
+	"\n" +
+	"```\n" +
+	"make run\n" +
+	"```\n"
+
+var pageRe = regexp.MustCompile(`/Type /Page\n`)
+
+func TestRender(t *testing.T) {
+	data, err := Render(markdown.Parse([]byte(source)), output_domain.Profiles["gost_34"], "АБВГ.001-ТЗ")
+	require.NoError(t, err)
+
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-")))
+	require.True(t, bytes.HasSuffix(bytes.TrimSpace(data), []byte("%%EOF")))
+
+	// the title page and the text
+	require.Len(t, pageRe.FindAll(data, -1), 2)
+
+	// embedded Cyrillic fonts
+	require.Contains(t, string(data), "/Encoding /Identity-H")
+	require.Contains(t, string(data), "/FontFile2")
+	require.Contains(t, string(data), "/ToUnicode")
+
+	require.Contains(t, string(data), "/URI (https://example.com?a=1&b=2)")
+	require.Contains(t, string(data), "/Outlines")
+}
+
+func TestRender_Plain(t *testing.T) {
+	data, err := Render(markdown.Parse([]byte(source)), output_domain.Profiles["plain"], "")
+	require.NoError(t, err)
+
+	require.Len(t, pageRe.FindAll(data, -1), 1)
+}
+
+func TestRender_Pages(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("## Таблица\n\n| № | Наименование |\n| ---: | --- |\n")
+	for range 100 {
+		b.WriteString("| 1 | Длинное наименование позиции, которое переносится на несколько строк в ячейке таблицы |\n")
+	}
+
+	data, err := Render(markdown.Parse([]byte(b.String())), output_domain.Profiles["gost_34"], "")
+	require.NoError(t, err)
+
+	require.Greater(t, len(pageRe.FindAll(data, -1)), 5)
+}
+
+func TestWriter_headingNumber(t *testing.T) {
+	w := newWriter(nil, output_domain.Profile{}, "")
+
+	require.Equal(t, "1", w.headingNumber(0))
+	require.Equal(t, "1.1", w.headingNumber(1))
+	require.Equal(t, "1.2", w.headingNumber(1))
+	require.Equal(t, "1.2.1", w.headingNumber(2))
+	require.Equal(t, "2", w.headingNumber(0))
+	require.Equal(t, "2.1", w.headingNumber(1))
+}
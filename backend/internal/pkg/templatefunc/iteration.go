@@ -0,0 +1,75 @@
+package templatefunc
+
+import (
+	"text/template"
+	"text/template/parse"
+)
+
+// IterationFunc is called by the templates passed to Instrument once per
+// template call and per range iteration. It does nothing in Funcs, and is
+// replaced at execution to meter the work of a template.
+const IterationFunc = "templateIteration"
+
+func iteration() string {
+	return ""
+}
+
+// Instrument adds a call of IterationFunc to the start of every template of
+// the set and of every range body in them. The templates must not have been
+// executed yet.
+func Instrument(templates []*template.Template) {
+	for _, tmpl := range templates {
+		if tmpl.Tree != nil && tmpl.Tree.Root != nil {
+			instrumentList(tmpl.Tree, tmpl.Tree.Root)
+		}
+	}
+}
+
+func instrumentList(tree *parse.Tree, list *parse.ListNode) {
+	if list == nil {
+		return
+	}
+
+	for _, node := range list.Nodes {
+		instrumentNode(tree, node)
+	}
+
+	list.Nodes = append([]parse.Node{iterationNode(tree, list.Pos)}, list.Nodes...)
+}
+
+func instrumentNode(tree *parse.Tree, node parse.Node) {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return
+		}
+		for _, n := range node.Nodes {
+			instrumentNode(tree, n)
+		}
+	case *parse.IfNode:
+		instrumentNode(tree, node.List)
+		instrumentNode(tree, node.ElseList)
+	case *parse.WithNode:
+		instrumentNode(tree, node.List)
+		instrumentNode(tree, node.ElseList)
+	case *parse.RangeNode:
+		instrumentList(tree, node.List)
+		instrumentNode(tree, node.ElseList)
+	}
+}
+
+func iterationNode(tree *parse.Tree, pos parse.Pos) *parse.ActionNode {
+	return &parse.ActionNode{
+		NodeType: parse.NodeAction,
+		Pos:      pos,
+		Pipe: &parse.PipeNode{
+			NodeType: parse.NodePipe,
+			Pos:      pos,
+			Cmds: []*parse.CommandNode{{
+				NodeType: parse.NodeCommand,
+				Pos:      pos,
+				Args:     []parse.Node{parse.NewIdentifier(IterationFunc).SetTree(tree).SetPos(pos)},
+			}},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package templatefunc
+
+import (
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/expression"
+)
+
+// Funcs is the sprig text/template helper set with process-environment
+// accessors removed so a template cannot exfiltrate the worker's secrets, and
+// key and password generators removed since they run too long to be stopped.
+// The number, Russian numeral, date and unit helpers are shared with
+// expressions.
+var Funcs = func() template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	delete(funcs, "env")
+	delete(funcs, "expandenv")
+	delete(funcs, "getHostByName")
+	for _, name := range []string{
+		"bcrypt", "htpasswd", "derivePassword", "genPrivateKey", "buildCustomCert",
+		"genCA", "genCAWithKey", "genSelfSignedCert", "genSelfSignedCertWithKey",
+		"genSignedCert", "genSignedCertWithKey",
+	} {
+		delete(funcs, name)
+	}
+	funcs[IterationFunc] = iteration
+	funcs["formatNumber"] = expression.FormatNumber
+	funcs["numberToWords"] = expression.NumberToWords
+	funcs["amountToWords"] = expression.AmountToWords
+	funcs["pluralForm"] = expression.PluralForm
+	funcs["ordinal"] = expression.Ordinal
+	funcs["formatDate"] = expression.FormatDate
+	funcs["parseDate"] = expression.ParseDate
+	funcs["addDays"] = expression.AddDays
+	funcs["addMonths"] = expression.AddMonths
+	funcs["addYears"] = expression.AddYears
+	funcs["addBusinessDays"] = expression.AddBusinessDays
+	funcs["businessDaysBetween"] = expression.BusinessDaysBetween
+	funcs["convert"] = expression.Convert
+	funcs["formatUnit"] = expression.FormatUnit
+	return funcs
+}()
@@ -0,0 +1,53 @@
+package templatefunc
+
+import (
+	"text/template"
+	"text/template/parse"
+)
+
+// ValueFunc is called by the templates passed to MarkValues with every value
+// they print, and returns the text to print. It is not in Funcs, so that a
+// template cannot call it, and is bound at execution.
+const ValueFunc = "templateValue"
+
+// MarkValues pipes the value of every action that prints one to ValueFunc in
+// the templates of the set. It must be called before Instrument, whose actions
+// print nothing, and before the templates are executed.
+func MarkValues(templates []*template.Template) {
+	for _, tmpl := range templates {
+		if tmpl.Tree != nil && tmpl.Tree.Root != nil {
+			markNode(tmpl.Tree, tmpl.Tree.Root)
+		}
+	}
+}
+
+func markNode(tree *parse.Tree, node parse.Node) {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return
+		}
+		for _, n := range node.Nodes {
+			markNode(tree, n)
+		}
+	case *parse.ActionNode:
+		pipe := node.Pipe
+		if len(pipe.Decl) > 0 {
+			return
+		}
+		pipe.Cmds = append(pipe.Cmds, &parse.CommandNode{
+			NodeType: parse.NodeCommand,
+			Pos:      pipe.Pos,
+			Args:     []parse.Node{parse.NewIdentifier(ValueFunc).SetTree(tree).SetPos(pipe.Pos)},
+		})
+	case *parse.IfNode:
+		markNode(tree, node.List)
+		markNode(tree, node.ElseList)
+	case *parse.WithNode:
+		markNode(tree, node.List)
+		markNode(tree, node.ElseList)
+	case *parse.RangeNode:
+		markNode(tree, node.List)
+		markNode(tree, node.ElseList)
+	}
+}
@@ -23,6 +23,75 @@ type ProjectUser struct {
 	Role      string `db:"role" fake:"{randomstring:[read,write,maintain]}"`
 }
 
+type ProjectFunction struct {
+	ID            int64      `db:"id"`
+	Name          string     `db:"name"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     *time.Time `db:"updated_at"`
+	ProjectID     int64      `db:"project_id"`
+	AuthorID      *int64     `db:"author_id"`
+	LastVersionID *int64     `db:"last_version_id"`
+}
+
+type ProjectFunctionVersion struct {
+	ID         int64     `db:"id"`
+	Number     int64     `db:"number"`
+	FunctionID int64     `db:"function_id"`
+	AuthorID   *int64    `db:"author_id"`
+	CreatedAt  time.Time `db:"created_at"`
+	Params     []byte    `db:"params" fake:"skip"`
+	Body       string    `db:"body"`
+}
+
+type ProjectPartial struct {
+	ID            int64      `db:"id"`
+	Name          string     `db:"name"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     *time.Time `db:"updated_at"`
+	ProjectID     int64      `db:"project_id"`
+	AuthorID      *int64     `db:"author_id"`
+	LastVersionID *int64     `db:"last_version_id"`
+}
+
+type ProjectPartialVersion struct {
+	ID        int64     `db:"id"`
+	Number    int64     `db:"number"`
+	PartialID int64     `db:"partial_id"`
+	AuthorID  *int64    `db:"author_id"`
+	CreatedAt time.Time `db:"created_at"`
+	Data      string    `db:"data"`
+}
+
+type ProjectConstant struct {
+	ID        int64     `db:"id"`
+	Name      string    `db:"name"`
+	Value     string    `db:"value"`
+	CreatedAt time.Time `db:"created_at"`
+	ProjectID int64     `db:"project_id"`
+	AuthorID  *int64    `db:"author_id"`
+}
+
+type ProjectDictionary struct {
+	ID            int64      `db:"id"`
+	Name          string     `db:"name"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     *time.Time `db:"updated_at"`
+	DeletedAt     *time.Time `db:"deleted_at" fake:"skip"`
+	ProjectID     int64      `db:"project_id"`
+	AuthorID      *int64     `db:"author_id"`
+	LastVersionID *int64     `db:"last_version_id"`
+}
+
+type ProjectDictionaryVersion struct {
+	ID           int64     `db:"id"`
+	Number       int64     `db:"number"`
+	DictionaryID int64     `db:"dictionary_id"`
+	AuthorID     *int64    `db:"author_id"`
+	CreatedAt    time.Time `db:"created_at"`
+	Columns      []byte    `db:"columns" fake:"skip"`
+	Rows         []byte    `db:"rows" fake:"skip"`
+}
+
 type Template struct {
 	ID            int64      `db:"id"`
 	Name          string     `db:"name"`
@@ -32,6 +101,8 @@ type Template struct {
 	ProjectID     *int64     `db:"project_id"`
 	AuthorID      *int64     `db:"author_id"`
 	LastVersionID *int64     `db:"last_version_id"`
+	OutputFormat  string     `db:"output_format" fake:"{randomstring:[md,docx,pdf,xlsx]}"`
+	StyleProfile  *string    `db:"style_profile" fake:"{randomstring:[gost_19,gost_34,plain]}"`
 }
 
 type TemplateUser struct {
@@ -41,22 +112,41 @@ type TemplateUser struct {
 }
 
 type Version struct {
-	ID         int64     `db:"id"`
-	Number     int64     `db:"number"`
-	TemplateID int64     `db:"template_id"`
-	AuthorID   *int64    `db:"author_id"`
-	CreatedAt  time.Time `db:"created_at"`
-	Data       []byte    `db:"data"`
+	ID           int64     `db:"id"`
+	Number       int64     `db:"number"`
+	TemplateID   int64     `db:"template_id"`
+	AuthorID     *int64    `db:"author_id"`
+	CreatedAt    time.Time `db:"created_at"`
+	Data         []byte    `db:"data"`
+	Dependencies []byte    `db:"dependencies" fake:"skip"`
+	Kind         string    `db:"kind" fake:"{randomstring:[md,docx]}"`
+}
+
+type VersionPartial struct {
+	VersionID        int64 `db:"version_id"`
+	PartialVersionID int64 `db:"partial_version_id"`
+}
+
+type VersionFunction struct {
+	VersionID         int64 `db:"version_id"`
+	FunctionVersionID int64 `db:"function_version_id"`
 }
 
 type Variable struct {
-	ID         int64   `db:"id"`
-	VersionID  int64   `db:"version_id"`
-	Name       string  `db:"name"`
-	Title      string  `db:"title"`
-	Type       string  `db:"type" fake:"{randomstring:[integer,float,string]}"`
-	Expression *string `db:"expression"`
-	IsInput    bool    `db:"is_input"`
+	ID                int64   `db:"id"`
+	VersionID         int64   `db:"version_id"`
+	Name              string  `db:"name"`
+	Title             string  `db:"title"`
+	Type              string  `db:"type" fake:"{randomstring:[integer,float,string]}"`
+	Expression        *string `db:"expression"`
+	IsInput           bool    `db:"is_input"`
+	DefaultExpression *string `db:"default_expression"`
+	EnabledIf         *string `db:"enabled_if"`
+	Unit              *string `db:"unit"`
+	OptionsFrom       *string `db:"options_from" fake:"skip"`
+	Options           []byte  `db:"options" fake:"skip"`
+	ItemType          *string `db:"item_type" fake:"skip"`
+	Columns           []byte  `db:"columns" fake:"skip"`
 }
 
 type Constraint struct {
@@ -68,18 +158,25 @@ type Constraint struct {
 }
 
 type Task struct {
-	ID        int64      `db:"id"`
-	VersionID int64      `db:"version_id"`
-	Status    string     `db:"status" fake:"{randomstring:[created,in_progress,succeed,failed]}"`
-	Payload   []byte     `db:"payload"`
-	ResultID  *int64     `db:"result_id"`
-	Error     []byte     `db:"error"`
-	CreatorID int64      `db:"creator_id"`
-	CreatedAt time.Time  `db:"created_at"`
-	UpdatedAt *time.Time `db:"updated_at"`
+	ID           int64      `db:"id"`
+	VersionID    int64      `db:"version_id"`
+	Status       string     `db:"status" fake:"{randomstring:[created,in_progress,succeed,failed]}"`
+	Payload      []byte     `db:"payload"`
+	ResultID     *int64     `db:"result_id"`
+	Error        []byte     `db:"error"`
+	IsTraced     bool       `db:"is_traced"`
+	Trace        []byte     `db:"trace" fake:"skip"`
+	Clock        time.Time  `db:"clock"`
+	Constants    []byte     `db:"constants" fake:"skip"`
+	OutputFormat *string    `db:"output_format" fake:"{randomstring:[md,docx,pdf,xlsx]}"`
+	CreatorID    int64      `db:"creator_id"`
+	CreatedAt    time.Time  `db:"created_at"`
+	UpdatedAt    *time.Time `db:"updated_at"`
 }
 
 type Result struct {
-	ID   int64  `db:"id"`
-	Data []byte `db:"data"`
+	ID          int64  `db:"id"`
+	Data        []byte `db:"data"`
+	Format      string `db:"format" fake:"{randomstring:[md,docx,pdf,xlsx]}"`
+	ContentType string `db:"content_type"`
 }
@@ -0,0 +1,130 @@
+package unit
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+type definition struct {
+	factor    decimal.Decimal
+	offset    decimal.Decimal
+	dimension Dimension
+	display   string
+}
+
+type prefix struct {
+	latin    []string
+	cyrillic string
+	exponent int32
+}
+
+// prefixes are the SI prefixes units may take, see base.prefixes.
+var prefixes = map[rune]prefix{
+	'G': {[]string{"G"}, "Г", 9},
+	'M': {[]string{"M"}, "М", 6},
+	'k': {[]string{"k"}, "к", 3},
+	'c': {[]string{"c"}, "с", -2},
+	'm': {[]string{"m"}, "м", -3},
+	'u': {[]string{"µ", "μ", "u"}, "мк", -6},
+	'n': {[]string{"n"}, "н", -9},
+}
+
+type base struct {
+	latin    []string
+	cyrillic []string
+	factor   string
+	offset   string
+	// dimension exponents in the order of Dimension
+	dimension Dimension
+	// prefixes lists the keys of the prefixes the unit may take
+	prefixes string
+}
+
+// bases are the supported units. The first Cyrillic symbol is the displayed
+// one.
+var bases = []base{
+	// length, area, volume
+	{latin: []string{"m"}, cyrillic: []string{"м"}, factor: "1", dimension: Dimension{1}, prefixes: "kcmun"},
+	{latin: []string{"in"}, cyrillic: []string{"дюйм"}, factor: "0.0254", dimension: Dimension{1}},
+	{latin: []string{"ft"}, cyrillic: []string{"фут"}, factor: "0.3048", dimension: Dimension{1}},
+	{latin: []string{"ha"}, cyrillic: []string{"га"}, factor: "10000", dimension: Dimension{2}},
+	{latin: []string{"L", "l"}, cyrillic: []string{"л"}, factor: "0.001", dimension: Dimension{3}, prefixes: "m"},
+
+	// mass
+	{latin: []string{"g"}, cyrillic: []string{"г"}, factor: "0.001", dimension: Dimension{0, 1}, prefixes: "kmu"},
+	{latin: []string{"t"}, cyrillic: []string{"т"}, factor: "1000", dimension: Dimension{0, 1}},
+
+	// time, frequency
+	{latin: []string{"s"}, cyrillic: []string{"с"}, factor: "1", dimension: Dimension{0, 0, 1}, prefixes: "mun"},
+	{latin: []string{"min"}, cyrillic: []string{"мин"}, factor: "60", dimension: Dimension{0, 0, 1}},
+	{latin: []string{"h"}, cyrillic: []string{"ч"}, factor: "3600", dimension: Dimension{0, 0, 1}},
+	{latin: []string{"d"}, cyrillic: []string{"сут"}, factor: "86400", dimension: Dimension{0, 0, 1}},
+	{latin: []string{"Hz"}, cyrillic: []string{"Гц"}, factor: "1", dimension: Dimension{0, 0, -1}, prefixes: "kMG"},
+
+	// other base quantities
+	{latin: []string{"A"}, cyrillic: []string{"А"}, factor: "1", dimension: Dimension{0, 0, 0, 1}, prefixes: "kmu"},
+	{latin: []string{"K"}, cyrillic: []string{"К"}, factor: "1", dimension: Dimension{0, 0, 0, 0, 1}},
+	{latin: []string{"degC", "℃"}, cyrillic: []string{"°C", "°С"}, factor: "1", offset: "273.15", dimension: Dimension{0, 0, 0, 0, 1}},
+	{latin: []string{"mol"}, cyrillic: []string{"моль"}, factor: "1", dimension: Dimension{0, 0, 0, 0, 0, 1}, prefixes: "km"},
+	{latin: []string{"cd"}, cyrillic: []string{"кд"}, factor: "1", dimension: Dimension{0, 0, 0, 0, 0, 0, 1}},
+
+	// mechanics
+	{latin: []string{"N"}, cyrillic: []string{"Н"}, factor: "1", dimension: Dimension{1, 1, -2}, prefixes: "kM"},
+	{latin: []string{"Pa"}, cyrillic: []string{"Па"}, factor: "1", dimension: Dimension{-1, 1, -2}, prefixes: "kMG"},
+	{latin: []string{"bar"}, cyrillic: []string{"бар"}, factor: "100000", dimension: Dimension{-1, 1, -2}},
+	{latin: []string{"J"}, cyrillic: []string{"Дж"}, factor: "1", dimension: Dimension{2, 1, -2}, prefixes: "kMG"},
+	{latin: []string{"W"}, cyrillic: []string{"Вт"}, factor: "1", dimension: Dimension{2, 1, -3}, prefixes: "mkMG"},
+
+	// electricity
+	{latin: []string{"V"}, cyrillic: []string{"В"}, factor: "1", dimension: Dimension{2, 1, -3, -1}, prefixes: "mk"},
+	{latin: []string{"Ohm", "Ω"}, cyrillic: []string{"Ом"}, factor: "1", dimension: Dimension{2, 1, -3, -2}, prefixes: "mkM"},
+	{latin: []string{"C"}, cyrillic: []string{"Кл"}, factor: "1", dimension: Dimension{0, 0, 1, 1}},
+
+	// dimensionless
+	{cyrillic: []string{"%"}, factor: "0.01"},
+}
+
+// symbols maps every accepted symbol, prefixed ones included, to its unit.
+var symbols = make(map[string]definition)
+
+func init() {
+	for _, b := range bases {
+		d := definition{
+			factor:    decimal.RequireFromString(b.factor),
+			dimension: b.dimension,
+			display:   b.cyrillic[0],
+		}
+		if b.offset != "" {
+			d.offset = decimal.RequireFromString(b.offset)
+		}
+
+		for _, s := range slices.Concat(b.latin, b.cyrillic) {
+			addSymbol(s, d)
+		}
+
+		for _, key := range b.prefixes {
+			p := prefixes[key]
+			pd := d
+			pd.factor = d.factor.Mul(decimal.New(1, p.exponent))
+			pd.display = p.cyrillic + d.display
+
+			for _, l := range p.latin {
+				for _, s := range b.latin {
+					addSymbol(l+s, pd)
+				}
+			}
+			for _, s := range b.cyrillic {
+				addSymbol(p.cyrillic+s, pd)
+			}
+		}
+	}
+}
+
+func addSymbol(s string, d definition) {
+	if _, ok := symbols[s]; ok {
+		panic(fmt.Sprintf("unit: duplicate symbol %q", s))
+	}
+	symbols[s] = d
+}
@@ -0,0 +1,335 @@
+package unit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrUnknown      = errors.New("unknown unit")
+	ErrIncompatible = errors.New("incompatible units")
+)
+
+// divisionPrecision is the number of decimal places kept when a conversion
+// does not come out exact, e.g. from km/h to m/s.
+const divisionPrecision = 32
+
+// maxExponent limits the exponents of a unit expression.
+const maxExponent = 9
+
+// Dimension holds the exponents of the SI base quantities: length, mass, time,
+// electric current, temperature, amount of substance and luminous intensity.
+type Dimension [7]int
+
+var baseSymbols = [7]string{"м", "кг", "с", "А", "К", "моль", "кд"}
+
+func (d Dimension) add(e Dimension, sign int) Dimension {
+	for i := range d {
+		d[i] += sign * e[i]
+	}
+	return d
+}
+
+func (d Dimension) String() string {
+	var terms []string
+	for i, n := range d {
+		if n != 0 {
+			terms = append(terms, powerSymbol(baseSymbols[i], n))
+		}
+	}
+	if len(terms) == 0 {
+		return "1"
+	}
+	return strings.Join(terms, "·")
+}
+
+// Unit is a unit of measurement: a value x of the unit is x·num/den + offset
+// in SI base units. The factor is kept as a fraction so that conversions
+// divide only once. Only lone temperature units such as °C have an offset.
+type Unit struct {
+	num       decimal.Decimal
+	den       decimal.Decimal
+	offset    decimal.Decimal
+	dimension Dimension
+	symbol    string
+}
+
+// One is the unit of dimensionless numbers.
+var One = Unit{num: decimal.NewFromInt(1), den: decimal.NewFromInt(1)}
+
+// Parse parses a unit expression: unit symbols, each optionally raised to an
+// integer power, joined by "*" or "·" and divided with "/", e.g. "kg*m/s^2",
+// "м/с²" or "W/m2". Latin and Cyrillic (GOST 8.417) symbols are accepted.
+func Parse(s string) (Unit, error) {
+	source := s
+	if strings.TrimSpace(s) == "" {
+		return Unit{}, fmt.Errorf("%w: %q", ErrUnknown, source)
+	}
+
+	u := One
+	var numerator, denominator []string
+	divide := false
+	for {
+		i := strings.IndexFunc(s, isSeparator)
+		term := s
+		if i >= 0 {
+			term = s[:i]
+		}
+
+		t, symbol, err := parseTerm(strings.TrimSpace(term))
+		if err != nil {
+			return Unit{}, fmt.Errorf("%w: %q", err, source)
+		}
+		if divide {
+			u = u.Div(t)
+			denominator = append(denominator, symbol)
+		} else {
+			u = u.Mul(t)
+			if symbol != "" {
+				numerator = append(numerator, symbol)
+			}
+		}
+
+		if i < 0 {
+			break
+		}
+		r := []rune(s[i:])[0]
+		divide = r == '/'
+		s = s[i+len(string(r)):]
+	}
+
+	if len(numerator) == 1 && len(denominator) == 0 {
+		// a lone unit keeps its offset, e.g. °C; in products and quotients it
+		// stands for a difference, e.g. J/(kg·°C)
+		if d, ok := symbols[strings.TrimSpace(source)]; ok {
+			u.offset = d.offset
+		}
+	}
+
+	u.symbol = strings.Join(numerator, "·")
+	if u.symbol == "" && len(denominator) != 0 {
+		u.symbol = "1"
+	}
+	for _, symbol := range denominator {
+		u.symbol += "/" + symbol
+	}
+	return u, nil
+}
+
+// Must is like Parse but panics if the unit does not parse.
+func Must(s string) Unit {
+	u, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// parseTerm parses a unit symbol followed by an optional exponent and returns
+// the unit and its display symbol.
+func parseTerm(term string) (Unit, string, error) {
+	if term == "1" {
+		return One, "", nil
+	}
+
+	i := strings.IndexFunc(term, func(r rune) bool {
+		return r == '^' || r == '-' || unicode.IsDigit(r) || strings.ContainsRune(superscripts, r) || r == '⁻'
+	})
+	symbol, power := term, ""
+	if i >= 0 {
+		symbol, power = term[:i], term[i:]
+	}
+
+	d, ok := symbols[symbol]
+	if !ok {
+		return Unit{}, "", ErrUnknown
+	}
+
+	n := 1
+	if power != "" {
+		var err error
+		n, err = parseExponent(power)
+		if err != nil {
+			return Unit{}, "", ErrUnknown
+		}
+	}
+
+	u := Unit{num: d.factor, den: One.den, dimension: d.dimension, symbol: d.display}.Pow(n)
+	return u, u.symbol, nil
+}
+
+func parseExponent(s string) (int, error) {
+	s = strings.TrimPrefix(s, "^")
+	s = strings.Map(func(r rune) rune {
+		if r == '⁻' {
+			return '-'
+		}
+		if i := strings.IndexRune(superscripts, r); i >= 0 {
+			return rune('0' + len([]rune(superscripts[:i])))
+		}
+		return r
+	}, s)
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 || n > maxExponent || n < -maxExponent {
+		return 0, fmt.Errorf("exponent %d is out of range", n)
+	}
+	return n, nil
+}
+
+func isSeparator(r rune) bool {
+	return r == '*' || r == '·' || r == '⋅' || r == '/'
+}
+
+// Dimension returns the dimension of the unit.
+func (u Unit) Dimension() Dimension { return u.dimension }
+
+// Compatible reports whether values of u and v can be converted into each
+// other, i.e. whether the units have the same dimension.
+func (u Unit) Compatible(v Unit) bool { return u.dimension == v.dimension }
+
+// Equivalent reports whether u and v denote the same unit, so that values of
+// them can be added without a conversion. Millimetres are compatible with
+// metres but not equivalent to them.
+func (u Unit) Equivalent(v Unit) bool {
+	return u.dimension == v.dimension && u.num.Mul(v.den).Equal(v.num.Mul(u.den)) && u.offset.Equal(v.offset)
+}
+
+// Dimensionless reports whether u has no dimension, like One or percent.
+func (u Unit) Dimensionless() bool { return u.dimension == Dimension{} }
+
+// Mul returns the product of units, e.g. N·m.
+func (u Unit) Mul(v Unit) Unit {
+	return Unit{
+		num:       u.num.Mul(v.num),
+		den:       u.den.Mul(v.den),
+		dimension: u.dimension.add(v.dimension, 1),
+		symbol:    joinSymbols(u.symbol, "·", v.symbol),
+	}
+}
+
+// Div returns the quotient of units, e.g. m/s.
+func (u Unit) Div(v Unit) Unit {
+	return Unit{
+		num:       u.num.Mul(v.den),
+		den:       u.den.Mul(v.num),
+		dimension: u.dimension.add(v.dimension, -1),
+		symbol:    joinSymbols(u.symbol, "/", v.symbol),
+	}
+}
+
+// Pow returns the unit raised to an integer power, e.g. m².
+func (u Unit) Pow(n int) Unit {
+	if n == 0 {
+		return One
+	}
+
+	p := One
+	for range max(n, -n) {
+		p = p.Mul(Unit{num: u.num, den: u.den, dimension: u.dimension})
+	}
+	if n < 0 {
+		p = One.Div(p)
+	}
+
+	p.symbol = powerSymbol(u.symbol, n)
+	return p
+}
+
+func powerSymbol(symbol string, n int) string {
+	if symbol == "" || n == 1 {
+		return symbol
+	}
+	return wrap(symbol) + superscript(n)
+}
+
+// String returns the Russian symbol of the unit, e.g. "кг·м/с²". Units derived
+// with Mul, Div or Pow are written in terms of the symbols they come from.
+func (u Unit) String() string {
+	if u.symbol == "" && !u.Dimensionless() {
+		return u.dimension.String()
+	}
+	return u.symbol
+}
+
+func joinSymbols(a, sep, b string) string {
+	switch {
+	case b == "":
+		return a
+	case a == "" && sep == "/":
+		return "1/" + wrap(b)
+	case a == "":
+		return b
+	case sep == "/":
+		return a + "/" + wrap(b)
+	default:
+		return a + sep + b
+	}
+}
+
+func wrap(symbol string) string {
+	if strings.ContainsAny(symbol, "·/") {
+		return "(" + symbol + ")"
+	}
+	return symbol
+}
+
+const superscripts = "⁰¹²³⁴⁵⁶⁷⁸⁹"
+
+func superscript(n int) string {
+	var b strings.Builder
+	if n < 0 {
+		b.WriteRune('⁻')
+		n = -n
+	}
+	for _, r := range strconv.Itoa(n) {
+		b.WriteRune([]rune(superscripts)[r-'0'])
+	}
+	return b.String()
+}
+
+// ConvertDecimal converts a value of unit from into unit to.
+func ConvertDecimal(x decimal.Decimal, from, to Unit) (decimal.Decimal, error) {
+	if !from.Compatible(to) {
+		return decimal.Decimal{}, fmt.Errorf("%w: %s and %s", ErrIncompatible, from, to)
+	}
+	if from.Equivalent(to) {
+		return x, nil
+	}
+	num, shift, den := conversion(from, to)
+	return x.Mul(num).Add(shift).DivRound(den, divisionPrecision), nil
+}
+
+// ConvertFloat converts a value of unit from into unit to.
+func ConvertFloat(x float64, from, to Unit) (float64, error) {
+	if !from.Compatible(to) {
+		return 0, fmt.Errorf("%w: %s and %s", ErrIncompatible, from, to)
+	}
+	if from.Equivalent(to) {
+		return x, nil
+	}
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return x, nil
+	}
+	// converted in decimal, so that 300 K is 26.85 °C and not 26.850000000000023
+	y, _ := ConvertDecimal(decimal.NewFromFloat(x), from, to)
+	return y.InexactFloat64(), nil
+}
+
+// conversion returns the terms converting values of unit from into unit to:
+// y = (x·num + shift) / den.
+func conversion(from, to Unit) (num, shift, den decimal.Decimal) {
+	num = from.num.Mul(to.den)
+	shift = from.offset.Sub(to.offset).Mul(from.den).Mul(to.den)
+	den = from.den.Mul(to.num)
+	return num, shift, den
+}
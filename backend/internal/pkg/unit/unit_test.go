@@ -0,0 +1,89 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		source    string
+		want      string
+		dimension Dimension
+	}{
+		{"mm", "мм", Dimension{1}},
+		{"мм", "мм", Dimension{1}},
+		{"m2", "м²", Dimension{2}},
+		{"м³", "м³", Dimension{3}},
+		{"kg*m/s^2", "кг·м/с²", Dimension{1, 1, -2}},
+		{"Н·м", "Н·м", Dimension{2, 1, -2}},
+		{"W/m2/K", "Вт/м²/К", Dimension{0, 1, -3, 0, -1}},
+		{"1/s", "1/с", Dimension{0, 0, -1}},
+		{"m^-1", "м⁻¹", Dimension{-1}},
+		{"µm", "мкм", Dimension{1}},
+		{"degC", "°C", Dimension{0, 0, 0, 0, 1}},
+		{"%", "%", Dimension{}},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.source)
+		require.NoError(t, err, tt.source)
+		require.Equal(t, tt.want, got.String(), tt.source)
+		require.Equal(t, tt.dimension, got.Dimension(), tt.source)
+	}
+
+	for _, source := range []string{"", "furlong", "m^0", "m^10", "m/", "kg**2"} {
+		_, err := Parse(source)
+		require.ErrorIs(t, err, ErrUnknown, source)
+	}
+}
+
+func TestUnit_Equivalent(t *testing.T) {
+	require.True(t, Must("N").Equivalent(Must("kg*m/s^2")))
+	require.True(t, Must("kN").Equivalent(Must("кН")))
+	require.True(t, Must("mL").Equivalent(Must("cm3")))
+	require.False(t, Must("mm").Equivalent(Must("m")))
+	require.True(t, Must("mm").Compatible(Must("m")))
+	require.False(t, Must("K").Equivalent(Must("degC")))
+	require.True(t, Must("m").Mul(Must("m")).Equivalent(Must("m2")))
+	require.True(t, One.Div(Must("s")).Equivalent(Must("Hz")))
+	require.Equal(t, "м·с⁻¹", Dimension{1, 0, -1}.String())
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		x        string
+		from, to string
+		want     string
+	}{
+		{"1500", "mm", "m", "1.5"},
+		{"2.5", "m", "mm", "2500"},
+		{"36", "km/h", "m/s", "10"},
+		{"1", "m2", "cm2", "10000"},
+		{"1", "ha", "m2", "10000"},
+		{"20", "degC", "K", "293.15"},
+		{"300", "K", "°C", "26.85"},
+		{"1", "in", "mm", "25.4"},
+		{"12", "kN", "N", "12000"},
+		{"1", "bar", "kPa", "100"},
+		{"15", "%", "1", "0.15"},
+	}
+
+	for _, tt := range tests {
+		got, err := ConvertDecimal(decimal.RequireFromString(tt.x), Must(tt.from), Must(tt.to))
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got.String(), "%s %s to %s", tt.x, tt.from, tt.to)
+
+		f, err := ConvertFloat(got.InexactFloat64(), Must(tt.to), Must(tt.from))
+		require.NoError(t, err)
+		require.InDelta(t, decimal.RequireFromString(tt.x).InexactFloat64(), f, 1e-9)
+	}
+
+	_, err := ConvertDecimal(decimal.NewFromInt(1), Must("m"), Must("kg"))
+	require.ErrorIs(t, err, ErrIncompatible)
+
+	_, err = ConvertFloat(1, Must("m"), Must("s"))
+	require.ErrorIs(t, err, ErrIncompatible)
+}
@@ -0,0 +1,190 @@
+package xlsx
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// The values a template prints are marked in the Markdown with characters of
+// the private use area, which the Markdown parser keeps as text. A mark is the
+// start character, the kind of the value, its text and the end character.
+const (
+	markStart = '\uE000'
+	markEnd   = '\uE001'
+
+	markNumber = 'n'
+	markDate   = 'd'
+	markText   = 't'
+)
+
+const markDateLayout = "02.01.2006"
+
+// Mark marks a value printed by a template, so that Render types its cell by
+// the value and never takes it for a formula. Printed strings, such as numbers
+// formatted by the template, are typed by their text.
+func Mark(v any) string {
+	kind, text := markText, fmt.Sprint(v)
+
+	switch v := v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		kind = markNumber
+	case float32:
+		kind, text = markFloat(float64(v), text)
+	case float64:
+		kind, text = markFloat(v, text)
+	case decimal.Decimal:
+		kind = markNumber
+	case time.Time:
+		kind, text = markDate, v.Format(markDateLayout)
+	}
+
+	// marks in the text would let it end its own mark
+	text = strings.Map(func(r rune) rune {
+		if r == markStart || r == markEnd {
+			return -1
+		}
+		return r
+	}, text)
+
+	return string(markStart) + string(kind) + text + string(markEnd)
+}
+
+func markFloat(f float64, text string) (rune, string) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return markText, text
+	}
+	return markNumber, strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// segment is a part of the cell text, either written by the template or
+// printed by it as a marked value.
+type segment struct {
+	kind rune
+	text string
+}
+
+func (s segment) marked() bool {
+	return s.kind != 0
+}
+
+// segments splits the text by the marks. A mark cut by the Markdown, e.g.
+// when a printed value spans table cells, is text up to the end of the cell.
+func segments(text string) []segment {
+	var (
+		result []segment
+		buf    strings.Builder
+		kind   rune
+	)
+
+	flush := func() {
+		if buf.Len() > 0 || kind != 0 {
+			result = append(result, segment{kind: kind, text: buf.String()})
+		}
+		buf.Reset()
+	}
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; r {
+		case markStart:
+			flush()
+			kind = markText
+			if i+1 < len(runes) && (runes[i+1] == markNumber || runes[i+1] == markDate || runes[i+1] == markText) {
+				kind = runes[i+1]
+				i++
+			}
+		case markEnd:
+			if kind == 0 {
+				kind = markText
+			}
+			flush()
+			kind = 0
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	flush()
+
+	return result
+}
+
+// plainText drops the marks from the text.
+func plainText(text string) string {
+	var b strings.Builder
+	for _, s := range segments(text) {
+		b.WriteString(s.text)
+	}
+	return b.String()
+}
+
+// parseCell types the text of a cell. A cell holding a printed value alone is
+// typed by the value, a printed string by its text but never as a formula. A
+// cell starting with "=" written by the template is a formula, the printed
+// values in which are literals. Other cells with printed values are text, and
+// the rest are typed by their text.
+func parseCell(text string) value {
+	segs := segments(strings.TrimSpace(text))
+	if !lo.SomeBy(segs, segment.marked) {
+		return parseValue(text)
+	}
+
+	if len(segs) == 1 {
+		return segs[0].value()
+	}
+
+	if first := segs[0]; !first.marked() && strings.HasPrefix(first.text, "=") {
+		var b strings.Builder
+		b.WriteString(first.text[1:])
+		for _, s := range segs[1:] {
+			b.WriteString(s.literal())
+		}
+		return value{kind: kindFormula, text: b.String()}
+	}
+
+	return value{kind: kindText, text: plainText(text)}
+}
+
+func (s segment) value() value {
+	switch s.kind {
+	case markNumber:
+		if number, err := strconv.ParseFloat(s.text, 64); err == nil {
+			_, fraction, _ := strings.Cut(s.text, ".")
+			return value{kind: kindNumber, text: s.text, number: number, decimals: len(fraction)}
+		}
+	case markDate:
+		if date, err := time.Parse(markDateLayout, s.text); err == nil {
+			return value{kind: kindDate, text: s.text, date: date}
+		}
+	}
+
+	if v := parseValue(s.text); v.kind != kindFormula {
+		return v
+	}
+
+	return value{kind: kindText, text: s.text}
+}
+
+// literal writes the segment into a formula, a printed value as a literal of
+// its kind.
+func (s segment) literal() string {
+	switch s.kind {
+	case 0:
+		return s.text
+	case markNumber:
+		if _, err := strconv.ParseFloat(s.text, 64); err == nil {
+			return s.text
+		}
+	case markDate:
+		if date, err := time.Parse(markDateLayout, s.text); err == nil {
+			return fmt.Sprintf("DATE(%d,%d,%d)", date.Year(), date.Month(), date.Day())
+		}
+	}
+
+	return `"` + strings.ReplaceAll(s.text, `"`, `""`) + `"`
+}
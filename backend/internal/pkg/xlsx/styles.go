@@ -0,0 +1,101 @@
+package xlsx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/markdown"
+)
+
+// Built-in number formats.
+const (
+	numFmtInteger = 3 // #,##0
+	numFmtPercent = 9 // 0%
+)
+
+const (
+	dateFormat  = "dd.mm.yyyy"
+	headerColor = "D9D9D9"
+)
+
+type styleKey struct {
+	header bool
+	// plain is the text of a document without tables, which has no borders
+	plain    bool
+	bold     bool
+	kind     valueKind
+	decimals int
+	align    markdown.Align
+}
+
+var horizontal = map[markdown.Align]string{
+	markdown.AlignLeft:   "left",
+	markdown.AlignCenter: "center",
+	markdown.AlignRight:  "right",
+}
+
+var borders = []excelize.Border{
+	{Type: "left", Color: "000000", Style: 1},
+	{Type: "top", Color: "000000", Style: 1},
+	{Type: "right", Color: "000000", Style: 1},
+	{Type: "bottom", Color: "000000", Style: 1},
+}
+
+// style returns the style of the key, adding it to the workbook once.
+func (w *writer) style(key styleKey) (int, error) {
+	if id, found := w.styles[key]; found {
+		return id, nil
+	}
+
+	style := &excelize.Style{
+		Font:      &excelize.Font{Family: w.profile.Font, Size: fontSize, Bold: key.bold},
+		Alignment: &excelize.Alignment{Horizontal: horizontal[key.align], Vertical: "top"},
+	}
+
+	switch {
+	case key.header:
+		style.Font.Bold = true
+		style.Fill = excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{headerColor}}
+		style.Alignment = &excelize.Alignment{Horizontal: "center", Vertical: "center", WrapText: true}
+		style.Border = borders
+	case key.plain:
+		style.Alignment.WrapText = true
+	default:
+		style.Border = borders
+		style.Alignment.WrapText = key.kind == kindText
+	}
+
+	switch key.kind {
+	case kindNumber:
+		if key.decimals == 0 {
+			style.NumFmt = numFmtInteger
+		} else {
+			style.CustomNumFmt = numberFormat("#,##0", key.decimals, "")
+		}
+	case kindPercent:
+		if key.decimals == 0 {
+			style.NumFmt = numFmtPercent
+		} else {
+			style.CustomNumFmt = numberFormat("0", key.decimals, "%")
+		}
+	case kindDate:
+		format := dateFormat
+		style.CustomNumFmt = &format
+	}
+
+	id, err := w.file.NewStyle(style)
+	if err != nil {
+		return 0, fmt.Errorf("new style: %w", err)
+	}
+
+	w.styles[key] = id
+	return id, nil
+}
+
+// numberFormat keeps the number of decimals the number is written with.
+func numberFormat(integer string, decimals int, suffix string) *string {
+	format := integer + "." + strings.Repeat("0", decimals) + suffix
+	return &format
+}
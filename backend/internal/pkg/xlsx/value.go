@@ -0,0 +1,80 @@
+package xlsx
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type valueKind int
+
+const (
+	kindText valueKind = iota
+	kindNumber
+	kindPercent
+	kindDate
+	kindFormula
+)
+
+// value is the content of a cell typed by its text.
+type value struct {
+	kind     valueKind
+	text     string
+	number   float64
+	decimals int
+	date     time.Time
+}
+
+// numberRe matches an integer or a decimal with a dot or a comma, optionally
+// with spaces between the digit groups and a percent sign. Numbers with
+// leading zeros, such as codes, are text.
+var numberRe = regexp.MustCompile(`^([-+−]?)(0|[1-9]\d{0,2}(?:[ \x{00a0}\x{202f}]\d{3})+|[1-9]\d*)(?:[.,](\d+))?(%?)$`)
+
+// outlineRe matches outline numbers such as 1.2.3, which keep a column with
+// them as text.
+var outlineRe = regexp.MustCompile(`^[1-9]\d*(?:\.[1-9]\d*){2,}\.?$`)
+
+var dateLayouts = []string{"02.01.2006", "2006-01-02"}
+
+// parseValue types the text of a cell. A formula starts with "=".
+func parseValue(text string) value {
+	text = strings.TrimSpace(text)
+
+	if len(text) > 1 && text[0] == '=' {
+		return value{kind: kindFormula, text: text[1:]}
+	}
+
+	if m := numberRe.FindStringSubmatch(text); m != nil {
+		digits := strings.Map(func(r rune) rune {
+			if r == ' ' || r == '\u00a0' || r == '\u202f' {
+				return -1
+			}
+			return r
+		}, m[2])
+		if m[3] != "" {
+			digits += "." + m[3]
+		}
+
+		number, err := strconv.ParseFloat(digits, 64)
+		if err == nil {
+			if m[1] != "" && m[1] != "+" {
+				number = -number
+			}
+
+			v := value{kind: kindNumber, text: text, number: number, decimals: len(m[3])}
+			if m[4] != "" {
+				v.kind, v.number = kindPercent, number/100
+			}
+			return v
+		}
+	}
+
+	for _, layout := range dateLayouts {
+		if date, err := time.Parse(layout, text); err == nil {
+			return value{kind: kindDate, text: text, date: date}
+		}
+	}
+
+	return value{kind: kindText, text: text}
+}
@@ -0,0 +1,355 @@
+package xlsx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/xuri/excelize/v2"
+
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/markdown"
+)
+
+const (
+	// fontSize is the size of the cell text, the profile sizes are for pages.
+	fontSize = 11
+	// sheetNameMaxLen is the limit of Excel on sheet names.
+	sheetNameMaxLen = 31
+
+	minColumnWidth = 8
+	maxColumnWidth = 60
+	textWidth      = 100
+
+	// defaultSheet is the sheet a new workbook starts with.
+	defaultSheet = "Sheet1"
+	textSheet    = "Документ"
+)
+
+// Render lays out the tables of the Markdown document as sheets of an XLSX
+// workbook, each named after the heading above it. The header row is styled
+// and frozen. A cell holding a value marked by Mark is typed by the value, and
+// a cell the template writes is typed by its text: numbers, percents and dates
+// keep the number of decimals, and text starting with "=" is a formula, which
+// refers to the cells of the sheet with the header in row 1. Columns aligned
+// left stay text. A document without tables is put on one sheet line by line.
+func Render(blocks []markdown.Block, profile output_domain.Profile) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	w := newWriter(f, profile)
+	if err := w.writeWorkbook(blocks); err != nil {
+		return nil, err
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("write workbook: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+type writer struct {
+	file    *excelize.File
+	profile output_domain.Profile
+	styles  map[styleKey]int
+	names   map[string]bool
+	sheets  int
+}
+
+func newWriter(file *excelize.File, profile output_domain.Profile) *writer {
+	return &writer{
+		file:    file,
+		profile: profile,
+		styles:  make(map[styleKey]int),
+		names:   make(map[string]bool),
+	}
+}
+
+// namedTable is a table with the heading above it.
+type namedTable struct {
+	heading string
+	table   markdown.Table
+}
+
+func (w *writer) writeWorkbook(blocks []markdown.Block) error {
+	var (
+		title   string
+		heading string
+		tables  []namedTable
+	)
+
+	var walk func(blocks []markdown.Block)
+	walk = func(blocks []markdown.Block) {
+		for _, block := range blocks {
+			switch b := block.(type) {
+			case markdown.Heading:
+				heading = plainText(markdown.PlainText(b.Text))
+				if b.Level == 1 && title == "" {
+					title = heading
+				}
+			case markdown.Table:
+				tables = append(tables, namedTable{heading: heading, table: b})
+			case markdown.Quote:
+				walk(b.Blocks)
+			case markdown.List:
+				for _, item := range b.Items {
+					walk(item.Children)
+				}
+			}
+		}
+	}
+	walk(blocks)
+
+	if title != "" {
+		if err := w.file.SetDocProps(&excelize.DocProperties{Title: title}); err != nil {
+			return fmt.Errorf("set doc props: %w", err)
+		}
+	}
+
+	if len(tables) == 0 {
+		return w.writeText(blocks)
+	}
+
+	for _, t := range tables {
+		if err := w.writeTable(w.sheetName(t.heading), t.table); err != nil {
+			return err
+		}
+	}
+
+	// the formulas are calculated once the workbook is opened
+	if err := w.file.SetCalcProps(&excelize.CalcPropsOptions{FullCalcOnLoad: lo.ToPtr(true)}); err != nil {
+		return fmt.Errorf("set calc props: %w", err)
+	}
+
+	return nil
+}
+
+func (w *writer) writeTable(sheet string, table markdown.Table) error {
+	if err := w.addSheet(sheet); err != nil {
+		return err
+	}
+
+	columns := len(table.Header)
+	widths := make([]int, columns)
+
+	for c, cell := range table.Header {
+		text := plainText(markdown.PlainText(cell.Text))
+		widths[c] = max(widths[c], len([]rune(text)))
+
+		if err := w.setCell(sheet, c+1, 1, value{kind: kindText, text: text}, styleKey{header: true}); err != nil {
+			return err
+		}
+	}
+
+	typed := make([]bool, columns)
+	for c := range columns {
+		typed[c] = align(table.Align, c) != markdown.AlignLeft && !lo.SomeBy(table.Rows, func(row []markdown.Cell) bool {
+			return c < len(row) && outlineRe.MatchString(strings.TrimSpace(plainText(markdown.PlainText(row[c].Text))))
+		})
+	}
+
+	for r, row := range table.Rows {
+		for c := range columns {
+			var text string
+			if c < len(row) {
+				text = markdown.PlainText(row[c].Text)
+			}
+			plain := plainText(text)
+			widths[c] = max(widths[c], len([]rune(plain)))
+
+			v := parseCell(text)
+			if !typed[c] && v.kind != kindFormula {
+				v = value{kind: kindText, text: plain}
+			}
+
+			key := styleKey{kind: v.kind, decimals: v.decimals, align: align(table.Align, c)}
+			if err := w.setCell(sheet, c+1, r+2, v, key); err != nil {
+				return err
+			}
+		}
+	}
+
+	for c, width := range widths {
+		name, err := excelize.ColumnNumberToName(c + 1)
+		if err != nil {
+			return fmt.Errorf("column name: %w", err)
+		}
+
+		width := min(max(float64(width)*1.2+2, minColumnWidth), maxColumnWidth)
+		if err := w.file.SetColWidth(sheet, name, name, width); err != nil {
+			return fmt.Errorf("set col width: %w", err)
+		}
+	}
+
+	if columns == 0 {
+		return nil
+	}
+
+	// the header stays in view and filters the rows
+	err := w.file.SetPanes(sheet, &excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"})
+	if err != nil {
+		return fmt.Errorf("set panes: %w", err)
+	}
+
+	last, err := excelize.CoordinatesToCellName(columns, len(table.Rows)+1)
+	if err != nil {
+		return fmt.Errorf("cell name: %w", err)
+	}
+
+	if err := w.file.AutoFilter(sheet, "A1:"+last, nil); err != nil {
+		return fmt.Errorf("auto filter: %w", err)
+	}
+
+	return nil
+}
+
+// writeText puts the text of a document without tables in the first column,
+// a block or a line of code per row.
+func (w *writer) writeText(blocks []markdown.Block) error {
+	if err := w.addSheet(textSheet); err != nil {
+		return err
+	}
+
+	row := 0
+	add := func(text string, key styleKey) error {
+		row++
+		return w.setCell(textSheet, 1, row, value{kind: kindText, text: text}, key)
+	}
+
+	var walk func(blocks []markdown.Block, indent string) error
+	walk = func(blocks []markdown.Block, indent string) error {
+		for _, block := range blocks {
+			var err error
+			switch b := block.(type) {
+			case markdown.Heading:
+				err = add(plainText(markdown.PlainText(b.Text)), styleKey{plain: true, bold: true})
+			case markdown.Paragraph:
+				err = add(indent+plainText(markdown.PlainText(b.Text)), styleKey{plain: true})
+			case markdown.List:
+				for i, item := range b.Items {
+					marker := "–"
+					if b.Ordered {
+						marker = fmt.Sprintf("%d.", b.Start+i)
+					}
+
+					if err = add(indent+marker+" "+plainText(markdown.PlainText(item.Text)), styleKey{plain: true}); err != nil {
+						return err
+					}
+
+					if err = walk(item.Children, indent+"    "); err != nil {
+						return err
+					}
+				}
+			case markdown.Quote:
+				err = walk(b.Blocks, indent+"    ")
+			case markdown.Code:
+				for _, line := range strings.Split(b.Text, "\n") {
+					if err = add(indent+plainText(line), styleKey{plain: true}); err != nil {
+						return err
+					}
+				}
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk(blocks, ""); err != nil {
+		return err
+	}
+
+	if err := w.file.SetColWidth(textSheet, "A", "A", textWidth); err != nil {
+		return fmt.Errorf("set col width: %w", err)
+	}
+
+	return nil
+}
+
+// addSheet adds a sheet, the first one takes the place of the default sheet.
+func (w *writer) addSheet(name string) error {
+	w.sheets++
+	if w.sheets == 1 {
+		if err := w.file.SetSheetName(defaultSheet, name); err != nil {
+			return fmt.Errorf("set sheet name: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := w.file.NewSheet(name); err != nil {
+		return fmt.Errorf("new sheet: %w", err)
+	}
+
+	return nil
+}
+
+var sheetNameReplacer = strings.NewReplacer("[", "", "]", "", ":", "", "*", "", "?", "", "/", "", `\`, "")
+
+// sheetName makes a unique sheet name of the heading, without the characters
+// Excel does not allow. Sheet names are compared case-insensitively.
+func (w *writer) sheetName(heading string) string {
+	base := strings.Trim(strings.TrimSpace(sheetNameReplacer.Replace(heading)), "'")
+	if base == "" {
+		base = fmt.Sprintf("Таблица %d", w.sheets+1)
+	}
+
+	name := truncate(base, sheetNameMaxLen)
+	for i := 2; w.names[strings.ToLower(name)]; i++ {
+		suffix := fmt.Sprintf(" (%d)", i)
+		name = truncate(base, sheetNameMaxLen-len([]rune(suffix))) + suffix
+	}
+	w.names[strings.ToLower(name)] = true
+
+	return name
+}
+
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return strings.TrimSpace(string(runes[:n]))
+}
+
+func align(aligns []markdown.Align, c int) markdown.Align {
+	if c < len(aligns) {
+		return aligns[c]
+	}
+	return markdown.AlignNone
+}
+
+func (w *writer) setCell(sheet string, col, row int, v value, key styleKey) error {
+	cell, err := excelize.CoordinatesToCellName(col, row)
+	if err != nil {
+		return fmt.Errorf("cell name: %w", err)
+	}
+
+	switch v.kind {
+	case kindNumber, kindPercent:
+		err = w.file.SetCellFloat(sheet, cell, v.number, -1, 64)
+	case kindDate:
+		err = w.file.SetCellValue(sheet, cell, v.date)
+	case kindFormula:
+		err = w.file.SetCellFormula(sheet, cell, v.text)
+	default:
+		err = w.file.SetCellStr(sheet, cell, v.text)
+	}
+	if err != nil {
+		return fmt.Errorf("set cell %s: %w", cell, err)
+	}
+
+	style, err := w.style(key)
+	if err != nil {
+		return err
+	}
+
+	if err := w.file.SetCellStyle(sheet, cell, cell, style); err != nil {
+		return fmt.Errorf("set cell style %s: %w", cell, err)
+	}
+
+	return nil
+}
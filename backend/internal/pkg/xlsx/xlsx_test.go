@@ -0,0 +1,245 @@
+package xlsx
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/markdown"
+)
+
+const source = "# Программа и методика испытаний\n" +
+	"\n" +
+	"## 1. Матрица испытаний\n" +
+	"\n" +
+	"| № | :Пункт | Наименование | Срок | Трудоёмкость, ч | Готовность |\n" +
+	"| --- | :--- | --- | --- | ---: | --- |\n" +
+	"| 1 | 1.1 | Проверка **входа** | 01.03.2025 | 1 250,5 | 50% |\n" +
+	"| 2 | 1.2 | Проверка выхода | 2025-03-02 | 2 | 12,5% |\n" +
+	"| 3 | 001 | Итого |  | =SUM(E2:E3) | |\n" +
+	"\n" +
+	"## 2. Ведомость: материалы\n" +
+	"\n" +
+	"| Раздел | Код |\n" +
+	"| --- | --- |\n" +
+	"| 2.1.1 | 10 |\n" +
+	"\n" +
+	"| А | Б |\n" +
+	"| --- | --- |\n" +
+	"| 1 | 2 |\n"
+
+func TestRender(t *testing.T) {
+	data, err := Render(markdown.Parse([]byte(source)), output_domain.Profiles["gost_34"])
+	require.NoError(t, err)
+
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer f.Close()
+
+	require.Equal(t, []string{"1. Матрица испытаний", "2. Ведомость материалы", "2. Ведомость материалы (2)"}, f.GetSheetList())
+
+	props, err := f.GetDocProps()
+	require.NoError(t, err)
+	require.Equal(t, "Программа и методика испытаний", props.Title)
+
+	sheet := "1. Матрица испытаний"
+
+	// the header is bold on a fill and frozen
+	header, err := f.GetRows(sheet)
+	require.NoError(t, err)
+	require.Equal(t, []string{"№", ":Пункт", "Наименование", "Срок", "Трудоёмкость, ч", "Готовность"}, header[0])
+
+	style := cellStyle(t, f, sheet, "A1")
+	require.True(t, style.Font.Bold)
+	require.Equal(t, []string{headerColor}, style.Fill.Color)
+
+	panes, err := f.GetPanes(sheet)
+	require.NoError(t, err)
+	require.True(t, panes.Freeze)
+	require.Equal(t, 1, panes.YSplit)
+
+	tests := []struct {
+		cell     string
+		text     bool
+		raw      string
+		numFmt   int
+		customFm string
+	}{
+		{cell: "A2", raw: "1", numFmt: numFmtInteger},
+		// a column aligned left stays text
+		{cell: "B2", text: true, raw: "1.1"},
+		{cell: "C2", text: true, raw: "Проверка входа"},
+		{cell: "D2", raw: "45717", customFm: dateFormat},
+		{cell: "D3", raw: "45718", customFm: dateFormat},
+		{cell: "E2", raw: "1250.5", customFm: "#,##0.0"},
+		{cell: "E3", raw: "2", numFmt: numFmtInteger},
+		{cell: "F2", raw: "0.5", numFmt: numFmtPercent},
+		{cell: "F3", raw: "0.125", customFm: "0.0%"},
+		{cell: "B4", text: true, raw: "001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cell, func(t *testing.T) {
+			raw, err := f.GetCellValue(sheet, tt.cell, excelize.Options{RawCellValue: true})
+			require.NoError(t, err)
+			require.Equal(t, tt.raw, raw)
+
+			typ, err := f.GetCellType(sheet, tt.cell)
+			require.NoError(t, err)
+			require.Equal(t, tt.text, typ == excelize.CellTypeSharedString)
+
+			style := cellStyle(t, f, sheet, tt.cell)
+			if tt.customFm != "" {
+				require.NotNil(t, style.CustomNumFmt)
+				require.Equal(t, tt.customFm, *style.CustomNumFmt)
+			} else {
+				require.Equal(t, tt.numFmt, style.NumFmt)
+			}
+		})
+	}
+
+	formula, err := f.GetCellFormula(sheet, "E4")
+	require.NoError(t, err)
+	require.Equal(t, "SUM(E2:E3)", formula)
+
+	// a column with outline numbers stays text
+	typ, err := f.GetCellType("2. Ведомость материалы", "A2")
+	require.NoError(t, err)
+	require.Equal(t, excelize.CellTypeSharedString, typ)
+	typ, err = f.GetCellType("2. Ведомость материалы", "B2")
+	require.NoError(t, err)
+	require.NotEqual(t, excelize.CellTypeSharedString, typ)
+}
+
+func TestRender_Text(t *testing.T) {
+	data, err := Render(markdown.Parse([]byte("# Заголовок\n\nТекст\n\n1. первый\n2. второй\n")), output_domain.Profiles["plain"])
+	require.NoError(t, err)
+
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer f.Close()
+
+	require.Equal(t, []string{textSheet}, f.GetSheetList())
+
+	cols, err := f.GetCols(textSheet)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"Заголовок", "Текст", "1. первый", "2. второй"}}, cols)
+}
+
+func TestParseValue(t *testing.T) {
+	tests := []struct {
+		text string
+		want value
+	}{
+		{text: "42", want: value{kind: kindNumber, text: "42", number: 42}},
+		{text: "-3,25", want: value{kind: kindNumber, text: "-3,25", number: -3.25, decimals: 2}},
+		{text: "−7", want: value{kind: kindNumber, text: "−7", number: -7}},
+		{text: "1 234 567.5", want: value{kind: kindNumber, text: "1 234 567.5", number: 1234567.5, decimals: 1}},
+		{text: "5%", want: value{kind: kindPercent, text: "5%", number: 0.05}},
+		{text: "007", want: value{kind: kindText, text: "007"}},
+		{text: "12 34", want: value{kind: kindText, text: "12 34"}},
+		{text: "31.12.2025", want: value{kind: kindDate, text: "31.12.2025", date: time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)}},
+		{text: "32.12.2025", want: value{kind: kindText, text: "32.12.2025"}},
+		{text: "=A1*2", want: value{kind: kindFormula, text: "A1*2"}},
+		{text: "=", want: value{kind: kindText, text: "="}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			require.Equal(t, tt.want, parseValue(tt.text))
+		})
+	}
+}
+
+func TestRender_Marked(t *testing.T) {
+	source := "| Количество | Сумма | Дата | Ссылка | Итого | Цена |\n" +
+		"| --- | --- | --- | --- | --- | --- |\n" +
+		"| " + Mark("1 000") + " | " + Mark(decimal.RequireFromString("12.50")) + " | " + Mark(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)) +
+		" | " + Mark(`=HYPERLINK("http://example.com")`) + " | =B2*" + Mark(int64(2)) + " | " + Mark("1\u00a0234,50") + " |\n"
+
+	data, err := Render(markdown.Parse([]byte(source)), output_domain.Profiles["plain"])
+	require.NoError(t, err)
+
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer f.Close()
+
+	sheet := f.GetSheetList()[0]
+
+	tests := []struct {
+		cell string
+		text bool
+		raw  string
+	}{
+		// a printed string is typed by its text but is never a formula
+		{cell: "A2", raw: "1000"},
+		{cell: "B2", raw: "12.5"},
+		{cell: "C2", raw: "46054"},
+		{cell: "D2", text: true, raw: `=HYPERLINK("http://example.com")`},
+		// a number formatted by formatNumber
+		{cell: "F2", raw: "1234.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cell, func(t *testing.T) {
+			raw, err := f.GetCellValue(sheet, tt.cell, excelize.Options{RawCellValue: true})
+			require.NoError(t, err)
+			require.Equal(t, tt.raw, raw)
+
+			typ, err := f.GetCellType(sheet, tt.cell)
+			require.NoError(t, err)
+			require.Equal(t, tt.text, typ == excelize.CellTypeSharedString)
+
+			formula, err := f.GetCellFormula(sheet, tt.cell)
+			require.NoError(t, err)
+			require.Empty(t, formula)
+		})
+	}
+
+	formula, err := f.GetCellFormula(sheet, "E2")
+	require.NoError(t, err)
+	require.Equal(t, "B2*2", formula)
+}
+
+func TestParseCell(t *testing.T) {
+	date := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		text string
+		want value
+	}{
+		{name: "Integer", text: Mark(42), want: value{kind: kindNumber, text: "42", number: 42}},
+		{name: "Float", text: Mark(1e6), want: value{kind: kindNumber, text: "1000000", number: 1e6}},
+		{name: "Decimal", text: Mark(decimal.RequireFromString("-3.25")), want: value{kind: kindNumber, text: "-3.25", number: -3.25, decimals: 2}},
+		{name: "Date", text: Mark(date), want: value{kind: kindDate, text: "01.02.2026", date: date}},
+		{name: "String", text: " " + Mark("ГОСТ 2.105") + " ", want: value{kind: kindText, text: "ГОСТ 2.105"}},
+		{name: "StringNumber", text: Mark("1\u00a0234,50"), want: value{kind: kindNumber, text: "1\u00a0234,50", number: 1234.5, decimals: 2}},
+		{name: "StringDate", text: Mark("01.02.2026"), want: value{kind: kindDate, text: "01.02.2026", date: date}},
+		{name: "StringFormula", text: Mark("=A1"), want: value{kind: kindText, text: "=A1"}},
+		{name: "StringMark", text: Mark("a\uE001=A1"), want: value{kind: kindText, text: "a=A1"}},
+		{name: "Mixed", text: Mark(5) + " шт.", want: value{kind: kindText, text: "5 шт."}},
+		{name: "Formula", text: "=IF(A1>" + Mark(3) + "," + Mark(date) + "," + Mark(`a"b`) + ")", want: value{kind: kindFormula, text: `IF(A1>3,DATE(2026,2,1),"a""b")`}},
+		{name: "Cut", text: "\uE000t| a", want: value{kind: kindText, text: "| a"}},
+		{name: "Template", text: "1 250,5", want: value{kind: kindNumber, text: "1 250,5", number: 1250.5, decimals: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, parseCell(tt.text))
+		})
+	}
+}
+
+func cellStyle(t *testing.T, f *excelize.File, sheet, cell string) *excelize.Style {
+	t.Helper()
+
+	id, err := f.GetCellStyle(sheet, cell)
+	require.NoError(t, err)
+
+	style, err := f.GetStyle(id)
+	require.NoError(t, err)
+
+	return style
+}
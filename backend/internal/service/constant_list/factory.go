@@ -0,0 +1,13 @@
+package constant_list_service
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	constant_repository "github.com/qsoulior/tech-generator/backend/internal/service/constant_list/repository/constant"
+	"github.com/qsoulior/tech-generator/backend/internal/service/constant_list/service"
+)
+
+func New(db *sqlx.DB) *service.Service {
+	constantRepo := constant_repository.New(db)
+	return service.New(constantRepo)
+}
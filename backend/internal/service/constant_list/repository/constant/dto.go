@@ -0,0 +1,12 @@
+package constant_repository
+
+import constant_domain "github.com/qsoulior/tech-generator/backend/internal/domain/constant"
+
+type constant struct {
+	Name  string `db:"name"`
+	Value string `db:"value"`
+}
+
+func (c constant) toDomain() constant_domain.Constant {
+	return constant_domain.Constant{Name: c.Name, Value: c.Value}
+}
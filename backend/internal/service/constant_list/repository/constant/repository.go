@@ -0,0 +1,52 @@
+package constant_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	constant_domain "github.com/qsoulior/tech-generator/backend/internal/domain/constant"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// ListByTemplateID returns the constants of the template project.
+func (r *Repository) ListByTemplateID(ctx context.Context, templateID int64) ([]constant_domain.Constant, error) {
+	op := "constant - list by template id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"c.name",
+			"c.value",
+		).
+		From("project_constant c").
+		Join("template t ON c.project_id = t.project_id").
+		Where(sq.Eq{"t.id": templateID}).
+		OrderBy("c.name ASC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dtos []constant
+	err = r.db.SelectContext(ctx, &dtos, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return lo.Map(dtos, func(c constant, _ int) constant_domain.Constant { return c.toDomain() }), nil
+}
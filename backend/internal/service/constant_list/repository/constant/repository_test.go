@@ -0,0 +1,68 @@
+package constant_repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	constant_domain "github.com/qsoulior/tech-generator/backend/internal/domain/constant"
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_ListByTemplateID() {
+	ctx := context.Background()
+	repo := New(s.C().DB())
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// projects
+	projects := test_db.GenerateEntities(2, func(p *test_db.Project, _ int) { p.AuthorID = userID })
+	projectIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project", projects)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project", projectIDs)) }()
+
+	// template
+	template := test_db.GenerateEntity(func(t *test_db.Template) {
+		t.IsDefault = false
+		t.ProjectID = &projectIDs[0]
+		t.AuthorID = nil
+	})
+	templateID, err := test_db.InsertEntityWithID[int64](s.C(), "template", template)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "template", templateID)) }()
+
+	// constants: two of the template project and one of another project
+	constants := test_db.GenerateEntities(3, func(c *test_db.ProjectConstant, i int) {
+		c.Name = []string{"org_name", "inn", "org_name"}[i]
+		c.ProjectID = projectIDs[i/2]
+		c.AuthorID = nil
+	})
+	constantIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_constant", constants)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_constant", constantIDs))
+	}()
+
+	want := []constant_domain.Constant{
+		{Name: constants[1].Name, Value: constants[1].Value},
+		{Name: constants[0].Name, Value: constants[0].Value},
+	}
+
+	got, err := repo.ListByTemplateID(ctx, templateID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), want, got)
+}
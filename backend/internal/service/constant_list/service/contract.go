@@ -0,0 +1,13 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package service
+
+import (
+	"context"
+
+	constant_domain "github.com/qsoulior/tech-generator/backend/internal/domain/constant"
+)
+
+type constantRepository interface {
+	ListByTemplateID(ctx context.Context, templateID int64) ([]constant_domain.Constant, error)
+}
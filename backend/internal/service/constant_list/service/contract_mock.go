@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -package service -source contract.go -destination contract_mock.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	constant_domain "github.com/qsoulior/tech-generator/backend/internal/domain/constant"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockconstantRepository is a mock of constantRepository interface.
+type MockconstantRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockconstantRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockconstantRepositoryMockRecorder is the mock recorder for MockconstantRepository.
+type MockconstantRepositoryMockRecorder struct {
+	mock *MockconstantRepository
+}
+
+// NewMockconstantRepository creates a new mock instance.
+func NewMockconstantRepository(ctrl *gomock.Controller) *MockconstantRepository {
+	mock := &MockconstantRepository{ctrl: ctrl}
+	mock.recorder = &MockconstantRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockconstantRepository) EXPECT() *MockconstantRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByTemplateID mocks base method.
+func (m *MockconstantRepository) ListByTemplateID(ctx context.Context, templateID int64) ([]constant_domain.Constant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTemplateID", ctx, templateID)
+	ret0, _ := ret[0].([]constant_domain.Constant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTemplateID indicates an expected call of ListByTemplateID.
+func (mr *MockconstantRepositoryMockRecorder) ListByTemplateID(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTemplateID", reflect.TypeOf((*MockconstantRepository)(nil).ListByTemplateID), ctx, templateID)
+}
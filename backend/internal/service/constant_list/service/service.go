@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	constant_domain "github.com/qsoulior/tech-generator/backend/internal/domain/constant"
+)
+
+type Service struct {
+	constantRepo constantRepository
+}
+
+func New(constantRepo constantRepository) *Service {
+	return &Service{
+		constantRepo: constantRepo,
+	}
+}
+
+// Handle returns the current constants of the template project by name. The
+// result is never nil, so that the project namespace is always available.
+func (s *Service) Handle(ctx context.Context, templateID int64) (map[string]string, error) {
+	constants, err := s.constantRepo.ListByTemplateID(ctx, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("constant repo - list by template id: %w", err)
+	}
+
+	return lo.SliceToMap(constants, func(c constant_domain.Constant) (string, string) { return c.Name, c.Value }), nil
+}
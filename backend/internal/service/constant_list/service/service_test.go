@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	constant_domain "github.com/qsoulior/tech-generator/backend/internal/domain/constant"
+)
+
+func TestService_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+	templateID := gofakeit.Int64()
+
+	tests := []struct {
+		name      string
+		constants []constant_domain.Constant
+		want      map[string]string
+	}{
+		{
+			name: "Constants",
+			constants: []constant_domain.Constant{
+				{Name: "org_name", Value: "ООО Ромашка"},
+				{Name: "inn", Value: "7701234567"},
+			},
+			want: map[string]string{"org_name": "ООО Ромашка", "inn": "7701234567"},
+		},
+		{
+			name:      "Empty",
+			constants: nil,
+			want:      map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			constantRepo := NewMockconstantRepository(ctrl)
+			constantRepo.EXPECT().ListByTemplateID(ctx, templateID).Return(tt.constants, nil)
+
+			service := New(constantRepo)
+			got, err := service.Handle(ctx, templateID)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+	templateID := gofakeit.Int64()
+
+	ctrl := gomock.NewController(t)
+	constantRepo := NewMockconstantRepository(ctrl)
+	constantRepo.EXPECT().ListByTemplateID(ctx, templateID).Return(nil, errors.New("test1"))
+
+	service := New(constantRepo)
+	_, err := service.Handle(ctx, templateID)
+	require.ErrorContains(t, err, "test1")
+}
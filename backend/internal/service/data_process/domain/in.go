@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"time"
+
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+)
+
+type DataProcessIn struct {
+	// VersionID identifies the version the template belongs to. Zero means the
+	// template is not saved, e.g. in a draft preview, and nothing is cached.
+	VersionID int64
+	Values    map[string]any
+	Data      []byte
+	// Kind is the kind of the template in Data. Empty means Markdown.
+	Kind template_domain.Kind
+	// Partials are the project partials the template may call. They are the
+	// same for a version ID.
+	Partials []partial_domain.Partial
+	// MaxOutputBytes limits the size of the rendered document. Zero means no
+	// limit.
+	MaxOutputBytes int
+	// Budget limits the number of items in sequences built by the template.
+	// Zero means no limit.
+	Budget uint
+	// Now is the render clock returned by now. Zero means the current time.
+	Now time.Time
+	// MarkValues marks the values a Markdown template prints, so that an XLSX
+	// workbook types its cells by them.
+	MarkValues bool
+}
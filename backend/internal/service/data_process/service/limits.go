@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/templatefunc"
+)
+
+var (
+	errOutputLimit = errors.New("output limit exceeded")
+	errBudget      = errors.New("budget exceeded")
+)
+
+// limitedWriter fails once more than limit bytes are written or the context
+// is done. Execution stops at the first failed write, so an oversized document
+// is never fully built and a timed out one is abandoned.
+type limitedWriter struct {
+	ctx     context.Context
+	w       io.Writer
+	limit   int
+	written int
+}
+
+func (lw *limitedWriter) Write(p []byte) (int, error) {
+	if err := lw.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if lw.limit > 0 && lw.written+len(p) > lw.limit {
+		return 0, errOutputLimit
+	}
+
+	n, err := lw.w.Write(p)
+	lw.written += n
+	return n, err
+}
+
+// meter charges the work of a render against its budget and stops it once the
+// context is done. Zero budget means no limit.
+type meter struct {
+	ctx    context.Context
+	budget uint
+	used   uint
+}
+
+func (m *meter) charge(cost uint) error {
+	if err := m.ctx.Err(); err != nil {
+		return err
+	}
+
+	m.used += cost
+	if m.budget > 0 && m.used > m.budget {
+		return errBudget
+	}
+
+	return nil
+}
+
+// estimate returns the cost of a call from its arguments before it is made.
+type estimate func(args []reflect.Value) uint
+
+// limitedFuncs replace the template helpers with ones charged against the
+// budget of a single render, none of which runs once the context is done.
+// Every template call and range iteration costs one. The helpers that allocate
+// in proportion to their arguments are charged the size of their result before
+// they run, the others the size their result grows over the largest argument.
+// A repeated string is also limited to the output limit. Zero limits mean no
+// limit.
+func limitedFuncs(ctx context.Context, budget uint, maxOutputBytes int) template.FuncMap {
+	m := &meter{ctx: ctx, budget: budget}
+
+	estimates := map[string]estimate{
+		"until": func(args []reflect.Value) uint {
+			count := intArg(args, 0)
+			return sequenceLength(0, count, 1)
+		},
+		"untilStep": func(args []reflect.Value) uint {
+			return sequenceLength(intArg(args, 0), intArg(args, 1), intArg(args, 2))
+		},
+		"seq":          seqLength,
+		"repeat":       func(args []reflect.Value) uint { return uint(max(intArg(args, 0), 0)) * uint(len(stringArg(args, 1))) },
+		"randAlphaNum": countArg,
+		"randAlpha":    countArg,
+		"randAscii":    countArg,
+		"randNumeric":  countArg,
+		"randBytes":    countArg,
+		"indent":       indentLength,
+		"nindent":      func(args []reflect.Value) uint { return indentLength(args) + 1 },
+		"wrap": func(args []reflect.Value) uint {
+			return wrapLength(intArg(args, 0), stringArg(args, 1), "\n")
+		},
+		"wrapWith": func(args []reflect.Value) uint {
+			return wrapLength(intArg(args, 0), stringArg(args, 2), stringArg(args, 1))
+		},
+		"replace": func(args []reflect.Value) uint {
+			old, replacement, src := stringArg(args, 0), stringArg(args, 1), stringArg(args, 2)
+			return uint(len(src) + strings.Count(src, old)*len(replacement))
+		},
+		"regexReplaceAll":            regexReplaceLength(true),
+		"mustRegexReplaceAll":        regexReplaceLength(true),
+		"regexReplaceAllLiteral":     regexReplaceLength(false),
+		"mustRegexReplaceAllLiteral": regexReplaceLength(false),
+	}
+
+	funcs := make(template.FuncMap, len(templatefunc.Funcs)+len(builtinFuncs))
+	for name, fn := range templatefunc.Funcs {
+		funcs[name] = m.wrap(fn, estimates[name])
+	}
+	for name, fn := range builtinFuncs {
+		funcs[name] = m.wrap(fn, nil)
+	}
+
+	funcs[templatefunc.IterationFunc] = func() (string, error) {
+		return "", m.charge(1)
+	}
+
+	repeat := funcs["repeat"].(func(int, string) string)
+	funcs["repeat"] = func(count int, str string) (string, error) {
+		if maxOutputBytes > 0 && count > 0 && len(str) > maxOutputBytes/count {
+			return "", errOutputLimit
+		}
+
+		return repeat(count, str), nil
+	}
+
+	return funcs
+}
+
+// builtinFuncs are the text/template builtins that allocate, shadowed so that
+// they are charged as well.
+var builtinFuncs = template.FuncMap{
+	"print":    fmt.Sprint,
+	"printf":   fmt.Sprintf,
+	"println":  fmt.Sprintln,
+	"html":     template.HTMLEscaper,
+	"js":       template.JSEscaper,
+	"urlquery": template.URLQueryEscaper,
+}
+
+// wrap returns fn charged by the meter, by the estimate if any or else by the
+// growth of its result. A function without an error result fails by panicking,
+// which the template turns into an execution error.
+func (m *meter) wrap(fn any, cost estimate) any {
+	value := reflect.ValueOf(fn)
+	typ := value.Type()
+
+	fail := func(err error) []reflect.Value {
+		if typ.NumOut() < 2 {
+			panic(err)
+		}
+		return []reflect.Value{reflect.Zero(typ.Out(0)), reflect.ValueOf(&err).Elem()}
+	}
+
+	return reflect.MakeFunc(typ, func(args []reflect.Value) []reflect.Value {
+		var before uint
+		if cost != nil {
+			before = cost(args)
+		}
+		if err := m.charge(before); err != nil {
+			return fail(err)
+		}
+
+		var out []reflect.Value
+		if typ.IsVariadic() {
+			out = value.CallSlice(args)
+		} else {
+			out = value.Call(args)
+		}
+
+		if cost == nil {
+			if err := m.charge(growth(out[0], args, typ.IsVariadic())); err != nil {
+				return fail(err)
+			}
+		}
+
+		return out
+	}).Interface()
+}
+
+// growth is the size of the result over the size of the largest argument.
+func growth(result reflect.Value, args []reflect.Value, variadic bool) uint {
+	var largest uint
+	for i, arg := range args {
+		largest = max(largest, size(arg))
+		if variadic && i == len(args)-1 {
+			for j := range arg.Len() {
+				largest = max(largest, size(arg.Index(j)))
+			}
+		}
+	}
+
+	return size(result) - min(size(result), largest)
+}
+
+// size is the length of a string, slice or map value, zero for other values.
+func size(value reflect.Value) uint {
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return 0
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return uint(value.Len())
+	}
+
+	return 0
+}
+
+func intArg(args []reflect.Value, i int) int {
+	return int(args[i].Int())
+}
+
+func stringArg(args []reflect.Value, i int) string {
+	return args[i].String()
+}
+
+func countArg(args []reflect.Value) uint {
+	return uint(max(intArg(args, 0), 0))
+}
+
+func seqLength(args []reflect.Value) uint {
+	params := args[0]
+	start, stop, step := 1, 0, 1
+	switch params.Len() {
+	case 1:
+		stop = int(params.Index(0).Int())
+	case 2:
+		start, stop = int(params.Index(0).Int()), int(params.Index(1).Int())
+	case 3:
+		start, step, stop = int(params.Index(0).Int()), int(params.Index(1).Int()), int(params.Index(2).Int())
+	}
+
+	// seq includes stop
+	if stop >= start {
+		stop++
+	} else {
+		stop--
+	}
+
+	return sequenceLength(start, stop, step)
+}
+
+func indentLength(args []reflect.Value) uint {
+	spaces, text := max(intArg(args, 0), 0), stringArg(args, 1)
+	return uint(len(text) + spaces*(strings.Count(text, "\n")+1))
+}
+
+// wrapLength is an upper bound of the length of text wrapped at width with sep
+// between the lines.
+func wrapLength(width int, text, sep string) uint {
+	return uint(len(text) + len(sep)*(len(text)/max(width, 1)+1))
+}
+
+// regexReplaceLength bounds the length of a replaced string. An expanded
+// replacement may repeat its match once per variable in it.
+func regexReplaceLength(expand bool) estimate {
+	return func(args []reflect.Value) uint {
+		re, err := regexp.Compile(stringArg(args, 0))
+		if err != nil {
+			return 0
+		}
+
+		src, replacement := stringArg(args, 1), stringArg(args, 2)
+		variables := 0
+		if expand {
+			variables = strings.Count(replacement, "$")
+		}
+
+		length := len(src)
+		for _, match := range re.FindAllStringIndex(src, -1) {
+			length += len(replacement) + variables*(match[1]-match[0])
+		}
+
+		return uint(length)
+	}
+}
+
+// sequenceLength is an upper bound of the number of items from start up to
+// but not including stop with the given step.
+func sequenceLength(start, stop, step int) uint {
+	if step == 0 {
+		return 0
+	}
+
+	if step < 0 {
+		step = -step
+	}
+
+	span := uint(max(start, stop)) - uint(min(start, stop))
+	return (span + uint(step) - 1) / uint(step)
+}
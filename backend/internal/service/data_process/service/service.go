@@ -0,0 +1,253 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/samber/lo"
+
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/docxtemplate"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/expression"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/lru"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/partial"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/templatefunc"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/xlsx"
+	"github.com/qsoulior/tech-generator/backend/internal/service/data_process/domain"
+)
+
+// Cache keeps parsed templates by version ID. Versions are immutable once
+// created, and a parsed template may be executed concurrently.
+type Cache = lru.Cache[cacheKey, *parsedTemplate]
+
+func NewCache(size int) *Cache {
+	return lru.New[cacheKey, *parsedTemplate](size)
+}
+
+// cacheKey tells apart the templates of a version parsed to mark their values.
+type cacheKey struct {
+	versionID  int64
+	markValues bool
+}
+
+type parsedTemplate struct {
+	tmpl *template.Template
+	docx *docxtemplate.Template
+	err  error
+}
+
+type Service struct {
+	cache *Cache
+}
+
+func New() *Service {
+	return &Service{}
+}
+
+// NewCached returns a service that reuses parsed templates from cache for
+// inputs with a version ID.
+func NewCached(cache *Cache) *Service {
+	return &Service{cache: cache}
+}
+
+func (s *Service) Handle(ctx context.Context, in domain.DataProcessIn) ([]byte, error) {
+	parsed := s.parse(in)
+	if parsed.err != nil {
+		return nil, &task_domain.ProcessError{
+			Message:  task_domain.MessageTemplateParse,
+			Template: buildTemplateError(in.Data, in.Partials, parsed.err),
+		}
+	}
+
+	// parsed templates are shared, so the limited helpers and the render clock
+	// are bound to a copy
+	funcs := limitedFuncs(ctx, in.Budget, in.MaxOutputBytes)
+	funcs["now"] = expression.Clock(in.Now)
+	if in.MarkValues {
+		funcs[templatefunc.ValueFunc] = xlsx.Mark
+	}
+	output := &limitedWriter{ctx: ctx, limit: in.MaxOutputBytes}
+
+	var (
+		result []byte
+		err    error
+	)
+	if parsed.docx != nil {
+		// the parts of the document share the output limit
+		result, err = parsed.docx.Execute(in.Values, funcs, func(w io.Writer) io.Writer {
+			output.w = w
+			return output
+		})
+	} else {
+		result, err = execute(parsed.tmpl, funcs, output, in.Values)
+	}
+
+	switch {
+	case errors.Is(err, errOutputLimit):
+		return nil, &task_domain.ProcessError{Message: task_domain.MessageOutputLimit}
+	case errors.Is(err, errBudget):
+		return nil, &task_domain.ProcessError{Message: task_domain.MessageBudget}
+	case errors.Is(err, context.DeadlineExceeded):
+		return nil, &task_domain.ProcessError{Message: task_domain.MessageTimeout}
+	case errors.Is(err, context.Canceled):
+		return nil, err
+	}
+
+	if err != nil {
+		return nil, &task_domain.ProcessError{
+			Message:  task_domain.MessageTemplateExec,
+			Template: buildTemplateError(in.Data, in.Partials, err),
+		}
+	}
+
+	return result, nil
+}
+
+func execute(tmpl *template.Template, funcs template.FuncMap, output *limitedWriter, values map[string]any) ([]byte, error) {
+	tmpl, err := tmpl.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("clone template: %w", err)
+	}
+	tmpl.Funcs(funcs)
+
+	var buf bytes.Buffer
+	output.w = &buf
+	if err := tmpl.Execute(output, values); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// parse parses the template of the input. Parse errors are cached as well,
+// since the same template fails the same way every time.
+func (s *Service) parse(in domain.DataProcessIn) *parsedTemplate {
+	if s.cache == nil || in.VersionID == 0 {
+		return parseTemplate(in)
+	}
+
+	key := cacheKey{versionID: in.VersionID, markValues: in.MarkValues}
+	parsed, ok := s.cache.Get(key)
+	if !ok {
+		parsed = parseTemplate(in)
+		s.cache.Add(key, parsed)
+	}
+
+	return parsed
+}
+
+// parseTemplate parses the partials before the template, so that the template
+// may redefine their blocks. The parsed templates are instrumented to meter
+// their calls and range iterations, and to mark the values they print if the
+// input asks to.
+func parseTemplate(in domain.DataProcessIn) *parsedTemplate {
+	parsed := &parsedTemplate{}
+	if in.Kind == template_domain.KindDOCX {
+		parsed.docx, parsed.err = docxtemplate.Parse(in.Data, templatefunc.Funcs, in.Partials)
+		if parsed.err == nil {
+			templatefunc.Instrument(parsed.docx.Templates())
+		}
+		return parsed
+	}
+
+	tmpl := template.New("").Funcs(templatefunc.Funcs)
+	if parsed.err = partial.Add(tmpl, in.Partials); parsed.err == nil {
+		parsed.tmpl, parsed.err = tmpl.Parse(string(in.Data))
+	}
+	if parsed.err == nil {
+		if in.MarkValues {
+			templatefunc.MarkValues(parsed.tmpl.Templates())
+		}
+		templatefunc.Instrument(parsed.tmpl.Templates())
+	}
+
+	return parsed
+}
+
+// templateErrRe matches the canonical Go text/template diagnostic prefix:
+// "template: <name>:<line>[:<col>]: <message>". The name segment is optional
+// content up to the first colon, line/col are decimal digits.
+var templateErrRe = regexp.MustCompile(`^template:\s*([^:]*):(\d+)(?::(\d+))?:\s*(.+)$`)
+
+// buildTemplateError extracts line/column/snippet from a Go template parse or
+// execution error. An error in a partial, named after it, is located in its
+// text. When the error message does not match the expected format, returns nil
+// so the caller falls back to the high-level message only.
+func buildTemplateError(data []byte, partials []partial_domain.Partial, err error) *task_domain.TemplateError {
+	var docxErr *docxtemplate.Error
+	if errors.As(err, &docxErr) {
+		return buildDOCXError(docxErr)
+	}
+
+	msg := err.Error()
+	m := templateErrRe.FindStringSubmatch(msg)
+	if m == nil {
+		return nil
+	}
+
+	line, convErr := strconv.Atoi(m[2])
+	if convErr != nil || line < 1 {
+		return nil
+	}
+
+	col := 0
+	if m[3] != "" {
+		if c, e := strconv.Atoi(m[3]); e == nil && c > 0 {
+			col = c
+		}
+	}
+
+	templateErr := &task_domain.TemplateError{
+		Line:   line,
+		Column: col,
+		Detail: strings.TrimSpace(m[4]),
+	}
+
+	if p, found := lo.Find(partials, func(p partial_domain.Partial) bool { return p.Name == m[1] }); found {
+		templateErr.Partial = p.Name
+		data = []byte(p.Data)
+	}
+	templateErr.Snippet = extractLine(data, line)
+
+	return templateErr
+}
+
+// buildDOCXError locates an error of a Word template by the paragraph, which
+// stands for the line, with its text for the snippet. The parts other than the
+// main document are named in the detail.
+func buildDOCXError(err *docxtemplate.Error) *task_domain.TemplateError {
+	if err.Paragraph == 0 {
+		return nil
+	}
+
+	detail := err.Detail
+	if err.Part != docxtemplate.DocumentPart {
+		detail = fmt.Sprintf("%s: %s", err.Part, detail)
+	}
+
+	return &task_domain.TemplateError{
+		Line:    err.Paragraph,
+		Snippet: err.Text,
+		Detail:  detail,
+	}
+}
+
+func extractLine(data []byte, line int) string {
+	if line < 1 {
+		return ""
+	}
+	lines := strings.SplitN(string(data), "\n", line+1)
+	if len(lines) < line {
+		return ""
+	}
+	return strings.TrimRight(lines[line-1], "\r")
+}
@@ -0,0 +1,553 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/xlsx"
+	"github.com/qsoulior/tech-generator/backend/internal/service/data_process/domain"
+)
+
+func TestService_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.DataProcessIn{
+		Values: map[string]any{
+			"test1": 123,
+			"test2": "some text",
+			"test3": 456.789,
+			"test4": "foo bar",
+		},
+		Data: []byte("Lorem {{.test1}} ipsum {{ .test3 }}, {{.test4}}"),
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+
+	want := "Lorem 123 ipsum 456.789, foo bar"
+	require.Equal(t, want, string(got))
+}
+
+func TestService_Handle_Sprig(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	tests := []struct {
+		name string
+		in   domain.DataProcessIn
+		want string
+	}{
+		{
+			name: "printf_decimals",
+			in: domain.DataProcessIn{
+				Values: map[string]any{"price": 1234.5678},
+				Data:   []byte(`{{ printf "%.2f" .price }}`),
+			},
+			want: "1234.57",
+		},
+		{
+			name: "range_table",
+			in: domain.DataProcessIn{
+				Values: map[string]any{
+					"cases": []map[string]any{
+						{"name": "login", "cost": 1.5},
+						{"name": "logout", "cost": 2.0},
+					},
+				},
+				Data: []byte(`{{ range $i, $c := .cases }}{{ add1 $i }}. {{ $c.name }} — {{ $c.cost }}
+{{ end }}`),
+			},
+			want: "1. login — 1.5\n2. logout — 2\n",
+		},
+		{
+			name: "default_fallback",
+			in: domain.DataProcessIn{
+				Values: map[string]any{},
+				Data:   []byte(`{{ default "n/a" .missing }}`),
+			},
+			want: "n/a",
+		},
+		{
+			name: "upper_replace",
+			in: domain.DataProcessIn{
+				Values: map[string]any{"name": "foo bar"},
+				Data:   []byte(`{{ .name | replace " " "_" | upper }}`),
+			},
+			want: "FOO_BAR",
+		},
+		{
+			name: "range_list",
+			in: domain.DataProcessIn{
+				Values: map[string]any{"items": []string{"a", "b", "c"}},
+				Data:   []byte(`{{- range .items }}{{ . }}{{ end -}}`),
+			},
+			want: "abc",
+		},
+		{
+			name: "decimal",
+			in: domain.DataProcessIn{
+				Values: map[string]any{"price": decimal.RequireFromString("1234.505")},
+				Data:   []byte(`{{ .price }} {{ .price.StringFixed 2 }} {{ formatNumber .price 2 "." "," }}`),
+			},
+			want: "1234.505 1234.51 1,234.51",
+		},
+		{
+			name: "render_clock",
+			in: domain.DataProcessIn{
+				Values: map[string]any{"signed": time.Date(2026, time.May, 4, 0, 0, 0, 0, time.UTC)},
+				Data:   []byte(`{{ now | formatDate }}, {{ formatDate now "02.01.2006" }}, {{ addMonths .signed 1 | formatDate }}`),
+				Now:    time.Date(2026, time.October, 18, 9, 30, 0, 0, time.UTC),
+			},
+			want: "18 октября 2026 г., 18.10.2026, 4 июня 2026 г.",
+		},
+		{
+			name: "russian_numerals",
+			in: domain.DataProcessIn{
+				Values: map[string]any{"pages": int64(22), "price": decimal.RequireFromString("1500.5"), "stage": 3},
+				Data:   []byte(`{{ .pages }} {{ pluralForm .pages "лист" "листа" "листов" }}, {{ amountToWords .price }}, {{ ordinal .stage "m" }} этап, {{ numberToWords .pages "f" }}`),
+			},
+			want: "22 листа, одна тысяча пятьсот рублей 50\u00a0копеек, третий этап, двадцать две",
+		},
+		{
+			name: "units",
+			in: domain.DataProcessIn{
+				Values: map[string]any{"length_mm": int64(2500), "load": decimal.RequireFromString("12.5")},
+				Data:   []byte(`{{ formatUnit (convert .length_mm "mm" "m") "m" 2 }}, {{ formatUnit .load "kN" }}, {{ formatUnit 9.81 "m/s^2" }}`),
+			},
+			want: "2,50\u00a0м, 12,5\u00a0кН, 9,81\u00a0м/с²",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := service.Handle(ctx, tt.in)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestService_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	tests := []struct {
+		name        string
+		in          domain.DataProcessIn
+		wantMessage string
+		wantLine    int
+		wantSnippet string
+		wantPartial string
+	}{
+		{
+			name: "TemplateParse",
+			in: domain.DataProcessIn{
+				Values: map[string]any{},
+				Data:   []byte("abc {{abc}}"),
+			},
+			wantMessage: task_domain.MessageTemplateParse,
+			wantLine:    1,
+			wantSnippet: "abc {{abc}}",
+		},
+		{
+			name: "EnvBlocked",
+			in: domain.DataProcessIn{
+				Values: map[string]any{},
+				Data:   []byte(`{{ env "PATH" }}`),
+			},
+			wantMessage: task_domain.MessageTemplateParse,
+			wantLine:    1,
+			wantSnippet: `{{ env "PATH" }}`,
+		},
+		{
+			name: "ExpandEnvBlocked",
+			in: domain.DataProcessIn{
+				Values: map[string]any{},
+				Data:   []byte(`{{ expandenv "$PATH" }}`),
+			},
+			wantMessage: task_domain.MessageTemplateParse,
+			wantLine:    1,
+			wantSnippet: `{{ expandenv "$PATH" }}`,
+		},
+		{
+			name: "TemplateParseOnSecondLine",
+			in: domain.DataProcessIn{
+				Values: map[string]any{},
+				Data:   []byte("first line\nbroken {{abc}}\nthird line"),
+			},
+			wantMessage: task_domain.MessageTemplateParse,
+			wantLine:    2,
+			wantSnippet: "broken {{abc}}",
+		},
+		{
+			name: "PartialExec",
+			in: domain.DataProcessIn{
+				Values:   map[string]any{"items": []int{}},
+				Data:     []byte(`{{ template "title_page" . }}`),
+				Partials: []partial_domain.Partial{{Name: "title_page", Data: "Титульный лист\n{{ index .items 3 }}"}},
+			},
+			wantMessage: task_domain.MessageTemplateExec,
+			wantLine:    2,
+			wantSnippet: "{{ index .items 3 }}",
+			wantPartial: "title_page",
+		},
+		{
+			name: "PartialMissing",
+			in: domain.DataProcessIn{
+				Values: map[string]any{},
+				Data:   []byte(`{{ template "title_page" . }}`),
+			},
+			wantMessage: task_domain.MessageTemplateExec,
+			wantLine:    1,
+			wantSnippet: `{{ template "title_page" . }}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Handle(ctx, tt.in)
+
+			var got *task_domain.ProcessError
+			require.ErrorAs(t, err, &got)
+			require.Equal(t, tt.wantMessage, got.Message)
+			require.NotNil(t, got.Template, "expected template error to be populated")
+			require.Equal(t, tt.wantLine, got.Template.Line)
+			require.Equal(t, tt.wantSnippet, got.Template.Snippet)
+			require.Equal(t, tt.wantPartial, got.Template.Partial)
+			require.NotEmpty(t, got.Template.Detail)
+		})
+	}
+}
+
+func TestService_Handle_Partials(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.DataProcessIn{
+		Values: map[string]any{"title": "Техническое задание", "approver": "Иванов И. И."},
+		Data:   []byte(`{{ template "layout" . }}{{ define "body" }}## 1. Общие сведения{{ end }}`),
+		Partials: []partial_domain.Partial{
+			{Name: "title_page", Data: `# {{ .title }}`},
+			{Name: "layout", Data: "{{ template \"title_page\" . }}\n{{ block \"approval\" . }}УТВЕРЖДАЮ {{ .approver }}{{ end }}\n{{ block \"body\" . }}{{ end }}"},
+		},
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, "# Техническое задание\nУТВЕРЖДАЮ Иванов И. И.\n## 1. Общие сведения", string(got))
+}
+
+func TestService_Handle_OutputLimit(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.DataProcessIn{
+		Values:         map[string]any{"text": "0123456789"},
+		Data:           []byte(`{{ range until 10 }}{{ $.text }}{{ end }}`),
+		MaxOutputBytes: 95,
+	}
+
+	_, err := service.Handle(ctx, in)
+
+	var got *task_domain.ProcessError
+	require.ErrorAs(t, err, &got)
+	require.Equal(t, task_domain.ProcessError{Message: task_domain.MessageOutputLimit}, *got)
+
+	in.MaxOutputBytes = 100
+	result, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Len(t, result, 100)
+}
+
+func TestService_Handle_Cached(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(1)
+	service := NewCached(cache)
+
+	in := domain.DataProcessIn{
+		VersionID: 1,
+		Values:    map[string]any{"name": "first"},
+		Data:      []byte("Hello, {{ .name }}"),
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, "Hello, first", string(got))
+
+	in.Values = map[string]any{"name": "second"}
+	got, err = service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, "Hello, second", string(got))
+
+	broken := domain.DataProcessIn{VersionID: 2, Values: map[string]any{}, Data: []byte("{{ .name ")}
+	for range 2 {
+		_, err = service.Handle(ctx, broken)
+
+		var processErr *task_domain.ProcessError
+		require.ErrorAs(t, err, &processErr)
+		require.Equal(t, task_domain.MessageTemplateParse, processErr.Message)
+	}
+
+	stats := cache.Stats()
+	require.Equal(t, uint64(2), stats.Hits)
+	require.Equal(t, uint64(2), stats.Misses)
+	require.Equal(t, uint64(1), stats.Evictions)
+}
+
+func TestService_Handle_MarkValues(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(2)
+	service := NewCached(cache)
+
+	in := domain.DataProcessIn{
+		VersionID:  1,
+		Values:     map[string]any{"n": int64(5), "items": []any{"a", decimal.RequireFromString("1.5")}},
+		Data:       []byte(`{{ define "x" }}[{{ . }}]{{ end }}{{ $m := .n }}{{ .n }} шт.{{ range .items }} {{ template "x" . }}{{ end }}`),
+		MarkValues: true,
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, xlsx.Mark(int64(5))+" шт. ["+xlsx.Mark("a")+"] ["+xlsx.Mark(decimal.RequireFromString("1.5"))+"]", string(got))
+
+	// the same version is parsed apart without the marks
+	in.MarkValues = false
+	got, err = service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, "5 шт. [a] [1.5]", string(got))
+}
+
+func TestService_Handle_Limits(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          domain.DataProcessIn
+		wantMessage string
+	}{
+		{
+			name:        "Until",
+			in:          domain.DataProcessIn{Data: []byte(`{{ range until 100000000 }}{{ end }}`), Budget: 1000},
+			wantMessage: task_domain.MessageBudget,
+		},
+		{
+			name:        "UntilStep",
+			in:          domain.DataProcessIn{Data: []byte(`{{ range untilStep 0 -100000000 -1 }}{{ end }}`), Budget: 1000},
+			wantMessage: task_domain.MessageBudget,
+		},
+		{
+			name:        "Seq",
+			in:          domain.DataProcessIn{Data: []byte(`{{ seq 1 2 100000000 | len }}`), Budget: 1000},
+			wantMessage: task_domain.MessageBudget,
+		},
+		{
+			name:        "Repeat",
+			in:          domain.DataProcessIn{Data: []byte(`{{ repeat 100000000 "ab" | len }}`), MaxOutputBytes: 1000},
+			wantMessage: task_domain.MessageOutputLimit,
+		},
+		{
+			name:        "NestedRange",
+			in:          domain.DataProcessIn{Data: []byte(`{{ $l := until 1000 }}{{ range $l }}{{ range $l }}{{ end }}{{ end }}`), Budget: 10000},
+			wantMessage: task_domain.MessageBudget,
+		},
+		{
+			name:        "RangeInt",
+			in:          domain.DataProcessIn{Data: []byte(`{{ range 100000000 }}{{ end }}`), Budget: 1000},
+			wantMessage: task_domain.MessageBudget,
+		},
+		{
+			name:        "Recursion",
+			in:          domain.DataProcessIn{Data: []byte(`{{ define "r" }}{{ template "r" }}{{ end }}{{ template "r" }}`), Budget: 1000},
+			wantMessage: task_domain.MessageBudget,
+		},
+		{
+			name:        "Growth",
+			in:          domain.DataProcessIn{Data: []byte(`{{ $s := "ab" }}{{ range until 40 }}{{ $s = printf "%s%s" $s $s }}{{ end }}{{ len $s }}`), Budget: 10000},
+			wantMessage: task_domain.MessageBudget,
+		},
+		{
+			name:        "RandAlpha",
+			in:          domain.DataProcessIn{Data: []byte(`{{ randAlpha 100000000 | len }}`), Budget: 1000},
+			wantMessage: task_domain.MessageBudget,
+		},
+		{
+			name:        "Indent",
+			in:          domain.DataProcessIn{Data: []byte(`{{ indent 100000000 "a" | len }}`), Budget: 1000},
+			wantMessage: task_domain.MessageBudget,
+		},
+		{
+			name:        "Replace",
+			in:          domain.DataProcessIn{Data: []byte(`{{ replace "" (repeat 100 "a") (repeat 100 "a") | len }}`), Budget: 1000},
+			wantMessage: task_domain.MessageBudget,
+		},
+		{
+			name: "DOCX",
+			in: domain.DataProcessIn{
+				Data:   document(t, `<w:p><w:r><w:t>{{ range 100000000 }}{{ end }}</w:t></w:r></w:p>`),
+				Kind:   template_domain.KindDOCX,
+				Budget: 1000,
+			},
+			wantMessage: task_domain.MessageBudget,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New().Handle(context.Background(), tt.in)
+
+			var got *task_domain.ProcessError
+			require.ErrorAs(t, err, &got)
+			require.Equal(t, task_domain.ProcessError{Message: tt.wantMessage}, *got)
+		})
+	}
+
+	t.Run("WithinLimits", func(t *testing.T) {
+		in := domain.DataProcessIn{
+			Data:           []byte(`{{ range until 3 }}{{ . }}{{ end }} {{ seq 3 }} {{ repeat 2 "ab" }}`),
+			Budget:         14,
+			MaxOutputBytes: 100,
+		}
+
+		got, err := New().Handle(context.Background(), in)
+		require.NoError(t, err)
+		require.Equal(t, "012 1 2 3 abab", string(got))
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+
+		in := domain.DataProcessIn{Data: []byte(`{{ range until 10 }}text{{ end }}`)}
+
+		_, err := New().Handle(ctx, in)
+
+		var got *task_domain.ProcessError
+		require.ErrorAs(t, err, &got)
+		require.Equal(t, task_domain.ProcessError{Message: task_domain.MessageTimeout}, *got)
+	})
+
+	t.Run("TimeoutWithoutOutput", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		in := domain.DataProcessIn{Data: []byte(`{{ range 1000000000000 }}{{ $s := printf "%d" 1 }}{{ end }}`)}
+
+		_, err := New().Handle(ctx, in)
+
+		var got *task_domain.ProcessError
+		require.ErrorAs(t, err, &got)
+		require.Equal(t, task_domain.ProcessError{Message: task_domain.MessageTimeout}, *got)
+	})
+}
+
+func document(t *testing.T, body string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	f, err := w.Create("word/document.xml")
+	require.NoError(t, err)
+	_, err = f.Write([]byte(`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` + body + `</w:body></w:document>`))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func documentPart(t *testing.T, data []byte) string {
+	t.Helper()
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	f, err := reader.Open("word/document.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	part, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	return string(part)
+}
+
+func TestService_Handle_DOCX(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.DataProcessIn{
+		Values: map[string]any{
+			"customer": "ООО «Ромашка» & Ко",
+			"items":    []any{"болт", "гайка"},
+			"project":  map[string]any{"org_name": "АО «Завод»"},
+		},
+		Data: document(t, `<w:p><w:r><w:t>{{ .custo</w:t></w:r><w:r><w:t>mer | upper }}, {{ .project.org_name }}, {{ now.Year }}</w:t></w:r></w:p>`+
+			`<w:p><w:r><w:t>{{ range .items }}</w:t></w:r></w:p><w:p><w:r><w:t>{{ . }}</w:t></w:r></w:p><w:p><w:r><w:t>{{ end }}</w:t></w:r></w:p>`),
+		Kind: template_domain.KindDOCX,
+		Now:  time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+
+	part := documentPart(t, got)
+	require.Contains(t, part, `<w:t xml:space="preserve">ООО «РОМАШКА» &amp; КО</w:t></w:r><w:r><w:t xml:space="preserve">, АО «Завод», 2025</w:t>`)
+	require.Contains(t, part, `<w:p><w:r><w:t xml:space="preserve">болт</w:t></w:r></w:p>`)
+	require.Contains(t, part, `<w:p><w:r><w:t xml:space="preserve">гайка</w:t></w:r></w:p>`)
+
+	t.Run("ParseError", func(t *testing.T) {
+		in := domain.DataProcessIn{
+			Data: document(t, `<w:p><w:r><w:t>Текст</w:t></w:r></w:p><w:p><w:r><w:t>{{ .a }</w:t></w:r></w:p>`),
+			Kind: template_domain.KindDOCX,
+		}
+
+		_, err := service.Handle(ctx, in)
+
+		var got *task_domain.ProcessError
+		require.ErrorAs(t, err, &got)
+		require.Equal(t, task_domain.MessageTemplateParse, got.Message)
+		require.NotNil(t, got.Template)
+		require.Equal(t, 2, got.Template.Line)
+		require.Equal(t, "{{ .a }", got.Template.Snippet)
+	})
+
+	t.Run("ExecError", func(t *testing.T) {
+		in := domain.DataProcessIn{
+			Values: map[string]any{"items": []int{}},
+			Data:   document(t, `<w:p><w:r><w:t>{{ index .items 1 }}</w:t></w:r></w:p>`),
+			Kind:   template_domain.KindDOCX,
+		}
+
+		_, err := service.Handle(ctx, in)
+
+		var got *task_domain.ProcessError
+		require.ErrorAs(t, err, &got)
+		require.Equal(t, task_domain.MessageTemplateExec, got.Message)
+		require.NotNil(t, got.Template)
+		require.Equal(t, 1, got.Template.Line)
+		require.Contains(t, got.Template.Detail, "index out of range")
+	})
+
+	t.Run("OutputLimit", func(t *testing.T) {
+		in := domain.DataProcessIn{
+			Values:         map[string]any{"text": "0123456789"},
+			Data:           document(t, `<w:p><w:r><w:t>{{ range until 100 }}{{ $.text }}{{ end }}</w:t></w:r></w:p>`),
+			Kind:           template_domain.KindDOCX,
+			MaxOutputBytes: 500,
+		}
+
+		_, err := service.Handle(ctx, in)
+
+		var got *task_domain.ProcessError
+		require.ErrorAs(t, err, &got)
+		require.Equal(t, task_domain.ProcessError{Message: task_domain.MessageOutputLimit}, *got)
+	})
+}
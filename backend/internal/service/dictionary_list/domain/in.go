@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+type DictionaryListIn struct {
+	TemplateID int64
+	// At pins the dictionaries to the versions saved by then, so that a task
+	// renders the same way again. Zero means the latest versions.
+	At time.Time
+}
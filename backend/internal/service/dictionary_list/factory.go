@@ -0,0 +1,14 @@
+package dictionary_list_service
+
+import (
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	dictionary_repository "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list/repository/dictionary"
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list/service"
+)
+
+func New(db *sqlx.DB) *service.Service {
+	dictionaryRepo := dictionary_repository.New(db, trmsqlx.DefaultCtxGetter)
+	return service.New(dictionaryRepo)
+}
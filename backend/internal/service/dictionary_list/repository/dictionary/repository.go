@@ -0,0 +1,93 @@
+package dictionary_repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+)
+
+type Repository struct {
+	db       *sqlx.DB
+	trGetter *trmsqlx.CtxGetter
+}
+
+func New(db *sqlx.DB, trGetter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{
+		db:       db,
+		trGetter: trGetter,
+	}
+}
+
+// ListByTemplateID returns the latest versions of the dictionaries of the
+// template project.
+func (r *Repository) ListByTemplateID(ctx context.Context, templateID int64) ([]dictionary_domain.Dictionary, error) {
+	op := "dictionary - list by template id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"dv.id AS version_id",
+			"d.name",
+			"dv.columns",
+			"dv.rows",
+		).
+		From("project_dictionary d").
+		Join("project_dictionary_version dv ON d.last_version_id = dv.id").
+		Join("template t ON d.project_id = t.project_id").
+		Where(sq.Eq{"t.id": templateID, "d.deleted_at": nil}).
+		OrderBy("d.name ASC")
+
+	return r.list(ctx, op, builder)
+}
+
+// ListByTemplateIDAt returns the versions of the dictionaries of the template
+// project that were the latest at the time: dictionaries created later or
+// deleted by then are left out.
+func (r *Repository) ListByTemplateIDAt(ctx context.Context, templateID int64, at time.Time) ([]dictionary_domain.Dictionary, error) {
+	op := "dictionary - list by template id at"
+
+	versions := sq.
+		Select(
+			"DISTINCT ON (d.id) dv.id AS version_id",
+			"d.name",
+			"dv.columns",
+			"dv.rows",
+		).
+		From("project_dictionary d").
+		Join("project_dictionary_version dv ON d.id = dv.dictionary_id").
+		Join("template t ON d.project_id = t.project_id").
+		Where(sq.Eq{"t.id": templateID}).
+		Where(sq.LtOrEq{"dv.created_at": at}).
+		Where(sq.Or{sq.Eq{"d.deleted_at": nil}, sq.Gt{"d.deleted_at": at}}).
+		OrderBy("d.id", "dv.number DESC")
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("*").
+		FromSelect(versions, "d").
+		OrderBy("d.name ASC")
+
+	return r.list(ctx, op, builder)
+}
+
+func (r *Repository) list(ctx context.Context, op string, builder sq.SelectBuilder) ([]dictionary_domain.Dictionary, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dtos []dictionary
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &dtos, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return lo.Map(dtos, func(d dictionary, _ int) dictionary_domain.Dictionary { return d.toDomain() }), nil
+}
@@ -0,0 +1,111 @@
+package dictionary_repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_List() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	at := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// projects
+	projects := test_db.GenerateEntities(2, func(p *test_db.Project, _ int) { p.AuthorID = userID })
+	projectIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project", projects)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project", projectIDs)) }()
+
+	// template
+	template := test_db.GenerateEntity(func(t *test_db.Template) {
+		t.IsDefault = false
+		t.ProjectID = &projectIDs[0]
+		t.AuthorID = nil
+	})
+	templateID, err := test_db.InsertEntityWithID[int64](s.C(), "template", template)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "template", templateID)) }()
+
+	// dictionaries: a live one, one deleted after the time and one of another project
+	dictionaries := test_db.GenerateEntities(3, func(d *test_db.ProjectDictionary, i int) {
+		d.Name = []string{"materials", "codes", "other"}[i]
+		d.ProjectID = projectIDs[i/2]
+		d.AuthorID = nil
+		d.LastVersionID = nil
+		if i == 1 {
+			d.DeletedAt = lo.ToPtr(at.Add(time.Hour))
+		}
+	})
+	dictionaryIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_dictionary", dictionaries)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_dictionary", dictionaryIDs))
+	}()
+
+	// dictionary versions: the second version of materials is saved after the time
+	versions := test_db.GenerateEntities(4, func(v *test_db.ProjectDictionaryVersion, i int) {
+		v.Number = int64(i/3 + 1)
+		v.DictionaryID = dictionaryIDs[i%3]
+		v.AuthorID = nil
+		v.CreatedAt = at.Add(time.Duration(i/3*2-1) * time.Minute)
+		v.Columns = []byte(`[{"name": "density", "type": "float"}]`)
+		v.Rows = []byte(`[{"key": "steel", "values": {"density": "7850"}}]`)
+	})
+	versionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_dictionary_version", versions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_dictionary_version", versionIDs))
+	}()
+
+	lastVersionIDs := []int64{versionIDs[3], versionIDs[1], versionIDs[2]}
+	for i := range dictionaryIDs {
+		_, err = s.C().DB().Exec("UPDATE project_dictionary SET last_version_id = $1 WHERE id = $2", lastVersionIDs[i], dictionaryIDs[i])
+		require.NoError(s.T(), err)
+	}
+
+	newDictionary := func(versionID int64, name string) dictionary_domain.Dictionary {
+		return dictionary_domain.Dictionary{
+			VersionID: versionID,
+			Name:      name,
+			Columns:   []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}},
+			Rows:      []dictionary_domain.Row{{Key: "steel", Values: map[string]string{"density": "7850"}}},
+		}
+	}
+
+	got, err := repo.ListByTemplateID(ctx, templateID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []dictionary_domain.Dictionary{newDictionary(versionIDs[3], "materials")}, got)
+
+	got, err = repo.ListByTemplateIDAt(ctx, templateID, at)
+	require.NoError(s.T(), err)
+	want := []dictionary_domain.Dictionary{
+		newDictionary(versionIDs[1], "codes"),
+		newDictionary(versionIDs[0], "materials"),
+	}
+	require.Equal(s.T(), want, got)
+}
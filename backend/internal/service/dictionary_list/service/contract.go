@@ -0,0 +1,15 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package service
+
+import (
+	"context"
+	"time"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+)
+
+type dictionaryRepository interface {
+	ListByTemplateID(ctx context.Context, templateID int64) ([]dictionary_domain.Dictionary, error)
+	ListByTemplateIDAt(ctx context.Context, templateID int64, at time.Time) ([]dictionary_domain.Dictionary, error)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -package service -source contract.go -destination contract_mock.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockdictionaryRepository is a mock of dictionaryRepository interface.
+type MockdictionaryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockdictionaryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockdictionaryRepositoryMockRecorder is the mock recorder for MockdictionaryRepository.
+type MockdictionaryRepositoryMockRecorder struct {
+	mock *MockdictionaryRepository
+}
+
+// NewMockdictionaryRepository creates a new mock instance.
+func NewMockdictionaryRepository(ctrl *gomock.Controller) *MockdictionaryRepository {
+	mock := &MockdictionaryRepository{ctrl: ctrl}
+	mock.recorder = &MockdictionaryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdictionaryRepository) EXPECT() *MockdictionaryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByTemplateID mocks base method.
+func (m *MockdictionaryRepository) ListByTemplateID(ctx context.Context, templateID int64) ([]dictionary_domain.Dictionary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTemplateID", ctx, templateID)
+	ret0, _ := ret[0].([]dictionary_domain.Dictionary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTemplateID indicates an expected call of ListByTemplateID.
+func (mr *MockdictionaryRepositoryMockRecorder) ListByTemplateID(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTemplateID", reflect.TypeOf((*MockdictionaryRepository)(nil).ListByTemplateID), ctx, templateID)
+}
+
+// ListByTemplateIDAt mocks base method.
+func (m *MockdictionaryRepository) ListByTemplateIDAt(ctx context.Context, templateID int64, at time.Time) ([]dictionary_domain.Dictionary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTemplateIDAt", ctx, templateID, at)
+	ret0, _ := ret[0].([]dictionary_domain.Dictionary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTemplateIDAt indicates an expected call of ListByTemplateIDAt.
+func (mr *MockdictionaryRepositoryMockRecorder) ListByTemplateIDAt(ctx, templateID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTemplateIDAt", reflect.TypeOf((*MockdictionaryRepository)(nil).ListByTemplateIDAt), ctx, templateID, at)
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list/domain"
+)
+
+type Service struct {
+	dictionaryRepo dictionaryRepository
+}
+
+func New(dictionaryRepo dictionaryRepository) *Service {
+	return &Service{
+		dictionaryRepo: dictionaryRepo,
+	}
+}
+
+func (s *Service) Handle(ctx context.Context, in domain.DictionaryListIn) ([]dictionary_domain.Dictionary, error) {
+	if in.At.IsZero() {
+		dictionaries, err := s.dictionaryRepo.ListByTemplateID(ctx, in.TemplateID)
+		if err != nil {
+			return nil, fmt.Errorf("dictionary repo - list by template id: %w", err)
+		}
+		return dictionaries, nil
+	}
+
+	dictionaries, err := s.dictionaryRepo.ListByTemplateIDAt(ctx, in.TemplateID, in.At)
+	if err != nil {
+		return nil, fmt.Errorf("dictionary repo - list by template id at: %w", err)
+	}
+	return dictionaries, nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list/domain"
+)
+
+func TestService_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+	templateID := gofakeit.Int64()
+	at := gofakeit.Date()
+
+	var dictionaries []dictionary_domain.Dictionary
+	gofakeit.Slice(&dictionaries)
+
+	tests := []struct {
+		name  string
+		in    domain.DictionaryListIn
+		setup func(dictionaryRepo *MockdictionaryRepository)
+	}{
+		{
+			name: "Latest",
+			in:   domain.DictionaryListIn{TemplateID: templateID},
+			setup: func(dictionaryRepo *MockdictionaryRepository) {
+				dictionaryRepo.EXPECT().ListByTemplateID(ctx, templateID).Return(dictionaries, nil)
+			},
+		},
+		{
+			name: "At",
+			in:   domain.DictionaryListIn{TemplateID: templateID, At: at},
+			setup: func(dictionaryRepo *MockdictionaryRepository) {
+				dictionaryRepo.EXPECT().ListByTemplateIDAt(ctx, templateID, at).Return(dictionaries, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			dictionaryRepo := NewMockdictionaryRepository(ctrl)
+			tt.setup(dictionaryRepo)
+
+			service := New(dictionaryRepo)
+			got, err := service.Handle(ctx, tt.in)
+			require.NoError(t, err)
+			require.Equal(t, dictionaries, got)
+		})
+	}
+}
+
+func TestService_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+	templateID := gofakeit.Int64()
+	at := gofakeit.Date()
+
+	tests := []struct {
+		name  string
+		in    domain.DictionaryListIn
+		setup func(dictionaryRepo *MockdictionaryRepository)
+		want  string
+	}{
+		{
+			name: "dictionaryRepo_ListByTemplateID",
+			in:   domain.DictionaryListIn{TemplateID: templateID},
+			setup: func(dictionaryRepo *MockdictionaryRepository) {
+				dictionaryRepo.EXPECT().ListByTemplateID(ctx, templateID).Return(nil, errors.New("test1"))
+			},
+			want: "test1",
+		},
+		{
+			name: "dictionaryRepo_ListByTemplateIDAt",
+			in:   domain.DictionaryListIn{TemplateID: templateID, At: at},
+			setup: func(dictionaryRepo *MockdictionaryRepository) {
+				dictionaryRepo.EXPECT().ListByTemplateIDAt(ctx, templateID, at).Return(nil, errors.New("test2"))
+			},
+			want: "test2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			dictionaryRepo := NewMockdictionaryRepository(ctrl)
+			tt.setup(dictionaryRepo)
+
+			service := New(dictionaryRepo)
+			_, err := service.Handle(ctx, tt.in)
+			require.ErrorContains(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package domain
+
+import dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+
+type DictionaryToCreate struct {
+	ProjectID int64
+	AuthorID  int64
+	Name      string
+}
+
+type DictionaryToUpdate struct {
+	ID            int64
+	LastVersionID int64
+}
+
+type DictionaryVersion struct {
+	DictionaryID int64
+	AuthorID     int64
+	Columns      []dictionary_domain.Column
+	Rows         []dictionary_domain.Row
+}
+
+type DictionaryVersionOut struct {
+	ID     int64
+	Number int64
+}
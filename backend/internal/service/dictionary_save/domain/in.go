@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+)
+
+var (
+	ErrValueEmpty     = errors.New("value is empty")
+	ErrValueInvalid   = errors.New("value is invalid")
+	ErrValueDuplicate = errors.New("value is duplicate")
+	ErrValueReserved  = errors.New("value is reserved")
+)
+
+var slugRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+const (
+	slugMaxLen    = 100
+	columnsMaxLen = 50
+	rowsMaxLen    = 10000
+)
+
+type DictionarySaveIn struct {
+	ProjectID int64
+	AuthorID  int64
+	Name      string
+	Columns   []dictionary_domain.Column
+	Rows      []dictionary_domain.Row
+}
+
+func (in DictionarySaveIn) Validate() error {
+	if !validSlug(in.Name) {
+		return error_domain.NewValidationError("name", ErrValueInvalid)
+	}
+
+	if len(in.Columns) > columnsMaxLen {
+		return error_domain.NewValidationError("columns", ErrValueInvalid)
+	}
+
+	for i, c := range in.Columns {
+		if !validSlug(c.Name) {
+			return error_domain.NewValidationError(fmt.Sprintf("columns.%d.name", i), ErrValueInvalid)
+		}
+
+		if c.Name == dictionary_domain.KeyColumn {
+			return error_domain.NewValidationError(fmt.Sprintf("columns.%d.name", i), ErrValueReserved)
+		}
+
+		if slices.ContainsFunc(in.Columns[:i], func(prev dictionary_domain.Column) bool { return prev.Name == c.Name }) {
+			return error_domain.NewValidationError(fmt.Sprintf("columns.%d.name", i), ErrValueDuplicate)
+		}
+
+		if !slices.Contains(dictionary_domain.ColumnTypes, c.Type) {
+			return error_domain.NewValidationError(fmt.Sprintf("columns.%d.type", i), ErrValueInvalid)
+		}
+	}
+
+	if len(in.Rows) > rowsMaxLen {
+		return error_domain.NewValidationError("rows", ErrValueInvalid)
+	}
+
+	keys := make(map[string]struct{}, len(in.Rows))
+	for i, r := range in.Rows {
+		if r.Key == "" {
+			return error_domain.NewValidationError(fmt.Sprintf("rows.%d.key", i), ErrValueEmpty)
+		}
+
+		if _, ok := keys[r.Key]; ok {
+			return error_domain.NewValidationError(fmt.Sprintf("rows.%d.key", i), ErrValueDuplicate)
+		}
+		keys[r.Key] = struct{}{}
+
+		if err := validateValues(in.Columns, r.Values); err != nil {
+			return error_domain.NewValidationError(fmt.Sprintf("rows.%d.values.%s", i, err.field), err.err)
+		}
+	}
+
+	return nil
+}
+
+func validSlug(s string) bool {
+	return s != "" && len(s) <= slugMaxLen && slugRegexp.MatchString(s)
+}
+
+type valueError struct {
+	field string
+	err   error
+}
+
+// validateValues checks that a row has a value of every column and no others,
+// and that every value parses as its column type.
+func validateValues(columns []dictionary_domain.Column, values map[string]string) *valueError {
+	for name := range values {
+		if !slices.ContainsFunc(columns, func(c dictionary_domain.Column) bool { return c.Name == name }) {
+			return &valueError{field: name, err: ErrValueInvalid}
+		}
+	}
+
+	for _, c := range columns {
+		value, ok := values[c.Name]
+		if !ok {
+			return &valueError{field: c.Name, err: ErrValueEmpty}
+		}
+
+		if _, err := c.Type.Parse(value); err != nil {
+			return &valueError{field: c.Name, err: fmt.Errorf("%w: %w", ErrValueInvalid, err)}
+		}
+	}
+
+	return nil
+}
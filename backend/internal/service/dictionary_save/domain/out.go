@@ -0,0 +1,6 @@
+package domain
+
+type DictionarySaveOut struct {
+	ID     int64
+	Number int64
+}
@@ -0,0 +1,18 @@
+package dictionary_save_service
+
+import (
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/jmoiron/sqlx"
+
+	dictionary_repository "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/repository/dictionary"
+	dictionary_version_repository "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/repository/dictionary_version"
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/service"
+)
+
+func New(db *sqlx.DB) *service.Service {
+	dictionaryRepo := dictionary_repository.New(db, trmsqlx.DefaultCtxGetter)
+	dictionaryVersionRepo := dictionary_version_repository.New(db, trmsqlx.DefaultCtxGetter)
+	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
+	return service.New(dictionaryRepo, dictionaryVersionRepo, trManager)
+}
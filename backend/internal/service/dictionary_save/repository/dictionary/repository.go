@@ -0,0 +1,102 @@
+package dictionary_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+)
+
+type Repository struct {
+	db       *sqlx.DB
+	trGetter *trmsqlx.CtxGetter
+}
+
+func New(db *sqlx.DB, trGetter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{
+		db:       db,
+		trGetter: trGetter,
+	}
+}
+
+func (r *Repository) GetIDByName(ctx context.Context, projectID int64, name string) (*int64, error) {
+	op := "dictionary - get id by name"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id").
+		From("project_dictionary").
+		Where(sq.Eq{"project_id": projectID, "name": name, "deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var id int64
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &id, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return &id, nil
+}
+
+func (r *Repository) Create(ctx context.Context, dictionary domain.DictionaryToCreate) (int64, error) {
+	op := "dictionary - create"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("project_dictionary").
+		Columns("name", "project_id", "author_id").
+		Values(dictionary.Name, dictionary.ProjectID, dictionary.AuthorID).
+		Suffix("RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var id int64
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &id, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (r *Repository) UpdateByID(ctx context.Context, dictionary domain.DictionaryToUpdate) error {
+	op := "dictionary - update by id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("project_dictionary").
+		Set("last_version_id", dictionary.LastVersionID).
+		Set("updated_at", sq.Expr("now() AT TIME ZONE 'utc'")).
+		Where(sq.Eq{"id": dictionary.ID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	_, err = r.trGetter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return nil
+}
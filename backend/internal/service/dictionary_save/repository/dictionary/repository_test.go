@@ -0,0 +1,98 @@
+package dictionary_repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_GetIDByName() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// dictionaries
+	deletedAt := time.Now().UTC()
+	dictionaries := test_db.GenerateEntities(2, func(d *test_db.ProjectDictionary, i int) {
+		d.Name = "materials"
+		d.ProjectID = projectID
+		d.AuthorID = &userID
+		d.LastVersionID = nil
+		if i == 0 {
+			d.DeletedAt = &deletedAt
+		}
+	})
+	dictionaryIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_dictionary", dictionaries)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_dictionary", dictionaryIDs)) }()
+
+	got, err := repo.GetIDByName(ctx, projectID, "materials")
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), dictionaryIDs[1], *got)
+
+	got, err = repo.GetIDByName(ctx, projectID, "standards")
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), got)
+}
+
+func (s *repositorySuite) TestRepository_Create() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// dictionary
+	dictionaryID, err := repo.Create(ctx, domain.DictionaryToCreate{ProjectID: projectID, AuthorID: userID, Name: "materials"})
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_dictionary", dictionaryID)) }()
+
+	dictionaries, err := test_db.SelectEntitiesByID[test_db.ProjectDictionary](s.C(), "project_dictionary", []int64{dictionaryID})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), dictionaries, 1)
+
+	got := dictionaries[0]
+	want := test_db.ProjectDictionary{
+		ID:        dictionaryID,
+		Name:      "materials",
+		CreatedAt: got.CreatedAt,
+		ProjectID: projectID,
+		AuthorID:  &userID,
+	}
+	require.Equal(s.T(), want, got)
+}
@@ -0,0 +1,68 @@
+package dictionary_version_repository
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/samber/lo"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+)
+
+type column struct {
+	Name string               `json:"name"`
+	Type variable_domain.Type `json:"type"`
+}
+
+type columns []column
+
+func newColumns(cs []dictionary_domain.Column) columns {
+	return lo.Map(cs, func(c dictionary_domain.Column, _ int) column { return column{Name: c.Name, Type: c.Type} })
+}
+
+func (c columns) Value() (driver.Value, error) {
+	return valueJSON(c)
+}
+
+type row struct {
+	Key    string            `json:"key"`
+	Values map[string]string `json:"values"`
+}
+
+type rows []row
+
+func newRows(rs []dictionary_domain.Row) rows {
+	return lo.Map(rs, func(r dictionary_domain.Row, _ int) row {
+		values := r.Values
+		if values == nil {
+			values = map[string]string{}
+		}
+		return row{Key: r.Key, Values: values}
+	})
+}
+
+func (r rows) Value() (driver.Value, error) {
+	return valueJSON(r)
+}
+
+func valueJSON[T any](s []T) (driver.Value, error) {
+	if s == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(s)
+}
+
+type dictionaryVersion struct {
+	ID     int64 `db:"id"`
+	Number int64 `db:"number"`
+}
+
+func (v dictionaryVersion) toDomain() domain.DictionaryVersionOut {
+	return domain.DictionaryVersionOut{
+		ID:     v.ID,
+		Number: v.Number,
+	}
+}
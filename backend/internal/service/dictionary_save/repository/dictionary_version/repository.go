@@ -0,0 +1,58 @@
+package dictionary_version_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+)
+
+type Repository struct {
+	db       *sqlx.DB
+	trGetter *trmsqlx.CtxGetter
+}
+
+func New(db *sqlx.DB, trGetter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{
+		db:       db,
+		trGetter: trGetter,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, version domain.DictionaryVersion) (*domain.DictionaryVersionOut, error) {
+	op := "dictionary version - create"
+
+	numberExpr := sq.Expr("(SELECT COALESCE(MAX(number), 0) FROM project_dictionary_version WHERE dictionary_id = ?) + 1", version.DictionaryID)
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("project_dictionary_version").
+		Columns("number", "dictionary_id", "author_id", "columns", "rows").
+		Values(
+			numberExpr,
+			version.DictionaryID,
+			version.AuthorID,
+			newColumns(version.Columns),
+			newRows(version.Rows),
+		).
+		Suffix("RETURNING id, number")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dto dictionaryVersion
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &dto, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	out := dto.toDomain()
+	return &out, nil
+}
@@ -0,0 +1,85 @@
+package dictionary_version_repository
+
+import (
+	"context"
+	"testing"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_Create() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// dictionary
+	dictionary := test_db.GenerateEntity(func(d *test_db.ProjectDictionary) {
+		d.ProjectID = projectID
+		d.AuthorID = &userID
+		d.LastVersionID = nil
+	})
+	dictionaryID, err := test_db.InsertEntityWithID[int64](s.C(), "project_dictionary", dictionary)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_dictionary", dictionaryID)) }()
+
+	// dictionary versions
+	version := domain.DictionaryVersion{
+		DictionaryID: dictionaryID,
+		AuthorID:     userID,
+		Columns:      []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}},
+		Rows:         []dictionary_domain.Row{{Key: "steel", Values: map[string]string{"density": "7850"}}},
+	}
+
+	first, err := repo.Create(ctx, version)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntityByColumn(s.C(), "project_dictionary_version", "dictionary_id", dictionaryID))
+	}()
+	require.Equal(s.T(), int64(1), first.Number)
+
+	second, err := repo.Create(ctx, version)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(2), second.Number)
+
+	versions, err := test_db.SelectEntitiesByID[test_db.ProjectDictionaryVersion](s.C(), "project_dictionary_version", []int64{first.ID})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), versions, 1)
+
+	got := versions[0]
+	want := test_db.ProjectDictionaryVersion{
+		ID:           first.ID,
+		Number:       1,
+		DictionaryID: dictionaryID,
+		AuthorID:     &userID,
+		CreatedAt:    got.CreatedAt,
+		Columns:      []byte(`[{"name": "density", "type": "float"}]`),
+		Rows:         []byte(`[{"key": "steel", "values": {"density": "7850"}}]`),
+	}
+	require.Equal(s.T(), want, got)
+}
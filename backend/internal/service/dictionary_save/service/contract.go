@@ -0,0 +1,19 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package service
+
+import (
+	"context"
+
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+)
+
+type dictionaryRepository interface {
+	GetIDByName(ctx context.Context, projectID int64, name string) (*int64, error)
+	Create(ctx context.Context, dictionary domain.DictionaryToCreate) (int64, error)
+	UpdateByID(ctx context.Context, dictionary domain.DictionaryToUpdate) error
+}
+
+type dictionaryVersionRepository interface {
+	Create(ctx context.Context, version domain.DictionaryVersion) (*domain.DictionaryVersionOut, error)
+}
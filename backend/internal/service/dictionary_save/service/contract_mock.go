@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -package service -source contract.go -destination contract_mock.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockdictionaryRepository is a mock of dictionaryRepository interface.
+type MockdictionaryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockdictionaryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockdictionaryRepositoryMockRecorder is the mock recorder for MockdictionaryRepository.
+type MockdictionaryRepositoryMockRecorder struct {
+	mock *MockdictionaryRepository
+}
+
+// NewMockdictionaryRepository creates a new mock instance.
+func NewMockdictionaryRepository(ctrl *gomock.Controller) *MockdictionaryRepository {
+	mock := &MockdictionaryRepository{ctrl: ctrl}
+	mock.recorder = &MockdictionaryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdictionaryRepository) EXPECT() *MockdictionaryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockdictionaryRepository) Create(ctx context.Context, dictionary domain.DictionaryToCreate) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dictionary)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockdictionaryRepositoryMockRecorder) Create(ctx, dictionary any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockdictionaryRepository)(nil).Create), ctx, dictionary)
+}
+
+// GetIDByName mocks base method.
+func (m *MockdictionaryRepository) GetIDByName(ctx context.Context, projectID int64, name string) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIDByName", ctx, projectID, name)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIDByName indicates an expected call of GetIDByName.
+func (mr *MockdictionaryRepositoryMockRecorder) GetIDByName(ctx, projectID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIDByName", reflect.TypeOf((*MockdictionaryRepository)(nil).GetIDByName), ctx, projectID, name)
+}
+
+// UpdateByID mocks base method.
+func (m *MockdictionaryRepository) UpdateByID(ctx context.Context, dictionary domain.DictionaryToUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByID", ctx, dictionary)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateByID indicates an expected call of UpdateByID.
+func (mr *MockdictionaryRepositoryMockRecorder) UpdateByID(ctx, dictionary any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MockdictionaryRepository)(nil).UpdateByID), ctx, dictionary)
+}
+
+// MockdictionaryVersionRepository is a mock of dictionaryVersionRepository interface.
+type MockdictionaryVersionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockdictionaryVersionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockdictionaryVersionRepositoryMockRecorder is the mock recorder for MockdictionaryVersionRepository.
+type MockdictionaryVersionRepositoryMockRecorder struct {
+	mock *MockdictionaryVersionRepository
+}
+
+// NewMockdictionaryVersionRepository creates a new mock instance.
+func NewMockdictionaryVersionRepository(ctrl *gomock.Controller) *MockdictionaryVersionRepository {
+	mock := &MockdictionaryVersionRepository{ctrl: ctrl}
+	mock.recorder = &MockdictionaryVersionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdictionaryVersionRepository) EXPECT() *MockdictionaryVersionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockdictionaryVersionRepository) Create(ctx context.Context, version domain.DictionaryVersion) (*domain.DictionaryVersionOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, version)
+	ret0, _ := ret[0].(*domain.DictionaryVersionOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockdictionaryVersionRepositoryMockRecorder) Create(ctx, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockdictionaryVersionRepository)(nil).Create), ctx, version)
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+)
+
+type Service struct {
+	dictionaryRepo        dictionaryRepository
+	dictionaryVersionRepo dictionaryVersionRepository
+	trManager             trm.Manager
+}
+
+func New(dictionaryRepo dictionaryRepository, dictionaryVersionRepo dictionaryVersionRepository, trManager trm.Manager) *Service {
+	return &Service{
+		dictionaryRepo:        dictionaryRepo,
+		dictionaryVersionRepo: dictionaryVersionRepo,
+		trManager:             trManager,
+	}
+}
+
+// Handle saves a project dictionary: the first save of a name creates the
+// dictionary, the next ones create its new versions.
+func (s *Service) Handle(ctx context.Context, in domain.DictionarySaveIn) (*domain.DictionarySaveOut, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	var out *domain.DictionarySaveOut
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		out, err = s.saveDictionary(ctx, in)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (s *Service) saveDictionary(ctx context.Context, in domain.DictionarySaveIn) (*domain.DictionarySaveOut, error) {
+	// get dictionary
+	existingID, err := s.dictionaryRepo.GetIDByName(ctx, in.ProjectID, in.Name)
+	if err != nil {
+		return nil, fmt.Errorf("dictionary repo - get id by name: %w", err)
+	}
+
+	// create dictionary
+	var dictionaryID int64
+	if existingID != nil {
+		dictionaryID = *existingID
+	} else {
+		dictionaryToCreate := domain.DictionaryToCreate{
+			ProjectID: in.ProjectID,
+			AuthorID:  in.AuthorID,
+			Name:      in.Name,
+		}
+
+		dictionaryID, err = s.dictionaryRepo.Create(ctx, dictionaryToCreate)
+		if err != nil {
+			return nil, fmt.Errorf("dictionary repo - create: %w", err)
+		}
+	}
+
+	// create version
+	version := domain.DictionaryVersion{
+		DictionaryID: dictionaryID,
+		AuthorID:     in.AuthorID,
+		Columns:      in.Columns,
+		Rows:         in.Rows,
+	}
+
+	versionOut, err := s.dictionaryVersionRepo.Create(ctx, version)
+	if err != nil {
+		return nil, fmt.Errorf("dictionary version repo - create: %w", err)
+	}
+
+	// update dictionary
+	dictionaryToUpdate := domain.DictionaryToUpdate{
+		ID:            dictionaryID,
+		LastVersionID: versionOut.ID,
+	}
+
+	err = s.dictionaryRepo.UpdateByID(ctx, dictionaryToUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("dictionary repo - update by id: %w", err)
+	}
+
+	return &domain.DictionarySaveOut{ID: dictionaryID, Number: versionOut.Number}, nil
+}
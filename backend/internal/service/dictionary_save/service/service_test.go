@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	test_trm "github.com/qsoulior/tech-generator/backend/internal/pkg/test/trm"
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+)
+
+func TestService_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+	trCtx := context.WithValue(ctx, test_trm.TrKey{}, struct{}{})
+
+	in := domain.DictionarySaveIn{
+		ProjectID: 10,
+		AuthorID:  1,
+		Name:      "materials",
+		Columns: []dictionary_domain.Column{
+			{Name: "title", Type: variable_domain.TypeString},
+			{Name: "density", Type: variable_domain.TypeDecimal},
+		},
+		Rows: []dictionary_domain.Row{
+			{Key: "steel", Values: map[string]string{"title": "Сталь", "density": "7850.5"}},
+			{Key: "copper", Values: map[string]string{"title": "Медь", "density": "8960"}},
+		},
+	}
+
+	version := domain.DictionaryVersion{DictionaryID: 100, AuthorID: 1, Columns: in.Columns, Rows: in.Rows}
+
+	tests := []struct {
+		name  string
+		setup func(dictionaryRepo *MockdictionaryRepository, dictionaryVersionRepo *MockdictionaryVersionRepository)
+		want  domain.DictionarySaveOut
+	}{
+		{
+			name: "Create",
+			setup: func(dictionaryRepo *MockdictionaryRepository, dictionaryVersionRepo *MockdictionaryVersionRepository) {
+				dictionaryRepo.EXPECT().GetIDByName(trCtx, int64(10), "materials").Return(nil, nil)
+
+				dictionaryToCreate := domain.DictionaryToCreate{ProjectID: 10, AuthorID: 1, Name: "materials"}
+				dictionaryRepo.EXPECT().Create(trCtx, dictionaryToCreate).Return(int64(100), nil)
+
+				dictionaryVersionRepo.EXPECT().Create(trCtx, version).Return(&domain.DictionaryVersionOut{ID: 101, Number: 1}, nil)
+
+				dictionaryRepo.EXPECT().UpdateByID(trCtx, domain.DictionaryToUpdate{ID: 100, LastVersionID: 101}).Return(nil)
+			},
+			want: domain.DictionarySaveOut{ID: 100, Number: 1},
+		},
+		{
+			name: "NewVersion",
+			setup: func(dictionaryRepo *MockdictionaryRepository, dictionaryVersionRepo *MockdictionaryVersionRepository) {
+				dictionaryID := int64(100)
+				dictionaryRepo.EXPECT().GetIDByName(trCtx, int64(10), "materials").Return(&dictionaryID, nil)
+
+				dictionaryVersionRepo.EXPECT().Create(trCtx, version).Return(&domain.DictionaryVersionOut{ID: 102, Number: 2}, nil)
+
+				dictionaryRepo.EXPECT().UpdateByID(trCtx, domain.DictionaryToUpdate{ID: 100, LastVersionID: 102}).Return(nil)
+			},
+			want: domain.DictionarySaveOut{ID: 100, Number: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dictionaryRepo := NewMockdictionaryRepository(ctrl)
+			dictionaryVersionRepo := NewMockdictionaryVersionRepository(ctrl)
+			trManager := test_trm.New()
+
+			tt.setup(dictionaryRepo, dictionaryVersionRepo)
+
+			service := New(dictionaryRepo, dictionaryVersionRepo, trManager)
+			got, err := service.Handle(ctx, in)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, *got)
+		})
+	}
+}
+
+func TestService_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+	trCtx := context.WithValue(ctx, test_trm.TrKey{}, struct{}{})
+
+	columns := []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}}
+
+	in := domain.DictionarySaveIn{
+		ProjectID: 10,
+		AuthorID:  1,
+		Name:      "materials",
+		Columns:   columns,
+		Rows:      []dictionary_domain.Row{{Key: "steel", Values: map[string]string{"density": "7850"}}},
+	}
+
+	tests := []struct {
+		name    string
+		in      domain.DictionarySaveIn
+		setup   func(dictionaryRepo *MockdictionaryRepository, dictionaryVersionRepo *MockdictionaryVersionRepository)
+		want    string
+		wantVal bool
+	}{
+		{
+			name:    "ValidationNameInvalid",
+			in:      domain.DictionarySaveIn{ProjectID: 10, AuthorID: 1, Name: "1st"},
+			want:    "name",
+			wantVal: true,
+		},
+		{
+			name:    "ValidationColumnReserved",
+			in:      domain.DictionarySaveIn{ProjectID: 10, AuthorID: 1, Name: "materials", Columns: []dictionary_domain.Column{{Name: "key", Type: variable_domain.TypeString}}},
+			want:    domain.ErrValueReserved.Error(),
+			wantVal: true,
+		},
+		{
+			name: "ValidationColumnDuplicate",
+			in: domain.DictionarySaveIn{ProjectID: 10, AuthorID: 1, Name: "materials", Columns: []dictionary_domain.Column{
+				{Name: "density", Type: variable_domain.TypeFloat},
+				{Name: "density", Type: variable_domain.TypeString},
+			}},
+			want:    "columns.1.name",
+			wantVal: true,
+		},
+		{
+			name:    "ValidationColumnTypeInvalid",
+			in:      domain.DictionarySaveIn{ProjectID: 10, AuthorID: 1, Name: "materials", Columns: []dictionary_domain.Column{{Name: "grade", Type: variable_domain.TypeEnum}}},
+			want:    "columns.0.type",
+			wantVal: true,
+		},
+		{
+			name: "ValidationKeyDuplicate",
+			in: domain.DictionarySaveIn{ProjectID: 10, AuthorID: 1, Name: "materials", Columns: columns, Rows: []dictionary_domain.Row{
+				{Key: "steel", Values: map[string]string{"density": "7850"}},
+				{Key: "steel", Values: map[string]string{"density": "7800"}},
+			}},
+			want:    "rows.1.key",
+			wantVal: true,
+		},
+		{
+			name: "ValidationValueMissing",
+			in: domain.DictionarySaveIn{ProjectID: 10, AuthorID: 1, Name: "materials", Columns: columns, Rows: []dictionary_domain.Row{
+				{Key: "steel", Values: map[string]string{}},
+			}},
+			want:    "rows.0.values.density",
+			wantVal: true,
+		},
+		{
+			name: "ValidationValueUnknownColumn",
+			in: domain.DictionarySaveIn{ProjectID: 10, AuthorID: 1, Name: "materials", Columns: columns, Rows: []dictionary_domain.Row{
+				{Key: "steel", Values: map[string]string{"density": "7850", "grade": "A"}},
+			}},
+			want:    "rows.0.values.grade",
+			wantVal: true,
+		},
+		{
+			name: "ValidationValueInvalid",
+			in: domain.DictionarySaveIn{ProjectID: 10, AuthorID: 1, Name: "materials", Columns: columns, Rows: []dictionary_domain.Row{
+				{Key: "steel", Values: map[string]string{"density": "heavy"}},
+			}},
+			want:    "rows.0.values.density",
+			wantVal: true,
+		},
+		{
+			name: "dictionaryRepo_GetIDByName",
+			in:   in,
+			setup: func(dictionaryRepo *MockdictionaryRepository, _ *MockdictionaryVersionRepository) {
+				dictionaryRepo.EXPECT().GetIDByName(trCtx, int64(10), "materials").Return(nil, errors.New("test1"))
+			},
+			want: "test1",
+		},
+		{
+			name: "dictionaryRepo_Create",
+			in:   in,
+			setup: func(dictionaryRepo *MockdictionaryRepository, _ *MockdictionaryVersionRepository) {
+				dictionaryRepo.EXPECT().GetIDByName(trCtx, int64(10), "materials").Return(nil, nil)
+				dictionaryRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(0), errors.New("test2"))
+			},
+			want: "test2",
+		},
+		{
+			name: "dictionaryVersionRepo_Create",
+			in:   in,
+			setup: func(dictionaryRepo *MockdictionaryRepository, dictionaryVersionRepo *MockdictionaryVersionRepository) {
+				dictionaryRepo.EXPECT().GetIDByName(trCtx, int64(10), "materials").Return(nil, nil)
+				dictionaryRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(100), nil)
+				dictionaryVersionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(nil, errors.New("test3"))
+			},
+			want: "test3",
+		},
+		{
+			name: "dictionaryRepo_UpdateByID",
+			in:   in,
+			setup: func(dictionaryRepo *MockdictionaryRepository, dictionaryVersionRepo *MockdictionaryVersionRepository) {
+				dictionaryRepo.EXPECT().GetIDByName(trCtx, int64(10), "materials").Return(nil, nil)
+				dictionaryRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(100), nil)
+				dictionaryVersionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(&domain.DictionaryVersionOut{ID: 101, Number: 1}, nil)
+				dictionaryRepo.EXPECT().UpdateByID(trCtx, gomock.Any()).Return(errors.New("test4"))
+			},
+			want: "test4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dictionaryRepo := NewMockdictionaryRepository(ctrl)
+			dictionaryVersionRepo := NewMockdictionaryVersionRepository(ctrl)
+			trManager := test_trm.New()
+
+			if tt.setup != nil {
+				tt.setup(dictionaryRepo, dictionaryVersionRepo)
+			}
+
+			service := New(dictionaryRepo, dictionaryVersionRepo, trManager)
+			_, err := service.Handle(ctx, tt.in)
+			require.ErrorContains(t, err, tt.want)
+
+			if tt.wantVal {
+				var validationErr *error_domain.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+)
+
+type OutputRenderIn struct {
+	Format output_domain.Format
+	// StyleProfile names the profile the document is laid out by. Nil or
+	// unknown means the default profile.
+	StyleProfile *string
+	// Code is the document code put in the page header of PDF. Empty means
+	// no header.
+	Code string
+	// Kind is the kind of the template Data is rendered from. Empty means
+	// Markdown.
+	Kind template_domain.Kind
+	// Data is the rendered Markdown or Word document.
+	Data []byte
+}
+
+type Output struct {
+	Data        []byte
+	Format      output_domain.Format
+	ContentType string
+}
@@ -0,0 +1,9 @@
+package output_render_service
+
+import (
+	"github.com/qsoulior/tech-generator/backend/internal/service/output_render/service"
+)
+
+func New() *service.Service {
+	return service.New()
+}
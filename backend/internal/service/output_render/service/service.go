@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/docx"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/markdown"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/pdf"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/xlsx"
+	"github.com/qsoulior/tech-generator/backend/internal/service/output_render/domain"
+)
+
+type Service struct{}
+
+func New() *Service {
+	return &Service{}
+}
+
+// Handle converts the rendered Markdown to the output format. A Word template
+// is already laid out as a document, so its render is returned as DOCX.
+func (s *Service) Handle(_ context.Context, in domain.OutputRenderIn) (*domain.Output, error) {
+	if in.Kind == template_domain.KindDOCX {
+		return &domain.Output{Data: in.Data, Format: output_domain.FormatDOCX, ContentType: output_domain.FormatDOCX.ContentType()}, nil
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+
+	switch in.Format {
+	case output_domain.FormatMarkdown:
+		data = in.Data
+	case output_domain.FormatDOCX:
+		data, err = docx.Render(markdown.Parse(in.Data), profile(in.StyleProfile))
+		if err != nil {
+			return nil, fmt.Errorf("docx - render: %w", err)
+		}
+	case output_domain.FormatPDF:
+		data, err = pdf.Render(markdown.Parse(in.Data), profile(in.StyleProfile), in.Code)
+		if err != nil {
+			return nil, fmt.Errorf("pdf - render: %w", err)
+		}
+	case output_domain.FormatXLSX:
+		data, err = xlsx.Render(markdown.Parse(in.Data), profile(in.StyleProfile))
+		if err != nil {
+			return nil, fmt.Errorf("xlsx - render: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown output format %q", in.Format)
+	}
+
+	return &domain.Output{Data: data, Format: in.Format, ContentType: in.Format.ContentType()}, nil
+}
+
+func profile(name *string) output_domain.Profile {
+	if name != nil {
+		if profile, found := output_domain.Profiles[*name]; found {
+			return profile
+		}
+	}
+
+	return output_domain.Profiles[output_domain.DefaultProfile]
+}
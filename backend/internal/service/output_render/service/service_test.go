@@ -0,0 +1,102 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+	"github.com/qsoulior/tech-generator/backend/internal/service/output_render/domain"
+)
+
+func TestService_Handle_Markdown(t *testing.T) {
+	in := domain.OutputRenderIn{Format: output_domain.FormatMarkdown, Data: []byte("# Заголовок")}
+
+	got, err := New().Handle(context.Background(), in)
+	require.NoError(t, err)
+
+	want := domain.Output{Data: []byte("# Заголовок"), Format: output_domain.FormatMarkdown, ContentType: "text/markdown; charset=utf-8"}
+	require.Equal(t, want, *got)
+}
+
+func TestService_Handle_DOCX(t *testing.T) {
+	tests := []struct {
+		name         string
+		styleProfile *string
+	}{
+		{name: "DefaultProfile", styleProfile: nil},
+		{name: "Profile", styleProfile: lo.ToPtr("gost_19")},
+		{name: "UnknownProfile", styleProfile: lo.ToPtr("unknown")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := domain.OutputRenderIn{Format: output_domain.FormatDOCX, StyleProfile: tt.styleProfile, Data: []byte("# Заголовок\n\n## 1. Раздел\n")}
+
+			got, err := New().Handle(context.Background(), in)
+			require.NoError(t, err)
+			require.Equal(t, output_domain.FormatDOCX, got.Format)
+			require.Equal(t, "application/vnd.openxmlformats-officedocument.wordprocessingml.document", got.ContentType)
+
+			_, err = zip.NewReader(bytes.NewReader(got.Data), int64(len(got.Data)))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_Handle_PDF(t *testing.T) {
+	in := domain.OutputRenderIn{
+		Format:       output_domain.FormatPDF,
+		StyleProfile: lo.ToPtr("gost_34"),
+		Code:         "АБВГ.001-ТЗ",
+		Data:         []byte("# Заголовок\n\n## 1. Раздел\n"),
+	}
+
+	got, err := New().Handle(context.Background(), in)
+	require.NoError(t, err)
+	require.Equal(t, output_domain.FormatPDF, got.Format)
+	require.Equal(t, "application/pdf", got.ContentType)
+	require.True(t, bytes.HasPrefix(got.Data, []byte("%PDF-")))
+}
+
+func TestService_Handle_XLSX(t *testing.T) {
+	in := domain.OutputRenderIn{
+		Format: output_domain.FormatXLSX,
+		Data:   []byte("# Ведомость\n\n| Наименование | Количество |\n| --- | --- |\n| Кабель | 12 |\n"),
+	}
+
+	got, err := New().Handle(context.Background(), in)
+	require.NoError(t, err)
+	require.Equal(t, output_domain.FormatXLSX, got.Format)
+	require.Equal(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", got.ContentType)
+
+	reader, err := zip.NewReader(bytes.NewReader(got.Data), int64(len(got.Data)))
+	require.NoError(t, err)
+	require.True(t, lo.ContainsBy(reader.File, func(f *zip.File) bool { return f.Name == "xl/workbook.xml" }))
+}
+
+func TestService_Handle_Error(t *testing.T) {
+	in := domain.OutputRenderIn{Format: output_domain.Format("odt"), Data: []byte("text")}
+
+	_, err := New().Handle(context.Background(), in)
+	require.ErrorContains(t, err, `unknown output format "odt"`)
+}
+
+func TestService_Handle_DOCXTemplate(t *testing.T) {
+	for _, format := range []output_domain.Format{output_domain.FormatMarkdown, output_domain.FormatDOCX, output_domain.FormatPDF, output_domain.FormatXLSX} {
+		t.Run(string(format), func(t *testing.T) {
+			in := domain.OutputRenderIn{Format: format, Kind: template_domain.KindDOCX, Data: []byte{1, 2, 3}}
+
+			got, err := New().Handle(context.Background(), in)
+			require.NoError(t, err)
+
+			want := domain.Output{Data: []byte{1, 2, 3}, Format: output_domain.FormatDOCX, ContentType: output_domain.FormatDOCX.ContentType()}
+			require.Equal(t, want, *got)
+		})
+	}
+}
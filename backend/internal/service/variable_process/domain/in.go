@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"time"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
+)
+
+type Variable = version_get_domain.Variable
+
+type Column = version_get_domain.Column
+
+type Constraint = version_get_domain.Constraint
+
+type VariableProcessIn struct {
+	// VersionID identifies the version the variables belong to. Zero means the
+	// variables are not saved, e.g. in a draft preview, and nothing is cached.
+	VersionID int64
+	Variables []Variable
+	// Dependencies is the dependency graph stored with the version. Versions
+	// saved before it was stored have none, and it is built from Variables.
+	Dependencies map[string][]string
+	Payload      map[string]any
+	// Functions are the project functions the expressions may call, see
+	// expression.FunctionOptions.
+	Functions []function_domain.Function
+	// Dictionaries are the project dictionaries read by lookup and by enums
+	// with OptionsFrom.
+	Dictionaries []dictionary_domain.Dictionary
+	// Constants are the project constants available under
+	// expression.ProjectNamespace and kept in the returned values, so that
+	// templates read them too. Nil means there is no such namespace.
+	Constants map[string]string
+	// Budget limits the work of every expression, see expression.Run. Zero
+	// means the expr default.
+	Budget uint
+	// Now is the render clock returned by now(). Zero means the current time.
+	Now time.Time
+}
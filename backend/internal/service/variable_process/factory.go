@@ -0,0 +1,19 @@
+package variable_process_service
+
+import (
+	"github.com/qsoulior/tech-generator/backend/internal/service/variable_process/service"
+)
+
+func New() *service.Service {
+	return service.New()
+}
+
+type Cache = service.Cache
+
+func NewCache(size int) *Cache {
+	return service.NewCache(size)
+}
+
+func NewCached(cache *Cache) *service.Service {
+	return service.NewCached(cache)
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/service/variable_process/domain"
+)
+
+func TestBuiltins_Math(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		want       any
+	}{
+		{"sqrt", "sqrt(9)", 3.0},
+		{"pow", "pow(2, 10)", 1024.0},
+		{"exp_log", "round(log(exp(1)), 4)", 1.0},
+		{"log10", "log10(1000)", 3.0},
+		{"log2", "log2(8)", 3.0},
+		{"sin_pi_half", "round(sin(pi / 2), 4)", 1.0},
+		{"cos_pi", "round(cos(pi), 4)", -1.0},
+		{"tan_zero", "round(tan(0), 4)", 0.0},
+		{"asin_one", "round(asin(1) - pi / 2, 4)", 0.0},
+		{"acos_one", "round(acos(1), 4)", 0.0},
+		{"atan_one", "round(atan(1) - pi / 4, 4)", 0.0},
+		{"atan2", "round(atan2(1, 1) - pi / 4, 4)", 0.0},
+		{"hypot", "hypot(3, 4)", 5.0},
+		{"mod", "mod(10, 3)", 1.0},
+		{"sign_pos", "sign(42)", 1.0},
+		{"sign_neg", "sign(-3.14)", -1.0},
+		{"sign_zero", "sign(0)", 0.0},
+		{"clamp_low", "clamp(-5, 0, 10)", 0.0},
+		{"clamp_high", "clamp(50, 0, 10)", 10.0},
+		{"clamp_mid", "clamp(5, 0, 10)", 5.0},
+		{"interpolate", "interpolate(2.5, 0, 5, 0, 100)", 50.0},
+		{"const_e", "round(e, 4)", 2.7183},
+		{"const_g", "g", 9.80665},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := runExpression(t, tt.expression, variable_domain.TypeFloat)
+			require.InDelta(t, tt.want, got, 1e-6)
+		})
+	}
+}
+
+func TestBuiltins_RoundAndFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		typ        variable_domain.Type
+		want       any
+	}{
+		{"round_no_arg", "round(3.7)", variable_domain.TypeFloat, 4.0},
+		{"round_two_decimals", "round(3.14159, 2)", variable_domain.TypeFloat, 3.14},
+		{"round_zero_decimals", "round(3.7, 0)", variable_domain.TypeFloat, 4.0},
+		{"round_negative", "round(-3.55, 1)", variable_domain.TypeFloat, -3.6},
+		{"round_step_half", "roundStep(12.3, 0.5)", variable_domain.TypeFloat, 12.5},
+		{"round_step_hundred", "roundStep(1234, 100)", variable_domain.TypeFloat, 1200.0},
+		{"format_number_default", `formatNumber(1234567.5, 2)`, variable_domain.TypeString, "1 234 567,50"},
+		{"format_number_custom", `formatNumber(1234.5, 1, ".", ",")`, variable_domain.TypeString, "1,234.5"},
+		{"format_number_no_thousands", `formatNumber(1234.5, 2, ",", "")`, variable_domain.TypeString, "1234,50"},
+		{"format_number_negative", `formatNumber(-1234.5, 1)`, variable_domain.TypeString, "-1 234,5"},
+		{"percent_zero", `percent(0.157)`, variable_domain.TypeString, "16%"},
+		{"percent_one_decimal", `percent(0.157, 1)`, variable_domain.TypeString, "15,7%"},
+		{"scientific_default", `scientific(123000.0)`, variable_domain.TypeString, "1.23e+05"},
+		{"scientific_zero", `scientific(123000.0, 0)`, variable_domain.TypeString, "1e+05"},
+		{"number_to_words", `numberToWords(21, "f")`, variable_domain.TypeString, "двадцать одна"},
+		{"amount_to_words", `amountToWords(2.3)`, variable_domain.TypeString, "два рубля 30\u00a0копеек"},
+		{"plural_form", `pluralForm(5, "лист", "листа", "листов")`, variable_domain.TypeString, "листов"},
+		{"ordinal", `ordinal(2, "n")`, variable_domain.TypeString, "второе"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := runExpression(t, tt.expression, tt.typ)
+			switch want := tt.want.(type) {
+			case float64:
+				require.InDelta(t, want, got, 1e-9)
+			default:
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBuiltins_ConstantsStrippedFromOutput(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{
+				ID:         1,
+				Name:       "circle_area",
+				Type:       variable_domain.TypeFloat,
+				Expression: lo.ToPtr("pi * pow(radius, 2)"),
+			},
+			{
+				ID:      2,
+				Name:    "radius",
+				Type:    variable_domain.TypeFloat,
+				IsInput: true,
+			},
+		},
+		Payload: map[string]any{"radius": "2"},
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+
+	require.NotContains(t, got, "pi")
+	require.NotContains(t, got, "e")
+	require.NotContains(t, got, "g")
+
+	require.InDelta(t, 4*math.Pi, got["circle_area"], 1e-9)
+}
+
+func TestBuiltins_ConstraintsSeeConstants(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{
+				ID:      1,
+				Name:    "angle",
+				Type:    variable_domain.TypeFloat,
+				IsInput: true,
+				Constraints: []domain.Constraint{
+					{
+						ID:         1,
+						Name:       "below_two_pi",
+						Expression: "angle < 2 * pi",
+						IsActive:   true,
+					},
+				},
+			},
+		},
+		Payload: map[string]any{"angle": "5"},
+	}
+
+	_, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+}
+
+func TestBuiltins_Decimal(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	variables := []domain.Variable{
+		{ID: 1, Name: "price", Type: variable_domain.TypeDecimal, IsInput: true},
+		{ID: 2, Name: "rate", Type: variable_domain.TypeDecimal, IsInput: true},
+		{ID: 3, Name: "count", Type: variable_domain.TypeInteger, IsInput: true},
+		{ID: 4, Name: "sum", Type: variable_domain.TypeDecimal, Expression: lo.ToPtr("price + rate")},
+		{ID: 5, Name: "total", Type: variable_domain.TypeDecimal, Expression: lo.ToPtr("round(price * count * (1 + rate), 2)")},
+		{ID: 6, Name: "share", Type: variable_domain.TypeDecimal, Expression: lo.ToPtr("-price / 3")},
+		{ID: 7, Name: "root", Type: variable_domain.TypeDecimal, Expression: lo.ToPtr("sqrt(count)")},
+		{ID: 8, Name: "computed", Type: variable_domain.TypeDecimal, Expression: lo.ToPtr("0.5")},
+		{ID: 9, Name: "formatted", Type: variable_domain.TypeString, Expression: lo.ToPtr("formatNumber(price * 10000, 2)")},
+		{ID: 10, Name: "exact", Type: variable_domain.TypeBoolean, Expression: lo.ToPtr("sum == 0.3 && price < rate && price != 0.2")},
+		{ID: 11, Name: "mod", Type: variable_domain.TypeDecimal, Expression: lo.ToPtr("mod(rate, 0.15)")},
+		{ID: 12, Name: "step", Type: variable_domain.TypeDecimal, Expression: lo.ToPtr("roundStep(1.27, price)")},
+		{ID: 13, Name: "percent", Type: variable_domain.TypeString, Expression: lo.ToPtr("percent(rate, 1)")},
+	}
+
+	in := domain.VariableProcessIn{
+		Variables: variables,
+		Payload:   map[string]any{"price": "0.1", "rate": 0.2, "count": "3"},
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+
+	want := map[string]string{
+		"price":    "0.1",
+		"rate":     "0.2",
+		"sum":      "0.3",
+		"total":    "0.36",
+		"share":    "-0.0333333333333333",
+		"root":     "1.7320508075688772",
+		"computed": "0.5",
+		"mod":      "0.05",
+		"step":     "1.3",
+	}
+	for name, value := range want {
+		require.IsType(t, decimal.Decimal{}, got[name], name)
+		require.Equal(t, value, got[name].(decimal.Decimal).String(), name)
+	}
+
+	require.Equal(t, "1\u00a0000,00", got["formatted"])
+	require.Equal(t, true, got["exact"])
+	require.Equal(t, "20,0%", got["percent"])
+}
+
+func TestBuiltins_DecimalConstraint(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{
+				ID:      1,
+				Name:    "amount",
+				Type:    variable_domain.TypeDecimal,
+				IsInput: true,
+				Constraints: []domain.Constraint{
+					{ID: 1, Name: "exact_sum", Expression: "amount + 0.2 == 0.3", IsActive: true},
+					{ID: 2, Name: "at_most", Expression: "amount <= 0.1", IsActive: true},
+					{ID: 3, Name: "above", Expression: "amount > 0.1", IsActive: true},
+				},
+			},
+		},
+		Payload: map[string]any{"amount": "0.1"},
+	}
+
+	_, err := service.Handle(ctx, in)
+
+	var processErr *task_domain.ProcessError
+	require.ErrorAs(t, err, &processErr)
+	require.Len(t, processErr.VariableErrors, 1)
+	require.Len(t, processErr.VariableErrors[0].ConstraintErrors, 1)
+	require.Equal(t, "above", processErr.VariableErrors[0].ConstraintErrors[0].Name)
+}
+
+func TestBuiltins_Dates(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{ID: 1, Name: "signed", Type: variable_domain.TypeDate, IsInput: true},
+			{ID: 2, Name: "due", Type: variable_domain.TypeString, Expression: lo.ToPtr("formatDate(addBusinessDays(signed, 10))")},
+			{ID: 3, Name: "today", Type: variable_domain.TypeString, Expression: lo.ToPtr(`formatDate(now(), "02.01.2006")`)},
+			{ID: 4, Name: "overdue", Type: variable_domain.TypeBoolean, Expression: lo.ToPtr("addMonths(signed, 1) < now()")},
+		},
+		Payload: map[string]any{"signed": "2026-09-01"},
+		Now:     time.Date(2026, time.October, 18, 9, 30, 0, 0, time.UTC),
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+
+	require.NotContains(t, got, "now")
+	require.Equal(t, "15 сентября 2026 г.", got["due"])
+	require.Equal(t, "18.10.2026", got["today"])
+	require.Equal(t, true, got["overdue"])
+}
+
+func TestBuiltins_ProjectFunctions(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(2)
+	service := NewCached(cache)
+
+	functions := []function_domain.Function{
+		{VersionID: 1, Name: "tolerance", Params: []string{"x", "tol"}, Body: `formatNumber(x, 1) + " ± " + formatNumber(x * tol / 100, 1)`},
+		{VersionID: 2, Name: "withinTolerance", Params: []string{"x", "nominal", "tol"}, Body: `abs(x - nominal) <= nominal * tol / 100`},
+	}
+
+	in := domain.VariableProcessIn{
+		VersionID: 1,
+		Variables: []domain.Variable{
+			{ID: 1, Name: "length", Type: variable_domain.TypeFloat, IsInput: true, Constraints: []domain.Constraint{
+				{ID: 1, Name: "band", Expression: "withinTolerance(length, 100, 5)", IsActive: true},
+			}},
+			{ID: 2, Name: "label", Type: variable_domain.TypeString, Expression: lo.ToPtr("tolerance(length, 5)")},
+		},
+		Payload:   map[string]any{"length": 102.0},
+		Functions: functions,
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, "102,0 ± 5,1", got["label"])
+
+	// a new version of a function is compiled anew
+	in.Functions = []function_domain.Function{
+		functions[0],
+		{VersionID: 3, Name: "withinTolerance", Params: []string{"x", "nominal", "tol"}, Body: `abs(x - nominal) <= nominal * tol / 1000`},
+	}
+
+	_, err = service.Handle(ctx, in)
+	var processErr *task_domain.ProcessError
+	require.ErrorAs(t, err, &processErr)
+	require.Equal(t, task_domain.MessageConstraintCheck, processErr.VariableErrors[0].ConstraintErrors[0].Message)
+
+	stats := cache.Stats()
+	require.Equal(t, uint64(2), stats.Misses)
+
+	// functions that do not compile fail the run
+	in.Functions = []function_domain.Function{{VersionID: 4, Name: "f", Body: `f()`}}
+
+	_, err = service.Handle(ctx, in)
+	require.ErrorAs(t, err, &processErr)
+	require.Equal(t, task_domain.MessageFunctionCompile, processErr.Message)
+	require.Equal(t, []string{"f", "f"}, processErr.Cycle)
+}
+
+// runExpression evaluates a single expression by wrapping it into a computed
+// variable so the existing Service pipeline does the heavy lifting.
+func runExpression(t *testing.T, expression string, typ variable_domain.Type) any {
+	t.Helper()
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{
+				ID:         1,
+				Name:       "result",
+				Type:       typ,
+				Expression: lo.ToPtr(expression),
+			},
+		},
+	}
+
+	got, err := service.Handle(ctx, in)
+	if err != nil {
+		var processErr *task_domain.ProcessError
+		require.ErrorAs(t, err, &processErr)
+		t.Fatalf("expression %q failed: %+v", expression, *processErr)
+	}
+
+	return got["result"]
+}
@@ -0,0 +1,579 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/expression"
+	"github.com/qsoulior/tech-generator/backend/internal/service/variable_process/domain"
+)
+
+type Service struct {
+	cache *Cache
+}
+
+func New() *Service {
+	return &Service{}
+}
+
+// NewCached returns a service that reuses compiled versions from cache for
+// inputs with a version ID.
+func NewCached(cache *Cache) *Service {
+	return &Service{cache: cache}
+}
+
+func (s *Service) Handle(ctx context.Context, in domain.VariableProcessIn) (map[string]any, error) {
+	values, _, err := s.process(ctx, in)
+	return values, err
+}
+
+// HandleWithTrace processes variables like Handle and also explains how every
+// value was reached. The trace is returned along with a process error, so
+// that a failed run can be explained as well.
+func (s *Service) HandleWithTrace(ctx context.Context, in domain.VariableProcessIn) (map[string]any, []task_domain.VariableTrace, error) {
+	return s.process(ctx, in)
+}
+
+// process evaluates the variables in order. It stops once ctx is done, so
+// that a render that timed out doesn't keep evaluating in the background.
+func (s *Service) process(ctx context.Context, in domain.VariableProcessIn) (map[string]any, []task_domain.VariableTrace, error) {
+	variablesByName := lo.KeyBy(in.Variables, func(v domain.Variable) string { return v.Name })
+
+	version := s.compiledVersion(in)
+	if version.orderErr != nil {
+		var cycleErr *expression.CycleError
+		if errors.As(version.orderErr, &cycleErr) {
+			return nil, nil, &task_domain.ProcessError{Message: task_domain.MessageCycle, Cycle: cycleErr.Path}
+		}
+		return nil, nil, version.orderErr
+	}
+
+	if version.functionsErr != nil {
+		return nil, nil, functionsError(version.functionsErr)
+	}
+
+	dependencies := version.dependencies
+	variableNames := version.order
+	eval := evaluator{version: version, budget: in.Budget}
+
+	var variableErrors []task_domain.VariableError
+	globals := expression.Globals(in.Now, in.Dictionaries)
+	variableValues := make(map[string]any, len(globals)+len(in.Payload)+len(variableNames))
+	maps.Copy(variableValues, globals)
+	if in.Constants != nil {
+		variableValues[expression.ProjectNamespace] = expression.ProjectConstants(in.Constants)
+	}
+	variableTraces := make([]task_domain.VariableTrace, 0, len(variableNames))
+
+	for _, name := range slices.Sorted(maps.Keys(in.Payload)) {
+		if _, ok := variablesByName[name]; !ok {
+			variableErrors = append(variableErrors, task_domain.VariableError{Name: name, Message: task_domain.MessageVariableNotFound})
+		}
+	}
+
+	for i, name := range variableNames {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, contextError(err)
+		}
+
+		variable := variablesByName[name]
+
+		variableTrace := task_domain.VariableTrace{
+			Order:        i + 1,
+			Name:         name,
+			Dependencies: traceDependencies(dependencies[name], variableValues),
+		}
+
+		variable, variableError := resolveOptions(variable, in.Dictionaries)
+		var variableValue any
+		if variableError == nil {
+			var err error
+			variableValue, variableError, err = processVariable(ctx, eval, variable, in.Payload, variableValues, variableNames, &variableTrace)
+			if err != nil {
+				return nil, nil, contextError(err)
+			}
+		}
+		if variableError != nil {
+			variableErrors = append(variableErrors, *variableError)
+			variableTrace.Message = variableError.Message
+		}
+
+		variableValues[name] = variableValue
+		variableTraces = append(variableTraces, variableTrace)
+	}
+
+	if len(variableErrors) != 0 {
+		return nil, variableTraces, &task_domain.ProcessError{VariableErrors: variableErrors}
+	}
+
+	for name := range globals {
+		delete(variableValues, name)
+	}
+
+	return variableValues, variableTraces, nil
+}
+
+func (s *Service) compiledVersion(in domain.VariableProcessIn) *compiledVersion {
+	if s.cache == nil || in.VersionID == 0 {
+		return newCompiledVersion(in)
+	}
+
+	key := newCacheKey(in)
+	version, ok := s.cache.Get(key)
+	if ok {
+		return version
+	}
+
+	version = newCompiledVersion(in)
+	s.cache.Add(key, version)
+	return version
+}
+
+// contextError describes a run stopped because ctx is done. One that ran out of
+// time fails as timed out.
+func contextError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &task_domain.ProcessError{Message: task_domain.MessageTimeout}
+	}
+	return err
+}
+
+// functionsError describes project functions that do not compile, which
+// validating them on save should have prevented.
+func functionsError(err error) error {
+	processErr := &task_domain.ProcessError{Message: task_domain.MessageFunctionCompile}
+
+	var cycleErr *expression.CycleError
+	var functionErr *expression.FunctionError
+	switch {
+	case errors.As(err, &cycleErr):
+		processErr.Cycle = cycleErr.Path
+	case errors.As(err, &functionErr):
+		processErr.Message = fmt.Sprintf("%s %s: %s", processErr.Message, functionErr.Name, functionErr.Err)
+	default:
+		return err
+	}
+
+	return processErr
+}
+
+func buildDependencies(variablesByName map[string]domain.Variable) map[string][]string {
+	names := lo.Keys(variablesByName)
+	dependents := make(map[string][]string)
+
+	for _, v := range variablesByName {
+		source := v.Expression
+		if v.IsInput {
+			source = v.DefaultExpression
+		}
+
+		sources := []string{lo.FromPtr(source), lo.FromPtr(v.EnabledIf)}
+		constraints := lo.Map(v.Constraints, func(c domain.Constraint, _ int) string { return c.Expression })
+		dependencies := expression.VariableDependencies(v.Name, sources, constraints, names)
+		dependents[v.Name] = dependencies
+	}
+
+	return dependents
+}
+
+// resolveOptions takes the options of an enum from the dictionary column it
+// refers to, if any.
+func resolveOptions(variable domain.Variable, dictionaries []dictionary_domain.Dictionary) (domain.Variable, *task_domain.VariableError) {
+	if variable.OptionsFrom == nil {
+		return variable, nil
+	}
+
+	name, column, _ := dictionary_domain.SplitReference(*variable.OptionsFrom)
+	dictionary, ok := dictionary_domain.Find(dictionaries, name)
+	if !ok {
+		return variable, &task_domain.VariableError{ID: variable.ID, Name: variable.Name, Title: variable.Title, Value: *variable.OptionsFrom, Message: task_domain.MessageDictionaryNotFound}
+	}
+
+	if _, ok := dictionary.Column(column); !ok {
+		return variable, &task_domain.VariableError{ID: variable.ID, Name: variable.Name, Title: variable.Title, Value: *variable.OptionsFrom, Message: task_domain.MessageDictionaryNotFound}
+	}
+
+	variable.Options = dictionary.Values(column)
+	return variable, nil
+}
+
+func parseValue(variable domain.Variable, value any) (any, *task_domain.VariableError) {
+	var parsedValue any
+	var variableError *task_domain.VariableError
+
+	switch variable.Type {
+	case variable_domain.TypeList:
+		parsedValue, variableError = parseList(variable, value)
+	case variable_domain.TypeTable:
+		parsedValue, variableError = parseTable(variable, value)
+	default:
+		parsedValue, variableError = parseScalar(variable.Type, variable.Options, value)
+	}
+
+	if variableError != nil {
+		variableError.ID = variable.ID
+		variableError.Name = variable.Name
+		variableError.Title = variable.Title
+		return nil, variableError
+	}
+
+	return parsedValue, nil
+}
+
+// parseList parses every item of a list variable with its item type. The
+// returned error points at the first invalid item.
+func parseList(variable domain.Variable, value any) (any, *task_domain.VariableError) {
+	items, ok := value.([]any)
+	if !ok {
+		return nil, &task_domain.VariableError{Message: task_domain.MessageVariableStructure}
+	}
+
+	itemType := lo.FromPtr(variable.ItemType)
+	list := make([]any, 0, len(items))
+
+	for i, item := range items {
+		parsedItem, variableError := parseScalar(itemType, variable.Options, item)
+		if variableError != nil {
+			variableError.Path = strconv.Itoa(i)
+			return nil, variableError
+		}
+
+		list = append(list, parsedItem)
+	}
+
+	return list, nil
+}
+
+// parseTable parses every row of a table variable column by column. Rows must
+// fill every declared column and nothing else. The returned error points at
+// the first invalid cell.
+func parseTable(variable domain.Variable, value any) (any, *task_domain.VariableError) {
+	rows, ok := value.([]any)
+	if !ok {
+		return nil, &task_domain.VariableError{Message: task_domain.MessageVariableStructure}
+	}
+
+	columnsByName := lo.KeyBy(variable.Columns, func(c domain.Column) string { return c.Name })
+	table := make([]map[string]any, 0, len(rows))
+
+	for i, row := range rows {
+		cells, ok := row.(map[string]any)
+		if !ok {
+			return nil, &task_domain.VariableError{Path: strconv.Itoa(i), Message: task_domain.MessageVariableStructure}
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(cells)) {
+			if _, ok := columnsByName[name]; !ok {
+				return nil, &task_domain.VariableError{Path: fmt.Sprintf("%d.%s", i, name), Message: task_domain.MessageColumnNotFound}
+			}
+		}
+
+		parsedRow := make(map[string]any, len(variable.Columns))
+		for _, column := range variable.Columns {
+			cell, ok := cells[column.Name]
+			if !ok {
+				return nil, &task_domain.VariableError{Path: fmt.Sprintf("%d.%s", i, column.Name), Message: task_domain.MessageColumnMissing}
+			}
+
+			parsedCell, variableError := parseScalar(column.Type, column.Options, cell)
+			if variableError != nil {
+				variableError.Path = fmt.Sprintf("%d.%s", i, column.Name)
+				return nil, variableError
+			}
+
+			parsedRow[column.Name] = parsedCell
+		}
+
+		table = append(table, parsedRow)
+	}
+
+	return table, nil
+}
+
+func parseScalar(typ variable_domain.Type, options []string, value any) (any, *task_domain.VariableError) {
+	if !typ.Scalar() {
+		return nil, &task_domain.VariableError{Message: task_domain.MessageVariableTypeUnknown}
+	}
+
+	s, ok := scalarString(value)
+	if !ok {
+		return nil, &task_domain.VariableError{Message: task_domain.MessageVariableStructure}
+	}
+
+	parsedValue, err := typ.Parse(s)
+	if err != nil {
+		return nil, &task_domain.VariableError{Message: task_domain.MessageVariableParse}
+	}
+
+	if typ == variable_domain.TypeEnum && !slices.Contains(options, s) {
+		return nil, &task_domain.VariableError{Value: s, Message: task_domain.MessageVariableOption}
+	}
+
+	return parsedValue, nil
+}
+
+// scalarString returns the textual form of a decoded JSON scalar, so that
+// both "42" and 42 are accepted for numeric variables. Values produced by
+// default expressions are accepted as well.
+func scalarString(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case time.Time:
+		return v.Format(time.DateOnly), true
+	case decimal.Decimal:
+		return v.String(), true
+	default:
+		return "", false
+	}
+}
+
+// processVariable evaluates a variable along with its condition and
+// constraints. The error is only returned once ctx is done.
+func processVariable(ctx context.Context, eval evaluator, variable domain.Variable, payload map[string]any, values map[string]any, names []string, trace *task_domain.VariableTrace) (any, *task_domain.VariableError, error) {
+	trace.Condition = lo.FromPtr(variable.EnabledIf)
+
+	enabled, variableError, err := processEnabled(ctx, eval, variable, values)
+	if err != nil || variableError != nil {
+		return nil, variableError, err
+	}
+
+	if !enabled {
+		trace.Source = task_domain.TraceSourceDisabled
+		return nil, nil, nil
+	}
+
+	value, variableError := processVariableValue(eval, variable, payload, values, trace)
+	if variableError != nil {
+		return nil, variableError, nil
+	}
+
+	trace.Value, trace.Type = traceValue(value)
+
+	constraintErrors, constraintTraces, err := processConstraints(ctx, eval, variable.Name, value, variable.Constraints, values, names)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trace.Constraints = constraintTraces
+	if len(constraintErrors) != 0 {
+		return nil, &task_domain.VariableError{
+			ID:               variable.ID,
+			Name:             variable.Name,
+			Title:            variable.Title,
+			Value:            fmt.Sprintf("%v", value),
+			ConstraintErrors: constraintErrors,
+		}, nil
+	}
+
+	return value, nil, nil
+}
+
+// processEnabled evaluates the condition of a variable. A disabled variable
+// is neither required nor checked, and its value is nil.
+func processEnabled(ctx context.Context, eval evaluator, variable domain.Variable, values map[string]any) (bool, *task_domain.VariableError, error) {
+	if variable.EnabledIf == nil {
+		return true, nil, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return false, nil, err
+	}
+
+	program, err := eval.compileConstraint(variable.Name, *variable.EnabledIf, values)
+	if err != nil {
+		return false, &task_domain.VariableError{ID: variable.ID, Name: variable.Name, Title: variable.Title, Message: task_domain.MessageConditionCompile}, nil
+	}
+
+	enabled, err := eval.run(program, values)
+	if err != nil {
+		return false, &task_domain.VariableError{ID: variable.ID, Name: variable.Name, Title: variable.Title, Message: execMessage(err, task_domain.MessageConditionExec)}, nil
+	}
+
+	result, ok := enabled.(bool)
+	if !ok {
+		return false, &task_domain.VariableError{ID: variable.ID, Name: variable.Name, Title: variable.Title, Message: task_domain.MessageConditionExec}, nil
+	}
+
+	return result, nil, nil
+}
+
+func processVariableValue(eval evaluator, variable domain.Variable, payload map[string]any, values map[string]any, trace *task_domain.VariableTrace) (any, *task_domain.VariableError) {
+	if variable.IsInput {
+		return processInputValue(eval, variable, payload, values, trace)
+	}
+
+	trace.Source = task_domain.TraceSourceExpression
+	trace.Expression = lo.FromPtr(variable.Expression)
+
+	value, variableError := processExpression(eval, variable, lo.FromPtr(variable.Expression), values)
+	if variableError != nil {
+		return nil, variableError
+	}
+
+	// builtins computed in float64 and plain numbers still make a decimal
+	if variable.Type == variable_domain.TypeDecimal {
+		return parseValue(variable, value)
+	}
+
+	return value, nil
+}
+
+// processInputValue returns the parsed payload value of an input variable.
+// An input missing from the payload takes its default value if it has one
+// and is reported as missing otherwise.
+func processInputValue(eval evaluator, variable domain.Variable, payload map[string]any, values map[string]any, trace *task_domain.VariableTrace) (any, *task_domain.VariableError) {
+	if value, ok := payload[variable.Name]; ok {
+		trace.Source = task_domain.TraceSourcePayload
+		return parseValue(variable, value)
+	}
+
+	if variable.DefaultExpression == nil {
+		return nil, &task_domain.VariableError{ID: variable.ID, Name: variable.Name, Title: variable.Title, Message: task_domain.MessageVariableMissing}
+	}
+
+	trace.Source = task_domain.TraceSourceDefault
+	trace.Expression = *variable.DefaultExpression
+
+	value, variableError := processExpression(eval, variable, *variable.DefaultExpression, values)
+	if variableError != nil {
+		return nil, variableError
+	}
+
+	return parseValue(variable, value)
+}
+
+func processExpression(eval evaluator, variable domain.Variable, source string, values map[string]any) (any, *task_domain.VariableError) {
+	program, err := eval.compile(variable.Name, source, values)
+	if err != nil {
+		return nil, &task_domain.VariableError{ID: variable.ID, Name: variable.Name, Title: variable.Title, Message: task_domain.MessageVariableCompile}
+	}
+
+	value, err := eval.run(program, values)
+	if err != nil {
+		return nil, &task_domain.VariableError{ID: variable.ID, Name: variable.Name, Title: variable.Title, Message: execMessage(err, task_domain.MessageVariableExec)}
+	}
+
+	return value, nil
+}
+
+// processConstraints checks the active constraints of the named variable and
+// returns the failed ones along with the outcome of every check. Constraints
+// are evaluated against all values computed so far, so they may refer to the
+// variables the owning variable depends on.
+func processConstraints(ctx context.Context, eval evaluator, name string, value any, constraints []domain.Constraint, values map[string]any, names []string) ([]task_domain.ConstraintError, []task_domain.ConstraintTrace, error) {
+	var constraintErrors []task_domain.ConstraintError
+	var constraintTraces []task_domain.ConstraintTrace
+
+	env := maps.Clone(values)
+	env[name] = value
+
+	for _, constraint := range constraints {
+		if !constraint.IsActive {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		constraintTrace := task_domain.ConstraintTrace{Name: constraint.Name, Expression: constraint.Expression, Passed: true}
+
+		constraintError := processConstraint(eval, name, constraint, env, names)
+		if constraintError != nil {
+			constraintErrors = append(constraintErrors, *constraintError)
+			constraintTrace.Passed = false
+			constraintTrace.Message = constraintError.Message
+		}
+
+		constraintTraces = append(constraintTraces, constraintTrace)
+	}
+
+	return constraintErrors, constraintTraces, nil
+}
+
+func processConstraint(eval evaluator, name string, constraint domain.Constraint, env map[string]any, names []string) *task_domain.ConstraintError {
+	program, err := eval.compileConstraint(name, constraint.Expression, env)
+	if err != nil {
+		return &task_domain.ConstraintError{ID: constraint.ID, Name: constraint.Name, Expression: constraint.Expression, Message: task_domain.MessageConstraintCompile}
+	}
+
+	check, err := eval.run(program, env)
+	if err != nil {
+		return &task_domain.ConstraintError{ID: constraint.ID, Name: constraint.Name, Expression: constraint.Expression, Message: execMessage(err, task_domain.MessageConstraintExec), Variables: constraintVariables(constraint, env, names)}
+	}
+
+	result, ok := check.(bool)
+	if !ok {
+		return &task_domain.ConstraintError{ID: constraint.ID, Name: constraint.Name, Expression: constraint.Expression, Message: task_domain.MessageConstraintExec, Variables: constraintVariables(constraint, env, names)}
+	}
+
+	if !result {
+		return &task_domain.ConstraintError{ID: constraint.ID, Name: constraint.Name, Expression: constraint.Expression, Message: task_domain.MessageConstraintCheck, Variables: constraintVariables(constraint, env, names)}
+	}
+
+	return nil
+}
+
+// execMessage tells an expression that exceeded its budget from one that
+// failed otherwise.
+func execMessage(err error, message string) string {
+	if errors.Is(err, expression.ErrBudget) {
+		return task_domain.MessageBudget
+	}
+	return message
+}
+
+// constraintVariables reports the variables a constraint refers to with their
+// values, so that a failed check can be traced to its inputs.
+func constraintVariables(constraint domain.Constraint, env map[string]any, names []string) []task_domain.ConstraintVariable {
+	dependencies := expression.ExtractDependencies(constraint.Expression, names)
+	return lo.Map(dependencies, func(name string, _ int) task_domain.ConstraintVariable {
+		return task_domain.ConstraintVariable{Name: name, Value: fmt.Sprintf("%v", env[name])}
+	})
+}
+
+// traceDependencies reports the dependencies of a variable, sorted by name,
+// with the values they had when it was evaluated.
+func traceDependencies(dependencies []string, values map[string]any) []task_domain.TraceDependency {
+	if len(dependencies) == 0 {
+		return nil
+	}
+
+	return lo.Map(slices.Sorted(slices.Values(dependencies)), func(name string, _ int) task_domain.TraceDependency {
+		value, typ := traceValue(values[name])
+		return task_domain.TraceDependency{Name: name, Value: value, Type: typ}
+	})
+}
+
+// traceValue returns the textual form of a value and its Go type. A nil value,
+// such as the value of a disabled variable, has neither.
+func traceValue(value any) (string, string) {
+	if value == nil {
+		return "", ""
+	}
+
+	return fmt.Sprintf("%v", value), fmt.Sprintf("%T", value)
+}
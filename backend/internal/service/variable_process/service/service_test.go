@@ -0,0 +1,1121 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/service/variable_process/domain"
+)
+
+func TestService_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			// input integer variable with enabled constraints
+			{
+				ID:      1,
+				Name:    "test1",
+				Type:    variable_domain.TypeInteger,
+				IsInput: true,
+				Constraints: []domain.Constraint{
+					{
+						ID:         1,
+						Name:       "test1",
+						Expression: "test1 > 100",
+						IsActive:   true,
+					},
+					{
+						ID:         2,
+						Name:       "test2",
+						Expression: "test1 < 200",
+						IsActive:   true,
+					},
+				},
+			},
+			// computed integer variable with disable constraint
+			{
+				ID:         2,
+				Name:       "test2",
+				Type:       variable_domain.TypeInteger,
+				Expression: lo.ToPtr("test1 + test3 - test1 / 2"),
+				IsInput:    false,
+				Constraints: []domain.Constraint{
+					{
+						ID:         3,
+						Name:       "test1",
+						Expression: "test2 > 1000",
+						IsActive:   false,
+					},
+				},
+			},
+			// input integer variable without constraints
+			{
+				ID:      3,
+				Name:    "test3",
+				Type:    variable_domain.TypeInteger,
+				IsInput: true,
+			},
+			// input string variable without constraints
+			{
+				ID:      4,
+				Name:    "test4",
+				Type:    variable_domain.TypeString,
+				IsInput: true,
+			},
+			// computed string variable with enabled constraints
+			{
+				ID:         5,
+				Name:       "test5",
+				Type:       variable_domain.TypeString,
+				Expression: lo.ToPtr(`test4 + "bar"`),
+				IsInput:    false,
+				Constraints: []domain.Constraint{
+					{
+						ID:         4,
+						Name:       "test1",
+						Expression: "len(test5) > 5",
+						IsActive:   true,
+					},
+				},
+			},
+			// computed float variable without constraints
+			{
+				ID:         6,
+				Name:       "test6",
+				Type:       variable_domain.TypeFloat,
+				Expression: lo.ToPtr(`test2 + 10.2`),
+				IsInput:    false,
+			},
+		},
+		Payload: map[string]any{
+			"test1": "123",
+			"test3": "321",
+			"test4": "foo",
+		},
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+
+	want := map[string]any{
+		"test1": int64(123),
+		"test2": 382.5,
+		"test3": int64(321),
+		"test4": "foo",
+		"test5": "foobar",
+		"test6": 392.7,
+	}
+
+	require.Equal(t, want, got)
+}
+
+func TestService_Handle_Types(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{ID: 1, Name: "flag", Type: variable_domain.TypeBoolean, IsInput: true},
+			{ID: 2, Name: "approved_at", Type: variable_domain.TypeDate, IsInput: true},
+			{ID: 3, Name: "grade", Type: variable_domain.TypeEnum, IsInput: true, Options: []string{"low", "high"}},
+			{
+				ID:         4,
+				Name:       "summary",
+				Type:       variable_domain.TypeString,
+				Expression: lo.ToPtr(`flag && grade == "high" ? approved_at.Format("02.01.2006") : ""`),
+				IsInput:    false,
+			},
+		},
+		Payload: map[string]any{
+			"flag":        "true",
+			"approved_at": "2026-10-18",
+			"grade":       "high",
+		},
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+
+	want := map[string]any{
+		"flag":        true,
+		"approved_at": time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		"grade":       "high",
+		"summary":     "18.10.2026",
+	}
+
+	require.Equal(t, want, got)
+}
+
+func TestService_Handle_Dictionaries(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	dictionaries := []dictionary_domain.Dictionary{
+		{
+			VersionID: 1,
+			Name:      "materials",
+			Columns:   []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}},
+			Rows: []dictionary_domain.Row{
+				{Key: "steel", Values: map[string]string{"density": "7850"}},
+				{Key: "aluminium", Values: map[string]string{"density": "2700"}},
+			},
+		},
+	}
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{ID: 1, Name: "material", Type: variable_domain.TypeEnum, IsInput: true, OptionsFrom: lo.ToPtr("materials.key")},
+			{ID: 2, Name: "volume", Type: variable_domain.TypeFloat, IsInput: true},
+			{ID: 3, Name: "mass", Type: variable_domain.TypeFloat, Expression: lo.ToPtr(`volume * lookup("materials", material, "density")`)},
+		},
+		Payload:      map[string]any{"material": "aluminium", "volume": "2"},
+		Dictionaries: dictionaries,
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"material": "aluminium", "volume": 2.0, "mass": 5400.0}, got)
+
+	in.Payload["material"] = "copper"
+	_, err = service.Handle(ctx, in)
+	require.Equal(t, &task_domain.ProcessError{VariableErrors: []task_domain.VariableError{
+		{ID: 1, Name: "material", Value: "copper", Message: task_domain.MessageVariableOption},
+		{ID: 3, Name: "mass", Message: task_domain.MessageVariableExec},
+	}}, err)
+
+	in.Payload["material"] = "steel"
+	in.Dictionaries = nil
+	_, err = service.Handle(ctx, in)
+	require.Equal(t, &task_domain.ProcessError{VariableErrors: []task_domain.VariableError{
+		{ID: 1, Name: "material", Value: "materials.key", Message: task_domain.MessageDictionaryNotFound},
+		{ID: 3, Name: "mass", Message: task_domain.MessageVariableExec},
+	}}, err)
+}
+
+func TestService_Handle_Constants(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{ID: 1, Name: "number", Type: variable_domain.TypeString, IsInput: true},
+			{ID: 2, Name: "title", Type: variable_domain.TypeString, Expression: lo.ToPtr(`project.org_name + " № " + number`)},
+		},
+		Payload:   map[string]any{"number": "17"},
+		Constants: map[string]string{"org_name": "ООО Ромашка"},
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"number":  "17",
+		"title":   "ООО Ромашка № 17",
+		"project": map[string]any{"org_name": "ООО Ромашка"},
+	}, got)
+
+	// a variable shadows the namespace
+	in.Variables = append(in.Variables, domain.Variable{ID: 3, Name: "project", Type: variable_domain.TypeString, IsInput: true})
+	in.Variables[1].Expression = lo.ToPtr(`project + " № " + number`)
+	in.Payload["project"] = "Мост"
+	got, err = service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"number": "17", "title": "Мост № 17", "project": "Мост"}, got)
+}
+
+func TestService_Handle_Collections(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{ID: 1, Name: "items", Type: variable_domain.TypeList, ItemType: lo.ToPtr(variable_domain.TypeInteger), IsInput: true},
+			{
+				ID:      2,
+				Name:    "cases",
+				Type:    variable_domain.TypeTable,
+				IsInput: true,
+				Columns: []domain.Column{
+					{Name: "name", Type: variable_domain.TypeString},
+					{Name: "cost", Type: variable_domain.TypeFloat},
+				},
+			},
+			{ID: 3, Name: "total", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("sum(items) + sum(map(cases, .cost))")},
+		},
+		Payload: map[string]any{
+			"items": []any{"1", json.Number("2")},
+			"cases": []any{
+				map[string]any{"name": "a", "cost": json.Number("1.5")},
+				map[string]any{"name": "b", "cost": "2.5"},
+			},
+		},
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+
+	want := map[string]any{
+		"items": []any{int64(1), int64(2)},
+		"cases": []map[string]any{
+			{"name": "a", "cost": 1.5},
+			{"name": "b", "cost": 2.5},
+		},
+		"total": 7.0,
+	}
+
+	require.Equal(t, want, got)
+}
+
+func TestService_Handle_Dependencies(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	variables := []domain.Variable{
+		{ID: 1, Name: "greeting", Type: variable_domain.TypeString, Expression: lo.ToPtr(`"name: " + name`)},
+		{ID: 2, Name: "name", Type: variable_domain.TypeString, Expression: lo.ToPtr(`"greeting"`)},
+	}
+
+	want := map[string]any{
+		"greeting": "name: greeting",
+		"name":     "greeting",
+	}
+
+	t.Run("Built", func(t *testing.T) {
+		got, err := service.Handle(ctx, domain.VariableProcessIn{Variables: variables})
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	})
+
+	t.Run("Stored", func(t *testing.T) {
+		in := domain.VariableProcessIn{
+			Variables:    variables,
+			Dependencies: map[string][]string{"greeting": {"name"}, "name": {}},
+		}
+
+		got, err := service.Handle(ctx, in)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	})
+}
+
+func TestService_Handle_Cached(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(1)
+	service := NewCached(cache)
+
+	variables := []domain.Variable{
+		{ID: 1, Name: "protected", Type: variable_domain.TypeBoolean, IsInput: true},
+		{ID: 2, Name: "fee", Type: variable_domain.TypeInteger, IsInput: true, EnabledIf: lo.ToPtr("protected")},
+		{
+			ID:         3,
+			Name:       "total",
+			Type:       variable_domain.TypeInteger,
+			Expression: lo.ToPtr("(fee ?? 0) * 2"),
+			Constraints: []domain.Constraint{
+				{ID: 1, Name: "limit", Expression: "total < 100", IsActive: true},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		payload map[string]any
+		want    map[string]any
+	}{
+		{
+			name:    "Enabled",
+			payload: map[string]any{"protected": true, "fee": 5},
+			want:    map[string]any{"protected": true, "fee": int64(5), "total": 10},
+		},
+		{
+			name:    "Disabled",
+			payload: map[string]any{"protected": false},
+			want:    map[string]any{"protected": false, "fee": nil, "total": 0},
+		},
+		{
+			name:    "EnabledAgain",
+			payload: map[string]any{"protected": true, "fee": 7},
+			want:    map[string]any{"protected": true, "fee": int64(7), "total": 14},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := service.Handle(ctx, domain.VariableProcessIn{VersionID: 1, Variables: variables, Payload: tt.payload})
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.want, got, tt.name)
+	}
+
+	// variables without a version ID are not cached
+	_, err := service.Handle(ctx, domain.VariableProcessIn{Variables: variables, Payload: tests[0].payload})
+	require.NoError(t, err)
+
+	stats := cache.Stats()
+	require.Equal(t, uint64(2), stats.Hits)
+	require.Equal(t, uint64(1), stats.Misses)
+	require.Equal(t, 1, stats.Len)
+}
+
+func TestService_Handle_CachedBudget(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(2)
+	service := NewCached(cache)
+
+	in := domain.VariableProcessIn{
+		VersionID: 1,
+		Variables: []domain.Variable{
+			{ID: 1, Name: "total", Type: variable_domain.TypeInteger, Expression: lo.ToPtr("total(1000)")},
+		},
+		Functions: []function_domain.Function{{VersionID: 1, Name: "total", Params: []string{"n"}, Body: "sum(1..n)"}},
+		Budget:    100,
+	}
+
+	// the functions run within the budget of the input
+	_, err := service.Handle(ctx, in)
+	require.Equal(t, &task_domain.ProcessError{VariableErrors: []task_domain.VariableError{{ID: 1, Name: "total", Message: task_domain.MessageBudget}}}, err)
+
+	in.Budget = 0
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"total": 500500}, got)
+}
+
+func TestService_Handle_CrossVariableConstraints(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{
+				ID:      1,
+				Name:    "end_date",
+				Type:    variable_domain.TypeDate,
+				IsInput: true,
+				Constraints: []domain.Constraint{
+					{ID: 1, Name: "after_start", Expression: "end_date > start_date", IsActive: true},
+				},
+			},
+			{ID: 2, Name: "start_date", Type: variable_domain.TypeDate, IsInput: true},
+		},
+		Payload: map[string]any{"end_date": "2026-10-20", "start_date": "2026-10-18"},
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+
+	want := map[string]any{
+		"end_date":   time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC),
+		"start_date": time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+	}
+
+	require.Equal(t, want, got)
+}
+
+func TestService_Handle_Defaults(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{ID: 1, Name: "length", Type: variable_domain.TypeInteger, IsInput: true},
+			{ID: 2, Name: "width", Type: variable_domain.TypeInteger, IsInput: true, DefaultExpression: lo.ToPtr("length / 2")},
+			{ID: 3, Name: "unit", Type: variable_domain.TypeEnum, IsInput: true, Options: []string{"mm", "m"}, DefaultExpression: lo.ToPtr(`"mm"`)},
+			{ID: 4, Name: "since", Type: variable_domain.TypeDate, IsInput: true, DefaultExpression: lo.ToPtr(`"2026-01-01"`)},
+			{ID: 5, Name: "sizes", Type: variable_domain.TypeList, ItemType: lo.ToPtr(variable_domain.TypeInteger), IsInput: true, DefaultExpression: lo.ToPtr("[length, 10]")},
+			{ID: 6, Name: "note", Type: variable_domain.TypeString, IsInput: true, DefaultExpression: lo.ToPtr(`"none"`)},
+		},
+		Payload: map[string]any{"length": "100", "note": "given"},
+	}
+
+	got, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+
+	want := map[string]any{
+		"length": int64(100),
+		"width":  int64(50),
+		"unit":   "mm",
+		"since":  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		"sizes":  []any{int64(100), int64(10)},
+		"note":   "given",
+	}
+
+	require.Equal(t, want, got)
+}
+
+func TestService_Handle_Conditional(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	variables := []domain.Variable{
+		{ID: 1, Name: "protected", Type: variable_domain.TypeBoolean, IsInput: true},
+		{
+			ID:        2,
+			Name:      "protection_class",
+			Type:      variable_domain.TypeInteger,
+			IsInput:   true,
+			EnabledIf: lo.ToPtr("protected"),
+			Constraints: []domain.Constraint{
+				{ID: 1, Name: "range", Expression: "protection_class >= 1 && protection_class <= 3", IsActive: true},
+			},
+		},
+		{ID: 3, Name: "summary", Type: variable_domain.TypeString, Expression: lo.ToPtr(`protected ? "К" + string(protection_class) : "нет"`)},
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		got, err := service.Handle(ctx, domain.VariableProcessIn{
+			Variables: variables,
+			Payload:   map[string]any{"protected": "false", "protection_class": "9"},
+		})
+		require.NoError(t, err)
+
+		want := map[string]any{
+			"protected":        false,
+			"protection_class": nil,
+			"summary":          "нет",
+		}
+		require.Equal(t, want, got)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		got, err := service.Handle(ctx, domain.VariableProcessIn{
+			Variables: variables,
+			Payload:   map[string]any{"protected": "true", "protection_class": "2"},
+		})
+		require.NoError(t, err)
+
+		want := map[string]any{
+			"protected":        true,
+			"protection_class": int64(2),
+			"summary":          "К2",
+		}
+		require.Equal(t, want, got)
+	})
+
+	t.Run("EnabledMissing", func(t *testing.T) {
+		_, err := service.Handle(ctx, domain.VariableProcessIn{
+			Variables: variables,
+			Payload:   map[string]any{"protected": "true"},
+		})
+
+		var got *task_domain.ProcessError
+		require.ErrorAs(t, err, &got)
+		require.Len(t, got.VariableErrors, 1)
+		require.Equal(t, "protection_class", got.VariableErrors[0].Name)
+		require.Equal(t, task_domain.MessageVariableMissing, got.VariableErrors[0].Message)
+	})
+}
+
+func TestService_Handle_Context(t *testing.T) {
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{ID: 1, Name: "var1", Type: variable_domain.TypeInteger, Expression: lo.ToPtr("1")},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := service.Handle(ctx, in)
+	require.ErrorIs(t, err, context.Canceled)
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now())
+	defer cancel()
+
+	_, trace, err := service.HandleWithTrace(ctx, in)
+	require.Equal(t, &task_domain.ProcessError{Message: task_domain.MessageTimeout}, err)
+	require.Nil(t, trace)
+}
+
+func TestService_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	tests := []struct {
+		name string
+		in   domain.VariableProcessIn
+		want task_domain.ProcessError
+	}{
+		{
+			name: "ProcessError_Cycle",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{
+						ID:         1,
+						Name:       "var1",
+						Type:       variable_domain.TypeInteger,
+						Expression: lo.ToPtr("var2 + 10"),
+						IsInput:    false,
+					},
+					{
+						ID:         2,
+						Name:       "var2",
+						Type:       variable_domain.TypeInteger,
+						Expression: lo.ToPtr("var1 + 10"),
+						IsInput:    false,
+					},
+				},
+			},
+			want: task_domain.ProcessError{
+				Message: task_domain.MessageCycle,
+				Cycle:   []string{"var1", "var2", "var1"},
+			},
+		},
+		{
+			name: "VariableError_Compile",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{
+						ID:         1,
+						Name:       "var1",
+						Type:       variable_domain.TypeInteger,
+						Expression: lo.ToPtr("invalid1"),
+						IsInput:    false,
+					},
+					{
+						ID:         2,
+						Name:       "var2",
+						Type:       variable_domain.TypeInteger,
+						Expression: lo.ToPtr("var1 + invalid"),
+						IsInput:    false,
+					},
+				},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{
+						ID:      1,
+						Name:    "var1",
+						Message: task_domain.MessageVariableCompile,
+					},
+					{
+						ID:      2,
+						Name:    "var2",
+						Message: task_domain.MessageVariableCompile,
+					},
+				},
+			},
+		},
+		{
+			name: "VariableError_Budget",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{ID: 1, Name: "var1", Type: variable_domain.TypeInteger, Expression: lo.ToPtr("sum(1..1000)")},
+					{
+						ID:         2,
+						Name:       "var2",
+						Type:       variable_domain.TypeString,
+						Expression: lo.ToPtr(`"ok"`),
+						Constraints: []domain.Constraint{
+							{ID: 1, Name: "short", Expression: `len(repeat(var2, 1000)) < 10`, IsActive: true},
+						},
+					},
+				},
+				Budget: 100,
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Message: task_domain.MessageBudget},
+					{
+						ID:    2,
+						Name:  "var2",
+						Value: "ok",
+						ConstraintErrors: []task_domain.ConstraintError{
+							{ID: 1, Name: "short", Expression: `len(repeat(var2, 1000)) < 10`, Message: task_domain.MessageBudget, Variables: []task_domain.ConstraintVariable{{Name: "var2", Value: "ok"}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VariableError_BudgetRepeat",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{ID: 1, Name: "var1", Type: variable_domain.TypeString, Expression: lo.ToPtr(`repeat("a", 100000000)`)},
+				},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Message: task_domain.MessageBudget},
+				},
+			},
+		},
+		{
+			name: "VariableError_Parse",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{ID: 1, Name: "var1", Type: variable_domain.TypeBoolean, IsInput: true},
+					{ID: 2, Name: "var2", Type: variable_domain.TypeDate, IsInput: true},
+				},
+				Payload: map[string]any{"var1": "maybe", "var2": "18.10.2026"},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Message: task_domain.MessageVariableParse},
+					{ID: 2, Name: "var2", Message: task_domain.MessageVariableParse},
+				},
+			},
+		},
+		{
+			name: "VariableError_Missing",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{ID: 1, Name: "var1", Title: "Var 1", Type: variable_domain.TypeInteger, IsInput: true},
+					{ID: 2, Name: "var2", Type: variable_domain.TypeInteger, IsInput: true, DefaultExpression: lo.ToPtr("1")},
+				},
+				Payload: map[string]any{},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Title: "Var 1", Message: task_domain.MessageVariableMissing},
+				},
+			},
+		},
+		{
+			name: "VariableError_DefaultParse",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{ID: 1, Name: "var1", Type: variable_domain.TypeEnum, IsInput: true, Options: []string{"a"}, DefaultExpression: lo.ToPtr(`"b"`)},
+				},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Value: "b", Message: task_domain.MessageVariableOption},
+				},
+			},
+		},
+		{
+			name: "VariableError_NotFound",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{ID: 1, Name: "var1", Type: variable_domain.TypeInteger, IsInput: true},
+				},
+				Payload: map[string]any{"var1": "1", "var2": "2"},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{Name: "var2", Message: task_domain.MessageVariableNotFound},
+				},
+			},
+		},
+		{
+			name: "VariableError_ConditionCompile",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{ID: 1, Name: "var1", Type: variable_domain.TypeInteger, IsInput: true, EnabledIf: lo.ToPtr("1 + 1")},
+				},
+				Payload: map[string]any{"var1": "1"},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Message: task_domain.MessageConditionCompile},
+				},
+			},
+		},
+		{
+			name: "VariableError_Option",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{ID: 1, Name: "var1", Type: variable_domain.TypeEnum, IsInput: true, Options: []string{"a", "b"}},
+				},
+				Payload: map[string]any{"var1": "c"},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Value: "c", Message: task_domain.MessageVariableOption},
+				},
+			},
+		},
+		{
+			name: "VariableError_ListStructure",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{ID: 1, Name: "var1", Type: variable_domain.TypeList, ItemType: lo.ToPtr(variable_domain.TypeInteger), IsInput: true},
+				},
+				Payload: map[string]any{"var1": "1,2"},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Message: task_domain.MessageVariableStructure},
+				},
+			},
+		},
+		{
+			name: "VariableError_ListItem",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{ID: 1, Name: "var1", Type: variable_domain.TypeList, ItemType: lo.ToPtr(variable_domain.TypeInteger), IsInput: true},
+				},
+				Payload: map[string]any{"var1": []any{"1", "x"}},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Path: "1", Message: task_domain.MessageVariableParse},
+				},
+			},
+		},
+		{
+			name: "VariableError_TableCell",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{
+						ID:      1,
+						Name:    "var1",
+						Type:    variable_domain.TypeTable,
+						IsInput: true,
+						Columns: []domain.Column{
+							{Name: "name", Type: variable_domain.TypeString},
+							{Name: "count", Type: variable_domain.TypeInteger},
+						},
+					},
+				},
+				Payload: map[string]any{"var1": []any{map[string]any{"name": "a", "count": "x"}}},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Path: "0.count", Message: task_domain.MessageVariableParse},
+				},
+			},
+		},
+		{
+			name: "VariableError_TableColumnNotFound",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{
+						ID:      1,
+						Name:    "var1",
+						Type:    variable_domain.TypeTable,
+						IsInput: true,
+						Columns: []domain.Column{
+							{Name: "name", Type: variable_domain.TypeString},
+							{Name: "count", Type: variable_domain.TypeInteger},
+						},
+					},
+				},
+				Payload: map[string]any{"var1": []any{map[string]any{"name": "a", "count": "1", "extra": "1"}}},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Path: "0.extra", Message: task_domain.MessageColumnNotFound},
+				},
+			},
+		},
+		{
+			name: "VariableError_TableColumnMissing",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{
+						ID:      1,
+						Name:    "var1",
+						Type:    variable_domain.TypeTable,
+						IsInput: true,
+						Columns: []domain.Column{
+							{Name: "name", Type: variable_domain.TypeString},
+							{Name: "count", Type: variable_domain.TypeInteger},
+						},
+					},
+				},
+				Payload: map[string]any{"var1": []any{map[string]any{"name": "a"}}},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{ID: 1, Name: "var1", Path: "0.count", Message: task_domain.MessageColumnMissing},
+				},
+			},
+		},
+		{
+			name: "ConstraintError_Compile",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{
+						ID:      1,
+						Name:    "var1",
+						Type:    variable_domain.TypeInteger,
+						IsInput: true,
+						Constraints: []domain.Constraint{
+							{
+								ID:         1,
+								Name:       "expr1",
+								Expression: "invalid",
+								IsActive:   true,
+							},
+							{
+								ID:         2,
+								Name:       "expr2",
+								Expression: "invalid",
+								IsActive:   true,
+							},
+						},
+					},
+				},
+				Payload: map[string]any{"var1": "100"},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{
+						ID:    1,
+						Name:  "var1",
+						Value: "100",
+						ConstraintErrors: []task_domain.ConstraintError{
+							{
+								ID:         1,
+								Name:       "expr1",
+								Expression: "invalid",
+								Message:    task_domain.MessageConstraintCompile,
+							},
+							{
+								ID:         2,
+								Name:       "expr2",
+								Expression: "invalid",
+								Message:    task_domain.MessageConstraintCompile,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ConstraintError_Check",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{
+						ID:      1,
+						Name:    "var1",
+						Type:    variable_domain.TypeInteger,
+						IsInput: true,
+						Constraints: []domain.Constraint{
+							{
+								ID:         1,
+								Name:       "expr1",
+								Expression: "var1 > 200",
+								IsActive:   true,
+							},
+							{
+								ID:         2,
+								Name:       "expr2",
+								Expression: "var1 > 300",
+								IsActive:   false,
+							},
+							{
+								ID:         3,
+								Name:       "expr3",
+								Expression: "var1 > 400",
+								IsActive:   true,
+							},
+						},
+					},
+				},
+				Payload: map[string]any{"var1": "100"},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{
+						ID:    1,
+						Name:  "var1",
+						Value: "100",
+						ConstraintErrors: []task_domain.ConstraintError{
+							{
+								ID:         1,
+								Name:       "expr1",
+								Expression: "var1 > 200",
+								Message:    task_domain.MessageConstraintCheck,
+								Variables:  []task_domain.ConstraintVariable{{Name: "var1", Value: "100"}},
+							},
+							{
+								ID:         3,
+								Name:       "expr3",
+								Expression: "var1 > 400",
+								Message:    task_domain.MessageConstraintCheck,
+								Variables:  []task_domain.ConstraintVariable{{Name: "var1", Value: "100"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ConstraintError_CrossVariable",
+			in: domain.VariableProcessIn{
+				Variables: []domain.Variable{
+					{
+						ID:      1,
+						Name:    "width_mm",
+						Type:    variable_domain.TypeInteger,
+						IsInput: true,
+						Constraints: []domain.Constraint{
+							{ID: 1, Name: "fits", Expression: "width_mm <= length_mm", IsActive: true},
+						},
+					},
+					{ID: 2, Name: "length_mm", Type: variable_domain.TypeInteger, Expression: lo.ToPtr("base_mm * 2")},
+					{ID: 3, Name: "base_mm", Type: variable_domain.TypeInteger, IsInput: true},
+				},
+				Payload: map[string]any{"width_mm": "300", "base_mm": "100"},
+			},
+			want: task_domain.ProcessError{
+				VariableErrors: []task_domain.VariableError{
+					{
+						ID:    1,
+						Name:  "width_mm",
+						Value: "300",
+						ConstraintErrors: []task_domain.ConstraintError{
+							{
+								ID:         1,
+								Name:       "fits",
+								Expression: "width_mm <= length_mm",
+								Message:    task_domain.MessageConstraintCheck,
+								Variables: []task_domain.ConstraintVariable{
+									{Name: "length_mm", Value: "200"},
+									{Name: "width_mm", Value: "300"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Handle(ctx, tt.in)
+
+			var got *task_domain.ProcessError
+			require.ErrorAs(t, err, &got)
+			require.Equal(t, tt.want, *got)
+		})
+	}
+}
+
+func TestService_HandleWithTrace_Success(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{ID: 1, Name: "a", Type: variable_domain.TypeInteger, IsInput: true},
+			{ID: 2, Name: "b", Type: variable_domain.TypeInteger, IsInput: true, DefaultExpression: lo.ToPtr("a * 10")},
+			{
+				ID:         3,
+				Name:       "c",
+				Type:       variable_domain.TypeInteger,
+				Expression: lo.ToPtr("a + b"),
+				Constraints: []domain.Constraint{
+					{ID: 1, Name: "positive", Expression: "c > 0", IsActive: true},
+					{ID: 2, Name: "inactive", Expression: "c > 1000", IsActive: false},
+				},
+			},
+			{ID: 4, Name: "d", Type: variable_domain.TypeString, Expression: lo.ToPtr(`"big"`), EnabledIf: lo.ToPtr("a > 5")},
+		},
+		Payload: map[string]any{"a": "2"},
+	}
+
+	got, gotTrace, err := service.HandleWithTrace(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"a": int64(2), "b": int64(20), "c": 22, "d": nil}, got)
+
+	want := []task_domain.VariableTrace{
+		{
+			Order:  1,
+			Name:   "a",
+			Source: task_domain.TraceSourcePayload,
+			Value:  "2",
+			Type:   "int64",
+		},
+		{
+			Order:        2,
+			Name:         "b",
+			Source:       task_domain.TraceSourceDefault,
+			Expression:   "a * 10",
+			Dependencies: []task_domain.TraceDependency{{Name: "a", Value: "2", Type: "int64"}},
+			Value:        "20",
+			Type:         "int64",
+		},
+		{
+			Order:      3,
+			Name:       "c",
+			Source:     task_domain.TraceSourceExpression,
+			Expression: "a + b",
+			Dependencies: []task_domain.TraceDependency{
+				{Name: "a", Value: "2", Type: "int64"},
+				{Name: "b", Value: "20", Type: "int64"},
+			},
+			Value:       "22",
+			Type:        "int",
+			Constraints: []task_domain.ConstraintTrace{{Name: "positive", Expression: "c > 0", Passed: true}},
+		},
+		{
+			Order:        4,
+			Name:         "d",
+			Source:       task_domain.TraceSourceDisabled,
+			Condition:    "a > 5",
+			Dependencies: []task_domain.TraceDependency{{Name: "a", Value: "2", Type: "int64"}},
+		},
+	}
+	require.Equal(t, want, gotTrace)
+}
+
+func TestService_HandleWithTrace_Error(t *testing.T) {
+	ctx := context.Background()
+	service := New()
+
+	in := domain.VariableProcessIn{
+		Variables: []domain.Variable{
+			{ID: 1, Name: "a", Type: variable_domain.TypeInteger, IsInput: true},
+			{
+				ID:         2,
+				Name:       "b",
+				Type:       variable_domain.TypeInteger,
+				Expression: lo.ToPtr("a * 2"),
+				Constraints: []domain.Constraint{
+					{ID: 1, Name: "big", Expression: "b > 100", IsActive: true},
+				},
+			},
+			{ID: 3, Name: "c", Type: variable_domain.TypeString, IsInput: true},
+		},
+		Payload: map[string]any{"a": "2"},
+	}
+
+	got, gotTrace, err := service.HandleWithTrace(ctx, in)
+	require.Nil(t, got)
+
+	var processErr *task_domain.ProcessError
+	require.ErrorAs(t, err, &processErr)
+	require.Len(t, processErr.VariableErrors, 2)
+
+	want := []task_domain.VariableTrace{
+		{
+			Order:  1,
+			Name:   "a",
+			Source: task_domain.TraceSourcePayload,
+			Value:  "2",
+			Type:   "int64",
+		},
+		{
+			Order:        2,
+			Name:         "b",
+			Source:       task_domain.TraceSourceExpression,
+			Expression:   "a * 2",
+			Dependencies: []task_domain.TraceDependency{{Name: "a", Value: "2", Type: "int64"}},
+			Value:        "4",
+			Type:         "int",
+			Constraints: []task_domain.ConstraintTrace{
+				{Name: "big", Expression: "b > 100", Passed: false, Message: task_domain.MessageConstraintCheck},
+			},
+		},
+		{
+			Order:   3,
+			Name:    "c",
+			Message: task_domain.MessageVariableMissing,
+		},
+	}
+	require.Equal(t, want, gotTrace)
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/samber/lo"
+
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/expression"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/lru"
+	"github.com/qsoulior/tech-generator/backend/internal/service/variable_process/domain"
+)
+
+// Cache keeps compiled versions by version ID, the versions of the project
+// functions they were compiled with and the budget the functions run within.
+// Versions and functions are immutable once created, so a compiled version is
+// valid for as long as it is cached.
+type Cache = lru.Cache[cacheKey, *compiledVersion]
+
+func NewCache(size int) *Cache {
+	return lru.New[cacheKey, *compiledVersion](size)
+}
+
+type cacheKey struct {
+	versionID int64
+	// functions lists the version IDs of the project functions
+	functions string
+	// budget is baked into the function options
+	budget uint
+}
+
+func newCacheKey(in domain.VariableProcessIn) cacheKey {
+	ids := lo.Map(in.Functions, func(f function_domain.Function, _ int) string { return strconv.FormatInt(f.VersionID, 10) })
+	slices.Sort(ids)
+	return cacheKey{versionID: in.VersionID, functions: strings.Join(ids, ","), budget: in.Budget}
+}
+
+// compiledVersion is what processing derives from the variables of a version
+// and the project functions: the dependency graph, the evaluation order, the
+// function options and the compiled programs.
+type compiledVersion struct {
+	dependencies map[string][]string
+	order        []string
+	orderErr     error
+
+	functions    []expr.Option
+	functionsErr error
+
+	mu       sync.Mutex
+	programs map[programKey]compiledProgram
+}
+
+// programKey identifies a compiled program. Programs are type checked against
+// the values they refer to, so the key includes the types of the values.
+type programKey struct {
+	source     string
+	constraint bool
+	types      string
+}
+
+// evaluator compiles expressions with a compiled version and runs them within
+// the budget of a single processing run.
+type evaluator struct {
+	version *compiledVersion
+	budget  uint
+}
+
+func (e evaluator) compile(name string, source string, env map[string]any) (*vm.Program, error) {
+	return e.version.compile(name, source, env)
+}
+
+func (e evaluator) compileConstraint(name string, source string, env map[string]any) (*vm.Program, error) {
+	return e.version.compileConstraint(name, source, env)
+}
+
+func (e evaluator) run(program *vm.Program, env map[string]any) (any, error) {
+	return expression.Run(program, env, e.budget)
+}
+
+type compiledProgram struct {
+	program *vm.Program
+	err     error
+}
+
+func newCompiledVersion(in domain.VariableProcessIn) *compiledVersion {
+	dependencies := in.Dependencies
+	if dependencies == nil {
+		variablesByName := lo.KeyBy(in.Variables, func(v domain.Variable) string { return v.Name })
+		dependencies = buildDependencies(variablesByName)
+	}
+
+	order, err := expression.SortDependencies(dependencies)
+	functions, functionsErr := expression.FunctionOptions(in.Functions, in.Budget)
+
+	return &compiledVersion{
+		dependencies: dependencies,
+		order:        order,
+		orderErr:     err,
+		functions:    functions,
+		functionsErr: functionsErr,
+		programs:     make(map[programKey]compiledProgram),
+	}
+}
+
+// compile compiles a variable expression of the named variable. Failed
+// compilations are kept as well, since they fail the same way every time.
+func (v *compiledVersion) compile(name string, source string, env map[string]any) (*vm.Program, error) {
+	return v.compileProgram(name, source, env, false)
+}
+
+// compileConstraint compiles a constraint or a condition of the named
+// variable.
+func (v *compiledVersion) compileConstraint(name string, source string, env map[string]any) (*vm.Program, error) {
+	return v.compileProgram(name, source, env, true)
+}
+
+func (v *compiledVersion) compileProgram(name string, source string, env map[string]any, constraint bool) (*vm.Program, error) {
+	key := programKey{source: source, constraint: constraint, types: v.types(name, env)}
+
+	v.mu.Lock()
+	compiled, ok := v.programs[key]
+	v.mu.Unlock()
+
+	if ok {
+		return compiled.program, compiled.err
+	}
+
+	if constraint {
+		compiled.program, compiled.err = expression.CompileConstraint(source, env, v.functions...)
+	} else {
+		compiled.program, compiled.err = expression.Compile(source, env, v.functions...)
+	}
+
+	v.mu.Lock()
+	v.programs[key] = compiled
+	v.mu.Unlock()
+
+	return compiled.program, compiled.err
+}
+
+// types describes the types of the values the expressions of the named
+// variable may refer to: its dependencies and the variable itself. A name
+// missing from env is described apart from a nil value.
+func (v *compiledVersion) types(name string, env map[string]any) string {
+	var b strings.Builder
+
+	for _, n := range v.dependencies[name] {
+		writeType(&b, env, n)
+	}
+	writeType(&b, env, name)
+
+	return b.String()
+}
+
+func writeType(b *strings.Builder, env map[string]any, name string) {
+	value, ok := env[name]
+	if !ok {
+		b.WriteString("-;")
+		return
+	}
+
+	fmt.Fprintf(b, "%T;", value)
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/docxtemplate"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/expression"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/templatefunc"
+)
+
+// validateData checks that the placeholders of a Word template parse and read
+// only the variables of the version and the project constants. Markdown
+// templates are checked at render time.
+func validateData(data []byte, variables []Variable) error {
+	if template_domain.DetectKind(data) != template_domain.KindDOCX {
+		return nil
+	}
+
+	tmpl, err := docxtemplate.Parse(data, templatefunc.Funcs, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValueInvalid, err)
+	}
+
+	names := lo.SliceToMap(variables, func(v Variable) (string, struct{}) { return v.Name, struct{}{} })
+	names[expression.ProjectNamespace] = struct{}{}
+
+	for _, field := range tmpl.Fields() {
+		if _, ok := names[field]; !ok {
+			return fmt.Errorf("%w: unknown variable %q", ErrValueInvalid, field)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+)
+
+func document(t *testing.T, body string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	f, err := w.Create("word/document.xml")
+	require.NoError(t, err)
+	_, err = f.Write([]byte(`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` + body + `</w:body></w:document>`))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func TestVersionCreateIn_Validate_Data(t *testing.T) {
+	variables := []Variable{
+		{Name: "customer", Title: "Customer", Type: variable_domain.TypeString, IsInput: true},
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr string
+	}{
+		{
+			name: "Markdown",
+			data: []byte("{{ .unknown }"),
+		},
+		{
+			name: "Valid",
+			data: document(t, `<w:p><w:r><w:t>{{ .cust</w:t></w:r><w:r><w:t>omer }} {{ .project.org_name }}</w:t></w:r></w:p>`+
+				`<w:p><w:r><w:t>{{ range $.project.items }}{{ .title }}{{ end }}</w:t></w:r></w:p>`),
+		},
+		{
+			name:    "ParseError",
+			data:    document(t, `<w:p><w:r><w:t>{{ if .customer }}</w:t></w:r></w:p>`),
+			wantErr: "paragraph 1",
+		},
+		{
+			name:    "UnknownVariable",
+			data:    document(t, `<w:p><w:r><w:t>{{ .customer }} {{ .contract }}</w:t></w:r></w:p>`),
+			wantErr: `unknown variable "contract"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := VersionCreateIn{Data: tt.data, Variables: variables}
+
+			err := in.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			var validationErr *error_domain.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			require.Equal(t, "data", validationErr.Field)
+			require.ErrorIs(t, err, ErrValueInvalid)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package domain
+
+import dictionary_list_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list/domain"
+
+type DictionaryListIn = dictionary_list_domain.DictionaryListIn
@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/expression"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/unit"
+)
+
+var (
+	ErrExpressionInvalid = errors.New("expression is invalid")
+	ErrDependencyCycle   = errors.New("dependencies contain a cycle")
+)
+
+// ValidateExpressions compiles every variable expression and constraint the
+// same way the worker does, checks their units and checks variable
+// dependencies for cycles. Expressions may call the project functions, look
+// up the project dictionaries, which enum options may also come from, and read
+// the project constants, whichever they are at render time.
+// Unlike Validate, it reports all invalid fields at once.
+func (in VersionCreateIn) ValidateExpressions(functions []function_domain.Function, dictionaries []dictionary_domain.Dictionary) error {
+	options, err := expression.FunctionOptions(functions, 0)
+	if err != nil {
+		// nothing compiles without the functions the expressions may call
+		err = fmt.Errorf("%w: project functions: %w", ErrExpressionInvalid, err)
+		return error_domain.ValidationErrors{error_domain.NewValidationError("variables", err)}
+	}
+
+	var errs error_domain.ValidationErrors
+
+	env := expression.Globals(time.Time{}, dictionaries)
+	env[expression.ProjectNamespace] = expression.ProjectConstants(nil)
+	units := expression.ConstantUnits()
+	for _, v := range in.Variables {
+		env[v.Name] = expression.ZeroValue(v.Type)
+		delete(units, v.Name)
+		if u, err := unit.Parse(lo.FromPtr(v.Unit)); err == nil {
+			units[v.Name] = u
+		}
+	}
+
+	for i, v := range in.Variables {
+		if v.OptionsFrom != nil {
+			field := fmt.Sprintf("variables.%d.optionsFrom", i)
+			if err := checkOptionsFrom(*v.OptionsFrom, dictionaries); err != nil {
+				errs = append(errs, error_domain.NewValidationError(field, fmt.Errorf("%w: %w", ErrValueInvalid, err)))
+			}
+		}
+
+		if !v.IsInput {
+			field := fmt.Sprintf("variables.%d.expression", i)
+
+			if lo.FromPtr(v.Expression) == "" {
+				errs = append(errs, error_domain.NewValidationError(field, ErrValueEmpty))
+			} else if _, err := expression.Compile(*v.Expression, env, options...); err != nil {
+				errs = append(errs, error_domain.NewValidationError(field, fmt.Errorf("%w: %w", ErrExpressionInvalid, err)))
+			} else if err := checkExpression(*v.Expression, units, v.Name, dictionaries); err != nil {
+				errs = append(errs, error_domain.NewValidationError(field, fmt.Errorf("%w: %w", ErrExpressionInvalid, err)))
+			}
+		}
+
+		if v.EnabledIf != nil {
+			field := fmt.Sprintf("variables.%d.enabledIf", i)
+			if _, err := expression.CompileConstraint(*v.EnabledIf, env, options...); err != nil {
+				errs = append(errs, error_domain.NewValidationError(field, fmt.Errorf("%w: %w", ErrExpressionInvalid, err)))
+			} else if err := checkExpression(*v.EnabledIf, units, "", dictionaries); err != nil {
+				errs = append(errs, error_domain.NewValidationError(field, fmt.Errorf("%w: %w", ErrExpressionInvalid, err)))
+			}
+		}
+
+		if v.DefaultExpression != nil {
+			field := fmt.Sprintf("variables.%d.defaultExpression", i)
+			if _, err := expression.Compile(*v.DefaultExpression, env, options...); err != nil {
+				errs = append(errs, error_domain.NewValidationError(field, fmt.Errorf("%w: %w", ErrExpressionInvalid, err)))
+			} else if err := checkExpression(*v.DefaultExpression, units, v.Name, dictionaries); err != nil {
+				errs = append(errs, error_domain.NewValidationError(field, fmt.Errorf("%w: %w", ErrExpressionInvalid, err)))
+			}
+		}
+
+		for j, c := range v.Constraints {
+			field := fmt.Sprintf("variables.%d.constraints.%d.expression", i, j)
+			if _, err := expression.CompileConstraint(c.Expression, env, options...); err != nil {
+				errs = append(errs, error_domain.NewValidationError(field, fmt.Errorf("%w: %w", ErrExpressionInvalid, err)))
+			} else if err := checkExpression(c.Expression, units, "", dictionaries); err != nil {
+				errs = append(errs, error_domain.NewValidationError(field, fmt.Errorf("%w: %w", ErrExpressionInvalid, err)))
+			}
+		}
+	}
+
+	if _, err := expression.SortDependencies(in.Dependencies()); err != nil {
+		var cycleErr *expression.CycleError
+		if errors.As(err, &cycleErr) {
+			err = fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(cycleErr.Path, " → "))
+		}
+		errs = append(errs, error_domain.NewValidationError("variables", err))
+	}
+
+	if len(errs) != 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// checkExpression checks the dictionary lookups and the units of an
+// expression, see checkUnits.
+func checkExpression(source string, units map[string]unit.Unit, name string, dictionaries []dictionary_domain.Dictionary) error {
+	if err := expression.CheckLookups(source, dictionaries); err != nil {
+		return err
+	}
+
+	return checkUnits(source, units, name)
+}
+
+// checkOptionsFrom checks that the dictionary column enum options come from
+// exists.
+func checkOptionsFrom(optionsFrom string, dictionaries []dictionary_domain.Dictionary) error {
+	name, column, _ := dictionary_domain.SplitReference(optionsFrom)
+
+	dictionary, ok := dictionary_domain.Find(dictionaries, name)
+	if !ok {
+		return fmt.Errorf("%w: %s", expression.ErrDictionaryNotFound, name)
+	}
+
+	if _, ok := dictionary.Column(column); !ok {
+		return fmt.Errorf("%w: %s", expression.ErrColumnNotFound, optionsFrom)
+	}
+
+	return nil
+}
+
+// checkUnits checks the units of an expression and, if the named variable
+// declares a unit, that the result has that unit.
+func checkUnits(source string, units map[string]unit.Unit, name string) error {
+	got, known, err := expression.CheckUnits(source, units)
+	if err != nil {
+		return err
+	}
+
+	if want, ok := units[name]; ok && known && !got.Equivalent(want) {
+		return fmt.Errorf("%w: result is %s, not %s", expression.ErrUnitMismatch, got, want)
+	}
+
+	return nil
+}
+
+// Dependencies returns the variable dependency graph: for every variable, the
+// names of the variables its expression (the default one for inputs),
+// condition and constraints refer to.
+func (in VersionCreateIn) Dependencies() map[string][]string {
+	names := lo.Map(in.Variables, func(v Variable, _ int) string { return v.Name })
+
+	dependencies := make(map[string][]string, len(in.Variables))
+	for _, v := range in.Variables {
+		source := v.Expression
+		if v.IsInput {
+			source = v.DefaultExpression
+		}
+
+		constraints := lo.Map(v.Constraints, func(c Constraint, _ int) string { return c.Expression })
+		sources := []string{lo.FromPtr(source), lo.FromPtr(v.EnabledIf)}
+		dependencies[v.Name] = expression.VariableDependencies(v.Name, sources, constraints, names)
+	}
+
+	return dependencies
+}
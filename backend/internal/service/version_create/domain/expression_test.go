@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/expression"
+)
+
+func TestVersionCreateIn_ValidateExpressions(t *testing.T) {
+	in := VersionCreateIn{
+		Variables: []Variable{
+			{Name: "a", Title: "A", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("a +")},
+			{Name: "b", Title: "B", Type: variable_domain.TypeString, IsInput: true, Constraints: []Constraint{
+				{Name: "ok", Expression: "len(b) > 0", IsActive: true},
+				{Name: "bad", Expression: "b > ", IsActive: false},
+			}},
+			{Name: "c", Title: "C", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("d + 1")},
+			{Name: "d", Title: "D", Type: variable_domain.TypeFloat, Expression: lo.ToPtr(`c + len("d")`)},
+		},
+	}
+
+	err := in.ValidateExpressions(nil, nil)
+
+	var errs error_domain.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 3)
+	require.Equal(t, "variables.0.expression", errs[0].Field)
+	require.Equal(t, "variables.1.constraints.1.expression", errs[1].Field)
+	require.Equal(t, "variables", errs[2].Field)
+	require.ErrorIs(t, errs[2], ErrDependencyCycle)
+	require.ErrorContains(t, errs[2], "c → d → c")
+}
+
+func TestVersionCreateIn_Dependencies(t *testing.T) {
+	in := VersionCreateIn{
+		Variables: []Variable{
+			{Name: "width", Title: "Width", Type: variable_domain.TypeInteger, IsInput: true, Constraints: []Constraint{
+				{Name: "fits", Expression: "width <= length", IsActive: true},
+			}},
+			{Name: "length", Title: "Length", Type: variable_domain.TypeInteger, Expression: lo.ToPtr("base * 2")},
+			{Name: "base", Title: "Base", Type: variable_domain.TypeInteger, IsInput: true, DefaultExpression: lo.ToPtr("offset + 10")},
+			{Name: "offset", Title: "Offset", Type: variable_domain.TypeInteger, IsInput: true, EnabledIf: lo.ToPtr("shifted")},
+			{Name: "shifted", Title: "Shifted", Type: variable_domain.TypeBoolean, IsInput: true},
+			{Name: "due", Title: "Due", Type: variable_domain.TypeString, Expression: lo.ToPtr("formatDate(addDays(now(), base))")},
+		},
+	}
+
+	require.NoError(t, in.ValidateExpressions(nil, nil))
+
+	want := map[string][]string{
+		"width":   {"length"},
+		"length":  {"base"},
+		"base":    {"offset"},
+		"offset":  {"shifted"},
+		"shifted": {},
+		"due":     {"base"},
+	}
+	require.Equal(t, want, in.Dependencies())
+}
+
+func TestVersionCreateIn_ValidateExpressions_Units(t *testing.T) {
+	in := VersionCreateIn{
+		Variables: []Variable{
+			{Name: "length_mm", Title: "Length", Type: variable_domain.TypeInteger, IsInput: true, Unit: lo.ToPtr("mm")},
+			{Name: "width_m", Title: "Width", Type: variable_domain.TypeFloat, IsInput: true, Unit: lo.ToPtr("m"), Constraints: []Constraint{
+				{Name: "fits", Expression: "width_m <= convert(length_mm, \"mm\", \"m\")", IsActive: true},
+				{Name: "mixed", Expression: "width_m <= length_mm", IsActive: true},
+			}},
+			{Name: "mass", Title: "Mass", Type: variable_domain.TypeDecimal, IsInput: true, Unit: lo.ToPtr("kg")},
+			{Name: "surface", Title: "Surface", Type: variable_domain.TypeFloat, Unit: lo.ToPtr("м²"), Expression: lo.ToPtr(`convert(length_mm, "mm", "m") * width_m`)},
+			{Name: "weight", Title: "Weight", Type: variable_domain.TypeDecimal, Unit: lo.ToPtr("N"), Expression: lo.ToPtr("mass * g")},
+			{Name: "total", Title: "Total", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("width_m + mass")},
+			{Name: "area", Title: "Area", Type: variable_domain.TypeFloat, Unit: lo.ToPtr("m2"), Expression: lo.ToPtr("length_mm * width_m")},
+		},
+	}
+
+	err := in.ValidateExpressions(nil, nil)
+
+	var errs error_domain.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 3)
+	require.Equal(t, "variables.1.constraints.1.expression", errs[0].Field)
+	require.ErrorContains(t, errs[0], "cannot compare м and мм")
+	require.Equal(t, "variables.5.expression", errs[1].Field)
+	require.ErrorContains(t, errs[1], "cannot add м and кг")
+	require.Equal(t, "variables.6.expression", errs[2].Field)
+	require.ErrorContains(t, errs[2], "result is мм·м, not м²")
+	for _, err := range errs {
+		require.ErrorIs(t, err, expression.ErrUnitMismatch)
+	}
+}
+
+func TestVersionCreateIn_ValidateExpressions_Functions(t *testing.T) {
+	functions := []function_domain.Function{
+		{Name: "tolerance", Params: []string{"x", "tol"}, Body: `x * tol / 100`},
+	}
+
+	in := VersionCreateIn{
+		Variables: []Variable{
+			{Name: "size", Title: "Size", Type: variable_domain.TypeFloat, IsInput: true, Constraints: []Constraint{
+				{Name: "tolerance", Expression: "tolerance(size, 5) < 1", IsActive: true},
+			}},
+			{Name: "delta", Title: "Delta", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("tolerance(size, 5)")},
+			{Name: "arity", Title: "Arity", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("tolerance(size)")},
+		},
+	}
+
+	err := in.ValidateExpressions(functions, nil)
+
+	var errs error_domain.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 1)
+	require.Equal(t, "variables.2.expression", errs[0].Field)
+	require.ErrorContains(t, errs[0], "not enough arguments")
+
+	in.Variables = in.Variables[:2]
+	require.NoError(t, in.ValidateExpressions(functions, nil))
+
+	err = in.ValidateExpressions(nil, nil)
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 2)
+}
+
+func TestVersionCreateIn_ValidateExpressions_FunctionsInvalid(t *testing.T) {
+	functions := []function_domain.Function{
+		{Name: "half", Params: []string{"x"}, Body: `x /`},
+	}
+
+	in := VersionCreateIn{
+		Variables: []Variable{
+			{Name: "a", Title: "A", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("half(2)")},
+		},
+	}
+
+	err := in.ValidateExpressions(functions, nil)
+
+	var errs error_domain.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 1)
+	require.Equal(t, "variables", errs[0].Field)
+	require.ErrorIs(t, errs[0], ErrExpressionInvalid)
+	require.ErrorContains(t, errs[0], "half")
+}
+
+func TestVersionCreateIn_ValidateExpressions_Dictionaries(t *testing.T) {
+	dictionaries := []dictionary_domain.Dictionary{
+		{Name: "materials", Columns: []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}}},
+	}
+
+	in := VersionCreateIn{
+		Variables: []Variable{
+			{Name: "material", Title: "Material", Type: variable_domain.TypeEnum, IsInput: true, OptionsFrom: lo.ToPtr("materials.key")},
+			{Name: "density", Title: "Density", Type: variable_domain.TypeFloat, Expression: lo.ToPtr(`lookup("materials", material, "density")`)},
+			{Name: "grade", Title: "Grade", Type: variable_domain.TypeEnum, IsInput: true, OptionsFrom: lo.ToPtr("materials.grade")},
+			{Name: "color", Title: "Color", Type: variable_domain.TypeString, Expression: lo.ToPtr(`lookup("colors", material, "title")`)},
+		},
+	}
+
+	err := in.ValidateExpressions(nil, dictionaries)
+
+	var errs error_domain.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 2)
+	require.Equal(t, "variables.2.optionsFrom", errs[0].Field)
+	require.ErrorIs(t, errs[0], expression.ErrColumnNotFound)
+	require.Equal(t, "variables.3.expression", errs[1].Field)
+	require.ErrorIs(t, errs[1], expression.ErrDictionaryNotFound)
+
+	in.Variables = in.Variables[:2]
+	require.NoError(t, in.ValidateExpressions(nil, dictionaries))
+}
+
+func TestVersionCreateIn_ValidateExpressions_Constants(t *testing.T) {
+	in := VersionCreateIn{
+		Variables: []Variable{
+			{Name: "number", Title: "Number", Type: variable_domain.TypeString, IsInput: true},
+			{Name: "title", Title: "Title", Type: variable_domain.TypeString, Expression: lo.ToPtr(`project.org_name + " № " + number`)},
+		},
+	}
+	require.NoError(t, in.ValidateExpressions(nil, nil))
+
+	// a variable shadows the namespace
+	in.Variables = append(in.Variables, Variable{Name: "project", Title: "Project", Type: variable_domain.TypeInteger, IsInput: true})
+	err := in.ValidateExpressions(nil, nil)
+
+	var errs error_domain.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 1)
+	require.Equal(t, "variables.1.expression", errs[0].Field)
+}
@@ -0,0 +1,6 @@
+package domain
+
+type VersionFunctionToCreate struct {
+	VersionID         int64
+	FunctionVersionID int64
+}
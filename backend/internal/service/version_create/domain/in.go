@@ -6,12 +6,16 @@ import (
 	"regexp"
 	"unicode/utf8"
 
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/unit"
 )
 
 var (
 	ErrValueInvalid       = errors.New("value is invalid")
 	ErrValueEmpty         = errors.New("value is empty")
+	ErrValueDuplicate     = errors.New("value is duplicate")
 	ErrVariableIDsInvalid = errors.New("variable ids length is invalid")
 )
 
@@ -30,22 +34,223 @@ type VersionCreateIn struct {
 }
 
 func (in VersionCreateIn) Validate() error {
-	for i, v := range in.Variables {
+	if err := ValidateVariables("variables", in.Variables); err != nil {
+		return err
+	}
+
+	if err := validateData(in.Data, in.Variables); err != nil {
+		return error_domain.NewValidationError("data", err)
+	}
+
+	return nil
+}
+
+// ValidateVariables checks the variables of a version, reporting invalid
+// fields under the given prefix.
+func ValidateVariables(field string, variables []Variable) error {
+	for i, v := range variables {
 		if !v.Type.Valid() {
-			return error_domain.NewValidationError(fmt.Sprintf("variables.%d.type", i), ErrValueInvalid)
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.type", field, i), ErrValueInvalid)
 		}
 
 		if v.Name == "" || len(v.Name) > slugMaxLen || !slugRegexp.MatchString(v.Name) {
-			return error_domain.NewValidationError(fmt.Sprintf("variables.%d.name", i), ErrValueInvalid)
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.name", field, i), ErrValueInvalid)
 		}
 
 		if v.Title == "" {
-			return error_domain.NewValidationError(fmt.Sprintf("variables.%d.title", i), ErrValueEmpty)
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.title", field, i), ErrValueEmpty)
 		}
 
 		if utf8.RuneCountInString(v.Title) > titleMaxLen {
-			return error_domain.NewValidationError(fmt.Sprintf("variables.%d.title", i), ErrValueInvalid)
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.title", field, i), ErrValueInvalid)
+		}
+
+		if err := validateDefaultExpression(v.IsInput, v.DefaultExpression); err != nil {
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.defaultExpression", field, i), err)
+		}
+
+		if v.EnabledIf != nil && *v.EnabledIf == "" {
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.enabledIf", field, i), ErrValueEmpty)
+		}
+
+		if err := validateUnit(v.Type, v.Unit); err != nil {
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.unit", field, i), err)
+		}
+
+		if err := validateItemType(v.Type, v.ItemType); err != nil {
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.itemType", field, i), err)
+		}
+
+		optionsType := v.Type
+		if v.ItemType != nil {
+			optionsType = *v.ItemType
+		}
+
+		if v.OptionsFrom != nil {
+			if err := validateOptionsFrom(optionsType, v.Options, *v.OptionsFrom); err != nil {
+				return error_domain.NewValidationError(fmt.Sprintf("%s.%d.optionsFrom", field, i), err)
+			}
+		} else if err := validateOptions(optionsType, v.Options); err != nil {
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.options", field, i), err)
+		}
+
+		if err := validateColumns(fmt.Sprintf("%s.%d.columns", field, i), v.Type, v.Columns); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateDefaultExpression checks that only input variables declare a default
+// and that a declared default is not empty. Inputs with a default are optional.
+func validateDefaultExpression(isInput bool, defaultExpression *string) error {
+	if defaultExpression == nil {
+		return nil
+	}
+
+	if !isInput {
+		return ErrValueInvalid
+	}
+
+	if *defaultExpression == "" {
+		return ErrValueEmpty
+	}
+
+	return nil
+}
+
+// validateUnit checks that only numeric variables declare a unit and that a
+// declared unit is known.
+func validateUnit(typ variable_domain.Type, u *string) error {
+	if u == nil {
+		return nil
+	}
+
+	if !typ.Numeric() {
+		return ErrValueInvalid
+	}
+
+	if *u == "" {
+		return ErrValueEmpty
+	}
+
+	if _, err := unit.Parse(*u); err != nil {
+		return ErrValueInvalid
+	}
+
+	return nil
+}
+
+// validateItemType checks that list variables declare a scalar item type and
+// that other types declare none.
+func validateItemType(typ variable_domain.Type, itemType *variable_domain.Type) error {
+	if typ != variable_domain.TypeList {
+		if itemType != nil {
+			return ErrValueInvalid
 		}
+		return nil
+	}
+
+	if itemType == nil {
+		return ErrValueEmpty
+	}
+
+	if !itemType.Scalar() {
+		return ErrValueInvalid
+	}
+
+	return nil
+}
+
+// validateColumns checks that table variables declare a non-empty list of
+// uniquely named scalar columns and that other types declare none.
+func validateColumns(field string, typ variable_domain.Type, columns []Column) error {
+	if typ != variable_domain.TypeTable {
+		if len(columns) != 0 {
+			return error_domain.NewValidationError(field, ErrValueInvalid)
+		}
+		return nil
+	}
+
+	if len(columns) == 0 {
+		return error_domain.NewValidationError(field, ErrValueEmpty)
+	}
+
+	seen := make(map[string]struct{}, len(columns))
+	for i, c := range columns {
+		if c.Name == "" || len(c.Name) > slugMaxLen || !slugRegexp.MatchString(c.Name) {
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.name", field, i), ErrValueInvalid)
+		}
+
+		if _, ok := seen[c.Name]; ok {
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.name", field, i), ErrValueDuplicate)
+		}
+		seen[c.Name] = struct{}{}
+
+		if c.Title == "" {
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.title", field, i), ErrValueEmpty)
+		}
+
+		if utf8.RuneCountInString(c.Title) > titleMaxLen {
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.title", field, i), ErrValueInvalid)
+		}
+
+		if !c.Type.Scalar() {
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.type", field, i), ErrValueInvalid)
+		}
+
+		if err := validateOptions(c.Type, c.Options); err != nil {
+			return error_domain.NewValidationError(fmt.Sprintf("%s.%d.options", field, i), err)
+		}
+	}
+
+	return nil
+}
+
+// validateOptions checks that enum variables (or lists of enums) carry a non-empty list of unique
+// options and that other types carry none.
+func validateOptions(typ variable_domain.Type, options []string) error {
+	if typ != variable_domain.TypeEnum {
+		if len(options) != 0 {
+			return ErrValueInvalid
+		}
+		return nil
+	}
+
+	if len(options) == 0 {
+		return ErrValueEmpty
+	}
+
+	seen := make(map[string]struct{}, len(options))
+	for _, option := range options {
+		if option == "" {
+			return ErrValueEmpty
+		}
+
+		if _, ok := seen[option]; ok {
+			return ErrValueDuplicate
+		}
+		seen[option] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateOptionsFrom checks that an enum taking its options from a dictionary
+// column refers to it as "dictionary.column" and carries no options of its
+// own. Whether the column exists is checked by ValidateExpressions.
+func validateOptionsFrom(typ variable_domain.Type, options []string, optionsFrom string) error {
+	if typ != variable_domain.TypeEnum || len(options) != 0 {
+		return ErrValueInvalid
+	}
+
+	if optionsFrom == "" {
+		return ErrValueEmpty
+	}
+
+	if _, _, ok := dictionary_domain.SplitReference(optionsFrom); !ok {
+		return ErrValueInvalid
 	}
 
 	return nil
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"fmt"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/docxtemplate"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/partial"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/templatefunc"
+)
+
+type VersionPartialToCreate struct {
+	VersionID        int64
+	PartialVersionID int64
+}
+
+// ValidatePartials checks that the template calls only the templates it
+// defines and the project partials. It returns the partials called, directly
+// or through other partials, which are pinned to the version.
+func (in VersionCreateIn) ValidatePartials(partials []partial_domain.Partial) ([]partial_domain.Partial, error) {
+	var (
+		used    []partial_domain.Partial
+		missing []string
+		err     error
+	)
+
+	if template_domain.DetectKind(in.Data) == template_domain.KindDOCX {
+		tmpl, parseErr := docxtemplate.Parse(in.Data, templatefunc.Funcs, partials)
+		if parseErr != nil {
+			return nil, error_domain.NewValidationError("data", fmt.Errorf("%w: %w", ErrValueInvalid, parseErr))
+		}
+		used, missing, err = tmpl.Partials()
+	} else {
+		trees, parseErr := partial.Trees("", string(in.Data))
+		if parseErr != nil {
+			return nil, error_domain.NewValidationError("data", fmt.Errorf("%w: %w", ErrValueInvalid, parseErr))
+		}
+		used, missing, err = partial.Resolve(trees, []string{""}, partials)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("resolve partials: %w", err)
+	}
+
+	if len(missing) > 0 {
+		return nil, error_domain.NewValidationError("data", fmt.Errorf("%w: unknown partial %q", ErrValueInvalid, missing[0]))
+	}
+
+	return used, nil
+}
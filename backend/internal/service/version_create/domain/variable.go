@@ -5,19 +5,40 @@ import (
 )
 
 type Variable struct {
-	Name        string
-	Title       string
-	Type        variable_domain.Type
-	Expression  *string
-	IsInput     bool
-	Constraints []Constraint
+	Name              string
+	Title             string
+	Type              variable_domain.Type
+	Expression        *string
+	IsInput           bool
+	DefaultExpression *string
+	EnabledIf         *string
+	Unit              *string
+	Options           []string
+	OptionsFrom       *string
+	ItemType          *variable_domain.Type
+	Columns           []Column
+	Constraints       []Constraint
 }
 
 type VariableToCreate struct {
-	VersionID  int64
-	Name       string
-	Title      string
-	Type       variable_domain.Type
-	Expression *string
-	IsInput    bool
+	VersionID         int64
+	Name              string
+	Title             string
+	Type              variable_domain.Type
+	Expression        *string
+	IsInput           bool
+	DefaultExpression *string
+	EnabledIf         *string
+	Unit              *string
+	Options           []string
+	OptionsFrom       *string
+	ItemType          *variable_domain.Type
+	Columns           []Column
+}
+
+type Column struct {
+	Name    string
+	Title   string
+	Type    variable_domain.Type
+	Options []string
 }
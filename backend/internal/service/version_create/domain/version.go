@@ -1,7 +1,11 @@
 package domain
 
+import template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+
 type Version struct {
-	TemplateID int64
-	AuthorID   int64
-	Data       []byte
+	TemplateID   int64
+	AuthorID     int64
+	Data         []byte
+	Kind         template_domain.Kind
+	Dependencies map[string][]string
 }
@@ -5,10 +5,15 @@ import (
 	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 	"github.com/jmoiron/sqlx"
 
+	dictionary_list_service "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list"
 	constraint_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_create/repository/constraint"
+	function_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_create/repository/function"
+	partial_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_create/repository/partial"
 	template_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_create/repository/template"
 	variable_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_create/repository/variable"
 	version_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_create/repository/version"
+	version_function_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_create/repository/version_function"
+	version_partial_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_create/repository/version_partial"
 	"github.com/qsoulior/tech-generator/backend/internal/service/version_create/service"
 )
 
@@ -17,6 +22,11 @@ func New(db *sqlx.DB) *service.Service {
 	versionRepo := version_repository.New(db, trmsqlx.DefaultCtxGetter)
 	variableRepo := variable_repository.New(db, trmsqlx.DefaultCtxGetter)
 	constraintRepo := constraint_repository.New(db, trmsqlx.DefaultCtxGetter)
+	functionRepo := function_repository.New(db, trmsqlx.DefaultCtxGetter)
+	partialRepo := partial_repository.New(db, trmsqlx.DefaultCtxGetter)
+	versionPartialRepo := version_partial_repository.New(db, trmsqlx.DefaultCtxGetter)
+	versionFunctionRepo := version_function_repository.New(db, trmsqlx.DefaultCtxGetter)
+	dictionaryListService := dictionary_list_service.New(db)
 	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
-	return service.New(templateRepo, versionRepo, variableRepo, constraintRepo, trManager)
+	return service.New(templateRepo, versionRepo, variableRepo, constraintRepo, functionRepo, partialRepo, versionPartialRepo, versionFunctionRepo, dictionaryListService, trManager)
 }
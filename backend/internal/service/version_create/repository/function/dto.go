@@ -0,0 +1,39 @@
+package function_repository
+
+import (
+	"encoding/json"
+	"errors"
+
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+)
+
+type function struct {
+	VersionID int64  `db:"version_id"`
+	Name      string `db:"name"`
+	Params    params `db:"params"`
+	Body      string `db:"body"`
+}
+
+func (f function) toDomain() function_domain.Function {
+	return function_domain.Function{
+		VersionID: f.VersionID,
+		Name:      f.Name,
+		Params:    f.Params,
+		Body:      f.Body,
+	}
+}
+
+type params []string
+
+func (p *params) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &p)
+}
@@ -0,0 +1,57 @@
+package function_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+)
+
+type Repository struct {
+	db       *sqlx.DB
+	trGetter *trmsqlx.CtxGetter
+}
+
+func New(db *sqlx.DB, trGetter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{
+		db:       db,
+		trGetter: trGetter,
+	}
+}
+
+func (r *Repository) ListByTemplateID(ctx context.Context, templateID int64) ([]function_domain.Function, error) {
+	op := "function - list by template id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"fv.id AS version_id",
+			"f.name",
+			"fv.params",
+			"fv.body",
+		).
+		From("project_function f").
+		Join("project_function_version fv ON f.last_version_id = fv.id").
+		Join("template t ON f.project_id = t.project_id").
+		Where(sq.Eq{"t.id": templateID}).
+		OrderBy("f.name ASC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dtos []function
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &dtos, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return lo.Map(dtos, func(f function, _ int) function_domain.Function { return f.toDomain() }), nil
+}
@@ -0,0 +1,84 @@
+package function_repository
+
+import (
+	"context"
+	"testing"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_ListByTemplateID() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// projects
+	projects := test_db.GenerateEntities(2, func(p *test_db.Project, _ int) { p.AuthorID = userID })
+	projectIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project", projects)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project", projectIDs)) }()
+
+	// template
+	template := test_db.GenerateEntity(func(t *test_db.Template) {
+		t.IsDefault = false
+		t.ProjectID = &projectIDs[0]
+		t.AuthorID = nil
+	})
+	templateID, err := test_db.InsertEntityWithID[int64](s.C(), "template", template)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "template", templateID)) }()
+
+	// functions
+	functions := test_db.GenerateEntities(2, func(f *test_db.ProjectFunction, i int) {
+		f.ProjectID = projectIDs[i]
+		f.AuthorID = nil
+		f.LastVersionID = nil
+	})
+	functionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_function", functions)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_function", functionIDs)) }()
+
+	// function versions
+	versions := test_db.GenerateEntities(2, func(v *test_db.ProjectFunctionVersion, i int) {
+		v.Number = 1
+		v.FunctionID = functionIDs[i]
+		v.AuthorID = nil
+		v.Params = []byte(`["x"]`)
+	})
+	versionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_function_version", versions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_function_version", versionIDs))
+	}()
+
+	for i := range functionIDs {
+		_, err = s.C().DB().Exec("UPDATE project_function SET last_version_id = $1 WHERE id = $2", versionIDs[i], functionIDs[i])
+		require.NoError(s.T(), err)
+	}
+
+	got, err := repo.ListByTemplateID(ctx, templateID)
+	require.NoError(s.T(), err)
+
+	want := []function_domain.Function{
+		{VersionID: versionIDs[0], Name: functions[0].Name, Params: []string{"x"}, Body: versions[0].Body},
+	}
+	require.Equal(s.T(), want, got)
+}
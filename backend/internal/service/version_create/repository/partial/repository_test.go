@@ -0,0 +1,83 @@
+package partial_repository
+
+import (
+	"context"
+	"testing"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_ListByTemplateID() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// projects
+	projects := test_db.GenerateEntities(2, func(p *test_db.Project, _ int) { p.AuthorID = userID })
+	projectIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project", projects)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project", projectIDs)) }()
+
+	// template
+	template := test_db.GenerateEntity(func(t *test_db.Template) {
+		t.IsDefault = false
+		t.ProjectID = &projectIDs[0]
+		t.AuthorID = nil
+	})
+	templateID, err := test_db.InsertEntityWithID[int64](s.C(), "template", template)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "template", templateID)) }()
+
+	// partials
+	partials := test_db.GenerateEntities(2, func(p *test_db.ProjectPartial, i int) {
+		p.ProjectID = projectIDs[i]
+		p.AuthorID = nil
+		p.LastVersionID = nil
+	})
+	partialIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_partial", partials)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_partial", partialIDs)) }()
+
+	// partial versions
+	versions := test_db.GenerateEntities(2, func(v *test_db.ProjectPartialVersion, i int) {
+		v.Number = 1
+		v.PartialID = partialIDs[i]
+		v.AuthorID = nil
+	})
+	versionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_partial_version", versions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_partial_version", versionIDs))
+	}()
+
+	for i := range partialIDs {
+		_, err = s.C().DB().Exec("UPDATE project_partial SET last_version_id = $1 WHERE id = $2", versionIDs[i], partialIDs[i])
+		require.NoError(s.T(), err)
+	}
+
+	got, err := repo.ListByTemplateID(ctx, templateID)
+	require.NoError(s.T(), err)
+
+	want := []partial_domain.Partial{
+		{VersionID: versionIDs[0], Name: partials[0].Name, Data: versions[0].Data},
+	}
+	require.Equal(s.T(), want, got)
+}
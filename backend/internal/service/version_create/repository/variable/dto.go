@@ -0,0 +1,40 @@
+package variable_repository
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
+)
+
+type options []string
+
+func (o options) Value() (driver.Value, error) {
+	if len(o) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal([]string(o))
+}
+
+type columns []domain.Column
+
+type column struct {
+	Name    string   `json:"name"`
+	Title   string   `json:"title"`
+	Type    string   `json:"type"`
+	Options []string `json:"options,omitempty"`
+}
+
+func (c columns) Value() (driver.Value, error) {
+	if len(c) == 0 {
+		return nil, nil
+	}
+
+	dtos := make([]column, 0, len(c))
+	for _, v := range c {
+		dtos = append(dtos, column{Name: v.Name, Title: v.Title, Type: string(v.Type), Options: v.Options})
+	}
+
+	return json.Marshal(dtos)
+}
@@ -28,11 +28,11 @@ func (r *Repository) Create(ctx context.Context, variables []domain.VariableToCr
 
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Insert("variable").
-		Columns("version_id", "name", "title", "type", "expression", "is_input").
+		Columns("version_id", "name", "title", "type", "expression", "is_input", "default_expression", "enabled_if", "unit", "options", "options_from", "item_type", "columns").
 		Suffix("RETURNING id")
 
 	for _, v := range variables {
-		builder = builder.Values(v.VersionID, v.Name, v.Title, v.Type, v.Expression, v.IsInput)
+		builder = builder.Values(v.VersionID, v.Name, v.Title, v.Type, v.Expression, v.IsInput, v.DefaultExpression, v.EnabledIf, v.Unit, options(v.Options), v.OptionsFrom, v.ItemType, columns(v.Columns))
 	}
 
 	query, args, err := builder.ToSql()
@@ -49,16 +49,21 @@ func (s *repositorySuite) TestRepository_Create() {
 	// variables
 	wantVariables := test_db.GenerateEntities(3, func(v *test_db.Variable, i int) {
 		v.VersionID = templateVersionID
+		v.OptionsFrom = lo.ToPtr("materials.key")
 	})
 
 	variables := lo.Map(wantVariables, func(v test_db.Variable, _ int) domain.VariableToCreate {
 		return domain.VariableToCreate{
-			VersionID:  templateVersionID,
-			Name:       v.Name,
-			Title:      v.Title,
-			Type:       variable_domain.Type(v.Type),
-			Expression: v.Expression,
-			IsInput:    v.IsInput,
+			VersionID:         templateVersionID,
+			Name:              v.Name,
+			Title:             v.Title,
+			Type:              variable_domain.Type(v.Type),
+			Expression:        v.Expression,
+			IsInput:           v.IsInput,
+			DefaultExpression: v.DefaultExpression,
+			EnabledIf:         v.EnabledIf,
+			Unit:              v.Unit,
+			OptionsFrom:       v.OptionsFrom,
 		}
 	})
 
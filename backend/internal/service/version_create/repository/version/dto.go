@@ -0,0 +1,16 @@
+package version_repository
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+)
+
+type dependencies map[string][]string
+
+func (d dependencies) Value() (driver.Value, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(map[string][]string(d))
+}
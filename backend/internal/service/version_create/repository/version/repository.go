@@ -30,12 +30,14 @@ func (r *Repository) Create(ctx context.Context, templateVersion domain.Version)
 
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Insert("template_version").
-		Columns("number", "template_id", "author_id", "data").
+		Columns("number", "template_id", "author_id", "data", "kind", "dependencies").
 		Values(
 			numberExpr,
 			templateVersion.TemplateID,
 			templateVersion.AuthorID,
 			templateVersion.Data,
+			templateVersion.Kind,
+			dependencies(templateVersion.Dependencies),
 		).
 		Suffix("RETURNING id")
 
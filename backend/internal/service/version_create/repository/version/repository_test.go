@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
 	"github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
 )
@@ -45,12 +46,15 @@ func (s *repositorySuite) TestRepository_Create() {
 		v.TemplateID = templateID
 		v.AuthorID = &userID
 		v.Number = 1
+		v.Dependencies = []byte(`{"a": ["b"], "b": []}`)
 	})
 
 	templateVersion := domain.Version{
-		TemplateID: templateID,
-		AuthorID:   userID,
-		Data:       want.Data,
+		TemplateID:   templateID,
+		AuthorID:     userID,
+		Data:         want.Data,
+		Kind:         template_domain.Kind(want.Kind),
+		Dependencies: map[string][]string{"a": {"b"}, "b": {}},
 	}
 
 	templateVersionID, err := repo.Create(ctx, templateVersion)
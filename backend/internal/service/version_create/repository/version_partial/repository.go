@@ -0,0 +1,50 @@
+package version_partial_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
+)
+
+type Repository struct {
+	db       *sqlx.DB
+	trGetter *trmsqlx.CtxGetter
+}
+
+func New(db *sqlx.DB, trGetter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{
+		db:       db,
+		trGetter: trGetter,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, versionPartials []domain.VersionPartialToCreate) error {
+	op := "version partial - create"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("template_version_partial").
+		Columns("version_id", "partial_version_id")
+
+	for _, p := range versionPartials {
+		builder = builder.Values(p.VersionID, p.PartialVersionID)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	_, err = r.trGetter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package version_partial_repository
+
+import (
+	"context"
+	"testing"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_Create() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// template
+	template := test_db.GenerateEntity(func(t *test_db.Template) {
+		t.IsDefault = false
+		t.ProjectID = &projectID
+		t.AuthorID = nil
+	})
+	templateID, err := test_db.InsertEntityWithID[int64](s.C(), "template", template)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "template", templateID)) }()
+
+	// template version
+	templateVersion := test_db.GenerateEntity(func(v *test_db.Version) {
+		v.TemplateID = templateID
+		v.AuthorID = nil
+		v.Number = 1
+	})
+	templateVersionID, err := test_db.InsertEntityWithID[int64](s.C(), "template_version", templateVersion)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "template_version", templateVersionID)) }()
+
+	// partials
+	partials := test_db.GenerateEntities(2, func(p *test_db.ProjectPartial, _ int) {
+		p.ProjectID = projectID
+		p.AuthorID = nil
+		p.LastVersionID = nil
+	})
+	partialIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_partial", partials)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_partial", partialIDs)) }()
+
+	// partial versions
+	versions := test_db.GenerateEntities(2, func(v *test_db.ProjectPartialVersion, i int) {
+		v.Number = 1
+		v.PartialID = partialIDs[i]
+		v.AuthorID = nil
+	})
+	versionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_partial_version", versions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_partial_version", versionIDs))
+	}()
+
+	// version partials
+	versionPartials := []domain.VersionPartialToCreate{
+		{VersionID: templateVersionID, PartialVersionID: versionIDs[0]},
+		{VersionID: templateVersionID, PartialVersionID: versionIDs[1]},
+	}
+
+	err = repo.Create(ctx, versionPartials)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntityByColumn(s.C(), "template_version_partial", "version_id", templateVersionID))
+	}()
+
+	got, err := test_db.SelectEntitiesByColumn[test_db.VersionPartial](s.C(), "template_version_partial", "version_id", []int64{templateVersionID})
+	require.NoError(s.T(), err)
+
+	want := []test_db.VersionPartial{
+		{VersionID: templateVersionID, PartialVersionID: versionIDs[0]},
+		{VersionID: templateVersionID, PartialVersionID: versionIDs[1]},
+	}
+	require.ElementsMatch(s.T(), want, got)
+}
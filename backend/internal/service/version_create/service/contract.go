@@ -5,6 +5,9 @@ package service
 import (
 	"context"
 
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
 	"github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
 )
 
@@ -23,3 +26,23 @@ type variableRepository interface {
 type constraintRepository interface {
 	Create(ctx context.Context, constraints []domain.ConstraintToCreate) error
 }
+
+type functionRepository interface {
+	ListByTemplateID(ctx context.Context, templateID int64) ([]function_domain.Function, error)
+}
+
+type partialRepository interface {
+	ListByTemplateID(ctx context.Context, templateID int64) ([]partial_domain.Partial, error)
+}
+
+type versionPartialRepository interface {
+	Create(ctx context.Context, versionPartials []domain.VersionPartialToCreate) error
+}
+
+type versionFunctionRepository interface {
+	Create(ctx context.Context, versionFunctions []domain.VersionFunctionToCreate) error
+}
+
+type dictionaryListService interface {
+	Handle(ctx context.Context, in domain.DictionaryListIn) ([]dictionary_domain.Dictionary, error)
+}
@@ -13,6 +13,9 @@ import (
 	context "context"
 	reflect "reflect"
 
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
 	domain "github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -170,3 +173,196 @@ func (mr *MockconstraintRepositoryMockRecorder) Create(ctx, constraints any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockconstraintRepository)(nil).Create), ctx, constraints)
 }
+
+// MockfunctionRepository is a mock of functionRepository interface.
+type MockfunctionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockfunctionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockfunctionRepositoryMockRecorder is the mock recorder for MockfunctionRepository.
+type MockfunctionRepositoryMockRecorder struct {
+	mock *MockfunctionRepository
+}
+
+// NewMockfunctionRepository creates a new mock instance.
+func NewMockfunctionRepository(ctrl *gomock.Controller) *MockfunctionRepository {
+	mock := &MockfunctionRepository{ctrl: ctrl}
+	mock.recorder = &MockfunctionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfunctionRepository) EXPECT() *MockfunctionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByTemplateID mocks base method.
+func (m *MockfunctionRepository) ListByTemplateID(ctx context.Context, templateID int64) ([]function_domain.Function, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTemplateID", ctx, templateID)
+	ret0, _ := ret[0].([]function_domain.Function)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTemplateID indicates an expected call of ListByTemplateID.
+func (mr *MockfunctionRepositoryMockRecorder) ListByTemplateID(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTemplateID", reflect.TypeOf((*MockfunctionRepository)(nil).ListByTemplateID), ctx, templateID)
+}
+
+// MockpartialRepository is a mock of partialRepository interface.
+type MockpartialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockpartialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockpartialRepositoryMockRecorder is the mock recorder for MockpartialRepository.
+type MockpartialRepositoryMockRecorder struct {
+	mock *MockpartialRepository
+}
+
+// NewMockpartialRepository creates a new mock instance.
+func NewMockpartialRepository(ctrl *gomock.Controller) *MockpartialRepository {
+	mock := &MockpartialRepository{ctrl: ctrl}
+	mock.recorder = &MockpartialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpartialRepository) EXPECT() *MockpartialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByTemplateID mocks base method.
+func (m *MockpartialRepository) ListByTemplateID(ctx context.Context, templateID int64) ([]partial_domain.Partial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTemplateID", ctx, templateID)
+	ret0, _ := ret[0].([]partial_domain.Partial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTemplateID indicates an expected call of ListByTemplateID.
+func (mr *MockpartialRepositoryMockRecorder) ListByTemplateID(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTemplateID", reflect.TypeOf((*MockpartialRepository)(nil).ListByTemplateID), ctx, templateID)
+}
+
+// MockversionPartialRepository is a mock of versionPartialRepository interface.
+type MockversionPartialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockversionPartialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockversionPartialRepositoryMockRecorder is the mock recorder for MockversionPartialRepository.
+type MockversionPartialRepositoryMockRecorder struct {
+	mock *MockversionPartialRepository
+}
+
+// NewMockversionPartialRepository creates a new mock instance.
+func NewMockversionPartialRepository(ctrl *gomock.Controller) *MockversionPartialRepository {
+	mock := &MockversionPartialRepository{ctrl: ctrl}
+	mock.recorder = &MockversionPartialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockversionPartialRepository) EXPECT() *MockversionPartialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockversionPartialRepository) Create(ctx context.Context, versionPartials []domain.VersionPartialToCreate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, versionPartials)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockversionPartialRepositoryMockRecorder) Create(ctx, versionPartials any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockversionPartialRepository)(nil).Create), ctx, versionPartials)
+}
+
+// MockversionFunctionRepository is a mock of versionFunctionRepository interface.
+type MockversionFunctionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockversionFunctionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockversionFunctionRepositoryMockRecorder is the mock recorder for MockversionFunctionRepository.
+type MockversionFunctionRepositoryMockRecorder struct {
+	mock *MockversionFunctionRepository
+}
+
+// NewMockversionFunctionRepository creates a new mock instance.
+func NewMockversionFunctionRepository(ctrl *gomock.Controller) *MockversionFunctionRepository {
+	mock := &MockversionFunctionRepository{ctrl: ctrl}
+	mock.recorder = &MockversionFunctionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockversionFunctionRepository) EXPECT() *MockversionFunctionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockversionFunctionRepository) Create(ctx context.Context, versionFunctions []domain.VersionFunctionToCreate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, versionFunctions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockversionFunctionRepositoryMockRecorder) Create(ctx, versionFunctions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockversionFunctionRepository)(nil).Create), ctx, versionFunctions)
+}
+
+// MockdictionaryListService is a mock of dictionaryListService interface.
+type MockdictionaryListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockdictionaryListServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockdictionaryListServiceMockRecorder is the mock recorder for MockdictionaryListService.
+type MockdictionaryListServiceMockRecorder struct {
+	mock *MockdictionaryListService
+}
+
+// NewMockdictionaryListService creates a new mock instance.
+func NewMockdictionaryListService(ctrl *gomock.Controller) *MockdictionaryListService {
+	mock := &MockdictionaryListService{ctrl: ctrl}
+	mock.recorder = &MockdictionaryListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdictionaryListService) EXPECT() *MockdictionaryListServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockdictionaryListService) Handle(ctx context.Context, in domain.DictionaryListIn) ([]dictionary_domain.Dictionary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, in)
+	ret0, _ := ret[0].([]dictionary_domain.Dictionary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockdictionaryListServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockdictionaryListService)(nil).Handle), ctx, in)
+}
@@ -7,15 +7,23 @@ import (
 	"github.com/avito-tech/go-transaction-manager/trm/v2"
 	"github.com/samber/lo"
 
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 	"github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
 )
 
 type Service struct {
-	templateRepo   templateRepository
-	versionRepo    versionRepository
-	variableRepo   variableRepository
-	constraintRepo constraintRepository
-	trManager      trm.Manager
+	templateRepo          templateRepository
+	versionRepo           versionRepository
+	variableRepo          variableRepository
+	constraintRepo        constraintRepository
+	functionRepo          functionRepository
+	partialRepo           partialRepository
+	versionPartialRepo    versionPartialRepository
+	versionFunctionRepo   versionFunctionRepository
+	dictionaryListService dictionaryListService
+	trManager             trm.Manager
 }
 
 func New(
@@ -23,14 +31,24 @@ func New(
 	versionRepo versionRepository,
 	variableRepo variableRepository,
 	constraintRepo constraintRepository,
+	functionRepo functionRepository,
+	partialRepo partialRepository,
+	versionPartialRepo versionPartialRepository,
+	versionFunctionRepo versionFunctionRepository,
+	dictionaryListService dictionaryListService,
 	trManager trm.Manager,
 ) *Service {
 	return &Service{
-		templateRepo:   templateRepo,
-		versionRepo:    versionRepo,
-		variableRepo:   variableRepo,
-		constraintRepo: constraintRepo,
-		trManager:      trManager,
+		templateRepo:          templateRepo,
+		versionRepo:           versionRepo,
+		variableRepo:          variableRepo,
+		constraintRepo:        constraintRepo,
+		functionRepo:          functionRepo,
+		partialRepo:           partialRepo,
+		versionPartialRepo:    versionPartialRepo,
+		versionFunctionRepo:   versionFunctionRepo,
+		dictionaryListService: dictionaryListService,
+		trManager:             trManager,
 	}
 }
 
@@ -40,11 +58,36 @@ func (u *Service) Handle(ctx context.Context, in domain.VersionCreateIn) (int64,
 		return 0, err
 	}
 
+	functions, err := u.functionRepo.ListByTemplateID(ctx, in.TemplateID)
+	if err != nil {
+		return 0, fmt.Errorf("function repo - list by template id: %w", err)
+	}
+
+	dictionaries, err := u.dictionaryListService.Handle(ctx, domain.DictionaryListIn{TemplateID: in.TemplateID})
+	if err != nil {
+		return 0, fmt.Errorf("dictionary list service - handle: %w", err)
+	}
+
+	if err := in.ValidateExpressions(functions, dictionaries); err != nil {
+		return 0, err
+	}
+
+	// resolve partials
+	projectPartials, err := u.partialRepo.ListByTemplateID(ctx, in.TemplateID)
+	if err != nil {
+		return 0, fmt.Errorf("partial repo - list by template id: %w", err)
+	}
+
+	partials, err := in.ValidatePartials(projectPartials)
+	if err != nil {
+		return 0, err
+	}
+
 	// create version
 	var versionID int64
-	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+	err = u.trManager.Do(ctx, func(ctx context.Context) error {
 		var err error
-		versionID, err = u.createVersion(ctx, in)
+		versionID, err = u.createVersion(ctx, in, functions, partials)
 		if err != nil {
 			return err
 		}
@@ -57,12 +100,14 @@ func (u *Service) Handle(ctx context.Context, in domain.VersionCreateIn) (int64,
 	return versionID, nil
 }
 
-func (u *Service) createVersion(ctx context.Context, in domain.VersionCreateIn) (int64, error) {
+func (u *Service) createVersion(ctx context.Context, in domain.VersionCreateIn, functions []function_domain.Function, partials []partial_domain.Partial) (int64, error) {
 	// create version
 	version := domain.Version{
-		TemplateID: in.TemplateID,
-		AuthorID:   in.AuthorID,
-		Data:       in.Data,
+		TemplateID:   in.TemplateID,
+		AuthorID:     in.AuthorID,
+		Data:         in.Data,
+		Kind:         template_domain.DetectKind(in.Data),
+		Dependencies: in.Dependencies(),
 	}
 
 	versionID, err := u.versionRepo.Create(ctx, version)
@@ -76,6 +121,18 @@ func (u *Service) createVersion(ctx context.Context, in domain.VersionCreateIn)
 		return 0, err
 	}
 
+	// pin functions
+	err = u.createVersionFunctions(ctx, versionID, functions)
+	if err != nil {
+		return 0, err
+	}
+
+	// pin partials
+	err = u.createVersionPartials(ctx, versionID, partials)
+	if err != nil {
+		return 0, err
+	}
+
 	// update template
 	templateToUpdate := domain.TemplateToUpdate{
 		ID:            in.TemplateID,
@@ -90,6 +147,45 @@ func (u *Service) createVersion(ctx context.Context, in domain.VersionCreateIn)
 	return versionID, nil
 }
 
+// createVersionFunctions pins the versions of the project functions the
+// expressions were checked against, so that the version evaluates the same once
+// the functions change.
+func (u *Service) createVersionFunctions(ctx context.Context, versionID int64, functions []function_domain.Function) error {
+	if len(functions) == 0 {
+		return nil
+	}
+
+	versionFunctions := lo.Map(functions, func(f function_domain.Function, _ int) domain.VersionFunctionToCreate {
+		return domain.VersionFunctionToCreate{VersionID: versionID, FunctionVersionID: f.VersionID}
+	})
+
+	err := u.versionFunctionRepo.Create(ctx, versionFunctions)
+	if err != nil {
+		return fmt.Errorf("version function repo - create: %w", err)
+	}
+
+	return nil
+}
+
+// createVersionPartials pins the versions of the partials the template calls,
+// so that the version renders the same once the partials change.
+func (u *Service) createVersionPartials(ctx context.Context, versionID int64, partials []partial_domain.Partial) error {
+	if len(partials) == 0 {
+		return nil
+	}
+
+	versionPartials := lo.Map(partials, func(p partial_domain.Partial, _ int) domain.VersionPartialToCreate {
+		return domain.VersionPartialToCreate{VersionID: versionID, PartialVersionID: p.VersionID}
+	})
+
+	err := u.versionPartialRepo.Create(ctx, versionPartials)
+	if err != nil {
+		return fmt.Errorf("version partial repo - create: %w", err)
+	}
+
+	return nil
+}
+
 func (u *Service) createVariables(ctx context.Context, templateVersionID int64, variables []domain.Variable) error {
 	if len(variables) == 0 {
 		return nil
@@ -98,12 +194,19 @@ func (u *Service) createVariables(ctx context.Context, templateVersionID int64,
 	// create variables
 	variablesToCreate := lo.Map(variables, func(v domain.Variable, _ int) domain.VariableToCreate {
 		return domain.VariableToCreate{
-			VersionID:  templateVersionID,
-			Name:       v.Name,
-			Title:      v.Title,
-			Type:       v.Type,
-			Expression: v.Expression,
-			IsInput:    v.IsInput,
+			VersionID:         templateVersionID,
+			Name:              v.Name,
+			Title:             v.Title,
+			Type:              v.Type,
+			Expression:        v.Expression,
+			IsInput:           v.IsInput,
+			DefaultExpression: v.DefaultExpression,
+			EnabledIf:         v.EnabledIf,
+			Unit:              v.Unit,
+			Options:           v.Options,
+			OptionsFrom:       v.OptionsFrom,
+			ItemType:          v.ItemType,
+			Columns:           v.Columns,
 		}
 	})
 
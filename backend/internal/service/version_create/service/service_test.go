@@ -9,6 +9,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
 	test_trm "github.com/qsoulior/tech-generator/backend/internal/pkg/test/trm"
 	"github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
@@ -21,7 +24,7 @@ func TestService_Handle_Success(t *testing.T) {
 	tests := []struct {
 		name  string
 		in    domain.VersionCreateIn
-		setup func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository)
+		setup func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService)
 		want  int64
 	}{
 		{
@@ -35,43 +38,49 @@ func TestService_Handle_Success(t *testing.T) {
 						Name:       "var_1",
 						Title:      "Var 1",
 						Type:       variable_domain.TypeString,
-						Expression: lo.ToPtr("expr_1"),
+						Expression: lo.ToPtr("string(var_2)"),
 						IsInput:    false,
 						Constraints: []domain.Constraint{
-							{Name: "constraint_1_1", Expression: "expr_1_1", IsActive: true},
-							{Name: "constraint_1_2", Expression: "expr_1_2", IsActive: false},
+							{Name: "constraint_1_1", Expression: "len(var_1) > 0", IsActive: true},
+							{Name: "constraint_1_2", Expression: `var_1 != ""`, IsActive: false},
 						},
 					},
 					{
 						Name:       "var_2",
 						Title:      "Var 2",
 						Type:       variable_domain.TypeFloat,
-						Expression: lo.ToPtr("expr_2"),
+						Expression: lo.ToPtr("2 * pi"),
 						IsInput:    false,
 						Constraints: []domain.Constraint{
-							{Name: "constraint_2_1", Expression: "expr_2_1", IsActive: true},
+							{Name: "constraint_2_1", Expression: "var_2 > 0", IsActive: true},
 						},
 					},
 				},
 			},
-			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+
 				templateVersion := domain.Version{
-					TemplateID: 10,
-					AuthorID:   1,
-					Data:       []byte{1, 2, 3},
+					TemplateID:   10,
+					AuthorID:     1,
+					Data:         []byte{1, 2, 3},
+					Kind:         template_domain.KindMarkdown,
+					Dependencies: map[string][]string{"var_1": {"var_2"}, "var_2": {}},
 				}
 				versionRepo.EXPECT().Create(trCtx, templateVersion).Return(int64(20), nil)
 
 				variables := []domain.VariableToCreate{
-					{VersionID: 20, Name: "var_1", Title: "Var 1", Type: variable_domain.TypeString, Expression: lo.ToPtr("expr_1")},
-					{VersionID: 20, Name: "var_2", Title: "Var 2", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("expr_2")},
+					{VersionID: 20, Name: "var_1", Title: "Var 1", Type: variable_domain.TypeString, Expression: lo.ToPtr("string(var_2)")},
+					{VersionID: 20, Name: "var_2", Title: "Var 2", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("2 * pi")},
 				}
 				variableRepo.EXPECT().Create(trCtx, variables).Return([]int64{31, 32}, nil)
 
 				constraints := []domain.ConstraintToCreate{
-					{VariableID: 31, Name: "constraint_1_1", Expression: "expr_1_1", IsActive: true},
-					{VariableID: 31, Name: "constraint_1_2", Expression: "expr_1_2", IsActive: false},
-					{VariableID: 32, Name: "constraint_2_1", Expression: "expr_2_1", IsActive: true},
+					{VariableID: 31, Name: "constraint_1_1", Expression: "len(var_1) > 0", IsActive: true},
+					{VariableID: 31, Name: "constraint_1_2", Expression: `var_1 != ""`, IsActive: false},
+					{VariableID: 32, Name: "constraint_2_1", Expression: "var_2 > 0", IsActive: true},
 				}
 				constraintRepo.EXPECT().Create(trCtx, constraints).Return(nil)
 
@@ -97,11 +106,17 @@ func TestService_Handle_Success(t *testing.T) {
 					},
 				},
 			},
-			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+
 				templateVersion := domain.Version{
-					TemplateID: 10,
-					AuthorID:   1,
-					Data:       []byte{1, 2, 3},
+					TemplateID:   10,
+					AuthorID:     1,
+					Data:         []byte{1, 2, 3},
+					Kind:         template_domain.KindMarkdown,
+					Dependencies: map[string][]string{"var_1": {}},
 				}
 				versionRepo.EXPECT().Create(trCtx, templateVersion).Return(int64(20), nil)
 
@@ -115,6 +130,106 @@ func TestService_Handle_Success(t *testing.T) {
 			},
 			want: 20,
 		},
+		{
+			name: "EnumOptions",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Data:       []byte{1, 2, 3},
+				Variables: []domain.Variable{
+					{
+						Name:        "var_1",
+						Title:       "Var 1",
+						Type:        variable_domain.TypeEnum,
+						Options:     []string{"a", "b"},
+						Constraints: []domain.Constraint{},
+						IsInput:     true,
+					},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+
+				templateVersion := domain.Version{
+					TemplateID:   10,
+					AuthorID:     1,
+					Data:         []byte{1, 2, 3},
+					Kind:         template_domain.KindMarkdown,
+					Dependencies: map[string][]string{"var_1": {}},
+				}
+				versionRepo.EXPECT().Create(trCtx, templateVersion).Return(int64(20), nil)
+
+				variables := []domain.VariableToCreate{
+					{VersionID: 20, Name: "var_1", Title: "Var 1", Type: variable_domain.TypeEnum, IsInput: true, Options: []string{"a", "b"}},
+				}
+				variableRepo.EXPECT().Create(trCtx, variables).Return([]int64{31}, nil)
+
+				templateToUpdate := domain.TemplateToUpdate{ID: 10, LastVersionID: 20}
+				templateRepo.EXPECT().UpdateByID(trCtx, templateToUpdate).Return(nil)
+			},
+			want: 20,
+		},
+		{
+			name: "ListAndTable",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Data:       []byte{1, 2, 3},
+				Variables: []domain.Variable{
+					{
+						Name:        "requirements",
+						Title:       "Requirements",
+						Type:        variable_domain.TypeList,
+						ItemType:    lo.ToPtr(variable_domain.TypeString),
+						Constraints: []domain.Constraint{},
+						IsInput:     true,
+					},
+					{
+						Name:  "cases",
+						Title: "Cases",
+						Type:  variable_domain.TypeTable,
+						Columns: []domain.Column{
+							{Name: "name", Title: "Name", Type: variable_domain.TypeString},
+							{Name: "result", Title: "Result", Type: variable_domain.TypeEnum, Options: []string{"ok", "fail"}},
+						},
+						Constraints: []domain.Constraint{},
+						IsInput:     true,
+					},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+
+				templateVersion := domain.Version{
+					TemplateID:   10,
+					AuthorID:     1,
+					Data:         []byte{1, 2, 3},
+					Kind:         template_domain.KindMarkdown,
+					Dependencies: map[string][]string{"requirements": {}, "cases": {}},
+				}
+				versionRepo.EXPECT().Create(trCtx, templateVersion).Return(int64(20), nil)
+
+				variables := []domain.VariableToCreate{
+					{VersionID: 20, Name: "requirements", Title: "Requirements", Type: variable_domain.TypeList, IsInput: true, ItemType: lo.ToPtr(variable_domain.TypeString)},
+					{
+						VersionID: 20, Name: "cases", Title: "Cases", Type: variable_domain.TypeTable, IsInput: true,
+						Columns: []domain.Column{
+							{Name: "name", Title: "Name", Type: variable_domain.TypeString},
+							{Name: "result", Title: "Result", Type: variable_domain.TypeEnum, Options: []string{"ok", "fail"}},
+						},
+					},
+				}
+				variableRepo.EXPECT().Create(trCtx, variables).Return([]int64{31, 32}, nil)
+
+				templateToUpdate := domain.TemplateToUpdate{ID: 10, LastVersionID: 20}
+				templateRepo.EXPECT().UpdateByID(trCtx, templateToUpdate).Return(nil)
+			},
+			want: 20,
+		},
 		{
 			name: "NoVariables",
 			in: domain.VersionCreateIn{
@@ -123,14 +238,96 @@ func TestService_Handle_Success(t *testing.T) {
 				Data:       []byte{1, 2, 3},
 				Variables:  []domain.Variable{},
 			},
-			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+
+				templateVersion := domain.Version{
+					TemplateID:   10,
+					AuthorID:     1,
+					Data:         []byte{1, 2, 3},
+					Kind:         template_domain.KindMarkdown,
+					Dependencies: map[string][]string{},
+				}
+				versionRepo.EXPECT().Create(trCtx, templateVersion).Return(int64(20), nil)
+
+				templateToUpdate := domain.TemplateToUpdate{ID: 10, LastVersionID: 20}
+				templateRepo.EXPECT().UpdateByID(trCtx, templateToUpdate).Return(nil)
+			},
+			want: 20,
+		},
+		{
+			name: "Partials",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Data:       []byte(`{{ template "title_page" . }}`),
+				Variables:  []domain.Variable{},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+
+				partials := []partial_domain.Partial{
+					{VersionID: 5, Name: "approval", Data: "УТВЕРЖДАЮ"},
+					{VersionID: 6, Name: "revisions", Data: "Лист регистрации изменений"},
+					{VersionID: 7, Name: "title_page", Data: `{{ template "approval" . }}`},
+				}
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(partials, nil)
+
+				templateVersion := domain.Version{
+					TemplateID:   10,
+					AuthorID:     1,
+					Data:         []byte(`{{ template "title_page" . }}`),
+					Kind:         template_domain.KindMarkdown,
+					Dependencies: map[string][]string{},
+				}
+				versionRepo.EXPECT().Create(trCtx, templateVersion).Return(int64(20), nil)
+
+				versionPartials := []domain.VersionPartialToCreate{
+					{VersionID: 20, PartialVersionID: 5},
+					{VersionID: 20, PartialVersionID: 7},
+				}
+				versionPartialRepo.EXPECT().Create(trCtx, versionPartials).Return(nil)
+
+				templateToUpdate := domain.TemplateToUpdate{ID: 10, LastVersionID: 20}
+				templateRepo.EXPECT().UpdateByID(trCtx, templateToUpdate).Return(nil)
+			},
+			want: 20,
+		},
+		{
+			name: "Functions",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Data:       []byte{1, 2, 3},
+				Variables:  []domain.Variable{},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functions := []function_domain.Function{
+					{VersionID: 3, Name: "area", Params: []string{"w", "h"}, Body: "w * h"},
+					{VersionID: 4, Name: "tolerance", Params: []string{"x", "tol"}, Body: "x * tol / 100"},
+				}
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(functions, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+
 				templateVersion := domain.Version{
-					TemplateID: 10,
-					AuthorID:   1,
-					Data:       []byte{1, 2, 3},
+					TemplateID:   10,
+					AuthorID:     1,
+					Data:         []byte{1, 2, 3},
+					Kind:         template_domain.KindMarkdown,
+					Dependencies: map[string][]string{},
 				}
 				versionRepo.EXPECT().Create(trCtx, templateVersion).Return(int64(20), nil)
 
+				versionFunctions := []domain.VersionFunctionToCreate{
+					{VersionID: 20, FunctionVersionID: 3},
+					{VersionID: 20, FunctionVersionID: 4},
+				}
+				versionFunctionRepo.EXPECT().Create(trCtx, versionFunctions).Return(nil)
+
 				templateToUpdate := domain.TemplateToUpdate{ID: 10, LastVersionID: 20}
 				templateRepo.EXPECT().UpdateByID(trCtx, templateToUpdate).Return(nil)
 			},
@@ -146,11 +343,16 @@ func TestService_Handle_Success(t *testing.T) {
 			versionRepo := NewMockversionRepository(ctrl)
 			variableRepo := NewMockvariableRepository(ctrl)
 			constraintRepo := NewMockconstraintRepository(ctrl)
+			functionRepo := NewMockfunctionRepository(ctrl)
+			partialRepo := NewMockpartialRepository(ctrl)
+			versionPartialRepo := NewMockversionPartialRepository(ctrl)
+			versionFunctionRepo := NewMockversionFunctionRepository(ctrl)
+			dictionaryListService := NewMockdictionaryListService(ctrl)
 			trManager := test_trm.New()
 
-			tt.setup(templateRepo, versionRepo, variableRepo, constraintRepo)
+			tt.setup(templateRepo, versionRepo, variableRepo, constraintRepo, functionRepo, partialRepo, versionPartialRepo, versionFunctionRepo, dictionaryListService)
 
-			usecase := New(templateRepo, versionRepo, variableRepo, constraintRepo, trManager)
+			usecase := New(templateRepo, versionRepo, variableRepo, constraintRepo, functionRepo, partialRepo, versionPartialRepo, versionFunctionRepo, dictionaryListService, trManager)
 
 			got, err := usecase.Handle(ctx, tt.in)
 			require.NoError(t, err)
@@ -172,11 +374,11 @@ func TestService_Handle_Error(t *testing.T) {
 				Name:       "var_1",
 				Title:      "Var 1",
 				Type:       variable_domain.TypeString,
-				Expression: lo.ToPtr("expr_1"),
+				Expression: lo.ToPtr(`"text"`),
 				Constraints: []domain.Constraint{
 					{
 						Name:       "constraint_1_1",
-						Expression: "expr_1_1",
+						Expression: "len(var_1) > 0",
 						IsActive:   true,
 					},
 				},
@@ -187,7 +389,7 @@ func TestService_Handle_Error(t *testing.T) {
 	tests := []struct {
 		name  string
 		in    domain.VersionCreateIn
-		setup func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository)
+		setup func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService)
 		want  string
 	}{
 		{
@@ -205,14 +407,294 @@ func TestService_Handle_Error(t *testing.T) {
 					},
 				},
 			},
-			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueInvalid.Error(),
+		},
+		{
+			name: "in_Validate_EnumOptionsEmpty",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeEnum, IsInput: true},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueEmpty.Error(),
+		},
+		{
+			name: "in_Validate_EnumOptionsDuplicate",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeEnum, IsInput: true, Options: []string{"a", "a"}},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueDuplicate.Error(),
+		},
+		{
+			name: "in_Validate_OptionsUnexpected",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeBoolean, IsInput: true, Options: []string{"a"}},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueInvalid.Error(),
+		},
+		{
+			name: "in_Validate_ListItemTypeEmpty",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeList, IsInput: true},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueEmpty.Error(),
+		},
+		{
+			name: "in_Validate_ListItemTypeInvalid",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeList, IsInput: true, ItemType: lo.ToPtr(variable_domain.TypeTable)},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueInvalid.Error(),
+		},
+		{
+			name: "in_Validate_TableColumnsEmpty",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeTable, IsInput: true},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueEmpty.Error(),
+		},
+		{
+			name: "in_Validate_TableColumnDuplicate",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeTable, IsInput: true, Columns: []domain.Column{{Name: "a", Title: "A", Type: variable_domain.TypeString}, {Name: "a", Title: "A", Type: variable_domain.TypeInteger}}},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueDuplicate.Error(),
+		},
+		{
+			name: "in_Validate_ColumnsUnexpected",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeString, IsInput: true, Columns: []domain.Column{{Name: "a", Title: "A", Type: variable_domain.TypeString}}},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
 			},
 			want: domain.ErrValueInvalid.Error(),
 		},
+		{
+			name: "in_Validate_DefaultExpressionNotInput",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("1"), DefaultExpression: lo.ToPtr("2")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueInvalid.Error(),
+		},
+		{
+			name: "in_Validate_DefaultExpressionEmpty",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeFloat, IsInput: true, DefaultExpression: lo.ToPtr("")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueEmpty.Error(),
+		},
+		{
+			name: "in_ValidateExpressions_DefaultCompile",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeFloat, IsInput: true, DefaultExpression: lo.ToPtr("unknown * 2")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+			},
+			want: domain.ErrExpressionInvalid.Error(),
+		},
+		{
+			name: "in_Validate_EnabledIfEmpty",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeFloat, IsInput: true, EnabledIf: lo.ToPtr("")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueEmpty.Error(),
+		},
+		{
+			name: "in_Validate_UnitNotNumeric",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeString, IsInput: true, Unit: lo.ToPtr("mm")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueInvalid.Error(),
+		},
+		{
+			name: "in_Validate_UnitUnknown",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeFloat, IsInput: true, Unit: lo.ToPtr("furlong")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueInvalid.Error(),
+		},
+		{
+			name: "in_ValidateExpressions_UnitMismatch",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "length", Title: "Length", Type: variable_domain.TypeFloat, IsInput: true, Unit: lo.ToPtr("m")},
+					{Name: "mass", Title: "Mass", Type: variable_domain.TypeFloat, IsInput: true, Unit: lo.ToPtr("kg")},
+					{Name: "sum", Title: "Sum", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("length + mass")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+			},
+			want: domain.ErrExpressionInvalid.Error(),
+		},
+		{
+			name: "in_ValidateExpressions_EnabledIfNotBool",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeFloat, IsInput: true, EnabledIf: lo.ToPtr("var + 1")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+			},
+			want: domain.ErrExpressionInvalid.Error(),
+		},
+		{
+			name: "in_ValidateExpressions_Compile",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("unknown * 2")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+			},
+			want: domain.ErrExpressionInvalid.Error(),
+		},
+		{
+			name: "in_ValidateExpressions_Empty",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeFloat},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+			},
+			want: domain.ErrValueEmpty.Error(),
+		},
+		{
+			name: "in_ValidateExpressions_ConstraintNotBool",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeFloat, IsInput: true, Constraints: []domain.Constraint{{Name: "c", Expression: "var + 1", IsActive: true}}},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+			},
+			want: domain.ErrExpressionInvalid.Error(),
+		},
+		{
+			name: "in_ValidateExpressions_Cycle",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "a", Title: "A", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("b + 1")},
+					{Name: "b", Title: "B", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("a + 1")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+			},
+			want: domain.ErrDependencyCycle.Error() + ": a → b → a",
+		},
 		{
 			name: "versionRepo_Create",
 			in:   validIn,
-			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
 				versionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(0), errors.New("test1"))
 			},
 			want: "test1",
@@ -220,7 +702,10 @@ func TestService_Handle_Error(t *testing.T) {
 		{
 			name: "variableRepo_Create",
 			in:   validIn,
-			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
 				versionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(20), nil)
 				variableRepo.EXPECT().Create(trCtx, gomock.Any()).Return(nil, errors.New("test2"))
 			},
@@ -229,7 +714,10 @@ func TestService_Handle_Error(t *testing.T) {
 		{
 			name: "domain_ErrVariableIDsInvalid",
 			in:   validIn,
-			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
 				versionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(20), nil)
 				variableRepo.EXPECT().Create(trCtx, gomock.Any()).Return([]int64{}, nil)
 			},
@@ -238,7 +726,10 @@ func TestService_Handle_Error(t *testing.T) {
 		{
 			name: "constraintRepo_Create",
 			in:   validIn,
-			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
 				versionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(20), nil)
 				variableRepo.EXPECT().Create(trCtx, gomock.Any()).Return([]int64{31}, nil)
 				constraintRepo.EXPECT().Create(trCtx, gomock.Any()).Return(errors.New("test3"))
@@ -248,7 +739,10 @@ func TestService_Handle_Error(t *testing.T) {
 		{
 			name: "templateRepo_UpdateByID",
 			in:   validIn,
-			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
 				versionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(20), nil)
 				variableRepo.EXPECT().Create(trCtx, gomock.Any()).Return([]int64{31}, nil)
 				constraintRepo.EXPECT().Create(trCtx, gomock.Any()).Return(nil)
@@ -256,6 +750,134 @@ func TestService_Handle_Error(t *testing.T) {
 			},
 			want: "test4",
 		},
+		{
+			name: "functionRepo_ListByTemplateID",
+			in:   validIn,
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, errors.New("test5"))
+			},
+			want: "test5",
+		},
+		{
+			name: "in_ValidateExpressions_Function",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeFloat, Expression: lo.ToPtr("tolerance(1)")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functions := []function_domain.Function{{VersionID: 1, Name: "tolerance", Params: []string{"x", "tol"}, Body: "x * tol / 100"}}
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(functions, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+			},
+			want: domain.ErrExpressionInvalid.Error(),
+		},
+		{
+			name: "dictionaryListService_Handle",
+			in:   validIn,
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, errors.New("test6"))
+			},
+			want: "test6",
+		},
+		{
+			name: "in_ValidateExpressions_OptionsFrom",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeEnum, IsInput: true, OptionsFrom: lo.ToPtr("materials.key")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+			},
+			want: domain.ErrValueInvalid.Error(),
+		},
+		{
+			name: "in_Validate_OptionsFromWithOptions",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Variables: []domain.Variable{
+					{Name: "var", Title: "Var", Type: variable_domain.TypeEnum, IsInput: true, Options: []string{"a"}, OptionsFrom: lo.ToPtr("materials.key")},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+			},
+			want: domain.ErrValueInvalid.Error(),
+		},
+		{
+			name: "partialRepo_ListByTemplateID",
+			in:   validIn,
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, errors.New("test7"))
+			},
+			want: "test7",
+		},
+		{
+			name: "in_ValidatePartials",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Data:       []byte(`{{ template "title_page" . }}`),
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return([]partial_domain.Partial{{VersionID: 5, Name: "approval"}}, nil)
+			},
+			want: `unknown partial "title_page"`,
+		},
+		{
+			name: "in_ValidatePartials_Parse",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Data:       []byte(`{{ template "title_page" . }}{{ if .ok }}`),
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+			},
+			want: domain.ErrValueInvalid.Error(),
+		},
+		{
+			name: "versionPartialRepo_Create",
+			in: domain.VersionCreateIn{
+				AuthorID:   1,
+				TemplateID: 10,
+				Data:       []byte(`{{ template "approval" . }}`),
+			},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return([]partial_domain.Partial{{VersionID: 5, Name: "approval"}}, nil)
+				versionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(20), nil)
+				versionPartialRepo.EXPECT().Create(trCtx, gomock.Any()).Return(errors.New("test8"))
+			},
+			want: "test8",
+		},
+		{
+			name: "versionFunctionRepo_Create",
+			in:   domain.VersionCreateIn{AuthorID: 1, TemplateID: 10, Data: []byte{1, 2, 3}},
+			setup: func(templateRepo *MocktemplateRepository, versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, versionPartialRepo *MockversionPartialRepository, versionFunctionRepo *MockversionFunctionRepository, dictionaryListService *MockdictionaryListService) {
+				functions := []function_domain.Function{{VersionID: 3, Name: "area", Params: []string{"w", "h"}, Body: "w * h"}}
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(functions, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				versionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(20), nil)
+				versionFunctionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(errors.New("test9"))
+			},
+			want: "test9",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -266,11 +888,16 @@ func TestService_Handle_Error(t *testing.T) {
 			versionRepo := NewMockversionRepository(ctrl)
 			variableRepo := NewMockvariableRepository(ctrl)
 			constraintRepo := NewMockconstraintRepository(ctrl)
+			functionRepo := NewMockfunctionRepository(ctrl)
+			partialRepo := NewMockpartialRepository(ctrl)
+			versionPartialRepo := NewMockversionPartialRepository(ctrl)
+			versionFunctionRepo := NewMockversionFunctionRepository(ctrl)
+			dictionaryListService := NewMockdictionaryListService(ctrl)
 			trManager := test_trm.New()
 
-			tt.setup(templateRepo, versionRepo, variableRepo, constraintRepo)
+			tt.setup(templateRepo, versionRepo, variableRepo, constraintRepo, functionRepo, partialRepo, versionPartialRepo, versionFunctionRepo, dictionaryListService)
 
-			usecase := New(templateRepo, versionRepo, variableRepo, constraintRepo, trManager)
+			usecase := New(templateRepo, versionRepo, variableRepo, constraintRepo, functionRepo, partialRepo, versionPartialRepo, versionFunctionRepo, dictionaryListService, trManager)
 
 			_, err := usecase.Handle(ctx, tt.in)
 			require.ErrorContains(t, err, tt.want)
@@ -3,11 +3,27 @@ package domain
 import variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
 
 type Variable struct {
-	ID          int64
-	Name        string
-	Title       string
-	Type        variable_domain.Type
-	Expression  *string
-	IsInput     bool
+	ID                int64
+	Name              string
+	Title             string
+	Type              variable_domain.Type
+	Expression        *string
+	IsInput           bool
+	DefaultExpression *string
+	EnabledIf         *string
+	Unit              *string
+	Options           []string
+	// OptionsFrom refers to the dictionary column an enum takes its options
+	// from, such as "materials.key", instead of Options.
+	OptionsFrom *string
+	ItemType    *variable_domain.Type
+	Columns     []Column
 	Constraints []Constraint
 }
+
+type Column struct {
+	Name    string
+	Title   string
+	Type    variable_domain.Type
+	Options []string
+}
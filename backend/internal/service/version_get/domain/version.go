@@ -3,15 +3,31 @@ package domain
 import (
 	"errors"
 	"time"
+
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 )
 
 var ErrVersionNotFound = errors.New("version not found")
 
 type Version struct {
-	ID         int64
-	TemplateID int64
-	Number     int64
-	CreatedAt  time.Time
-	Data       []byte
-	Variables  []Variable
+	ID           int64
+	TemplateID   int64
+	Number       int64
+	CreatedAt    time.Time
+	Data         []byte
+	Kind         template_domain.Kind
+	Dependencies map[string][]string
+	Variables    []Variable
+	// Functions are the latest versions of the functions of the template
+	// project.
+	Functions []function_domain.Function
+	// Partials are the versions of the project partials pinned to the
+	// version when it was saved.
+	Partials []partial_domain.Partial
+	// OutputFormat and StyleProfile are the output settings of the template.
+	OutputFormat output_domain.Format
+	StyleProfile *string
 }
@@ -4,6 +4,8 @@ import (
 	"github.com/jmoiron/sqlx"
 
 	constraint_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_get/repository/constraint"
+	function_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_get/repository/function"
+	partial_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_get/repository/partial"
 	variable_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_get/repository/variable"
 	version_repository "github.com/qsoulior/tech-generator/backend/internal/service/version_get/repository/version"
 	"github.com/qsoulior/tech-generator/backend/internal/service/version_get/service"
@@ -13,5 +15,7 @@ func New(db *sqlx.DB) *service.Service {
 	versionRepo := version_repository.New(db)
 	variableRepo := variable_repository.New(db)
 	constraintRepo := constraint_repository.New(db)
-	return service.New(versionRepo, variableRepo, constraintRepo)
+	functionRepo := function_repository.New(db)
+	partialRepo := partial_repository.New(db)
+	return service.New(versionRepo, variableRepo, constraintRepo, functionRepo, partialRepo)
 }
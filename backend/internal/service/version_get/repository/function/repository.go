@@ -0,0 +1,55 @@
+package function_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// ListByVersionID returns the function versions pinned to the template version.
+func (r *Repository) ListByVersionID(ctx context.Context, versionID int64) ([]function_domain.Function, error) {
+	op := "function - list by version id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"fv.id AS version_id",
+			"f.name",
+			"fv.params",
+			"fv.body",
+		).
+		From("template_version_function vf").
+		Join("project_function_version fv ON vf.function_version_id = fv.id").
+		Join("project_function f ON fv.function_id = f.id").
+		Where(sq.Eq{"vf.version_id": versionID}).
+		OrderBy("f.name ASC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dtos []function
+	err = r.db.SelectContext(ctx, &dtos, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return lo.Map(dtos, func(f function, _ int) function_domain.Function { return f.toDomain() }), nil
+}
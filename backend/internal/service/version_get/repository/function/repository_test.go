@@ -0,0 +1,105 @@
+package function_repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_ListByVersionID() {
+	ctx := context.Background()
+	repo := New(s.C().DB())
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// template
+	template := test_db.GenerateEntity(func(t *test_db.Template) {
+		t.IsDefault = false
+		t.ProjectID = &projectID
+		t.AuthorID = nil
+	})
+	templateID, err := test_db.InsertEntityWithID[int64](s.C(), "template", template)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "template", templateID)) }()
+
+	// template versions
+	templateVersions := test_db.GenerateEntities(2, func(v *test_db.Version, i int) {
+		v.TemplateID = templateID
+		v.AuthorID = nil
+		v.Number = int64(i + 1)
+	})
+	templateVersionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "template_version", templateVersions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "template_version", templateVersionIDs))
+	}()
+
+	// function
+	function := test_db.GenerateEntity(func(f *test_db.ProjectFunction) {
+		f.ProjectID = projectID
+		f.AuthorID = nil
+		f.LastVersionID = nil
+	})
+	functionID, err := test_db.InsertEntityWithID[int64](s.C(), "project_function", function)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_function", functionID)) }()
+
+	// function versions
+	versions := test_db.GenerateEntities(2, func(v *test_db.ProjectFunctionVersion, i int) {
+		v.Number = int64(i + 1)
+		v.FunctionID = functionID
+		v.AuthorID = nil
+		v.Params = []byte(`["x"]`)
+	})
+	versionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_function_version", versions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_function_version", versionIDs))
+	}()
+
+	// the function moved on after the first template version was created
+	_, err = s.C().DB().Exec("UPDATE project_function SET last_version_id = $1 WHERE id = $2", versionIDs[1], functionID)
+	require.NoError(s.T(), err)
+
+	// version functions
+	versionFunctions := []test_db.VersionFunction{
+		{VersionID: templateVersionIDs[0], FunctionVersionID: versionIDs[0]},
+		{VersionID: templateVersionIDs[1], FunctionVersionID: versionIDs[1]},
+	}
+	_, err = test_db.InsertEntitiesWithColumn[int64](s.C(), "template_version_function", versionFunctions, "version_id")
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByColumn(s.C(), "template_version_function", "version_id", templateVersionIDs))
+	}()
+
+	got, err := repo.ListByVersionID(ctx, templateVersionIDs[0])
+	require.NoError(s.T(), err)
+
+	want := []function_domain.Function{
+		{VersionID: versionIDs[0], Name: function.Name, Params: []string{"x"}, Body: versions[0].Body},
+	}
+	require.Equal(s.T(), want, got)
+}
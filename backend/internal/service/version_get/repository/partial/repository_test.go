@@ -0,0 +1,101 @@
+package partial_repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_ListByVersionID() {
+	ctx := context.Background()
+	repo := New(s.C().DB())
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// template
+	template := test_db.GenerateEntity(func(t *test_db.Template) {
+		t.IsDefault = false
+		t.ProjectID = &projectID
+		t.AuthorID = nil
+	})
+	templateID, err := test_db.InsertEntityWithID[int64](s.C(), "template", template)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "template", templateID)) }()
+
+	// template versions
+	templateVersions := test_db.GenerateEntities(2, func(v *test_db.Version, i int) {
+		v.TemplateID = templateID
+		v.AuthorID = nil
+		v.Number = int64(i + 1)
+	})
+	templateVersionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "template_version", templateVersions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "template_version", templateVersionIDs))
+	}()
+
+	// partial
+	partial := test_db.GenerateEntity(func(p *test_db.ProjectPartial) {
+		p.Name = "title_page"
+		p.ProjectID = projectID
+		p.AuthorID = nil
+		p.LastVersionID = nil
+	})
+	partialID, err := test_db.InsertEntityWithID[int64](s.C(), "project_partial", partial)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_partial", partialID)) }()
+
+	// partial versions
+	versions := test_db.GenerateEntities(2, func(v *test_db.ProjectPartialVersion, i int) {
+		v.Number = int64(i + 1)
+		v.PartialID = partialID
+		v.AuthorID = nil
+	})
+	versionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_partial_version", versions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_partial_version", versionIDs))
+	}()
+
+	// version partials
+	versionPartials := []test_db.VersionPartial{
+		{VersionID: templateVersionIDs[0], PartialVersionID: versionIDs[0]},
+		{VersionID: templateVersionIDs[1], PartialVersionID: versionIDs[1]},
+	}
+	_, err = test_db.InsertEntitiesWithColumn[int64](s.C(), "template_version_partial", versionPartials, "version_id")
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByColumn(s.C(), "template_version_partial", "version_id", templateVersionIDs))
+	}()
+
+	got, err := repo.ListByVersionID(ctx, templateVersionIDs[0])
+	require.NoError(s.T(), err)
+
+	want := []partial_domain.Partial{
+		{VersionID: versionIDs[0], Name: "title_page", Data: versions[0].Data},
+	}
+	require.Equal(s.T(), want, got)
+}
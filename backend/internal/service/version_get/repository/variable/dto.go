@@ -1,26 +1,98 @@
 package variable_repository
 
 import (
+	"encoding/json"
+	"errors"
+
 	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
 	"github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
 )
 
 type variable struct {
-	ID         int64   `db:"id"`
-	Name       string  `db:"name"`
-	Title      string  `db:"title"`
-	Type       string  `db:"type"`
-	Expression *string `db:"expression"`
-	IsInput    bool    `db:"is_input"`
+	ID                int64   `db:"id"`
+	Name              string  `db:"name"`
+	Title             string  `db:"title"`
+	Type              string  `db:"type"`
+	Expression        *string `db:"expression"`
+	IsInput           bool    `db:"is_input"`
+	DefaultExpression *string `db:"default_expression"`
+	EnabledIf         *string `db:"enabled_if"`
+	Unit              *string `db:"unit"`
+	Options           options `db:"options"`
+	OptionsFrom       *string `db:"options_from"`
+	ItemType          *string `db:"item_type"`
+	Columns           columns `db:"columns"`
 }
 
 func (v *variable) toDomain() domain.Variable {
 	return domain.Variable{
-		ID:         v.ID,
-		Name:       v.Name,
-		Title:      v.Title,
-		Type:       variable_domain.Type(v.Type),
-		Expression: v.Expression,
-		IsInput:    v.IsInput,
+		ID:                v.ID,
+		Name:              v.Name,
+		Title:             v.Title,
+		Type:              variable_domain.Type(v.Type),
+		Expression:        v.Expression,
+		IsInput:           v.IsInput,
+		DefaultExpression: v.DefaultExpression,
+		EnabledIf:         v.EnabledIf,
+		Unit:              v.Unit,
+		Options:           v.Options,
+		OptionsFrom:       v.OptionsFrom,
+		ItemType:          (*variable_domain.Type)(v.ItemType),
+		Columns:           v.Columns.toDomain(),
+	}
+}
+
+type options []string
+
+func (o *options) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
 	}
+
+	return json.Unmarshal(b, &o)
+}
+
+type columns []column
+
+type column struct {
+	Name    string   `json:"name"`
+	Title   string   `json:"title"`
+	Type    string   `json:"type"`
+	Options []string `json:"options"`
+}
+
+func (c *columns) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &c)
+}
+
+func (c columns) toDomain() []domain.Column {
+	if len(c) == 0 {
+		return nil
+	}
+
+	out := make([]domain.Column, 0, len(c))
+	for _, v := range c {
+		out = append(out, domain.Column{
+			Name:    v.Name,
+			Title:   v.Title,
+			Type:    variable_domain.Type(v.Type),
+			Options: v.Options,
+		})
+	}
+
+	return out
 }
@@ -32,6 +32,13 @@ func (r *Repository) ListByVersionID(ctx context.Context, versionID int64) ([]do
 			"type",
 			"expression",
 			"is_input",
+			"default_expression",
+			"enabled_if",
+			"unit",
+			"options",
+			"options_from",
+			"item_type",
+			"columns",
 		).
 		From("variable").
 		Where(sq.Eq{"version_id": versionID}).
@@ -49,6 +49,7 @@ func (s *repositorySuite) TestRepository_ListByVersionID() {
 	variables := slices.Concat(
 		test_db.GenerateEntities(3, func(v *test_db.Variable, i int) {
 			v.VersionID = versionIDs[0]
+			v.OptionsFrom = lo.ToPtr("materials.key")
 		}),
 		test_db.GenerateEntities(2, func(v *test_db.Variable, i int) {
 			v.VersionID = versionIDs[1]
@@ -63,12 +64,16 @@ func (s *repositorySuite) TestRepository_ListByVersionID() {
 
 	want := lo.Map(variables[:3], func(v test_db.Variable, _ int) domain.Variable {
 		return domain.Variable{
-			ID:         v.ID,
-			Name:       v.Name,
-			Title:      v.Title,
-			Type:       variable_domain.Type(v.Type),
-			Expression: v.Expression,
-			IsInput:    v.IsInput,
+			ID:                v.ID,
+			Name:              v.Name,
+			Title:             v.Title,
+			Type:              variable_domain.Type(v.Type),
+			Expression:        v.Expression,
+			IsInput:           v.IsInput,
+			DefaultExpression: v.DefaultExpression,
+			EnabledIf:         v.EnabledIf,
+			Unit:              v.Unit,
+			OptionsFrom:       v.OptionsFrom,
 		}
 	})
 	slices.SortFunc(want, func(a, b domain.Variable) int { return int(a.ID - b.ID) })
@@ -1,25 +1,52 @@
 package version_repository
 
 import (
+	"encoding/json"
+	"errors"
 	"time"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 	"github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
 )
 
 type version struct {
-	ID         int64     `db:"id"`
-	TemplateID int64     `db:"template_id"`
-	Number     int64     `db:"number"`
-	CreatedAt  time.Time `db:"created_at"`
-	Data       []byte    `db:"data"`
+	ID           int64        `db:"id"`
+	TemplateID   int64        `db:"template_id"`
+	Number       int64        `db:"number"`
+	CreatedAt    time.Time    `db:"created_at"`
+	Data         []byte       `db:"data"`
+	Kind         string       `db:"kind"`
+	Dependencies dependencies `db:"dependencies"`
+	OutputFormat string       `db:"output_format"`
+	StyleProfile *string      `db:"style_profile"`
 }
 
 func (v *version) toDomain() *domain.Version {
 	return &domain.Version{
-		ID:         v.ID,
-		TemplateID: v.TemplateID,
-		Number:     v.Number,
-		CreatedAt:  v.CreatedAt,
-		Data:       v.Data,
+		ID:           v.ID,
+		TemplateID:   v.TemplateID,
+		Number:       v.Number,
+		CreatedAt:    v.CreatedAt,
+		Data:         v.Data,
+		Kind:         template_domain.Kind(v.Kind),
+		Dependencies: v.Dependencies,
+		OutputFormat: output_domain.Format(v.OutputFormat),
+		StyleProfile: v.StyleProfile,
 	}
 }
+
+type dependencies map[string][]string
+
+func (d *dependencies) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &d)
+}
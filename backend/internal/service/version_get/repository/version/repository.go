@@ -27,14 +27,19 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Version, er
 
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Select(
-			"id",
-			"template_id",
-			"number",
-			"created_at",
-			"data",
+			"v.id",
+			"v.template_id",
+			"v.number",
+			"v.created_at",
+			"v.data",
+			"v.kind",
+			"v.dependencies",
+			"t.output_format",
+			"t.style_profile",
 		).
-		From("template_version").
-		Where(sq.Eq{"id": id})
+		From("template_version v").
+		Join("template t ON v.template_id = t.id").
+		Where(sq.Eq{"v.id": id})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
 	"github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
 )
@@ -46,6 +48,7 @@ func (s *repositorySuite) TestRepository_GetByID() {
 		templateVersion := test_db.GenerateEntity(func(v *test_db.Version) {
 			v.TemplateID = templateID
 			v.AuthorID = &userID
+			v.Dependencies = []byte(`{"a": ["b"], "b": []}`)
 		})
 		templateVersionID, err := test_db.InsertEntityWithID[int64](s.C(), "template_version", templateVersion)
 		require.NoError(s.T(), err)
@@ -55,11 +58,15 @@ func (s *repositorySuite) TestRepository_GetByID() {
 		require.NoError(t, err)
 
 		want := domain.Version{
-			ID:         templateVersionID,
-			TemplateID: templateID,
-			Number:     templateVersion.Number,
-			CreatedAt:  templateVersion.CreatedAt.Truncate(1 * time.Microsecond),
-			Data:       templateVersion.Data,
+			ID:           templateVersionID,
+			TemplateID:   templateID,
+			Number:       templateVersion.Number,
+			CreatedAt:    templateVersion.CreatedAt.Truncate(1 * time.Microsecond),
+			Data:         templateVersion.Data,
+			Kind:         template_domain.Kind(templateVersion.Kind),
+			Dependencies: map[string][]string{"a": {"b"}, "b": {}},
+			OutputFormat: output_domain.Format(template.OutputFormat),
+			StyleProfile: template.StyleProfile,
 		}
 		require.Equal(t, want, *got)
 	})
@@ -5,6 +5,8 @@ package service
 import (
 	"context"
 
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
 	"github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
 )
 
@@ -19,3 +21,11 @@ type variableRepository interface {
 type constraintRepository interface {
 	ListByVariableIDs(ctx context.Context, variableIDs []int64) ([]domain.Constraint, error)
 }
+
+type functionRepository interface {
+	ListByVersionID(ctx context.Context, versionID int64) ([]function_domain.Function, error)
+}
+
+type partialRepository interface {
+	ListByVersionID(ctx context.Context, versionID int64) ([]partial_domain.Partial, error)
+}
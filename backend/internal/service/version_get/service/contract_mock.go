@@ -13,6 +13,8 @@ import (
 	context "context"
 	reflect "reflect"
 
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
 	domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -133,3 +135,81 @@ func (mr *MockconstraintRepositoryMockRecorder) ListByVariableIDs(ctx, variableI
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVariableIDs", reflect.TypeOf((*MockconstraintRepository)(nil).ListByVariableIDs), ctx, variableIDs)
 }
+
+// MockfunctionRepository is a mock of functionRepository interface.
+type MockfunctionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockfunctionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockfunctionRepositoryMockRecorder is the mock recorder for MockfunctionRepository.
+type MockfunctionRepositoryMockRecorder struct {
+	mock *MockfunctionRepository
+}
+
+// NewMockfunctionRepository creates a new mock instance.
+func NewMockfunctionRepository(ctrl *gomock.Controller) *MockfunctionRepository {
+	mock := &MockfunctionRepository{ctrl: ctrl}
+	mock.recorder = &MockfunctionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfunctionRepository) EXPECT() *MockfunctionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByVersionID mocks base method.
+func (m *MockfunctionRepository) ListByVersionID(ctx context.Context, versionID int64) ([]function_domain.Function, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVersionID", ctx, versionID)
+	ret0, _ := ret[0].([]function_domain.Function)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVersionID indicates an expected call of ListByVersionID.
+func (mr *MockfunctionRepositoryMockRecorder) ListByVersionID(ctx, versionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVersionID", reflect.TypeOf((*MockfunctionRepository)(nil).ListByVersionID), ctx, versionID)
+}
+
+// MockpartialRepository is a mock of partialRepository interface.
+type MockpartialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockpartialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockpartialRepositoryMockRecorder is the mock recorder for MockpartialRepository.
+type MockpartialRepositoryMockRecorder struct {
+	mock *MockpartialRepository
+}
+
+// NewMockpartialRepository creates a new mock instance.
+func NewMockpartialRepository(ctrl *gomock.Controller) *MockpartialRepository {
+	mock := &MockpartialRepository{ctrl: ctrl}
+	mock.recorder = &MockpartialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpartialRepository) EXPECT() *MockpartialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByVersionID mocks base method.
+func (m *MockpartialRepository) ListByVersionID(ctx context.Context, versionID int64) ([]partial_domain.Partial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVersionID", ctx, versionID)
+	ret0, _ := ret[0].([]partial_domain.Partial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVersionID indicates an expected call of ListByVersionID.
+func (mr *MockpartialRepositoryMockRecorder) ListByVersionID(ctx, versionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVersionID", reflect.TypeOf((*MockpartialRepository)(nil).ListByVersionID), ctx, versionID)
+}
@@ -13,17 +13,23 @@ type Service struct {
 	versionRepo    versionRepository
 	variableRepo   variableRepository
 	constraintRepo constraintRepository
+	functionRepo   functionRepository
+	partialRepo    partialRepository
 }
 
 func New(
 	versionRepo versionRepository,
 	variableRepo variableRepository,
 	constraintRepo constraintRepository,
+	functionRepo functionRepository,
+	partialRepo partialRepository,
 ) *Service {
 	return &Service{
 		versionRepo:    versionRepo,
 		variableRepo:   variableRepo,
 		constraintRepo: constraintRepo,
+		functionRepo:   functionRepo,
+		partialRepo:    partialRepo,
 	}
 }
 
@@ -49,6 +55,18 @@ func (u *Service) Handle(ctx context.Context, versionID int64) (*domain.Version,
 		return nil, err
 	}
 
+	// get pinned functions
+	version.Functions, err = u.functionRepo.ListByVersionID(ctx, version.ID)
+	if err != nil {
+		return nil, fmt.Errorf("function repo - list by version id: %w", err)
+	}
+
+	// get pinned partials
+	version.Partials, err = u.partialRepo.ListByVersionID(ctx, version.ID)
+	if err != nil {
+		return nil, fmt.Errorf("partial repo - list by version id: %w", err)
+	}
+
 	return version, nil
 }
 
@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
 	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
 	"github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
 )
@@ -21,12 +23,12 @@ func TestService_Handle_Success(t *testing.T) {
 
 	tests := []struct {
 		name  string
-		setup func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository)
+		setup func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository)
 		want  domain.Version
 	}{
 		{
 			name: "Variables",
-			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository) {
 				version := domain.Version{
 					ID:         versionID,
 					TemplateID: 1,
@@ -76,6 +78,16 @@ func TestService_Handle_Success(t *testing.T) {
 					},
 				}
 				constraintRepo.EXPECT().ListByVariableIDs(ctx, []int64{31, 32}).Return(constraints, nil)
+
+				functions := []function_domain.Function{
+					{VersionID: 51, Name: "tolerance", Params: []string{"x", "tol"}, Body: "x * tol / 100"},
+				}
+				functionRepo.EXPECT().ListByVersionID(ctx, versionID).Return(functions, nil)
+
+				partials := []partial_domain.Partial{
+					{VersionID: 61, Name: "title_page", Data: "{{ define \"title_page\" }}# Title{{ end }}"},
+				}
+				partialRepo.EXPECT().ListByVersionID(ctx, versionID).Return(partials, nil)
 			},
 			want: domain.Version{
 				ID:         versionID,
@@ -122,11 +134,17 @@ func TestService_Handle_Success(t *testing.T) {
 						},
 					},
 				},
+				Functions: []function_domain.Function{
+					{VersionID: 51, Name: "tolerance", Params: []string{"x", "tol"}, Body: "x * tol / 100"},
+				},
+				Partials: []partial_domain.Partial{
+					{VersionID: 61, Name: "title_page", Data: "{{ define \"title_page\" }}# Title{{ end }}"},
+				},
 			},
 		},
 		{
 			name: "NoVariables",
-			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository) {
 				version := domain.Version{
 					ID:         versionID,
 					TemplateID: 1,
@@ -138,6 +156,8 @@ func TestService_Handle_Success(t *testing.T) {
 
 				variables := []domain.Variable{}
 				variableRepo.EXPECT().ListByVersionID(ctx, versionID).Return(variables, nil)
+				functionRepo.EXPECT().ListByVersionID(ctx, versionID).Return(nil, nil)
+				partialRepo.EXPECT().ListByVersionID(ctx, versionID).Return(nil, nil)
 			},
 			want: domain.Version{
 				ID:         versionID,
@@ -157,10 +177,12 @@ func TestService_Handle_Success(t *testing.T) {
 			versionRepo := NewMockversionRepository(ctrl)
 			variableRepo := NewMockvariableRepository(ctrl)
 			constraintRepo := NewMockconstraintRepository(ctrl)
+			functionRepo := NewMockfunctionRepository(ctrl)
+			partialRepo := NewMockpartialRepository(ctrl)
 
-			tt.setup(versionRepo, variableRepo, constraintRepo)
+			tt.setup(versionRepo, variableRepo, constraintRepo, functionRepo, partialRepo)
 
-			usecase := New(versionRepo, variableRepo, constraintRepo)
+			usecase := New(versionRepo, variableRepo, constraintRepo, functionRepo, partialRepo)
 
 			got, err := usecase.Handle(ctx, versionID)
 			require.NoError(t, err)
@@ -176,26 +198,26 @@ func TestService_Handle_Error(t *testing.T) {
 
 	tests := []struct {
 		name  string
-		setup func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository)
+		setup func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository)
 		want  string
 	}{
 		{
 			name: "versionRepo_GetByID",
-			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository) {
 				versionRepo.EXPECT().GetByID(ctx, versionID).Return(nil, errors.New("test3"))
 			},
 			want: "test3",
 		},
 		{
 			name: "domain_ErrTemplateVersionNotFound",
-			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository) {
 				versionRepo.EXPECT().GetByID(ctx, versionID).Return(nil, nil)
 			},
 			want: domain.ErrVersionNotFound.Error(),
 		},
 		{
 			name: "variableRepo_ListByVersionID",
-			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository) {
 				version := domain.Version{ID: versionID, Data: []byte{1, 2, 3}}
 				versionRepo.EXPECT().GetByID(ctx, versionID).Return(&version, nil)
 				variableRepo.EXPECT().ListByVersionID(ctx, versionID).Return(nil, errors.New("test4"))
@@ -204,7 +226,7 @@ func TestService_Handle_Error(t *testing.T) {
 		},
 		{
 			name: "constraintRepo_ListByVariableIDs",
-			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository) {
+			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository) {
 				version := domain.Version{ID: versionID, Data: []byte{1, 2, 3}}
 				versionRepo.EXPECT().GetByID(ctx, versionID).Return(&version, nil)
 
@@ -221,6 +243,27 @@ func TestService_Handle_Error(t *testing.T) {
 			},
 			want: "test5",
 		},
+		{
+			name: "functionRepo_ListByVersionID",
+			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository) {
+				version := domain.Version{ID: versionID, TemplateID: 1, Data: []byte{1, 2, 3}}
+				versionRepo.EXPECT().GetByID(ctx, versionID).Return(&version, nil)
+				variableRepo.EXPECT().ListByVersionID(ctx, versionID).Return(nil, nil)
+				functionRepo.EXPECT().ListByVersionID(ctx, versionID).Return(nil, errors.New("test6"))
+			},
+			want: "test6",
+		},
+		{
+			name: "partialRepo_ListByVersionID",
+			setup: func(versionRepo *MockversionRepository, variableRepo *MockvariableRepository, constraintRepo *MockconstraintRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository) {
+				version := domain.Version{ID: versionID, TemplateID: 1, Data: []byte{1, 2, 3}}
+				versionRepo.EXPECT().GetByID(ctx, versionID).Return(&version, nil)
+				variableRepo.EXPECT().ListByVersionID(ctx, versionID).Return(nil, nil)
+				functionRepo.EXPECT().ListByVersionID(ctx, versionID).Return(nil, nil)
+				partialRepo.EXPECT().ListByVersionID(ctx, versionID).Return(nil, errors.New("test7"))
+			},
+			want: "test7",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -230,10 +273,12 @@ func TestService_Handle_Error(t *testing.T) {
 			versionRepo := NewMockversionRepository(ctrl)
 			variableRepo := NewMockvariableRepository(ctrl)
 			constraintRepo := NewMockconstraintRepository(ctrl)
+			functionRepo := NewMockfunctionRepository(ctrl)
+			partialRepo := NewMockpartialRepository(ctrl)
 
-			tt.setup(versionRepo, variableRepo, constraintRepo)
+			tt.setup(versionRepo, variableRepo, constraintRepo, functionRepo, partialRepo)
 
-			usecase := New(versionRepo, variableRepo, constraintRepo)
+			usecase := New(versionRepo, variableRepo, constraintRepo, functionRepo, partialRepo)
 
 			_, err := usecase.Handle(ctx, versionID)
 			require.ErrorContains(t, err, tt.want)
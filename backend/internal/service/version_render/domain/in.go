@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"time"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+	data_process_domain "github.com/qsoulior/tech-generator/backend/internal/service/data_process/domain"
+	variable_process_domain "github.com/qsoulior/tech-generator/backend/internal/service/variable_process/domain"
+)
+
+type Variable = variable_process_domain.Variable
+
+type VersionRenderIn struct {
+	// VersionID identifies a saved version. Zero means a draft, which is never
+	// cached.
+	VersionID int64
+	Data      []byte
+	// Kind is the kind of the template in Data. Empty means Markdown.
+	Kind         template_domain.Kind
+	Variables    []Variable
+	Dependencies map[string][]string
+	Payload      map[string]any
+	// Functions are the project functions the expressions may call.
+	Functions []function_domain.Function
+	// Dictionaries are the project dictionaries the expressions may read.
+	Dictionaries []dictionary_domain.Dictionary
+	// Constants are the project constants the expressions and the template
+	// may read.
+	Constants map[string]string
+	// Partials are the project partials the template may call.
+	Partials []partial_domain.Partial
+	// Trace makes the render explain how every variable value was reached.
+	Trace bool
+	// Now is the render clock, so that rendering a task again gives the same
+	// dates. Zero means the current time.
+	Now time.Time
+	// MarkValues marks the values the template prints, so that an XLSX
+	// workbook types its cells by them.
+	MarkValues bool
+}
+
+type VariableProcessIn = variable_process_domain.VariableProcessIn
+
+type DataProcessIn = data_process_domain.DataProcessIn
@@ -0,0 +1,27 @@
+package version_render_service
+
+import (
+	"time"
+
+	data_process_service "github.com/qsoulior/tech-generator/backend/internal/service/data_process"
+	variable_process_service "github.com/qsoulior/tech-generator/backend/internal/service/variable_process"
+	"github.com/qsoulior/tech-generator/backend/internal/service/version_render/service"
+)
+
+func New(timeout time.Duration, maxOutputBytes int, budget uint, concurrency int) *service.Service {
+	variableProcessService := variable_process_service.New()
+	dataProcessService := data_process_service.New()
+	return service.New(variableProcessService, dataProcessService, timeout, maxOutputBytes, budget, concurrency)
+}
+
+func NewCached(
+	variableCache *variable_process_service.Cache,
+	templateCache *data_process_service.Cache,
+	timeout time.Duration,
+	maxOutputBytes int,
+	budget uint,
+) *service.Service {
+	variableProcessService := variable_process_service.NewCached(variableCache)
+	dataProcessService := data_process_service.NewCached(templateCache)
+	return service.New(variableProcessService, dataProcessService, timeout, maxOutputBytes, budget, 0)
+}
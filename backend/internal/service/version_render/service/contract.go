@@ -0,0 +1,19 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package service
+
+import (
+	"context"
+
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	"github.com/qsoulior/tech-generator/backend/internal/service/version_render/domain"
+)
+
+type variableProcessService interface {
+	Handle(ctx context.Context, in domain.VariableProcessIn) (map[string]any, error)
+	HandleWithTrace(ctx context.Context, in domain.VariableProcessIn) (map[string]any, []task_domain.VariableTrace, error)
+}
+
+type dataProcessService interface {
+	Handle(ctx context.Context, in domain.DataProcessIn) ([]byte, error)
+}
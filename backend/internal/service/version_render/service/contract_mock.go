@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -package service -source contract.go -destination contract_mock.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	domain "github.com/qsoulior/tech-generator/backend/internal/service/version_render/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockvariableProcessService is a mock of variableProcessService interface.
+type MockvariableProcessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockvariableProcessServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockvariableProcessServiceMockRecorder is the mock recorder for MockvariableProcessService.
+type MockvariableProcessServiceMockRecorder struct {
+	mock *MockvariableProcessService
+}
+
+// NewMockvariableProcessService creates a new mock instance.
+func NewMockvariableProcessService(ctrl *gomock.Controller) *MockvariableProcessService {
+	mock := &MockvariableProcessService{ctrl: ctrl}
+	mock.recorder = &MockvariableProcessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockvariableProcessService) EXPECT() *MockvariableProcessServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockvariableProcessService) Handle(ctx context.Context, in domain.VariableProcessIn) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, in)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockvariableProcessServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockvariableProcessService)(nil).Handle), ctx, in)
+}
+
+// HandleWithTrace mocks base method.
+func (m *MockvariableProcessService) HandleWithTrace(ctx context.Context, in domain.VariableProcessIn) (map[string]any, []task_domain.VariableTrace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleWithTrace", ctx, in)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].([]task_domain.VariableTrace)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HandleWithTrace indicates an expected call of HandleWithTrace.
+func (mr *MockvariableProcessServiceMockRecorder) HandleWithTrace(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleWithTrace", reflect.TypeOf((*MockvariableProcessService)(nil).HandleWithTrace), ctx, in)
+}
+
+// MockdataProcessService is a mock of dataProcessService interface.
+type MockdataProcessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockdataProcessServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockdataProcessServiceMockRecorder is the mock recorder for MockdataProcessService.
+type MockdataProcessServiceMockRecorder struct {
+	mock *MockdataProcessService
+}
+
+// NewMockdataProcessService creates a new mock instance.
+func NewMockdataProcessService(ctrl *gomock.Controller) *MockdataProcessService {
+	mock := &MockdataProcessService{ctrl: ctrl}
+	mock.recorder = &MockdataProcessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdataProcessService) EXPECT() *MockdataProcessServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockdataProcessService) Handle(ctx context.Context, in domain.DataProcessIn) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, in)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockdataProcessServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockdataProcessService)(nil).Handle), ctx, in)
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	"github.com/qsoulior/tech-generator/backend/internal/service/version_render/domain"
+)
+
+type Service struct {
+	variableProcessService variableProcessService
+	dataProcessService     dataProcessService
+	timeout                time.Duration
+	maxOutputBytes         int
+	budget                 uint
+	// renders limits the renders running at once, nil means no limit
+	renders *semaphore.Weighted
+}
+
+func New(
+	variableProcessService variableProcessService,
+	dataProcessService dataProcessService,
+	timeout time.Duration,
+	maxOutputBytes int,
+	budget uint,
+	concurrency int,
+) *Service {
+	s := &Service{
+		variableProcessService: variableProcessService,
+		dataProcessService:     dataProcessService,
+		timeout:                timeout,
+		maxOutputBytes:         maxOutputBytes,
+		budget:                 budget,
+	}
+	if concurrency > 0 {
+		s.renders = semaphore.NewWeighted(int64(concurrency))
+	}
+	return s
+}
+
+// Handle renders the version in a separate goroutine and stops waiting for it
+// once the timeout expires. The render itself stops at its next variable,
+// condition, constraint, template call, range iteration, helper call or write,
+// so it doesn't outlive the timeout and keep its slot. When the concurrency is
+// limited, a render waits for a slot within the timeout and fails as busy
+// otherwise. The trace is only returned when requested, along with a process
+// error if variables fail.
+func (s *Service) Handle(ctx context.Context, in domain.VersionRenderIn) ([]byte, []task_domain.VariableTrace, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	if s.renders != nil {
+		if err := s.renders.Acquire(ctx, 1); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, nil, &task_domain.ProcessError{Message: task_domain.MessageBusy}
+			}
+			return nil, nil, err
+		}
+	}
+
+	type rendered struct {
+		result []byte
+		trace  []task_domain.VariableTrace
+		err    error
+	}
+
+	done := make(chan rendered, 1)
+	go func() {
+		if s.renders != nil {
+			defer s.renders.Release(1)
+		}
+
+		result, trace, err := s.render(ctx, in)
+		done <- rendered{result: result, trace: trace, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.result, r.trace, r.err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, nil, &task_domain.ProcessError{Message: task_domain.MessageTimeout}
+		}
+		return nil, nil, ctx.Err()
+	}
+}
+
+func (s *Service) render(ctx context.Context, in domain.VersionRenderIn) ([]byte, []task_domain.VariableTrace, error) {
+	// process variables
+	variableValues, variableTrace, err := s.processVariables(ctx, in)
+	if err != nil {
+		return nil, variableTrace, err
+	}
+
+	// process data
+	dataProcessIn := domain.DataProcessIn{
+		VersionID:      in.VersionID,
+		Values:         variableValues,
+		Data:           in.Data,
+		Kind:           in.Kind,
+		Partials:       in.Partials,
+		MaxOutputBytes: s.maxOutputBytes,
+		Budget:         s.budget,
+		Now:            in.Now,
+		MarkValues:     in.MarkValues,
+	}
+	result, err := s.dataProcessService.Handle(ctx, dataProcessIn)
+	if err != nil {
+		return nil, variableTrace, err
+	}
+
+	return result, variableTrace, nil
+}
+
+func (s *Service) processVariables(ctx context.Context, in domain.VersionRenderIn) (map[string]any, []task_domain.VariableTrace, error) {
+	variableProcessIn := domain.VariableProcessIn{
+		VersionID:    in.VersionID,
+		Variables:    in.Variables,
+		Dependencies: in.Dependencies,
+		Payload:      in.Payload,
+		Functions:    in.Functions,
+		Dictionaries: in.Dictionaries,
+		Constants:    in.Constants,
+		Budget:       s.budget,
+		Now:          in.Now,
+	}
+
+	if in.Trace {
+		return s.variableProcessService.HandleWithTrace(ctx, variableProcessIn)
+	}
+
+	variableValues, err := s.variableProcessService.Handle(ctx, variableProcessIn)
+	return variableValues, nil, err
+}
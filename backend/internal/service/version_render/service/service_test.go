@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	"github.com/qsoulior/tech-generator/backend/internal/service/version_render/domain"
+)
+
+const (
+	testTimeout        = time.Second
+	testMaxOutputBytes = 1024
+	testBudget         = 1000
+)
+
+func TestService_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+
+	in := domain.VersionRenderIn{
+		VersionID:    7,
+		Data:         []byte("{{ .k }}"),
+		Dependencies: map[string][]string{"k": {}},
+		Payload:      map[string]any{"k": "v"},
+		Partials:     []partial_domain.Partial{{VersionID: 3, Name: "title_page", Data: "# {{ .k }}"}},
+	}
+
+	values := map[string]any{"k": "v"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	variableProcessService := NewMockvariableProcessService(ctrl)
+	dataProcessService := NewMockdataProcessService(ctrl)
+
+	variableProcessIn := domain.VariableProcessIn{VersionID: 7, Variables: in.Variables, Dependencies: in.Dependencies, Payload: in.Payload, Budget: testBudget}
+	variableProcessService.EXPECT().Handle(gomock.Any(), variableProcessIn).Return(values, nil)
+
+	dataProcessIn := domain.DataProcessIn{VersionID: 7, Values: values, Data: in.Data, Partials: in.Partials, MaxOutputBytes: testMaxOutputBytes, Budget: testBudget}
+	dataProcessService.EXPECT().Handle(gomock.Any(), dataProcessIn).Return([]byte("v"), nil)
+
+	service := New(variableProcessService, dataProcessService, testTimeout, testMaxOutputBytes, testBudget, 0)
+	got, gotTrace, err := service.Handle(ctx, in)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v"), got)
+	require.Nil(t, gotTrace)
+}
+
+func TestService_Handle_Trace(t *testing.T) {
+	ctx := context.Background()
+
+	in := domain.VersionRenderIn{
+		Data:    []byte("{{ .k }}"),
+		Payload: map[string]any{"k": "v"},
+		Trace:   true,
+	}
+
+	values := map[string]any{"k": "v"}
+	trace := []task_domain.VariableTrace{{Order: 1, Name: "k", Source: task_domain.TraceSourcePayload, Value: "v", Type: "string"}}
+
+	tests := []struct {
+		name       string
+		setup      func(variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService)
+		wantResult []byte
+		wantErr    bool
+	}{
+		{
+			name: "Result",
+			setup: func(variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService) {
+				variableProcessService.EXPECT().HandleWithTrace(gomock.Any(), gomock.Any()).Return(values, trace, nil)
+				dataProcessService.EXPECT().Handle(gomock.Any(), gomock.Any()).Return([]byte("v"), nil)
+			},
+			wantResult: []byte("v"),
+		},
+		{
+			name: "variableProcessService_ProcessError",
+			setup: func(variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService) {
+				variableProcessService.EXPECT().HandleWithTrace(gomock.Any(), gomock.Any()).Return(nil, trace, &task_domain.ProcessError{Message: "test1"})
+			},
+			wantErr: true,
+		},
+		{
+			name: "dataProcessService_ProcessError",
+			setup: func(variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService) {
+				variableProcessService.EXPECT().HandleWithTrace(gomock.Any(), gomock.Any()).Return(values, trace, nil)
+				dataProcessService.EXPECT().Handle(gomock.Any(), gomock.Any()).Return(nil, &task_domain.ProcessError{Message: task_domain.MessageOutputLimit})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			variableProcessService := NewMockvariableProcessService(ctrl)
+			dataProcessService := NewMockdataProcessService(ctrl)
+			tt.setup(variableProcessService, dataProcessService)
+
+			service := New(variableProcessService, dataProcessService, testTimeout, testMaxOutputBytes, testBudget, 0)
+			got, gotTrace, err := service.Handle(ctx, in)
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Equal(t, tt.wantResult, got)
+			require.Equal(t, trace, gotTrace)
+		})
+	}
+}
+
+func TestService_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+
+	testErr := errors.New("test error")
+
+	values := map[string]any{"k": "v"}
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		setup   func(variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService)
+		want    error
+	}{
+		{
+			name:    "variableProcessService_Handle",
+			timeout: testTimeout,
+			setup: func(variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService) {
+				variableProcessService.EXPECT().Handle(gomock.Any(), gomock.Any()).Return(nil, testErr)
+			},
+			want: testErr,
+		},
+		{
+			name:    "variableProcessService_ProcessError",
+			timeout: testTimeout,
+			setup: func(variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService) {
+				variableProcessService.EXPECT().Handle(gomock.Any(), gomock.Any()).Return(nil, &task_domain.ProcessError{Message: "test1"})
+			},
+			want: &task_domain.ProcessError{Message: "test1"},
+		},
+		{
+			name:    "dataProcessService_ProcessError",
+			timeout: testTimeout,
+			setup: func(variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService) {
+				variableProcessService.EXPECT().Handle(gomock.Any(), gomock.Any()).Return(values, nil)
+				dataProcessService.EXPECT().Handle(gomock.Any(), gomock.Any()).Return(nil, &task_domain.ProcessError{Message: task_domain.MessageOutputLimit})
+			},
+			want: &task_domain.ProcessError{Message: task_domain.MessageOutputLimit},
+		},
+		{
+			name:    "Timeout",
+			timeout: 10 * time.Millisecond,
+			setup: func(variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService) {
+				variableProcessService.EXPECT().Handle(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, _ domain.VariableProcessIn) (map[string]any, error) {
+						<-ctx.Done()
+						return values, nil
+					},
+				)
+				dataProcessService.EXPECT().Handle(gomock.Any(), gomock.Any()).Return([]byte("v"), nil).AnyTimes()
+			},
+			want: &task_domain.ProcessError{Message: task_domain.MessageTimeout},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			variableProcessService := NewMockvariableProcessService(ctrl)
+			dataProcessService := NewMockdataProcessService(ctrl)
+			tt.setup(variableProcessService, dataProcessService)
+
+			service := New(variableProcessService, dataProcessService, tt.timeout, testMaxOutputBytes, testBudget, 0)
+			_, _, err := service.Handle(ctx, domain.VersionRenderIn{})
+
+			var processErr *task_domain.ProcessError
+			if errors.As(tt.want, &processErr) {
+				var got *task_domain.ProcessError
+				require.ErrorAs(t, err, &got)
+				require.Equal(t, processErr, got)
+				return
+			}
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestService_Handle_Busy(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	variableProcessService := NewMockvariableProcessService(ctrl)
+	dataProcessService := NewMockdataProcessService(ctrl)
+
+	started, release := make(chan struct{}), make(chan struct{})
+	variableProcessService.EXPECT().Handle(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, domain.VariableProcessIn) (map[string]any, error) {
+			close(started)
+			<-release
+			return nil, &task_domain.ProcessError{Message: "test"}
+		},
+	)
+
+	service := New(variableProcessService, dataProcessService, 50*time.Millisecond, testMaxOutputBytes, testBudget, 1)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _, _ = service.Handle(ctx, domain.VersionRenderIn{})
+	}()
+	<-started
+
+	_, _, err := service.Handle(ctx, domain.VersionRenderIn{})
+
+	var got *task_domain.ProcessError
+	require.ErrorAs(t, err, &got)
+	require.Equal(t, task_domain.ProcessError{Message: task_domain.MessageBusy}, *got)
+
+	close(release)
+	<-done
+}
@@ -0,0 +1,183 @@
+package process_converter
+
+import (
+	"github.com/samber/lo"
+
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+)
+
+// ConvertErrorToResponse converts the error of processing a version.
+func ConvertErrorToResponse(processError task_domain.ProcessError) api.ProcessError {
+	item := api.ProcessError{
+		Cycle:          processError.Cycle,
+		VariableErrors: convertVariableErrorsToResponse(processError.VariableErrors),
+	}
+
+	if processError.Message != "" {
+		item.Message.SetTo(processError.Message)
+	}
+
+	if processError.Template != nil {
+		item.Template.SetTo(convertTemplateErrorToResponse(*processError.Template))
+	}
+
+	return item
+}
+
+func convertTemplateErrorToResponse(templateError task_domain.TemplateError) api.ProcessErrorTemplate {
+	item := api.ProcessErrorTemplate{
+		Line: templateError.Line,
+	}
+
+	if templateError.Column > 0 {
+		item.Column.SetTo(templateError.Column)
+	}
+
+	if templateError.Snippet != "" {
+		item.Snippet.SetTo(templateError.Snippet)
+	}
+
+	if templateError.Detail != "" {
+		item.Detail.SetTo(templateError.Detail)
+	}
+
+	if templateError.Partial != "" {
+		item.Partial.SetTo(templateError.Partial)
+	}
+
+	return item
+}
+
+func convertVariableErrorsToResponse(variableErrors []task_domain.VariableError) []api.ProcessErrorVariableErrorsItem {
+	return lo.Map(variableErrors, func(v task_domain.VariableError, _ int) api.ProcessErrorVariableErrorsItem {
+		item := api.ProcessErrorVariableErrorsItem{
+			ID:               v.ID,
+			Name:             v.Name,
+			Title:            v.Title,
+			ConstraintErrors: convertConstraintErrorsToResponse(v.ConstraintErrors),
+		}
+
+		if v.Value != "" {
+			item.Value.SetTo(v.Value)
+		}
+
+		if v.Path != "" {
+			item.Path.SetTo(v.Path)
+		}
+
+		if v.Message != "" {
+			item.Message.SetTo(v.Message)
+		}
+
+		return item
+	})
+}
+
+func convertConstraintErrorsToResponse(constraintErrors []task_domain.ConstraintError) []api.ProcessErrorVariableErrorsItemConstraintErrorsItem {
+	return lo.Map(constraintErrors, func(c task_domain.ConstraintError, _ int) api.ProcessErrorVariableErrorsItemConstraintErrorsItem {
+		item := api.ProcessErrorVariableErrorsItemConstraintErrorsItem{
+			ID:         c.ID,
+			Name:       c.Name,
+			Expression: c.Expression,
+			Variables:  convertConstraintVariablesToResponse(c.Variables),
+		}
+
+		if c.Message != "" {
+			item.Message.SetTo(c.Message)
+		}
+
+		return item
+	})
+}
+
+func convertConstraintVariablesToResponse(variables []task_domain.ConstraintVariable) []api.ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem {
+	return lo.Map(variables, func(v task_domain.ConstraintVariable, _ int) api.ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem {
+		item := api.ProcessErrorVariableErrorsItemConstraintErrorsItemVariablesItem{
+			Name: v.Name,
+		}
+
+		if v.Value != "" {
+			item.Value.SetTo(v.Value)
+		}
+
+		return item
+	})
+}
+
+// ConvertTraceToResponse converts the trace of processing a version, which is
+// nil unless requested.
+func ConvertTraceToResponse(trace []task_domain.VariableTrace) api.Trace {
+	if trace == nil {
+		return nil
+	}
+
+	return lo.Map(trace, func(v task_domain.VariableTrace, _ int) api.TraceItem {
+		item := api.TraceItem{
+			Order:        v.Order,
+			Name:         v.Name,
+			Dependencies: convertTraceDependenciesToResponse(v.Dependencies),
+			Constraints:  convertTraceConstraintsToResponse(v.Constraints),
+		}
+
+		if v.Source != "" {
+			item.Source.SetTo(api.TraceItemSource(v.Source))
+		}
+
+		if v.Condition != "" {
+			item.Condition.SetTo(v.Condition)
+		}
+
+		if v.Expression != "" {
+			item.Expression.SetTo(v.Expression)
+		}
+
+		if v.Value != "" {
+			item.Value.SetTo(v.Value)
+		}
+
+		if v.Type != "" {
+			item.Type.SetTo(v.Type)
+		}
+
+		if v.Message != "" {
+			item.Message.SetTo(v.Message)
+		}
+
+		return item
+	})
+}
+
+func convertTraceDependenciesToResponse(dependencies []task_domain.TraceDependency) []api.TraceItemDependenciesItem {
+	return lo.Map(dependencies, func(d task_domain.TraceDependency, _ int) api.TraceItemDependenciesItem {
+		item := api.TraceItemDependenciesItem{
+			Name: d.Name,
+		}
+
+		if d.Value != "" {
+			item.Value.SetTo(d.Value)
+		}
+
+		if d.Type != "" {
+			item.Type.SetTo(d.Type)
+		}
+
+		return item
+	})
+}
+
+func convertTraceConstraintsToResponse(constraints []task_domain.ConstraintTrace) []api.TraceItemConstraintsItem {
+	return lo.Map(constraints, func(c task_domain.ConstraintTrace, _ int) api.TraceItemConstraintsItem {
+		item := api.TraceItemConstraintsItem{
+			Name:       c.Name,
+			Expression: c.Expression,
+			Passed:     c.Passed,
+		}
+
+		if c.Message != "" {
+			item.Message.SetTo(c.Message)
+		}
+
+		return item
+	})
+}
@@ -1,10 +1,21 @@
 package http
 
 import (
+	project_constant_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_constant_list"
+	project_constant_save_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_constant_save"
 	project_create_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_create"
 	project_delete_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_delete"
+	project_dictionary_delete_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_dictionary_delete"
+	project_dictionary_get_by_id_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_dictionary_get_by_id"
+	project_dictionary_import_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_dictionary_import"
+	project_dictionary_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_dictionary_list"
+	project_dictionary_save_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_dictionary_save"
+	project_function_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_function_list"
+	project_function_save_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_function_save"
 	project_get_by_id_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_get_by_id"
 	project_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_list"
+	project_partial_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_partial_list"
+	project_partial_save_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_partial_save"
 	project_update_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_update"
 	project_update_users_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_update_users"
 	project_users_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/project_users"
@@ -30,13 +41,26 @@ import (
 	version_create_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/version_create"
 	version_create_from_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/version_create_from"
 	version_list_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/version_list"
+	version_preview_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/version_preview"
+	version_preview_draft_handler "github.com/qsoulior/tech-generator/backend/internal/transport/http/handler/version_preview_draft"
 )
 
 type Handler struct {
+	*ProjectConstantListHandler
+	*ProjectConstantSaveHandler
 	*ProjectCreateHandler
 	*ProjectDeleteHandler
+	*ProjectDictionaryDeleteHandler
+	*ProjectDictionaryGetByIDHandler
+	*ProjectDictionaryImportHandler
+	*ProjectDictionaryListHandler
+	*ProjectDictionarySaveHandler
+	*ProjectFunctionListHandler
+	*ProjectFunctionSaveHandler
 	*ProjectGetByIDHandler
 	*ProjectListHandler
+	*ProjectPartialListHandler
+	*ProjectPartialSaveHandler
 	*ProjectUpdateHandler
 	*ProjectUpdateUsersHandler
 	*ProjectUsersHandler
@@ -62,13 +86,26 @@ type Handler struct {
 	*VersionCreateHandler
 	*VersionCreateFromHandler
 	*VersionListHandler
+	*VersionPreviewHandler
+	*VersionPreviewDraftHandler
 }
 
 type (
+	ProjectConstantListHandler       = project_constant_list_handler.Handler
+	ProjectConstantSaveHandler       = project_constant_save_handler.Handler
 	ProjectCreateHandler             = project_create_handler.Handler
 	ProjectDeleteHandler             = project_delete_handler.Handler
+	ProjectDictionaryDeleteHandler   = project_dictionary_delete_handler.Handler
+	ProjectDictionaryGetByIDHandler  = project_dictionary_get_by_id_handler.Handler
+	ProjectDictionaryImportHandler   = project_dictionary_import_handler.Handler
+	ProjectDictionaryListHandler     = project_dictionary_list_handler.Handler
+	ProjectDictionarySaveHandler     = project_dictionary_save_handler.Handler
+	ProjectFunctionListHandler       = project_function_list_handler.Handler
+	ProjectFunctionSaveHandler       = project_function_save_handler.Handler
 	ProjectGetByIDHandler            = project_get_by_id_handler.Handler
 	ProjectListHandler               = project_list_handler.Handler
+	ProjectPartialListHandler        = project_partial_list_handler.Handler
+	ProjectPartialSaveHandler        = project_partial_save_handler.Handler
 	ProjectUpdateHandler             = project_update_handler.Handler
 	ProjectUpdateUsersHandler        = project_update_users_handler.Handler
 	ProjectUsersHandler              = project_users_handler.Handler
@@ -94,4 +131,6 @@ type (
 	VersionCreateHandler             = version_create_handler.Handler
 	VersionCreateFromHandler         = version_create_from_handler.Handler
 	VersionListHandler               = version_list_handler.Handler
+	VersionPreviewHandler            = version_preview_handler.Handler
+	VersionPreviewDraftHandler       = version_preview_draft_handler.Handler
 )
@@ -0,0 +1,49 @@
+package project_constant_list_handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_list/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) ProjectConstantList(ctx context.Context, params api.ProjectConstantListParams) (api.ProjectConstantListRes, error) {
+	in := domain.ProjectConstantListIn{
+		ProjectID: params.ProjectID,
+		UserID:    params.XUserID,
+	}
+
+	constants, err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+		return nil, fmt.Errorf("project constant list usecase: %w", err)
+	}
+
+	resp := api.ProjectConstantListResponse{
+		Constants: lo.Map(constants, func(c domain.Constant, _ int) api.ProjectConstantListResponseConstantsItem {
+			return api.ProjectConstantListResponseConstantsItem{
+				Name:  c.Name,
+				Value: c.Value,
+			}
+		}),
+	}
+	return &resp, nil
+}
@@ -0,0 +1,82 @@
+package project_constant_list_handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_list/domain"
+)
+
+func TestHandler_ProjectConstantList_Success(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectConstantListParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().
+		Handle(ctx, domain.ProjectConstantListIn{ProjectID: 10, UserID: 1}).
+		Return([]domain.Constant{
+			{Name: "inn", Value: "7701234567"},
+			{Name: "org_name", Value: "ООО Ромашка"},
+		}, nil)
+
+	handler := New(usecase)
+	got, err := handler.ProjectConstantList(ctx, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.ProjectConstantListResponse)
+	require.True(t, ok, "expected *api.ProjectConstantListResponse, got %T", got)
+
+	want := []api.ProjectConstantListResponseConstantsItem{
+		{Name: "inn", Value: "7701234567"},
+		{Name: "org_name", Value: "ООО Ромашка"},
+	}
+	require.Equal(t, want, resp.Constants)
+}
+
+func TestHandler_ProjectConstantList_BaseError(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectConstantListParams{ProjectID: 10, XUserID: 1}
+
+	for _, wantErr := range []error{domain.ErrProjectNotFound, domain.ErrProjectInvalid} {
+		t.Run(wantErr.Error(), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, wantErr)
+
+			handler := New(usecase)
+			got, err := handler.ProjectConstantList(ctx, params)
+			require.NoError(t, err)
+
+			resp, ok := got.(*api.Error)
+			require.True(t, ok, "expected *api.Error, got %T", got)
+			require.Equal(t, wantErr.Error(), resp.Message)
+		})
+	}
+}
+
+func TestHandler_ProjectConstantList_InternalError(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectConstantListParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.ProjectConstantList(ctx, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "project constant list usecase")
+	require.ErrorContains(t, err, "boom")
+}
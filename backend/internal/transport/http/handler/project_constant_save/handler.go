@@ -0,0 +1,53 @@
+package project_constant_save_handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) ProjectConstantSave(ctx context.Context, req *api.ProjectConstantSaveRequest, params api.ProjectConstantSaveParams) (api.ProjectConstantSaveRes, error) {
+	in := domain.ProjectConstantSaveIn{
+		ProjectID: params.ProjectID,
+		AuthorID:  params.XUserID,
+		Constants: lo.Map(req.Constants, func(c api.ProjectConstantSaveRequestConstantsItem, _ int) domain.Constant {
+			return domain.Constant{
+				Name:  c.Name,
+				Value: c.Value,
+			}
+		}),
+	}
+
+	err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
+		var validationErr *error_domain.ValidationError
+		if errors.As(err, &validationErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
+		return nil, fmt.Errorf("project constant save usecase: %w", err)
+	}
+
+	return &api.ProjectConstantSaveNoContent{}, nil
+}
@@ -0,0 +1,98 @@
+package project_constant_save_handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save/domain"
+)
+
+func TestHandler_ProjectConstantSave_Success(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectConstantSaveRequest{
+		Constants: []api.ProjectConstantSaveRequestConstantsItem{
+			{Name: "org_name", Value: "ООО Ромашка"},
+			{Name: "inn", Value: "7701234567"},
+		},
+	}
+	params := api.ProjectConstantSaveParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().
+		Handle(ctx, domain.ProjectConstantSaveIn{
+			ProjectID: 10,
+			AuthorID:  1,
+			Constants: []domain.Constant{
+				{Name: "org_name", Value: "ООО Ромашка"},
+				{Name: "inn", Value: "7701234567"},
+			},
+		}).
+		Return(nil)
+
+	handler := New(usecase)
+	got, err := handler.ProjectConstantSave(ctx, req, params)
+	require.NoError(t, err)
+
+	_, ok := got.(*api.ProjectConstantSaveNoContent)
+	require.True(t, ok, "expected *api.ProjectConstantSaveNoContent, got %T", got)
+}
+
+func TestHandler_ProjectConstantSave_Error(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectConstantSaveRequest{}
+	params := api.ProjectConstantSaveParams{ProjectID: 10, XUserID: 1}
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "ProjectNotFound", err: domain.ErrProjectNotFound},
+		{name: "ProjectInvalid", err: domain.ErrProjectInvalid},
+		{name: "ValidationError", err: error_domain.NewValidationError("constants.0.name", domain.ErrValueInvalid)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(tt.err)
+
+			handler := New(usecase)
+			got, err := handler.ProjectConstantSave(ctx, req, params)
+			require.NoError(t, err)
+
+			resp, ok := got.(*api.Error)
+			require.True(t, ok, "expected *api.Error, got %T", got)
+			require.Equal(t, tt.err.Error(), resp.Message)
+		})
+	}
+}
+
+func TestHandler_ProjectConstantSave_InternalError(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectConstantSaveRequest{}
+	params := api.ProjectConstantSaveParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.ProjectConstantSave(ctx, req, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "project constant save usecase")
+	require.ErrorContains(t, err, "boom")
+}
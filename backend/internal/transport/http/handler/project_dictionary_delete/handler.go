@@ -0,0 +1,39 @@
+package project_dictionary_delete_handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) ProjectDictionaryDeleteByID(ctx context.Context, params api.ProjectDictionaryDeleteByIDParams) (api.ProjectDictionaryDeleteByIDRes, error) {
+	in := domain.ProjectDictionaryDeleteIn{
+		DictionaryID: params.DictionaryID,
+		UserID:       params.XUserID,
+	}
+
+	err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+		return nil, fmt.Errorf("project dictionary delete by id usecase: %w", err)
+	}
+
+	return &api.ProjectDictionaryDeleteByIDNoContent{}, nil
+}
@@ -0,0 +1,73 @@
+package project_dictionary_delete_handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/domain"
+)
+
+func TestHandler_ProjectDictionaryDeleteByID_Success(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectDictionaryDeleteByIDParams{DictionaryID: 5, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().
+		Handle(ctx, domain.ProjectDictionaryDeleteIn{DictionaryID: 5, UserID: 1}).
+		Return(nil)
+
+	handler := New(usecase)
+	got, err := handler.ProjectDictionaryDeleteByID(ctx, params)
+	require.NoError(t, err)
+
+	_, ok := got.(*api.ProjectDictionaryDeleteByIDNoContent)
+	require.True(t, ok, "expected *api.ProjectDictionaryDeleteByIDNoContent, got %T", got)
+}
+
+func TestHandler_ProjectDictionaryDeleteByID_BaseError(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectDictionaryDeleteByIDParams{DictionaryID: 5, XUserID: 1}
+
+	for _, wantErr := range []error{domain.ErrDictionaryNotFound, domain.ErrProjectInvalid} {
+		t.Run(wantErr.Error(), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(wantErr)
+
+			handler := New(usecase)
+			got, err := handler.ProjectDictionaryDeleteByID(ctx, params)
+			require.NoError(t, err)
+
+			resp, ok := got.(*api.Error)
+			require.True(t, ok, "expected *api.Error, got %T", got)
+			require.Equal(t, wantErr.Error(), resp.Message)
+		})
+	}
+}
+
+func TestHandler_ProjectDictionaryDeleteByID_InternalError(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectDictionaryDeleteByIDParams{DictionaryID: 5, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.ProjectDictionaryDeleteByID(ctx, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "project dictionary delete by id usecase")
+	require.ErrorContains(t, err, "boom")
+}
@@ -0,0 +1,13 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package project_dictionary_get_by_id_handler
+
+import (
+	"context"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_get_by_id/domain"
+)
+
+type usecase interface {
+	Handle(ctx context.Context, in domain.ProjectDictionaryGetByIDIn) (*domain.Dictionary, error)
+}
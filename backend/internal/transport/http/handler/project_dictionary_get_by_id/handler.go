@@ -0,0 +1,54 @@
+package project_dictionary_get_by_id_handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_get_by_id/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) ProjectDictionaryGetByID(ctx context.Context, params api.ProjectDictionaryGetByIDParams) (api.ProjectDictionaryGetByIDRes, error) {
+	in := domain.ProjectDictionaryGetByIDIn{
+		DictionaryID: params.DictionaryID,
+		UserID:       params.XUserID,
+	}
+
+	dictionary, err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+		return nil, fmt.Errorf("project dictionary get by id usecase: %w", err)
+	}
+
+	resp := api.ProjectDictionaryGetByIDResponse{
+		ID:   dictionary.ID,
+		Name: dictionary.Name,
+		Columns: lo.Map(dictionary.Columns, func(c dictionary_domain.Column, _ int) api.ProjectDictionaryGetByIDResponseColumnsItem {
+			return api.ProjectDictionaryGetByIDResponseColumnsItem{Name: c.Name, Type: string(c.Type)}
+		}),
+		Rows: lo.Map(dictionary.Rows, func(r dictionary_domain.Row, _ int) api.ProjectDictionaryGetByIDResponseRowsItem {
+			return api.ProjectDictionaryGetByIDResponseRowsItem{Key: r.Key, Values: r.Values}
+		}),
+		Number:    dictionary.Number,
+		UpdatedAt: dictionary.UpdatedAt,
+	}
+	return &resp, nil
+}
@@ -0,0 +1,95 @@
+package project_dictionary_get_by_id_handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_get_by_id/domain"
+)
+
+func TestHandler_ProjectDictionaryGetByID_Success(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectDictionaryGetByIDParams{DictionaryID: 5, XUserID: 1}
+	updatedAt := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().
+		Handle(ctx, domain.ProjectDictionaryGetByIDIn{DictionaryID: 5, UserID: 1}).
+		Return(&domain.Dictionary{
+			ID:        5,
+			ProjectID: 10,
+			Name:      "materials",
+			Columns:   []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}},
+			Rows:      []dictionary_domain.Row{{Key: "steel", Values: map[string]string{"density": "7850"}}},
+			Number:    2,
+			UpdatedAt: updatedAt,
+		}, nil)
+
+	handler := New(usecase)
+	got, err := handler.ProjectDictionaryGetByID(ctx, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.ProjectDictionaryGetByIDResponse)
+	require.True(t, ok, "expected *api.ProjectDictionaryGetByIDResponse, got %T", got)
+
+	want := &api.ProjectDictionaryGetByIDResponse{
+		ID:        5,
+		Name:      "materials",
+		Columns:   []api.ProjectDictionaryGetByIDResponseColumnsItem{{Name: "density", Type: "float"}},
+		Rows:      []api.ProjectDictionaryGetByIDResponseRowsItem{{Key: "steel", Values: api.ProjectDictionaryGetByIDResponseRowsItemValues{"density": "7850"}}},
+		Number:    2,
+		UpdatedAt: updatedAt,
+	}
+	require.Equal(t, want, resp)
+}
+
+func TestHandler_ProjectDictionaryGetByID_BaseError(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectDictionaryGetByIDParams{DictionaryID: 5, XUserID: 1}
+
+	for _, wantErr := range []error{domain.ErrDictionaryNotFound, domain.ErrProjectInvalid} {
+		t.Run(wantErr.Error(), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, wantErr)
+
+			handler := New(usecase)
+			got, err := handler.ProjectDictionaryGetByID(ctx, params)
+			require.NoError(t, err)
+
+			resp, ok := got.(*api.Error)
+			require.True(t, ok, "expected *api.Error, got %T", got)
+			require.Equal(t, wantErr.Error(), resp.Message)
+		})
+	}
+}
+
+func TestHandler_ProjectDictionaryGetByID_InternalError(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectDictionaryGetByIDParams{DictionaryID: 5, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.ProjectDictionaryGetByID(ctx, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "project dictionary get by id usecase")
+	require.ErrorContains(t, err, "boom")
+}
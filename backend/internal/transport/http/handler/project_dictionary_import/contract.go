@@ -0,0 +1,14 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package project_dictionary_import_handler
+
+import (
+	"context"
+
+	dictionary_save_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_import/domain"
+)
+
+type usecase interface {
+	Handle(ctx context.Context, in domain.ProjectDictionaryImportIn) (*dictionary_save_domain.DictionarySaveOut, error)
+}
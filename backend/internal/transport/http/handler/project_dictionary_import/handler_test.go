@@ -0,0 +1,93 @@
+package project_dictionary_import_handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	dictionary_save_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_import/domain"
+)
+
+func TestHandler_ProjectDictionaryImport_Success(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectDictionaryImportRequest{Name: "materials", Data: "key;density:float\nsteel;7850\n"}
+	params := api.ProjectDictionaryImportParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().
+		Handle(ctx, domain.ProjectDictionaryImportIn{
+			ProjectID: 10,
+			AuthorID:  1,
+			Name:      "materials",
+			Data:      "key;density:float\nsteel;7850\n",
+		}).
+		Return(&dictionary_save_domain.DictionarySaveOut{ID: 5, Number: 1}, nil)
+
+	handler := New(usecase)
+	got, err := handler.ProjectDictionaryImport(ctx, req, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.ProjectDictionaryImportResponse)
+	require.True(t, ok, "expected *api.ProjectDictionaryImportResponse, got %T", got)
+	require.Equal(t, &api.ProjectDictionaryImportResponse{ID: 5, Number: 1}, resp)
+}
+
+func TestHandler_ProjectDictionaryImport_Error(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectDictionaryImportRequest{Name: "materials"}
+	params := api.ProjectDictionaryImportParams{ProjectID: 10, XUserID: 1}
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "ProjectNotFound", err: domain.ErrProjectNotFound},
+		{name: "ProjectInvalid", err: domain.ErrProjectInvalid},
+		{name: "ValidationError", err: error_domain.NewValidationError("data", domain.ErrValueEmpty)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, tt.err)
+
+			handler := New(usecase)
+			got, err := handler.ProjectDictionaryImport(ctx, req, params)
+			require.NoError(t, err)
+
+			resp, ok := got.(*api.Error)
+			require.True(t, ok, "expected *api.Error, got %T", got)
+			require.Equal(t, tt.err.Error(), resp.Message)
+		})
+	}
+}
+
+func TestHandler_ProjectDictionaryImport_InternalError(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectDictionaryImportRequest{Name: "materials"}
+	params := api.ProjectDictionaryImportParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.ProjectDictionaryImport(ctx, req, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "project dictionary import usecase")
+	require.ErrorContains(t, err, "boom")
+}
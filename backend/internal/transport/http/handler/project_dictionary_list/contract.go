@@ -0,0 +1,13 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package project_dictionary_list_handler
+
+import (
+	"context"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_list/domain"
+)
+
+type usecase interface {
+	Handle(ctx context.Context, in domain.ProjectDictionaryListIn) ([]domain.Dictionary, error)
+}
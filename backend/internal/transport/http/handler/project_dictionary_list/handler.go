@@ -0,0 +1,56 @@
+package project_dictionary_list_handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_list/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) ProjectDictionaryList(ctx context.Context, params api.ProjectDictionaryListParams) (api.ProjectDictionaryListRes, error) {
+	in := domain.ProjectDictionaryListIn{
+		ProjectID: params.ProjectID,
+		UserID:    params.XUserID,
+	}
+
+	dictionaries, err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+		return nil, fmt.Errorf("project dictionary list usecase: %w", err)
+	}
+
+	resp := api.ProjectDictionaryListResponse{
+		Dictionaries: lo.Map(dictionaries, func(d domain.Dictionary, _ int) api.ProjectDictionaryListResponseDictionariesItem {
+			return api.ProjectDictionaryListResponseDictionariesItem{
+				ID:   d.ID,
+				Name: d.Name,
+				Columns: lo.Map(d.Columns, func(c dictionary_domain.Column, _ int) api.ProjectDictionaryListResponseDictionariesItemColumnsItem {
+					return api.ProjectDictionaryListResponseDictionariesItemColumnsItem{Name: c.Name, Type: string(c.Type)}
+				}),
+				RowCount:  d.RowCount,
+				Number:    d.Number,
+				UpdatedAt: d.UpdatedAt,
+			}
+		}),
+	}
+	return &resp, nil
+}
@@ -0,0 +1,98 @@
+package project_dictionary_list_handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_list/domain"
+)
+
+func TestHandler_ProjectDictionaryList_Success(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectDictionaryListParams{ProjectID: 10, XUserID: 1}
+	updatedAt := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().
+		Handle(ctx, domain.ProjectDictionaryListIn{ProjectID: 10, UserID: 1}).
+		Return([]domain.Dictionary{
+			{
+				ID:        5,
+				Name:      "materials",
+				Columns:   []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}},
+				RowCount:  3,
+				Number:    2,
+				UpdatedAt: updatedAt,
+			},
+		}, nil)
+
+	handler := New(usecase)
+	got, err := handler.ProjectDictionaryList(ctx, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.ProjectDictionaryListResponse)
+	require.True(t, ok, "expected *api.ProjectDictionaryListResponse, got %T", got)
+
+	want := []api.ProjectDictionaryListResponseDictionariesItem{
+		{
+			ID:        5,
+			Name:      "materials",
+			Columns:   []api.ProjectDictionaryListResponseDictionariesItemColumnsItem{{Name: "density", Type: "float"}},
+			RowCount:  3,
+			Number:    2,
+			UpdatedAt: updatedAt,
+		},
+	}
+	require.Equal(t, want, resp.Dictionaries)
+}
+
+func TestHandler_ProjectDictionaryList_BaseError(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectDictionaryListParams{ProjectID: 10, XUserID: 1}
+
+	for _, wantErr := range []error{domain.ErrProjectNotFound, domain.ErrProjectInvalid} {
+		t.Run(wantErr.Error(), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, wantErr)
+
+			handler := New(usecase)
+			got, err := handler.ProjectDictionaryList(ctx, params)
+			require.NoError(t, err)
+
+			resp, ok := got.(*api.Error)
+			require.True(t, ok, "expected *api.Error, got %T", got)
+			require.Equal(t, wantErr.Error(), resp.Message)
+		})
+	}
+}
+
+func TestHandler_ProjectDictionaryList_InternalError(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectDictionaryListParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.ProjectDictionaryList(ctx, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "project dictionary list usecase")
+	require.ErrorContains(t, err, "boom")
+}
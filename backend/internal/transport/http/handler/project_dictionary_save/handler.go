@@ -0,0 +1,56 @@
+package project_dictionary_save_handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) ProjectDictionarySave(ctx context.Context, req *api.ProjectDictionarySaveRequest, params api.ProjectDictionarySaveParams) (api.ProjectDictionarySaveRes, error) {
+	in := domain.DictionarySaveIn{
+		ProjectID: params.ProjectID,
+		AuthorID:  params.XUserID,
+		Name:      req.Name,
+		Columns: lo.Map(req.Columns, func(c api.ProjectDictionarySaveRequestColumnsItem, _ int) dictionary_domain.Column {
+			return dictionary_domain.Column{Name: c.Name, Type: variable_domain.Type(c.Type)}
+		}),
+		Rows: lo.Map(req.Rows, func(r api.ProjectDictionarySaveRequestRowsItem, _ int) dictionary_domain.Row {
+			return dictionary_domain.Row{Key: r.Key, Values: r.Values}
+		}),
+	}
+
+	out, err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
+		var validationErr *error_domain.ValidationError
+		if errors.As(err, &validationErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
+		return nil, fmt.Errorf("project dictionary save usecase: %w", err)
+	}
+
+	return &api.ProjectDictionarySaveResponse{ID: out.ID, Number: out.Number}, nil
+}
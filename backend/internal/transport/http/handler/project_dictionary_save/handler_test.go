@@ -0,0 +1,101 @@
+package project_dictionary_save_handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+	project_dictionary_save_domain "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_save/domain"
+)
+
+func TestHandler_ProjectDictionarySave_Success(t *testing.T) {
+	ctx := context.Background()
+
+	req := &api.ProjectDictionarySaveRequest{
+		Name:    "materials",
+		Columns: []api.ProjectDictionarySaveRequestColumnsItem{{Name: "density", Type: api.ProjectDictionarySaveRequestColumnsItemTypeFloat}},
+		Rows:    []api.ProjectDictionarySaveRequestRowsItem{{Key: "steel", Values: api.ProjectDictionarySaveRequestRowsItemValues{"density": "7850"}}},
+	}
+	params := api.ProjectDictionarySaveParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().
+		Handle(ctx, domain.DictionarySaveIn{
+			ProjectID: 10,
+			AuthorID:  1,
+			Name:      "materials",
+			Columns:   []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}},
+			Rows:      []dictionary_domain.Row{{Key: "steel", Values: map[string]string{"density": "7850"}}},
+		}).
+		Return(&domain.DictionarySaveOut{ID: 5, Number: 2}, nil)
+
+	handler := New(usecase)
+	got, err := handler.ProjectDictionarySave(ctx, req, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.ProjectDictionarySaveResponse)
+	require.True(t, ok, "expected *api.ProjectDictionarySaveResponse, got %T", got)
+	require.Equal(t, &api.ProjectDictionarySaveResponse{ID: 5, Number: 2}, resp)
+}
+
+func TestHandler_ProjectDictionarySave_Error(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectDictionarySaveRequest{Name: "materials"}
+	params := api.ProjectDictionarySaveParams{ProjectID: 10, XUserID: 1}
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "ProjectNotFound", err: project_dictionary_save_domain.ErrProjectNotFound},
+		{name: "ProjectInvalid", err: project_dictionary_save_domain.ErrProjectInvalid},
+		{name: "ValidationError", err: error_domain.NewValidationError("rows.0.key", domain.ErrValueDuplicate)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, tt.err)
+
+			handler := New(usecase)
+			got, err := handler.ProjectDictionarySave(ctx, req, params)
+			require.NoError(t, err)
+
+			resp, ok := got.(*api.Error)
+			require.True(t, ok, "expected *api.Error, got %T", got)
+			require.Equal(t, tt.err.Error(), resp.Message)
+		})
+	}
+}
+
+func TestHandler_ProjectDictionarySave_InternalError(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectDictionarySaveRequest{Name: "materials"}
+	params := api.ProjectDictionarySaveParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.ProjectDictionarySave(ctx, req, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "project dictionary save usecase")
+	require.ErrorContains(t, err, "boom")
+}
@@ -0,0 +1,53 @@
+package project_function_list_handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_list/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) ProjectFunctionList(ctx context.Context, params api.ProjectFunctionListParams) (api.ProjectFunctionListRes, error) {
+	in := domain.ProjectFunctionListIn{
+		ProjectID: params.ProjectID,
+		UserID:    params.XUserID,
+	}
+
+	functions, err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+		return nil, fmt.Errorf("project function list usecase: %w", err)
+	}
+
+	resp := api.ProjectFunctionListResponse{
+		Functions: lo.Map(functions, func(f domain.Function, _ int) api.ProjectFunctionListResponseFunctionsItem {
+			return api.ProjectFunctionListResponseFunctionsItem{
+				ID:        f.ID,
+				Name:      f.Name,
+				Params:    f.Params,
+				Body:      f.Body,
+				Number:    f.Number,
+				UpdatedAt: f.UpdatedAt,
+			}
+		}),
+	}
+	return &resp, nil
+}
@@ -0,0 +1,82 @@
+package project_function_list_handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_list/domain"
+)
+
+func TestHandler_ProjectFunctionList_Success(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectFunctionListParams{ProjectID: 10, XUserID: 1}
+	updatedAt := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().
+		Handle(ctx, domain.ProjectFunctionListIn{ProjectID: 10, UserID: 1}).
+		Return([]domain.Function{
+			{ID: 5, Name: "tolerance", Params: []string{"x", "tol"}, Body: "x * tol / 100", Number: 2, UpdatedAt: updatedAt},
+		}, nil)
+
+	handler := New(usecase)
+	got, err := handler.ProjectFunctionList(ctx, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.ProjectFunctionListResponse)
+	require.True(t, ok, "expected *api.ProjectFunctionListResponse, got %T", got)
+
+	want := []api.ProjectFunctionListResponseFunctionsItem{
+		{ID: 5, Name: "tolerance", Params: []string{"x", "tol"}, Body: "x * tol / 100", Number: 2, UpdatedAt: updatedAt},
+	}
+	require.Equal(t, want, resp.Functions)
+}
+
+func TestHandler_ProjectFunctionList_BaseError(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectFunctionListParams{ProjectID: 10, XUserID: 1}
+
+	for _, wantErr := range []error{domain.ErrProjectNotFound, domain.ErrProjectInvalid} {
+		t.Run(wantErr.Error(), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, wantErr)
+
+			handler := New(usecase)
+			got, err := handler.ProjectFunctionList(ctx, params)
+			require.NoError(t, err)
+
+			resp, ok := got.(*api.Error)
+			require.True(t, ok, "expected *api.Error, got %T", got)
+			require.Equal(t, wantErr.Error(), resp.Message)
+		})
+	}
+}
+
+func TestHandler_ProjectFunctionList_InternalError(t *testing.T) {
+	ctx := context.Background()
+	params := api.ProjectFunctionListParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.ProjectFunctionList(ctx, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "project function list usecase")
+	require.ErrorContains(t, err, "boom")
+}
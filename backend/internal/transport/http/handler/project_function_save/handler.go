@@ -0,0 +1,48 @@
+package project_function_save_handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) ProjectFunctionSave(ctx context.Context, req *api.ProjectFunctionSaveRequest, params api.ProjectFunctionSaveParams) (api.ProjectFunctionSaveRes, error) {
+	in := domain.ProjectFunctionSaveIn{
+		ProjectID: params.ProjectID,
+		AuthorID:  params.XUserID,
+		Name:      req.Name,
+		Params:    req.Params,
+		Body:      req.Body,
+	}
+
+	out, err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
+		var validationErr *error_domain.ValidationError
+		if errors.As(err, &validationErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
+		return nil, fmt.Errorf("project function save usecase: %w", err)
+	}
+
+	return &api.ProjectFunctionSaveResponse{ID: out.ID, Number: out.Number}, nil
+}
@@ -0,0 +1,93 @@
+package project_function_save_handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+)
+
+func TestHandler_ProjectFunctionSave_Success(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectFunctionSaveRequest{Name: "tolerance", Params: []string{"x", "tol"}, Body: "x * tol / 100"}
+	params := api.ProjectFunctionSaveParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().
+		Handle(ctx, domain.ProjectFunctionSaveIn{
+			ProjectID: 10,
+			AuthorID:  1,
+			Name:      "tolerance",
+			Params:    []string{"x", "tol"},
+			Body:      "x * tol / 100",
+		}).
+		Return(&domain.ProjectFunctionSaveOut{ID: 5, Number: 2}, nil)
+
+	handler := New(usecase)
+	got, err := handler.ProjectFunctionSave(ctx, req, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.ProjectFunctionSaveResponse)
+	require.True(t, ok, "expected *api.ProjectFunctionSaveResponse, got %T", got)
+	require.Equal(t, &api.ProjectFunctionSaveResponse{ID: 5, Number: 2}, resp)
+}
+
+func TestHandler_ProjectFunctionSave_Error(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectFunctionSaveRequest{Name: "tolerance", Body: "1"}
+	params := api.ProjectFunctionSaveParams{ProjectID: 10, XUserID: 1}
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "ProjectNotFound", err: domain.ErrProjectNotFound},
+		{name: "ProjectInvalid", err: domain.ErrProjectInvalid},
+		{name: "ValidationError", err: error_domain.NewValidationError("body", domain.ErrBodyInvalid)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, tt.err)
+
+			handler := New(usecase)
+			got, err := handler.ProjectFunctionSave(ctx, req, params)
+			require.NoError(t, err)
+
+			resp, ok := got.(*api.Error)
+			require.True(t, ok, "expected *api.Error, got %T", got)
+			require.Equal(t, tt.err.Error(), resp.Message)
+		})
+	}
+}
+
+func TestHandler_ProjectFunctionSave_InternalError(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectFunctionSaveRequest{Name: "tolerance", Body: "1"}
+	params := api.ProjectFunctionSaveParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.ProjectFunctionSave(ctx, req, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "project function save usecase")
+	require.ErrorContains(t, err, "boom")
+}
@@ -0,0 +1,52 @@
+package project_partial_list_handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_list/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) ProjectPartialList(ctx context.Context, params api.ProjectPartialListParams) (api.ProjectPartialListRes, error) {
+	in := domain.ProjectPartialListIn{
+		ProjectID: params.ProjectID,
+		UserID:    params.XUserID,
+	}
+
+	partials, err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+		return nil, fmt.Errorf("project partial list usecase: %w", err)
+	}
+
+	resp := api.ProjectPartialListResponse{
+		Partials: lo.Map(partials, func(p domain.Partial, _ int) api.ProjectPartialListResponsePartialsItem {
+			return api.ProjectPartialListResponsePartialsItem{
+				ID:        p.ID,
+				Name:      p.Name,
+				Data:      p.Data,
+				Number:    p.Number,
+				UpdatedAt: p.UpdatedAt,
+			}
+		}),
+	}
+	return &resp, nil
+}
@@ -0,0 +1,13 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package project_partial_save_handler
+
+import (
+	"context"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+)
+
+type usecase interface {
+	Handle(ctx context.Context, in domain.ProjectPartialSaveIn) (*domain.ProjectPartialSaveOut, error)
+}
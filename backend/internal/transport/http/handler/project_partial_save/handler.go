@@ -0,0 +1,47 @@
+package project_partial_save_handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) ProjectPartialSave(ctx context.Context, req *api.ProjectPartialSaveRequest, params api.ProjectPartialSaveParams) (api.ProjectPartialSaveRes, error) {
+	in := domain.ProjectPartialSaveIn{
+		ProjectID: params.ProjectID,
+		AuthorID:  params.XUserID,
+		Name:      req.Name,
+		Data:      req.Data,
+	}
+
+	out, err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
+		var validationErr *error_domain.ValidationError
+		if errors.As(err, &validationErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
+		return nil, fmt.Errorf("project partial save usecase: %w", err)
+	}
+
+	return &api.ProjectPartialSaveResponse{ID: out.ID, Number: out.Number}, nil
+}
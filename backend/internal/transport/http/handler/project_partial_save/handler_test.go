@@ -0,0 +1,92 @@
+package project_partial_save_handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+)
+
+func TestHandler_ProjectPartialSave_Success(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectPartialSaveRequest{Name: "footer", Data: "{{ .org_name }}"}
+	params := api.ProjectPartialSaveParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().
+		Handle(ctx, domain.ProjectPartialSaveIn{
+			ProjectID: 10,
+			AuthorID:  1,
+			Name:      "footer",
+			Data:      "{{ .org_name }}",
+		}).
+		Return(&domain.ProjectPartialSaveOut{ID: 5, Number: 2}, nil)
+
+	handler := New(usecase)
+	got, err := handler.ProjectPartialSave(ctx, req, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.ProjectPartialSaveResponse)
+	require.True(t, ok, "expected *api.ProjectPartialSaveResponse, got %T", got)
+	require.Equal(t, &api.ProjectPartialSaveResponse{ID: 5, Number: 2}, resp)
+}
+
+func TestHandler_ProjectPartialSave_Error(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectPartialSaveRequest{Name: "footer", Data: "1"}
+	params := api.ProjectPartialSaveParams{ProjectID: 10, XUserID: 1}
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "ProjectNotFound", err: domain.ErrProjectNotFound},
+		{name: "ProjectInvalid", err: domain.ErrProjectInvalid},
+		{name: "ValidationError", err: error_domain.NewValidationError("data", domain.ErrValueInvalid)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, tt.err)
+
+			handler := New(usecase)
+			got, err := handler.ProjectPartialSave(ctx, req, params)
+			require.NoError(t, err)
+
+			resp, ok := got.(*api.Error)
+			require.True(t, ok, "expected *api.Error, got %T", got)
+			require.Equal(t, tt.err.Error(), resp.Message)
+		})
+	}
+}
+
+func TestHandler_ProjectPartialSave_InternalError(t *testing.T) {
+	ctx := context.Background()
+	req := &api.ProjectPartialSaveRequest{Name: "footer", Data: "1"}
+	params := api.ProjectPartialSaveParams{ProjectID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.ProjectPartialSave(ctx, req, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "project partial save usecase")
+	require.ErrorContains(t, err, "boom")
+}
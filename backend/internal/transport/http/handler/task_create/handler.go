@@ -1,11 +1,16 @@
 package task_create_handler
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
+	"github.com/samber/lo"
+
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_create/domain"
 )
@@ -21,13 +26,23 @@ func New(usecase usecase) *Handler {
 }
 
 func (h *Handler) TaskCreate(ctx context.Context, req *api.TaskCreateRequest, params api.TaskCreateParams) (api.TaskCreateRes, error) {
+	payload, err := convertPayloadToIn(req.Payload)
+	if err != nil {
+		return &api.Error{Message: err.Error()}, nil
+	}
+
 	in := domain.TaskCreateIn{
 		VersionID: req.VersionID,
 		CreatorID: params.XUserID,
-		Payload:   req.Payload,
+		Payload:   payload,
+		Trace:     req.Trace.Or(false),
 	}
 
-	err := h.usecase.Handle(ctx, in)
+	if outputFormat, ok := req.OutputFormat.Get(); ok {
+		in.OutputFormat = lo.ToPtr(output_domain.Format(outputFormat))
+	}
+
+	err = h.usecase.Handle(ctx, in)
 	if err != nil {
 		var baseErr *error_domain.BaseError
 		if errors.As(err, &baseErr) {
@@ -39,3 +54,20 @@ func (h *Handler) TaskCreate(ctx context.Context, req *api.TaskCreateRequest, pa
 
 	return &api.TaskCreateCreated{}, nil
 }
+
+func convertPayloadToIn(payload api.TaskCreateRequestPayload) (map[string]any, error) {
+	in := make(map[string]any, len(payload))
+	for name, raw := range payload {
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("payload %q: %w", name, err)
+		}
+
+		in[name] = value
+	}
+
+	return in, nil
+}
@@ -2,21 +2,29 @@ package task_create_handler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
+	"github.com/go-faster/jx"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_create/domain"
 )
 
 func TestHandler_TaskCreate_Success(t *testing.T) {
 	ctx := context.Background()
-	payload := api.TaskCreateRequestPayload{"key": "value"}
-	req := &api.TaskCreateRequest{VersionID: 7, Payload: payload}
+	payload := api.TaskCreateRequestPayload{
+		"key":   jx.Raw(`"value"`),
+		"count": jx.Raw(`42`),
+		"items": jx.Raw(`[{"name": "a"}]`),
+	}
+	req := &api.TaskCreateRequest{VersionID: 7, Payload: payload, Trace: api.NewOptBool(true), OutputFormat: api.NewOptOutputFormat(api.OutputFormatPdf)}
 	params := api.TaskCreateParams{XUserID: 1}
 
 	ctrl := gomock.NewController(t)
@@ -24,7 +32,11 @@ func TestHandler_TaskCreate_Success(t *testing.T) {
 
 	usecase := NewMockusecase(ctrl)
 	usecase.EXPECT().
-		Handle(ctx, domain.TaskCreateIn{VersionID: 7, CreatorID: 1, Payload: payload}).
+		Handle(ctx, domain.TaskCreateIn{VersionID: 7, CreatorID: 1, Payload: map[string]any{
+			"key":   "value",
+			"count": json.Number("42"),
+			"items": []any{map[string]any{"name": "a"}},
+		}, Trace: true, OutputFormat: lo.ToPtr(output_domain.FormatPDF)}).
 		Return(nil)
 
 	handler := New(usecase)
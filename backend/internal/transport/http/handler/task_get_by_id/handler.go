@@ -2,14 +2,13 @@ package task_get_by_id_handler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
-	"github.com/samber/lo"
-
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
-	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	process_converter "github.com/qsoulior/tech-generator/backend/internal/transport/http/converter/process"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_get_by_id/domain"
 )
 
@@ -39,107 +38,75 @@ func (h *Handler) TaskGetByID(ctx context.Context, params api.TaskGetByIDParams)
 		return nil, fmt.Errorf("task get by id usecase: %w", err)
 	}
 
-	resp := convertOutToResponse(*out)
-	return &resp, nil
-}
-
-func convertOutToResponse(out domain.TaskGetByIDOut) api.TaskGetByIDResponse {
-	return api.TaskGetByIDResponse{
-		Task:   convertTaskToResponse(out.Task),
-		Result: out.Result,
-	}
-}
-
-func convertTaskToResponse(task domain.Task) api.TaskGetByIDResponseTask {
-	taskResponse := api.TaskGetByIDResponseTask{
-		ID:          task.ID,
-		VersionID:   task.VersionID,
-		Status:      api.TaskStatus(task.Status),
-		Payload:     api.TaskGetByIDResponseTaskPayload(task.Payload),
-		CreatorName: task.CreatorName,
-		CreatedAt:   task.CreatedAt,
-	}
-
-	if task.Error != nil {
-		taskResponse.Error.SetTo(convertTaskErrorToResponse(*task.Error))
-	}
-
-	if task.UpdatedAt != nil {
-		taskResponse.UpdatedAt.SetTo(*task.UpdatedAt)
+	resp, err := convertOutToResponse(*out)
+	if err != nil {
+		return nil, fmt.Errorf("convert out to response: %w", err)
 	}
 
-	return taskResponse
+	return &resp, nil
 }
 
-func convertTaskErrorToResponse(taskError task_domain.ProcessError) api.TaskGetByIDResponseTaskError {
-	item := api.TaskGetByIDResponseTaskError{
-		VariableErrors: convertVariableErrorsToResponse(taskError.VariableErrors),
+func convertOutToResponse(out domain.TaskGetByIDOut) (api.TaskGetByIDResponse, error) {
+	task, err := convertTaskToResponse(out.Task)
+	if err != nil {
+		return api.TaskGetByIDResponse{}, err
 	}
 
-	if taskError.Message != "" {
-		item.Message.SetTo(taskError.Message)
+	resp := api.TaskGetByIDResponse{
+		Task: task,
 	}
 
-	if taskError.Template != nil {
-		item.Template.SetTo(convertTemplateErrorToResponse(*taskError.Template))
+	if out.Result != nil {
+		resp.Result = out.Result.Data
+		resp.ResultFormat.SetTo(api.OutputFormat(out.Result.Format))
+		resp.ResultContentType.SetTo(out.Result.ContentType)
 	}
 
-	return item
+	return resp, nil
 }
 
-func convertTemplateErrorToResponse(templateError task_domain.TemplateError) api.TaskGetByIDResponseTaskErrorTemplate {
-	item := api.TaskGetByIDResponseTaskErrorTemplate{
-		Line: templateError.Line,
+func convertTaskToResponse(task domain.Task) (api.TaskGetByIDResponseTask, error) {
+	payload, err := convertPayloadToResponse(task.Payload)
+	if err != nil {
+		return api.TaskGetByIDResponseTask{}, err
 	}
 
-	if templateError.Column > 0 {
-		item.Column.SetTo(templateError.Column)
+	taskResponse := api.TaskGetByIDResponseTask{
+		ID:          task.ID,
+		VersionID:   task.VersionID,
+		Status:      api.TaskStatus(task.Status),
+		Payload:     payload,
+		Trace:       process_converter.ConvertTraceToResponse(task.Trace),
+		CreatorName: task.CreatorName,
+		Clock:       task.Clock,
+		CreatedAt:   task.CreatedAt,
 	}
 
-	if templateError.Snippet != "" {
-		item.Snippet.SetTo(templateError.Snippet)
+	if task.Error != nil {
+		taskResponse.Error.SetTo(process_converter.ConvertErrorToResponse(*task.Error))
 	}
 
-	if templateError.Detail != "" {
-		item.Detail.SetTo(templateError.Detail)
+	if task.Constants != nil {
+		taskResponse.Constants.SetTo(api.TaskGetByIDResponseTaskConstants(task.Constants))
 	}
 
-	return item
-}
-
-func convertVariableErrorsToResponse(variableErrors []task_domain.VariableError) []api.TaskGetByIDResponseTaskErrorVariableErrorsItem {
-	return lo.Map(variableErrors, func(v task_domain.VariableError, _ int) api.TaskGetByIDResponseTaskErrorVariableErrorsItem {
-		item := api.TaskGetByIDResponseTaskErrorVariableErrorsItem{
-			ID:               v.ID,
-			Name:             v.Name,
-			Title:            v.Title,
-			ConstraintErrors: convertConstraintErrorsToResponse(v.ConstraintErrors),
-		}
-
-		if v.Value != "" {
-			item.Value.SetTo(v.Value)
-		}
-
-		if v.Message != "" {
-			item.Message.SetTo(v.Message)
-		}
+	if task.UpdatedAt != nil {
+		taskResponse.UpdatedAt.SetTo(*task.UpdatedAt)
+	}
 
-		return item
-	})
+	return taskResponse, nil
 }
 
-func convertConstraintErrorsToResponse(constraintErrors []task_domain.ConstraintError) []api.TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem {
-	return lo.Map(constraintErrors, func(c task_domain.ConstraintError, _ int) api.TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem {
-		item := api.TaskGetByIDResponseTaskErrorVariableErrorsItemConstraintErrorsItem{
-			ID:         c.ID,
-			Name:       c.Name,
-			Expression: c.Expression,
+func convertPayloadToResponse(payload map[string]any) (api.TaskGetByIDResponseTaskPayload, error) {
+	resp := make(api.TaskGetByIDResponseTaskPayload, len(payload))
+	for name, value := range payload {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("payload %q: %w", name, err)
 		}
 
-		if c.Message != "" {
-			item.Message.SetTo(c.Message)
-		}
+		resp[name] = raw
+	}
 
-		return item
-	})
+	return resp, nil
 }
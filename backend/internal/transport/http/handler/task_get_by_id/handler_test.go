@@ -10,6 +10,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_get_by_id/domain"
@@ -26,6 +27,7 @@ func TestHandler_TaskGetByID_Success(t *testing.T) {
 	updatedAt := createdAt.Add(time.Hour)
 	taskErr := task_domain.ProcessError{
 		Message: "fail",
+		Cycle:   []string{"a", "b", "a"},
 		VariableErrors: []task_domain.VariableError{
 			{
 				ID:      11,
@@ -33,7 +35,7 @@ func TestHandler_TaskGetByID_Success(t *testing.T) {
 				Value:   "42",
 				Message: "bad",
 				ConstraintErrors: []task_domain.ConstraintError{
-					{ID: 21, Name: "c1", Expression: "v1 > 100", Message: "broken"},
+					{ID: 21, Name: "c1", Expression: "v1 > 100", Message: "broken", Variables: []task_domain.ConstraintVariable{{Name: "v1", Value: "42"}}},
 				},
 			},
 		},
@@ -42,20 +44,39 @@ func TestHandler_TaskGetByID_Success(t *testing.T) {
 			Column:  5,
 			Snippet: "broken {{ . test }}",
 			Detail:  `function "test" not defined`,
+			Partial: "footer",
 		},
 	}
 	out := &domain.TaskGetByIDOut{
 		Task: domain.Task{
-			ID:          9,
-			VersionID:   7,
-			Status:      task_domain.StatusFailed,
-			Payload:     map[string]string{"k": "v"},
-			Error:       &taskErr,
+			ID:        9,
+			VersionID: 7,
+			Status:    task_domain.StatusFailed,
+			Payload:   map[string]any{"k": "v"},
+			Error:     &taskErr,
+			Trace: []task_domain.VariableTrace{
+				{
+					Order:        1,
+					Name:         "v1",
+					Source:       task_domain.TraceSourceExpression,
+					Expression:   "v0 * 2",
+					Dependencies: []task_domain.TraceDependency{{Name: "v0", Value: "21", Type: "int64"}},
+					Value:        "42",
+					Type:         "int",
+					Constraints:  []task_domain.ConstraintTrace{{Name: "c1", Expression: "v1 > 100", Passed: false, Message: "broken"}},
+				},
+			},
 			CreatorName: "alice",
+			Clock:       createdAt,
+			Constants:   map[string]string{"org_name": "ООО Ромашка"},
 			CreatedAt:   createdAt,
 			UpdatedAt:   &updatedAt,
 		},
-		Result: []byte("payload"),
+		Result: &domain.Result{
+			Data:        []byte("payload"),
+			Format:      output_domain.FormatDOCX,
+			ContentType: output_domain.FormatDOCX.ContentType(),
+		},
 	}
 
 	usecase := NewMockusecase(ctrl)
@@ -71,6 +92,8 @@ func TestHandler_TaskGetByID_Success(t *testing.T) {
 	require.Equal(t, int64(7), resp.Task.VersionID)
 	require.Equal(t, api.TaskStatus(task_domain.StatusFailed), resp.Task.Status)
 	require.Equal(t, "alice", resp.Task.CreatorName)
+	require.Equal(t, createdAt, resp.Task.Clock)
+	require.Equal(t, api.NewOptTaskGetByIDResponseTaskConstants(api.TaskGetByIDResponseTaskConstants{"org_name": "ООО Ромашка"}), resp.Task.Constants)
 	require.Equal(t, createdAt, resp.Task.CreatedAt)
 
 	gotUpdatedAt, ok := resp.Task.UpdatedAt.Get()
@@ -82,6 +105,7 @@ func TestHandler_TaskGetByID_Success(t *testing.T) {
 	gotMsg, ok := gotErr.Message.Get()
 	require.True(t, ok)
 	require.Equal(t, "fail", gotMsg)
+	require.Equal(t, []string{"a", "b", "a"}, gotErr.Cycle)
 	require.Len(t, gotErr.VariableErrors, 1)
 	require.Equal(t, int64(11), gotErr.VariableErrors[0].ID)
 	require.Equal(t, "v1", gotErr.VariableErrors[0].Name)
@@ -91,7 +115,11 @@ func TestHandler_TaskGetByID_Success(t *testing.T) {
 	require.Len(t, gotErr.VariableErrors[0].ConstraintErrors, 1)
 	require.Equal(t, int64(21), gotErr.VariableErrors[0].ConstraintErrors[0].ID)
 	require.Equal(t, "v1 > 100", gotErr.VariableErrors[0].ConstraintErrors[0].Expression)
+	require.Len(t, gotErr.VariableErrors[0].ConstraintErrors[0].Variables, 1)
+	require.Equal(t, "v1", gotErr.VariableErrors[0].ConstraintErrors[0].Variables[0].Name)
 	require.Equal(t, []byte("payload"), resp.Result)
+	require.Equal(t, api.NewOptOutputFormat(api.OutputFormatDocx), resp.ResultFormat)
+	require.Equal(t, api.NewOptString(output_domain.FormatDOCX.ContentType()), resp.ResultContentType)
 
 	gotTemplate, ok := gotErr.Template.Get()
 	require.True(t, ok)
@@ -105,6 +133,23 @@ func TestHandler_TaskGetByID_Success(t *testing.T) {
 	gotDetail, ok := gotTemplate.Detail.Get()
 	require.True(t, ok)
 	require.Equal(t, `function "test" not defined`, gotDetail)
+	gotPartial, ok := gotTemplate.Partial.Get()
+	require.True(t, ok)
+	require.Equal(t, "footer", gotPartial)
+
+	wantTrace := api.Trace{
+		{
+			Order:        1,
+			Name:         "v1",
+			Source:       api.NewOptTraceItemSource(api.TraceItemSourceExpression),
+			Expression:   api.NewOptString("v0 * 2"),
+			Dependencies: []api.TraceItemDependenciesItem{{Name: "v0", Value: api.NewOptString("21"), Type: api.NewOptString("int64")}},
+			Value:        api.NewOptString("42"),
+			Type:         api.NewOptString("int"),
+			Constraints:  []api.TraceItemConstraintsItem{{Name: "c1", Expression: "v1 > 100", Passed: false, Message: api.NewOptString("broken")}},
+		},
+	}
+	require.Equal(t, wantTrace, resp.Task.Trace)
 }
 
 func TestHandler_TaskGetByID_BaseError(t *testing.T) {
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/samber/lo"
+
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
 	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/template_create_from_default/domain"
@@ -30,6 +32,11 @@ func (h *Handler) TemplateCreateFromDefault(ctx context.Context, req *api.Templa
 			return &api.Error{Message: err.Error()}, nil
 		}
 
+		var validationErrs error_domain.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			return &api.Error{Message: err.Error(), Details: convertValidationErrorsToResponse(validationErrs)}, nil
+		}
+
 		var validationErr *error_domain.ValidationError
 		if errors.As(err, &validationErr) {
 			return &api.Error{Message: err.Error()}, nil
@@ -49,3 +56,12 @@ func convertRequestToIn(req *api.TemplateCreateFromDefaultRequest, params api.Te
 		Name:             req.Name,
 	}
 }
+
+func convertValidationErrorsToResponse(validationErrs error_domain.ValidationErrors) []api.ErrorDetailsItem {
+	return lo.Map(validationErrs, func(e *error_domain.ValidationError, _ int) api.ErrorDetailsItem {
+		return api.ErrorDetailsItem{
+			Field:   e.Field,
+			Message: e.Reason.Error(),
+		}
+	})
+}
@@ -40,7 +40,11 @@ func (h *Handler) TemplateGetByID(ctx context.Context, params api.TemplateGetByI
 	}
 
 	resp := api.TemplateGetByIDResponse{
-		Name: out.Name,
+		Name:         out.Name,
+		OutputFormat: api.OutputFormat(out.OutputFormat),
+	}
+	if out.StyleProfile != nil {
+		resp.StyleProfile.SetTo(*out.StyleProfile)
 	}
 	if out.Version != nil {
 		resp.Version.SetTo(convertVersionToResponse(*out.Version))
@@ -55,6 +59,7 @@ func convertVersionToResponse(version version_get_domain.Version) api.TemplateGe
 		Number:    version.Number,
 		CreatedAt: version.CreatedAt,
 		Data:      version.Data,
+		Kind:      api.TemplateGetByIDVersionKind(version.Kind),
 		Variables: convertVariablesToResponse(version.Variables),
 	}
 }
@@ -67,6 +72,8 @@ func convertVariablesToResponse(variables []version_get_domain.Variable) []api.T
 			Title:       v.Title,
 			Type:        api.TemplateGetByIDVersionVariablesItemType(v.Type),
 			IsInput:     v.IsInput,
+			Options:     v.Options,
+			Columns:     convertColumnsToResponse(v.Columns),
 			Constraints: convertConstraintsToResponse(v.Constraints),
 		}
 
@@ -74,10 +81,45 @@ func convertVariablesToResponse(variables []version_get_domain.Variable) []api.T
 			item.Expression.SetTo(*v.Expression)
 		}
 
+		if v.DefaultExpression != nil {
+			item.DefaultExpression.SetTo(*v.DefaultExpression)
+		}
+
+		if v.EnabledIf != nil {
+			item.EnabledIf.SetTo(*v.EnabledIf)
+		}
+
+		if v.Unit != nil {
+			item.Unit.SetTo(*v.Unit)
+		}
+
+		if v.OptionsFrom != nil {
+			item.OptionsFrom.SetTo(*v.OptionsFrom)
+		}
+
+		if v.ItemType != nil {
+			item.ItemType.SetTo(api.TemplateGetByIDVersionVariablesItemItemType(*v.ItemType))
+		}
+
 		return item
 	})
 }
 
+func convertColumnsToResponse(columns []version_get_domain.Column) []api.TemplateGetByIDVersionVariablesItemColumnsItem {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	return lo.Map(columns, func(c version_get_domain.Column, _ int) api.TemplateGetByIDVersionVariablesItemColumnsItem {
+		return api.TemplateGetByIDVersionVariablesItemColumnsItem{
+			Name:    c.Name,
+			Title:   c.Title,
+			Type:    api.TemplateGetByIDVersionVariablesItemColumnsItemType(c.Type),
+			Options: c.Options,
+		}
+	})
+}
+
 func convertConstraintsToResponse(constraints []version_get_domain.Constraint) []api.TemplateGetByIDVersionVariablesItemConstraintsItem {
 	return lo.Map(constraints, func(c version_get_domain.Constraint, _ int) api.TemplateGetByIDVersionVariablesItemConstraintsItem {
 		return api.TemplateGetByIDVersionVariablesItemConstraintsItem{
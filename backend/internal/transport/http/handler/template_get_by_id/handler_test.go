@@ -6,10 +6,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
 	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
 	version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
@@ -26,18 +29,24 @@ func TestHandler_TemplateGetByID_Success(t *testing.T) {
 	createdAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
 	expr := "x+1"
 	out := &domain.TemplateGetByIDOut{
-		Name: "tmpl",
+		Name:         "tmpl",
+		OutputFormat: output_domain.FormatDOCX,
+		StyleProfile: lo.ToPtr("gost_34"),
 		Version: &version_get_domain.Version{
 			ID:        5,
 			Number:    2,
 			CreatedAt: createdAt,
 			Data:      []byte("data"),
+			Kind:      template_domain.KindDOCX,
 			Variables: []version_get_domain.Variable{{
-				ID:         11,
-				Name:       "v1",
-				Type:       variable_domain.TypeString,
-				Expression: &expr,
-				IsInput:    true,
+				ID:          11,
+				Name:        "v1",
+				Type:        variable_domain.TypeString,
+				Expression:  &expr,
+				IsInput:     true,
+				EnabledIf:   lo.ToPtr("y > 0"),
+				Unit:        lo.ToPtr("mm"),
+				OptionsFrom: lo.ToPtr("materials.key"),
 				Constraints: []version_get_domain.Constraint{{
 					ID:         21,
 					VariableID: 11,
@@ -61,6 +70,8 @@ func TestHandler_TemplateGetByID_Success(t *testing.T) {
 	resp, ok := got.(*api.TemplateGetByIDResponse)
 	require.True(t, ok, "expected *api.TemplateGetByIDResponse, got %T", got)
 	require.Equal(t, "tmpl", resp.Name)
+	require.Equal(t, api.OutputFormatDocx, resp.OutputFormat)
+	require.Equal(t, api.NewOptString("gost_34"), resp.StyleProfile)
 
 	version, ok := resp.Version.Get()
 	require.True(t, ok)
@@ -68,12 +79,22 @@ func TestHandler_TemplateGetByID_Success(t *testing.T) {
 	require.Equal(t, int64(2), version.Number)
 	require.Equal(t, createdAt, version.CreatedAt)
 	require.Equal(t, []byte("data"), version.Data)
+	require.Equal(t, api.TemplateGetByIDVersionKindDocx, version.Kind)
 	require.Len(t, version.Variables, 1)
 	require.Equal(t, int64(11), version.Variables[0].ID)
 	require.Equal(t, "v1", version.Variables[0].Name)
 	gotExpr, ok := version.Variables[0].Expression.Get()
 	require.True(t, ok)
 	require.Equal(t, expr, gotExpr)
+	gotEnabledIf, ok := version.Variables[0].EnabledIf.Get()
+	require.True(t, ok)
+	require.Equal(t, "y > 0", gotEnabledIf)
+	gotUnit, ok := version.Variables[0].Unit.Get()
+	require.True(t, ok)
+	require.Equal(t, "mm", gotUnit)
+	gotOptionsFrom, ok := version.Variables[0].OptionsFrom.Get()
+	require.True(t, ok)
+	require.Equal(t, "materials.key", gotOptionsFrom)
 	require.Len(t, version.Variables[0].Constraints, 1)
 	require.Equal(t, int64(21), version.Variables[0].Constraints[0].ID)
 }
@@ -88,7 +109,7 @@ func TestHandler_TemplateGetByID_SuccessNoVersion(t *testing.T) {
 	usecase := NewMockusecase(ctrl)
 	usecase.EXPECT().
 		Handle(ctx, domain.TemplateGetByIDIn{TemplateID: 10, UserID: 1}).
-		Return(&domain.TemplateGetByIDOut{Name: "tmpl"}, nil)
+		Return(&domain.TemplateGetByIDOut{Name: "tmpl", OutputFormat: output_domain.FormatMarkdown}, nil)
 
 	handler := New(usecase)
 	got, err := handler.TemplateGetByID(ctx, params)
@@ -97,6 +118,8 @@ func TestHandler_TemplateGetByID_SuccessNoVersion(t *testing.T) {
 	resp, ok := got.(*api.TemplateGetByIDResponse)
 	require.True(t, ok)
 	require.Equal(t, "tmpl", resp.Name)
+	require.Equal(t, api.OutputFormatMd, resp.OutputFormat)
+	require.False(t, resp.StyleProfile.IsSet())
 	require.False(t, resp.Version.IsSet())
 }
 
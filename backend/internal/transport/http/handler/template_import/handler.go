@@ -33,6 +33,11 @@ func (h *Handler) TemplateImport(ctx context.Context, req *api.TemplateImportReq
 			return &api.Error{Message: err.Error()}, nil
 		}
 
+		var validationErrs error_domain.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			return &api.Error{Message: err.Error(), Details: convertValidationErrorsToResponse(validationErrs)}, nil
+		}
+
 		var validationErr *error_domain.ValidationError
 		if errors.As(err, &validationErr) {
 			return &api.Error{Message: err.Error()}, nil
@@ -68,6 +73,8 @@ func convertVariablesToIn(variables []api.TemplateImportVersionVariablesItem) []
 			Title:       v.Title,
 			Type:        variable_domain.Type(v.Type),
 			IsInput:     v.IsInput,
+			Options:     v.Options,
+			Columns:     convertColumnsToIn(v.Columns),
 			Constraints: convertConstraintsToIn(v.Constraints),
 		}
 
@@ -75,10 +82,45 @@ func convertVariablesToIn(variables []api.TemplateImportVersionVariablesItem) []
 			variable.Expression = &v.Expression.Value
 		}
 
+		if v.DefaultExpression.IsSet() {
+			variable.DefaultExpression = &v.DefaultExpression.Value
+		}
+
+		if v.EnabledIf.IsSet() {
+			variable.EnabledIf = &v.EnabledIf.Value
+		}
+
+		if v.Unit.IsSet() {
+			variable.Unit = &v.Unit.Value
+		}
+
+		if v.OptionsFrom.IsSet() {
+			variable.OptionsFrom = &v.OptionsFrom.Value
+		}
+
+		if v.ItemType.IsSet() {
+			variable.ItemType = lo.ToPtr(variable_domain.Type(v.ItemType.Value))
+		}
+
 		return variable
 	})
 }
 
+func convertColumnsToIn(columns []api.TemplateImportVersionVariablesItemColumnsItem) []domain.Column {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	return lo.Map(columns, func(c api.TemplateImportVersionVariablesItemColumnsItem, _ int) domain.Column {
+		return domain.Column{
+			Name:    c.Name,
+			Title:   c.Title,
+			Type:    variable_domain.Type(c.Type),
+			Options: c.Options,
+		}
+	})
+}
+
 func convertConstraintsToIn(constraints []api.TemplateImportVersionVariablesItemConstraintsItem) []domain.Constraint {
 	return lo.Map(constraints, func(c api.TemplateImportVersionVariablesItemConstraintsItem, _ int) domain.Constraint {
 		return domain.Constraint{
@@ -88,3 +130,12 @@ func convertConstraintsToIn(constraints []api.TemplateImportVersionVariablesItem
 		}
 	})
 }
+
+func convertValidationErrorsToResponse(validationErrs error_domain.ValidationErrors) []api.ErrorDetailsItem {
+	return lo.Map(validationErrs, func(e *error_domain.ValidationError, _ int) api.ErrorDetailsItem {
+		return api.ErrorDetailsItem{
+			Field:   e.Field,
+			Message: e.Reason.Error(),
+		}
+	})
+}
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/samber/lo"
+
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/template_update/domain"
 )
@@ -27,6 +30,14 @@ func (h *Handler) TemplateUpdateByID(ctx context.Context, req *api.TemplateUpdat
 		Name:       req.Name,
 	}
 
+	if outputFormat, ok := req.OutputFormat.Get(); ok {
+		in.OutputFormat = lo.ToPtr(output_domain.Format(outputFormat))
+	}
+
+	if styleProfile, ok := req.StyleProfile.Get(); ok {
+		in.StyleProfile = &styleProfile
+	}
+
 	err := h.usecase.Handle(ctx, in)
 	if err != nil {
 		var baseErr *error_domain.BaseError
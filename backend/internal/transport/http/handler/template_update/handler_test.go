@@ -5,10 +5,12 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/template_update/domain"
 )
@@ -34,6 +36,37 @@ func TestHandler_TemplateUpdateByID_Success(t *testing.T) {
 	require.True(t, ok, "expected *api.TemplateUpdateByIDNoContent, got %T", got)
 }
 
+func TestHandler_TemplateUpdateByID_OutputFormat(t *testing.T) {
+	ctx := context.Background()
+	req := &api.TemplateUpdateRequest{
+		Name:         "new",
+		OutputFormat: api.NewOptOutputFormat(api.OutputFormatDocx),
+		StyleProfile: api.NewOptString("gost_19"),
+	}
+	params := api.TemplateUpdateByIDParams{TemplateID: 10, XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	in := domain.TemplateUpdateIn{
+		TemplateID:   10,
+		UserID:       1,
+		Name:         "new",
+		OutputFormat: lo.ToPtr(output_domain.FormatDOCX),
+		StyleProfile: lo.ToPtr("gost_19"),
+	}
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, in).Return(nil)
+
+	handler := New(usecase)
+	got, err := handler.TemplateUpdateByID(ctx, req, params)
+	require.NoError(t, err)
+
+	_, ok := got.(*api.TemplateUpdateByIDNoContent)
+	require.True(t, ok, "expected *api.TemplateUpdateByIDNoContent, got %T", got)
+}
+
 func TestHandler_TemplateUpdateByID_BaseError(t *testing.T) {
 	ctx := context.Background()
 	req := &api.TemplateUpdateRequest{Name: "new"}
@@ -31,6 +31,11 @@ func (h *Handler) VersionCreate(ctx context.Context, req *api.VersionCreateReque
 			return &api.Error{Message: err.Error()}, nil
 		}
 
+		var validationErrs error_domain.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			return &api.Error{Message: err.Error(), Details: convertValidationErrorsToResponse(validationErrs)}, nil
+		}
+
 		var validationErr *error_domain.ValidationError
 		if errors.As(err, &validationErr) {
 			return &api.Error{Message: err.Error()}, nil
@@ -58,6 +63,8 @@ func convertVariablesToIn(variables []api.VersionCreateRequestVariablesItem) []v
 			Title:       v.Title,
 			Type:        variable_domain.Type(v.Type),
 			IsInput:     v.IsInput,
+			Options:     v.Options,
+			Columns:     convertColumnsToIn(v.Columns),
 			Constraints: convertConstraintsToIn(v.Constraints),
 		}
 
@@ -65,10 +72,45 @@ func convertVariablesToIn(variables []api.VersionCreateRequestVariablesItem) []v
 			variable.Expression = &v.Expression.Value
 		}
 
+		if v.DefaultExpression.IsSet() {
+			variable.DefaultExpression = &v.DefaultExpression.Value
+		}
+
+		if v.EnabledIf.IsSet() {
+			variable.EnabledIf = &v.EnabledIf.Value
+		}
+
+		if v.Unit.IsSet() {
+			variable.Unit = &v.Unit.Value
+		}
+
+		if v.OptionsFrom.IsSet() {
+			variable.OptionsFrom = &v.OptionsFrom.Value
+		}
+
+		if v.ItemType.IsSet() {
+			variable.ItemType = lo.ToPtr(variable_domain.Type(v.ItemType.Value))
+		}
+
 		return variable
 	})
 }
 
+func convertColumnsToIn(columns []api.VersionCreateRequestVariablesItemColumnsItem) []version_create_domain.Column {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	return lo.Map(columns, func(c api.VersionCreateRequestVariablesItemColumnsItem, _ int) version_create_domain.Column {
+		return version_create_domain.Column{
+			Name:    c.Name,
+			Title:   c.Title,
+			Type:    variable_domain.Type(c.Type),
+			Options: c.Options,
+		}
+	})
+}
+
 func convertConstraintsToIn(constraints []api.VersionCreateRequestVariablesItemConstraintsItem) []version_create_domain.Constraint {
 	return lo.Map(constraints, func(c api.VersionCreateRequestVariablesItemConstraintsItem, _ int) version_create_domain.Constraint {
 		return version_create_domain.Constraint{
@@ -78,3 +120,12 @@ func convertConstraintsToIn(constraints []api.VersionCreateRequestVariablesItemC
 		}
 	})
 }
+
+func convertValidationErrorsToResponse(validationErrs error_domain.ValidationErrors) []api.ErrorDetailsItem {
+	return lo.Map(validationErrs, func(e *error_domain.ValidationError, _ int) api.ErrorDetailsItem {
+		return api.ErrorDetailsItem{
+			Field:   e.Field,
+			Message: e.Reason.Error(),
+		}
+	})
+}
@@ -108,6 +108,36 @@ func TestHandler_VersionCreate_ValidationError(t *testing.T) {
 	require.Equal(t, validationErr.Error(), resp.Message)
 }
 
+func TestHandler_VersionCreate_ValidationErrors(t *testing.T) {
+	ctx := context.Background()
+	req := &api.VersionCreateRequest{TemplateID: 3, Data: []byte("d")}
+	params := api.VersionCreateParams{XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validationErrs := error_domain.ValidationErrors{
+		error_domain.NewValidationError("variables.0.expression", errors.New("invalid")),
+		error_domain.NewValidationError("variables", errors.New("cycle")),
+	}
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(int64(0), validationErrs)
+
+	handler := New(usecase)
+	got, err := handler.VersionCreate(ctx, req, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.Error)
+	require.True(t, ok, "expected *api.Error, got %T", got)
+	require.Equal(t, validationErrs.Error(), resp.Message)
+
+	want := []api.ErrorDetailsItem{
+		{Field: "variables.0.expression", Message: "invalid"},
+		{Field: "variables", Message: "cycle"},
+	}
+	require.Equal(t, want, resp.Details)
+}
+
 func TestHandler_VersionCreate_InternalError(t *testing.T) {
 	ctx := context.Background()
 	req := &api.VersionCreateRequest{TemplateID: 3, Data: []byte("d")}
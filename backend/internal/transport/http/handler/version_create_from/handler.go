@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/samber/lo"
+
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
 	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_create_from/domain"
@@ -28,6 +30,16 @@ func (h *Handler) VersionCreateFrom(ctx context.Context, req *api.VersionCreateF
 			return &api.Error{Message: err.Error()}, nil
 		}
 
+		var validationErrs error_domain.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			return &api.Error{Message: err.Error(), Details: convertValidationErrorsToResponse(validationErrs)}, nil
+		}
+
+		var validationErr *error_domain.ValidationError
+		if errors.As(err, &validationErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
 		return nil, fmt.Errorf("version create from usecase: %w", err)
 	}
 
@@ -41,3 +53,12 @@ func convertRequestToIn(req *api.VersionCreateFromRequest, params api.VersionCre
 		VersionID:  req.VersionID,
 	}
 }
+
+func convertValidationErrorsToResponse(validationErrs error_domain.ValidationErrors) []api.ErrorDetailsItem {
+	return lo.Map(validationErrs, func(e *error_domain.ValidationError, _ int) api.ErrorDetailsItem {
+		return api.ErrorDetailsItem{
+			Field:   e.Field,
+			Message: e.Reason.Error(),
+		}
+	})
+}
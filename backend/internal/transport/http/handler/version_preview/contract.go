@@ -0,0 +1,13 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package version_preview_handler
+
+import (
+	"context"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview/domain"
+)
+
+type usecase interface {
+	Handle(ctx context.Context, in domain.VersionPreviewIn) (*domain.VersionPreviewOut, error)
+}
@@ -0,0 +1,76 @@
+package version_preview_handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	process_converter "github.com/qsoulior/tech-generator/backend/internal/transport/http/converter/process"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) VersionPreview(ctx context.Context, req *api.VersionPreviewRequest, params api.VersionPreviewParams) (api.VersionPreviewRes, error) {
+	payload, err := convertPayloadToIn(req.Payload)
+	if err != nil {
+		return &api.Error{Message: err.Error()}, nil
+	}
+
+	in := domain.VersionPreviewIn{
+		VersionID: req.VersionID,
+		UserID:    params.XUserID,
+		Payload:   payload,
+		Trace:     req.Trace.Or(false),
+	}
+
+	out, err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
+		return nil, fmt.Errorf("version preview usecase: %w", err)
+	}
+
+	resp := api.VersionPreviewResponse{
+		Result: out.Result,
+		Trace:  process_converter.ConvertTraceToResponse(out.Trace),
+	}
+
+	if out.Error != nil {
+		resp.Error.SetTo(process_converter.ConvertErrorToResponse(*out.Error))
+	}
+
+	return &resp, nil
+}
+
+func convertPayloadToIn(payload api.VersionPreviewRequestPayload) (map[string]any, error) {
+	in := make(map[string]any, len(payload))
+	for name, raw := range payload {
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("payload %q: %w", name, err)
+		}
+
+		in[name] = value
+	}
+
+	return in, nil
+}
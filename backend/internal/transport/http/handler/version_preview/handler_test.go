@@ -0,0 +1,141 @@
+package version_preview_handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview/domain"
+)
+
+func TestHandler_VersionPreview_Success(t *testing.T) {
+	ctx := context.Background()
+	req := &api.VersionPreviewRequest{VersionID: 7, Payload: api.VersionPreviewRequestPayload{"count": jx.Raw(`42`)}, Trace: api.NewOptBool(true)}
+	params := api.VersionPreviewParams{XUserID: 1}
+
+	trace := []task_domain.VariableTrace{
+		{Order: 1, Name: "count", Source: task_domain.TraceSourcePayload, Value: "42", Type: "int64"},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().
+		Handle(ctx, domain.VersionPreviewIn{VersionID: 7, UserID: 1, Payload: map[string]any{"count": json.Number("42")}, Trace: true}).
+		Return(&domain.VersionPreviewOut{Result: []byte("42"), Trace: trace}, nil)
+
+	handler := New(usecase)
+	got, err := handler.VersionPreview(ctx, req, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.VersionPreviewResponse)
+	require.True(t, ok, "expected *api.VersionPreviewResponse, got %T", got)
+	require.Equal(t, []byte("42"), resp.Result)
+	require.False(t, resp.Error.IsSet())
+
+	wantTrace := api.Trace{
+		{
+			Order:        1,
+			Name:         "count",
+			Source:       api.NewOptTraceItemSource(api.TraceItemSourcePayload),
+			Dependencies: []api.TraceItemDependenciesItem{},
+			Value:        api.NewOptString("42"),
+			Type:         api.NewOptString("int64"),
+			Constraints:  []api.TraceItemConstraintsItem{},
+		},
+	}
+	require.Equal(t, wantTrace, resp.Trace)
+}
+
+func TestHandler_VersionPreview_ProcessError(t *testing.T) {
+	ctx := context.Background()
+	req := &api.VersionPreviewRequest{VersionID: 7, Payload: api.VersionPreviewRequestPayload{}}
+	params := api.VersionPreviewParams{XUserID: 1}
+
+	processErr := &task_domain.ProcessError{
+		VariableErrors: []task_domain.VariableError{
+			{ID: 11, Name: "v1", Title: "V1", Message: task_domain.MessageVariableMissing},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(&domain.VersionPreviewOut{Error: processErr}, nil)
+
+	handler := New(usecase)
+	got, err := handler.VersionPreview(ctx, req, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.VersionPreviewResponse)
+	require.True(t, ok, "expected *api.VersionPreviewResponse, got %T", got)
+	require.Nil(t, resp.Result)
+
+	gotErr, ok := resp.Error.Get()
+	require.True(t, ok)
+	require.False(t, gotErr.Message.IsSet())
+	require.Len(t, gotErr.VariableErrors, 1)
+	require.Equal(t, "v1", gotErr.VariableErrors[0].Name)
+	gotMsg, ok := gotErr.VariableErrors[0].Message.Get()
+	require.True(t, ok)
+	require.Equal(t, task_domain.MessageVariableMissing, gotMsg)
+}
+
+func TestHandler_VersionPreview_BaseError(t *testing.T) {
+	ctx := context.Background()
+	req := &api.VersionPreviewRequest{VersionID: 7, Payload: api.VersionPreviewRequestPayload{}}
+	params := api.VersionPreviewParams{XUserID: 1}
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "VersionNotFound", err: domain.ErrVersionNotFound},
+		{name: "VersionInvalid", err: domain.ErrVersionInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, tt.err)
+
+			handler := New(usecase)
+			got, err := handler.VersionPreview(ctx, req, params)
+			require.NoError(t, err)
+
+			resp, ok := got.(*api.Error)
+			require.True(t, ok, "expected *api.Error, got %T", got)
+			require.Equal(t, tt.err.Error(), resp.Message)
+		})
+	}
+}
+
+func TestHandler_VersionPreview_InternalError(t *testing.T) {
+	ctx := context.Background()
+	req := &api.VersionPreviewRequest{VersionID: 7, Payload: api.VersionPreviewRequestPayload{}}
+	params := api.VersionPreviewParams{XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.VersionPreview(ctx, req, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "version preview usecase")
+	require.ErrorContains(t, err, "boom")
+}
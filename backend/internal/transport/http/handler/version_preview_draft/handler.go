@@ -0,0 +1,168 @@
+package version_preview_draft_handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	version_create_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
+	process_converter "github.com/qsoulior/tech-generator/backend/internal/transport/http/converter/process"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview_draft/domain"
+)
+
+type Handler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) VersionPreviewDraft(ctx context.Context, req *api.VersionPreviewDraftRequest, params api.VersionPreviewDraftParams) (api.VersionPreviewDraftRes, error) {
+	payload, err := convertPayloadToIn(req.Payload)
+	if err != nil {
+		return &api.Error{Message: err.Error()}, nil
+	}
+
+	in := domain.VersionPreviewDraftIn{
+		Version: domain.VersionCreateIn{
+			AuthorID:   params.XUserID,
+			TemplateID: req.TemplateID,
+			Data:       req.Data,
+			Variables:  convertVariablesToIn(req.Variables),
+		},
+		Payload: payload,
+		Trace:   req.Trace.Or(false),
+	}
+
+	out, err := h.usecase.Handle(ctx, in)
+	if err != nil {
+		var baseErr *error_domain.BaseError
+		if errors.As(err, &baseErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
+		var validationErrs error_domain.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			return &api.Error{Message: err.Error(), Details: convertValidationErrorsToResponse(validationErrs)}, nil
+		}
+
+		var validationErr *error_domain.ValidationError
+		if errors.As(err, &validationErr) {
+			return &api.Error{Message: err.Error()}, nil
+		}
+
+		return nil, fmt.Errorf("version preview draft usecase: %w", err)
+	}
+
+	resp := api.VersionPreviewResponse{
+		Result: out.Result,
+		Trace:  process_converter.ConvertTraceToResponse(out.Trace),
+	}
+
+	if out.Error != nil {
+		resp.Error.SetTo(process_converter.ConvertErrorToResponse(*out.Error))
+	}
+
+	return &resp, nil
+}
+
+func convertVariablesToIn(variables []api.VersionPreviewDraftRequestVariablesItem) []version_create_domain.Variable {
+	return lo.Map(variables, func(v api.VersionPreviewDraftRequestVariablesItem, _ int) version_create_domain.Variable {
+		variable := version_create_domain.Variable{
+			Name:        v.Name,
+			Title:       v.Title,
+			Type:        variable_domain.Type(v.Type),
+			IsInput:     v.IsInput,
+			Options:     v.Options,
+			Columns:     convertColumnsToIn(v.Columns),
+			Constraints: convertConstraintsToIn(v.Constraints),
+		}
+
+		if v.Expression.IsSet() {
+			variable.Expression = &v.Expression.Value
+		}
+
+		if v.DefaultExpression.IsSet() {
+			variable.DefaultExpression = &v.DefaultExpression.Value
+		}
+
+		if v.EnabledIf.IsSet() {
+			variable.EnabledIf = &v.EnabledIf.Value
+		}
+
+		if v.Unit.IsSet() {
+			variable.Unit = &v.Unit.Value
+		}
+
+		if v.OptionsFrom.IsSet() {
+			variable.OptionsFrom = &v.OptionsFrom.Value
+		}
+
+		if v.ItemType.IsSet() {
+			variable.ItemType = lo.ToPtr(variable_domain.Type(v.ItemType.Value))
+		}
+
+		return variable
+	})
+}
+
+func convertColumnsToIn(columns []api.VersionPreviewDraftRequestVariablesItemColumnsItem) []version_create_domain.Column {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	return lo.Map(columns, func(c api.VersionPreviewDraftRequestVariablesItemColumnsItem, _ int) version_create_domain.Column {
+		return version_create_domain.Column{
+			Name:    c.Name,
+			Title:   c.Title,
+			Type:    variable_domain.Type(c.Type),
+			Options: c.Options,
+		}
+	})
+}
+
+func convertConstraintsToIn(constraints []api.VersionPreviewDraftRequestVariablesItemConstraintsItem) []version_create_domain.Constraint {
+	return lo.Map(constraints, func(c api.VersionPreviewDraftRequestVariablesItemConstraintsItem, _ int) version_create_domain.Constraint {
+		return version_create_domain.Constraint{
+			Name:       c.Name,
+			Expression: c.Expression,
+			IsActive:   c.IsActive,
+		}
+	})
+}
+
+func convertValidationErrorsToResponse(validationErrs error_domain.ValidationErrors) []api.ErrorDetailsItem {
+	return lo.Map(validationErrs, func(e *error_domain.ValidationError, _ int) api.ErrorDetailsItem {
+		return api.ErrorDetailsItem{
+			Field:   e.Field,
+			Message: e.Reason.Error(),
+		}
+	})
+}
+
+func convertPayloadToIn(payload api.VersionPreviewDraftRequestPayload) (map[string]any, error) {
+	in := make(map[string]any, len(payload))
+	for name, raw := range payload {
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("payload %q: %w", name, err)
+		}
+
+		in[name] = value
+	}
+
+	return in, nil
+}
@@ -0,0 +1,199 @@
+package version_preview_draft_handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-faster/jx"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/generated/api"
+	version_create_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview_draft/domain"
+)
+
+func TestHandler_VersionPreviewDraft_Success(t *testing.T) {
+	ctx := context.Background()
+	req := &api.VersionPreviewDraftRequest{
+		TemplateID: 10,
+		Data:       []byte("{{ .total }}"),
+		Variables: []api.VersionPreviewDraftRequestVariablesItem{
+			{
+				Name:    "count",
+				Title:   "Count",
+				Type:    api.VersionPreviewDraftRequestVariablesItemTypeInteger,
+				IsInput: true,
+			},
+			{
+				Name:       "total",
+				Title:      "Total",
+				Type:       api.VersionPreviewDraftRequestVariablesItemTypeInteger,
+				Expression: api.NewOptString("count * 2"),
+				Constraints: []api.VersionPreviewDraftRequestVariablesItemConstraintsItem{
+					{Name: "positive", Expression: "total > 0", IsActive: true},
+				},
+			},
+		},
+		Payload: api.VersionPreviewDraftRequestPayload{"count": jx.Raw(`42`)},
+	}
+	params := api.VersionPreviewDraftParams{XUserID: 1}
+
+	in := domain.VersionPreviewDraftIn{
+		Version: domain.VersionCreateIn{
+			AuthorID:   1,
+			TemplateID: 10,
+			Data:       []byte("{{ .total }}"),
+			Variables: []version_create_domain.Variable{
+				{
+					Name:        "count",
+					Title:       "Count",
+					Type:        variable_domain.TypeInteger,
+					IsInput:     true,
+					Constraints: []version_create_domain.Constraint{},
+				},
+				{
+					Name:        "total",
+					Title:       "Total",
+					Type:        variable_domain.TypeInteger,
+					Expression:  lo.ToPtr("count * 2"),
+					Constraints: []version_create_domain.Constraint{{Name: "positive", Expression: "total > 0", IsActive: true}},
+				},
+			},
+		},
+		Payload: map[string]any{"count": json.Number("42")},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, in).Return(&domain.VersionPreviewDraftOut{Result: []byte("84")}, nil)
+
+	handler := New(usecase)
+	got, err := handler.VersionPreviewDraft(ctx, req, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.VersionPreviewResponse)
+	require.True(t, ok, "expected *api.VersionPreviewResponse, got %T", got)
+	require.Equal(t, []byte("84"), resp.Result)
+	require.False(t, resp.Error.IsSet())
+}
+
+func TestHandler_VersionPreviewDraft_ProcessError(t *testing.T) {
+	ctx := context.Background()
+	req := &api.VersionPreviewDraftRequest{TemplateID: 10, Payload: api.VersionPreviewDraftRequestPayload{}}
+	params := api.VersionPreviewDraftParams{XUserID: 1}
+
+	processErr := &task_domain.ProcessError{
+		Message: task_domain.MessageTemplateParse,
+		Template: &task_domain.TemplateError{
+			Line:    2,
+			Snippet: "broken {{abc}}",
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(&domain.VersionPreviewDraftOut{Error: processErr}, nil)
+
+	handler := New(usecase)
+	got, err := handler.VersionPreviewDraft(ctx, req, params)
+	require.NoError(t, err)
+
+	resp, ok := got.(*api.VersionPreviewResponse)
+	require.True(t, ok, "expected *api.VersionPreviewResponse, got %T", got)
+	require.Nil(t, resp.Result)
+
+	gotErr, ok := resp.Error.Get()
+	require.True(t, ok)
+	require.Equal(t, api.NewOptString(task_domain.MessageTemplateParse), gotErr.Message)
+
+	gotTemplate, ok := gotErr.Template.Get()
+	require.True(t, ok)
+	require.Equal(t, 2, gotTemplate.Line)
+	require.Equal(t, api.NewOptString("broken {{abc}}"), gotTemplate.Snippet)
+}
+
+func TestHandler_VersionPreviewDraft_Error(t *testing.T) {
+	ctx := context.Background()
+	req := &api.VersionPreviewDraftRequest{TemplateID: 10, Payload: api.VersionPreviewDraftRequestPayload{}}
+	params := api.VersionPreviewDraftParams{XUserID: 1}
+
+	validationErrs := error_domain.ValidationErrors{
+		error_domain.NewValidationError("variables.0.expression", version_create_domain.ErrValueEmpty),
+	}
+
+	validationErr := error_domain.NewValidationError("variables.0.title", version_create_domain.ErrValueEmpty)
+
+	tests := []struct {
+		name string
+		err  error
+		want *api.Error
+	}{
+		{
+			name: "TemplateNotFound",
+			err:  domain.ErrTemplateNotFound,
+			want: &api.Error{Message: domain.ErrTemplateNotFound.Error()},
+		},
+		{
+			name: "TemplateInvalid",
+			err:  domain.ErrTemplateInvalid,
+			want: &api.Error{Message: domain.ErrTemplateInvalid.Error()},
+		},
+		{
+			name: "ValidationError",
+			err:  validationErr,
+			want: &api.Error{Message: validationErr.Error()},
+		},
+		{
+			name: "ValidationErrors",
+			err:  validationErrs,
+			want: &api.Error{
+				Message: validationErrs.Error(),
+				Details: []api.ErrorDetailsItem{{Field: "variables.0.expression", Message: "value is empty"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := NewMockusecase(ctrl)
+			usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, tt.err)
+
+			handler := New(usecase)
+			got, err := handler.VersionPreviewDraft(ctx, req, params)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHandler_VersionPreviewDraft_InternalError(t *testing.T) {
+	ctx := context.Background()
+	req := &api.VersionPreviewDraftRequest{TemplateID: 10, Payload: api.VersionPreviewDraftRequestPayload{}}
+	params := api.VersionPreviewDraftParams{XUserID: 1}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := NewMockusecase(ctrl)
+	usecase.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("boom"))
+
+	handler := New(usecase)
+	got, err := handler.VersionPreviewDraft(ctx, req, params)
+	require.Nil(t, got)
+	require.ErrorContains(t, err, "version preview draft usecase")
+	require.ErrorContains(t, err, "boom")
+}
@@ -0,0 +1,5 @@
+package domain
+
+import constant_domain "github.com/qsoulior/tech-generator/backend/internal/domain/constant"
+
+type Constant = constant_domain.Constant
@@ -0,0 +1,15 @@
+package project_constant_list_usecase
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	constant_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_list/repository/constant"
+	project_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_list/repository/project"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_list/usecase"
+)
+
+func New(db *sqlx.DB) *usecase.Usecase {
+	projectRepo := project_repository.New(db)
+	constantRepo := constant_repository.New(db)
+	return usecase.New(projectRepo, constantRepo)
+}
@@ -0,0 +1,15 @@
+package constant_repository
+
+import "github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_list/domain"
+
+type constant struct {
+	Name  string `db:"name"`
+	Value string `db:"value"`
+}
+
+func (c constant) toDomain() domain.Constant {
+	return domain.Constant{
+		Name:  c.Name,
+		Value: c.Value,
+	}
+}
@@ -0,0 +1,50 @@
+package constant_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_list/domain"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+func (r *Repository) ListByProjectID(ctx context.Context, projectID int64) ([]domain.Constant, error) {
+	op := "constant - list by project id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"name",
+			"value",
+		).
+		From("project_constant").
+		Where(sq.Eq{"project_id": projectID}).
+		OrderBy("name ASC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dtos []constant
+	err = r.db.SelectContext(ctx, &dtos, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return lo.Map(dtos, func(c constant, _ int) domain.Constant { return c.toDomain() }), nil
+}
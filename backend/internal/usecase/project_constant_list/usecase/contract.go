@@ -0,0 +1,17 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_list/domain"
+)
+
+type projectRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Project, error)
+}
+
+type constantRepository interface {
+	ListByProjectID(ctx context.Context, projectID int64) ([]domain.Constant, error)
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_list/domain"
+)
+
+type Usecase struct {
+	projectRepo  projectRepository
+	constantRepo constantRepository
+}
+
+func New(projectRepo projectRepository, constantRepo constantRepository) *Usecase {
+	return &Usecase{
+		projectRepo:  projectRepo,
+		constantRepo: constantRepo,
+	}
+}
+
+func (u *Usecase) Handle(ctx context.Context, in domain.ProjectConstantListIn) ([]domain.Constant, error) {
+	project, err := u.projectRepo.GetByID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("project repo - get by id: %w", err)
+	}
+
+	if project == nil {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	isMember := lo.SomeBy(project.Users, func(user domain.ProjectUser) bool { return user.ID == in.UserID })
+
+	if project.AuthorID != in.UserID && !isMember {
+		return nil, domain.ErrProjectInvalid
+	}
+
+	constants, err := u.constantRepo.ListByProjectID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("constant repo - list by project id: %w", err)
+	}
+
+	return constants, nil
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+)
+
+var (
+	ErrProjectNotFound = error_domain.NewBaseError("project not found")
+	ErrProjectInvalid  = error_domain.NewBaseError("project is invalid")
+	ErrValueInvalid    = errors.New("value is invalid")
+	ErrValueDuplicate  = errors.New("value is duplicate")
+)
+
+var slugRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+const (
+	slugMaxLen      = 100
+	constantsMaxLen = 200
+	valueMaxLen     = 10000
+)
+
+// ProjectConstantSaveIn replaces all the constants of the project.
+type ProjectConstantSaveIn struct {
+	ProjectID int64
+	AuthorID  int64
+	Constants []Constant
+}
+
+func (in ProjectConstantSaveIn) Validate() error {
+	if len(in.Constants) > constantsMaxLen {
+		return error_domain.NewValidationError("constants", ErrValueInvalid)
+	}
+
+	for i, c := range in.Constants {
+		if !validSlug(c.Name) {
+			return error_domain.NewValidationError(fmt.Sprintf("constants.%d.name", i), ErrValueInvalid)
+		}
+
+		if slices.ContainsFunc(in.Constants[:i], func(prev Constant) bool { return prev.Name == c.Name }) {
+			return error_domain.NewValidationError(fmt.Sprintf("constants.%d.name", i), ErrValueDuplicate)
+		}
+
+		if utf8.RuneCountInString(c.Value) > valueMaxLen {
+			return error_domain.NewValidationError(fmt.Sprintf("constants.%d.value", i), ErrValueInvalid)
+		}
+	}
+
+	return nil
+}
+
+func validSlug(s string) bool {
+	return s != "" && len(s) <= slugMaxLen && slugRegexp.MatchString(s)
+}
@@ -0,0 +1,18 @@
+package project_constant_save_usecase
+
+import (
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/jmoiron/sqlx"
+
+	constant_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save/repository/constant"
+	project_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save/repository/project"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save/usecase"
+)
+
+func New(db *sqlx.DB) *usecase.Usecase {
+	projectRepo := project_repository.New(db)
+	constantRepo := constant_repository.New(db, trmsqlx.DefaultCtxGetter)
+	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
+	return usecase.New(projectRepo, constantRepo, trManager)
+}
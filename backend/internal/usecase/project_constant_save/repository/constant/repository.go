@@ -0,0 +1,72 @@
+package constant_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save/domain"
+)
+
+type Repository struct {
+	db       *sqlx.DB
+	trGetter *trmsqlx.CtxGetter
+}
+
+func New(db *sqlx.DB, trGetter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{
+		db:       db,
+		trGetter: trGetter,
+	}
+}
+
+func (r *Repository) DeleteByProjectID(ctx context.Context, projectID int64) error {
+	op := "constant - delete by project id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("project_constant").
+		Where(sq.Eq{"project_id": projectID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	_, err = r.trGetter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Create(ctx context.Context, projectID int64, authorID int64, constants []domain.Constant) error {
+	op := "constant - create"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("project_constant").
+		Columns("project_id", "author_id", "name", "value")
+
+	for _, c := range constants {
+		builder = builder.Values(projectID, authorID, c.Name, c.Value)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	_, err = r.trGetter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return nil
+}
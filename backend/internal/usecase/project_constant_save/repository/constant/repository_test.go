@@ -0,0 +1,95 @@
+package constant_repository
+
+import (
+	"context"
+	"testing"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_DeleteByProjectID() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// projects
+	projects := test_db.GenerateEntities(2, func(p *test_db.Project, _ int) { p.AuthorID = userID })
+	projectIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project", projects)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project", projectIDs)) }()
+
+	// constants: two of the project and one of another project
+	constants := test_db.GenerateEntities(3, func(c *test_db.ProjectConstant, i int) {
+		c.Name = []string{"org_name", "inn", "org_name"}[i]
+		c.ProjectID = projectIDs[i/2]
+		c.AuthorID = &userID
+	})
+	_, err = test_db.InsertEntitiesWithID[int64](s.C(), "project_constant", constants)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByColumn(s.C(), "project_constant", "project_id", projectIDs))
+	}()
+
+	err = repo.DeleteByProjectID(ctx, projectIDs[0])
+	require.NoError(s.T(), err)
+
+	got, err := test_db.SelectEntitiesByColumn[test_db.ProjectConstant](s.C(), "project_constant", "project_id", projectIDs)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), got, 1)
+	require.Equal(s.T(), projectIDs[1], got[0].ProjectID)
+}
+
+func (s *repositorySuite) TestRepository_Create() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	constants := []domain.Constant{
+		{Name: "org_name", Value: "ООО Ромашка"},
+		{Name: "inn", Value: "7701234567"},
+	}
+	err = repo.Create(ctx, projectID, userID, constants)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByColumn(s.C(), "project_constant", "project_id", []int64{projectID}))
+	}()
+
+	got, err := test_db.SelectEntitiesByColumn[test_db.ProjectConstant](s.C(), "project_constant", "project_id", []int64{projectID})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), got, 2)
+
+	for i, c := range constants {
+		require.Equal(s.T(), c.Name, got[i].Name)
+		require.Equal(s.T(), c.Value, got[i].Value)
+		require.Equal(s.T(), &userID, got[i].AuthorID)
+	}
+}
@@ -0,0 +1,18 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save/domain"
+)
+
+type projectRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Project, error)
+}
+
+type constantRepository interface {
+	DeleteByProjectID(ctx context.Context, projectID int64) error
+	Create(ctx context.Context, projectID int64, authorID int64, constants []domain.Constant) error
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -package usecase -source contract.go -destination contract_mock.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockprojectRepository is a mock of projectRepository interface.
+type MockprojectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockprojectRepositoryMockRecorder is the mock recorder for MockprojectRepository.
+type MockprojectRepositoryMockRecorder struct {
+	mock *MockprojectRepository
+}
+
+// NewMockprojectRepository creates a new mock instance.
+func NewMockprojectRepository(ctrl *gomock.Controller) *MockprojectRepository {
+	mock := &MockprojectRepository{ctrl: ctrl}
+	mock.recorder = &MockprojectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectRepository) EXPECT() *MockprojectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockprojectRepository) GetByID(ctx context.Context, id int64) (*domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockprojectRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockprojectRepository)(nil).GetByID), ctx, id)
+}
+
+// MockconstantRepository is a mock of constantRepository interface.
+type MockconstantRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockconstantRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockconstantRepositoryMockRecorder is the mock recorder for MockconstantRepository.
+type MockconstantRepositoryMockRecorder struct {
+	mock *MockconstantRepository
+}
+
+// NewMockconstantRepository creates a new mock instance.
+func NewMockconstantRepository(ctrl *gomock.Controller) *MockconstantRepository {
+	mock := &MockconstantRepository{ctrl: ctrl}
+	mock.recorder = &MockconstantRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockconstantRepository) EXPECT() *MockconstantRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockconstantRepository) Create(ctx context.Context, projectID, authorID int64, constants []domain.Constant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, projectID, authorID, constants)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockconstantRepositoryMockRecorder) Create(ctx, projectID, authorID, constants any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockconstantRepository)(nil).Create), ctx, projectID, authorID, constants)
+}
+
+// DeleteByProjectID mocks base method.
+func (m *MockconstantRepository) DeleteByProjectID(ctx context.Context, projectID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByProjectID", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByProjectID indicates an expected call of DeleteByProjectID.
+func (mr *MockconstantRepositoryMockRecorder) DeleteByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByProjectID", reflect.TypeOf((*MockconstantRepository)(nil).DeleteByProjectID), ctx, projectID)
+}
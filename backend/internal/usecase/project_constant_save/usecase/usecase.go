@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/samber/lo"
+
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save/domain"
+)
+
+type Usecase struct {
+	projectRepo  projectRepository
+	constantRepo constantRepository
+	trManager    trm.Manager
+}
+
+func New(projectRepo projectRepository, constantRepo constantRepository, trManager trm.Manager) *Usecase {
+	return &Usecase{
+		projectRepo:  projectRepo,
+		constantRepo: constantRepo,
+		trManager:    trManager,
+	}
+}
+
+// Handle replaces the constants of the project. Tasks that are already
+// processed keep the constants they were rendered with.
+func (u *Usecase) Handle(ctx context.Context, in domain.ProjectConstantSaveIn) error {
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	// check project
+	project, err := u.projectRepo.GetByID(ctx, in.ProjectID)
+	if err != nil {
+		return fmt.Errorf("project repo - get by id: %w", err)
+	}
+
+	if project == nil {
+		return domain.ErrProjectNotFound
+	}
+
+	isMaintainer := lo.SomeBy(project.Users, func(user domain.ProjectUser) bool {
+		return user.ID == in.AuthorID && user.Role == user_domain.RoleMaintain
+	})
+
+	if project.AuthorID != in.AuthorID && !isMaintainer {
+		return domain.ErrProjectInvalid
+	}
+
+	// save constants
+	return u.trManager.Do(ctx, func(ctx context.Context) error {
+		return u.saveConstants(ctx, in)
+	})
+}
+
+func (u *Usecase) saveConstants(ctx context.Context, in domain.ProjectConstantSaveIn) error {
+	err := u.constantRepo.DeleteByProjectID(ctx, in.ProjectID)
+	if err != nil {
+		return fmt.Errorf("constant repo - delete by project id: %w", err)
+	}
+
+	if len(in.Constants) == 0 {
+		return nil
+	}
+
+	err = u.constantRepo.Create(ctx, in.ProjectID, in.AuthorID, in.Constants)
+	if err != nil {
+		return fmt.Errorf("constant repo - create: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	test_trm "github.com/qsoulior/tech-generator/backend/internal/pkg/test/trm"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_constant_save/domain"
+)
+
+func TestUsecase_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+	trCtx := context.WithValue(ctx, test_trm.TrKey{}, struct{}{})
+
+	constants := []domain.Constant{
+		{Name: "org_name", Value: "ООО Ромашка"},
+		{Name: "inn", Value: "7701234567"},
+	}
+
+	tests := []struct {
+		name  string
+		in    domain.ProjectConstantSaveIn
+		setup func(projectRepo *MockprojectRepository, constantRepo *MockconstantRepository)
+	}{
+		{
+			name: "IsAuthor",
+			in:   domain.ProjectConstantSaveIn{ProjectID: 10, AuthorID: 1, Constants: constants},
+			setup: func(projectRepo *MockprojectRepository, constantRepo *MockconstantRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				constantRepo.EXPECT().DeleteByProjectID(trCtx, int64(10)).Return(nil)
+				constantRepo.EXPECT().Create(trCtx, int64(10), int64(1), constants).Return(nil)
+			},
+		},
+		{
+			name: "IsMaintainer",
+			in:   domain.ProjectConstantSaveIn{ProjectID: 10, AuthorID: 2, Constants: constants},
+			setup: func(projectRepo *MockprojectRepository, constantRepo *MockconstantRepository) {
+				project := domain.Project{AuthorID: 1, Users: []domain.ProjectUser{{ID: 2, Role: user_domain.RoleMaintain}}}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+				constantRepo.EXPECT().DeleteByProjectID(trCtx, int64(10)).Return(nil)
+				constantRepo.EXPECT().Create(trCtx, int64(10), int64(2), constants).Return(nil)
+			},
+		},
+		{
+			name: "Empty",
+			in:   domain.ProjectConstantSaveIn{ProjectID: 10, AuthorID: 1},
+			setup: func(projectRepo *MockprojectRepository, constantRepo *MockconstantRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				constantRepo.EXPECT().DeleteByProjectID(trCtx, int64(10)).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			constantRepo := NewMockconstantRepository(ctrl)
+			trManager := test_trm.New()
+
+			tt.setup(projectRepo, constantRepo)
+
+			usecase := New(projectRepo, constantRepo, trManager)
+			err := usecase.Handle(ctx, tt.in)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUsecase_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+	trCtx := context.WithValue(ctx, test_trm.TrKey{}, struct{}{})
+
+	in := domain.ProjectConstantSaveIn{
+		ProjectID: 10,
+		AuthorID:  1,
+		Constants: []domain.Constant{{Name: "org_name", Value: "ООО Ромашка"}},
+	}
+
+	tests := []struct {
+		name  string
+		in    domain.ProjectConstantSaveIn
+		setup func(projectRepo *MockprojectRepository, constantRepo *MockconstantRepository)
+		want  string
+	}{
+		{
+			name:  "Validate",
+			in:    domain.ProjectConstantSaveIn{ProjectID: 10, AuthorID: 1, Constants: []domain.Constant{{Name: "inn"}, {Name: "inn"}}},
+			setup: func(projectRepo *MockprojectRepository, constantRepo *MockconstantRepository) {},
+			want:  "constants.1.name",
+		},
+		{
+			name: "projectRepo_GetByID",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, constantRepo *MockconstantRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, errors.New("test1"))
+			},
+			want: "test1",
+		},
+		{
+			name: "domain_ErrProjectNotFound",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, constantRepo *MockconstantRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, nil)
+			},
+			want: domain.ErrProjectNotFound.Error(),
+		},
+		{
+			name: "domain_ErrProjectInvalid",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, constantRepo *MockconstantRepository) {
+				project := domain.Project{AuthorID: 9, Users: []domain.ProjectUser{{ID: 1, Role: user_domain.RoleWrite}}}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+			},
+			want: domain.ErrProjectInvalid.Error(),
+		},
+		{
+			name: "constantRepo_DeleteByProjectID",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, constantRepo *MockconstantRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				constantRepo.EXPECT().DeleteByProjectID(trCtx, int64(10)).Return(errors.New("test2"))
+			},
+			want: "test2",
+		},
+		{
+			name: "constantRepo_Create",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, constantRepo *MockconstantRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				constantRepo.EXPECT().DeleteByProjectID(trCtx, int64(10)).Return(nil)
+				constantRepo.EXPECT().Create(trCtx, int64(10), int64(1), in.Constants).Return(errors.New("test3"))
+			},
+			want: "test3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			constantRepo := NewMockconstantRepository(ctrl)
+			trManager := test_trm.New()
+
+			tt.setup(projectRepo, constantRepo)
+
+			usecase := New(projectRepo, constantRepo, trManager)
+			err := usecase.Handle(ctx, tt.in)
+			require.ErrorContains(t, err, tt.want)
+		})
+	}
+}
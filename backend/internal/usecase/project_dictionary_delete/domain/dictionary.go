@@ -0,0 +1,5 @@
+package domain
+
+type Dictionary struct {
+	ProjectID int64
+}
@@ -0,0 +1,15 @@
+package project_dictionary_delete_usecase
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	dictionary_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/repository/dictionary"
+	project_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/repository/project"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/usecase"
+)
+
+func New(db *sqlx.DB) *usecase.Usecase {
+	dictionaryRepo := dictionary_repository.New(db)
+	projectRepo := project_repository.New(db)
+	return usecase.New(dictionaryRepo, projectRepo)
+}
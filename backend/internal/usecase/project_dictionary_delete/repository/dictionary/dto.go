@@ -0,0 +1,13 @@
+package dictionary_repository
+
+import "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/domain"
+
+type dictionary struct {
+	ProjectID int64 `db:"project_id"`
+}
+
+func (d dictionary) toDomain() *domain.Dictionary {
+	return &domain.Dictionary{
+		ProjectID: d.ProjectID,
+	}
+}
@@ -0,0 +1,75 @@
+package dictionary_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/domain"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Dictionary, error) {
+	op := "dictionary - get by id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("project_id").
+		From("project_dictionary").
+		Where(sq.Eq{"id": id, "deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dto dictionary
+	err = r.db.GetContext(ctx, &dto, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return dto.toDomain(), nil
+}
+
+// DeleteByID marks the dictionary deleted. Its versions are kept, so that
+// tasks created before render with the same dictionary.
+func (r *Repository) DeleteByID(ctx context.Context, id int64) error {
+	op := "dictionary - delete by id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("project_dictionary").
+		Set("deleted_at", sq.Expr("now() AT TIME ZONE 'utc'")).
+		Where(sq.Eq{"id": id})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return nil
+}
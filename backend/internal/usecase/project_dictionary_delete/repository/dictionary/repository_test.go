@@ -0,0 +1,106 @@
+package dictionary_repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_GetByID() {
+	ctx := context.Background()
+	repo := New(s.C().DB())
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// dictionaries
+	deletedAt := time.Now().UTC()
+	dictionaries := test_db.GenerateEntities(2, func(d *test_db.ProjectDictionary, i int) {
+		d.ProjectID = projectID
+		d.AuthorID = &userID
+		d.LastVersionID = nil
+		if i == 1 {
+			d.DeletedAt = &deletedAt
+		}
+	})
+	dictionaryIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_dictionary", dictionaries)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_dictionary", dictionaryIDs)) }()
+
+	s.T().Run("Exists", func(t *testing.T) {
+		got, err := repo.GetByID(ctx, dictionaryIDs[0])
+		require.NoError(t, err)
+		require.Equal(t, domain.Dictionary{ProjectID: projectID}, *got)
+	})
+
+	s.T().Run("Deleted", func(t *testing.T) {
+		got, err := repo.GetByID(ctx, dictionaryIDs[1])
+		require.NoError(t, err)
+		require.Nil(t, got)
+	})
+
+	s.T().Run("NotExists", func(t *testing.T) {
+		got, err := repo.GetByID(ctx, gofakeit.Int64())
+		require.NoError(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func (s *repositorySuite) TestRepository_DeleteByID() {
+	ctx := context.Background()
+	repo := New(s.C().DB())
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// dictionary
+	dictionary := test_db.GenerateEntity(func(d *test_db.ProjectDictionary) {
+		d.ProjectID = projectID
+		d.AuthorID = &userID
+		d.LastVersionID = nil
+	})
+	dictionaryID, err := test_db.InsertEntityWithID[int64](s.C(), "project_dictionary", dictionary)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_dictionary", dictionaryID)) }()
+
+	err = repo.DeleteByID(ctx, dictionaryID)
+	require.NoError(s.T(), err)
+
+	got, err := test_db.SelectEntitiesByID[test_db.ProjectDictionary](s.C(), "project_dictionary", []int64{dictionaryID})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), got, 1)
+	require.NotNil(s.T(), got[0].DeletedAt)
+}
@@ -0,0 +1,34 @@
+package project_repository
+
+import (
+	"github.com/samber/lo"
+
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/domain"
+)
+
+type project struct {
+	AuthorID int64   `db:"author_id"`
+	UserID   *int64  `db:"user_id"`
+	Role     *string `db:"role"`
+}
+
+type projects []project
+
+func (ps projects) toDomain() *domain.Project {
+	if len(ps) == 0 {
+		return nil
+	}
+
+	users := lo.FilterMap(ps, func(p project, _ int) (domain.ProjectUser, bool) {
+		if p.UserID == nil {
+			return domain.ProjectUser{}, false
+		}
+		return domain.ProjectUser{ID: *p.UserID, Role: user_domain.Role(*p.Role)}, true
+	})
+
+	return &domain.Project{
+		AuthorID: ps[0].AuthorID,
+		Users:    users,
+	}
+}
@@ -0,0 +1,18 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/domain"
+)
+
+type dictionaryRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Dictionary, error)
+	DeleteByID(ctx context.Context, id int64) error
+}
+
+type projectRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Project, error)
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/domain"
+)
+
+type Usecase struct {
+	dictionaryRepo dictionaryRepository
+	projectRepo    projectRepository
+}
+
+func New(dictionaryRepo dictionaryRepository, projectRepo projectRepository) *Usecase {
+	return &Usecase{
+		dictionaryRepo: dictionaryRepo,
+		projectRepo:    projectRepo,
+	}
+}
+
+func (u *Usecase) Handle(ctx context.Context, in domain.ProjectDictionaryDeleteIn) error {
+	// get dictionary
+	dictionary, err := u.dictionaryRepo.GetByID(ctx, in.DictionaryID)
+	if err != nil {
+		return fmt.Errorf("dictionary repo - get by id: %w", err)
+	}
+
+	if dictionary == nil {
+		return domain.ErrDictionaryNotFound
+	}
+
+	// check permission
+	project, err := u.projectRepo.GetByID(ctx, dictionary.ProjectID)
+	if err != nil {
+		return fmt.Errorf("project repo - get by id: %w", err)
+	}
+
+	if project == nil {
+		return domain.ErrDictionaryNotFound
+	}
+
+	isMaintainer := lo.SomeBy(project.Users, func(user domain.ProjectUser) bool {
+		return user.ID == in.UserID && user.Role == user_domain.RoleMaintain
+	})
+
+	if project.AuthorID != in.UserID && !isMaintainer {
+		return domain.ErrProjectInvalid
+	}
+
+	// delete dictionary
+	err = u.dictionaryRepo.DeleteByID(ctx, in.DictionaryID)
+	if err != nil {
+		return fmt.Errorf("dictionary repo - delete by id: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_delete/domain"
+)
+
+func TestUsecase_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		in      domain.ProjectDictionaryDeleteIn
+		project *domain.Project
+	}{
+		{
+			name:    "IsAuthor",
+			in:      domain.ProjectDictionaryDeleteIn{DictionaryID: 100, UserID: 1},
+			project: &domain.Project{AuthorID: 1},
+		},
+		{
+			name: "IsMaintainer",
+			in:   domain.ProjectDictionaryDeleteIn{DictionaryID: 100, UserID: 2},
+			project: &domain.Project{
+				AuthorID: 1,
+				Users:    []domain.ProjectUser{{ID: 2, Role: user_domain.RoleMaintain}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dictionaryRepo := NewMockdictionaryRepository(ctrl)
+			dictionaryRepo.EXPECT().GetByID(ctx, int64(100)).Return(&domain.Dictionary{ProjectID: 10}, nil)
+			dictionaryRepo.EXPECT().DeleteByID(ctx, int64(100)).Return(nil)
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(tt.project, nil)
+
+			usecase := New(dictionaryRepo, projectRepo)
+			err := usecase.Handle(ctx, tt.in)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUsecase_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+	in := domain.ProjectDictionaryDeleteIn{DictionaryID: 100, UserID: 1}
+	dictionary := &domain.Dictionary{ProjectID: 10}
+
+	tests := []struct {
+		name  string
+		setup func(dictionaryRepo *MockdictionaryRepository, projectRepo *MockprojectRepository)
+		want  string
+	}{
+		{
+			name: "dictionaryRepo_GetByID",
+			setup: func(dictionaryRepo *MockdictionaryRepository, _ *MockprojectRepository) {
+				dictionaryRepo.EXPECT().GetByID(ctx, int64(100)).Return(nil, errors.New("test1"))
+			},
+			want: "test1",
+		},
+		{
+			name: "domain_ErrDictionaryNotFound",
+			setup: func(dictionaryRepo *MockdictionaryRepository, _ *MockprojectRepository) {
+				dictionaryRepo.EXPECT().GetByID(ctx, int64(100)).Return(nil, nil)
+			},
+			want: domain.ErrDictionaryNotFound.Error(),
+		},
+		{
+			name: "projectRepo_GetByID",
+			setup: func(dictionaryRepo *MockdictionaryRepository, projectRepo *MockprojectRepository) {
+				dictionaryRepo.EXPECT().GetByID(ctx, int64(100)).Return(dictionary, nil)
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, errors.New("test2"))
+			},
+			want: "test2",
+		},
+		{
+			name: "domain_ErrProjectInvalid_NonMaintainRole",
+			setup: func(dictionaryRepo *MockdictionaryRepository, projectRepo *MockprojectRepository) {
+				dictionaryRepo.EXPECT().GetByID(ctx, int64(100)).Return(dictionary, nil)
+				project := domain.Project{
+					AuthorID: 2,
+					Users:    []domain.ProjectUser{{ID: 1, Role: user_domain.RoleWrite}},
+				}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+			},
+			want: domain.ErrProjectInvalid.Error(),
+		},
+		{
+			name: "dictionaryRepo_DeleteByID",
+			setup: func(dictionaryRepo *MockdictionaryRepository, projectRepo *MockprojectRepository) {
+				dictionaryRepo.EXPECT().GetByID(ctx, int64(100)).Return(dictionary, nil)
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				dictionaryRepo.EXPECT().DeleteByID(ctx, int64(100)).Return(errors.New("test3"))
+			},
+			want: "test3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dictionaryRepo := NewMockdictionaryRepository(ctrl)
+			projectRepo := NewMockprojectRepository(ctrl)
+			tt.setup(dictionaryRepo, projectRepo)
+
+			usecase := New(dictionaryRepo, projectRepo)
+			err := usecase.Handle(ctx, in)
+			require.ErrorContains(t, err, tt.want)
+		})
+	}
+}
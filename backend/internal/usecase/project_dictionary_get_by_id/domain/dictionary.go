@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"time"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+)
+
+// Dictionary is the latest version of a project dictionary.
+type Dictionary struct {
+	ID        int64
+	ProjectID int64
+	Name      string
+	Columns   []dictionary_domain.Column
+	Rows      []dictionary_domain.Row
+	Number    int64
+	UpdatedAt time.Time
+}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+)
+
+var (
+	ErrDictionaryNotFound = error_domain.NewBaseError("dictionary not found")
+	ErrProjectInvalid     = error_domain.NewBaseError("project is invalid")
+)
+
+type ProjectDictionaryGetByIDIn struct {
+	DictionaryID int64
+	UserID       int64
+}
@@ -0,0 +1,74 @@
+package dictionary_repository
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_get_by_id/domain"
+)
+
+type dictionary struct {
+	ID        int64     `db:"id"`
+	ProjectID int64     `db:"project_id"`
+	Name      string    `db:"name"`
+	Columns   columns   `db:"columns"`
+	Rows      rows      `db:"rows"`
+	Number    int64     `db:"number"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (d dictionary) toDomain() *domain.Dictionary {
+	return &domain.Dictionary{
+		ID:        d.ID,
+		ProjectID: d.ProjectID,
+		Name:      d.Name,
+		Columns: lo.Map(d.Columns, func(c column, _ int) dictionary_domain.Column {
+			return dictionary_domain.Column{Name: c.Name, Type: c.Type}
+		}),
+		Rows: lo.Map(d.Rows, func(r row, _ int) dictionary_domain.Row {
+			return dictionary_domain.Row{Key: r.Key, Values: r.Values}
+		}),
+		Number:    d.Number,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+type column struct {
+	Name string               `json:"name"`
+	Type variable_domain.Type `json:"type"`
+}
+
+type columns []column
+
+func (c *columns) Scan(value any) error {
+	return scanJSON(value, c)
+}
+
+type row struct {
+	Key    string            `json:"key"`
+	Values map[string]string `json:"values"`
+}
+
+type rows []row
+
+func (r *rows) Scan(value any) error {
+	return scanJSON(value, r)
+}
+
+func scanJSON(value any, dest any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, dest)
+}
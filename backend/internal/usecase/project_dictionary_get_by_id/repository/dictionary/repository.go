@@ -0,0 +1,59 @@
+package dictionary_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_get_by_id/domain"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Dictionary, error) {
+	op := "dictionary - get by id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"d.id",
+			"d.project_id",
+			"d.name",
+			"dv.columns",
+			"dv.rows",
+			"dv.number",
+			"COALESCE(d.updated_at, d.created_at) AS updated_at",
+		).
+		From("project_dictionary d").
+		Join("project_dictionary_version dv ON d.last_version_id = dv.id").
+		Where(sq.Eq{"d.id": id, "d.deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dto dictionary
+	err = r.db.GetContext(ctx, &dto, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return dto.toDomain(), nil
+}
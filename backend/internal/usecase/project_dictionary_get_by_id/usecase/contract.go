@@ -0,0 +1,17 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_get_by_id/domain"
+)
+
+type dictionaryRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Dictionary, error)
+}
+
+type projectRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Project, error)
+}
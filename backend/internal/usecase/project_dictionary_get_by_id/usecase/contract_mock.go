@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -package usecase -source contract.go -destination contract_mock.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_get_by_id/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockdictionaryRepository is a mock of dictionaryRepository interface.
+type MockdictionaryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockdictionaryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockdictionaryRepositoryMockRecorder is the mock recorder for MockdictionaryRepository.
+type MockdictionaryRepositoryMockRecorder struct {
+	mock *MockdictionaryRepository
+}
+
+// NewMockdictionaryRepository creates a new mock instance.
+func NewMockdictionaryRepository(ctrl *gomock.Controller) *MockdictionaryRepository {
+	mock := &MockdictionaryRepository{ctrl: ctrl}
+	mock.recorder = &MockdictionaryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdictionaryRepository) EXPECT() *MockdictionaryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockdictionaryRepository) GetByID(ctx context.Context, id int64) (*domain.Dictionary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Dictionary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockdictionaryRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockdictionaryRepository)(nil).GetByID), ctx, id)
+}
+
+// MockprojectRepository is a mock of projectRepository interface.
+type MockprojectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockprojectRepositoryMockRecorder is the mock recorder for MockprojectRepository.
+type MockprojectRepositoryMockRecorder struct {
+	mock *MockprojectRepository
+}
+
+// NewMockprojectRepository creates a new mock instance.
+func NewMockprojectRepository(ctrl *gomock.Controller) *MockprojectRepository {
+	mock := &MockprojectRepository{ctrl: ctrl}
+	mock.recorder = &MockprojectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectRepository) EXPECT() *MockprojectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockprojectRepository) GetByID(ctx context.Context, id int64) (*domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockprojectRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockprojectRepository)(nil).GetByID), ctx, id)
+}
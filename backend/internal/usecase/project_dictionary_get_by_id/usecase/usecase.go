@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_get_by_id/domain"
+)
+
+type Usecase struct {
+	dictionaryRepo dictionaryRepository
+	projectRepo    projectRepository
+}
+
+func New(dictionaryRepo dictionaryRepository, projectRepo projectRepository) *Usecase {
+	return &Usecase{
+		dictionaryRepo: dictionaryRepo,
+		projectRepo:    projectRepo,
+	}
+}
+
+func (u *Usecase) Handle(ctx context.Context, in domain.ProjectDictionaryGetByIDIn) (*domain.Dictionary, error) {
+	// get dictionary
+	dictionary, err := u.dictionaryRepo.GetByID(ctx, in.DictionaryID)
+	if err != nil {
+		return nil, fmt.Errorf("dictionary repo - get by id: %w", err)
+	}
+
+	if dictionary == nil {
+		return nil, domain.ErrDictionaryNotFound
+	}
+
+	// check permission
+	project, err := u.projectRepo.GetByID(ctx, dictionary.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("project repo - get by id: %w", err)
+	}
+
+	if project == nil {
+		return nil, domain.ErrDictionaryNotFound
+	}
+
+	isMember := lo.SomeBy(project.Users, func(user domain.ProjectUser) bool { return user.ID == in.UserID })
+
+	if project.AuthorID != in.UserID && !isMember {
+		return nil, domain.ErrProjectInvalid
+	}
+
+	return dictionary, nil
+}
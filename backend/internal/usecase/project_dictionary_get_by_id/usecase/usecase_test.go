@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_get_by_id/domain"
+)
+
+func TestUsecase_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+
+	want := domain.Dictionary{
+		ID:        100,
+		ProjectID: 10,
+		Name:      "materials",
+		Columns:   []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}},
+		Rows:      []dictionary_domain.Row{{Key: "steel", Values: map[string]string{"density": "7850"}}},
+		Number:    2,
+		UpdatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name    string
+		in      domain.ProjectDictionaryGetByIDIn
+		project *domain.Project
+	}{
+		{
+			name:    "IsAuthor",
+			in:      domain.ProjectDictionaryGetByIDIn{DictionaryID: 100, UserID: 1},
+			project: &domain.Project{AuthorID: 1},
+		},
+		{
+			name: "IsReader",
+			in:   domain.ProjectDictionaryGetByIDIn{DictionaryID: 100, UserID: 2},
+			project: &domain.Project{
+				AuthorID: 1,
+				Users:    []domain.ProjectUser{{ID: 2, Role: user_domain.RoleRead}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dictionaryRepo := NewMockdictionaryRepository(ctrl)
+			dictionaryRepo.EXPECT().GetByID(ctx, int64(100)).Return(&want, nil)
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(tt.project, nil)
+
+			usecase := New(dictionaryRepo, projectRepo)
+			got, err := usecase.Handle(ctx, tt.in)
+			require.NoError(t, err)
+			require.Equal(t, want, *got)
+		})
+	}
+}
+
+func TestUsecase_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+	in := domain.ProjectDictionaryGetByIDIn{DictionaryID: 100, UserID: 1}
+	dictionary := &domain.Dictionary{ID: 100, ProjectID: 10}
+
+	tests := []struct {
+		name  string
+		setup func(dictionaryRepo *MockdictionaryRepository, projectRepo *MockprojectRepository)
+		want  string
+	}{
+		{
+			name: "dictionaryRepo_GetByID",
+			setup: func(dictionaryRepo *MockdictionaryRepository, _ *MockprojectRepository) {
+				dictionaryRepo.EXPECT().GetByID(ctx, int64(100)).Return(nil, errors.New("test1"))
+			},
+			want: "test1",
+		},
+		{
+			name: "domain_ErrDictionaryNotFound",
+			setup: func(dictionaryRepo *MockdictionaryRepository, _ *MockprojectRepository) {
+				dictionaryRepo.EXPECT().GetByID(ctx, int64(100)).Return(nil, nil)
+			},
+			want: domain.ErrDictionaryNotFound.Error(),
+		},
+		{
+			name: "projectRepo_GetByID",
+			setup: func(dictionaryRepo *MockdictionaryRepository, projectRepo *MockprojectRepository) {
+				dictionaryRepo.EXPECT().GetByID(ctx, int64(100)).Return(dictionary, nil)
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, errors.New("test2"))
+			},
+			want: "test2",
+		},
+		{
+			name: "domain_ErrProjectInvalid",
+			setup: func(dictionaryRepo *MockdictionaryRepository, projectRepo *MockprojectRepository) {
+				dictionaryRepo.EXPECT().GetByID(ctx, int64(100)).Return(dictionary, nil)
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 9}, nil)
+			},
+			want: domain.ErrProjectInvalid.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dictionaryRepo := NewMockdictionaryRepository(ctrl)
+			projectRepo := NewMockprojectRepository(ctrl)
+			tt.setup(dictionaryRepo, projectRepo)
+
+			usecase := New(dictionaryRepo, projectRepo)
+			_, err := usecase.Handle(ctx, in)
+			require.ErrorContains(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/samber/lo"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	dictionary_save_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+)
+
+var (
+	ErrValueEmpty   = errors.New("value is empty")
+	ErrValueInvalid = errors.New("value is invalid")
+)
+
+type ProjectDictionaryImportIn struct {
+	ProjectID int64
+	AuthorID  int64
+	Name      string
+	Data      string
+}
+
+// Parse reads the CSV data into a dictionary. The first header cell names the
+// key column, the others are "name" or "name:type". A column without a type
+// keeps its type from the existing columns or becomes a string one. Cells are
+// separated by commas or semicolons, numbers may use a decimal comma.
+func (in ProjectDictionaryImportIn) Parse(existing []dictionary_domain.Column) (dictionary_save_domain.DictionarySaveIn, error) {
+	out := dictionary_save_domain.DictionarySaveIn{
+		ProjectID: in.ProjectID,
+		AuthorID:  in.AuthorID,
+		Name:      in.Name,
+	}
+
+	data := strings.TrimPrefix(in.Data, "\ufeff")
+	if strings.TrimSpace(data) == "" {
+		return out, error_domain.NewValidationError("data", ErrValueEmpty)
+	}
+
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comma = delimiter(data)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return out, error_domain.NewValidationError("data", fmt.Errorf("%w: %w", ErrValueInvalid, err))
+	}
+
+	out.Columns = lo.Map(header[1:], func(cell string, _ int) dictionary_domain.Column {
+		return parseColumn(cell, existing)
+	})
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return out, error_domain.NewValidationError("data", fmt.Errorf("%w: %w", ErrValueInvalid, err))
+		}
+
+		row := dictionary_domain.Row{
+			Key:    strings.TrimSpace(record[0]),
+			Values: make(map[string]string, len(out.Columns)),
+		}
+		for i, c := range out.Columns {
+			row.Values[c.Name] = parseValue(record[i+1], c.Type)
+		}
+
+		out.Rows = append(out.Rows, row)
+	}
+
+	return out, nil
+}
+
+// delimiter guesses the delimiter by the header: spreadsheets in the Russian
+// locale export CSV with semicolons.
+func delimiter(data string) rune {
+	header, _, _ := strings.Cut(data, "\n")
+	if strings.Count(header, ";") > strings.Count(header, ",") {
+		return ';'
+	}
+	return ','
+}
+
+func parseColumn(cell string, existing []dictionary_domain.Column) dictionary_domain.Column {
+	name, typ, typed := strings.Cut(strings.TrimSpace(cell), ":")
+	name = strings.TrimSpace(name)
+
+	if typed {
+		return dictionary_domain.Column{Name: name, Type: variable_domain.Type(strings.TrimSpace(typ))}
+	}
+
+	if c, ok := lo.Find(existing, func(c dictionary_domain.Column) bool { return c.Name == name }); ok {
+		return c
+	}
+
+	return dictionary_domain.Column{Name: name, Type: variable_domain.TypeString}
+}
+
+func parseValue(cell string, typ variable_domain.Type) string {
+	value := strings.TrimSpace(cell)
+	if typ == variable_domain.TypeFloat || typ == variable_domain.TypeDecimal {
+		value = strings.ReplaceAll(value, ",", ".")
+	}
+	return value
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+)
+
+func TestProjectDictionaryImportIn_Parse(t *testing.T) {
+	existing := []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeDecimal}}
+
+	tests := []struct {
+		name        string
+		data        string
+		wantColumns []dictionary_domain.Column
+		wantRows    []dictionary_domain.Row
+	}{
+		{
+			name: "Comma",
+			data: "code,title,density:float\nsteel,Сталь,7850.5\ncopper,\"Медь, М1\",8960\n",
+			wantColumns: []dictionary_domain.Column{
+				{Name: "title", Type: variable_domain.TypeString},
+				{Name: "density", Type: variable_domain.TypeFloat},
+			},
+			wantRows: []dictionary_domain.Row{
+				{Key: "steel", Values: map[string]string{"title": "Сталь", "density": "7850.5"}},
+				{Key: "copper", Values: map[string]string{"title": "Медь, М1", "density": "8960"}},
+			},
+		},
+		{
+			name: "SemicolonExistingType",
+			data: "\ufeffкод; density; grade:integer\r\nsteel; 7850,5; 3\r\n",
+			wantColumns: []dictionary_domain.Column{
+				{Name: "density", Type: variable_domain.TypeDecimal},
+				{Name: "grade", Type: variable_domain.TypeInteger},
+			},
+			wantRows: []dictionary_domain.Row{
+				{Key: "steel", Values: map[string]string{"density": "7850.5", "grade": "3"}},
+			},
+		},
+		{
+			name:        "HeaderOnly",
+			data:        "key",
+			wantColumns: []dictionary_domain.Column{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := ProjectDictionaryImportIn{ProjectID: 10, AuthorID: 1, Name: "materials", Data: tt.data}
+
+			got, err := in.Parse(existing)
+			require.NoError(t, err)
+			require.Equal(t, int64(10), got.ProjectID)
+			require.Equal(t, int64(1), got.AuthorID)
+			require.Equal(t, "materials", got.Name)
+			require.Equal(t, tt.wantColumns, got.Columns)
+			require.Equal(t, tt.wantRows, got.Rows)
+		})
+	}
+}
+
+func TestProjectDictionaryImportIn_Parse_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want error
+	}{
+		{name: "Empty", data: " \n", want: ErrValueEmpty},
+		{name: "FieldCount", data: "key,density\nsteel\n", want: ErrValueInvalid},
+		{name: "Quote", data: "key,title\nsteel,\"Сталь\n", want: ErrValueInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := ProjectDictionaryImportIn{ProjectID: 10, AuthorID: 1, Name: "materials", Data: tt.data}
+
+			_, err := in.Parse(nil)
+			require.ErrorIs(t, err, tt.want)
+
+			var validationErr *error_domain.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+)
+
+var (
+	ErrProjectNotFound = error_domain.NewBaseError("project not found")
+	ErrProjectInvalid  = error_domain.NewBaseError("project is invalid")
+)
+
+type Project struct {
+	AuthorID int64
+	Users    []ProjectUser
+}
+
+type ProjectUser struct {
+	ID   int64
+	Role user_domain.Role
+}
@@ -0,0 +1,17 @@
+package project_dictionary_import_usecase
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	dictionary_save_service "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save"
+	dictionary_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_import/repository/dictionary"
+	project_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_import/repository/project"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_import/usecase"
+)
+
+func New(db *sqlx.DB) *usecase.Usecase {
+	projectRepo := project_repository.New(db)
+	dictionaryRepo := dictionary_repository.New(db)
+	dictionarySaveService := dictionary_save_service.New(db)
+	return usecase.New(projectRepo, dictionaryRepo, dictionarySaveService)
+}
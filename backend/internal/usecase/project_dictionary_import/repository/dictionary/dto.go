@@ -0,0 +1,37 @@
+package dictionary_repository
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/samber/lo"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+)
+
+type column struct {
+	Name string               `json:"name"`
+	Type variable_domain.Type `json:"type"`
+}
+
+type columns []column
+
+func (c *columns) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, c)
+}
+
+func (c columns) toDomain() []dictionary_domain.Column {
+	return lo.Map(c, func(c column, _ int) dictionary_domain.Column {
+		return dictionary_domain.Column{Name: c.Name, Type: c.Type}
+	})
+}
@@ -0,0 +1,53 @@
+package dictionary_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// GetColumnsByName returns the columns of the latest version of the named
+// dictionary, nil if there is no such dictionary.
+func (r *Repository) GetColumnsByName(ctx context.Context, projectID int64, name string) ([]dictionary_domain.Column, error) {
+	op := "dictionary - get columns by name"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("dv.columns").
+		From("project_dictionary d").
+		Join("project_dictionary_version dv ON d.last_version_id = dv.id").
+		Where(sq.Eq{"d.project_id": projectID, "d.name": name, "d.deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dto columns
+	err = r.db.GetContext(ctx, &dto, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return dto.toDomain(), nil
+}
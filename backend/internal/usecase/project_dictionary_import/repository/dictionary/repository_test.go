@@ -0,0 +1,74 @@
+package dictionary_repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_GetColumnsByName() {
+	ctx := context.Background()
+	repo := New(s.C().DB())
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// dictionary
+	dictionary := test_db.GenerateEntity(func(d *test_db.ProjectDictionary) {
+		d.Name = "materials"
+		d.ProjectID = projectID
+		d.AuthorID = &userID
+		d.LastVersionID = nil
+	})
+	dictionaryID, err := test_db.InsertEntityWithID[int64](s.C(), "project_dictionary", dictionary)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_dictionary", dictionaryID)) }()
+
+	// dictionary version
+	version := test_db.GenerateEntity(func(v *test_db.ProjectDictionaryVersion) {
+		v.Number = 1
+		v.DictionaryID = dictionaryID
+		v.AuthorID = &userID
+		v.Columns = []byte(`[{"name": "density", "type": "float"}]`)
+		v.Rows = []byte(`[]`)
+	})
+	versionID, err := test_db.InsertEntityWithID[int64](s.C(), "project_dictionary_version", version)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_dictionary_version", versionID))
+	}()
+
+	_, err = s.C().DB().Exec("UPDATE project_dictionary SET last_version_id = $1 WHERE id = $2", versionID, dictionaryID)
+	require.NoError(s.T(), err)
+
+	got, err := repo.GetColumnsByName(ctx, projectID, "materials")
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}}, got)
+
+	got, err = repo.GetColumnsByName(ctx, projectID, "standards")
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), got)
+}
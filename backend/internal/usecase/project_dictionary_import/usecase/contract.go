@@ -0,0 +1,23 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package usecase
+
+import (
+	"context"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	dictionary_save_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_import/domain"
+)
+
+type projectRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Project, error)
+}
+
+type dictionaryRepository interface {
+	GetColumnsByName(ctx context.Context, projectID int64, name string) ([]dictionary_domain.Column, error)
+}
+
+type dictionarySaveService interface {
+	Handle(ctx context.Context, in dictionary_save_domain.DictionarySaveIn) (*dictionary_save_domain.DictionarySaveOut, error)
+}
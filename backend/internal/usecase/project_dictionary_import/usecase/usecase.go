@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	dictionary_save_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_import/domain"
+)
+
+type Usecase struct {
+	projectRepo           projectRepository
+	dictionaryRepo        dictionaryRepository
+	dictionarySaveService dictionarySaveService
+}
+
+func New(projectRepo projectRepository, dictionaryRepo dictionaryRepository, dictionarySaveService dictionarySaveService) *Usecase {
+	return &Usecase{
+		projectRepo:           projectRepo,
+		dictionaryRepo:        dictionaryRepo,
+		dictionarySaveService: dictionarySaveService,
+	}
+}
+
+func (u *Usecase) Handle(ctx context.Context, in domain.ProjectDictionaryImportIn) (*dictionary_save_domain.DictionarySaveOut, error) {
+	// get project
+	project, err := u.projectRepo.GetByID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("project repo - get by id: %w", err)
+	}
+
+	if project == nil {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	// check permission
+	isMaintainer := lo.SomeBy(project.Users, func(user domain.ProjectUser) bool {
+		return user.ID == in.AuthorID && user.Role == user_domain.RoleMaintain
+	})
+
+	if project.AuthorID != in.AuthorID && !isMaintainer {
+		return nil, domain.ErrProjectInvalid
+	}
+
+	// parse data
+	columns, err := u.dictionaryRepo.GetColumnsByName(ctx, in.ProjectID, in.Name)
+	if err != nil {
+		return nil, fmt.Errorf("dictionary repo - get columns by name: %w", err)
+	}
+
+	dictionary, err := in.Parse(columns)
+	if err != nil {
+		return nil, err
+	}
+
+	// save dictionary
+	out, err := u.dictionarySaveService.Handle(ctx, dictionary)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	dictionary_save_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_import/domain"
+)
+
+func TestUsecase_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+
+	in := domain.ProjectDictionaryImportIn{
+		ProjectID: 10,
+		AuthorID:  1,
+		Name:      "materials",
+		Data:      "key;density\nsteel;7850,5\n",
+	}
+
+	existing := []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}}
+
+	saveIn := dictionary_save_domain.DictionarySaveIn{
+		ProjectID: 10,
+		AuthorID:  1,
+		Name:      "materials",
+		Columns:   existing,
+		Rows:      []dictionary_domain.Row{{Key: "steel", Values: map[string]string{"density": "7850.5"}}},
+	}
+
+	out := dictionary_save_domain.DictionarySaveOut{ID: 100, Number: 2}
+
+	tests := []struct {
+		name  string
+		setup func(projectRepo *MockprojectRepository, dictionaryRepo *MockdictionaryRepository, dictionarySaveService *MockdictionarySaveService)
+	}{
+		{
+			name: "IsAuthor",
+			setup: func(projectRepo *MockprojectRepository, dictionaryRepo *MockdictionaryRepository, dictionarySaveService *MockdictionarySaveService) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				dictionaryRepo.EXPECT().GetColumnsByName(ctx, int64(10), "materials").Return(existing, nil)
+				dictionarySaveService.EXPECT().Handle(ctx, saveIn).Return(&out, nil)
+			},
+		},
+		{
+			name: "IsMaintainer",
+			setup: func(projectRepo *MockprojectRepository, dictionaryRepo *MockdictionaryRepository, dictionarySaveService *MockdictionarySaveService) {
+				project := domain.Project{
+					AuthorID: 2,
+					Users:    []domain.ProjectUser{{ID: 1, Role: user_domain.RoleMaintain}},
+				}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+				dictionaryRepo.EXPECT().GetColumnsByName(ctx, int64(10), "materials").Return(existing, nil)
+				dictionarySaveService.EXPECT().Handle(ctx, saveIn).Return(&out, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			dictionaryRepo := NewMockdictionaryRepository(ctrl)
+			dictionarySaveService := NewMockdictionarySaveService(ctrl)
+
+			tt.setup(projectRepo, dictionaryRepo, dictionarySaveService)
+
+			usecase := New(projectRepo, dictionaryRepo, dictionarySaveService)
+			got, err := usecase.Handle(ctx, in)
+			require.NoError(t, err)
+			require.Equal(t, out, *got)
+		})
+	}
+}
+
+func TestUsecase_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+
+	in := domain.ProjectDictionaryImportIn{ProjectID: 10, AuthorID: 1, Name: "materials", Data: "key\nsteel\n"}
+
+	tests := []struct {
+		name  string
+		in    domain.ProjectDictionaryImportIn
+		setup func(projectRepo *MockprojectRepository, dictionaryRepo *MockdictionaryRepository, dictionarySaveService *MockdictionarySaveService)
+		want  string
+	}{
+		{
+			name: "projectRepo_GetByID",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, _ *MockdictionaryRepository, _ *MockdictionarySaveService) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, errors.New("test1"))
+			},
+			want: "test1",
+		},
+		{
+			name: "domain_ErrProjectNotFound",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, _ *MockdictionaryRepository, _ *MockdictionarySaveService) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, nil)
+			},
+			want: domain.ErrProjectNotFound.Error(),
+		},
+		{
+			name: "domain_ErrProjectInvalid_NonMaintainRole",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, _ *MockdictionaryRepository, _ *MockdictionarySaveService) {
+				project := domain.Project{
+					AuthorID: 2,
+					Users:    []domain.ProjectUser{{ID: 1, Role: user_domain.RoleRead}},
+				}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+			},
+			want: domain.ErrProjectInvalid.Error(),
+		},
+		{
+			name: "dictionaryRepo_GetColumnsByName",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, dictionaryRepo *MockdictionaryRepository, _ *MockdictionarySaveService) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				dictionaryRepo.EXPECT().GetColumnsByName(ctx, int64(10), "materials").Return(nil, errors.New("test2"))
+			},
+			want: "test2",
+		},
+		{
+			name: "domain_ErrValueEmpty",
+			in:   domain.ProjectDictionaryImportIn{ProjectID: 10, AuthorID: 1, Name: "materials"},
+			setup: func(projectRepo *MockprojectRepository, dictionaryRepo *MockdictionaryRepository, _ *MockdictionarySaveService) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				dictionaryRepo.EXPECT().GetColumnsByName(ctx, int64(10), "materials").Return(nil, nil)
+			},
+			want: domain.ErrValueEmpty.Error(),
+		},
+		{
+			name: "dictionarySaveService_Handle",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, dictionaryRepo *MockdictionaryRepository, dictionarySaveService *MockdictionarySaveService) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				dictionaryRepo.EXPECT().GetColumnsByName(ctx, int64(10), "materials").Return(nil, nil)
+				dictionarySaveService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("test3"))
+			},
+			want: "test3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			dictionaryRepo := NewMockdictionaryRepository(ctrl)
+			dictionarySaveService := NewMockdictionarySaveService(ctrl)
+
+			tt.setup(projectRepo, dictionaryRepo, dictionarySaveService)
+
+			usecase := New(projectRepo, dictionaryRepo, dictionarySaveService)
+			_, err := usecase.Handle(ctx, tt.in)
+			require.ErrorContains(t, err, tt.want)
+		})
+	}
+}
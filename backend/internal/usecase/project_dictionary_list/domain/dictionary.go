@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"time"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+)
+
+// Dictionary is the latest version of a project dictionary without its rows.
+type Dictionary struct {
+	ID        int64
+	Name      string
+	Columns   []dictionary_domain.Column
+	RowCount  int64
+	Number    int64
+	UpdatedAt time.Time
+}
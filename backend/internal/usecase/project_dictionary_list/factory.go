@@ -0,0 +1,15 @@
+package project_dictionary_list_usecase
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	dictionary_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_list/repository/dictionary"
+	project_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_list/repository/project"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_list/usecase"
+)
+
+func New(db *sqlx.DB) *usecase.Usecase {
+	projectRepo := project_repository.New(db)
+	dictionaryRepo := dictionary_repository.New(db)
+	return usecase.New(projectRepo, dictionaryRepo)
+}
@@ -0,0 +1,55 @@
+package dictionary_repository
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_list/domain"
+)
+
+type dictionary struct {
+	ID        int64     `db:"id"`
+	Name      string    `db:"name"`
+	Columns   columns   `db:"columns"`
+	RowCount  int64     `db:"row_count"`
+	Number    int64     `db:"number"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (d dictionary) toDomain() domain.Dictionary {
+	return domain.Dictionary{
+		ID:   d.ID,
+		Name: d.Name,
+		Columns: lo.Map(d.Columns, func(c column, _ int) dictionary_domain.Column {
+			return dictionary_domain.Column{Name: c.Name, Type: c.Type}
+		}),
+		RowCount:  d.RowCount,
+		Number:    d.Number,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+type column struct {
+	Name string               `json:"name"`
+	Type variable_domain.Type `json:"type"`
+}
+
+type columns []column
+
+func (c *columns) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, c)
+}
@@ -0,0 +1,55 @@
+package dictionary_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_list/domain"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+func (r *Repository) ListByProjectID(ctx context.Context, projectID int64) ([]domain.Dictionary, error) {
+	op := "dictionary - list by project id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"d.id",
+			"d.name",
+			"dv.columns",
+			"jsonb_array_length(dv.rows) AS row_count",
+			"dv.number",
+			"COALESCE(d.updated_at, d.created_at) AS updated_at",
+		).
+		From("project_dictionary d").
+		Join("project_dictionary_version dv ON d.last_version_id = dv.id").
+		Where(sq.Eq{"d.project_id": projectID, "d.deleted_at": nil}).
+		OrderBy("d.name ASC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dtos []dictionary
+	err = r.db.SelectContext(ctx, &dtos, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return lo.Map(dtos, func(d dictionary, _ int) domain.Dictionary { return d.toDomain() }), nil
+}
@@ -0,0 +1,90 @@
+package dictionary_repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_list/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_ListByProjectID() {
+	ctx := context.Background()
+	repo := New(s.C().DB())
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// dictionaries
+	deletedAt := time.Now().UTC()
+	dictionaries := test_db.GenerateEntities(2, func(d *test_db.ProjectDictionary, i int) {
+		d.ProjectID = projectID
+		d.AuthorID = &userID
+		d.LastVersionID = nil
+		d.UpdatedAt = nil
+		if i == 1 {
+			d.DeletedAt = &deletedAt
+		}
+	})
+	dictionaryIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_dictionary", dictionaries)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_dictionary", dictionaryIDs)) }()
+
+	// dictionary versions
+	versions := test_db.GenerateEntities(2, func(v *test_db.ProjectDictionaryVersion, i int) {
+		v.Number = 1
+		v.DictionaryID = dictionaryIDs[i]
+		v.AuthorID = &userID
+		v.Columns = []byte(`[{"name": "density", "type": "float"}]`)
+		v.Rows = []byte(`[{"key": "steel", "values": {"density": "7850"}}, {"key": "copper", "values": {"density": "8960"}}]`)
+	})
+	versionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_dictionary_version", versions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_dictionary_version", versionIDs))
+	}()
+
+	for i := range dictionaryIDs {
+		_, err = s.C().DB().Exec("UPDATE project_dictionary SET last_version_id = $1 WHERE id = $2", versionIDs[i], dictionaryIDs[i])
+		require.NoError(s.T(), err)
+	}
+
+	got, err := repo.ListByProjectID(ctx, projectID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), got, 1)
+
+	want := []domain.Dictionary{
+		{
+			ID:        dictionaryIDs[0],
+			Name:      dictionaries[0].Name,
+			Columns:   []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}},
+			RowCount:  2,
+			Number:    1,
+			UpdatedAt: got[0].UpdatedAt,
+		},
+	}
+	require.Equal(s.T(), want, got)
+}
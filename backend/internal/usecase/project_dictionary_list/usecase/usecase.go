@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_list/domain"
+)
+
+type Usecase struct {
+	projectRepo    projectRepository
+	dictionaryRepo dictionaryRepository
+}
+
+func New(projectRepo projectRepository, dictionaryRepo dictionaryRepository) *Usecase {
+	return &Usecase{
+		projectRepo:    projectRepo,
+		dictionaryRepo: dictionaryRepo,
+	}
+}
+
+func (u *Usecase) Handle(ctx context.Context, in domain.ProjectDictionaryListIn) ([]domain.Dictionary, error) {
+	project, err := u.projectRepo.GetByID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("project repo - get by id: %w", err)
+	}
+
+	if project == nil {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	isMember := lo.SomeBy(project.Users, func(user domain.ProjectUser) bool { return user.ID == in.UserID })
+
+	if project.AuthorID != in.UserID && !isMember {
+		return nil, domain.ErrProjectInvalid
+	}
+
+	dictionaries, err := u.dictionaryRepo.ListByProjectID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("dictionary repo - list by project id: %w", err)
+	}
+
+	return dictionaries, nil
+}
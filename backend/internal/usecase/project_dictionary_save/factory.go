@@ -0,0 +1,15 @@
+package project_dictionary_save_usecase
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	dictionary_save_service "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save"
+	project_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_save/repository/project"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_save/usecase"
+)
+
+func New(db *sqlx.DB) *usecase.Usecase {
+	projectRepo := project_repository.New(db)
+	dictionarySaveService := dictionary_save_service.New(db)
+	return usecase.New(projectRepo, dictionarySaveService)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -package usecase -source contract.go -destination contract_mock.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+	domain0 "github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_save/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockprojectRepository is a mock of projectRepository interface.
+type MockprojectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockprojectRepositoryMockRecorder is the mock recorder for MockprojectRepository.
+type MockprojectRepositoryMockRecorder struct {
+	mock *MockprojectRepository
+}
+
+// NewMockprojectRepository creates a new mock instance.
+func NewMockprojectRepository(ctrl *gomock.Controller) *MockprojectRepository {
+	mock := &MockprojectRepository{ctrl: ctrl}
+	mock.recorder = &MockprojectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectRepository) EXPECT() *MockprojectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockprojectRepository) GetByID(ctx context.Context, id int64) (*domain0.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain0.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockprojectRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockprojectRepository)(nil).GetByID), ctx, id)
+}
+
+// MockdictionarySaveService is a mock of dictionarySaveService interface.
+type MockdictionarySaveService struct {
+	ctrl     *gomock.Controller
+	recorder *MockdictionarySaveServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockdictionarySaveServiceMockRecorder is the mock recorder for MockdictionarySaveService.
+type MockdictionarySaveServiceMockRecorder struct {
+	mock *MockdictionarySaveService
+}
+
+// NewMockdictionarySaveService creates a new mock instance.
+func NewMockdictionarySaveService(ctrl *gomock.Controller) *MockdictionarySaveService {
+	mock := &MockdictionarySaveService{ctrl: ctrl}
+	mock.recorder = &MockdictionarySaveServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdictionarySaveService) EXPECT() *MockdictionarySaveServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockdictionarySaveService) Handle(ctx context.Context, in domain.DictionarySaveIn) (*domain.DictionarySaveOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, in)
+	ret0, _ := ret[0].(*domain.DictionarySaveOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockdictionarySaveServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockdictionarySaveService)(nil).Handle), ctx, in)
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	dictionary_save_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_save/domain"
+)
+
+type Usecase struct {
+	projectRepo           projectRepository
+	dictionarySaveService dictionarySaveService
+}
+
+func New(projectRepo projectRepository, dictionarySaveService dictionarySaveService) *Usecase {
+	return &Usecase{
+		projectRepo:           projectRepo,
+		dictionarySaveService: dictionarySaveService,
+	}
+}
+
+func (u *Usecase) Handle(ctx context.Context, in dictionary_save_domain.DictionarySaveIn) (*dictionary_save_domain.DictionarySaveOut, error) {
+	// get project
+	project, err := u.projectRepo.GetByID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("project repo - get by id: %w", err)
+	}
+
+	if project == nil {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	// check permission
+	isMaintainer := lo.SomeBy(project.Users, func(user domain.ProjectUser) bool {
+		return user.ID == in.AuthorID && user.Role == user_domain.RoleMaintain
+	})
+
+	if project.AuthorID != in.AuthorID && !isMaintainer {
+		return nil, domain.ErrProjectInvalid
+	}
+
+	// save dictionary
+	out, err := u.dictionarySaveService.Handle(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
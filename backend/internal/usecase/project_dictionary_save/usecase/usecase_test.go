@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	dictionary_save_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_save/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_dictionary_save/domain"
+)
+
+func TestUsecase_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+
+	in := dictionary_save_domain.DictionarySaveIn{
+		ProjectID: 10,
+		AuthorID:  1,
+		Name:      "materials",
+		Columns:   []dictionary_domain.Column{{Name: "density", Type: variable_domain.TypeFloat}},
+		Rows:      []dictionary_domain.Row{{Key: "steel", Values: map[string]string{"density": "7850"}}},
+	}
+
+	out := dictionary_save_domain.DictionarySaveOut{ID: 100, Number: 1}
+
+	tests := []struct {
+		name  string
+		setup func(projectRepo *MockprojectRepository, dictionarySaveService *MockdictionarySaveService)
+	}{
+		{
+			name: "IsAuthor",
+			setup: func(projectRepo *MockprojectRepository, dictionarySaveService *MockdictionarySaveService) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				dictionarySaveService.EXPECT().Handle(ctx, in).Return(&out, nil)
+			},
+		},
+		{
+			name: "IsMaintainer",
+			setup: func(projectRepo *MockprojectRepository, dictionarySaveService *MockdictionarySaveService) {
+				project := domain.Project{
+					AuthorID: 2,
+					Users:    []domain.ProjectUser{{ID: 1, Role: user_domain.RoleMaintain}},
+				}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+				dictionarySaveService.EXPECT().Handle(ctx, in).Return(&out, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			dictionarySaveService := NewMockdictionarySaveService(ctrl)
+
+			tt.setup(projectRepo, dictionarySaveService)
+
+			usecase := New(projectRepo, dictionarySaveService)
+			got, err := usecase.Handle(ctx, in)
+			require.NoError(t, err)
+			require.Equal(t, out, *got)
+		})
+	}
+}
+
+func TestUsecase_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+
+	in := dictionary_save_domain.DictionarySaveIn{ProjectID: 10, AuthorID: 1, Name: "materials"}
+
+	tests := []struct {
+		name  string
+		setup func(projectRepo *MockprojectRepository, dictionarySaveService *MockdictionarySaveService)
+		want  string
+	}{
+		{
+			name: "projectRepo_GetByID",
+			setup: func(projectRepo *MockprojectRepository, _ *MockdictionarySaveService) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, errors.New("test1"))
+			},
+			want: "test1",
+		},
+		{
+			name: "domain_ErrProjectNotFound",
+			setup: func(projectRepo *MockprojectRepository, _ *MockdictionarySaveService) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, nil)
+			},
+			want: domain.ErrProjectNotFound.Error(),
+		},
+		{
+			name: "domain_ErrProjectInvalid_NonMaintainRole",
+			setup: func(projectRepo *MockprojectRepository, _ *MockdictionarySaveService) {
+				project := domain.Project{
+					AuthorID: 2,
+					Users:    []domain.ProjectUser{{ID: 1, Role: user_domain.RoleWrite}},
+				}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+			},
+			want: domain.ErrProjectInvalid.Error(),
+		},
+		{
+			name: "dictionarySaveService_Handle",
+			setup: func(projectRepo *MockprojectRepository, dictionarySaveService *MockdictionarySaveService) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				dictionarySaveService.EXPECT().Handle(ctx, in).Return(nil, errors.New("test2"))
+			},
+			want: "test2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			dictionarySaveService := NewMockdictionarySaveService(ctrl)
+
+			tt.setup(projectRepo, dictionarySaveService)
+
+			usecase := New(projectRepo, dictionarySaveService)
+			_, err := usecase.Handle(ctx, in)
+			require.ErrorContains(t, err, tt.want)
+		})
+	}
+}
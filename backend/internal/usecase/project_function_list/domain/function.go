@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+type Function struct {
+	ID        int64
+	Name      string
+	Params    []string
+	Body      string
+	Number    int64
+	UpdatedAt time.Time
+}
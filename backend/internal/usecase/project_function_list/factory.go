@@ -0,0 +1,15 @@
+package project_function_list_usecase
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	function_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_list/repository/function"
+	project_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_list/repository/project"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_list/usecase"
+)
+
+func New(db *sqlx.DB) *usecase.Usecase {
+	projectRepo := project_repository.New(db)
+	functionRepo := function_repository.New(db)
+	return usecase.New(projectRepo, functionRepo)
+}
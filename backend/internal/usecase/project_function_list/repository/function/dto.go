@@ -0,0 +1,44 @@
+package function_repository
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_list/domain"
+)
+
+type function struct {
+	ID        int64     `db:"id"`
+	Name      string    `db:"name"`
+	Params    params    `db:"params"`
+	Body      string    `db:"body"`
+	Number    int64     `db:"number"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (f function) toDomain() domain.Function {
+	return domain.Function{
+		ID:        f.ID,
+		Name:      f.Name,
+		Params:    f.Params,
+		Body:      f.Body,
+		Number:    f.Number,
+		UpdatedAt: f.UpdatedAt,
+	}
+}
+
+type params []string
+
+func (p *params) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &p)
+}
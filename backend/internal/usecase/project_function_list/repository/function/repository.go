@@ -0,0 +1,55 @@
+package function_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_list/domain"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+func (r *Repository) ListByProjectID(ctx context.Context, projectID int64) ([]domain.Function, error) {
+	op := "function - list by project id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"f.id",
+			"f.name",
+			"fv.params",
+			"fv.body",
+			"fv.number",
+			"COALESCE(f.updated_at, f.created_at) AS updated_at",
+		).
+		From("project_function f").
+		Join("project_function_version fv ON f.last_version_id = fv.id").
+		Where(sq.Eq{"f.project_id": projectID}).
+		OrderBy("f.name ASC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dtos []function
+	err = r.db.SelectContext(ctx, &dtos, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return lo.Map(dtos, func(f function, _ int) domain.Function { return f.toDomain() }), nil
+}
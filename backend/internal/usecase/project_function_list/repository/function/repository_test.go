@@ -0,0 +1,80 @@
+package function_repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_list/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_ListByProjectID() {
+	ctx := context.Background()
+	repo := New(s.C().DB())
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// function
+	function := test_db.GenerateEntity(func(f *test_db.ProjectFunction) {
+		f.ProjectID = projectID
+		f.AuthorID = &userID
+		f.LastVersionID = nil
+		f.UpdatedAt = nil
+	})
+	functionID, err := test_db.InsertEntityWithID[int64](s.C(), "project_function", function)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_function", functionID)) }()
+
+	// function versions
+	versions := test_db.GenerateEntities(2, func(v *test_db.ProjectFunctionVersion, i int) {
+		v.Number = int64(i + 1)
+		v.FunctionID = functionID
+		v.AuthorID = &userID
+		v.Params = []byte(`["x", "tol"]`)
+	})
+	versionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_function_version", versions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_function_version", versionIDs))
+	}()
+
+	_, err = s.C().DB().Exec("UPDATE project_function SET last_version_id = $1 WHERE id = $2", versionIDs[1], functionID)
+	require.NoError(s.T(), err)
+
+	got, err := repo.ListByProjectID(ctx, projectID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), got, 1)
+
+	want := []domain.Function{
+		{
+			ID:        functionID,
+			Name:      function.Name,
+			Params:    []string{"x", "tol"},
+			Body:      versions[1].Body,
+			Number:    2,
+			UpdatedAt: got[0].UpdatedAt,
+		},
+	}
+	require.Equal(s.T(), want, got)
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_list/domain"
+)
+
+type Usecase struct {
+	projectRepo  projectRepository
+	functionRepo functionRepository
+}
+
+func New(projectRepo projectRepository, functionRepo functionRepository) *Usecase {
+	return &Usecase{
+		projectRepo:  projectRepo,
+		functionRepo: functionRepo,
+	}
+}
+
+func (u *Usecase) Handle(ctx context.Context, in domain.ProjectFunctionListIn) ([]domain.Function, error) {
+	project, err := u.projectRepo.GetByID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("project repo - get by id: %w", err)
+	}
+
+	if project == nil {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	isMember := lo.SomeBy(project.Users, func(user domain.ProjectUser) bool { return user.ID == in.UserID })
+
+	if project.AuthorID != in.UserID && !isMember {
+		return nil, domain.ErrProjectInvalid
+	}
+
+	functions, err := u.functionRepo.ListByProjectID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("function repo - list by project id: %w", err)
+	}
+
+	return functions, nil
+}
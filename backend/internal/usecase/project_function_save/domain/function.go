@@ -0,0 +1,33 @@
+package domain
+
+// Function is the latest version of a project function.
+type Function struct {
+	ID        int64
+	VersionID int64
+	Name      string
+	Params    []string
+	Body      string
+}
+
+type FunctionToCreate struct {
+	ProjectID int64
+	AuthorID  int64
+	Name      string
+}
+
+type FunctionToUpdate struct {
+	ID            int64
+	LastVersionID int64
+}
+
+type FunctionVersion struct {
+	FunctionID int64
+	AuthorID   int64
+	Params     []string
+	Body       string
+}
+
+type FunctionVersionOut struct {
+	ID     int64
+	Number int64
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/samber/lo"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/expression"
+)
+
+var (
+	ErrProjectNotFound  = error_domain.NewBaseError("project not found")
+	ErrProjectInvalid   = error_domain.NewBaseError("project is invalid")
+	ErrValueEmpty       = errors.New("value is empty")
+	ErrValueInvalid     = errors.New("value is invalid")
+	ErrValueDuplicate   = errors.New("value is duplicate")
+	ErrValueReserved    = errors.New("value is reserved")
+	ErrBodyInvalid      = errors.New("body is invalid")
+	ErrDependencyCycle  = errors.New("functions contain a cycle")
+	ErrFunctionsInvalid = errors.New("functions are invalid")
+)
+
+var slugRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+const (
+	slugMaxLen   = 100
+	paramsMaxLen = 20
+)
+
+type ProjectFunctionSaveIn struct {
+	ProjectID int64
+	AuthorID  int64
+	Name      string
+	Params    []string
+	Body      string
+}
+
+func (in ProjectFunctionSaveIn) Validate() error {
+	if !validSlug(in.Name) {
+		return error_domain.NewValidationError("name", ErrValueInvalid)
+	}
+
+	if expression.IsReserved(in.Name) {
+		return error_domain.NewValidationError("name", ErrValueReserved)
+	}
+
+	if len(in.Params) > paramsMaxLen {
+		return error_domain.NewValidationError("params", ErrValueInvalid)
+	}
+
+	for i, param := range in.Params {
+		if !validSlug(param) {
+			return error_domain.NewValidationError(fmt.Sprintf("params.%d", i), ErrValueInvalid)
+		}
+
+		if lo.Contains(in.Params[:i], param) {
+			return error_domain.NewValidationError(fmt.Sprintf("params.%d", i), ErrValueDuplicate)
+		}
+	}
+
+	if strings.TrimSpace(in.Body) == "" {
+		return error_domain.NewValidationError("body", ErrValueEmpty)
+	}
+
+	return nil
+}
+
+func validSlug(s string) bool {
+	return s != "" && len(s) <= slugMaxLen && slugRegexp.MatchString(s)
+}
+
+// ValidateBody compiles the function along with the other functions of the
+// project the same way the worker does. The other functions are compiled as
+// well: they may call this one, and a change of its params breaks them.
+func (in ProjectFunctionSaveIn) ValidateBody(functions []Function) error {
+	project := lo.FilterMap(functions, func(f Function, _ int) (function_domain.Function, bool) {
+		return function_domain.Function{Name: f.Name, Params: f.Params, Body: f.Body}, f.Name != in.Name
+	})
+	project = append(project, function_domain.Function{Name: in.Name, Params: in.Params, Body: in.Body})
+
+	_, err := expression.FunctionOptions(project, 0)
+	if err == nil {
+		return nil
+	}
+
+	var cycleErr *expression.CycleError
+	if errors.As(err, &cycleErr) {
+		return error_domain.NewValidationError("body", fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(cycleErr.Path, " → ")))
+	}
+
+	var functionErr *expression.FunctionError
+	if errors.As(err, &functionErr) && functionErr.Name == in.Name {
+		return error_domain.NewValidationError("body", fmt.Errorf("%w: %w", ErrBodyInvalid, functionErr.Err))
+	}
+
+	return error_domain.NewValidationError("body", fmt.Errorf("%w: %w", ErrFunctionsInvalid, err))
+}
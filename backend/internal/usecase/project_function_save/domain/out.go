@@ -0,0 +1,6 @@
+package domain
+
+type ProjectFunctionSaveOut struct {
+	ID     int64
+	Number int64
+}
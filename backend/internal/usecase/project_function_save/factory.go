@@ -0,0 +1,20 @@
+package project_function_save_usecase
+
+import (
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/jmoiron/sqlx"
+
+	function_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/repository/function"
+	function_version_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/repository/function_version"
+	project_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/repository/project"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/usecase"
+)
+
+func New(db *sqlx.DB) *usecase.Usecase {
+	projectRepo := project_repository.New(db)
+	functionRepo := function_repository.New(db, trmsqlx.DefaultCtxGetter)
+	functionVersionRepo := function_version_repository.New(db, trmsqlx.DefaultCtxGetter)
+	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
+	return usecase.New(projectRepo, functionRepo, functionVersionRepo, trManager)
+}
@@ -0,0 +1,41 @@
+package function_repository
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+)
+
+type function struct {
+	ID        int64  `db:"id"`
+	VersionID int64  `db:"version_id"`
+	Name      string `db:"name"`
+	Params    params `db:"params"`
+	Body      string `db:"body"`
+}
+
+func (f function) toDomain() domain.Function {
+	return domain.Function{
+		ID:        f.ID,
+		VersionID: f.VersionID,
+		Name:      f.Name,
+		Params:    f.Params,
+		Body:      f.Body,
+	}
+}
+
+type params []string
+
+func (p *params) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &p)
+}
@@ -0,0 +1,106 @@
+package function_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+)
+
+type Repository struct {
+	db       *sqlx.DB
+	trGetter *trmsqlx.CtxGetter
+}
+
+func New(db *sqlx.DB, trGetter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{
+		db:       db,
+		trGetter: trGetter,
+	}
+}
+
+func (r *Repository) ListByProjectID(ctx context.Context, projectID int64) ([]domain.Function, error) {
+	op := "function - list by project id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"f.id",
+			"fv.id AS version_id",
+			"f.name",
+			"fv.params",
+			"fv.body",
+		).
+		From("project_function f").
+		Join("project_function_version fv ON f.last_version_id = fv.id").
+		Where(sq.Eq{"f.project_id": projectID}).
+		OrderBy("f.name ASC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dtos []function
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &dtos, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return lo.Map(dtos, func(f function, _ int) domain.Function { return f.toDomain() }), nil
+}
+
+func (r *Repository) Create(ctx context.Context, function domain.FunctionToCreate) (int64, error) {
+	op := "function - create"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("project_function").
+		Columns("name", "project_id", "author_id").
+		Values(function.Name, function.ProjectID, function.AuthorID).
+		Suffix("RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var id int64
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &id, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (r *Repository) UpdateByID(ctx context.Context, function domain.FunctionToUpdate) error {
+	op := "function - update by id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("project_function").
+		Set("last_version_id", function.LastVersionID).
+		Set("updated_at", sq.Expr("now() AT TIME ZONE 'utc'")).
+		Where(sq.Eq{"id": function.ID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	_, err = r.trGetter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return nil
+}
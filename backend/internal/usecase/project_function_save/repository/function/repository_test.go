@@ -0,0 +1,109 @@
+package function_repository
+
+import (
+	"context"
+	"testing"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_ListByProjectID() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// function
+	function := test_db.GenerateEntity(func(f *test_db.ProjectFunction) {
+		f.Name = "tolerance"
+		f.ProjectID = projectID
+		f.AuthorID = &userID
+		f.LastVersionID = nil
+	})
+	functionID, err := test_db.InsertEntityWithID[int64](s.C(), "project_function", function)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_function", functionID)) }()
+
+	// function versions
+	versions := test_db.GenerateEntities(2, func(v *test_db.ProjectFunctionVersion, i int) {
+		v.Number = int64(i + 1)
+		v.FunctionID = functionID
+		v.AuthorID = &userID
+		v.Params = []byte(`["x"]`)
+	})
+	versionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_function_version", versions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_function_version", versionIDs))
+	}()
+
+	err = repo.UpdateByID(ctx, domain.FunctionToUpdate{ID: functionID, LastVersionID: versionIDs[1]})
+	require.NoError(s.T(), err)
+
+	got, err := repo.ListByProjectID(ctx, projectID)
+	require.NoError(s.T(), err)
+
+	want := []domain.Function{
+		{ID: functionID, VersionID: versionIDs[1], Name: "tolerance", Params: []string{"x"}, Body: versions[1].Body},
+	}
+	require.Equal(s.T(), want, got)
+}
+
+func (s *repositorySuite) TestRepository_Create() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// function
+	functionID, err := repo.Create(ctx, domain.FunctionToCreate{ProjectID: projectID, AuthorID: userID, Name: "tolerance"})
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_function", functionID)) }()
+
+	functions, err := test_db.SelectEntitiesByID[test_db.ProjectFunction](s.C(), "project_function", []int64{functionID})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), functions, 1)
+
+	got := functions[0]
+	want := test_db.ProjectFunction{
+		ID:        functionID,
+		Name:      "tolerance",
+		CreatedAt: got.CreatedAt,
+		ProjectID: projectID,
+		AuthorID:  &userID,
+	}
+	require.Equal(s.T(), want, got)
+}
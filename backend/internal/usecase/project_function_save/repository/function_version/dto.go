@@ -0,0 +1,30 @@
+package function_version_repository
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+)
+
+type params []string
+
+func (p params) Value() (driver.Value, error) {
+	if p == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]string(p))
+}
+
+type functionVersion struct {
+	ID     int64 `db:"id"`
+	Number int64 `db:"number"`
+}
+
+func (v functionVersion) toDomain() domain.FunctionVersionOut {
+	return domain.FunctionVersionOut{
+		ID:     v.ID,
+		Number: v.Number,
+	}
+}
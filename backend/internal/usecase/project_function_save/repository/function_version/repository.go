@@ -0,0 +1,58 @@
+package function_version_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+)
+
+type Repository struct {
+	db       *sqlx.DB
+	trGetter *trmsqlx.CtxGetter
+}
+
+func New(db *sqlx.DB, trGetter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{
+		db:       db,
+		trGetter: trGetter,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, version domain.FunctionVersion) (*domain.FunctionVersionOut, error) {
+	op := "function version - create"
+
+	numberExpr := sq.Expr("(SELECT COALESCE(MAX(number), 0) FROM project_function_version WHERE function_id = ?) + 1", version.FunctionID)
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("project_function_version").
+		Columns("number", "function_id", "author_id", "params", "body").
+		Values(
+			numberExpr,
+			version.FunctionID,
+			version.AuthorID,
+			params(version.Params),
+			version.Body,
+		).
+		Suffix("RETURNING id, number")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dto functionVersion
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &dto, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	out := dto.toDomain()
+	return &out, nil
+}
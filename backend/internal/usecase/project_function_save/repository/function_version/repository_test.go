@@ -0,0 +1,83 @@
+package function_version_repository
+
+import (
+	"context"
+	"testing"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_Create() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// function
+	function := test_db.GenerateEntity(func(f *test_db.ProjectFunction) {
+		f.ProjectID = projectID
+		f.AuthorID = &userID
+		f.LastVersionID = nil
+	})
+	functionID, err := test_db.InsertEntityWithID[int64](s.C(), "project_function", function)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_function", functionID)) }()
+
+	// function versions
+	version := domain.FunctionVersion{
+		FunctionID: functionID,
+		AuthorID:   userID,
+		Params:     []string{"x", "tol"},
+		Body:       "x * tol / 100",
+	}
+
+	first, err := repo.Create(ctx, version)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntityByColumn(s.C(), "project_function_version", "function_id", functionID))
+	}()
+	require.Equal(s.T(), int64(1), first.Number)
+
+	second, err := repo.Create(ctx, version)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(2), second.Number)
+
+	versions, err := test_db.SelectEntitiesByID[test_db.ProjectFunctionVersion](s.C(), "project_function_version", []int64{first.ID})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), versions, 1)
+
+	got := versions[0]
+	want := test_db.ProjectFunctionVersion{
+		ID:         first.ID,
+		Number:     1,
+		FunctionID: functionID,
+		AuthorID:   &userID,
+		CreatedAt:  got.CreatedAt,
+		Params:     []byte(`["x", "tol"]`),
+		Body:       "x * tol / 100",
+	}
+	require.Equal(s.T(), want, got)
+}
@@ -0,0 +1,23 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+)
+
+type projectRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Project, error)
+}
+
+type functionRepository interface {
+	ListByProjectID(ctx context.Context, projectID int64) ([]domain.Function, error)
+	Create(ctx context.Context, function domain.FunctionToCreate) (int64, error)
+	UpdateByID(ctx context.Context, function domain.FunctionToUpdate) error
+}
+
+type functionVersionRepository interface {
+	Create(ctx context.Context, version domain.FunctionVersion) (*domain.FunctionVersionOut, error)
+}
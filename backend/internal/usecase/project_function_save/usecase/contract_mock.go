@@ -0,0 +1,164 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -package usecase -source contract.go -destination contract_mock.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockprojectRepository is a mock of projectRepository interface.
+type MockprojectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockprojectRepositoryMockRecorder is the mock recorder for MockprojectRepository.
+type MockprojectRepositoryMockRecorder struct {
+	mock *MockprojectRepository
+}
+
+// NewMockprojectRepository creates a new mock instance.
+func NewMockprojectRepository(ctrl *gomock.Controller) *MockprojectRepository {
+	mock := &MockprojectRepository{ctrl: ctrl}
+	mock.recorder = &MockprojectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectRepository) EXPECT() *MockprojectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockprojectRepository) GetByID(ctx context.Context, id int64) (*domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockprojectRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockprojectRepository)(nil).GetByID), ctx, id)
+}
+
+// MockfunctionRepository is a mock of functionRepository interface.
+type MockfunctionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockfunctionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockfunctionRepositoryMockRecorder is the mock recorder for MockfunctionRepository.
+type MockfunctionRepositoryMockRecorder struct {
+	mock *MockfunctionRepository
+}
+
+// NewMockfunctionRepository creates a new mock instance.
+func NewMockfunctionRepository(ctrl *gomock.Controller) *MockfunctionRepository {
+	mock := &MockfunctionRepository{ctrl: ctrl}
+	mock.recorder = &MockfunctionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfunctionRepository) EXPECT() *MockfunctionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockfunctionRepository) Create(ctx context.Context, function domain.FunctionToCreate) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, function)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockfunctionRepositoryMockRecorder) Create(ctx, function any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockfunctionRepository)(nil).Create), ctx, function)
+}
+
+// ListByProjectID mocks base method.
+func (m *MockfunctionRepository) ListByProjectID(ctx context.Context, projectID int64) ([]domain.Function, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByProjectID", ctx, projectID)
+	ret0, _ := ret[0].([]domain.Function)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByProjectID indicates an expected call of ListByProjectID.
+func (mr *MockfunctionRepositoryMockRecorder) ListByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProjectID", reflect.TypeOf((*MockfunctionRepository)(nil).ListByProjectID), ctx, projectID)
+}
+
+// UpdateByID mocks base method.
+func (m *MockfunctionRepository) UpdateByID(ctx context.Context, function domain.FunctionToUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByID", ctx, function)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateByID indicates an expected call of UpdateByID.
+func (mr *MockfunctionRepositoryMockRecorder) UpdateByID(ctx, function any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MockfunctionRepository)(nil).UpdateByID), ctx, function)
+}
+
+// MockfunctionVersionRepository is a mock of functionVersionRepository interface.
+type MockfunctionVersionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockfunctionVersionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockfunctionVersionRepositoryMockRecorder is the mock recorder for MockfunctionVersionRepository.
+type MockfunctionVersionRepositoryMockRecorder struct {
+	mock *MockfunctionVersionRepository
+}
+
+// NewMockfunctionVersionRepository creates a new mock instance.
+func NewMockfunctionVersionRepository(ctrl *gomock.Controller) *MockfunctionVersionRepository {
+	mock := &MockfunctionVersionRepository{ctrl: ctrl}
+	mock.recorder = &MockfunctionVersionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfunctionVersionRepository) EXPECT() *MockfunctionVersionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockfunctionVersionRepository) Create(ctx context.Context, version domain.FunctionVersion) (*domain.FunctionVersionOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, version)
+	ret0, _ := ret[0].(*domain.FunctionVersionOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockfunctionVersionRepositoryMockRecorder) Create(ctx, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockfunctionVersionRepository)(nil).Create), ctx, version)
+}
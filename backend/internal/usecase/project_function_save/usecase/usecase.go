@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/samber/lo"
+
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+)
+
+type Usecase struct {
+	projectRepo         projectRepository
+	functionRepo        functionRepository
+	functionVersionRepo functionVersionRepository
+	trManager           trm.Manager
+}
+
+func New(
+	projectRepo projectRepository,
+	functionRepo functionRepository,
+	functionVersionRepo functionVersionRepository,
+	trManager trm.Manager,
+) *Usecase {
+	return &Usecase{
+		projectRepo:         projectRepo,
+		functionRepo:        functionRepo,
+		functionVersionRepo: functionVersionRepo,
+		trManager:           trManager,
+	}
+}
+
+// Handle saves a project function: the first save of a name creates the
+// function, the next ones create its new versions.
+func (u *Usecase) Handle(ctx context.Context, in domain.ProjectFunctionSaveIn) (*domain.ProjectFunctionSaveOut, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	// check project
+	project, err := u.projectRepo.GetByID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("project repo - get by id: %w", err)
+	}
+
+	if project == nil {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	isMaintainer := lo.SomeBy(project.Users, func(user domain.ProjectUser) bool {
+		return user.ID == in.AuthorID && user.Role == user_domain.RoleMaintain
+	})
+
+	if project.AuthorID != in.AuthorID && !isMaintainer {
+		return nil, domain.ErrProjectInvalid
+	}
+
+	// validate body
+	functions, err := u.functionRepo.ListByProjectID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("function repo - list by project id: %w", err)
+	}
+
+	if err := in.ValidateBody(functions); err != nil {
+		return nil, err
+	}
+
+	// save function
+	existing, exists := lo.Find(functions, func(f domain.Function) bool { return f.Name == in.Name })
+
+	var out *domain.ProjectFunctionSaveOut
+	err = u.trManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		out, err = u.saveFunction(ctx, in, existing.ID, exists)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (u *Usecase) saveFunction(ctx context.Context, in domain.ProjectFunctionSaveIn, functionID int64, exists bool) (*domain.ProjectFunctionSaveOut, error) {
+	// create function
+	if !exists {
+		functionToCreate := domain.FunctionToCreate{
+			ProjectID: in.ProjectID,
+			AuthorID:  in.AuthorID,
+			Name:      in.Name,
+		}
+
+		var err error
+		functionID, err = u.functionRepo.Create(ctx, functionToCreate)
+		if err != nil {
+			return nil, fmt.Errorf("function repo - create: %w", err)
+		}
+	}
+
+	// create version
+	version := domain.FunctionVersion{
+		FunctionID: functionID,
+		AuthorID:   in.AuthorID,
+		Params:     in.Params,
+		Body:       in.Body,
+	}
+
+	versionOut, err := u.functionVersionRepo.Create(ctx, version)
+	if err != nil {
+		return nil, fmt.Errorf("function version repo - create: %w", err)
+	}
+
+	// update function
+	functionToUpdate := domain.FunctionToUpdate{
+		ID:            functionID,
+		LastVersionID: versionOut.ID,
+	}
+
+	err = u.functionRepo.UpdateByID(ctx, functionToUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("function repo - update by id: %w", err)
+	}
+
+	return &domain.ProjectFunctionSaveOut{ID: functionID, Number: versionOut.Number}, nil
+}
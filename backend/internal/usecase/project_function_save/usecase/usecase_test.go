@@ -0,0 +1,280 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	test_trm "github.com/qsoulior/tech-generator/backend/internal/pkg/test/trm"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_function_save/domain"
+)
+
+func TestUsecase_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+	trCtx := context.WithValue(ctx, test_trm.TrKey{}, struct{}{})
+
+	in := domain.ProjectFunctionSaveIn{
+		ProjectID: 10,
+		AuthorID:  1,
+		Name:      "tolerance",
+		Params:    []string{"x", "tol"},
+		Body:      `formatNumber(x, 1) + " ± " + formatNumber(x * tol / 100, 1)`,
+	}
+
+	version := domain.FunctionVersion{FunctionID: 100, AuthorID: 1, Params: in.Params, Body: in.Body}
+
+	tests := []struct {
+		name  string
+		in    domain.ProjectFunctionSaveIn
+		setup func(projectRepo *MockprojectRepository, functionRepo *MockfunctionRepository, functionVersionRepo *MockfunctionVersionRepository)
+		want  domain.ProjectFunctionSaveOut
+	}{
+		{
+			name: "Create",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, functionRepo *MockfunctionRepository, functionVersionRepo *MockfunctionVersionRepository) {
+				project := domain.Project{AuthorID: 1}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+
+				functions := []domain.Function{{ID: 200, VersionID: 201, Name: "gostCode", Params: []string{"x"}, Body: `"ГОСТ " + x`}}
+				functionRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(functions, nil)
+
+				functionToCreate := domain.FunctionToCreate{ProjectID: 10, AuthorID: 1, Name: "tolerance"}
+				functionRepo.EXPECT().Create(trCtx, functionToCreate).Return(int64(100), nil)
+
+				functionVersionRepo.EXPECT().Create(trCtx, version).Return(&domain.FunctionVersionOut{ID: 101, Number: 1}, nil)
+
+				functionRepo.EXPECT().UpdateByID(trCtx, domain.FunctionToUpdate{ID: 100, LastVersionID: 101}).Return(nil)
+			},
+			want: domain.ProjectFunctionSaveOut{ID: 100, Number: 1},
+		},
+		{
+			name: "NewVersion_IsMaintainer",
+			in: domain.ProjectFunctionSaveIn{
+				ProjectID: 10,
+				AuthorID:  2,
+				Name:      "tolerance",
+				Params:    []string{"x"},
+				Body:      `formatNumber(x, 1)`,
+			},
+			setup: func(projectRepo *MockprojectRepository, functionRepo *MockfunctionRepository, functionVersionRepo *MockfunctionVersionRepository) {
+				project := domain.Project{
+					AuthorID: 1,
+					Users:    []domain.ProjectUser{{ID: 2, Role: user_domain.RoleMaintain}},
+				}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+
+				functions := []domain.Function{{ID: 100, VersionID: 101, Name: "tolerance", Params: in.Params, Body: in.Body}}
+				functionRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(functions, nil)
+
+				version := domain.FunctionVersion{FunctionID: 100, AuthorID: 2, Params: []string{"x"}, Body: `formatNumber(x, 1)`}
+				functionVersionRepo.EXPECT().Create(trCtx, version).Return(&domain.FunctionVersionOut{ID: 102, Number: 2}, nil)
+
+				functionRepo.EXPECT().UpdateByID(trCtx, domain.FunctionToUpdate{ID: 100, LastVersionID: 102}).Return(nil)
+			},
+			want: domain.ProjectFunctionSaveOut{ID: 100, Number: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			functionRepo := NewMockfunctionRepository(ctrl)
+			functionVersionRepo := NewMockfunctionVersionRepository(ctrl)
+			trManager := test_trm.New()
+
+			tt.setup(projectRepo, functionRepo, functionVersionRepo)
+
+			usecase := New(projectRepo, functionRepo, functionVersionRepo, trManager)
+			got, err := usecase.Handle(ctx, tt.in)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, *got)
+		})
+	}
+}
+
+func TestUsecase_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+	trCtx := context.WithValue(ctx, test_trm.TrKey{}, struct{}{})
+
+	in := domain.ProjectFunctionSaveIn{
+		ProjectID: 10,
+		AuthorID:  1,
+		Name:      "tolerance",
+		Params:    []string{"x", "tol"},
+		Body:      `x * tol / 100`,
+	}
+
+	tests := []struct {
+		name    string
+		in      domain.ProjectFunctionSaveIn
+		setup   func(projectRepo *MockprojectRepository, functionRepo *MockfunctionRepository, functionVersionRepo *MockfunctionVersionRepository)
+		want    string
+		wantVal bool
+	}{
+		{
+			name:    "ValidationNameInvalid",
+			in:      domain.ProjectFunctionSaveIn{ProjectID: 10, AuthorID: 1, Name: "1st", Body: "1"},
+			setup:   func(_ *MockprojectRepository, _ *MockfunctionRepository, _ *MockfunctionVersionRepository) {},
+			want:    "name",
+			wantVal: true,
+		},
+		{
+			name:    "ValidationNameReserved",
+			in:      domain.ProjectFunctionSaveIn{ProjectID: 10, AuthorID: 1, Name: "round", Body: "1"},
+			setup:   func(_ *MockprojectRepository, _ *MockfunctionRepository, _ *MockfunctionVersionRepository) {},
+			want:    domain.ErrValueReserved.Error(),
+			wantVal: true,
+		},
+		{
+			name:    "ValidationParamDuplicate",
+			in:      domain.ProjectFunctionSaveIn{ProjectID: 10, AuthorID: 1, Name: "f", Params: []string{"x", "x"}, Body: "x"},
+			setup:   func(_ *MockprojectRepository, _ *MockfunctionRepository, _ *MockfunctionVersionRepository) {},
+			want:    "params.1",
+			wantVal: true,
+		},
+		{
+			name:    "ValidationBodyEmpty",
+			in:      domain.ProjectFunctionSaveIn{ProjectID: 10, AuthorID: 1, Name: "f", Body: " "},
+			setup:   func(_ *MockprojectRepository, _ *MockfunctionRepository, _ *MockfunctionVersionRepository) {},
+			want:    "body",
+			wantVal: true,
+		},
+		{
+			name: "projectRepo_GetByID",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, _ *MockfunctionRepository, _ *MockfunctionVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, errors.New("test1"))
+			},
+			want: "test1",
+		},
+		{
+			name: "domain_ErrProjectNotFound",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, _ *MockfunctionRepository, _ *MockfunctionVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, nil)
+			},
+			want: domain.ErrProjectNotFound.Error(),
+		},
+		{
+			name: "domain_ErrProjectInvalid_NonMaintainRole",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, _ *MockfunctionRepository, _ *MockfunctionVersionRepository) {
+				project := domain.Project{
+					AuthorID: 2,
+					Users:    []domain.ProjectUser{{ID: 1, Role: user_domain.RoleWrite}},
+				}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+			},
+			want: domain.ErrProjectInvalid.Error(),
+		},
+		{
+			name: "functionRepo_ListByProjectID",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, functionRepo *MockfunctionRepository, _ *MockfunctionVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				functionRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(nil, errors.New("test2"))
+			},
+			want: "test2",
+		},
+		{
+			name: "domain_ErrBodyInvalid",
+			in:   domain.ProjectFunctionSaveIn{ProjectID: 10, AuthorID: 1, Name: "f", Params: []string{"x"}, Body: "x + y"},
+			setup: func(projectRepo *MockprojectRepository, functionRepo *MockfunctionRepository, _ *MockfunctionVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				functionRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(nil, nil)
+			},
+			want:    domain.ErrBodyInvalid.Error(),
+			wantVal: true,
+		},
+		{
+			name: "domain_ErrDependencyCycle",
+			in:   domain.ProjectFunctionSaveIn{ProjectID: 10, AuthorID: 1, Name: "f", Params: []string{"x"}, Body: "g(x)"},
+			setup: func(projectRepo *MockprojectRepository, functionRepo *MockfunctionRepository, _ *MockfunctionVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				functions := []domain.Function{{ID: 100, VersionID: 101, Name: "g", Params: []string{"x"}, Body: "f(x) + 1"}}
+				functionRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(functions, nil)
+			},
+			want:    domain.ErrDependencyCycle.Error(),
+			wantVal: true,
+		},
+		{
+			name: "domain_ErrFunctionsInvalid",
+			in:   domain.ProjectFunctionSaveIn{ProjectID: 10, AuthorID: 1, Name: "f", Params: []string{"x"}, Body: "x"},
+			setup: func(projectRepo *MockprojectRepository, functionRepo *MockfunctionRepository, _ *MockfunctionVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				functions := []domain.Function{
+					{ID: 100, VersionID: 101, Name: "f", Params: []string{"x", "y"}, Body: "x + y"},
+					{ID: 200, VersionID: 201, Name: "g", Params: []string{"x"}, Body: "f(x, 1)"},
+				}
+				functionRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(functions, nil)
+			},
+			want:    domain.ErrFunctionsInvalid.Error(),
+			wantVal: true,
+		},
+		{
+			name: "functionRepo_Create",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, functionRepo *MockfunctionRepository, _ *MockfunctionVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				functionRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(nil, nil)
+				functionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(0), errors.New("test3"))
+			},
+			want: "test3",
+		},
+		{
+			name: "functionVersionRepo_Create",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, functionRepo *MockfunctionRepository, functionVersionRepo *MockfunctionVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				functionRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(nil, nil)
+				functionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(100), nil)
+				functionVersionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(nil, errors.New("test4"))
+			},
+			want: "test4",
+		},
+		{
+			name: "functionRepo_UpdateByID",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, functionRepo *MockfunctionRepository, functionVersionRepo *MockfunctionVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				functionRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(nil, nil)
+				functionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(100), nil)
+				functionVersionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(&domain.FunctionVersionOut{ID: 101, Number: 1}, nil)
+				functionRepo.EXPECT().UpdateByID(trCtx, gomock.Any()).Return(errors.New("test5"))
+			},
+			want: "test5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			functionRepo := NewMockfunctionRepository(ctrl)
+			functionVersionRepo := NewMockfunctionVersionRepository(ctrl)
+			trManager := test_trm.New()
+
+			tt.setup(projectRepo, functionRepo, functionVersionRepo)
+
+			usecase := New(projectRepo, functionRepo, functionVersionRepo, trManager)
+			_, err := usecase.Handle(ctx, tt.in)
+			require.ErrorContains(t, err, tt.want)
+
+			if tt.wantVal {
+				var validationErr *error_domain.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+type Partial struct {
+	ID        int64
+	Name      string
+	Data      string
+	Number    int64
+	UpdatedAt time.Time
+}
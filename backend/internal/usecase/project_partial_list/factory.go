@@ -0,0 +1,15 @@
+package project_partial_list_usecase
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	partial_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_list/repository/partial"
+	project_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_list/repository/project"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_list/usecase"
+)
+
+func New(db *sqlx.DB) *usecase.Usecase {
+	projectRepo := project_repository.New(db)
+	partialRepo := partial_repository.New(db)
+	return usecase.New(projectRepo, partialRepo)
+}
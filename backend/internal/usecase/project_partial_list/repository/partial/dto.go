@@ -0,0 +1,25 @@
+package partial_repository
+
+import (
+	"time"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_list/domain"
+)
+
+type partial struct {
+	ID        int64     `db:"id"`
+	Name      string    `db:"name"`
+	Data      string    `db:"data"`
+	Number    int64     `db:"number"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (p partial) toDomain() domain.Partial {
+	return domain.Partial{
+		ID:        p.ID,
+		Name:      p.Name,
+		Data:      p.Data,
+		Number:    p.Number,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
@@ -0,0 +1,54 @@
+package partial_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_list/domain"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+func (r *Repository) ListByProjectID(ctx context.Context, projectID int64) ([]domain.Partial, error) {
+	op := "partial - list by project id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"p.id",
+			"p.name",
+			"pv.data",
+			"pv.number",
+			"COALESCE(p.updated_at, p.created_at) AS updated_at",
+		).
+		From("project_partial p").
+		Join("project_partial_version pv ON p.last_version_id = pv.id").
+		Where(sq.Eq{"p.project_id": projectID}).
+		OrderBy("p.name ASC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dtos []partial
+	err = r.db.SelectContext(ctx, &dtos, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return lo.Map(dtos, func(p partial, _ int) domain.Partial { return p.toDomain() }), nil
+}
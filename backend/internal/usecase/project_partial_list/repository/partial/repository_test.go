@@ -0,0 +1,78 @@
+package partial_repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_list/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_ListByProjectID() {
+	ctx := context.Background()
+	repo := New(s.C().DB())
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// partial
+	partial := test_db.GenerateEntity(func(p *test_db.ProjectPartial) {
+		p.ProjectID = projectID
+		p.AuthorID = &userID
+		p.LastVersionID = nil
+		p.UpdatedAt = nil
+	})
+	partialID, err := test_db.InsertEntityWithID[int64](s.C(), "project_partial", partial)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_partial", partialID)) }()
+
+	// partial versions
+	versions := test_db.GenerateEntities(2, func(v *test_db.ProjectPartialVersion, i int) {
+		v.Number = int64(i + 1)
+		v.PartialID = partialID
+		v.AuthorID = &userID
+	})
+	versionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_partial_version", versions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_partial_version", versionIDs))
+	}()
+
+	_, err = s.C().DB().Exec("UPDATE project_partial SET last_version_id = $1 WHERE id = $2", versionIDs[1], partialID)
+	require.NoError(s.T(), err)
+
+	got, err := repo.ListByProjectID(ctx, projectID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), got, 1)
+
+	want := []domain.Partial{
+		{
+			ID:        partialID,
+			Name:      partial.Name,
+			Data:      versions[1].Data,
+			Number:    2,
+			UpdatedAt: got[0].UpdatedAt,
+		},
+	}
+	require.Equal(s.T(), want, got)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -package usecase -source contract.go -destination contract_mock.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_list/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockprojectRepository is a mock of projectRepository interface.
+type MockprojectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockprojectRepositoryMockRecorder is the mock recorder for MockprojectRepository.
+type MockprojectRepositoryMockRecorder struct {
+	mock *MockprojectRepository
+}
+
+// NewMockprojectRepository creates a new mock instance.
+func NewMockprojectRepository(ctrl *gomock.Controller) *MockprojectRepository {
+	mock := &MockprojectRepository{ctrl: ctrl}
+	mock.recorder = &MockprojectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectRepository) EXPECT() *MockprojectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockprojectRepository) GetByID(ctx context.Context, id int64) (*domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockprojectRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockprojectRepository)(nil).GetByID), ctx, id)
+}
+
+// MockpartialRepository is a mock of partialRepository interface.
+type MockpartialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockpartialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockpartialRepositoryMockRecorder is the mock recorder for MockpartialRepository.
+type MockpartialRepositoryMockRecorder struct {
+	mock *MockpartialRepository
+}
+
+// NewMockpartialRepository creates a new mock instance.
+func NewMockpartialRepository(ctrl *gomock.Controller) *MockpartialRepository {
+	mock := &MockpartialRepository{ctrl: ctrl}
+	mock.recorder = &MockpartialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpartialRepository) EXPECT() *MockpartialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByProjectID mocks base method.
+func (m *MockpartialRepository) ListByProjectID(ctx context.Context, projectID int64) ([]domain.Partial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByProjectID", ctx, projectID)
+	ret0, _ := ret[0].([]domain.Partial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByProjectID indicates an expected call of ListByProjectID.
+func (mr *MockpartialRepositoryMockRecorder) ListByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProjectID", reflect.TypeOf((*MockpartialRepository)(nil).ListByProjectID), ctx, projectID)
+}
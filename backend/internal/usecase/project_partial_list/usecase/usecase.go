@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_list/domain"
+)
+
+type Usecase struct {
+	projectRepo projectRepository
+	partialRepo partialRepository
+}
+
+func New(projectRepo projectRepository, partialRepo partialRepository) *Usecase {
+	return &Usecase{
+		projectRepo: projectRepo,
+		partialRepo: partialRepo,
+	}
+}
+
+func (u *Usecase) Handle(ctx context.Context, in domain.ProjectPartialListIn) ([]domain.Partial, error) {
+	project, err := u.projectRepo.GetByID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("project repo - get by id: %w", err)
+	}
+
+	if project == nil {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	isMember := lo.SomeBy(project.Users, func(user domain.ProjectUser) bool { return user.ID == in.UserID })
+
+	if project.AuthorID != in.UserID && !isMember {
+		return nil, domain.ErrProjectInvalid
+	}
+
+	partials, err := u.partialRepo.ListByProjectID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("partial repo - list by project id: %w", err)
+	}
+
+	return partials, nil
+}
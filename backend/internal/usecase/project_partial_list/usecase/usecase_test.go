@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_list/domain"
+)
+
+func TestUsecase_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+
+	want := []domain.Partial{
+		{ID: 1, Name: "footer", Data: "{{ .org_name }}", Number: 2, UpdatedAt: time.Now()},
+	}
+
+	tests := []struct {
+		name    string
+		in      domain.ProjectPartialListIn
+		project *domain.Project
+	}{
+		{
+			name:    "IsAuthor",
+			in:      domain.ProjectPartialListIn{ProjectID: 10, UserID: 1},
+			project: &domain.Project{AuthorID: 1},
+		},
+		{
+			name: "IsReader",
+			in:   domain.ProjectPartialListIn{ProjectID: 10, UserID: 2},
+			project: &domain.Project{
+				AuthorID: 1,
+				Users:    []domain.ProjectUser{{ID: 2, Role: user_domain.RoleRead}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			projectRepo.EXPECT().GetByID(ctx, tt.in.ProjectID).Return(tt.project, nil)
+
+			partialRepo := NewMockpartialRepository(ctrl)
+			partialRepo.EXPECT().ListByProjectID(ctx, tt.in.ProjectID).Return(want, nil)
+
+			usecase := New(projectRepo, partialRepo)
+			got, err := usecase.Handle(ctx, tt.in)
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestUsecase_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+	in := domain.ProjectPartialListIn{ProjectID: 10, UserID: 1}
+
+	tests := []struct {
+		name  string
+		setup func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository)
+		want  string
+	}{
+		{
+			name: "projectRepo_GetByID",
+			setup: func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository) {
+				projectRepo.EXPECT().GetByID(ctx, gomock.Any()).Return(nil, errors.New("test1"))
+			},
+			want: "test1",
+		},
+		{
+			name: "domain_ErrProjectNotFound",
+			setup: func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository) {
+				projectRepo.EXPECT().GetByID(ctx, gomock.Any()).Return(nil, nil)
+			},
+			want: domain.ErrProjectNotFound.Error(),
+		},
+		{
+			name: "domain_ErrProjectInvalid",
+			setup: func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository) {
+				projectRepo.EXPECT().GetByID(ctx, gomock.Any()).Return(&domain.Project{AuthorID: 9}, nil)
+			},
+			want: domain.ErrProjectInvalid.Error(),
+		},
+		{
+			name: "partialRepo_ListByProjectID",
+			setup: func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository) {
+				projectRepo.EXPECT().GetByID(ctx, gomock.Any()).Return(&domain.Project{AuthorID: 1}, nil)
+				partialRepo.EXPECT().ListByProjectID(ctx, gomock.Any()).Return(nil, errors.New("test2"))
+			},
+			want: "test2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			partialRepo := NewMockpartialRepository(ctrl)
+			tt.setup(projectRepo, partialRepo)
+
+			usecase := New(projectRepo, partialRepo)
+			_, err := usecase.Handle(ctx, in)
+			require.ErrorContains(t, err, tt.want)
+		})
+	}
+}
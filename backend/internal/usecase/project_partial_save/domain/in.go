@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/partial"
+	"github.com/qsoulior/tech-generator/backend/internal/pkg/templatefunc"
+)
+
+var (
+	ErrProjectNotFound = error_domain.NewBaseError("project not found")
+	ErrProjectInvalid  = error_domain.NewBaseError("project is invalid")
+	ErrValueEmpty      = errors.New("value is empty")
+	ErrValueInvalid    = errors.New("value is invalid")
+	ErrValueDuplicate  = errors.New("value is duplicate")
+)
+
+var slugRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+const slugMaxLen = 100
+
+type ProjectPartialSaveIn struct {
+	ProjectID int64
+	AuthorID  int64
+	Name      string
+	Data      string
+}
+
+func (in ProjectPartialSaveIn) Validate() error {
+	if in.Name == "" || len(in.Name) > slugMaxLen || !slugRegexp.MatchString(in.Name) {
+		return error_domain.NewValidationError("name", ErrValueInvalid)
+	}
+
+	if strings.TrimSpace(in.Data) == "" {
+		return error_domain.NewValidationError("data", ErrValueEmpty)
+	}
+
+	if _, err := template.New(in.Name).Funcs(templatefunc.Funcs).Parse(in.Data); err != nil {
+		return error_domain.NewValidationError("data", fmt.Errorf("%w: %w", ErrValueInvalid, err))
+	}
+
+	return nil
+}
+
+// ValidateData checks that the partial does not define the templates the other
+// partials of the project define, as a template calling such a name would not
+// know which partial it depends on.
+func (in ProjectPartialSaveIn) ValidateData(partials []Partial) error {
+	trees, err := partial.Trees(in.Name, in.Data)
+	if err != nil {
+		return error_domain.NewValidationError("data", fmt.Errorf("%w: %w", ErrValueInvalid, err))
+	}
+
+	for _, p := range partials {
+		if p.Name == in.Name {
+			continue
+		}
+
+		others, err := partial.Trees(p.Name, p.Data)
+		if err != nil {
+			return fmt.Errorf("parse partial %q: %w", p.Name, err)
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(trees)) {
+			if _, found := others[name]; found {
+				return error_domain.NewValidationError("data", fmt.Errorf("%w: %q is defined by partial %q", ErrValueDuplicate, name, p.Name))
+			}
+		}
+	}
+
+	return nil
+}
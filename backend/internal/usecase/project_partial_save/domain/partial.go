@@ -0,0 +1,31 @@
+package domain
+
+// Partial is the latest version of a project partial.
+type Partial struct {
+	ID        int64
+	VersionID int64
+	Name      string
+	Data      string
+}
+
+type PartialToCreate struct {
+	ProjectID int64
+	AuthorID  int64
+	Name      string
+}
+
+type PartialToUpdate struct {
+	ID            int64
+	LastVersionID int64
+}
+
+type PartialVersion struct {
+	PartialID int64
+	AuthorID  int64
+	Data      string
+}
+
+type PartialVersionOut struct {
+	ID     int64
+	Number int64
+}
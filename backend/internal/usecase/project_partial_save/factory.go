@@ -0,0 +1,20 @@
+package project_partial_save_usecase
+
+import (
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/jmoiron/sqlx"
+
+	partial_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/repository/partial"
+	partial_version_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/repository/partial_version"
+	project_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/repository/project"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/usecase"
+)
+
+func New(db *sqlx.DB) *usecase.Usecase {
+	projectRepo := project_repository.New(db)
+	partialRepo := partial_repository.New(db, trmsqlx.DefaultCtxGetter)
+	partialVersionRepo := partial_version_repository.New(db, trmsqlx.DefaultCtxGetter)
+	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
+	return usecase.New(projectRepo, partialRepo, partialVersionRepo, trManager)
+}
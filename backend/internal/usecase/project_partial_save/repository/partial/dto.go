@@ -0,0 +1,19 @@
+package partial_repository
+
+import "github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+
+type partial struct {
+	ID        int64  `db:"id"`
+	VersionID int64  `db:"version_id"`
+	Name      string `db:"name"`
+	Data      string `db:"data"`
+}
+
+func (p partial) toDomain() domain.Partial {
+	return domain.Partial{
+		ID:        p.ID,
+		VersionID: p.VersionID,
+		Name:      p.Name,
+		Data:      p.Data,
+	}
+}
@@ -0,0 +1,105 @@
+package partial_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+)
+
+type Repository struct {
+	db       *sqlx.DB
+	trGetter *trmsqlx.CtxGetter
+}
+
+func New(db *sqlx.DB, trGetter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{
+		db:       db,
+		trGetter: trGetter,
+	}
+}
+
+func (r *Repository) ListByProjectID(ctx context.Context, projectID int64) ([]domain.Partial, error) {
+	op := "partial - list by project id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"p.id",
+			"pv.id AS version_id",
+			"p.name",
+			"pv.data",
+		).
+		From("project_partial p").
+		Join("project_partial_version pv ON p.last_version_id = pv.id").
+		Where(sq.Eq{"p.project_id": projectID}).
+		OrderBy("p.name ASC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dtos []partial
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &dtos, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return lo.Map(dtos, func(p partial, _ int) domain.Partial { return p.toDomain() }), nil
+}
+
+func (r *Repository) Create(ctx context.Context, partial domain.PartialToCreate) (int64, error) {
+	op := "partial - create"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("project_partial").
+		Columns("name", "project_id", "author_id").
+		Values(partial.Name, partial.ProjectID, partial.AuthorID).
+		Suffix("RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var id int64
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &id, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (r *Repository) UpdateByID(ctx context.Context, partial domain.PartialToUpdate) error {
+	op := "partial - update by id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("project_partial").
+		Set("last_version_id", partial.LastVersionID).
+		Set("updated_at", sq.Expr("now() AT TIME ZONE 'utc'")).
+		Where(sq.Eq{"id": partial.ID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	_, err = r.trGetter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return nil
+}
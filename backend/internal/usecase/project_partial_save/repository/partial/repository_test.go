@@ -0,0 +1,108 @@
+package partial_repository
+
+import (
+	"context"
+	"testing"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_ListByProjectID() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// partial
+	partial := test_db.GenerateEntity(func(p *test_db.ProjectPartial) {
+		p.Name = "title_page"
+		p.ProjectID = projectID
+		p.AuthorID = &userID
+		p.LastVersionID = nil
+	})
+	partialID, err := test_db.InsertEntityWithID[int64](s.C(), "project_partial", partial)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_partial", partialID)) }()
+
+	// partial versions
+	versions := test_db.GenerateEntities(2, func(v *test_db.ProjectPartialVersion, i int) {
+		v.Number = int64(i + 1)
+		v.PartialID = partialID
+		v.AuthorID = &userID
+	})
+	versionIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "project_partial_version", versions)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntitiesByID(s.C(), "project_partial_version", versionIDs))
+	}()
+
+	err = repo.UpdateByID(ctx, domain.PartialToUpdate{ID: partialID, LastVersionID: versionIDs[1]})
+	require.NoError(s.T(), err)
+
+	got, err := repo.ListByProjectID(ctx, projectID)
+	require.NoError(s.T(), err)
+
+	want := []domain.Partial{
+		{ID: partialID, VersionID: versionIDs[1], Name: "title_page", Data: versions[1].Data},
+	}
+	require.Equal(s.T(), want, got)
+}
+
+func (s *repositorySuite) TestRepository_Create() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// partial
+	partialID, err := repo.Create(ctx, domain.PartialToCreate{ProjectID: projectID, AuthorID: userID, Name: "title_page"})
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_partial", partialID)) }()
+
+	partials, err := test_db.SelectEntitiesByID[test_db.ProjectPartial](s.C(), "project_partial", []int64{partialID})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), partials, 1)
+
+	got := partials[0]
+	want := test_db.ProjectPartial{
+		ID:        partialID,
+		Name:      "title_page",
+		CreatedAt: got.CreatedAt,
+		ProjectID: projectID,
+		AuthorID:  &userID,
+	}
+	require.Equal(s.T(), want, got)
+}
@@ -0,0 +1,15 @@
+package partial_version_repository
+
+import "github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+
+type partialVersion struct {
+	ID     int64 `db:"id"`
+	Number int64 `db:"number"`
+}
+
+func (v partialVersion) toDomain() domain.PartialVersionOut {
+	return domain.PartialVersionOut{
+		ID:     v.ID,
+		Number: v.Number,
+	}
+}
@@ -0,0 +1,57 @@
+package partial_version_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+)
+
+type Repository struct {
+	db       *sqlx.DB
+	trGetter *trmsqlx.CtxGetter
+}
+
+func New(db *sqlx.DB, trGetter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{
+		db:       db,
+		trGetter: trGetter,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, version domain.PartialVersion) (*domain.PartialVersionOut, error) {
+	op := "partial version - create"
+
+	numberExpr := sq.Expr("(SELECT COALESCE(MAX(number), 0) FROM project_partial_version WHERE partial_id = ?) + 1", version.PartialID)
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("project_partial_version").
+		Columns("number", "partial_id", "author_id", "data").
+		Values(
+			numberExpr,
+			version.PartialID,
+			version.AuthorID,
+			version.Data,
+		).
+		Suffix("RETURNING id, number")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dto partialVersion
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &dto, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	out := dto.toDomain()
+	return &out, nil
+}
@@ -0,0 +1,81 @@
+package partial_version_repository
+
+import (
+	"context"
+	"testing"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+)
+
+type repositorySuite struct {
+	test_db.PsqlTestSuite
+}
+
+func Test_repositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) TestRepository_Create() {
+	ctx := context.Background()
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
+
+	// user
+	user := test_db.GenerateEntity[test_db.User]()
+	userID, err := test_db.InsertEntityWithID[int64](s.C(), "usr", user)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "usr", userID)) }()
+
+	// project
+	project := test_db.GenerateEntity(func(p *test_db.Project) { p.AuthorID = userID })
+	projectID, err := test_db.InsertEntityWithID[int64](s.C(), "project", project)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
+
+	// partial
+	partial := test_db.GenerateEntity(func(p *test_db.ProjectPartial) {
+		p.ProjectID = projectID
+		p.AuthorID = &userID
+		p.LastVersionID = nil
+	})
+	partialID, err := test_db.InsertEntityWithID[int64](s.C(), "project_partial", partial)
+	require.NoError(s.T(), err)
+	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project_partial", partialID)) }()
+
+	// partial versions
+	version := domain.PartialVersion{
+		PartialID: partialID,
+		AuthorID:  userID,
+		Data:      "{{ define \"footer\" }}{{ .org_name }}{{ end }}",
+	}
+
+	first, err := repo.Create(ctx, version)
+	require.NoError(s.T(), err)
+	defer func() {
+		require.NoError(s.T(), test_db.DeleteEntityByColumn(s.C(), "project_partial_version", "partial_id", partialID))
+	}()
+	require.Equal(s.T(), int64(1), first.Number)
+
+	second, err := repo.Create(ctx, version)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(2), second.Number)
+
+	versions, err := test_db.SelectEntitiesByID[test_db.ProjectPartialVersion](s.C(), "project_partial_version", []int64{first.ID})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), versions, 1)
+
+	got := versions[0]
+	want := test_db.ProjectPartialVersion{
+		ID:        first.ID,
+		Number:    1,
+		PartialID: partialID,
+		AuthorID:  &userID,
+		CreatedAt: got.CreatedAt,
+		Data:      "{{ define \"footer\" }}{{ .org_name }}{{ end }}",
+	}
+	require.Equal(s.T(), want, got)
+}
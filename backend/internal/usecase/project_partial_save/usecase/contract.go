@@ -0,0 +1,23 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+)
+
+type projectRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Project, error)
+}
+
+type partialRepository interface {
+	ListByProjectID(ctx context.Context, projectID int64) ([]domain.Partial, error)
+	Create(ctx context.Context, partial domain.PartialToCreate) (int64, error)
+	UpdateByID(ctx context.Context, partial domain.PartialToUpdate) error
+}
+
+type partialVersionRepository interface {
+	Create(ctx context.Context, version domain.PartialVersion) (*domain.PartialVersionOut, error)
+}
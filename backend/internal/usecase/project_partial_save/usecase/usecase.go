@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/samber/lo"
+
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+)
+
+type Usecase struct {
+	projectRepo        projectRepository
+	partialRepo        partialRepository
+	partialVersionRepo partialVersionRepository
+	trManager          trm.Manager
+}
+
+func New(
+	projectRepo projectRepository,
+	partialRepo partialRepository,
+	partialVersionRepo partialVersionRepository,
+	trManager trm.Manager,
+) *Usecase {
+	return &Usecase{
+		projectRepo:        projectRepo,
+		partialRepo:        partialRepo,
+		partialVersionRepo: partialVersionRepo,
+		trManager:          trManager,
+	}
+}
+
+// Handle saves a project partial: the first save of a name creates the
+// partial, the next ones create its new versions. The template versions keep
+// the partial versions they were saved with.
+func (u *Usecase) Handle(ctx context.Context, in domain.ProjectPartialSaveIn) (*domain.ProjectPartialSaveOut, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	// check project
+	project, err := u.projectRepo.GetByID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("project repo - get by id: %w", err)
+	}
+
+	if project == nil {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	isMaintainer := lo.SomeBy(project.Users, func(user domain.ProjectUser) bool {
+		return user.ID == in.AuthorID && user.Role == user_domain.RoleMaintain
+	})
+
+	if project.AuthorID != in.AuthorID && !isMaintainer {
+		return nil, domain.ErrProjectInvalid
+	}
+
+	// validate data
+	partials, err := u.partialRepo.ListByProjectID(ctx, in.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("partial repo - list by project id: %w", err)
+	}
+
+	if err := in.ValidateData(partials); err != nil {
+		return nil, err
+	}
+
+	// save partial
+	existing, exists := lo.Find(partials, func(p domain.Partial) bool { return p.Name == in.Name })
+
+	var out *domain.ProjectPartialSaveOut
+	err = u.trManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		out, err = u.savePartial(ctx, in, existing.ID, exists)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (u *Usecase) savePartial(ctx context.Context, in domain.ProjectPartialSaveIn, partialID int64, exists bool) (*domain.ProjectPartialSaveOut, error) {
+	// create partial
+	if !exists {
+		partialToCreate := domain.PartialToCreate{
+			ProjectID: in.ProjectID,
+			AuthorID:  in.AuthorID,
+			Name:      in.Name,
+		}
+
+		var err error
+		partialID, err = u.partialRepo.Create(ctx, partialToCreate)
+		if err != nil {
+			return nil, fmt.Errorf("partial repo - create: %w", err)
+		}
+	}
+
+	// create version
+	version := domain.PartialVersion{
+		PartialID: partialID,
+		AuthorID:  in.AuthorID,
+		Data:      in.Data,
+	}
+
+	versionOut, err := u.partialVersionRepo.Create(ctx, version)
+	if err != nil {
+		return nil, fmt.Errorf("partial version repo - create: %w", err)
+	}
+
+	// update partial
+	partialToUpdate := domain.PartialToUpdate{
+		ID:            partialID,
+		LastVersionID: versionOut.ID,
+	}
+
+	err = u.partialRepo.UpdateByID(ctx, partialToUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("partial repo - update by id: %w", err)
+	}
+
+	return &domain.ProjectPartialSaveOut{ID: partialID, Number: versionOut.Number}, nil
+}
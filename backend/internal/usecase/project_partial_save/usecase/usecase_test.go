@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	test_trm "github.com/qsoulior/tech-generator/backend/internal/pkg/test/trm"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/project_partial_save/domain"
+)
+
+func TestUsecase_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+	trCtx := context.WithValue(ctx, test_trm.TrKey{}, struct{}{})
+
+	in := domain.ProjectPartialSaveIn{
+		ProjectID: 10,
+		AuthorID:  1,
+		Name:      "layout",
+		Data:      `# {{ .title }}{{ block "content" . }}{{ end }}{{ template "footer" . }}`,
+	}
+
+	version := domain.PartialVersion{PartialID: 100, AuthorID: 1, Data: in.Data}
+
+	tests := []struct {
+		name  string
+		in    domain.ProjectPartialSaveIn
+		setup func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository, partialVersionRepo *MockpartialVersionRepository)
+		want  domain.ProjectPartialSaveOut
+	}{
+		{
+			name: "Create",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository, partialVersionRepo *MockpartialVersionRepository) {
+				project := domain.Project{AuthorID: 1}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+
+				partials := []domain.Partial{{ID: 200, VersionID: 201, Name: "footer", Data: `{{ .org_name }}`}}
+				partialRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(partials, nil)
+
+				partialToCreate := domain.PartialToCreate{ProjectID: 10, AuthorID: 1, Name: "layout"}
+				partialRepo.EXPECT().Create(trCtx, partialToCreate).Return(int64(100), nil)
+
+				partialVersionRepo.EXPECT().Create(trCtx, version).Return(&domain.PartialVersionOut{ID: 101, Number: 1}, nil)
+
+				partialRepo.EXPECT().UpdateByID(trCtx, domain.PartialToUpdate{ID: 100, LastVersionID: 101}).Return(nil)
+			},
+			want: domain.ProjectPartialSaveOut{ID: 100, Number: 1},
+		},
+		{
+			name: "NewVersion_IsMaintainer",
+			in: domain.ProjectPartialSaveIn{
+				ProjectID: 10,
+				AuthorID:  2,
+				Name:      "layout",
+				Data:      `{{ block "content" . }}{{ end }}`,
+			},
+			setup: func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository, partialVersionRepo *MockpartialVersionRepository) {
+				project := domain.Project{
+					AuthorID: 1,
+					Users:    []domain.ProjectUser{{ID: 2, Role: user_domain.RoleMaintain}},
+				}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+
+				// the previous version of the partial defines the same block
+				partials := []domain.Partial{{ID: 100, VersionID: 101, Name: "layout", Data: in.Data}}
+				partialRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(partials, nil)
+
+				version := domain.PartialVersion{PartialID: 100, AuthorID: 2, Data: `{{ block "content" . }}{{ end }}`}
+				partialVersionRepo.EXPECT().Create(trCtx, version).Return(&domain.PartialVersionOut{ID: 102, Number: 2}, nil)
+
+				partialRepo.EXPECT().UpdateByID(trCtx, domain.PartialToUpdate{ID: 100, LastVersionID: 102}).Return(nil)
+			},
+			want: domain.ProjectPartialSaveOut{ID: 100, Number: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			partialRepo := NewMockpartialRepository(ctrl)
+			partialVersionRepo := NewMockpartialVersionRepository(ctrl)
+			trManager := test_trm.New()
+
+			tt.setup(projectRepo, partialRepo, partialVersionRepo)
+
+			usecase := New(projectRepo, partialRepo, partialVersionRepo, trManager)
+			got, err := usecase.Handle(ctx, tt.in)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, *got)
+		})
+	}
+}
+
+func TestUsecase_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+	trCtx := context.WithValue(ctx, test_trm.TrKey{}, struct{}{})
+
+	in := domain.ProjectPartialSaveIn{
+		ProjectID: 10,
+		AuthorID:  1,
+		Name:      "footer",
+		Data:      `{{ .org_name }}`,
+	}
+
+	tests := []struct {
+		name    string
+		in      domain.ProjectPartialSaveIn
+		setup   func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository, partialVersionRepo *MockpartialVersionRepository)
+		want    string
+		wantVal bool
+	}{
+		{
+			name:    "ValidationNameInvalid",
+			in:      domain.ProjectPartialSaveIn{ProjectID: 10, AuthorID: 1, Name: "title-page", Data: "1"},
+			setup:   func(_ *MockprojectRepository, _ *MockpartialRepository, _ *MockpartialVersionRepository) {},
+			want:    "name",
+			wantVal: true,
+		},
+		{
+			name:    "ValidationDataEmpty",
+			in:      domain.ProjectPartialSaveIn{ProjectID: 10, AuthorID: 1, Name: "footer", Data: " "},
+			setup:   func(_ *MockprojectRepository, _ *MockpartialRepository, _ *MockpartialVersionRepository) {},
+			want:    domain.ErrValueEmpty.Error(),
+			wantVal: true,
+		},
+		{
+			name:    "ValidationDataInvalid",
+			in:      domain.ProjectPartialSaveIn{ProjectID: 10, AuthorID: 1, Name: "footer", Data: "{{ unknownFunc .x }}"},
+			setup:   func(_ *MockprojectRepository, _ *MockpartialRepository, _ *MockpartialVersionRepository) {},
+			want:    domain.ErrValueInvalid.Error(),
+			wantVal: true,
+		},
+		{
+			name: "projectRepo_GetByID",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, _ *MockpartialRepository, _ *MockpartialVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, errors.New("test1"))
+			},
+			want: "test1",
+		},
+		{
+			name: "domain_ErrProjectNotFound",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, _ *MockpartialRepository, _ *MockpartialVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, nil)
+			},
+			want: domain.ErrProjectNotFound.Error(),
+		},
+		{
+			name: "domain_ErrProjectInvalid_NonMaintainRole",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, _ *MockpartialRepository, _ *MockpartialVersionRepository) {
+				project := domain.Project{
+					AuthorID: 2,
+					Users:    []domain.ProjectUser{{ID: 1, Role: user_domain.RoleWrite}},
+				}
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&project, nil)
+			},
+			want: domain.ErrProjectInvalid.Error(),
+		},
+		{
+			name: "partialRepo_ListByProjectID",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository, _ *MockpartialVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				partialRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(nil, errors.New("test2"))
+			},
+			want: "test2",
+		},
+		{
+			name: "domain_ErrValueDuplicate",
+			in:   domain.ProjectPartialSaveIn{ProjectID: 10, AuthorID: 1, Name: "layout", Data: `{{ define "footer" }}{{ end }}`},
+			setup: func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository, _ *MockpartialVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				partials := []domain.Partial{{ID: 100, VersionID: 101, Name: "footer", Data: `{{ .org_name }}`}}
+				partialRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(partials, nil)
+			},
+			want:    domain.ErrValueDuplicate.Error(),
+			wantVal: true,
+		},
+		{
+			name: "partialRepo_Create",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository, _ *MockpartialVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				partialRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(nil, nil)
+				partialRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(0), errors.New("test3"))
+			},
+			want: "test3",
+		},
+		{
+			name: "partialVersionRepo_Create",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository, partialVersionRepo *MockpartialVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				partialRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(nil, nil)
+				partialRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(100), nil)
+				partialVersionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(nil, errors.New("test4"))
+			},
+			want: "test4",
+		},
+		{
+			name: "partialRepo_UpdateByID",
+			in:   in,
+			setup: func(projectRepo *MockprojectRepository, partialRepo *MockpartialRepository, partialVersionRepo *MockpartialVersionRepository) {
+				projectRepo.EXPECT().GetByID(ctx, int64(10)).Return(&domain.Project{AuthorID: 1}, nil)
+				partialRepo.EXPECT().ListByProjectID(ctx, int64(10)).Return(nil, nil)
+				partialRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(100), nil)
+				partialVersionRepo.EXPECT().Create(trCtx, gomock.Any()).Return(&domain.PartialVersionOut{ID: 101, Number: 1}, nil)
+				partialRepo.EXPECT().UpdateByID(trCtx, gomock.Any()).Return(errors.New("test5"))
+			},
+			want: "test5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := NewMockprojectRepository(ctrl)
+			partialRepo := NewMockpartialRepository(ctrl)
+			partialVersionRepo := NewMockpartialVersionRepository(ctrl)
+			trManager := test_trm.New()
+
+			tt.setup(projectRepo, partialRepo, partialVersionRepo)
+
+			usecase := New(projectRepo, partialRepo, partialVersionRepo, trManager)
+			_, err := usecase.Handle(ctx, tt.in)
+			require.ErrorContains(t, err, tt.want)
+
+			if tt.wantVal {
+				var validationErr *error_domain.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			}
+		})
+	}
+}
@@ -1,7 +1,13 @@
 package domain
 
+import output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+
 type TaskCreateIn struct {
 	VersionID int64
 	CreatorID int64
-	Payload   map[string]string
+	Payload   map[string]any
+	// Trace makes the worker store how every variable value was reached.
+	Trace bool
+	// OutputFormat overrides the output format of the template unless nil.
+	OutputFormat *output_domain.Format
 }
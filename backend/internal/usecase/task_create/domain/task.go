@@ -0,0 +1,14 @@
+package domain
+
+import output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+
+type TaskToCreate struct {
+	VersionID    int64
+	CreatorID    int64
+	Payload      map[string]any
+	IsTraced     bool
+	OutputFormat *output_domain.Format
+	// Constants are the project constants the task is rendered with, kept so
+	// that later edits of them don't change the task.
+	Constants map[string]string
+}
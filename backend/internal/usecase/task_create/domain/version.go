@@ -2,18 +2,25 @@ package domain
 
 import (
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
 )
 
 var (
 	ErrVersionNotFound = error_domain.NewBaseError("version not found")
 	ErrVersionInvalid  = error_domain.NewBaseError("version is invalid")
+	// ErrOutputFormatInvalid is returned for an output format the version can't
+	// be rendered to. A Word template is laid out by itself and only renders
+	// to DOCX.
+	ErrOutputFormatInvalid = error_domain.NewBaseError("output format is invalid for the version")
 )
 
 type Version struct {
+	TemplateID       int64
 	ProjectAuthorID  int64
 	TemplateAuthorID int64
 	TemplateUsers    []TemplateUser
+	Kind             template_domain.Kind
 }
 
 type TemplateUser struct {
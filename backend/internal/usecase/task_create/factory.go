@@ -4,6 +4,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/rabbitmq/amqp091-go"
 
+	constant_list_service "github.com/qsoulior/tech-generator/backend/internal/service/constant_list"
 	task_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/task_create/repository/task"
 	version_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/task_create/repository/version"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_create/service/publisher"
@@ -13,6 +14,7 @@ import (
 func New(db *sqlx.DB, amqp *amqp091.Channel) *usecase.Usecase {
 	versionRepo := version_repository.New(db)
 	taskRepo := task_repository.New(db)
+	constantListService := constant_list_service.New(db)
 	publisher := publisher.New(amqp)
-	return usecase.New(versionRepo, taskRepo, publisher)
+	return usecase.New(versionRepo, taskRepo, constantListService, publisher)
 }
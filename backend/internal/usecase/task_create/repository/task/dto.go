@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 )
 
-type payload map[string]string
+type payload map[string]any
 
 func (p *payload) Value() (driver.Value, error) {
 	if p == nil {
@@ -14,3 +14,9 @@ func (p *payload) Value() (driver.Value, error) {
 
 	return json.Marshal(p)
 }
+
+type constants map[string]string
+
+func (c constants) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
@@ -18,13 +18,13 @@ func New(db *sqlx.DB) *Repository {
 	return &Repository{db: db}
 }
 
-func (r *Repository) Insert(ctx context.Context, in domain.TaskCreateIn) (int64, error) {
+func (r *Repository) Insert(ctx context.Context, task domain.TaskToCreate) (int64, error) {
 	op := "task - insert"
 
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Insert("task").
-		Columns("version_id", "creator_id", "payload").
-		Values(in.VersionID, in.CreatorID, payload(in.Payload)).
+		Columns("version_id", "creator_id", "payload", "is_traced", "output_format", "constants").
+		Values(task.VersionID, task.CreatorID, payload(task.Payload), task.IsTraced, task.OutputFormat, constants(task.Constants)).
 		Suffix("RETURNING id")
 
 	query, args, err := builder.ToSql()
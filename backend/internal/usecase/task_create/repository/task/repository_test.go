@@ -4,9 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_create/domain"
@@ -48,17 +50,20 @@ func (s *repositorySuite) TestRepository_Insert() {
 	require.NoError(s.T(), err)
 	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "template_version", versionID)) }()
 
-	in := domain.TaskCreateIn{
+	task := domain.TaskToCreate{
 		VersionID: versionID,
 		CreatorID: userID,
-		Payload: map[string]string{
+		Payload: map[string]any{
 			"test1": "123",
 			"test2": "456.789",
 			"test3": "text",
 		},
+		IsTraced:     true,
+		OutputFormat: lo.ToPtr(output_domain.FormatPDF),
+		Constants:    map[string]string{"org_name": "ООО Ромашка"},
 	}
 
-	gotID, err := repo.Insert(ctx, in)
+	gotID, err := repo.Insert(ctx, task)
 	require.NoError(s.T(), err)
 	defer func() {
 		require.NoError(s.T(), test_db.DeleteEntityByColumn(s.C(), "task", "version_id", versionID))
@@ -71,15 +76,19 @@ func (s *repositorySuite) TestRepository_Insert() {
 	got := gotTasks[0]
 
 	want := test_db.Task{
-		ID:        got.ID,
-		VersionID: versionID,
-		Status:    string(task_domain.StatusCreated),
-		Payload:   []byte(`{"test1": "123", "test2": "456.789", "test3": "text"}`),
-		ResultID:  nil,
-		Error:     nil,
-		CreatorID: userID,
-		CreatedAt: got.CreatedAt,
-		UpdatedAt: nil,
+		ID:           got.ID,
+		VersionID:    versionID,
+		Status:       string(task_domain.StatusCreated),
+		Payload:      []byte(`{"test1": "123", "test2": "456.789", "test3": "text"}`),
+		ResultID:     nil,
+		Error:        nil,
+		IsTraced:     true,
+		CreatorID:    userID,
+		Clock:        got.Clock,
+		Constants:    []byte(`{"org_name": "ООО Ромашка"}`),
+		OutputFormat: lo.ToPtr("pdf"),
+		CreatedAt:    got.CreatedAt,
+		UpdatedAt:    nil,
 	}
 
 	require.Equal(s.T(), want, got)
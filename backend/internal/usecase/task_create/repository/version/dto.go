@@ -3,15 +3,18 @@ package version_repository
 import (
 	"github.com/samber/lo"
 
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_create/domain"
 )
 
 type version struct {
+	TemplateID       int64   `db:"template_id"`
 	ProjectAuthorID  int64   `db:"project_author_id"`
 	TemplateAuthorID int64   `db:"template_author_id"`
 	TemplateUserID   *int64  `db:"template_user_id"`
 	TemplateRole     *string `db:"template_user_role"`
+	Kind             string  `db:"kind"`
 }
 
 type versions []version
@@ -29,8 +32,10 @@ func (vs versions) toDomain() *domain.Version {
 	})
 
 	return &domain.Version{
+		TemplateID:       vs[0].TemplateID,
 		ProjectAuthorID:  vs[0].ProjectAuthorID,
 		TemplateAuthorID: vs[0].TemplateAuthorID,
 		TemplateUsers:    users,
+		Kind:             template_domain.Kind(vs[0].Kind),
 	}
 }
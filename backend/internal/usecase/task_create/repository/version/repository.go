@@ -25,10 +25,12 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Version, er
 
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Select(
+			"v.template_id",
 			"p.author_id as project_author_id",
 			"t.author_id as template_author_id",
 			"tu.user_id as template_user_id",
 			"tu.role as template_user_role",
+			"v.kind",
 		).
 		From("template_version v").
 		Join("template t ON v.template_id = t.id").
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
 	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_create/domain"
@@ -71,12 +72,14 @@ func (s *repositorySuite) TestRepository_GetByID() {
 		defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "template_version", versionID)) }()
 
 		want := domain.Version{
+			TemplateID:       templateID,
 			TemplateAuthorID: *template.AuthorID,
 			ProjectAuthorID:  project.AuthorID,
 			TemplateUsers: []domain.TemplateUser{
 				{ID: templateUsers[0].UserID, Role: user_domain.Role(templateUsers[0].Role)},
 				{ID: templateUsers[1].UserID, Role: user_domain.Role(templateUsers[1].Role)},
 			},
+			Kind: template_domain.Kind(version.Kind),
 		}
 		slices.SortFunc(want.TemplateUsers, func(a, b domain.TemplateUser) int { return int(a.ID - b.ID) })
 
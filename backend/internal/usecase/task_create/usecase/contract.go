@@ -13,7 +13,11 @@ type versionRepository interface {
 }
 
 type taskRepository interface {
-	Insert(ctx context.Context, in domain.TaskCreateIn) (int64, error)
+	Insert(ctx context.Context, task domain.TaskToCreate) (int64, error)
+}
+
+type constantListService interface {
+	Handle(ctx context.Context, templateID int64) (map[string]string, error)
 }
 
 type publisher interface {
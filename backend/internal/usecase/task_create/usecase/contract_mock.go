@@ -81,18 +81,57 @@ func (m *MocktaskRepository) EXPECT() *MocktaskRepositoryMockRecorder {
 }
 
 // Insert mocks base method.
-func (m *MocktaskRepository) Insert(ctx context.Context, in domain.TaskCreateIn) (int64, error) {
+func (m *MocktaskRepository) Insert(ctx context.Context, task domain.TaskToCreate) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Insert", ctx, in)
+	ret := m.ctrl.Call(m, "Insert", ctx, task)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Insert indicates an expected call of Insert.
-func (mr *MocktaskRepositoryMockRecorder) Insert(ctx, in any) *gomock.Call {
+func (mr *MocktaskRepositoryMockRecorder) Insert(ctx, task any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MocktaskRepository)(nil).Insert), ctx, in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MocktaskRepository)(nil).Insert), ctx, task)
+}
+
+// MockconstantListService is a mock of constantListService interface.
+type MockconstantListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockconstantListServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockconstantListServiceMockRecorder is the mock recorder for MockconstantListService.
+type MockconstantListServiceMockRecorder struct {
+	mock *MockconstantListService
+}
+
+// NewMockconstantListService creates a new mock instance.
+func NewMockconstantListService(ctrl *gomock.Controller) *MockconstantListService {
+	mock := &MockconstantListService{ctrl: ctrl}
+	mock.recorder = &MockconstantListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockconstantListService) EXPECT() *MockconstantListServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockconstantListService) Handle(ctx context.Context, templateID int64) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, templateID)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockconstantListServiceMockRecorder) Handle(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockconstantListService)(nil).Handle), ctx, templateID)
 }
 
 // Mockpublisher is a mock of publisher interface.
@@ -6,31 +6,50 @@ import (
 
 	"github.com/samber/lo"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_create/domain"
 )
 
 type Usecase struct {
-	versionRepo versionRepository
-	taskRepo    taskRepository
-	publisher   publisher
+	versionRepo         versionRepository
+	taskRepo            taskRepository
+	constantListService constantListService
+	publisher           publisher
 }
 
-func New(versionRepo versionRepository, taskRepo taskRepository, publisher publisher) *Usecase {
+func New(versionRepo versionRepository, taskRepo taskRepository, constantListService constantListService, publisher publisher) *Usecase {
 	return &Usecase{
-		versionRepo: versionRepo,
-		taskRepo:    taskRepo,
-		publisher:   publisher,
+		versionRepo:         versionRepo,
+		taskRepo:            taskRepo,
+		constantListService: constantListService,
+		publisher:           publisher,
 	}
 }
 
 func (u *Usecase) Handle(ctx context.Context, in domain.TaskCreateIn) error {
 	// check version
-	if err := u.handleVersion(ctx, in); err != nil {
+	version, err := u.handleVersion(ctx, in)
+	if err != nil {
 		return err
 	}
 
-	taskID, err := u.taskRepo.Insert(ctx, in)
+	// get constants, the task is rendered with them along with the clock
+	constants, err := u.constantListService.Handle(ctx, version.TemplateID)
+	if err != nil {
+		return fmt.Errorf("constant list service - handle: %w", err)
+	}
+
+	task := domain.TaskToCreate{
+		VersionID:    in.VersionID,
+		CreatorID:    in.CreatorID,
+		Payload:      in.Payload,
+		IsTraced:     in.Trace,
+		OutputFormat: in.OutputFormat,
+		Constants:    constants,
+	}
+	taskID, err := u.taskRepo.Insert(ctx, task)
 	if err != nil {
 		return fmt.Errorf("task repo - insert: %w", err)
 	}
@@ -42,15 +61,15 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TaskCreateIn) error {
 	return nil
 }
 
-func (u *Usecase) handleVersion(ctx context.Context, in domain.TaskCreateIn) error {
+func (u *Usecase) handleVersion(ctx context.Context, in domain.TaskCreateIn) (*domain.Version, error) {
 	// get version
 	version, err := u.versionRepo.GetByID(ctx, in.VersionID)
 	if err != nil {
-		return fmt.Errorf("version repo - get by id: %w", err)
+		return nil, fmt.Errorf("version repo - get by id: %w", err)
 	}
 
 	if version == nil {
-		return domain.ErrVersionNotFound
+		return nil, domain.ErrVersionNotFound
 	}
 
 	// check permission
@@ -59,8 +78,13 @@ func (u *Usecase) handleVersion(ctx context.Context, in domain.TaskCreateIn) err
 	})
 
 	if version.ProjectAuthorID != in.CreatorID && version.TemplateAuthorID != in.CreatorID && !isWriter {
-		return domain.ErrVersionInvalid
+		return nil, domain.ErrVersionInvalid
 	}
 
-	return nil
+	// check output format
+	if version.Kind == template_domain.KindDOCX && in.OutputFormat != nil && *in.OutputFormat != output_domain.FormatDOCX {
+		return nil, domain.ErrOutputFormatInvalid
+	}
+
+	return version, nil
 }
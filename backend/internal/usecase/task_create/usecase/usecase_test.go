@@ -5,9 +5,12 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_create/domain"
 )
@@ -20,25 +23,41 @@ func TestUsecase_Handle_Success(t *testing.T) {
 
 	versionRepo := NewMockversionRepository(ctrl)
 	taskRepo := NewMocktaskRepository(ctrl)
+	constantListService := NewMockconstantListService(ctrl)
 	publisher := NewMockpublisher(ctrl)
 
 	in := domain.TaskCreateIn{
-		VersionID: 100,
-		CreatorID: 1,
-		Payload:   map[string]string{"k": "v"},
+		VersionID:    100,
+		CreatorID:    1,
+		Payload:      map[string]any{"k": "v"},
+		Trace:        true,
+		OutputFormat: lo.ToPtr(output_domain.FormatXLSX),
 	}
 
 	version := &domain.Version{
+		TemplateID:       10,
 		ProjectAuthorID:  1,
 		TemplateAuthorID: 2,
 		TemplateUsers:    nil,
 	}
 
 	versionRepo.EXPECT().GetByID(ctx, in.VersionID).Return(version, nil)
-	taskRepo.EXPECT().Insert(ctx, in).Return(int64(50), nil)
+
+	constants := map[string]string{"org_name": "ООО Ромашка"}
+	constantListService.EXPECT().Handle(ctx, version.TemplateID).Return(constants, nil)
+
+	task := domain.TaskToCreate{
+		VersionID:    in.VersionID,
+		CreatorID:    in.CreatorID,
+		Payload:      in.Payload,
+		IsTraced:     true,
+		OutputFormat: in.OutputFormat,
+		Constants:    constants,
+	}
+	taskRepo.EXPECT().Insert(ctx, task).Return(int64(50), nil)
 	publisher.EXPECT().PublishTaskCreated(ctx, int64(50)).Return(nil)
 
-	usecase := New(versionRepo, taskRepo, publisher)
+	usecase := New(versionRepo, taskRepo, constantListService, publisher)
 	err := usecase.Handle(ctx, in)
 	require.NoError(t, err)
 }
@@ -51,24 +70,32 @@ func TestUsecase_Handle_Error(t *testing.T) {
 	validIn := domain.TaskCreateIn{
 		VersionID: 100,
 		CreatorID: 1,
-		Payload:   map[string]string{"k": "v"},
+		Payload:   map[string]any{"k": "v"},
 	}
 
 	validVersion := &domain.Version{
+		TemplateID:       10,
 		ProjectAuthorID:  1,
 		TemplateAuthorID: 2,
 		TemplateUsers:    nil,
 	}
 
+	validTask := domain.TaskToCreate{
+		VersionID: validIn.VersionID,
+		CreatorID: validIn.CreatorID,
+		Payload:   validIn.Payload,
+		Constants: map[string]string{},
+	}
+
 	tests := []struct {
 		name  string
-		setup func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, publisher *Mockpublisher)
+		setup func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, constantListService *MockconstantListService, publisher *Mockpublisher)
 		in    domain.TaskCreateIn
 		want  error
 	}{
 		{
 			name: "versionRepo_GetByID",
-			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, publisher *Mockpublisher) {
+			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, constantListService *MockconstantListService, publisher *Mockpublisher) {
 				versionRepo.EXPECT().GetByID(ctx, validIn.VersionID).Return(nil, testErr)
 			},
 			in:   validIn,
@@ -76,7 +103,7 @@ func TestUsecase_Handle_Error(t *testing.T) {
 		},
 		{
 			name: "versionRepo_GetByID_NotFound",
-			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, publisher *Mockpublisher) {
+			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, constantListService *MockconstantListService, publisher *Mockpublisher) {
 				versionRepo.EXPECT().GetByID(ctx, validIn.VersionID).Return(nil, nil)
 			},
 			in:   validIn,
@@ -84,7 +111,7 @@ func TestUsecase_Handle_Error(t *testing.T) {
 		},
 		{
 			name: "version_Invalid_NoPermission",
-			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, publisher *Mockpublisher) {
+			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, constantListService *MockconstantListService, publisher *Mockpublisher) {
 				version := &domain.Version{
 					ProjectAuthorID:  999,
 					TemplateAuthorID: 998,
@@ -97,7 +124,7 @@ func TestUsecase_Handle_Error(t *testing.T) {
 		},
 		{
 			name: "version_Invalid_WrongRole",
-			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, publisher *Mockpublisher) {
+			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, constantListService *MockconstantListService, publisher *Mockpublisher) {
 				version := &domain.Version{
 					ProjectAuthorID:  999,
 					TemplateAuthorID: 998,
@@ -110,20 +137,44 @@ func TestUsecase_Handle_Error(t *testing.T) {
 			in:   validIn,
 			want: domain.ErrVersionInvalid,
 		},
+		{
+			name: "version_OutputFormatInvalid",
+			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, constantListService *MockconstantListService, publisher *Mockpublisher) {
+				version := &domain.Version{
+					ProjectAuthorID:  1,
+					TemplateAuthorID: 2,
+					Kind:             template_domain.KindDOCX,
+				}
+				versionRepo.EXPECT().GetByID(ctx, validIn.VersionID).Return(version, nil)
+			},
+			in:   domain.TaskCreateIn{VersionID: 100, CreatorID: 1, OutputFormat: lo.ToPtr(output_domain.FormatPDF)},
+			want: domain.ErrOutputFormatInvalid,
+		},
+		{
+			name: "constantListService_Handle",
+			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, constantListService *MockconstantListService, publisher *Mockpublisher) {
+				versionRepo.EXPECT().GetByID(ctx, validIn.VersionID).Return(validVersion, nil)
+				constantListService.EXPECT().Handle(ctx, validVersion.TemplateID).Return(nil, testErr)
+			},
+			in:   validIn,
+			want: testErr,
+		},
 		{
 			name: "taskRepo_Insert",
-			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, publisher *Mockpublisher) {
+			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, constantListService *MockconstantListService, publisher *Mockpublisher) {
 				versionRepo.EXPECT().GetByID(ctx, validIn.VersionID).Return(validVersion, nil)
-				taskRepo.EXPECT().Insert(ctx, validIn).Return(int64(0), testErr)
+				constantListService.EXPECT().Handle(ctx, validVersion.TemplateID).Return(map[string]string{}, nil)
+				taskRepo.EXPECT().Insert(ctx, validTask).Return(int64(0), testErr)
 			},
 			in:   validIn,
 			want: testErr,
 		},
 		{
 			name: "publisher_PublishTaskCreated",
-			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, publisher *Mockpublisher) {
+			setup: func(versionRepo *MockversionRepository, taskRepo *MocktaskRepository, constantListService *MockconstantListService, publisher *Mockpublisher) {
 				versionRepo.EXPECT().GetByID(ctx, validIn.VersionID).Return(validVersion, nil)
-				taskRepo.EXPECT().Insert(ctx, validIn).Return(int64(50), nil)
+				constantListService.EXPECT().Handle(ctx, validVersion.TemplateID).Return(map[string]string{}, nil)
+				taskRepo.EXPECT().Insert(ctx, validTask).Return(int64(50), nil)
 				publisher.EXPECT().PublishTaskCreated(ctx, int64(50)).Return(testErr)
 			},
 			in:   validIn,
@@ -138,10 +189,11 @@ func TestUsecase_Handle_Error(t *testing.T) {
 
 			versionRepo := NewMockversionRepository(ctrl)
 			taskRepo := NewMocktaskRepository(ctrl)
+			constantListService := NewMockconstantListService(ctrl)
 			publisher := NewMockpublisher(ctrl)
-			tt.setup(versionRepo, taskRepo, publisher)
+			tt.setup(versionRepo, taskRepo, constantListService, publisher)
 
-			usecase := New(versionRepo, taskRepo, publisher)
+			usecase := New(versionRepo, taskRepo, constantListService, publisher)
 			err := usecase.Handle(ctx, tt.in)
 			require.ErrorIs(t, err, tt.want)
 		})
@@ -2,5 +2,5 @@ package domain
 
 type TaskGetByIDOut struct {
 	Task   Task
-	Result []byte
+	Result *Result
 }
@@ -0,0 +1,9 @@
+package domain
+
+import output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+
+type Result struct {
+	Data        []byte
+	Format      output_domain.Format
+	ContentType string
+}
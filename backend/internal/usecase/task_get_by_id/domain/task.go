@@ -16,10 +16,13 @@ type Task struct {
 	ID          int64
 	VersionID   int64
 	Status      task_domain.Status
-	Payload     map[string]string
+	Payload     map[string]any
 	ResultID    *int64
 	Error       *task_domain.ProcessError
+	Trace       []task_domain.VariableTrace
 	CreatorName string
+	Clock       time.Time
+	Constants   map[string]string
 	CreatedAt   time.Time
 	UpdatedAt   *time.Time
 }
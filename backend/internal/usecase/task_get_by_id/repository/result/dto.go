@@ -0,0 +1,20 @@
+package result_repository
+
+import (
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_get_by_id/domain"
+)
+
+type result struct {
+	Data        []byte `db:"data"`
+	Format      string `db:"format"`
+	ContentType string `db:"content_type"`
+}
+
+func (r result) toDomain() *domain.Result {
+	return &domain.Result{
+		Data:        r.Data,
+		Format:      output_domain.Format(r.Format),
+		ContentType: r.ContentType,
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_get_by_id/domain"
 )
 
 type Repository struct {
@@ -18,11 +20,11 @@ func New(db *sqlx.DB) *Repository {
 	}
 }
 
-func (r *Repository) GetDataByID(ctx context.Context, id int64) ([]byte, error) {
-	op := "result - insert"
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Result, error) {
+	op := "result - get by id"
 
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
-		Select("data").
+		Select("data", "format", "content_type").
 		From("result").
 		Where(sq.Eq{"id": id})
 
@@ -33,11 +35,11 @@ func (r *Repository) GetDataByID(ctx context.Context, id int64) ([]byte, error)
 
 	query = fmt.Sprintf("-- %s\n%s", op, query)
 
-	var data []byte
-	err = r.db.GetContext(ctx, &data, query, args...)
+	var dto result
+	err = r.db.GetContext(ctx, &dto, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("exec query %q: %w", op, err)
 	}
 
-	return data, nil
+	return dto.toDomain(), nil
 }
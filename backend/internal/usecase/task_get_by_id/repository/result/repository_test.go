@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_get_by_id/domain"
 )
 
 type repositorySuite struct {
@@ -18,7 +20,7 @@ func Test_repositorySuite(t *testing.T) {
 	suite.Run(t, new(repositorySuite))
 }
 
-func (s *repositorySuite) TestRepository_Insert() {
+func (s *repositorySuite) TestRepository_GetByID() {
 	ctx := context.Background()
 	repo := New(s.C().DB())
 
@@ -27,7 +29,13 @@ func (s *repositorySuite) TestRepository_Insert() {
 	require.NoError(s.T(), err)
 	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "result", resultID)) }()
 
-	got, err := repo.GetDataByID(ctx, resultID)
+	got, err := repo.GetByID(ctx, resultID)
 	require.NoError(s.T(), err)
-	require.Equal(s.T(), result.Data, got)
+
+	want := domain.Result{
+		Data:        result.Data,
+		Format:      output_domain.Format(result.Format),
+		ContentType: result.ContentType,
+	}
+	require.Equal(s.T(), want, *got)
 }
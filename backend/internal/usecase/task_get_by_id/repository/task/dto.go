@@ -1,6 +1,7 @@
 package task_repository
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"time"
@@ -16,7 +17,10 @@ type task struct {
 	Payload     payload    `db:"payload"`
 	ResultID    *int64     `db:"result_id"`
 	Error       *taskError `db:"error"`
+	Trace       taskTrace  `db:"trace"`
 	CreatorName string     `db:"creator_name"`
+	Clock       time.Time  `db:"clock"`
+	Constants   constants  `db:"constants"`
 	CreatedAt   time.Time  `db:"created_at"`
 	UpdatedAt   *time.Time `db:"updated_at"`
 }
@@ -29,13 +33,16 @@ func (t *task) toDomain() *domain.Task {
 		Payload:     t.Payload,
 		ResultID:    t.ResultID,
 		Error:       (*task_domain.ProcessError)(t.Error),
+		Trace:       t.Trace,
 		CreatorName: t.CreatorName,
+		Clock:       t.Clock,
+		Constants:   t.Constants,
 		CreatedAt:   t.CreatedAt,
 		UpdatedAt:   t.UpdatedAt,
 	}
 }
 
-type payload map[string]string
+type payload map[string]any
 
 func (p *payload) Scan(value any) error {
 	b, ok := value.([]byte)
@@ -43,7 +50,25 @@ func (p *payload) Scan(value any) error {
 		return errors.New("type assertion to []byte failed")
 	}
 
-	return json.Unmarshal(b, &p)
+	// keep numbers as json.Number so that large integers are not rounded
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	return decoder.Decode(&p)
+}
+
+type constants map[string]string
+
+func (c *constants) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &c)
 }
 
 type taskError task_domain.ProcessError
@@ -56,3 +81,18 @@ func (e *taskError) Scan(value any) error {
 
 	return json.Unmarshal(b, &e)
 }
+
+type taskTrace []task_domain.VariableTrace
+
+func (t *taskTrace) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &t)
+}
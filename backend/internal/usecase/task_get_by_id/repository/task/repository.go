@@ -33,7 +33,10 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Task, error
 			"t.payload",
 			"t.result_id",
 			"t.error",
+			"t.trace",
 			"u.name as creator_name",
+			"t.clock",
+			"t.constants",
 			"t.created_at",
 			"t.updated_at",
 		).
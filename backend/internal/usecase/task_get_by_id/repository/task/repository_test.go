@@ -63,7 +63,7 @@ func (s *repositorySuite) TestRepository_GetByID() {
 			ID:        gofakeit.Int64(),
 			VersionID: versionID,
 			Status:    task_domain.StatusInProgress,
-			Payload: map[string]string{
+			Payload: map[string]any{
 				"test1": "123",
 				"test2": "456.789",
 				"test3": "text",
@@ -86,7 +86,21 @@ func (s *repositorySuite) TestRepository_GetByID() {
 					},
 				},
 			},
+			Trace: []task_domain.VariableTrace{
+				{
+					Order:        1,
+					Name:         "name4",
+					Source:       task_domain.TraceSourceExpression,
+					Expression:   "name5 + 1",
+					Dependencies: []task_domain.TraceDependency{{Name: "name5", Value: "1", Type: "int64"}},
+					Value:        "2",
+					Type:         "int",
+					Constraints:  []task_domain.ConstraintTrace{{Name: "name6", Expression: "name4 > 0", Passed: true}},
+				},
+			},
 			CreatorName: user.Name,
+			Clock:       gofakeit.Date().Truncate(1 * time.Microsecond),
+			Constants:   map[string]string{"name7": "value7"},
 			CreatedAt:   gofakeit.Date().Truncate(1 * time.Microsecond),
 			UpdatedAt:   lo.ToPtr(gofakeit.Date().Truncate(1 * time.Microsecond)),
 		}
@@ -97,6 +111,12 @@ func (s *repositorySuite) TestRepository_GetByID() {
 		taskError, err := json.Marshal(want.Error)
 		require.NoError(t, err)
 
+		taskTrace, err := json.Marshal(want.Trace)
+		require.NoError(t, err)
+
+		taskConstants, err := json.Marshal(want.Constants)
+		require.NoError(t, err)
+
 		// task
 		task := test_db.Task{
 			ID:        want.ID,
@@ -105,6 +125,10 @@ func (s *repositorySuite) TestRepository_GetByID() {
 			Payload:   payload,
 			ResultID:  &resultID,
 			Error:     taskError,
+			IsTraced:  true,
+			Trace:     taskTrace,
+			Clock:     want.Clock,
+			Constants: taskConstants,
 			CreatorID: userID,
 			CreatedAt: want.CreatedAt,
 			UpdatedAt: want.UpdatedAt,
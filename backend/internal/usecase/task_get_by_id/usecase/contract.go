@@ -17,5 +17,5 @@ type versionRepository interface {
 }
 
 type resultRepository interface {
-	GetDataByID(ctx context.Context, id int64) ([]byte, error)
+	GetByID(ctx context.Context, id int64) (*domain.Result, error)
 }
@@ -119,17 +119,17 @@ func (m *MockresultRepository) EXPECT() *MockresultRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GetDataByID mocks base method.
-func (m *MockresultRepository) GetDataByID(ctx context.Context, id int64) ([]byte, error) {
+// GetByID mocks base method.
+func (m *MockresultRepository) GetByID(ctx context.Context, id int64) (*domain.Result, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDataByID", ctx, id)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Result)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDataByID indicates an expected call of GetDataByID.
-func (mr *MockresultRepositoryMockRecorder) GetDataByID(ctx, id any) *gomock.Call {
+// GetByID indicates an expected call of GetByID.
+func (mr *MockresultRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataByID", reflect.TypeOf((*MockresultRepository)(nil).GetDataByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockresultRepository)(nil).GetByID), ctx, id)
 }
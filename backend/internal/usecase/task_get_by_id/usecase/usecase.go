@@ -43,9 +43,9 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TaskGetByIDIn) (*domain.
 		return &domain.TaskGetByIDOut{Task: *task, Result: nil}, nil
 	}
 
-	result, err := u.resultRepo.GetDataByID(ctx, *task.ResultID)
+	result, err := u.resultRepo.GetByID(ctx, *task.ResultID)
 	if err != nil {
-		return nil, fmt.Errorf("result repo - get data by id: %w", err)
+		return nil, fmt.Errorf("result repo - get by id: %w", err)
 	}
 
 	return &domain.TaskGetByIDOut{Task: *task, Result: result}, nil
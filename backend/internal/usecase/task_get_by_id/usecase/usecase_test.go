@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_get_by_id/domain"
@@ -33,7 +34,7 @@ func TestUsecase_Handle_Success(t *testing.T) {
 					ID:          1,
 					VersionID:   2,
 					Status:      task_domain.StatusSucceed,
-					Payload:     map[string]string{"test": "1"},
+					Payload:     map[string]any{"test": "1"},
 					ResultID:    lo.ToPtr[int64](3),
 					CreatorName: "test",
 				}
@@ -42,19 +43,19 @@ func TestUsecase_Handle_Success(t *testing.T) {
 				version := domain.Version{ProjectAuthorID: in.UserID}
 				versionRepo.EXPECT().GetByID(ctx, task.VersionID).Return(&version, nil)
 
-				result := []byte{1, 2, 3}
-				resultRepo.EXPECT().GetDataByID(ctx, *task.ResultID).Return(result, nil)
+				result := domain.Result{Data: []byte{1, 2, 3}, Format: output_domain.FormatMarkdown, ContentType: "text/markdown; charset=utf-8"}
+				resultRepo.EXPECT().GetByID(ctx, *task.ResultID).Return(&result, nil)
 			},
 			want: &domain.TaskGetByIDOut{
 				Task: domain.Task{
 					ID:          1,
 					VersionID:   2,
 					Status:      task_domain.StatusSucceed,
-					Payload:     map[string]string{"test": "1"},
+					Payload:     map[string]any{"test": "1"},
 					ResultID:    lo.ToPtr[int64](3),
 					CreatorName: "test",
 				},
-				Result: []byte{1, 2, 3},
+				Result: &domain.Result{Data: []byte{1, 2, 3}, Format: output_domain.FormatMarkdown, ContentType: "text/markdown; charset=utf-8"},
 			},
 		},
 		{
@@ -64,7 +65,7 @@ func TestUsecase_Handle_Success(t *testing.T) {
 					ID:          1,
 					VersionID:   2,
 					Status:      task_domain.StatusFailed,
-					Payload:     map[string]string{"test": "1"},
+					Payload:     map[string]any{"test": "1"},
 					Error:       &task_domain.ProcessError{Message: "test"},
 					CreatorName: "test",
 				}
@@ -78,7 +79,7 @@ func TestUsecase_Handle_Success(t *testing.T) {
 					ID:          1,
 					VersionID:   2,
 					Status:      task_domain.StatusFailed,
-					Payload:     map[string]string{"test": "1"},
+					Payload:     map[string]any{"test": "1"},
 					Error:       &task_domain.ProcessError{Message: "test"},
 					CreatorName: "test",
 				},
@@ -229,7 +230,7 @@ func TestUsecase_Handle_Error(t *testing.T) {
 			want: "test2",
 		},
 		{
-			name: "resultRepo_GetDataByID",
+			name: "resultRepo_GetByID",
 			setup: func(taskRepo *MocktaskRepository, versionRepo *MockversionRepository, resultRepo *MockresultRepository) {
 				task := domain.Task{
 					ID:          1,
@@ -243,7 +244,7 @@ func TestUsecase_Handle_Error(t *testing.T) {
 				version := domain.Version{ProjectAuthorID: in.UserID}
 				versionRepo.EXPECT().GetByID(ctx, task.VersionID).Return(&version, nil)
 
-				resultRepo.EXPECT().GetDataByID(ctx, *task.ResultID).Return(nil, errors.New("test3"))
+				resultRepo.EXPECT().GetByID(ctx, *task.ResultID).Return(nil, errors.New("test3"))
 			},
 			want: "test3",
 		},
@@ -1,6 +1,11 @@
 package domain
 
-import version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
+import (
+	dictionary_list_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list/domain"
+	output_render_domain "github.com/qsoulior/tech-generator/backend/internal/service/output_render/domain"
+	version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
+	version_render_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_render/domain"
+)
 
 type TaskProcessIn struct {
 	TaskID int64
@@ -8,16 +13,10 @@ type TaskProcessIn struct {
 
 type Version = version_get_domain.Version
 
-type Variable = version_get_domain.Variable
+type VersionRenderIn = version_render_domain.VersionRenderIn
 
-type Constraint = version_get_domain.Constraint
+type DictionaryListIn = dictionary_list_domain.DictionaryListIn
 
-type VariableProcessIn struct {
-	Variables []Variable
-	Payload   map[string]string
-}
+type OutputRenderIn = output_render_domain.OutputRenderIn
 
-type DataProcessIn struct {
-	Values map[string]any
-	Data   []byte
-}
+type Output = output_render_domain.Output
@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"time"
+
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 )
 
@@ -9,7 +12,14 @@ var ErrTaskNotFound = error_domain.NewBaseError("task not found")
 
 type Task struct {
 	VersionID int64
-	Payload   map[string]string
+	Payload   map[string]any
+	IsTraced  bool
+	Clock     time.Time
+	// Constants are the project constants the task is rendered with, kept
+	// when it is created.
+	Constants map[string]string
+	// OutputFormat overrides the output format of the template unless nil.
+	OutputFormat *output_domain.Format
 }
 
 type TaskUpdate struct {
@@ -17,4 +27,5 @@ type TaskUpdate struct {
 	Status   task_domain.Status
 	ResultID *int64
 	Error    *task_domain.ProcessError
+	Trace    []task_domain.VariableTrace
 }
@@ -1,21 +1,34 @@
 package task_process_usecase
 
 import (
+	"log/slog"
+
 	"github.com/jmoiron/sqlx"
 
+	"github.com/qsoulior/tech-generator/backend/internal/config"
+	data_process_service "github.com/qsoulior/tech-generator/backend/internal/service/data_process"
+	dictionary_list_service "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list"
+	output_render_service "github.com/qsoulior/tech-generator/backend/internal/service/output_render"
+	variable_process_service "github.com/qsoulior/tech-generator/backend/internal/service/variable_process"
 	version_get_service "github.com/qsoulior/tech-generator/backend/internal/service/version_get"
+	version_render_service "github.com/qsoulior/tech-generator/backend/internal/service/version_render"
 	result_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/repository/result"
 	task_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/repository/task"
-	data_process_service "github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/service/data_process"
-	variable_process_service "github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/service/variable_process"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/usecase"
 )
 
-func New(db *sqlx.DB) *usecase.Usecase {
+func New(
+	db *sqlx.DB,
+	cfg *config.WorkerConfig,
+	variableCache *variable_process_service.Cache,
+	templateCache *data_process_service.Cache,
+	logger *slog.Logger,
+) *usecase.Usecase {
 	taskRepo := task_repository.New(db)
 	versionGetService := version_get_service.New(db)
-	variableProcessService := variable_process_service.New()
-	dataProcessService := data_process_service.New()
+	dictionaryListService := dictionary_list_service.New(db)
+	versionRenderService := version_render_service.NewCached(variableCache, templateCache, cfg.WorkerTaskTimeout, cfg.WorkerMaxOutputBytes, cfg.WorkerBudget)
+	outputRenderService := output_render_service.New()
 	resultRepo := result_repository.New(db)
-	return usecase.New(taskRepo, versionGetService, variableProcessService, dataProcessService, resultRepo)
+	return usecase.New(taskRepo, versionGetService, dictionaryListService, versionRenderService, outputRenderService, resultRepo, logger)
 }
@@ -6,6 +6,8 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/domain"
 )
 
 type Repository struct {
@@ -18,13 +20,13 @@ func New(db *sqlx.DB) *Repository {
 	}
 }
 
-func (r *Repository) Insert(ctx context.Context, data []byte) (int64, error) {
+func (r *Repository) Insert(ctx context.Context, result domain.Output) (int64, error) {
 	op := "result - insert"
 
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Insert("result").
-		Columns("data").
-		Values(data).
+		Columns("data", "format", "content_type").
+		Values(result.Data, result.Format, result.ContentType).
 		Suffix("RETURNING id")
 
 	query, args, err := builder.ToSql()
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/domain"
 )
 
 type repositorySuite struct {
@@ -22,13 +24,24 @@ func (s *repositorySuite) TestRepository_Insert() {
 	ctx := context.Background()
 	repo := New(s.C().DB())
 
-	data := []byte{1, 2, 3}
-	resultID, err := repo.Insert(ctx, data)
+	result := domain.Output{
+		Data:        []byte{1, 2, 3},
+		Format:      output_domain.FormatDOCX,
+		ContentType: output_domain.FormatDOCX.ContentType(),
+	}
+	resultID, err := repo.Insert(ctx, result)
 	require.NoError(s.T(), err)
 	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "result", resultID)) }()
 
 	gotResults, err := test_db.SelectEntitiesByID[test_db.Result](s.C(), "result", []int64{resultID})
 	require.NoError(s.T(), err)
 	require.Len(s.T(), gotResults, 1)
-	require.Equal(s.T(), data, gotResults[0].Data)
+
+	want := test_db.Result{
+		ID:          resultID,
+		Data:        result.Data,
+		Format:      string(result.Format),
+		ContentType: result.ContentType,
+	}
+	require.Equal(s.T(), want, gotResults[0])
 }
@@ -1,20 +1,27 @@
 package task_repository
 
 import (
+	"bytes"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"time"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/domain"
 )
 
 type task struct {
-	VersionID int64   `db:"version_id"`
-	Payload   payload `db:"payload"`
+	VersionID    int64     `db:"version_id"`
+	Payload      payload   `db:"payload"`
+	IsTraced     bool      `db:"is_traced"`
+	Clock        time.Time `db:"clock"`
+	Constants    constants `db:"constants"`
+	OutputFormat *string   `db:"output_format"`
 }
 
-type payload map[string]string
+type payload map[string]any
 
 func (p *payload) Scan(value any) error {
 	b, ok := value.([]byte)
@@ -22,16 +29,40 @@ func (p *payload) Scan(value any) error {
 		return errors.New("type assertion to []byte failed")
 	}
 
-	return json.Unmarshal(b, &p)
+	// keep numbers as json.Number so that large integers are not rounded
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	return decoder.Decode(&p)
 }
 
 func (t *task) toDomain() *domain.Task {
 	return &domain.Task{
-		VersionID: t.VersionID,
-		Payload:   t.Payload,
+		VersionID:    t.VersionID,
+		Payload:      t.Payload,
+		IsTraced:     t.IsTraced,
+		Clock:        t.Clock,
+		Constants:    t.Constants,
+		OutputFormat: (*output_domain.Format)(t.OutputFormat),
 	}
 }
 
+// constants are nil for a task created before they were kept with tasks.
+type constants map[string]string
+
+func (c *constants) Scan(value any) error {
+	if value == nil {
+		*c = nil
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, c)
+}
+
 type taskError task_domain.ProcessError
 
 func (e *taskError) Value() (driver.Value, error) {
@@ -41,3 +72,13 @@ func (e *taskError) Value() (driver.Value, error) {
 
 	return json.Marshal(e)
 }
+
+type taskTrace []task_domain.VariableTrace
+
+func (t taskTrace) Value() (driver.Value, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(t)
+}
@@ -29,6 +29,10 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Task, error
 		Select(
 			"version_id",
 			"payload",
+			"is_traced",
+			"clock",
+			"constants",
+			"output_format",
 		).
 		From("task").
 		Where(sq.Eq{"id": id})
@@ -55,14 +59,17 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Task, error
 func (r *Repository) UpdateByID(ctx context.Context, task domain.TaskUpdate) error {
 	op := "task - update by id"
 
+	values := map[string]any{
+		"status":     task.Status,
+		"result_id":  task.ResultID,
+		"error":      (*taskError)(task.Error),
+		"trace":      taskTrace(task.Trace),
+		"updated_at": sq.Expr("now() AT TIME ZONE 'utc'"),
+	}
+
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Update("task").
-		SetMap(map[string]any{
-			"status":     task.Status,
-			"result_id":  task.ResultID,
-			"error":      (*taskError)(task.Error),
-			"updated_at": sq.Expr("now() AT TIME ZONE 'utc'"),
-		}).
+		SetMap(values).
 		Where(sq.Eq{"id": task.ID})
 
 	query, args, err := builder.ToSql()
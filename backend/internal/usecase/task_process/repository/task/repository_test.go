@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/domain"
@@ -53,16 +56,23 @@ func (s *repositorySuite) TestRepository_GetByID() {
 
 		want := domain.Task{
 			VersionID: versionID,
-			Payload: map[string]string{
+			Payload: map[string]any{
 				"test1": "123",
 				"test2": "456.789",
 				"test3": "text",
 			},
+			IsTraced:     true,
+			Clock:        gofakeit.Date().Truncate(1 * time.Microsecond),
+			Constants:    map[string]string{"org_name": "ООО Ромашка"},
+			OutputFormat: lo.ToPtr(output_domain.FormatPDF),
 		}
 
 		payload, err := json.Marshal(want.Payload)
 		require.NoError(t, err)
 
+		constants, err := json.Marshal(want.Constants)
+		require.NoError(t, err)
+
 		// task
 		task := test_db.GenerateEntity(func(t *test_db.Task) {
 			t.CreatorID = userID
@@ -70,6 +80,10 @@ func (s *repositorySuite) TestRepository_GetByID() {
 			t.ResultID = nil
 			t.Payload = payload
 			t.Error = nil
+			t.IsTraced = true
+			t.Clock = want.Clock
+			t.Constants = constants
+			t.OutputFormat = lo.ToPtr("pdf")
 		})
 		taskID, err := test_db.InsertEntityWithID[int64](s.C(), "task", task)
 		require.NoError(t, err)
@@ -124,6 +138,9 @@ func (s *repositorySuite) TestRepository_UpdateByID() {
 		t.ResultID = nil
 		t.Payload = []byte("{\"test\":123}")
 		t.Error = nil
+		t.IsTraced = true
+		t.Constants = []byte("{\"org_name\": \"ООО Ромашка\"}")
+		t.OutputFormat = nil
 	})
 	taskID, err := test_db.InsertEntityWithID[int64](s.C(), "task", task)
 	require.NoError(s.T(), err)
@@ -141,6 +158,7 @@ func (s *repositorySuite) TestRepository_UpdateByID() {
 		Status:   task_domain.StatusSucceed,
 		ResultID: &resultID,
 		Error:    &task_domain.ProcessError{Message: "123"},
+		Trace:    []task_domain.VariableTrace{{Order: 1, Name: "test"}},
 	}
 	err = repo.UpdateByID(ctx, taskUpdate)
 	require.NoError(s.T(), err)
@@ -158,7 +176,11 @@ func (s *repositorySuite) TestRepository_UpdateByID() {
 		Payload:   []byte("{\"test\": 123}"),
 		ResultID:  &resultID,
 		Error:     []byte("{\"message\": \"123\"}"),
+		IsTraced:  true,
+		Trace:     []byte("[{\"name\": \"test\", \"order\": 1}]"),
+		Constants: []byte("{\"org_name\": \"ООО Ромашка\"}"),
 		CreatorID: userID,
+		Clock:     got.Clock,
 		CreatedAt: got.CreatedAt,
 		UpdatedAt: got.UpdatedAt,
 	}
@@ -188,7 +210,11 @@ func (s *repositorySuite) TestRepository_UpdateByID() {
 		Payload:   []byte("{\"test\": 123}"),
 		ResultID:  nil,
 		Error:     nil,
+		IsTraced:  true,
+		Trace:     nil,
+		Constants: []byte("{\"org_name\": \"ООО Ромашка\"}"),
 		CreatorID: userID,
+		Clock:     got.Clock,
 		CreatedAt: got.CreatedAt,
 		UpdatedAt: got.UpdatedAt,
 	}
@@ -5,6 +5,8 @@ package usecase
 import (
 	"context"
 
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 	version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/domain"
 )
@@ -18,14 +20,18 @@ type versionGetService interface {
 	Handle(ctx context.Context, versionID int64) (*version_get_domain.Version, error)
 }
 
-type variableProcessService interface {
-	Handle(ctx context.Context, in domain.VariableProcessIn) (map[string]any, error)
+type dictionaryListService interface {
+	Handle(ctx context.Context, in domain.DictionaryListIn) ([]dictionary_domain.Dictionary, error)
 }
 
-type dataProcessService interface {
-	Handle(ctx context.Context, in domain.DataProcessIn) ([]byte, error)
+type versionRenderService interface {
+	Handle(ctx context.Context, in domain.VersionRenderIn) ([]byte, []task_domain.VariableTrace, error)
+}
+
+type outputRenderService interface {
+	Handle(ctx context.Context, in domain.OutputRenderIn) (*domain.Output, error)
 }
 
 type resultRepository interface {
-	Insert(ctx context.Context, data []byte) (int64, error)
+	Insert(ctx context.Context, result domain.Output) (int64, error)
 }
@@ -13,6 +13,8 @@ import (
 	context "context"
 	reflect "reflect"
 
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 	domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
 	domain0 "github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -110,82 +112,122 @@ func (mr *MockversionGetServiceMockRecorder) Handle(ctx, versionID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockversionGetService)(nil).Handle), ctx, versionID)
 }
 
-// MockvariableProcessService is a mock of variableProcessService interface.
-type MockvariableProcessService struct {
+// MockdictionaryListService is a mock of dictionaryListService interface.
+type MockdictionaryListService struct {
 	ctrl     *gomock.Controller
-	recorder *MockvariableProcessServiceMockRecorder
+	recorder *MockdictionaryListServiceMockRecorder
 	isgomock struct{}
 }
 
-// MockvariableProcessServiceMockRecorder is the mock recorder for MockvariableProcessService.
-type MockvariableProcessServiceMockRecorder struct {
-	mock *MockvariableProcessService
+// MockdictionaryListServiceMockRecorder is the mock recorder for MockdictionaryListService.
+type MockdictionaryListServiceMockRecorder struct {
+	mock *MockdictionaryListService
 }
 
-// NewMockvariableProcessService creates a new mock instance.
-func NewMockvariableProcessService(ctrl *gomock.Controller) *MockvariableProcessService {
-	mock := &MockvariableProcessService{ctrl: ctrl}
-	mock.recorder = &MockvariableProcessServiceMockRecorder{mock}
+// NewMockdictionaryListService creates a new mock instance.
+func NewMockdictionaryListService(ctrl *gomock.Controller) *MockdictionaryListService {
+	mock := &MockdictionaryListService{ctrl: ctrl}
+	mock.recorder = &MockdictionaryListServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockvariableProcessService) EXPECT() *MockvariableProcessServiceMockRecorder {
+func (m *MockdictionaryListService) EXPECT() *MockdictionaryListServiceMockRecorder {
 	return m.recorder
 }
 
 // Handle mocks base method.
-func (m *MockvariableProcessService) Handle(ctx context.Context, in domain0.VariableProcessIn) (map[string]any, error) {
+func (m *MockdictionaryListService) Handle(ctx context.Context, in domain0.DictionaryListIn) ([]dictionary_domain.Dictionary, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Handle", ctx, in)
-	ret0, _ := ret[0].(map[string]any)
+	ret0, _ := ret[0].([]dictionary_domain.Dictionary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Handle indicates an expected call of Handle.
-func (mr *MockvariableProcessServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
+func (mr *MockdictionaryListServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockvariableProcessService)(nil).Handle), ctx, in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockdictionaryListService)(nil).Handle), ctx, in)
 }
 
-// MockdataProcessService is a mock of dataProcessService interface.
-type MockdataProcessService struct {
+// MockversionRenderService is a mock of versionRenderService interface.
+type MockversionRenderService struct {
 	ctrl     *gomock.Controller
-	recorder *MockdataProcessServiceMockRecorder
+	recorder *MockversionRenderServiceMockRecorder
 	isgomock struct{}
 }
 
-// MockdataProcessServiceMockRecorder is the mock recorder for MockdataProcessService.
-type MockdataProcessServiceMockRecorder struct {
-	mock *MockdataProcessService
+// MockversionRenderServiceMockRecorder is the mock recorder for MockversionRenderService.
+type MockversionRenderServiceMockRecorder struct {
+	mock *MockversionRenderService
 }
 
-// NewMockdataProcessService creates a new mock instance.
-func NewMockdataProcessService(ctrl *gomock.Controller) *MockdataProcessService {
-	mock := &MockdataProcessService{ctrl: ctrl}
-	mock.recorder = &MockdataProcessServiceMockRecorder{mock}
+// NewMockversionRenderService creates a new mock instance.
+func NewMockversionRenderService(ctrl *gomock.Controller) *MockversionRenderService {
+	mock := &MockversionRenderService{ctrl: ctrl}
+	mock.recorder = &MockversionRenderServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdataProcessService) EXPECT() *MockdataProcessServiceMockRecorder {
+func (m *MockversionRenderService) EXPECT() *MockversionRenderServiceMockRecorder {
 	return m.recorder
 }
 
 // Handle mocks base method.
-func (m *MockdataProcessService) Handle(ctx context.Context, in domain0.DataProcessIn) ([]byte, error) {
+func (m *MockversionRenderService) Handle(ctx context.Context, in domain0.VersionRenderIn) ([]byte, []task_domain.VariableTrace, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Handle", ctx, in)
 	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].([]task_domain.VariableTrace)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockversionRenderServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockversionRenderService)(nil).Handle), ctx, in)
+}
+
+// MockoutputRenderService is a mock of outputRenderService interface.
+type MockoutputRenderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutputRenderServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockoutputRenderServiceMockRecorder is the mock recorder for MockoutputRenderService.
+type MockoutputRenderServiceMockRecorder struct {
+	mock *MockoutputRenderService
+}
+
+// NewMockoutputRenderService creates a new mock instance.
+func NewMockoutputRenderService(ctrl *gomock.Controller) *MockoutputRenderService {
+	mock := &MockoutputRenderService{ctrl: ctrl}
+	mock.recorder = &MockoutputRenderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutputRenderService) EXPECT() *MockoutputRenderServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockoutputRenderService) Handle(ctx context.Context, in domain0.OutputRenderIn) (*domain0.Output, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, in)
+	ret0, _ := ret[0].(*domain0.Output)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Handle indicates an expected call of Handle.
-func (mr *MockdataProcessServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
+func (mr *MockoutputRenderServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockdataProcessService)(nil).Handle), ctx, in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockoutputRenderService)(nil).Handle), ctx, in)
 }
 
 // MockresultRepository is a mock of resultRepository interface.
@@ -213,16 +255,16 @@ func (m *MockresultRepository) EXPECT() *MockresultRepositoryMockRecorder {
 }
 
 // Insert mocks base method.
-func (m *MockresultRepository) Insert(ctx context.Context, data []byte) (int64, error) {
+func (m *MockresultRepository) Insert(ctx context.Context, result domain0.Output) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Insert", ctx, data)
+	ret := m.ctrl.Call(m, "Insert", ctx, result)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Insert indicates an expected call of Insert.
-func (mr *MockresultRepositoryMockRecorder) Insert(ctx, data any) *gomock.Call {
+func (mr *MockresultRepositoryMockRecorder) Insert(ctx, result any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockresultRepository)(nil).Insert), ctx, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockresultRepository)(nil).Insert), ctx, result)
 }
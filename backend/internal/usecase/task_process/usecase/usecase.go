@@ -4,32 +4,40 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/domain"
 )
 
 type Usecase struct {
-	taskRepo               taskRepository
-	versionGetService      versionGetService
-	variableProcessService variableProcessService
-	dataProcessService     dataProcessService
-	resultRepo             resultRepository
+	taskRepo              taskRepository
+	versionGetService     versionGetService
+	dictionaryListService dictionaryListService
+	versionRenderService  versionRenderService
+	outputRenderService   outputRenderService
+	resultRepo            resultRepository
+	logger                *slog.Logger
 }
 
 func New(
 	taskRepo taskRepository,
 	versionGetService versionGetService,
-	variableProcessService variableProcessService,
-	dataProcessService dataProcessService,
+	dictionaryListService dictionaryListService,
+	versionRenderService versionRenderService,
+	outputRenderService outputRenderService,
 	resultRepo resultRepository,
+	logger *slog.Logger,
 ) *Usecase {
 	return &Usecase{
-		taskRepo:               taskRepo,
-		versionGetService:      versionGetService,
-		variableProcessService: variableProcessService,
-		dataProcessService:     dataProcessService,
-		resultRepo:             resultRepo,
+		taskRepo:              taskRepo,
+		versionGetService:     versionGetService,
+		dictionaryListService: dictionaryListService,
+		versionRenderService:  versionRenderService,
+		outputRenderService:   outputRenderService,
+		resultRepo:            resultRepo,
+		logger:                logger,
 	}
 }
 
@@ -52,12 +60,12 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TaskProcessIn) error {
 	}
 
 	// handle task
-	resultID, err := u.handleTask(ctx, *task)
+	resultID, trace, err := u.handleTask(ctx, in.TaskID, task)
 	if err != nil {
 		var processErr *task_domain.ProcessError
 		if errors.As(err, &processErr) {
 			// update task
-			taskUpdate = domain.TaskUpdate{ID: in.TaskID, Status: task_domain.StatusFailed, Error: processErr}
+			taskUpdate = domain.TaskUpdate{ID: in.TaskID, Status: task_domain.StatusFailed, Error: processErr, Trace: trace}
 			err = u.taskRepo.UpdateByID(ctx, taskUpdate)
 			if err != nil {
 				return fmt.Errorf("task repo - update by id: %w", err)
@@ -68,7 +76,7 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TaskProcessIn) error {
 	}
 
 	// update task
-	taskUpdate = domain.TaskUpdate{ID: in.TaskID, Status: task_domain.StatusSucceed, ResultID: &resultID}
+	taskUpdate = domain.TaskUpdate{ID: in.TaskID, Status: task_domain.StatusSucceed, ResultID: &resultID, Trace: trace}
 	err = u.taskRepo.UpdateByID(ctx, taskUpdate)
 	if err != nil {
 		return fmt.Errorf("task repo - update by id: %w", err)
@@ -77,38 +85,70 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TaskProcessIn) error {
 	return nil
 }
 
-func (u *Usecase) handleTask(ctx context.Context, task domain.Task) (int64, error) {
+func (u *Usecase) handleTask(ctx context.Context, taskID int64, task *domain.Task) (int64, []task_domain.VariableTrace, error) {
 	// get version
 	version, err := u.versionGetService.Handle(ctx, task.VersionID)
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
-	// process variables
-	variableProcessIn := domain.VariableProcessIn{
-		Variables: version.Variables,
-		Payload:   task.Payload,
+	// get dictionaries as of the task clock
+	dictionaryListIn := domain.DictionaryListIn{TemplateID: version.TemplateID, At: task.Clock}
+	dictionaries, err := u.dictionaryListService.Handle(ctx, dictionaryListIn)
+	if err != nil {
+		return 0, nil, fmt.Errorf("dictionary list service - handle: %w", err)
+	}
+
+	// the output format of the task or the template
+	format := version.OutputFormat
+	if task.OutputFormat != nil {
+		format = *task.OutputFormat
+	}
+
+	// render version
+	versionRenderIn := domain.VersionRenderIn{
+		VersionID:    version.ID,
+		Data:         version.Data,
+		Kind:         version.Kind,
+		Variables:    version.Variables,
+		Dependencies: version.Dependencies,
+		Payload:      task.Payload,
+		Functions:    version.Functions,
+		Partials:     version.Partials,
+		Dictionaries: dictionaries,
+		Constants:    task.Constants,
+		Trace:        task.IsTraced,
+		Now:          task.Clock,
+		MarkValues:   format == output_domain.FormatXLSX,
 	}
-	variableValues, err := u.variableProcessService.Handle(ctx, variableProcessIn)
+	result, trace, err := u.versionRenderService.Handle(ctx, versionRenderIn)
 	if err != nil {
-		return 0, err
+		return 0, trace, err
 	}
 
-	// process data
-	dataProcessIn := domain.DataProcessIn{
-		Values: variableValues,
-		Data:   version.Data,
+	// convert result to the output format
+	outputRenderIn := domain.OutputRenderIn{
+		Format:       format,
+		StyleProfile: version.StyleProfile,
+		Code:         task.Constants[output_domain.CodeConstant],
+		Kind:         version.Kind,
+		Data:         result,
 	}
-	result, err := u.dataProcessService.Handle(ctx, dataProcessIn)
+	output, err := u.outputRenderService.Handle(ctx, outputRenderIn)
 	if err != nil {
-		return 0, err
+		// the task keeps only the user-facing message, so the cause is logged
+		u.logger.Error("output render service - handle",
+			slog.Int64("task_id", taskID),
+			slog.String("err", err.Error()),
+		)
+		return 0, trace, &task_domain.ProcessError{Message: task_domain.MessageOutputRender}
 	}
 
 	// insert result
-	resultID, err := u.resultRepo.Insert(ctx, result)
+	resultID, err := u.resultRepo.Insert(ctx, *output)
 	if err != nil {
-		return 0, fmt.Errorf("result repo - insert: %w", err)
+		return 0, nil, fmt.Errorf("result repo - insert: %w", err)
 	}
 
-	return resultID, nil
+	return resultID, trace, nil
 }
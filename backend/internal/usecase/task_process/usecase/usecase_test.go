@@ -1,14 +1,19 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"log/slog"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/task_process/domain"
 )
@@ -19,13 +24,16 @@ func TestUsecase_Handle_Success(t *testing.T) {
 
 	tests := []struct {
 		name  string
-		setup func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository)
+		setup func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository)
 	}{
 		{
 			name: "Success",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				var task domain.Task
 				_ = gofakeit.Struct(&task)
+				task.Constants = map[string]string{"org_name": gofakeit.Company()}
+				task.IsTraced = false
+				task.OutputFormat = nil
 
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&task, nil)
 
@@ -36,26 +44,46 @@ func TestUsecase_Handle_Success(t *testing.T) {
 				_ = gofakeit.Struct(&version)
 				versionGetService.EXPECT().Handle(ctx, task.VersionID).Return(&version, nil)
 
-				variableProcessIn := domain.VariableProcessIn{Variables: version.Variables, Payload: task.Payload}
-				variableValues := gofakeit.Map()
-				variableProcessService.EXPECT().Handle(ctx, variableProcessIn).Return(variableValues, nil)
+				var dictionaries []dictionary_domain.Dictionary
+				gofakeit.Slice(&dictionaries)
+				dictionaryListIn := domain.DictionaryListIn{TemplateID: version.TemplateID, At: task.Clock}
+				dictionaryListService.EXPECT().Handle(ctx, dictionaryListIn).Return(dictionaries, nil)
 
-				dataProcessIn := domain.DataProcessIn{Values: variableValues, Data: version.Data}
+				versionRenderIn := domain.VersionRenderIn{
+					VersionID:    version.ID,
+					Data:         version.Data,
+					Kind:         version.Kind,
+					Variables:    version.Variables,
+					Dependencies: version.Dependencies,
+					Payload:      task.Payload,
+					Functions:    version.Functions,
+					Partials:     version.Partials,
+					Dictionaries: dictionaries,
+					Constants:    task.Constants,
+					Now:          task.Clock,
+				}
 				result := []byte{1, 2, 3}
-				dataProcessService.EXPECT().Handle(ctx, dataProcessIn).Return(result, nil)
+				versionRenderService.EXPECT().Handle(ctx, versionRenderIn).Return(result, nil, nil)
+
+				outputRenderIn := domain.OutputRenderIn{Format: version.OutputFormat, StyleProfile: version.StyleProfile, Kind: version.Kind, Data: result}
+				output := domain.Output{Data: []byte{4, 5, 6}, Format: output_domain.FormatDOCX, ContentType: output_domain.FormatDOCX.ContentType()}
+				outputRenderService.EXPECT().Handle(ctx, outputRenderIn).Return(&output, nil)
 
 				resultID := gofakeit.Int64()
-				resultRepo.EXPECT().Insert(ctx, result).Return(resultID, nil)
+				resultRepo.EXPECT().Insert(ctx, output).Return(resultID, nil)
 
 				taskUpdate = domain.TaskUpdate{ID: taskID, Status: task_domain.StatusSucceed, ResultID: &resultID}
 				taskRepo.EXPECT().UpdateByID(ctx, taskUpdate).Return(nil)
 			},
 		},
 		{
-			name: "variableProcessService_ProcessError",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			name: "TaskOutputFormat",
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				var task domain.Task
 				_ = gofakeit.Struct(&task)
+				task.Constants = map[string]string{"org_name": gofakeit.Company(), "document_code": "АБВГ.001-ТЗ"}
+				task.IsTraced = false
+				task.OutputFormat = lo.ToPtr(output_domain.FormatXLSX)
 
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&task, nil)
 
@@ -66,19 +94,45 @@ func TestUsecase_Handle_Success(t *testing.T) {
 				_ = gofakeit.Struct(&version)
 				versionGetService.EXPECT().Handle(ctx, task.VersionID).Return(&version, nil)
 
-				variableProcessIn := domain.VariableProcessIn{Variables: version.Variables, Payload: task.Payload}
-				err := &task_domain.ProcessError{Message: "test1"}
-				variableProcessService.EXPECT().Handle(ctx, variableProcessIn).Return(nil, err)
+				var dictionaries []dictionary_domain.Dictionary
+				gofakeit.Slice(&dictionaries)
+				dictionaryListIn := domain.DictionaryListIn{TemplateID: version.TemplateID, At: task.Clock}
+				dictionaryListService.EXPECT().Handle(ctx, dictionaryListIn).Return(dictionaries, nil)
 
-				taskUpdate = domain.TaskUpdate{ID: taskID, Status: task_domain.StatusFailed, Error: err}
+				versionRenderIn := domain.VersionRenderIn{
+					VersionID:    version.ID,
+					Data:         version.Data,
+					Kind:         version.Kind,
+					Variables:    version.Variables,
+					Dependencies: version.Dependencies,
+					Payload:      task.Payload,
+					Functions:    version.Functions,
+					Partials:     version.Partials,
+					Dictionaries: dictionaries,
+					Constants:    task.Constants,
+					Now:          task.Clock,
+					MarkValues:   true,
+				}
+				result := []byte{1, 2, 3}
+				versionRenderService.EXPECT().Handle(ctx, versionRenderIn).Return(result, nil, nil)
+
+				outputRenderIn := domain.OutputRenderIn{Format: output_domain.FormatXLSX, StyleProfile: version.StyleProfile, Code: "АБВГ.001-ТЗ", Kind: version.Kind, Data: result}
+				output := domain.Output{Data: []byte{4, 5, 6}, Format: output_domain.FormatXLSX, ContentType: output_domain.FormatXLSX.ContentType()}
+				outputRenderService.EXPECT().Handle(ctx, outputRenderIn).Return(&output, nil)
+
+				resultID := gofakeit.Int64()
+				resultRepo.EXPECT().Insert(ctx, output).Return(resultID, nil)
+
+				taskUpdate = domain.TaskUpdate{ID: taskID, Status: task_domain.StatusSucceed, ResultID: &resultID}
 				taskRepo.EXPECT().UpdateByID(ctx, taskUpdate).Return(nil)
 			},
 		},
 		{
-			name: "dataProcessService_ProcessError",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			name: "versionRenderService_ProcessError",
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				var task domain.Task
 				_ = gofakeit.Struct(&task)
+				task.IsTraced = false
 
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&task, nil)
 
@@ -89,18 +143,136 @@ func TestUsecase_Handle_Success(t *testing.T) {
 				_ = gofakeit.Struct(&version)
 				versionGetService.EXPECT().Handle(ctx, task.VersionID).Return(&version, nil)
 
-				variableProcessIn := domain.VariableProcessIn{Variables: version.Variables, Payload: task.Payload}
-				variableValues := gofakeit.Map()
-				variableProcessService.EXPECT().Handle(ctx, variableProcessIn).Return(variableValues, nil)
+				var dictionaries []dictionary_domain.Dictionary
+				gofakeit.Slice(&dictionaries)
+				dictionaryListIn := domain.DictionaryListIn{TemplateID: version.TemplateID, At: task.Clock}
+				dictionaryListService.EXPECT().Handle(ctx, dictionaryListIn).Return(dictionaries, nil)
+
+				versionRenderIn := domain.VersionRenderIn{
+					VersionID:    version.ID,
+					Data:         version.Data,
+					Kind:         version.Kind,
+					Variables:    version.Variables,
+					Dependencies: version.Dependencies,
+					Payload:      task.Payload,
+					Functions:    version.Functions,
+					Partials:     version.Partials,
+					Dictionaries: dictionaries,
+					Constants:    task.Constants,
+					Now:          task.Clock,
+				}
+				err := &task_domain.ProcessError{Message: task_domain.MessageTimeout}
+				versionRenderService.EXPECT().Handle(ctx, versionRenderIn).Return(nil, nil, err)
+
+				taskUpdate = domain.TaskUpdate{ID: taskID, Status: task_domain.StatusFailed, Error: err}
+				taskRepo.EXPECT().UpdateByID(ctx, taskUpdate).Return(nil)
+			},
+		},
+		{
+			name: "outputRenderService_Error",
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
+				var task domain.Task
+				_ = gofakeit.Struct(&task)
+				task.IsTraced = false
+
+				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&task, nil)
+
+				taskUpdate := domain.TaskUpdate{ID: taskID, Status: task_domain.StatusInProgress}
+				taskRepo.EXPECT().UpdateByID(ctx, taskUpdate).Return(nil)
 
-				err := &task_domain.ProcessError{Message: "test2"}
-				dataProcessIn := domain.DataProcessIn{Values: variableValues, Data: version.Data}
-				dataProcessService.EXPECT().Handle(ctx, dataProcessIn).Return(nil, err)
+				var version domain.Version
+				_ = gofakeit.Struct(&version)
+				versionGetService.EXPECT().Handle(ctx, task.VersionID).Return(&version, nil)
+				dictionaryListService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil)
+				versionRenderService.EXPECT().Handle(ctx, gomock.Any()).Return([]byte("# Title"), nil, nil)
+				outputRenderService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("test"))
 
+				err := &task_domain.ProcessError{Message: task_domain.MessageOutputRender}
 				taskUpdate = domain.TaskUpdate{ID: taskID, Status: task_domain.StatusFailed, Error: err}
 				taskRepo.EXPECT().UpdateByID(ctx, taskUpdate).Return(nil)
 			},
 		},
+		{
+			name: "Traced",
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
+				var task domain.Task
+				_ = gofakeit.Struct(&task)
+				task.Constants = map[string]string{"org_name": gofakeit.Company()}
+				task.IsTraced = true
+				task.OutputFormat = nil
+
+				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&task, nil)
+
+				taskUpdate := domain.TaskUpdate{ID: taskID, Status: task_domain.StatusInProgress}
+				taskRepo.EXPECT().UpdateByID(ctx, taskUpdate).Return(nil)
+
+				var version domain.Version
+				_ = gofakeit.Struct(&version)
+				versionGetService.EXPECT().Handle(ctx, task.VersionID).Return(&version, nil)
+
+				var dictionaries []dictionary_domain.Dictionary
+				gofakeit.Slice(&dictionaries)
+				dictionaryListIn := domain.DictionaryListIn{TemplateID: version.TemplateID, At: task.Clock}
+				dictionaryListService.EXPECT().Handle(ctx, dictionaryListIn).Return(dictionaries, nil)
+
+				versionRenderIn := domain.VersionRenderIn{
+					VersionID:    version.ID,
+					Data:         version.Data,
+					Kind:         version.Kind,
+					Variables:    version.Variables,
+					Dependencies: version.Dependencies,
+					Payload:      task.Payload,
+					Functions:    version.Functions,
+					Partials:     version.Partials,
+					Dictionaries: dictionaries,
+					Constants:    task.Constants,
+					Trace:        true,
+					Now:          task.Clock,
+				}
+				result := []byte{1, 2, 3}
+				trace := []task_domain.VariableTrace{{Order: 1, Name: "test1"}}
+				versionRenderService.EXPECT().Handle(ctx, versionRenderIn).Return(result, trace, nil)
+
+				outputRenderIn := domain.OutputRenderIn{Format: version.OutputFormat, StyleProfile: version.StyleProfile, Kind: version.Kind, Data: result}
+				output := domain.Output{Data: []byte{4, 5, 6}, Format: output_domain.FormatDOCX, ContentType: output_domain.FormatDOCX.ContentType()}
+				outputRenderService.EXPECT().Handle(ctx, outputRenderIn).Return(&output, nil)
+
+				resultID := gofakeit.Int64()
+				resultRepo.EXPECT().Insert(ctx, output).Return(resultID, nil)
+
+				taskUpdate = domain.TaskUpdate{ID: taskID, Status: task_domain.StatusSucceed, ResultID: &resultID, Trace: trace}
+				taskRepo.EXPECT().UpdateByID(ctx, taskUpdate).Return(nil)
+			},
+		},
+		{
+			name: "Traced_versionRenderService_ProcessError",
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
+				var task domain.Task
+				_ = gofakeit.Struct(&task)
+				task.IsTraced = true
+
+				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&task, nil)
+
+				taskUpdate := domain.TaskUpdate{ID: taskID, Status: task_domain.StatusInProgress}
+				taskRepo.EXPECT().UpdateByID(ctx, taskUpdate).Return(nil)
+
+				var version domain.Version
+				_ = gofakeit.Struct(&version)
+				versionGetService.EXPECT().Handle(ctx, task.VersionID).Return(&version, nil)
+
+				var dictionaries []dictionary_domain.Dictionary
+				gofakeit.Slice(&dictionaries)
+				dictionaryListIn := domain.DictionaryListIn{TemplateID: version.TemplateID, At: task.Clock}
+				dictionaryListService.EXPECT().Handle(ctx, dictionaryListIn).Return(dictionaries, nil)
+
+				trace := []task_domain.VariableTrace{{Order: 1, Name: "test1", Message: "test1"}}
+				err := &task_domain.ProcessError{Message: "test1"}
+				versionRenderService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, trace, err)
+
+				taskUpdate = domain.TaskUpdate{ID: taskID, Status: task_domain.StatusFailed, Error: err, Trace: trace}
+				taskRepo.EXPECT().UpdateByID(ctx, taskUpdate).Return(nil)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -109,13 +281,14 @@ func TestUsecase_Handle_Success(t *testing.T) {
 
 			taskRepo := NewMocktaskRepository(ctrl)
 			versionGetService := NewMockversionGetService(ctrl)
-			variableProcessService := NewMockvariableProcessService(ctrl)
-			dataProcessService := NewMockdataProcessService(ctrl)
+			dictionaryListService := NewMockdictionaryListService(ctrl)
+			versionRenderService := NewMockversionRenderService(ctrl)
+			outputRenderService := NewMockoutputRenderService(ctrl)
 			resultRepo := NewMockresultRepository(ctrl)
 
-			tt.setup(taskRepo, versionGetService, variableProcessService, dataProcessService, resultRepo)
+			tt.setup(taskRepo, versionGetService, dictionaryListService, versionRenderService, outputRenderService, resultRepo)
 
-			usecase := New(taskRepo, versionGetService, variableProcessService, dataProcessService, resultRepo)
+			usecase := New(taskRepo, versionGetService, dictionaryListService, versionRenderService, outputRenderService, resultRepo, slog.New(slog.DiscardHandler))
 			err := usecase.Handle(ctx, domain.TaskProcessIn{TaskID: taskID})
 			require.NoError(t, err)
 		})
@@ -128,26 +301,26 @@ func TestUsecase_Handle_Error(t *testing.T) {
 
 	tests := []struct {
 		name  string
-		setup func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository)
+		setup func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository)
 		want  string
 	}{
 		{
 			name: "taskRepo_GetByID",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(nil, errors.New("test1"))
 			},
 			want: "test1",
 		},
 		{
 			name: "domain_ErrTaskNotFound",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(nil, nil)
 			},
 			want: domain.ErrTaskNotFound.Error(),
 		},
 		{
 			name: "taskRepo_UpdateByID_#1",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&domain.Task{}, nil)
 				taskRepo.EXPECT().UpdateByID(ctx, gomock.Any()).Return(errors.New("test2"))
 			},
@@ -155,7 +328,7 @@ func TestUsecase_Handle_Error(t *testing.T) {
 		},
 		{
 			name: "versionGetService_Error",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&domain.Task{}, nil)
 				taskRepo.EXPECT().UpdateByID(ctx, gomock.Any()).Return(nil)
 				versionGetService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("test3"))
@@ -163,45 +336,48 @@ func TestUsecase_Handle_Error(t *testing.T) {
 			want: "test3",
 		},
 		{
-			name: "variableProcessService_Error",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			name: "dictionaryListService_Error",
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&domain.Task{}, nil)
 				taskRepo.EXPECT().UpdateByID(ctx, gomock.Any()).Return(nil)
 				versionGetService.EXPECT().Handle(ctx, gomock.Any()).Return(&domain.Version{}, nil)
-				variableProcessService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("test4"))
+				dictionaryListService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("test5"))
 			},
+			want: "test5",
 		},
 		{
-			name: "dataProcessService_Error",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			name: "versionRenderService_Error",
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&domain.Task{}, nil)
 				taskRepo.EXPECT().UpdateByID(ctx, gomock.Any()).Return(nil)
 				versionGetService.EXPECT().Handle(ctx, gomock.Any()).Return(&domain.Version{}, nil)
-				variableProcessService.EXPECT().Handle(ctx, gomock.Any()).Return(map[string]any{}, nil)
-				dataProcessService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("test5"))
+				dictionaryListService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil)
+				versionRenderService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil, errors.New("test4"))
 			},
-			want: "test5",
+			want: "test4",
 		},
 		{
 			name: "resultRepo_Insert",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&domain.Task{}, nil)
 				taskRepo.EXPECT().UpdateByID(ctx, gomock.Any()).Return(nil)
 				versionGetService.EXPECT().Handle(ctx, gomock.Any()).Return(&domain.Version{}, nil)
-				variableProcessService.EXPECT().Handle(ctx, gomock.Any()).Return(map[string]any{}, nil)
-				dataProcessService.EXPECT().Handle(ctx, gomock.Any()).Return([]byte{}, nil)
+				dictionaryListService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil)
+				versionRenderService.EXPECT().Handle(ctx, gomock.Any()).Return([]byte{}, nil, nil)
+				outputRenderService.EXPECT().Handle(ctx, gomock.Any()).Return(&domain.Output{}, nil)
 				resultRepo.EXPECT().Insert(ctx, gomock.Any()).Return(int64(0), errors.New("test6"))
 			},
 			want: "test6",
 		},
 		{
 			name: "taskRepo_UpdateByID_#2",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&domain.Task{}, nil)
 				taskRepo.EXPECT().UpdateByID(ctx, gomock.Any()).Return(nil)
 				versionGetService.EXPECT().Handle(ctx, gomock.Any()).Return(&domain.Version{}, nil)
-				variableProcessService.EXPECT().Handle(ctx, gomock.Any()).Return(map[string]any{}, nil)
-				dataProcessService.EXPECT().Handle(ctx, gomock.Any()).Return([]byte{}, nil)
+				dictionaryListService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil)
+				versionRenderService.EXPECT().Handle(ctx, gomock.Any()).Return([]byte{}, nil, nil)
+				outputRenderService.EXPECT().Handle(ctx, gomock.Any()).Return(&domain.Output{}, nil)
 				resultRepo.EXPECT().Insert(ctx, gomock.Any()).Return(int64(0), nil)
 				taskRepo.EXPECT().UpdateByID(ctx, gomock.Any()).Return(errors.New("test7"))
 			},
@@ -209,12 +385,12 @@ func TestUsecase_Handle_Error(t *testing.T) {
 		},
 		{
 			name: "taskRepo_UpdateByID_#3",
-			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, variableProcessService *MockvariableProcessService, dataProcessService *MockdataProcessService, resultRepo *MockresultRepository) {
+			setup: func(taskRepo *MocktaskRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, versionRenderService *MockversionRenderService, outputRenderService *MockoutputRenderService, resultRepo *MockresultRepository) {
 				taskRepo.EXPECT().GetByID(ctx, taskID).Return(&domain.Task{}, nil)
 				taskRepo.EXPECT().UpdateByID(ctx, gomock.Any()).Return(nil)
 				versionGetService.EXPECT().Handle(ctx, gomock.Any()).Return(&domain.Version{}, nil)
-				variableProcessService.EXPECT().Handle(ctx, gomock.Any()).Return(map[string]any{}, nil)
-				dataProcessService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, &task_domain.ProcessError{Message: "test1"})
+				dictionaryListService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil)
+				versionRenderService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil, &task_domain.ProcessError{Message: "test1"})
 				taskRepo.EXPECT().UpdateByID(ctx, gomock.Any()).Return(errors.New("test8"))
 			},
 			want: "test8",
@@ -227,15 +403,51 @@ func TestUsecase_Handle_Error(t *testing.T) {
 
 			taskRepo := NewMocktaskRepository(ctrl)
 			versionGetService := NewMockversionGetService(ctrl)
-			variableProcessService := NewMockvariableProcessService(ctrl)
-			dataProcessService := NewMockdataProcessService(ctrl)
+			dictionaryListService := NewMockdictionaryListService(ctrl)
+			versionRenderService := NewMockversionRenderService(ctrl)
+			outputRenderService := NewMockoutputRenderService(ctrl)
 			resultRepo := NewMockresultRepository(ctrl)
 
-			tt.setup(taskRepo, versionGetService, variableProcessService, dataProcessService, resultRepo)
+			tt.setup(taskRepo, versionGetService, dictionaryListService, versionRenderService, outputRenderService, resultRepo)
 
-			usecase := New(taskRepo, versionGetService, variableProcessService, dataProcessService, resultRepo)
+			usecase := New(taskRepo, versionGetService, dictionaryListService, versionRenderService, outputRenderService, resultRepo, slog.New(slog.DiscardHandler))
 			err := usecase.Handle(ctx, domain.TaskProcessIn{TaskID: taskID})
 			require.ErrorContains(t, err, tt.want)
 		})
 	}
 }
+
+func TestUsecase_Handle_OutputRenderLogged(t *testing.T) {
+	ctx := context.Background()
+	taskID := gofakeit.Int64()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskRepo := NewMocktaskRepository(ctrl)
+	versionGetService := NewMockversionGetService(ctrl)
+	dictionaryListService := NewMockdictionaryListService(ctrl)
+	versionRenderService := NewMockversionRenderService(ctrl)
+	outputRenderService := NewMockoutputRenderService(ctrl)
+	resultRepo := NewMockresultRepository(ctrl)
+
+	var task domain.Task
+	_ = gofakeit.Struct(&task)
+	taskRepo.EXPECT().GetByID(ctx, taskID).Return(&task, nil)
+	taskRepo.EXPECT().UpdateByID(ctx, gomock.Any()).Return(nil).Times(2)
+
+	var version domain.Version
+	_ = gofakeit.Struct(&version)
+	versionGetService.EXPECT().Handle(ctx, task.VersionID).Return(&version, nil)
+	dictionaryListService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil)
+	versionRenderService.EXPECT().Handle(ctx, gomock.Any()).Return([]byte("# Title"), nil, nil)
+	outputRenderService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, errors.New("pandoc exited"))
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+
+	usecase := New(taskRepo, versionGetService, dictionaryListService, versionRenderService, outputRenderService, resultRepo, logger)
+	err := usecase.Handle(ctx, domain.TaskProcessIn{TaskID: taskID})
+	require.NoError(t, err)
+	require.Contains(t, logs.String(), "pandoc exited")
+}
@@ -40,10 +40,11 @@ func (s *repositorySuite) TestRepository_Create() {
 
 	// template
 	want := test_db.Template{
-		Name:      gofakeit.UUID(),
-		IsDefault: false,
-		ProjectID: &projectID,
-		AuthorID:  &userIDs[1],
+		Name:         gofakeit.UUID(),
+		IsDefault:    false,
+		ProjectID:    &projectID,
+		AuthorID:     &userIDs[1],
+		OutputFormat: "md",
 	}
 
 	template := domain.Template{
@@ -37,10 +37,11 @@ func (s *repositorySuite) TestRepository_Create() {
 	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
 
 	want := test_db.Template{
-		Name:      gofakeit.UUID(),
-		IsDefault: false,
-		ProjectID: &projectID,
-		AuthorID:  &userIDs[1],
+		Name:         gofakeit.UUID(),
+		IsDefault:    false,
+		ProjectID:    &projectID,
+		AuthorID:     &userIDs[1],
+		OutputFormat: "md",
 	}
 
 	template := domain.Template{
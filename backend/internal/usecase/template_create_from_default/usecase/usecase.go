@@ -106,12 +106,34 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TemplateCreateFromDefaul
 func convertVariables(variables []version_get_domain.Variable) []version_create_domain.Variable {
 	return lo.Map(variables, func(v version_get_domain.Variable, _ int) version_create_domain.Variable {
 		return version_create_domain.Variable{
-			Name:        v.Name,
-			Title:       v.Title,
-			Type:        v.Type,
-			Expression:  v.Expression,
-			IsInput:     v.IsInput,
-			Constraints: convertConstraints(v.Constraints),
+			Name:              v.Name,
+			Title:             v.Title,
+			Type:              v.Type,
+			Expression:        v.Expression,
+			IsInput:           v.IsInput,
+			DefaultExpression: v.DefaultExpression,
+			EnabledIf:         v.EnabledIf,
+			Unit:              v.Unit,
+			Options:           v.Options,
+			OptionsFrom:       v.OptionsFrom,
+			ItemType:          v.ItemType,
+			Columns:           convertColumns(v.Columns),
+			Constraints:       convertConstraints(v.Constraints),
+		}
+	})
+}
+
+func convertColumns(columns []version_get_domain.Column) []version_create_domain.Column {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	return lo.Map(columns, func(c version_get_domain.Column, _ int) version_create_domain.Column {
+		return version_create_domain.Column{
+			Name:    c.Name,
+			Title:   c.Title,
+			Type:    c.Type,
+			Options: c.Options,
 		}
 	})
 }
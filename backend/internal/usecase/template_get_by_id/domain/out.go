@@ -1,8 +1,13 @@
 package domain
 
-import version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
+import (
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+	version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
+)
 
 type TemplateGetByIDOut struct {
-	Name    string
-	Version *version_get_domain.Version
+	Name         string
+	OutputFormat output_domain.Format
+	StyleProfile *string
+	Version      *version_get_domain.Version
 }
@@ -1,11 +1,14 @@
 package domain
 
 import (
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
 )
 
 type Template struct {
 	Name            string
+	OutputFormat    output_domain.Format
+	StyleProfile    *string
 	LastVersionID   *int64
 	AuthorID        int64
 	ProjectAuthorID int64
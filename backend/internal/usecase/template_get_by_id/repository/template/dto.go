@@ -3,12 +3,15 @@ package template_repository
 import (
 	"github.com/samber/lo"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/template_get_by_id/domain"
 )
 
 type template struct {
 	Name            string  `db:"name"`
+	OutputFormat    string  `db:"output_format"`
+	StyleProfile    *string `db:"style_profile"`
 	LastVersionID   *int64  `db:"last_version_id"`
 	AuthorID        int64   `db:"author_id"`
 	ProjectAuthorID int64   `db:"project_author_id"`
@@ -32,6 +35,8 @@ func (ts templates) toDomain() *domain.Template {
 
 	return &domain.Template{
 		Name:            ts[0].Name,
+		OutputFormat:    output_domain.Format(ts[0].OutputFormat),
+		StyleProfile:    ts[0].StyleProfile,
 		LastVersionID:   ts[0].LastVersionID,
 		AuthorID:        ts[0].AuthorID,
 		ProjectAuthorID: ts[0].ProjectAuthorID,
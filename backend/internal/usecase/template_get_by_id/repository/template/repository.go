@@ -26,6 +26,8 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Template, e
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Select(
 			"t.name",
+			"t.output_format",
+			"t.style_profile",
 			"t.last_version_id",
 			"t.author_id",
 			"p.author_id as project_author_id",
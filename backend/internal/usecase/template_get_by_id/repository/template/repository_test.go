@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
 	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/template_get_by_id/domain"
@@ -67,6 +68,8 @@ func (s *repositorySuite) TestRepository_GetByID() {
 
 		want := domain.Template{
 			Name:            template.Name,
+			OutputFormat:    output_domain.Format(template.OutputFormat),
+			StyleProfile:    template.StyleProfile,
 			LastVersionID:   template.LastVersionID,
 			AuthorID:        *template.AuthorID,
 			ProjectAuthorID: project.AuthorID,
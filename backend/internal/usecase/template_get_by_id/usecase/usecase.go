@@ -29,8 +29,14 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TemplateGetByIDIn) (*dom
 		return nil, err
 	}
 
+	out := &domain.TemplateGetByIDOut{
+		Name:         template.Name,
+		OutputFormat: template.OutputFormat,
+		StyleProfile: template.StyleProfile,
+	}
+
 	if template.LastVersionID == nil {
-		return &domain.TemplateGetByIDOut{Name: template.Name, Version: nil}, nil
+		return out, nil
 	}
 
 	// get last version
@@ -39,7 +45,8 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TemplateGetByIDIn) (*dom
 		return nil, err
 	}
 
-	return &domain.TemplateGetByIDOut{Name: template.Name, Version: version}, nil
+	out.Version = version
+	return out, nil
 }
 
 func (u *Usecase) getTemplate(ctx context.Context, in domain.TemplateGetByIDIn) (*domain.Template, error) {
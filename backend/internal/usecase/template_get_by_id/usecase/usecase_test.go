@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
 	version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/template_get_by_id/domain"
@@ -28,6 +29,8 @@ func TestUsecase_Handle_Success(t *testing.T) {
 			setup: func(templateRepo *MocktemplateRepository, versionGetService *MockversionGetService) {
 				template := domain.Template{
 					Name:            "test",
+					OutputFormat:    output_domain.FormatDOCX,
+					StyleProfile:    lo.ToPtr("gost_19"),
 					LastVersionID:   lo.ToPtr[int64](20),
 					AuthorID:        2,
 					ProjectAuthorID: 1,
@@ -35,7 +38,12 @@ func TestUsecase_Handle_Success(t *testing.T) {
 				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(&template, nil)
 				versionGetService.EXPECT().Handle(ctx, int64(20)).Return(&version_get_domain.Version{ID: 20}, nil)
 			},
-			want: domain.TemplateGetByIDOut{Name: "test", Version: &version_get_domain.Version{ID: 20}},
+			want: domain.TemplateGetByIDOut{
+				Name:         "test",
+				OutputFormat: output_domain.FormatDOCX,
+				StyleProfile: lo.ToPtr("gost_19"),
+				Version:      &version_get_domain.Version{ID: 20},
+			},
 		},
 		{
 			name: "IsAuthor/NoLastVersion",
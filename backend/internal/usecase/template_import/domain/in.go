@@ -2,42 +2,22 @@ package domain
 
 import (
 	"errors"
-	"fmt"
-	"regexp"
-	"unicode/utf8"
 
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
-	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	version_create_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
 )
 
 var (
 	ErrValueEmpty      = errors.New("value is empty")
-	ErrValueInvalid    = errors.New("value is invalid")
 	ErrProjectNotFound = error_domain.NewBaseError("project not found")
 	ErrProjectInvalid  = error_domain.NewBaseError("project is invalid")
 )
 
-var slugRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+type Variable = version_create_domain.Variable
 
-const (
-	slugMaxLen  = 100
-	titleMaxLen = 255
-)
+type Column = version_create_domain.Column
 
-type Constraint struct {
-	Name       string
-	Expression string
-	IsActive   bool
-}
-
-type Variable struct {
-	Name        string
-	Title       string
-	Type        variable_domain.Type
-	Expression  *string
-	IsInput     bool
-	Constraints []Constraint
-}
+type Constraint = version_create_domain.Constraint
 
 type Version struct {
 	Data      []byte
@@ -60,23 +40,5 @@ func (in TemplateImportIn) Validate() error {
 		return nil
 	}
 
-	for i, v := range in.Version.Variables {
-		if !v.Type.Valid() {
-			return error_domain.NewValidationError(fmt.Sprintf("version.variables.%d.type", i), ErrValueInvalid)
-		}
-
-		if v.Name == "" || len(v.Name) > slugMaxLen || !slugRegexp.MatchString(v.Name) {
-			return error_domain.NewValidationError(fmt.Sprintf("version.variables.%d.name", i), ErrValueInvalid)
-		}
-
-		if v.Title == "" {
-			return error_domain.NewValidationError(fmt.Sprintf("version.variables.%d.title", i), ErrValueEmpty)
-		}
-
-		if utf8.RuneCountInString(v.Title) > titleMaxLen {
-			return error_domain.NewValidationError(fmt.Sprintf("version.variables.%d.title", i), ErrValueInvalid)
-		}
-	}
-
-	return nil
+	return version_create_domain.ValidateVariables("version.variables", in.Version.Variables)
 }
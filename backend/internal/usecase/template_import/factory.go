@@ -1,6 +1,8 @@
 package template_import_usecase
 
 import (
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 	"github.com/jmoiron/sqlx"
 
 	version_create_service "github.com/qsoulior/tech-generator/backend/internal/service/version_create"
@@ -11,7 +13,8 @@ import (
 
 func New(db *sqlx.DB) *usecase.Usecase {
 	projectRepo := project_repository.New(db)
-	templateRepo := template_repository.New(db)
+	templateRepo := template_repository.New(db, trmsqlx.DefaultCtxGetter)
 	versionCreateService := version_create_service.New(db)
-	return usecase.New(projectRepo, templateRepo, versionCreateService)
+	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
+	return usecase.New(projectRepo, templateRepo, versionCreateService, trManager)
 }
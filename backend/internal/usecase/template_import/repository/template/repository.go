@@ -5,17 +5,22 @@ import (
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jmoiron/sqlx"
 
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/template_import/domain"
 )
 
 type Repository struct {
-	db *sqlx.DB
+	db       *sqlx.DB
+	trGetter *trmsqlx.CtxGetter
 }
 
-func New(db *sqlx.DB) *Repository {
-	return &Repository{db: db}
+func New(db *sqlx.DB, trGetter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{
+		db:       db,
+		trGetter: trGetter,
+	}
 }
 
 func (r *Repository) Create(ctx context.Context, template domain.Template) (int64, error) {
@@ -40,7 +45,7 @@ func (r *Repository) Create(ctx context.Context, template domain.Template) (int6
 	query = fmt.Sprintf("-- %s\n%s", op, query)
 
 	var id int64
-	err = r.db.GetContext(ctx, &id, query, args...)
+	err = r.trGetter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &id, query, args...)
 	if err != nil {
 		return 0, fmt.Errorf("exec query %q: %w", op, err)
 	}
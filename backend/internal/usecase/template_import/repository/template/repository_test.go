@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -22,7 +23,7 @@ func Test_repositorySuite(t *testing.T) {
 
 func (s *repositorySuite) TestRepository_Create() {
 	ctx := context.Background()
-	repo := New(s.C().DB())
+	repo := New(s.C().DB(), trmsqlx.DefaultCtxGetter)
 
 	users := test_db.GenerateEntities[test_db.User](2)
 	userIDs, err := test_db.InsertEntitiesWithID[int64](s.C(), "usr", users)
@@ -37,10 +38,11 @@ func (s *repositorySuite) TestRepository_Create() {
 	defer func() { require.NoError(s.T(), test_db.DeleteEntityByID(s.C(), "project", projectID)) }()
 
 	want := test_db.Template{
-		Name:      gofakeit.UUID(),
-		IsDefault: false,
-		ProjectID: &projectID,
-		AuthorID:  &userIDs[1],
+		Name:         gofakeit.UUID(),
+		IsDefault:    false,
+		ProjectID:    &projectID,
+		AuthorID:     &userIDs[1],
+		OutputFormat: "md",
 	}
 
 	template := domain.Template{
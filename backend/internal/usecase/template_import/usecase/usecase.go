@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
 	"github.com/samber/lo"
 
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
@@ -15,13 +16,15 @@ type Usecase struct {
 	projectRepo          projectRepository
 	templateRepo         templateRepository
 	versionCreateService versionCreateService
+	trManager            trm.Manager
 }
 
-func New(projectRepo projectRepository, templateRepo templateRepository, versionCreateService versionCreateService) *Usecase {
+func New(projectRepo projectRepository, templateRepo templateRepository, versionCreateService versionCreateService, trManager trm.Manager) *Usecase {
 	return &Usecase{
 		projectRepo:          projectRepo,
 		templateRepo:         templateRepo,
 		versionCreateService: versionCreateService,
+		trManager:            trManager,
 	}
 }
 
@@ -47,6 +50,21 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TemplateImportIn) (*doma
 		return nil, domain.ErrProjectInvalid
 	}
 
+	// create template and version, a version that fails validation leaves no template
+	var templateID int64
+	err = u.trManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		templateID, err = u.createTemplate(ctx, in)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TemplateImportOut{ID: templateID}, nil
+}
+
+func (u *Usecase) createTemplate(ctx context.Context, in domain.TemplateImportIn) (int64, error) {
 	templateID, err := u.templateRepo.Create(ctx, domain.Template{
 		Name:      in.Name,
 		IsDefault: false,
@@ -54,7 +72,7 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TemplateImportIn) (*doma
 		AuthorID:  in.AuthorID,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("template repo - create: %w", err)
+		return 0, fmt.Errorf("template repo - create: %w", err)
 	}
 
 	if in.Version != nil {
@@ -62,37 +80,14 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TemplateImportIn) (*doma
 			AuthorID:   in.AuthorID,
 			TemplateID: templateID,
 			Data:       in.Version.Data,
-			Variables:  convertVariables(in.Version.Variables),
+			Variables:  in.Version.Variables,
 		}
 
 		_, err = u.versionCreateService.Handle(ctx, versionIn)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 	}
 
-	return &domain.TemplateImportOut{ID: templateID}, nil
-}
-
-func convertVariables(variables []domain.Variable) []version_create_domain.Variable {
-	return lo.Map(variables, func(v domain.Variable, _ int) version_create_domain.Variable {
-		return version_create_domain.Variable{
-			Name:        v.Name,
-			Title:       v.Title,
-			Type:        v.Type,
-			Expression:  v.Expression,
-			IsInput:     v.IsInput,
-			Constraints: convertConstraints(v.Constraints),
-		}
-	})
-}
-
-func convertConstraints(constraints []domain.Constraint) []version_create_domain.Constraint {
-	return lo.Map(constraints, func(c domain.Constraint, _ int) version_create_domain.Constraint {
-		return version_create_domain.Constraint{
-			Name:       c.Name,
-			Expression: c.Expression,
-			IsActive:   c.IsActive,
-		}
-	})
+	return templateID, nil
 }
@@ -5,18 +5,23 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
 	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
 	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	test_trm "github.com/qsoulior/tech-generator/backend/internal/pkg/test/trm"
 	version_create_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/template_import/domain"
 )
 
 func TestUsecase_Handle_Success(t *testing.T) {
 	ctx := context.Background()
+	trCtx := context.WithValue(ctx, test_trm.TrKey{}, struct{}{})
 
 	t.Run("IsAuthor/NoVersion", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -34,14 +39,14 @@ func TestUsecase_Handle_Success(t *testing.T) {
 		versionCreateService := NewMockversionCreateService(ctrl)
 
 		projectRepo.EXPECT().GetByID(ctx, int64(2)).Return(&domain.Project{AuthorID: 1}, nil)
-		templateRepo.EXPECT().Create(ctx, domain.Template{
+		templateRepo.EXPECT().Create(trCtx, domain.Template{
 			Name:      "test",
 			IsDefault: false,
 			ProjectID: 2,
 			AuthorID:  1,
 		}).Return(int64(42), nil)
 
-		usecase := New(projectRepo, templateRepo, versionCreateService)
+		usecase := New(projectRepo, templateRepo, versionCreateService, test_trm.New())
 		got, err := usecase.Handle(ctx, in)
 		require.NoError(t, err)
 		require.NotNil(t, got)
@@ -84,14 +89,14 @@ func TestUsecase_Handle_Success(t *testing.T) {
 		versionCreateService := NewMockversionCreateService(ctrl)
 
 		projectRepo.EXPECT().GetByID(ctx, int64(2)).Return(&project, nil)
-		templateRepo.EXPECT().Create(ctx, domain.Template{
+		templateRepo.EXPECT().Create(trCtx, domain.Template{
 			Name:      "test",
 			IsDefault: false,
 			ProjectID: 2,
 			AuthorID:  1,
 		}).Return(int64(42), nil)
 
-		versionCreateService.EXPECT().Handle(ctx, version_create_domain.VersionCreateIn{
+		versionCreateService.EXPECT().Handle(trCtx, version_create_domain.VersionCreateIn{
 			AuthorID:   1,
 			TemplateID: 42,
 			Data:       []byte("body"),
@@ -109,7 +114,7 @@ func TestUsecase_Handle_Success(t *testing.T) {
 			},
 		}).Return(int64(100), nil)
 
-		usecase := New(projectRepo, templateRepo, versionCreateService)
+		usecase := New(projectRepo, templateRepo, versionCreateService, test_trm.New())
 		got, err := usecase.Handle(ctx, in)
 		require.NoError(t, err)
 		require.NotNil(t, got)
@@ -119,6 +124,7 @@ func TestUsecase_Handle_Success(t *testing.T) {
 
 func TestUsecase_Handle_Error(t *testing.T) {
 	ctx := context.Background()
+	trCtx := context.WithValue(ctx, test_trm.TrKey{}, struct{}{})
 
 	tests := []struct {
 		name  string
@@ -143,7 +149,51 @@ func TestUsecase_Handle_Error(t *testing.T) {
 				},
 			},
 			setup: func(*MockprojectRepository, *MocktemplateRepository, *MockversionCreateService) {},
-			want:  domain.ErrValueInvalid.Error(),
+			want:  version_create_domain.ErrValueInvalid.Error(),
+		},
+		{
+			name: "in_Validate/VariableOptions",
+			in: domain.TemplateImportIn{
+				Name:      "test",
+				ProjectID: 2,
+				AuthorID:  1,
+				Version: &domain.Version{
+					Variables: []domain.Variable{{Name: "x", Title: "X", Type: variable_domain.TypeEnum}},
+				},
+			},
+			setup: func(*MockprojectRepository, *MocktemplateRepository, *MockversionCreateService) {},
+			want:  version_create_domain.ErrValueEmpty.Error(),
+		},
+		{
+			name: "in_Validate/VariableColumns",
+			in: domain.TemplateImportIn{
+				Name:      "test",
+				ProjectID: 2,
+				AuthorID:  1,
+				Version: &domain.Version{
+					Variables: []domain.Variable{{
+						Name:    "x",
+						Title:   "X",
+						Type:    variable_domain.TypeTable,
+						Columns: []domain.Column{{Name: "1a", Title: "A", Type: variable_domain.TypeString}},
+					}},
+				},
+			},
+			setup: func(*MockprojectRepository, *MocktemplateRepository, *MockversionCreateService) {},
+			want:  version_create_domain.ErrValueInvalid.Error(),
+		},
+		{
+			name: "in_Validate/VariableDefaultExpression",
+			in: domain.TemplateImportIn{
+				Name:      "test",
+				ProjectID: 2,
+				AuthorID:  1,
+				Version: &domain.Version{
+					Variables: []domain.Variable{{Name: "x", Title: "X", Type: variable_domain.TypeString, DefaultExpression: lo.ToPtr(`"x"`)}},
+				},
+			},
+			setup: func(*MockprojectRepository, *MocktemplateRepository, *MockversionCreateService) {},
+			want:  version_create_domain.ErrValueInvalid.Error(),
 		},
 		{
 			name: "projectRepo_GetByID",
@@ -174,7 +224,7 @@ func TestUsecase_Handle_Error(t *testing.T) {
 			in:   domain.TemplateImportIn{Name: "test", ProjectID: 2, AuthorID: 1},
 			setup: func(projectRepo *MockprojectRepository, templateRepo *MocktemplateRepository, _ *MockversionCreateService) {
 				projectRepo.EXPECT().GetByID(ctx, gomock.Any()).Return(&domain.Project{AuthorID: 1}, nil)
-				templateRepo.EXPECT().Create(ctx, gomock.Any()).Return(int64(0), errors.New("test2"))
+				templateRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(0), errors.New("test2"))
 			},
 			want: "test2",
 		},
@@ -193,8 +243,8 @@ func TestUsecase_Handle_Error(t *testing.T) {
 			},
 			setup: func(projectRepo *MockprojectRepository, templateRepo *MocktemplateRepository, versionCreateService *MockversionCreateService) {
 				projectRepo.EXPECT().GetByID(ctx, gomock.Any()).Return(&domain.Project{AuthorID: 1}, nil)
-				templateRepo.EXPECT().Create(ctx, gomock.Any()).Return(int64(42), nil)
-				versionCreateService.EXPECT().Handle(ctx, gomock.Any()).Return(int64(0), errors.New("test3"))
+				templateRepo.EXPECT().Create(trCtx, gomock.Any()).Return(int64(42), nil)
+				versionCreateService.EXPECT().Handle(trCtx, gomock.Any()).Return(int64(0), errors.New("test3"))
 			},
 			want: "test3",
 		},
@@ -209,9 +259,67 @@ func TestUsecase_Handle_Error(t *testing.T) {
 			versionCreateService := NewMockversionCreateService(ctrl)
 			tt.setup(projectRepo, templateRepo, versionCreateService)
 
-			usecase := New(projectRepo, templateRepo, versionCreateService)
+			usecase := New(projectRepo, templateRepo, versionCreateService, test_trm.New())
 			_, err := usecase.Handle(ctx, tt.in)
 			require.ErrorContains(t, err, tt.want)
 		})
 	}
 }
+
+func TestUsecase_Handle_VersionInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	in := domain.TemplateImportIn{
+		Name:      "test",
+		ProjectID: 2,
+		AuthorID:  1,
+		Version: &domain.Version{
+			Data: []byte("{{ .x }}"),
+			Variables: []domain.Variable{
+				{Name: "x", Title: "Variable X", Type: variable_domain.TypeInteger, Expression: lo.ToPtr("y +")},
+			},
+		},
+	}
+
+	projectRepo := NewMockprojectRepository(ctrl)
+	versionCreateService := NewMockversionCreateService(ctrl)
+	templates := &templateTx{}
+
+	projectRepo.EXPECT().GetByID(ctx, int64(2)).Return(&domain.Project{AuthorID: 1}, nil)
+	versionErr := error_domain.NewValidationError("variables[0].expression", version_create_domain.ErrValueInvalid)
+	versionCreateService.EXPECT().Handle(gomock.Any(), gomock.Any()).Return(int64(0), versionErr)
+
+	usecase := New(projectRepo, templates, versionCreateService, templates)
+	_, err := usecase.Handle(ctx, in)
+	require.ErrorIs(t, err, versionErr)
+	require.Len(t, templates.created, 1)
+	require.Empty(t, templates.committed)
+}
+
+// templateTx keeps the templates created in a transaction until it commits.
+type templateTx struct {
+	created   []domain.Template
+	committed []domain.Template
+}
+
+func (tx *templateTx) Create(_ context.Context, template domain.Template) (int64, error) {
+	tx.created = append(tx.created, template)
+	return int64(len(tx.created)), nil
+}
+
+func (tx *templateTx) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	created := len(tx.created)
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	tx.committed = append(tx.committed, tx.created[created:]...)
+	return nil
+}
+
+func (tx *templateTx) DoWithSettings(ctx context.Context, _ trm.Settings, fn func(ctx context.Context) error) error {
+	return tx.Do(ctx, fn)
+}
@@ -4,18 +4,24 @@ import (
 	"errors"
 
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 )
 
 var (
 	ErrTemplateNotFound = error_domain.NewBaseError("template not found")
 	ErrTemplateInvalid  = error_domain.NewBaseError("template is invalid")
 	ErrValueEmpty       = errors.New("value is empty")
+	ErrValueInvalid     = errors.New("value is invalid")
 )
 
 type TemplateUpdateIn struct {
 	TemplateID int64
 	UserID     int64
 	Name       string
+	// OutputFormat is left as it is if nil.
+	OutputFormat *output_domain.Format
+	// StyleProfile is left as it is if nil. Empty means the default profile.
+	StyleProfile *string
 }
 
 func (in TemplateUpdateIn) Validate() error {
@@ -23,5 +29,15 @@ func (in TemplateUpdateIn) Validate() error {
 		return error_domain.NewValidationError("name", ErrValueEmpty)
 	}
 
+	if in.OutputFormat != nil && !in.OutputFormat.Valid() {
+		return error_domain.NewValidationError("outputFormat", ErrValueInvalid)
+	}
+
+	if in.StyleProfile != nil && *in.StyleProfile != "" {
+		if _, found := output_domain.Profiles[*in.StyleProfile]; !found {
+			return error_domain.NewValidationError("styleProfile", ErrValueInvalid)
+		}
+	}
+
 	return nil
 }
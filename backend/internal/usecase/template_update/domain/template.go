@@ -1,6 +1,16 @@
 package domain
 
+import output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
+
 type Template struct {
 	AuthorID        int64
 	ProjectAuthorID int64
 }
+
+type TemplateUpdate struct {
+	ID   int64
+	Name string
+	// OutputFormat and StyleProfile are not updated if nil.
+	OutputFormat *output_domain.Format
+	StyleProfile *string
+}
@@ -8,6 +8,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
 
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/template_update/domain"
 )
@@ -53,13 +54,24 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Template, e
 	return template.toDomain(), nil
 }
 
-func (r *Repository) UpdateByID(ctx context.Context, id int64, name string) error {
+func (r *Repository) UpdateByID(ctx context.Context, template domain.TemplateUpdate) error {
 	op := "template - update by id"
 
+	values := map[string]any{"name": template.Name}
+
+	if template.OutputFormat != nil {
+		values["output_format"] = *template.OutputFormat
+	}
+
+	if template.StyleProfile != nil {
+		// empty means the default profile
+		values["style_profile"] = lo.EmptyableToPtr(*template.StyleProfile)
+	}
+
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Update("template").
-		Set("name", name).
-		Where(sq.Eq{"id": id})
+		SetMap(values).
+		Where(sq.Eq{"id": template.ID})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -5,9 +5,11 @@ import (
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	test_db "github.com/qsoulior/tech-generator/backend/internal/pkg/test/db"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/template_update/domain"
 )
@@ -110,11 +112,29 @@ func (s *repositorySuite) TestRepository_UpdateByID() {
 
 	// handle
 	newName := gofakeit.UUID()
-	err = repo.UpdateByID(ctx, templateID, newName)
+	err = repo.UpdateByID(ctx, domain.TemplateUpdate{ID: templateID, Name: newName})
 	require.NoError(s.T(), err)
 
 	templates, err := test_db.SelectEntitiesByID[test_db.Template](s.C(), "template", []int64{templateID})
 	require.NoError(s.T(), err)
 	require.Len(s.T(), templates, 1)
 	require.Equal(s.T(), newName, templates[0].Name)
+	require.Equal(s.T(), template.OutputFormat, templates[0].OutputFormat)
+	require.Equal(s.T(), template.StyleProfile, templates[0].StyleProfile)
+
+	// handle with output format
+	templateUpdate := domain.TemplateUpdate{
+		ID:           templateID,
+		Name:         newName,
+		OutputFormat: lo.ToPtr(output_domain.FormatDOCX),
+		StyleProfile: lo.ToPtr(""),
+	}
+	err = repo.UpdateByID(ctx, templateUpdate)
+	require.NoError(s.T(), err)
+
+	templates, err = test_db.SelectEntitiesByID[test_db.Template](s.C(), "template", []int64{templateID})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), templates, 1)
+	require.Equal(s.T(), string(output_domain.FormatDOCX), templates[0].OutputFormat)
+	require.Nil(s.T(), templates[0].StyleProfile)
 }
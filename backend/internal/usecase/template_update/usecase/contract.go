@@ -10,5 +10,5 @@ import (
 
 type templateRepository interface {
 	GetByID(ctx context.Context, id int64) (*domain.Template, error)
-	UpdateByID(ctx context.Context, id int64, name string) error
+	UpdateByID(ctx context.Context, template domain.TemplateUpdate) error
 }
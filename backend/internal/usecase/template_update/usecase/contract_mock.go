@@ -57,15 +57,15 @@ func (mr *MocktemplateRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call
 }
 
 // UpdateByID mocks base method.
-func (m *MocktemplateRepository) UpdateByID(ctx context.Context, id int64, name string) error {
+func (m *MocktemplateRepository) UpdateByID(ctx context.Context, template domain.TemplateUpdate) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateByID", ctx, id, name)
+	ret := m.ctrl.Call(m, "UpdateByID", ctx, template)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateByID indicates an expected call of UpdateByID.
-func (mr *MocktemplateRepositoryMockRecorder) UpdateByID(ctx, id, name any) *gomock.Call {
+func (mr *MocktemplateRepositoryMockRecorder) UpdateByID(ctx, template any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MocktemplateRepository)(nil).UpdateByID), ctx, id, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MocktemplateRepository)(nil).UpdateByID), ctx, template)
 }
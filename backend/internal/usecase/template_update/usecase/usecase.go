@@ -35,7 +35,13 @@ func (u *Usecase) Handle(ctx context.Context, in domain.TemplateUpdateIn) error
 		return domain.ErrTemplateInvalid
 	}
 
-	err = u.templateRepo.UpdateByID(ctx, in.TemplateID, in.Name)
+	templateUpdate := domain.TemplateUpdate{
+		ID:           in.TemplateID,
+		Name:         in.Name,
+		OutputFormat: in.OutputFormat,
+		StyleProfile: in.StyleProfile,
+	}
+	err = u.templateRepo.UpdateByID(ctx, templateUpdate)
 	if err != nil {
 		return fmt.Errorf("template repo - update by id: %w", err)
 	}
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	output_domain "github.com/qsoulior/tech-generator/backend/internal/domain/output"
 	"github.com/qsoulior/tech-generator/backend/internal/usecase/template_update/domain"
 )
 
@@ -26,7 +28,7 @@ func TestUsecase_Handle_Success(t *testing.T) {
 			setup: func(templateRepo *MocktemplateRepository) {
 				template := domain.Template{AuthorID: 1, ProjectAuthorID: 2}
 				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(&template, nil)
-				templateRepo.EXPECT().UpdateByID(ctx, int64(10), "new").Return(nil)
+				templateRepo.EXPECT().UpdateByID(ctx, domain.TemplateUpdate{ID: 10, Name: "new"}).Return(nil)
 			},
 		},
 		{
@@ -35,7 +37,28 @@ func TestUsecase_Handle_Success(t *testing.T) {
 			setup: func(templateRepo *MocktemplateRepository) {
 				template := domain.Template{AuthorID: 1, ProjectAuthorID: 2}
 				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(&template, nil)
-				templateRepo.EXPECT().UpdateByID(ctx, int64(10), "new").Return(nil)
+				templateRepo.EXPECT().UpdateByID(ctx, domain.TemplateUpdate{ID: 10, Name: "new"}).Return(nil)
+			},
+		},
+		{
+			name: "OutputFormat",
+			in: domain.TemplateUpdateIn{
+				TemplateID:   10,
+				UserID:       1,
+				Name:         "new",
+				OutputFormat: lo.ToPtr(output_domain.FormatDOCX),
+				StyleProfile: lo.ToPtr("gost_19"),
+			},
+			setup: func(templateRepo *MocktemplateRepository) {
+				template := domain.Template{AuthorID: 1, ProjectAuthorID: 2}
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(&template, nil)
+				templateUpdate := domain.TemplateUpdate{
+					ID:           10,
+					Name:         "new",
+					OutputFormat: lo.ToPtr(output_domain.FormatDOCX),
+					StyleProfile: lo.ToPtr("gost_19"),
+				}
+				templateRepo.EXPECT().UpdateByID(ctx, templateUpdate).Return(nil)
 			},
 		},
 	}
@@ -71,6 +94,20 @@ func TestUsecase_Handle_Error(t *testing.T) {
 			want:    "name",
 			wantVal: true,
 		},
+		{
+			name:    "ValidationInvalidOutputFormat",
+			in:      domain.TemplateUpdateIn{TemplateID: 10, UserID: 1, Name: "new", OutputFormat: lo.ToPtr(output_domain.Format("odt"))},
+			setup:   func(_ *MocktemplateRepository) {},
+			want:    "outputFormat",
+			wantVal: true,
+		},
+		{
+			name:    "ValidationInvalidStyleProfile",
+			in:      domain.TemplateUpdateIn{TemplateID: 10, UserID: 1, Name: "new", StyleProfile: lo.ToPtr("gost_2")},
+			setup:   func(_ *MocktemplateRepository) {},
+			want:    "styleProfile",
+			wantVal: true,
+		},
 		{
 			name: "templateRepo_GetByID",
 			in:   domain.TemplateUpdateIn{TemplateID: 10, UserID: 1, Name: "new"},
@@ -102,7 +139,7 @@ func TestUsecase_Handle_Error(t *testing.T) {
 			setup: func(templateRepo *MocktemplateRepository) {
 				template := domain.Template{AuthorID: 1, ProjectAuthorID: 2}
 				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(&template, nil)
-				templateRepo.EXPECT().UpdateByID(ctx, int64(10), "new").Return(errors.New("test2"))
+				templateRepo.EXPECT().UpdateByID(ctx, domain.TemplateUpdate{ID: 10, Name: "new"}).Return(errors.New("test2"))
 			},
 			want: "test2",
 		},
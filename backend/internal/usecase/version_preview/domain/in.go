@@ -0,0 +1,8 @@
+package domain
+
+type VersionPreviewIn struct {
+	VersionID int64
+	UserID    int64
+	Payload   map[string]any
+	Trace     bool
+}
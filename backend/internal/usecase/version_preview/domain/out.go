@@ -0,0 +1,11 @@
+package domain
+
+import task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+
+// VersionPreviewOut holds either the rendered document or the error a task
+// with the same payload would fail with.
+type VersionPreviewOut struct {
+	Result []byte
+	Error  *task_domain.ProcessError
+	Trace  []task_domain.VariableTrace
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	dictionary_list_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list/domain"
+	version_render_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_render/domain"
+)
+
+var (
+	ErrVersionNotFound = error_domain.NewBaseError("version not found")
+	ErrVersionInvalid  = error_domain.NewBaseError("version is invalid")
+)
+
+type Version struct {
+	ProjectAuthorID  int64
+	TemplateAuthorID int64
+	TemplateUsers    []TemplateUser
+}
+
+type TemplateUser struct {
+	ID   int64
+	Role user_domain.Role
+}
+
+type VersionRenderIn = version_render_domain.VersionRenderIn
+
+type DictionaryListIn = dictionary_list_domain.DictionaryListIn
@@ -0,0 +1,20 @@
+package version_preview_usecase
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	constant_list_service "github.com/qsoulior/tech-generator/backend/internal/service/constant_list"
+	dictionary_list_service "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list"
+	version_get_service "github.com/qsoulior/tech-generator/backend/internal/service/version_get"
+	version_render_service "github.com/qsoulior/tech-generator/backend/internal/service/version_render/service"
+	version_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview/repository/version"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview/usecase"
+)
+
+func New(db *sqlx.DB, versionRenderService *version_render_service.Service) *usecase.Usecase {
+	versionRepo := version_repository.New(db)
+	versionGetService := version_get_service.New(db)
+	dictionaryListService := dictionary_list_service.New(db)
+	constantListService := constant_list_service.New(db)
+	return usecase.New(versionRepo, versionGetService, dictionaryListService, constantListService, versionRenderService)
+}
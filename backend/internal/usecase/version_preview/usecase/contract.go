@@ -0,0 +1,32 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package usecase
+
+import (
+	"context"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview/domain"
+)
+
+type versionRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Version, error)
+}
+
+type versionGetService interface {
+	Handle(ctx context.Context, versionID int64) (*version_get_domain.Version, error)
+}
+
+type dictionaryListService interface {
+	Handle(ctx context.Context, in domain.DictionaryListIn) ([]dictionary_domain.Dictionary, error)
+}
+
+type constantListService interface {
+	Handle(ctx context.Context, templateID int64) (map[string]string, error)
+}
+
+type versionRenderService interface {
+	Handle(ctx context.Context, in domain.VersionRenderIn) ([]byte, []task_domain.VariableTrace, error)
+}
@@ -0,0 +1,217 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -package usecase -source contract.go -destination contract_mock.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
+	domain0 "github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockversionRepository is a mock of versionRepository interface.
+type MockversionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockversionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockversionRepositoryMockRecorder is the mock recorder for MockversionRepository.
+type MockversionRepositoryMockRecorder struct {
+	mock *MockversionRepository
+}
+
+// NewMockversionRepository creates a new mock instance.
+func NewMockversionRepository(ctrl *gomock.Controller) *MockversionRepository {
+	mock := &MockversionRepository{ctrl: ctrl}
+	mock.recorder = &MockversionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockversionRepository) EXPECT() *MockversionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockversionRepository) GetByID(ctx context.Context, id int64) (*domain0.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain0.Version)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockversionRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockversionRepository)(nil).GetByID), ctx, id)
+}
+
+// MockversionGetService is a mock of versionGetService interface.
+type MockversionGetService struct {
+	ctrl     *gomock.Controller
+	recorder *MockversionGetServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockversionGetServiceMockRecorder is the mock recorder for MockversionGetService.
+type MockversionGetServiceMockRecorder struct {
+	mock *MockversionGetService
+}
+
+// NewMockversionGetService creates a new mock instance.
+func NewMockversionGetService(ctrl *gomock.Controller) *MockversionGetService {
+	mock := &MockversionGetService{ctrl: ctrl}
+	mock.recorder = &MockversionGetServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockversionGetService) EXPECT() *MockversionGetServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockversionGetService) Handle(ctx context.Context, versionID int64) (*domain.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, versionID)
+	ret0, _ := ret[0].(*domain.Version)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockversionGetServiceMockRecorder) Handle(ctx, versionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockversionGetService)(nil).Handle), ctx, versionID)
+}
+
+// MockdictionaryListService is a mock of dictionaryListService interface.
+type MockdictionaryListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockdictionaryListServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockdictionaryListServiceMockRecorder is the mock recorder for MockdictionaryListService.
+type MockdictionaryListServiceMockRecorder struct {
+	mock *MockdictionaryListService
+}
+
+// NewMockdictionaryListService creates a new mock instance.
+func NewMockdictionaryListService(ctrl *gomock.Controller) *MockdictionaryListService {
+	mock := &MockdictionaryListService{ctrl: ctrl}
+	mock.recorder = &MockdictionaryListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdictionaryListService) EXPECT() *MockdictionaryListServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockdictionaryListService) Handle(ctx context.Context, in domain0.DictionaryListIn) ([]dictionary_domain.Dictionary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, in)
+	ret0, _ := ret[0].([]dictionary_domain.Dictionary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockdictionaryListServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockdictionaryListService)(nil).Handle), ctx, in)
+}
+
+// MockconstantListService is a mock of constantListService interface.
+type MockconstantListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockconstantListServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockconstantListServiceMockRecorder is the mock recorder for MockconstantListService.
+type MockconstantListServiceMockRecorder struct {
+	mock *MockconstantListService
+}
+
+// NewMockconstantListService creates a new mock instance.
+func NewMockconstantListService(ctrl *gomock.Controller) *MockconstantListService {
+	mock := &MockconstantListService{ctrl: ctrl}
+	mock.recorder = &MockconstantListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockconstantListService) EXPECT() *MockconstantListServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockconstantListService) Handle(ctx context.Context, templateID int64) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, templateID)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockconstantListServiceMockRecorder) Handle(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockconstantListService)(nil).Handle), ctx, templateID)
+}
+
+// MockversionRenderService is a mock of versionRenderService interface.
+type MockversionRenderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockversionRenderServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockversionRenderServiceMockRecorder is the mock recorder for MockversionRenderService.
+type MockversionRenderServiceMockRecorder struct {
+	mock *MockversionRenderService
+}
+
+// NewMockversionRenderService creates a new mock instance.
+func NewMockversionRenderService(ctrl *gomock.Controller) *MockversionRenderService {
+	mock := &MockversionRenderService{ctrl: ctrl}
+	mock.recorder = &MockversionRenderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockversionRenderService) EXPECT() *MockversionRenderServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockversionRenderService) Handle(ctx context.Context, in domain0.VersionRenderIn) ([]byte, []task_domain.VariableTrace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, in)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].([]task_domain.VariableTrace)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockversionRenderServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockversionRenderService)(nil).Handle), ctx, in)
+}
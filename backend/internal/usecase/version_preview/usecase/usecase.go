@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview/domain"
+)
+
+type Usecase struct {
+	versionRepo           versionRepository
+	versionGetService     versionGetService
+	dictionaryListService dictionaryListService
+	constantListService   constantListService
+	versionRenderService  versionRenderService
+}
+
+func New(
+	versionRepo versionRepository,
+	versionGetService versionGetService,
+	dictionaryListService dictionaryListService,
+	constantListService constantListService,
+	versionRenderService versionRenderService,
+) *Usecase {
+	return &Usecase{
+		versionRepo:           versionRepo,
+		versionGetService:     versionGetService,
+		dictionaryListService: dictionaryListService,
+		constantListService:   constantListService,
+		versionRenderService:  versionRenderService,
+	}
+}
+
+func (u *Usecase) Handle(ctx context.Context, in domain.VersionPreviewIn) (*domain.VersionPreviewOut, error) {
+	// check version
+	if err := u.handleVersion(ctx, in); err != nil {
+		return nil, err
+	}
+
+	// get version
+	version, err := u.versionGetService.Handle(ctx, in.VersionID)
+	if err != nil {
+		return nil, fmt.Errorf("version get service - handle: %w", err)
+	}
+
+	// get dictionaries
+	dictionaries, err := u.dictionaryListService.Handle(ctx, domain.DictionaryListIn{TemplateID: version.TemplateID})
+	if err != nil {
+		return nil, fmt.Errorf("dictionary list service - handle: %w", err)
+	}
+
+	// get constants
+	constants, err := u.constantListService.Handle(ctx, version.TemplateID)
+	if err != nil {
+		return nil, fmt.Errorf("constant list service - handle: %w", err)
+	}
+
+	// render version
+	versionRenderIn := domain.VersionRenderIn{
+		Data:         version.Data,
+		Kind:         version.Kind,
+		Variables:    version.Variables,
+		Dependencies: version.Dependencies,
+		Payload:      in.Payload,
+		Functions:    version.Functions,
+		Partials:     version.Partials,
+		Dictionaries: dictionaries,
+		Constants:    constants,
+		Trace:        in.Trace,
+	}
+	result, trace, err := u.versionRenderService.Handle(ctx, versionRenderIn)
+	if err != nil {
+		var processErr *task_domain.ProcessError
+		if errors.As(err, &processErr) {
+			return &domain.VersionPreviewOut{Error: processErr, Trace: trace}, nil
+		}
+		return nil, fmt.Errorf("version render service - handle: %w", err)
+	}
+
+	return &domain.VersionPreviewOut{Result: result, Trace: trace}, nil
+}
+
+func (u *Usecase) handleVersion(ctx context.Context, in domain.VersionPreviewIn) error {
+	// get version
+	version, err := u.versionRepo.GetByID(ctx, in.VersionID)
+	if err != nil {
+		return fmt.Errorf("version repo - get by id: %w", err)
+	}
+
+	if version == nil {
+		return domain.ErrVersionNotFound
+	}
+
+	// check permission
+	isReader := lo.SomeBy(version.TemplateUsers, func(user domain.TemplateUser) bool {
+		return user.ID == in.UserID && (user.Role == user_domain.RoleRead || user.Role == user_domain.RoleWrite)
+	})
+
+	if version.ProjectAuthorID != in.UserID && version.TemplateAuthorID != in.UserID && !isReader {
+		return domain.ErrVersionInvalid
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview/domain"
+)
+
+func TestUsecase_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+
+	in := domain.VersionPreviewIn{
+		VersionID: 100,
+		UserID:    3,
+		Payload:   map[string]any{"k": "v"},
+		Trace:     true,
+	}
+
+	version := &domain.Version{
+		ProjectAuthorID:  1,
+		TemplateAuthorID: 2,
+		TemplateUsers:    []domain.TemplateUser{{ID: 3, Role: user_domain.RoleRead}},
+	}
+
+	fullVersion := &version_get_domain.Version{
+		ID:           100,
+		TemplateID:   10,
+		Data:         []byte("{{ .k }}"),
+		Dependencies: map[string][]string{"k": {}},
+	}
+
+	dictionaries := []dictionary_domain.Dictionary{{VersionID: 1, Name: "materials"}}
+	constants := map[string]string{"org_name": "ООО Ромашка"}
+
+	versionRenderIn := domain.VersionRenderIn{
+		Data:         fullVersion.Data,
+		Variables:    fullVersion.Variables,
+		Dependencies: fullVersion.Dependencies,
+		Payload:      in.Payload,
+		Functions:    fullVersion.Functions,
+		Partials:     fullVersion.Partials,
+		Dictionaries: dictionaries,
+		Constants:    constants,
+		Trace:        true,
+	}
+
+	trace := []task_domain.VariableTrace{{Order: 1, Name: "k", Source: task_domain.TraceSourcePayload, Value: "v", Type: "string"}}
+
+	tests := []struct {
+		name  string
+		setup func(versionRenderService *MockversionRenderService)
+		want  domain.VersionPreviewOut
+	}{
+		{
+			name: "Result",
+			setup: func(versionRenderService *MockversionRenderService) {
+				versionRenderService.EXPECT().Handle(ctx, versionRenderIn).Return([]byte("v"), trace, nil)
+			},
+			want: domain.VersionPreviewOut{Result: []byte("v"), Trace: trace},
+		},
+		{
+			name: "ProcessError",
+			setup: func(versionRenderService *MockversionRenderService) {
+				versionRenderService.EXPECT().Handle(ctx, versionRenderIn).Return(nil, trace, &task_domain.ProcessError{Message: task_domain.MessageConstraintCheck})
+			},
+			want: domain.VersionPreviewOut{Error: &task_domain.ProcessError{Message: task_domain.MessageConstraintCheck}, Trace: trace},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			versionRepo := NewMockversionRepository(ctrl)
+			versionGetService := NewMockversionGetService(ctrl)
+			dictionaryListService := NewMockdictionaryListService(ctrl)
+			constantListService := NewMockconstantListService(ctrl)
+			versionRenderService := NewMockversionRenderService(ctrl)
+
+			versionRepo.EXPECT().GetByID(ctx, in.VersionID).Return(version, nil)
+			versionGetService.EXPECT().Handle(ctx, in.VersionID).Return(fullVersion, nil)
+			dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: fullVersion.TemplateID}).Return(dictionaries, nil)
+			constantListService.EXPECT().Handle(ctx, fullVersion.TemplateID).Return(constants, nil)
+			tt.setup(versionRenderService)
+
+			usecase := New(versionRepo, versionGetService, dictionaryListService, constantListService, versionRenderService)
+			got, err := usecase.Handle(ctx, in)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, *got)
+		})
+	}
+}
+
+func TestUsecase_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+
+	testErr := errors.New("test error")
+
+	in := domain.VersionPreviewIn{
+		VersionID: 100,
+		UserID:    1,
+		Payload:   map[string]any{"k": "v"},
+	}
+
+	validVersion := &domain.Version{
+		ProjectAuthorID:  1,
+		TemplateAuthorID: 2,
+	}
+
+	tests := []struct {
+		name  string
+		setup func(versionRepo *MockversionRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService)
+		want  error
+	}{
+		{
+			name: "versionRepo_GetByID",
+			setup: func(versionRepo *MockversionRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				versionRepo.EXPECT().GetByID(ctx, in.VersionID).Return(nil, testErr)
+			},
+			want: testErr,
+		},
+		{
+			name: "versionRepo_GetByID_NotFound",
+			setup: func(versionRepo *MockversionRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				versionRepo.EXPECT().GetByID(ctx, in.VersionID).Return(nil, nil)
+			},
+			want: domain.ErrVersionNotFound,
+		},
+		{
+			name: "version_Invalid_NoPermission",
+			setup: func(versionRepo *MockversionRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				version := &domain.Version{
+					ProjectAuthorID:  999,
+					TemplateAuthorID: 998,
+					TemplateUsers:    []domain.TemplateUser{{ID: in.UserID, Role: user_domain.Role("invalid")}},
+				}
+				versionRepo.EXPECT().GetByID(ctx, in.VersionID).Return(version, nil)
+			},
+			want: domain.ErrVersionInvalid,
+		},
+		{
+			name: "versionGetService_Handle",
+			setup: func(versionRepo *MockversionRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				versionRepo.EXPECT().GetByID(ctx, in.VersionID).Return(validVersion, nil)
+				versionGetService.EXPECT().Handle(ctx, in.VersionID).Return(nil, testErr)
+			},
+			want: testErr,
+		},
+		{
+			name: "dictionaryListService_Handle",
+			setup: func(versionRepo *MockversionRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				versionRepo.EXPECT().GetByID(ctx, in.VersionID).Return(validVersion, nil)
+				versionGetService.EXPECT().Handle(ctx, in.VersionID).Return(&version_get_domain.Version{}, nil)
+				dictionaryListService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, testErr)
+			},
+			want: testErr,
+		},
+		{
+			name: "constantListService_Handle",
+			setup: func(versionRepo *MockversionRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				versionRepo.EXPECT().GetByID(ctx, in.VersionID).Return(validVersion, nil)
+				versionGetService.EXPECT().Handle(ctx, in.VersionID).Return(&version_get_domain.Version{}, nil)
+				dictionaryListService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil)
+				constantListService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, testErr)
+			},
+			want: testErr,
+		},
+		{
+			name: "versionRenderService_Handle",
+			setup: func(versionRepo *MockversionRepository, versionGetService *MockversionGetService, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				versionRepo.EXPECT().GetByID(ctx, in.VersionID).Return(validVersion, nil)
+				versionGetService.EXPECT().Handle(ctx, in.VersionID).Return(&version_get_domain.Version{}, nil)
+				dictionaryListService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil)
+				constantListService.EXPECT().Handle(ctx, gomock.Any()).Return(map[string]string{}, nil)
+				versionRenderService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil, testErr)
+			},
+			want: testErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			versionRepo := NewMockversionRepository(ctrl)
+			versionGetService := NewMockversionGetService(ctrl)
+			dictionaryListService := NewMockdictionaryListService(ctrl)
+			constantListService := NewMockconstantListService(ctrl)
+			versionRenderService := NewMockversionRenderService(ctrl)
+			tt.setup(versionRepo, versionGetService, dictionaryListService, constantListService, versionRenderService)
+
+			usecase := New(versionRepo, versionGetService, dictionaryListService, constantListService, versionRenderService)
+			_, err := usecase.Handle(ctx, in)
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
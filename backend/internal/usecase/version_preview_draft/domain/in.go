@@ -0,0 +1,21 @@
+package domain
+
+import (
+	dictionary_list_domain "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list/domain"
+	version_create_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
+	version_render_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_render/domain"
+)
+
+// VersionPreviewDraftIn holds an unsaved version in the same shape it would be
+// created with, and a sample payload to render it with.
+type VersionPreviewDraftIn struct {
+	Version VersionCreateIn
+	Payload map[string]any
+	Trace   bool
+}
+
+type VersionCreateIn = version_create_domain.VersionCreateIn
+
+type VersionRenderIn = version_render_domain.VersionRenderIn
+
+type DictionaryListIn = dictionary_list_domain.DictionaryListIn
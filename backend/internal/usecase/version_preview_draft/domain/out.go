@@ -0,0 +1,11 @@
+package domain
+
+import task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+
+// VersionPreviewDraftOut holds either the rendered document or the error a task
+// with the same payload would fail with once the draft is saved.
+type VersionPreviewDraftOut struct {
+	Result []byte
+	Error  *task_domain.ProcessError
+	Trace  []task_domain.VariableTrace
+}
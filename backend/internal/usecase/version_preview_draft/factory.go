@@ -0,0 +1,22 @@
+package version_preview_draft_usecase
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	constant_list_service "github.com/qsoulior/tech-generator/backend/internal/service/constant_list"
+	dictionary_list_service "github.com/qsoulior/tech-generator/backend/internal/service/dictionary_list"
+	version_render_service "github.com/qsoulior/tech-generator/backend/internal/service/version_render/service"
+	function_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview_draft/repository/function"
+	partial_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview_draft/repository/partial"
+	template_repository "github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview_draft/repository/template"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview_draft/usecase"
+)
+
+func New(db *sqlx.DB, versionRenderService *version_render_service.Service) *usecase.Usecase {
+	templateRepo := template_repository.New(db)
+	functionRepo := function_repository.New(db)
+	partialRepo := partial_repository.New(db)
+	dictionaryListService := dictionary_list_service.New(db)
+	constantListService := constant_list_service.New(db)
+	return usecase.New(templateRepo, functionRepo, partialRepo, dictionaryListService, constantListService, versionRenderService)
+}
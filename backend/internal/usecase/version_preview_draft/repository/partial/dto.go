@@ -0,0 +1,17 @@
+package partial_repository
+
+import partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+
+type partial struct {
+	VersionID int64  `db:"version_id"`
+	Name      string `db:"name"`
+	Data      string `db:"data"`
+}
+
+func (p partial) toDomain() partial_domain.Partial {
+	return partial_domain.Partial{
+		VersionID: p.VersionID,
+		Name:      p.Name,
+		Data:      p.Data,
+	}
+}
@@ -0,0 +1,53 @@
+package partial_repository
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+func (r *Repository) ListByTemplateID(ctx context.Context, templateID int64) ([]partial_domain.Partial, error) {
+	op := "partial - list by template id"
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"pv.id AS version_id",
+			"p.name",
+			"pv.data",
+		).
+		From("project_partial p").
+		Join("project_partial_version pv ON p.last_version_id = pv.id").
+		Join("template t ON p.project_id = t.project_id").
+		Where(sq.Eq{"t.id": templateID}).
+		OrderBy("p.name ASC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query %q: %w", op, err)
+	}
+
+	query = fmt.Sprintf("-- %s\n%s", op, query)
+
+	var dtos []partial
+	err = r.db.SelectContext(ctx, &dtos, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query %q: %w", op, err)
+	}
+
+	return lo.Map(dtos, func(p partial, _ int) partial_domain.Partial { return p.toDomain() }), nil
+}
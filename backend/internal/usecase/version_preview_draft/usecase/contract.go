@@ -0,0 +1,37 @@
+//go:generate go tool mockgen -package $GOPACKAGE -source contract.go -destination contract_mock.go
+
+package usecase
+
+import (
+	"context"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview_draft/domain"
+)
+
+type templateRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Template, error)
+}
+
+type functionRepository interface {
+	ListByTemplateID(ctx context.Context, templateID int64) ([]function_domain.Function, error)
+}
+
+type partialRepository interface {
+	ListByTemplateID(ctx context.Context, templateID int64) ([]partial_domain.Partial, error)
+}
+
+type dictionaryListService interface {
+	Handle(ctx context.Context, in domain.DictionaryListIn) ([]dictionary_domain.Dictionary, error)
+}
+
+type constantListService interface {
+	Handle(ctx context.Context, templateID int64) (map[string]string, error)
+}
+
+type versionRenderService interface {
+	Handle(ctx context.Context, in domain.VersionRenderIn) ([]byte, []task_domain.VariableTrace, error)
+}
@@ -0,0 +1,257 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -package usecase -source contract.go -destination contract_mock.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	domain "github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview_draft/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktemplateRepository is a mock of templateRepository interface.
+type MocktemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MocktemplateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MocktemplateRepositoryMockRecorder is the mock recorder for MocktemplateRepository.
+type MocktemplateRepositoryMockRecorder struct {
+	mock *MocktemplateRepository
+}
+
+// NewMocktemplateRepository creates a new mock instance.
+func NewMocktemplateRepository(ctrl *gomock.Controller) *MocktemplateRepository {
+	mock := &MocktemplateRepository{ctrl: ctrl}
+	mock.recorder = &MocktemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktemplateRepository) EXPECT() *MocktemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MocktemplateRepository) GetByID(ctx context.Context, id int64) (*domain.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MocktemplateRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MocktemplateRepository)(nil).GetByID), ctx, id)
+}
+
+// MockfunctionRepository is a mock of functionRepository interface.
+type MockfunctionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockfunctionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockfunctionRepositoryMockRecorder is the mock recorder for MockfunctionRepository.
+type MockfunctionRepositoryMockRecorder struct {
+	mock *MockfunctionRepository
+}
+
+// NewMockfunctionRepository creates a new mock instance.
+func NewMockfunctionRepository(ctrl *gomock.Controller) *MockfunctionRepository {
+	mock := &MockfunctionRepository{ctrl: ctrl}
+	mock.recorder = &MockfunctionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfunctionRepository) EXPECT() *MockfunctionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByTemplateID mocks base method.
+func (m *MockfunctionRepository) ListByTemplateID(ctx context.Context, templateID int64) ([]function_domain.Function, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTemplateID", ctx, templateID)
+	ret0, _ := ret[0].([]function_domain.Function)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTemplateID indicates an expected call of ListByTemplateID.
+func (mr *MockfunctionRepositoryMockRecorder) ListByTemplateID(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTemplateID", reflect.TypeOf((*MockfunctionRepository)(nil).ListByTemplateID), ctx, templateID)
+}
+
+// MockpartialRepository is a mock of partialRepository interface.
+type MockpartialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockpartialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockpartialRepositoryMockRecorder is the mock recorder for MockpartialRepository.
+type MockpartialRepositoryMockRecorder struct {
+	mock *MockpartialRepository
+}
+
+// NewMockpartialRepository creates a new mock instance.
+func NewMockpartialRepository(ctrl *gomock.Controller) *MockpartialRepository {
+	mock := &MockpartialRepository{ctrl: ctrl}
+	mock.recorder = &MockpartialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpartialRepository) EXPECT() *MockpartialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByTemplateID mocks base method.
+func (m *MockpartialRepository) ListByTemplateID(ctx context.Context, templateID int64) ([]partial_domain.Partial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTemplateID", ctx, templateID)
+	ret0, _ := ret[0].([]partial_domain.Partial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTemplateID indicates an expected call of ListByTemplateID.
+func (mr *MockpartialRepositoryMockRecorder) ListByTemplateID(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTemplateID", reflect.TypeOf((*MockpartialRepository)(nil).ListByTemplateID), ctx, templateID)
+}
+
+// MockdictionaryListService is a mock of dictionaryListService interface.
+type MockdictionaryListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockdictionaryListServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockdictionaryListServiceMockRecorder is the mock recorder for MockdictionaryListService.
+type MockdictionaryListServiceMockRecorder struct {
+	mock *MockdictionaryListService
+}
+
+// NewMockdictionaryListService creates a new mock instance.
+func NewMockdictionaryListService(ctrl *gomock.Controller) *MockdictionaryListService {
+	mock := &MockdictionaryListService{ctrl: ctrl}
+	mock.recorder = &MockdictionaryListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdictionaryListService) EXPECT() *MockdictionaryListServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockdictionaryListService) Handle(ctx context.Context, in domain.DictionaryListIn) ([]dictionary_domain.Dictionary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, in)
+	ret0, _ := ret[0].([]dictionary_domain.Dictionary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockdictionaryListServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockdictionaryListService)(nil).Handle), ctx, in)
+}
+
+// MockconstantListService is a mock of constantListService interface.
+type MockconstantListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockconstantListServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockconstantListServiceMockRecorder is the mock recorder for MockconstantListService.
+type MockconstantListServiceMockRecorder struct {
+	mock *MockconstantListService
+}
+
+// NewMockconstantListService creates a new mock instance.
+func NewMockconstantListService(ctrl *gomock.Controller) *MockconstantListService {
+	mock := &MockconstantListService{ctrl: ctrl}
+	mock.recorder = &MockconstantListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockconstantListService) EXPECT() *MockconstantListServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockconstantListService) Handle(ctx context.Context, templateID int64) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, templateID)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockconstantListServiceMockRecorder) Handle(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockconstantListService)(nil).Handle), ctx, templateID)
+}
+
+// MockversionRenderService is a mock of versionRenderService interface.
+type MockversionRenderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockversionRenderServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockversionRenderServiceMockRecorder is the mock recorder for MockversionRenderService.
+type MockversionRenderServiceMockRecorder struct {
+	mock *MockversionRenderService
+}
+
+// NewMockversionRenderService creates a new mock instance.
+func NewMockversionRenderService(ctrl *gomock.Controller) *MockversionRenderService {
+	mock := &MockversionRenderService{ctrl: ctrl}
+	mock.recorder = &MockversionRenderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockversionRenderService) EXPECT() *MockversionRenderServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockversionRenderService) Handle(ctx context.Context, in domain.VersionRenderIn) ([]byte, []task_domain.VariableTrace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, in)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].([]task_domain.VariableTrace)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockversionRenderServiceMockRecorder) Handle(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockversionRenderService)(nil).Handle), ctx, in)
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	version_create_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
+	version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview_draft/domain"
+)
+
+type Usecase struct {
+	templateRepo          templateRepository
+	functionRepo          functionRepository
+	partialRepo           partialRepository
+	dictionaryListService dictionaryListService
+	constantListService   constantListService
+	versionRenderService  versionRenderService
+}
+
+func New(
+	templateRepo templateRepository,
+	functionRepo functionRepository,
+	partialRepo partialRepository,
+	dictionaryListService dictionaryListService,
+	constantListService constantListService,
+	versionRenderService versionRenderService,
+) *Usecase {
+	return &Usecase{
+		templateRepo:          templateRepo,
+		functionRepo:          functionRepo,
+		partialRepo:           partialRepo,
+		dictionaryListService: dictionaryListService,
+		constantListService:   constantListService,
+		versionRenderService:  versionRenderService,
+	}
+}
+
+func (u *Usecase) Handle(ctx context.Context, in domain.VersionPreviewDraftIn) (*domain.VersionPreviewDraftOut, error) {
+	// check template
+	if err := u.handleTemplate(ctx, in.Version); err != nil {
+		return nil, err
+	}
+
+	// validate version
+	if err := in.Version.Validate(); err != nil {
+		return nil, err
+	}
+
+	functions, err := u.functionRepo.ListByTemplateID(ctx, in.Version.TemplateID)
+	if err != nil {
+		return nil, fmt.Errorf("function repo - list by template id: %w", err)
+	}
+
+	dictionaries, err := u.dictionaryListService.Handle(ctx, domain.DictionaryListIn{TemplateID: in.Version.TemplateID})
+	if err != nil {
+		return nil, fmt.Errorf("dictionary list service - handle: %w", err)
+	}
+
+	if err := in.Version.ValidateExpressions(functions, dictionaries); err != nil {
+		return nil, err
+	}
+
+	partials, err := u.partialRepo.ListByTemplateID(ctx, in.Version.TemplateID)
+	if err != nil {
+		return nil, fmt.Errorf("partial repo - list by template id: %w", err)
+	}
+
+	partials, err = in.Version.ValidatePartials(partials)
+	if err != nil {
+		return nil, err
+	}
+
+	constants, err := u.constantListService.Handle(ctx, in.Version.TemplateID)
+	if err != nil {
+		return nil, fmt.Errorf("constant list service - handle: %w", err)
+	}
+
+	// render version
+	versionRenderIn := domain.VersionRenderIn{
+		Data:         in.Version.Data,
+		Kind:         template_domain.DetectKind(in.Version.Data),
+		Variables:    convertVariables(in.Version.Variables),
+		Dependencies: in.Version.Dependencies(),
+		Payload:      in.Payload,
+		Functions:    functions,
+		Partials:     partials,
+		Dictionaries: dictionaries,
+		Constants:    constants,
+		Trace:        in.Trace,
+	}
+	result, trace, err := u.versionRenderService.Handle(ctx, versionRenderIn)
+	if err != nil {
+		var processErr *task_domain.ProcessError
+		if errors.As(err, &processErr) {
+			return &domain.VersionPreviewDraftOut{Error: processErr, Trace: trace}, nil
+		}
+		return nil, fmt.Errorf("version render service - handle: %w", err)
+	}
+
+	return &domain.VersionPreviewDraftOut{Result: result, Trace: trace}, nil
+}
+
+func (u *Usecase) handleTemplate(ctx context.Context, version domain.VersionCreateIn) error {
+	// get template
+	template, err := u.templateRepo.GetByID(ctx, version.TemplateID)
+	if err != nil {
+		return fmt.Errorf("template repo - get by id: %w", err)
+	}
+
+	if template == nil {
+		return domain.ErrTemplateNotFound
+	}
+
+	// check permission
+	isWriter := lo.SomeBy(template.Users, func(user domain.TemplateUser) bool {
+		return user.ID == version.AuthorID && user.Role == user_domain.RoleWrite
+	})
+
+	if template.ProjectAuthorID != version.AuthorID && template.AuthorID != version.AuthorID && !isWriter {
+		return domain.ErrTemplateInvalid
+	}
+
+	return nil
+}
+
+func convertVariables(variables []version_create_domain.Variable) []version_get_domain.Variable {
+	return lo.Map(variables, func(v version_create_domain.Variable, _ int) version_get_domain.Variable {
+		return version_get_domain.Variable{
+			Name:              v.Name,
+			Title:             v.Title,
+			Type:              v.Type,
+			Expression:        v.Expression,
+			IsInput:           v.IsInput,
+			DefaultExpression: v.DefaultExpression,
+			EnabledIf:         v.EnabledIf,
+			Unit:              v.Unit,
+			Options:           v.Options,
+			OptionsFrom:       v.OptionsFrom,
+			ItemType:          v.ItemType,
+			Columns:           convertColumns(v.Columns),
+			Constraints:       convertConstraints(v.Constraints),
+		}
+	})
+}
+
+func convertColumns(columns []version_create_domain.Column) []version_get_domain.Column {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	return lo.Map(columns, func(c version_create_domain.Column, _ int) version_get_domain.Column {
+		return version_get_domain.Column{
+			Name:    c.Name,
+			Title:   c.Title,
+			Type:    c.Type,
+			Options: c.Options,
+		}
+	})
+}
+
+func convertConstraints(constraints []version_create_domain.Constraint) []version_get_domain.Constraint {
+	if len(constraints) == 0 {
+		return nil
+	}
+
+	return lo.Map(constraints, func(c version_create_domain.Constraint, _ int) version_get_domain.Constraint {
+		return version_get_domain.Constraint{
+			Name:       c.Name,
+			Expression: c.Expression,
+			IsActive:   c.IsActive,
+		}
+	})
+}
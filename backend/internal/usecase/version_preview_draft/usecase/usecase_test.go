@@ -0,0 +1,349 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dictionary_domain "github.com/qsoulior/tech-generator/backend/internal/domain/dictionary"
+	error_domain "github.com/qsoulior/tech-generator/backend/internal/domain/error"
+	function_domain "github.com/qsoulior/tech-generator/backend/internal/domain/function"
+	partial_domain "github.com/qsoulior/tech-generator/backend/internal/domain/partial"
+	task_domain "github.com/qsoulior/tech-generator/backend/internal/domain/task"
+	template_domain "github.com/qsoulior/tech-generator/backend/internal/domain/template"
+	user_domain "github.com/qsoulior/tech-generator/backend/internal/domain/user"
+	variable_domain "github.com/qsoulior/tech-generator/backend/internal/domain/variable"
+	version_create_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_create/domain"
+	version_get_domain "github.com/qsoulior/tech-generator/backend/internal/service/version_get/domain"
+	"github.com/qsoulior/tech-generator/backend/internal/usecase/version_preview_draft/domain"
+)
+
+func TestUsecase_Handle_Success(t *testing.T) {
+	ctx := context.Background()
+
+	in := domain.VersionPreviewDraftIn{
+		Version: domain.VersionCreateIn{
+			AuthorID:   1,
+			TemplateID: 10,
+			Data:       []byte("{{ .total }}{{ template \"footer\" . }}"),
+			Variables: []version_create_domain.Variable{
+				{
+					Name:    "rows",
+					Title:   "Rows",
+					Type:    variable_domain.TypeTable,
+					IsInput: true,
+					Columns: []version_create_domain.Column{{Name: "cost", Title: "Cost", Type: variable_domain.TypeFloat}},
+				},
+				{
+					Name:        "total",
+					Title:       "Total",
+					Type:        variable_domain.TypeFloat,
+					Expression:  lo.ToPtr("half(sum(rows, .cost) * 2)"),
+					Constraints: []version_create_domain.Constraint{{Name: "positive", Expression: "total > 0", IsActive: true}},
+				},
+			},
+		},
+		Payload: map[string]any{"rows": []any{map[string]any{"cost": "1.5"}}},
+		Trace:   true,
+	}
+
+	functions := []function_domain.Function{{VersionID: 5, Name: "half", Params: []string{"x"}, Body: "x / 2"}}
+	dictionaries := []dictionary_domain.Dictionary{{VersionID: 6, Name: "materials"}}
+	constants := map[string]string{"org_name": "ООО Ромашка"}
+	partials := []partial_domain.Partial{
+		{VersionID: 7, Name: "footer", Data: "{{ .org_name }}"},
+		{VersionID: 8, Name: "header", Data: "# Header"},
+	}
+
+	versionRenderIn := domain.VersionRenderIn{
+		Data: in.Version.Data,
+		Kind: template_domain.KindMarkdown,
+		Variables: []version_get_domain.Variable{
+			{
+				Name:    "rows",
+				Title:   "Rows",
+				Type:    variable_domain.TypeTable,
+				IsInput: true,
+				Columns: []version_get_domain.Column{{Name: "cost", Title: "Cost", Type: variable_domain.TypeFloat}},
+			},
+			{
+				Name:        "total",
+				Title:       "Total",
+				Type:        variable_domain.TypeFloat,
+				Expression:  lo.ToPtr("half(sum(rows, .cost) * 2)"),
+				Constraints: []version_get_domain.Constraint{{Name: "positive", Expression: "total > 0", IsActive: true}},
+			},
+		},
+		Dependencies: map[string][]string{"rows": {}, "total": {"rows"}},
+		Payload:      in.Payload,
+		Functions:    functions,
+		Partials:     partials[:1],
+		Dictionaries: dictionaries,
+		Constants:    constants,
+		Trace:        true,
+	}
+
+	trace := []task_domain.VariableTrace{{Order: 1, Name: "rows", Source: task_domain.TraceSourcePayload, Value: "[map[cost:1.5]]", Type: "[]map[string]interface {}"}}
+
+	tests := []struct {
+		name  string
+		setup func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService)
+		want  domain.VersionPreviewDraftOut
+	}{
+		{
+			name: "IsAuthor",
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				template := domain.Template{AuthorID: 1, ProjectAuthorID: 2}
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(&template, nil)
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(functions, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(dictionaries, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(partials, nil)
+				constantListService.EXPECT().Handle(ctx, int64(10)).Return(constants, nil)
+				versionRenderService.EXPECT().Handle(ctx, versionRenderIn).Return([]byte("1.5"), trace, nil)
+			},
+			want: domain.VersionPreviewDraftOut{Result: []byte("1.5"), Trace: trace},
+		},
+		{
+			name: "IsWriter",
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				template := domain.Template{
+					AuthorID:        2,
+					ProjectAuthorID: 3,
+					Users:           []domain.TemplateUser{{ID: 1, Role: user_domain.RoleWrite}},
+				}
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(&template, nil)
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(functions, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(dictionaries, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(partials, nil)
+				constantListService.EXPECT().Handle(ctx, int64(10)).Return(constants, nil)
+				versionRenderService.EXPECT().Handle(ctx, versionRenderIn).Return([]byte("1.5"), trace, nil)
+			},
+			want: domain.VersionPreviewDraftOut{Result: []byte("1.5"), Trace: trace},
+		},
+		{
+			name: "ProcessError",
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				template := domain.Template{AuthorID: 1, ProjectAuthorID: 2}
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(&template, nil)
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(functions, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(dictionaries, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(partials, nil)
+				constantListService.EXPECT().Handle(ctx, int64(10)).Return(constants, nil)
+				versionRenderService.EXPECT().Handle(ctx, versionRenderIn).Return(nil, nil, &task_domain.ProcessError{Message: task_domain.MessageTimeout})
+			},
+			want: domain.VersionPreviewDraftOut{Error: &task_domain.ProcessError{Message: task_domain.MessageTimeout}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			templateRepo := NewMocktemplateRepository(ctrl)
+			functionRepo := NewMockfunctionRepository(ctrl)
+			partialRepo := NewMockpartialRepository(ctrl)
+			dictionaryListService := NewMockdictionaryListService(ctrl)
+			constantListService := NewMockconstantListService(ctrl)
+			versionRenderService := NewMockversionRenderService(ctrl)
+			tt.setup(templateRepo, functionRepo, partialRepo, dictionaryListService, constantListService, versionRenderService)
+
+			usecase := New(templateRepo, functionRepo, partialRepo, dictionaryListService, constantListService, versionRenderService)
+			got, err := usecase.Handle(ctx, in)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, *got)
+		})
+	}
+}
+
+func TestUsecase_Handle_Error(t *testing.T) {
+	ctx := context.Background()
+
+	testErr := errors.New("test error")
+
+	validIn := domain.VersionPreviewDraftIn{
+		Version: domain.VersionCreateIn{
+			AuthorID:   1,
+			TemplateID: 10,
+			Data:       []byte("{{ .a }}"),
+			Variables: []version_create_domain.Variable{
+				{Name: "a", Title: "A", Type: variable_domain.TypeString, IsInput: true},
+			},
+		},
+		Payload: map[string]any{"a": "v"},
+	}
+
+	template := &domain.Template{AuthorID: 1, ProjectAuthorID: 2}
+
+	tests := []struct {
+		name  string
+		in    domain.VersionPreviewDraftIn
+		setup func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService)
+		want  error
+	}{
+		{
+			name: "templateRepo_GetByID",
+			in:   validIn,
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, testErr)
+			},
+			want: testErr,
+		},
+		{
+			name: "templateRepo_GetByID_NotFound",
+			in:   validIn,
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(nil, nil)
+			},
+			want: domain.ErrTemplateNotFound,
+		},
+		{
+			name: "template_Invalid_NoPermission",
+			in:   validIn,
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				template := domain.Template{
+					AuthorID:        2,
+					ProjectAuthorID: 3,
+					Users:           []domain.TemplateUser{{ID: 1, Role: user_domain.RoleRead}},
+				}
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(&template, nil)
+			},
+			want: domain.ErrTemplateInvalid,
+		},
+		{
+			name: "Validate",
+			in: domain.VersionPreviewDraftIn{
+				Version: domain.VersionCreateIn{
+					AuthorID:   1,
+					TemplateID: 10,
+					Variables:  []version_create_domain.Variable{{Name: "a", Title: "", Type: variable_domain.TypeString, IsInput: true}},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(template, nil)
+			},
+			want: error_domain.NewValidationError("variables.0.title", version_create_domain.ErrValueEmpty),
+		},
+		{
+			name: "functionRepo_ListByTemplateID",
+			in:   validIn,
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(template, nil)
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, testErr)
+			},
+			want: testErr,
+		},
+		{
+			name: "dictionaryListService_Handle",
+			in:   validIn,
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(template, nil)
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, testErr)
+			},
+			want: testErr,
+		},
+		{
+			name: "ValidateExpressions",
+			in: domain.VersionPreviewDraftIn{
+				Version: domain.VersionCreateIn{
+					AuthorID:   1,
+					TemplateID: 10,
+					Variables:  []version_create_domain.Variable{{Name: "a", Title: "A", Type: variable_domain.TypeString}},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(template, nil)
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+			},
+			want: error_domain.ValidationErrors{error_domain.NewValidationError("variables.0.expression", version_create_domain.ErrValueEmpty)},
+		},
+		{
+			name: "partialRepo_ListByTemplateID",
+			in:   validIn,
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(template, nil)
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, testErr)
+			},
+			want: testErr,
+		},
+		{
+			name: "ValidatePartials",
+			in: domain.VersionPreviewDraftIn{
+				Version: domain.VersionCreateIn{
+					AuthorID:   1,
+					TemplateID: 10,
+					Data:       []byte("{{ .a }}{{ template \"footer\" . }}"),
+					Variables:  []version_create_domain.Variable{{Name: "a", Title: "A", Type: variable_domain.TypeString, IsInput: true}},
+				},
+			},
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(template, nil)
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+			},
+			want: error_domain.NewValidationError("data", fmt.Errorf("%w: unknown partial %q", version_create_domain.ErrValueInvalid, "footer")),
+		},
+		{
+			name: "constantListService_Handle",
+			in:   validIn,
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(template, nil)
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				constantListService.EXPECT().Handle(ctx, int64(10)).Return(nil, testErr)
+			},
+			want: testErr,
+		},
+		{
+			name: "versionRenderService_Handle",
+			in:   validIn,
+			setup: func(templateRepo *MocktemplateRepository, functionRepo *MockfunctionRepository, partialRepo *MockpartialRepository, dictionaryListService *MockdictionaryListService, constantListService *MockconstantListService, versionRenderService *MockversionRenderService) {
+				templateRepo.EXPECT().GetByID(ctx, int64(10)).Return(template, nil)
+				functionRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				dictionaryListService.EXPECT().Handle(ctx, domain.DictionaryListIn{TemplateID: 10}).Return(nil, nil)
+				partialRepo.EXPECT().ListByTemplateID(ctx, int64(10)).Return(nil, nil)
+				constantListService.EXPECT().Handle(ctx, int64(10)).Return(map[string]string{}, nil)
+				versionRenderService.EXPECT().Handle(ctx, gomock.Any()).Return(nil, nil, testErr)
+			},
+			want: testErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			templateRepo := NewMocktemplateRepository(ctrl)
+			functionRepo := NewMockfunctionRepository(ctrl)
+			partialRepo := NewMockpartialRepository(ctrl)
+			dictionaryListService := NewMockdictionaryListService(ctrl)
+			constantListService := NewMockconstantListService(ctrl)
+			versionRenderService := NewMockversionRenderService(ctrl)
+			tt.setup(templateRepo, functionRepo, partialRepo, dictionaryListService, constantListService, versionRenderService)
+
+			usecase := New(templateRepo, functionRepo, partialRepo, dictionaryListService, constantListService, versionRenderService)
+			_, err := usecase.Handle(ctx, tt.in)
+			require.Error(t, err)
+
+			var validationErr *error_domain.ValidationError
+			var validationErrs error_domain.ValidationErrors
+			if errors.As(tt.want, &validationErr) || errors.As(tt.want, &validationErrs) {
+				require.Equal(t, tt.want, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+ALTER TABLE variable ADD COLUMN options jsonb;
+
+ALTER TABLE variable DROP CONSTRAINT variable_type_check;
+
+ALTER TABLE variable ADD CONSTRAINT variable_type_check CHECK (
+    type IN ('integer', 'float', 'string', 'boolean', 'date', 'enum')
+);
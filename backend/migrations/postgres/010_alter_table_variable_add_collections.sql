@@ -0,0 +1,11 @@
+ALTER TABLE variable ADD COLUMN item_type text CHECK (
+    item_type IN ('integer', 'float', 'string', 'boolean', 'date', 'enum')
+);
+
+ALTER TABLE variable ADD COLUMN columns jsonb;
+
+ALTER TABLE variable DROP CONSTRAINT variable_type_check;
+
+ALTER TABLE variable ADD CONSTRAINT variable_type_check CHECK (
+    type IN ('integer', 'float', 'string', 'boolean', 'date', 'enum', 'list', 'table')
+);
@@ -0,0 +1 @@
+ALTER TABLE template_version ADD COLUMN dependencies jsonb;
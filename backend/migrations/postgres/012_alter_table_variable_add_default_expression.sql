@@ -0,0 +1 @@
+ALTER TABLE variable ADD COLUMN default_expression text;
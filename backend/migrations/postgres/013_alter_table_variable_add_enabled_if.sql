@@ -0,0 +1 @@
+ALTER TABLE variable ADD COLUMN enabled_if text;
@@ -0,0 +1,2 @@
+ALTER TABLE task ADD COLUMN is_traced boolean NOT NULL DEFAULT false;
+ALTER TABLE task ADD COLUMN trace jsonb;
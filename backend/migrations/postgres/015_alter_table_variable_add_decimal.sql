@@ -0,0 +1,11 @@
+ALTER TABLE variable DROP CONSTRAINT variable_type_check;
+
+ALTER TABLE variable ADD CONSTRAINT variable_type_check CHECK (
+    type IN ('integer', 'float', 'string', 'boolean', 'date', 'enum', 'list', 'table', 'decimal')
+);
+
+ALTER TABLE variable DROP CONSTRAINT variable_item_type_check;
+
+ALTER TABLE variable ADD CONSTRAINT variable_item_type_check CHECK (
+    item_type IN ('integer', 'float', 'string', 'boolean', 'date', 'enum', 'decimal')
+);
@@ -0,0 +1,2 @@
+ALTER TABLE task ADD COLUMN clock TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc');
+UPDATE task SET clock = created_at;
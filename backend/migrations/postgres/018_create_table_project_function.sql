@@ -0,0 +1,28 @@
+CREATE TABLE project_function (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    updated_at TIMESTAMP,
+    project_id BIGINT NOT NULL REFERENCES project (id) ON DELETE CASCADE,
+    author_id BIGINT REFERENCES usr (id),
+    last_version_id BIGINT,
+    UNIQUE (project_id, name)
+);
+
+CREATE TABLE project_function_version (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    number BIGINT NOT NULL,
+    function_id BIGINT NOT NULL REFERENCES project_function (id) ON DELETE CASCADE,
+    author_id BIGINT REFERENCES usr (id),
+    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    params JSONB NOT NULL,
+    body TEXT NOT NULL
+);
+
+CREATE INDEX project_function_version__function_id__idx ON project_function_version (function_id);
+
+CREATE TABLE template_version_function (
+    version_id BIGINT NOT NULL REFERENCES template_version (id) ON DELETE CASCADE,
+    function_version_id BIGINT NOT NULL REFERENCES project_function_version (id) ON DELETE CASCADE,
+    PRIMARY KEY (version_id, function_version_id)
+);
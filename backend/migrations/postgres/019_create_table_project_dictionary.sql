@@ -0,0 +1,24 @@
+CREATE TABLE project_dictionary (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP,
+    project_id BIGINT NOT NULL REFERENCES project (id) ON DELETE CASCADE,
+    author_id BIGINT REFERENCES usr (id),
+    last_version_id BIGINT
+);
+
+CREATE UNIQUE INDEX project_dictionary__project_id__name__idx ON project_dictionary (project_id, name) WHERE deleted_at IS NULL;
+
+CREATE TABLE project_dictionary_version (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    number BIGINT NOT NULL,
+    dictionary_id BIGINT NOT NULL REFERENCES project_dictionary (id) ON DELETE CASCADE,
+    author_id BIGINT REFERENCES usr (id),
+    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    columns JSONB NOT NULL,
+    rows JSONB NOT NULL
+);
+
+CREATE INDEX project_dictionary_version__dictionary_id__idx ON project_dictionary_version (dictionary_id);
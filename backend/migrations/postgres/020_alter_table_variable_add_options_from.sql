@@ -0,0 +1 @@
+ALTER TABLE variable ADD COLUMN options_from text;
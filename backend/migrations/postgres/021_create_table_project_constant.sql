@@ -0,0 +1,9 @@
+CREATE TABLE project_constant (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    name VARCHAR(100) NOT NULL,
+    value TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    project_id BIGINT NOT NULL REFERENCES project (id) ON DELETE CASCADE,
+    author_id BIGINT REFERENCES usr (id),
+    UNIQUE (project_id, name)
+);
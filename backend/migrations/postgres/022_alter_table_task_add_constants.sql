@@ -0,0 +1 @@
+ALTER TABLE task ADD COLUMN constants JSONB;
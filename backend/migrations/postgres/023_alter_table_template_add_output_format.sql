@@ -0,0 +1,7 @@
+ALTER TABLE template ADD COLUMN output_format VARCHAR(10) NOT NULL DEFAULT 'md';
+
+ALTER TABLE template ADD CONSTRAINT template_output_format_check CHECK (
+    output_format IN ('md', 'docx')
+);
+
+ALTER TABLE template ADD COLUMN style_profile VARCHAR(50);
@@ -0,0 +1,2 @@
+ALTER TABLE result ADD COLUMN format VARCHAR(10) NOT NULL DEFAULT 'md';
+ALTER TABLE result ADD COLUMN content_type VARCHAR(100) NOT NULL DEFAULT 'text/markdown; charset=utf-8';
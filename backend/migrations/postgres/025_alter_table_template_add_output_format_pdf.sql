@@ -0,0 +1,5 @@
+ALTER TABLE template DROP CONSTRAINT template_output_format_check;
+
+ALTER TABLE template ADD CONSTRAINT template_output_format_check CHECK (
+    output_format IN ('md', 'docx', 'pdf')
+);
@@ -0,0 +1,5 @@
+ALTER TABLE task ADD COLUMN output_format VARCHAR(10);
+
+ALTER TABLE task ADD CONSTRAINT task_output_format_check CHECK (
+    output_format IN ('md', 'docx', 'pdf')
+);
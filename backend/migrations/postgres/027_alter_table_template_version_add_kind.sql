@@ -0,0 +1,5 @@
+ALTER TABLE template_version ADD COLUMN kind VARCHAR(10) NOT NULL DEFAULT 'md';
+
+ALTER TABLE template_version ADD CONSTRAINT template_version_kind_check CHECK (
+    kind IN ('md', 'docx')
+);
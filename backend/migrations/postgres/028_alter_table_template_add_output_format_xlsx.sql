@@ -0,0 +1,11 @@
+ALTER TABLE template DROP CONSTRAINT template_output_format_check;
+
+ALTER TABLE template ADD CONSTRAINT template_output_format_check CHECK (
+    output_format IN ('md', 'docx', 'pdf', 'xlsx')
+);
+
+ALTER TABLE task DROP CONSTRAINT task_output_format_check;
+
+ALTER TABLE task ADD CONSTRAINT task_output_format_check CHECK (
+    output_format IN ('md', 'docx', 'pdf', 'xlsx')
+);
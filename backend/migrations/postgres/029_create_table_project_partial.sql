@@ -0,0 +1,27 @@
+CREATE TABLE project_partial (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    updated_at TIMESTAMP,
+    project_id BIGINT NOT NULL REFERENCES project (id) ON DELETE CASCADE,
+    author_id BIGINT REFERENCES usr (id),
+    last_version_id BIGINT,
+    UNIQUE (project_id, name)
+);
+
+CREATE TABLE project_partial_version (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    number BIGINT NOT NULL,
+    partial_id BIGINT NOT NULL REFERENCES project_partial (id) ON DELETE CASCADE,
+    author_id BIGINT REFERENCES usr (id),
+    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    data TEXT NOT NULL
+);
+
+CREATE INDEX project_partial_version__partial_id__idx ON project_partial_version (partial_id);
+
+CREATE TABLE template_version_partial (
+    version_id BIGINT NOT NULL REFERENCES template_version (id) ON DELETE CASCADE,
+    partial_version_id BIGINT NOT NULL REFERENCES project_partial_version (id) ON DELETE CASCADE,
+    PRIMARY KEY (version_id, partial_version_id)
+);
@@ -55,6 +55,228 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/project/constant/list/{projectID}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Получить список констант проекта */
+        get: operations["projectConstantList"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/project/constant/save/{projectID}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Заменить список констант проекта
+         * @description Новые значения используются в задачах, созданных после сохранения. Обработанные задачи сохраняют значения, с которыми они были сформированы.
+         *     
+         */
+        post: operations["projectConstantSave"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/project/dictionary/delete/{dictionaryID}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /**
+         * Удалить справочник проекта
+         * @description Справочник перестает быть доступен шаблонам, но его версии сохраняются,
+         *     чтобы ранее созданные задачи генерировались так же.
+         *     
+         */
+        delete: operations["projectDictionaryDeleteByID"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/project/dictionary/get/{dictionaryID}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Получить справочник проекта по ID */
+        get: operations["projectDictionaryGetByID"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/project/dictionary/import/{projectID}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Импортировать справочник проекта из CSV
+         * @description Создает справочник проекта или новую версию справочника с тем же названием из CSV.
+         *     Первая строка — заголовок: первый столбец содержит ключи, остальные задаются как
+         *     name или name:type. Столбец без типа сохраняет тип из текущей версии справочника
+         *     или становится строковым. Разделитель — запятая или точка с запятой; в числах
+         *     допускается десятичная запятая.
+         *     
+         */
+        post: operations["projectDictionaryImport"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/project/dictionary/list/{projectID}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Получить список справочников проекта */
+        get: operations["projectDictionaryList"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/project/dictionary/save/{projectID}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Сохранить справочник проекта
+         * @description Создает справочник проекта или новую версию справочника с тем же названием.
+         *     Выражения переменных читают справочник функцией lookup(dict, key, column),
+         *     а перечисления могут брать из его столбца допустимые значения.
+         *     
+         */
+        post: operations["projectDictionarySave"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/project/function/list/{projectID}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Получить список функций проекта */
+        get: operations["projectFunctionList"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/project/function/save/{projectID}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Сохранить функцию проекта
+         * @description Создает функцию проекта или новую версию функции с тем же названием.
+         *     Функцию могут вызывать выражения переменных всех шаблонов проекта.
+         *     
+         */
+        post: operations["projectFunctionSave"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/project/partial/list/{projectID}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Получить список фрагментов проекта */
+        get: operations["projectPartialList"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/project/partial/save/{projectID}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Сохранить фрагмент проекта
+         * @description Создает фрагмент проекта или новую версию фрагмента с тем же названием.
+         *     Шаблоны проекта вызывают фрагмент как {{ template "название" . }} и могут
+         *     переопределять объявленные в нем блоки ({{ block }}), что делает фрагмент макетом.
+         *     Версия шаблона сохраняется с версиями фрагментов, которые она вызывает.
+         *     
+         */
+        post: operations["projectPartialSave"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/project/list": {
         parameters: {
             query?: never;
@@ -497,6 +719,40 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/version/preview": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Сгенерировать документ по версии шаблона без создания задачи */
+        post: operations["versionPreview"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/version/preview_draft": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Сгенерировать документ по несохранённому черновику версии шаблона */
+        post: operations["versionPreviewDraft"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
 }
 export type webhooks = Record<string, never>;
 export interface components {
@@ -508,6 +764,13 @@ export interface components {
         /** @description Ошибка */
         Error: {
             message: string;
+            /** @description Ошибки валидации отдельных полей */
+            details?: {
+                /** @description Путь к полю (например, variables.0.expression) */
+                field: string;
+                /** @description Сообщение ошибки */
+                message: string;
+            }[];
         };
         ProjectGetByIDResponse: {
             /** @description Название проекта */
@@ -515,58 +778,286 @@ export interface components {
             /** @description Имя автора проекта */
             authorName: string;
         };
-        ProjectListResponse: {
-            /** @description Список проектов */
-            projects: {
-                /**
-                 * Format: int64
-                 * @description ID проекта
-                 */
-                id: number;
-                /** @description Название проекта */
+        ProjectConstantListResponse: {
+            /** @description Список констант проекта, доступных в шаблонах как .project.<название> */
+            constants: {
+                /** @description Название константы */
                 name: string;
-                /** @description Имя автора проекта */
-                authorName: string;
+                /** @description Значение константы */
+                value: string;
+            }[];
+        };
+        ProjectConstantSaveRequest: {
+            /** @description Список констант проекта, доступных в шаблонах как .project.<название> */
+            constants: {
+                /** @description Название константы */
+                name: string;
+                /** @description Значение константы */
+                value: string;
             }[];
+        };
+        ProjectDictionaryGetByIDResponse: {
             /**
              * Format: int64
-             * @description Общее количество проектов
+             * @description ID справочника
              */
-            totalProjects: number;
+            id: number;
+            /** @description Название справочника */
+            name: string;
+            /** @description Столбцы справочника кроме ключа */
+            columns: {
+                /** @description Название столбца */
+                name: string;
+                /** @description Тип значений столбца */
+                type: string;
+            }[];
+            /** @description Строки справочника */
+            rows: {
+                /** @description Ключ строки */
+                key: string;
+                /** @description Значения строки по названиям столбцов в текстовом виде */
+                values: {
+                    [key: string]: string;
+                };
+            }[];
             /**
              * Format: int64
-             * @description Общее количество страниц
+             * @description Номер последней версии справочника
              */
-            totalPages: number;
+            number: number;
+            /**
+             * Format: date-time
+             * @description Время сохранения последней версии
+             */
+            updatedAt: string;
         };
-        ProjectUpdateRequest: {
-            /** @description Название проекта */
+        ProjectDictionaryImportRequest: {
+            /** @description Название справочника (идентификатор) */
             name: string;
+            /** @description Содержимое CSV-файла */
+            data: string;
         };
-        ProjectUpdateUsersRequest: {
-            /** @description Список пользователей проекта */
-            users: {
+        ProjectDictionaryImportResponse: {
+            /**
+             * Format: int64
+             * @description ID справочника
+             */
+            id: number;
+            /**
+             * Format: int64
+             * @description Номер сохраненной версии справочника
+             */
+            number: number;
+        };
+        ProjectDictionaryListResponse: {
+            /** @description Список справочников проекта в последних версиях */
+            dictionaries: {
                 /**
                  * Format: int64
-                 * @description ID пользователя
+                 * @description ID справочника
                  */
                 id: number;
+                /** @description Название справочника */
+                name: string;
+                /** @description Столбцы справочника кроме ключа */
+                columns: {
+                    /** @description Название столбца */
+                    name: string;
+                    /** @description Тип значений столбца */
+                    type: string;
+                }[];
                 /**
-                 * @description Роль пользователя в проекте
-                 * @enum {string}
+                 * Format: int64
+                 * @description Количество строк
                  */
-                role: "read" | "write" | "maintain";
-            }[];
-        };
-        ProjectUsersResponse: {
-            /** @description Список пользователей проекта */
-            users: {
+                rowCount: number;
                 /**
                  * Format: int64
-                 * @description ID пользователя
+                 * @description Номер последней версии справочника
                  */
-                id: number;
-                /** @description Имя пользователя */
+                number: number;
+                /**
+                 * Format: date-time
+                 * @description Время сохранения последней версии
+                 */
+                updatedAt: string;
+            }[];
+        };
+        ProjectDictionarySaveRequest: {
+            /** @description Название справочника (идентификатор) */
+            name: string;
+            /** @description Столбцы справочника кроме ключа */
+            columns: {
+                /** @description Название столбца (идентификатор) */
+                name: string;
+                /**
+                 * @description Тип значений столбца
+                 * @enum {string}
+                 */
+                type: "string" | "integer" | "float" | "decimal" | "boolean" | "date";
+            }[];
+            /** @description Строки справочника */
+            rows: {
+                /** @description Уникальный ключ строки */
+                key: string;
+                /** @description Значения строки по названиям столбцов в текстовом виде */
+                values: {
+                    [key: string]: string;
+                };
+            }[];
+        };
+        ProjectDictionarySaveResponse: {
+            /**
+             * Format: int64
+             * @description ID справочника
+             */
+            id: number;
+            /**
+             * Format: int64
+             * @description Номер сохраненной версии справочника
+             */
+            number: number;
+        };
+        ProjectFunctionListResponse: {
+            /** @description Список функций проекта в последних версиях */
+            functions: {
+                /**
+                 * Format: int64
+                 * @description ID функции
+                 */
+                id: number;
+                /** @description Название функции */
+                name: string;
+                /** @description Названия параметров функции */
+                params: string[];
+                /** @description Тело функции */
+                body: string;
+                /**
+                 * Format: int64
+                 * @description Номер последней версии функции
+                 */
+                number: number;
+                /**
+                 * Format: date-time
+                 * @description Время сохранения последней версии
+                 */
+                updatedAt: string;
+            }[];
+        };
+        ProjectFunctionSaveRequest: {
+            /** @description Название функции (идентификатор) */
+            name: string;
+            /** @description Названия параметров функции */
+            params: string[];
+            /** @description Тело функции — выражение над параметрами */
+            body: string;
+        };
+        ProjectFunctionSaveResponse: {
+            /**
+             * Format: int64
+             * @description ID функции
+             */
+            id: number;
+            /**
+             * Format: int64
+             * @description Номер сохраненной версии функции
+             */
+            number: number;
+        };
+        ProjectPartialListResponse: {
+            /** @description Список фрагментов проекта в последних версиях */
+            partials: {
+                /**
+                 * Format: int64
+                 * @description ID фрагмента
+                 */
+                id: number;
+                /** @description Название фрагмента */
+                name: string;
+                /** @description Текст фрагмента */
+                data: string;
+                /**
+                 * Format: int64
+                 * @description Номер последней версии фрагмента
+                 */
+                number: number;
+                /**
+                 * Format: date-time
+                 * @description Время сохранения последней версии
+                 */
+                updatedAt: string;
+            }[];
+        };
+        ProjectPartialSaveRequest: {
+            /** @description Название фрагмента (идентификатор) */
+            name: string;
+            /** @description Текст фрагмента на языке шаблонов */
+            data: string;
+        };
+        ProjectPartialSaveResponse: {
+            /**
+             * Format: int64
+             * @description ID фрагмента
+             */
+            id: number;
+            /**
+             * Format: int64
+             * @description Номер сохраненной версии фрагмента
+             */
+            number: number;
+        };
+        ProjectListResponse: {
+            /** @description Список проектов */
+            projects: {
+                /**
+                 * Format: int64
+                 * @description ID проекта
+                 */
+                id: number;
+                /** @description Название проекта */
+                name: string;
+                /** @description Имя автора проекта */
+                authorName: string;
+            }[];
+            /**
+             * Format: int64
+             * @description Общее количество проектов
+             */
+            totalProjects: number;
+            /**
+             * Format: int64
+             * @description Общее количество страниц
+             */
+            totalPages: number;
+        };
+        ProjectUpdateRequest: {
+            /** @description Название проекта */
+            name: string;
+        };
+        ProjectUpdateUsersRequest: {
+            /** @description Список пользователей проекта */
+            users: {
+                /**
+                 * Format: int64
+                 * @description ID пользователя
+                 */
+                id: number;
+                /**
+                 * @description Роль пользователя в проекте
+                 * @enum {string}
+                 */
+                role: "read" | "write" | "maintain";
+            }[];
+        };
+        ProjectUsersResponse: {
+            /** @description Список пользователей проекта */
+            users: {
+                /**
+                 * Format: int64
+                 * @description ID пользователя
+                 */
+                id: number;
+                /** @description Имя пользователя */
                 name: string;
                 /** @description Электронный адрес пользователя */
                 email: string;
@@ -577,22 +1068,129 @@ export interface components {
                 role: "read" | "write" | "maintain";
             }[];
         };
+        /**
+         * @description Формат результата (md — Markdown, docx — документ Word, pdf — документ PDF, xlsx — книга Excel)
+         * @enum {string}
+         */
+        OutputFormat: "md" | "docx" | "pdf" | "xlsx";
         TaskCreateRequest: {
             /**
              * Format: int64
              * @description ID версии шаблона
              */
             versionID: number;
-            /** @description Пэйлоад задачи */
+            /** @description Пэйлоад задачи (скаляры строками, списки и таблицы массивами) */
             payload: {
-                [key: string]: string;
+                [key: string]: unknown;
             };
+            /** @description Сохранить трассировку вычисления переменных вместе с задачей */
+            trace?: boolean;
+            outputFormat?: components["schemas"]["OutputFormat"];
         };
         /**
          * @description Статус задачи
          * @enum {string}
          */
         TaskStatus: "created" | "in_progress" | "succeed" | "failed";
+        /** @description Ошибка обработки задачи генерации */
+        ProcessError: {
+            /** @description Сообщение ошибки */
+            message?: string;
+            /** @description Путь цикличной зависимости переменных (например, a, b, c, a) */
+            cycle?: string[];
+            /** @description Локализация ошибки внутри текста шаблона */
+            template?: {
+                /** @description Номер строки в шаблоне (начиная с 1) */
+                line: number;
+                /** @description Номер столбца в шаблоне (начиная с 1); отсутствует, если неизвестен */
+                column?: number;
+                /** @description Содержимое строки шаблона, на которой произошла ошибка */
+                snippet?: string;
+                /** @description Подробное диагностическое сообщение из движка шаблонов */
+                detail?: string;
+                /** @description Имя фрагмента проекта, в котором произошла ошибка; отсутствует, если ошибка в самом шаблоне */
+                partial?: string;
+            };
+            variableErrors?: {
+                /**
+                 * Format: int64
+                 * @description ID переменной
+                 */
+                id: number;
+                /** @description Слаг переменной */
+                name: string;
+                /** @description Человекочитаемое название переменной */
+                title: string;
+                /** @description Вычисленное значение переменной, на котором сработала проверка ограничений */
+                value?: string;
+                /** @description Положение ошибочного значения внутри списка или таблицы (например, 2.cost) */
+                path?: string;
+                /** @description Сообщение ошибки */
+                message?: string;
+                constraintErrors?: {
+                    /**
+                     * Format: int64
+                     * @description ID ограничения
+                     */
+                    id: number;
+                    /** @description Название ограничения */
+                    name: string;
+                    /** @description Выражение ограничения */
+                    expression: string;
+                    /** @description Сообщение ошибки */
+                    message?: string;
+                    /** @description Переменные, участвовавшие в проверке ограничения */
+                    variables?: {
+                        /** @description Слаг переменной */
+                        name: string;
+                        /** @description Значение переменной на момент проверки */
+                        value?: string;
+                    }[];
+                }[];
+            }[];
+        };
+        /** @description Трассировка вычисления переменных в порядке вычисления */
+        Trace: {
+            /** @description Порядковый номер вычисления (начиная с 1) */
+            order: number;
+            /** @description Слаг переменной */
+            name: string;
+            /**
+             * @description Источник значения
+             * @enum {string}
+             */
+            source?: "payload" | "default" | "expression" | "disabled";
+            /** @description Условие использования переменной */
+            condition?: string;
+            /** @description Вычисленное выражение (значение по умолчанию для входной переменной) */
+            expression?: string;
+            /** @description Переменные, от которых зависит переменная, со значениями на момент вычисления */
+            dependencies?: {
+                /** @description Слаг переменной */
+                name: string;
+                /** @description Значение переменной */
+                value?: string;
+                /** @description Go-тип значения */
+                type?: string;
+            }[];
+            /** @description Вычисленное значение переменной */
+            value?: string;
+            /** @description Go-тип вычисленного значения */
+            type?: string;
+            /** @description Сообщение ошибки, если переменную не удалось вычислить */
+            message?: string;
+            /** @description Результаты проверки активных ограничений */
+            constraints?: {
+                /** @description Название ограничения */
+                name: string;
+                /** @description Выражение ограничения */
+                expression: string;
+                /** @description Пройдена ли проверка */
+                passed: boolean;
+                /** @description Сообщение ошибки */
+                message?: string;
+            }[];
+        }[];
         TaskGetByIDResponse: {
             task: {
                 /**
@@ -606,56 +1204,23 @@ export interface components {
                  */
                 versionID: number;
                 status: components["schemas"]["TaskStatus"];
-                /** @description Пэйлоад задачи */
+                /** @description Пэйлоад задачи (скаляры строками, списки и таблицы массивами) */
                 payload: {
-                    [key: string]: string;
-                };
-                /** @description Ошибка обработки задачи */
-                error?: {
-                    /** @description Сообщение ошибки */
-                    message?: string;
-                    /** @description Локализация ошибки внутри текста шаблона */
-                    template?: {
-                        /** @description Номер строки в шаблоне (начиная с 1) */
-                        line: number;
-                        /** @description Номер столбца в шаблоне (начиная с 1); отсутствует, если неизвестен */
-                        column?: number;
-                        /** @description Содержимое строки шаблона, на которой произошла ошибка */
-                        snippet?: string;
-                        /** @description Подробное диагностическое сообщение из движка шаблонов */
-                        detail?: string;
-                    };
-                    variableErrors?: {
-                        /**
-                         * Format: int64
-                         * @description ID переменной
-                         */
-                        id: number;
-                        /** @description Слаг переменной */
-                        name: string;
-                        /** @description Человекочитаемое название переменной */
-                        title: string;
-                        /** @description Вычисленное значение переменной, на котором сработала проверка ограничений */
-                        value?: string;
-                        /** @description Сообщение ошибки */
-                        message?: string;
-                        constraintErrors?: {
-                            /**
-                             * Format: int64
-                             * @description ID ограничения
-                             */
-                            id: number;
-                            /** @description Название ограничения */
-                            name: string;
-                            /** @description Выражение ограничения */
-                            expression: string;
-                            /** @description Сообщение ошибки */
-                            message?: string;
-                        }[];
-                    }[];
+                    [key: string]: unknown;
                 };
+                error?: components["schemas"]["ProcessError"];
+                trace?: components["schemas"]["Trace"];
                 /** @description Имя создателя задачи */
                 creatorName: string;
+                /**
+                 * Format: date-time
+                 * @description Время рендера, которое возвращает now (фиксируется при создании задачи)
+                 */
+                clock: string;
+                /** @description Константы проекта, с которыми сформирована задача (фиксируются при создании задачи) */
+                constants?: {
+                    [key: string]: string;
+                };
                 /**
                  * Format: date-time
                  * @description Дата и время создания задачи
@@ -669,6 +1234,9 @@ export interface components {
             };
             /** Format: byte */
             result?: string;
+            resultFormat?: components["schemas"]["OutputFormat"];
+            /** @description MIME-тип результата */
+            resultContentType?: string;
         };
         TaskListResponse: {
             /** @description Список задач генерации */
@@ -791,6 +1359,11 @@ export interface components {
              * @description Данные шаблона
              */
             data: string;
+            /**
+             * @description Вид шаблона (md — Markdown, docx — документ Word)
+             * @enum {string}
+             */
+            kind: "md" | "docx";
             /** @description Список переменных шаблона */
             variables: {
                 /**
@@ -806,11 +1379,40 @@ export interface components {
                  * @description Тип переменной
                  * @enum {string}
                  */
-                type: "string" | "integer" | "float";
+                type: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum" | "list" | "table";
                 /** @description Выражение переменной */
                 expression?: string;
                 /** @description Являтеся ли переменная входной */
                 isInput: boolean;
+                /** @description Выражение значения по умолчанию для необязательной входной переменной */
+                defaultExpression?: string;
+                /** @description Условие, при котором переменная используется; если ложно, переменная скрыта и равна nil */
+                enabledIf?: string;
+                /** @description Единица измерения числовой переменной, например mm, kN или m/s^2 */
+                unit?: string;
+                /** @description Список допустимых значений (для типа enum) */
+                options?: string[];
+                /** @description Столбец справочника проекта, из которого берутся допустимые значения (для типа enum) вместо options, например materials.key */
+                optionsFrom?: string;
+                /**
+                 * @description Тип элементов (для типа list)
+                 * @enum {string}
+                 */
+                itemType?: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum";
+                /** @description Список колонок (для типа table) */
+                columns?: {
+                    /** @description Слаг колонки (идентификатор) */
+                    name: string;
+                    /** @description Человекочитаемое название колонки */
+                    title: string;
+                    /**
+                     * @description Тип колонки
+                     * @enum {string}
+                     */
+                    type: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum";
+                    /** @description Список допустимых значений (для типа enum) */
+                    options?: string[];
+                }[];
                 /** @description Список ограничений переменной */
                 constraints: {
                     /**
@@ -830,6 +1432,9 @@ export interface components {
         TemplateGetByIDResponse: {
             /** @description Название шаблона */
             name: string;
+            outputFormat: components["schemas"]["OutputFormat"];
+            /** @description Профиль оформления документа, если выбран */
+            styleProfile?: string;
             version?: components["schemas"]["TemplateGetByIDVersion"];
         };
         TemplateImportVersion: {
@@ -848,11 +1453,40 @@ export interface components {
                  * @description Тип переменной
                  * @enum {string}
                  */
-                type: "string" | "integer" | "float";
+                type: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum" | "list" | "table";
                 /** @description Выражение переменной */
                 expression?: string;
                 /** @description Является ли переменная входной */
                 isInput: boolean;
+                /** @description Выражение значения по умолчанию для необязательной входной переменной */
+                defaultExpression?: string;
+                /** @description Условие, при котором переменная используется; если ложно, переменная скрыта и равна nil */
+                enabledIf?: string;
+                /** @description Единица измерения числовой переменной, например mm, kN или m/s^2 */
+                unit?: string;
+                /** @description Список допустимых значений (для типа enum) */
+                options?: string[];
+                /** @description Столбец справочника проекта, из которого берутся допустимые значения (для типа enum) вместо options, например materials.key */
+                optionsFrom?: string;
+                /**
+                 * @description Тип элементов (для типа list)
+                 * @enum {string}
+                 */
+                itemType?: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum";
+                /** @description Список колонок (для типа table) */
+                columns?: {
+                    /** @description Слаг колонки (идентификатор) */
+                    name: string;
+                    /** @description Человекочитаемое название колонки */
+                    title: string;
+                    /**
+                     * @description Тип колонки
+                     * @enum {string}
+                     */
+                    type: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum";
+                    /** @description Список допустимых значений (для типа enum) */
+                    options?: string[];
+                }[];
                 /** @description Список ограничений переменной */
                 constraints: {
                     /** @description Название ограничения */
@@ -925,6 +1559,9 @@ export interface components {
         TemplateUpdateRequest: {
             /** @description Название шаблона */
             name: string;
+            outputFormat?: components["schemas"]["OutputFormat"];
+            /** @description Профиль оформления документа (gost_34, gost_19 или plain); пустая строка — профиль по умолчанию */
+            styleProfile?: string;
         };
         TemplateUpdateUsersRequest: {
             /** @description Список пользователей шаблона */
@@ -1058,11 +1695,40 @@ export interface components {
                  * @description Тип переменной
                  * @enum {string}
                  */
-                type: "string" | "integer" | "float";
+                type: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum" | "list" | "table";
                 /** @description Выражение переменной */
                 expression?: string;
                 /** @description Является ли переменная входной */
                 isInput: boolean;
+                /** @description Выражение значения по умолчанию для необязательной входной переменной */
+                defaultExpression?: string;
+                /** @description Условие, при котором переменная используется; если ложно, переменная скрыта и равна nil */
+                enabledIf?: string;
+                /** @description Единица измерения числовой переменной, например mm, kN или m/s^2 */
+                unit?: string;
+                /** @description Список допустимых значений (для типа enum) */
+                options?: string[];
+                /** @description Столбец справочника проекта, из которого берутся допустимые значения (для типа enum) вместо options, например materials.key */
+                optionsFrom?: string;
+                /**
+                 * @description Тип элементов (для типа list)
+                 * @enum {string}
+                 */
+                itemType?: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum";
+                /** @description Список колонок (для типа table) */
+                columns?: {
+                    /** @description Слаг колонки (идентификатор) */
+                    name: string;
+                    /** @description Человекочитаемое название колонки */
+                    title: string;
+                    /**
+                     * @description Тип колонки
+                     * @enum {string}
+                     */
+                    type: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum";
+                    /** @description Список допустимых значений (для типа enum) */
+                    options?: string[];
+                }[];
                 /** @description Список ограничений переменной */
                 constraints: {
                     /** @description Название ограничения */
@@ -1103,61 +1769,474 @@ export interface components {
                 createdAt: string;
             }[];
         };
+        VersionPreviewRequest: {
+            /**
+             * Format: int64
+             * @description ID версии шаблона
+             */
+            versionID: number;
+            /** @description Пэйлоад задачи (скаляры строками, списки и таблицы массивами) */
+            payload: {
+                [key: string]: unknown;
+            };
+            /** @description Вернуть трассировку вычисления переменных */
+            trace?: boolean;
+        };
+        /** @description Результат генерации либо ошибка обработки, с которой завершилась бы задача */
+        VersionPreviewResponse: {
+            /** Format: byte */
+            result?: string;
+            error?: components["schemas"]["ProcessError"];
+            trace?: components["schemas"]["Trace"];
+        };
+        VersionPreviewDraftRequest: {
+            /**
+             * Format: int64
+             * @description ID шаблона
+             */
+            templateID: number;
+            /**
+             * Format: byte
+             * @description Данные черновика шаблона
+             */
+            data: string;
+            /** @description Список переменных шаблона */
+            variables: {
+                /** @description Слаг переменной (идентификатор) */
+                name: string;
+                /** @description Человекочитаемое название переменной */
+                title: string;
+                /**
+                 * @description Тип переменной
+                 * @enum {string}
+                 */
+                type: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum" | "list" | "table";
+                /** @description Выражение переменной */
+                expression?: string;
+                /** @description Является ли переменная входной */
+                isInput: boolean;
+                /** @description Выражение значения по умолчанию для необязательной входной переменной */
+                defaultExpression?: string;
+                /** @description Условие, при котором переменная используется; если ложно, переменная скрыта и равна nil */
+                enabledIf?: string;
+                /** @description Единица измерения числовой переменной, например mm, kN или m/s^2 */
+                unit?: string;
+                /** @description Список допустимых значений (для типа enum) */
+                options?: string[];
+                /** @description Столбец справочника проекта, из которого берутся допустимые значения (для типа enum) вместо options, например materials.key */
+                optionsFrom?: string;
+                /**
+                 * @description Тип элементов (для типа list)
+                 * @enum {string}
+                 */
+                itemType?: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum";
+                /** @description Список колонок (для типа table) */
+                columns?: {
+                    /** @description Слаг колонки (идентификатор) */
+                    name: string;
+                    /** @description Человекочитаемое название колонки */
+                    title: string;
+                    /**
+                     * @description Тип колонки
+                     * @enum {string}
+                     */
+                    type: "string" | "integer" | "float" | "decimal" | "boolean" | "date" | "enum";
+                    /** @description Список допустимых значений (для типа enum) */
+                    options?: string[];
+                }[];
+                /** @description Список ограничений переменной */
+                constraints: {
+                    /** @description Название ограничения */
+                    name: string;
+                    /** @description Выражение ограничения */
+                    expression: string;
+                    /** @description Активно ли ограничение */
+                    isActive: boolean;
+                }[];
+            }[];
+            /** @description Пэйлоад задачи (скаляры строками, списки и таблицы массивами) */
+            payload: {
+                [key: string]: unknown;
+            };
+            /** @description Вернуть трассировку вычисления переменных */
+            trace?: boolean;
+        };
+    };
+    responses: never;
+    parameters: {
+        /** @description ID пользователя */
+        UserID: number;
+        /** @description ID проекта */
+        ProjectID: number;
+        /** @description ID справочника */
+        DictionaryID: number;
+        /** @description Номер страницы */
+        Page: number;
+        /** @description Количество записей на странице */
+        Size: number;
+        /** @description Сортировка списка */
+        Sorting: {
+            /** @description Атрибут, по которому сортируется список */
+            attribute: string;
+            /**
+             * @description Направление, по которому сортируется список
+             * @enum {string}
+             */
+            direction: "ASC" | "DESC";
+        };
+        /** @description Название проекта */
+        ProjectName: string;
+        /** @description ID задачи */
+        TaskID: number;
+        /** @description ID шаблона */
+        TaskTemplateID: number;
+        /** @description ID создателя задачи */
+        TaskCreatorID: number;
+        /** @description Название шаблона */
+        TemplateName: string;
+        /** @description ID шаблона */
+        TemplateID: number;
+        /** @description Имя пользователя */
+        UserName: string;
+    };
+    requestBodies: never;
+    headers: never;
+    pathItems: never;
+}
+export type $defs = Record<string, never>;
+export interface operations {
+    projectCreate: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ProjectCreateRequest"];
+            };
+        };
+        responses: {
+            /** @description Created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    projectDeleteByID: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path: {
+                /** @description ID проекта */
+                projectID: components["parameters"]["ProjectID"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description No content */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    projectGetByID: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path: {
+                /** @description ID проекта */
+                projectID: components["parameters"]["ProjectID"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Ok */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProjectGetByIDResponse"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    projectConstantList: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path: {
+                /** @description ID проекта */
+                projectID: components["parameters"]["ProjectID"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Ok */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProjectConstantListResponse"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    projectConstantSave: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path: {
+                /** @description ID проекта */
+                projectID: components["parameters"]["ProjectID"];
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ProjectConstantSaveRequest"];
+            };
+        };
+        responses: {
+            /** @description No content */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    projectDictionaryDeleteByID: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path: {
+                /** @description ID справочника */
+                dictionaryID: components["parameters"]["DictionaryID"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description No content */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    projectDictionaryGetByID: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path: {
+                /** @description ID справочника */
+                dictionaryID: components["parameters"]["DictionaryID"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Ok */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProjectDictionaryGetByIDResponse"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    projectDictionaryImport: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path: {
+                /** @description ID проекта */
+                projectID: components["parameters"]["ProjectID"];
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ProjectDictionaryImportRequest"];
+            };
+        };
+        responses: {
+            /** @description Created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProjectDictionaryImportResponse"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
     };
-    responses: never;
-    parameters: {
-        /** @description ID пользователя */
-        UserID: number;
-        /** @description ID проекта */
-        ProjectID: number;
-        /** @description Номер страницы */
-        Page: number;
-        /** @description Количество записей на странице */
-        Size: number;
-        /** @description Сортировка списка */
-        Sorting: {
-            /** @description Атрибут, по которому сортируется список */
-            attribute: string;
-            /**
-             * @description Направление, по которому сортируется список
-             * @enum {string}
-             */
-            direction: "ASC" | "DESC";
+    projectDictionaryList: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path: {
+                /** @description ID проекта */
+                projectID: components["parameters"]["ProjectID"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Ok */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProjectDictionaryListResponse"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
         };
-        /** @description Название проекта */
-        ProjectName: string;
-        /** @description ID задачи */
-        TaskID: number;
-        /** @description ID шаблона */
-        TaskTemplateID: number;
-        /** @description ID создателя задачи */
-        TaskCreatorID: number;
-        /** @description Название шаблона */
-        TemplateName: string;
-        /** @description ID шаблона */
-        TemplateID: number;
-        /** @description Имя пользователя */
-        UserName: string;
     };
-    requestBodies: never;
-    headers: never;
-    pathItems: never;
-}
-export type $defs = Record<string, never>;
-export interface operations {
-    projectCreate: {
+    projectDictionarySave: {
         parameters: {
             query?: never;
             header: {
                 /** @description ID пользователя */
                 "X-User-Id": components["parameters"]["UserID"];
             };
-            path?: never;
+            path: {
+                /** @description ID проекта */
+                projectID: components["parameters"]["ProjectID"];
+            };
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["ProjectCreateRequest"];
+                "application/json": components["schemas"]["ProjectDictionarySaveRequest"];
             };
         };
         responses: {
@@ -1166,7 +2245,9 @@ export interface operations {
                 headers: {
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": components["schemas"]["ProjectDictionarySaveResponse"];
+                };
             };
             /** @description Bad request */
             400: {
@@ -1179,7 +2260,7 @@ export interface operations {
             };
         };
     };
-    projectDeleteByID: {
+    projectFunctionList: {
         parameters: {
             query?: never;
             header: {
@@ -1194,12 +2275,14 @@ export interface operations {
         };
         requestBody?: never;
         responses: {
-            /** @description No content */
-            204: {
+            /** @description Ok */
+            200: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": components["schemas"]["ProjectFunctionListResponse"];
+                };
             };
             /** @description Bad request */
             400: {
@@ -1212,7 +2295,46 @@ export interface operations {
             };
         };
     };
-    projectGetByID: {
+    projectFunctionSave: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path: {
+                /** @description ID проекта */
+                projectID: components["parameters"]["ProjectID"];
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ProjectFunctionSaveRequest"];
+            };
+        };
+        responses: {
+            /** @description Created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProjectFunctionSaveResponse"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    projectPartialList: {
         parameters: {
             query?: never;
             header: {
@@ -1233,7 +2355,46 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ProjectGetByIDResponse"];
+                    "application/json": components["schemas"]["ProjectPartialListResponse"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    projectPartialSave: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path: {
+                /** @description ID проекта */
+                projectID: components["parameters"]["ProjectID"];
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ProjectPartialSaveRequest"];
+            };
+        };
+        responses: {
+            /** @description Created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProjectPartialSaveResponse"];
                 };
             };
             /** @description Bad request */
@@ -2170,4 +3331,76 @@ export interface operations {
             };
         };
     };
+    versionPreview: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["VersionPreviewRequest"];
+            };
+        };
+        responses: {
+            /** @description Ok */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["VersionPreviewResponse"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    versionPreviewDraft: {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description ID пользователя */
+                "X-User-Id": components["parameters"]["UserID"];
+            };
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["VersionPreviewDraftRequest"];
+            };
+        };
+        responses: {
+            /** @description Ok */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["VersionPreviewResponse"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
 }
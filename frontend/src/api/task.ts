@@ -11,6 +11,7 @@ export type TaskGetVariableError = NonNullable<TaskGetError["variableErrors"]>[n
 export type TaskGetConstraintError = NonNullable<TaskGetVariableError["constraintErrors"]>[number]
 export type TaskGetTemplateError = NonNullable<TaskGetError["template"]>
 export type TaskCreateInput = components["schemas"]["TaskCreateRequest"]
+export type TaskOutputFormat = components["schemas"]["OutputFormat"]
 
 export interface TaskListParams {
   templateID: number
@@ -1,7 +1,10 @@
-export function fromBase64(data: string): string {
+export function base64ToBytes(data: string): Uint8Array<ArrayBuffer> {
   const bin = atob(data)
-  const bytes = Uint8Array.from(bin, (m) => m.codePointAt(0) ?? 0)
-  return new TextDecoder().decode(bytes)
+  return Uint8Array.from(bin, (m) => m.codePointAt(0) ?? 0)
+}
+
+export function fromBase64(data: string): string {
+  return new TextDecoder().decode(base64ToBytes(data))
 }
 
 export function toBase64(data: string): string {